@@ -0,0 +1,37 @@
+package main
+
+import (
+	"context"
+
+	consentService "credo/internal/consent/service"
+	"credo/internal/dataexport"
+	id "credo/pkg/domain"
+	"credo/pkg/requestcontext"
+)
+
+// consentHistoryAdapter implements dataexport.ConsentHistory over the consent service,
+// keeping the export module independent of consent models.
+type consentHistoryAdapter struct {
+	consents *consentService.Service
+}
+
+func (a *consentHistoryAdapter) ListConsents(ctx context.Context, userID id.UserID) ([]dataexport.Consent, error) {
+	records, err := a.consents.List(ctx, userID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	now := requestcontext.Now(ctx)
+	out := make([]dataexport.Consent, len(records))
+	for i, r := range records {
+		out[i] = dataexport.Consent{
+			ID:        r.ID.String(),
+			Purpose:   string(r.Purpose),
+			Status:    string(r.ComputeStatus(now)),
+			GrantedAt: r.GrantedAt,
+			ExpiresAt: r.ExpiresAt,
+			RevokedAt: r.RevokedAt,
+		}
+	}
+	return out, nil
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"log/slog"
+	"maps"
 	"net/http"
 	"os"
 	"os/signal"
@@ -16,6 +17,7 @@ import (
 	"credo/internal/auth/device"
 	authHandler "credo/internal/auth/handler"
 	authmetrics "credo/internal/auth/metrics"
+	authModels "credo/internal/auth/models"
 	authPorts "credo/internal/auth/ports"
 	authService "credo/internal/auth/service"
 	authCodeStore "credo/internal/auth/store/authorization-code"
@@ -26,8 +28,12 @@ import (
 	cleanupWorker "credo/internal/auth/workers/cleanup"
 	consentHandler "credo/internal/consent/handler"
 	consentmetrics "credo/internal/consent/metrics"
+	consentMW "credo/internal/consent/middleware"
+	consentModels "credo/internal/consent/models"
 	consentService "credo/internal/consent/service"
 	consentStore "credo/internal/consent/store"
+	"credo/internal/dataexport"
+	"credo/internal/erasure"
 	"credo/internal/decision"
 	decisionAdapters "credo/internal/decision/adapters"
 	decisionHandler "credo/internal/decision/handler"
@@ -36,6 +42,7 @@ import (
 	registryHandler "credo/internal/evidence/registry/handler"
 	registrymetrics "credo/internal/evidence/registry/metrics"
 	"credo/internal/evidence/registry/orchestrator"
+	"credo/internal/evidence/registry/orchestrator/correlation"
 	"credo/internal/evidence/registry/providers"
 	citizenProvider "credo/internal/evidence/registry/providers/citizen"
 	sanctionsProvider "credo/internal/evidence/registry/providers/sanctions"
@@ -55,7 +62,10 @@ import (
 	kafkaproducer "credo/internal/platform/kafka/producer"
 	"credo/internal/platform/logger"
 	platformredis "credo/internal/platform/redis"
+	rateLimitAdmin "credo/internal/ratelimit/admin"
 	rateLimitConfig "credo/internal/ratelimit/config"
+	rateLimitHandler "credo/internal/ratelimit/handler"
+	rateLimitMetrics "credo/internal/ratelimit/metrics"
 	rateLimitMW "credo/internal/ratelimit/middleware"
 	rateLimitModels "credo/internal/ratelimit/models"
 	"credo/internal/ratelimit/service/authlockout"
@@ -66,11 +76,15 @@ import (
 	authlockoutStore "credo/internal/ratelimit/store/authlockout"
 	rwbucketStore "credo/internal/ratelimit/store/bucket"
 	globalthrottleStore "credo/internal/ratelimit/store/globalthrottle"
+	quotaStore "credo/internal/ratelimit/store/quota"
+	"credo/internal/ratelimit/workers/allowlistpurge"
 	tenantHandler "credo/internal/tenant/handler"
 	tenantmetrics "credo/internal/tenant/metrics"
 	tenantService "credo/internal/tenant/service"
 	clientstore "credo/internal/tenant/store/client"
 	tenantstore "credo/internal/tenant/store/tenant"
+	tenantCounts "credo/internal/tenant/workers/counts"
+	tenantPurge "credo/internal/tenant/workers/purge"
 	audit "credo/pkg/platform/audit"
 	auditconsumer "credo/pkg/platform/audit/consumer"
 	auditmetrics "credo/pkg/platform/audit/metrics"
@@ -78,9 +92,13 @@ import (
 	outboxpostgres "credo/pkg/platform/audit/outbox/store/postgres"
 	outboxworker "credo/pkg/platform/audit/outbox/worker"
 	auditpublishers "credo/pkg/platform/audit/publishers"
+	"credo/pkg/platform/audit/publishers/compliance"
+	"credo/pkg/platform/audit/publishers/security"
+	auditlogsink "credo/pkg/platform/audit/store/logsink"
 	auditmemory "credo/pkg/platform/audit/store/memory"
 	auditpostgres "credo/pkg/platform/audit/store/postgres"
 	id "credo/pkg/domain"
+	"credo/pkg/platform/circuit"
 	adminmw "credo/pkg/platform/middleware/admin"
 	auth "credo/pkg/platform/middleware/auth"
 	devicemw "credo/pkg/platform/middleware/device"
@@ -105,12 +123,16 @@ type infraBundle struct {
 	RequestMetrics  *request.Metrics
 	JWTService      *jwttoken.JWTService
 	JWTValidator    *jwttoken.JWTServiceAdapter
+	DPoPVerifier    *jwttoken.DPoPVerifier
 	DeviceService   *device.Service
+	Locations       authService.LocationResolver // nil unless AUTH_LOCATION_NETWORKS is set
+	AuditRouter     *audit.CategoryRouter
 
 	// Phase 2: Infrastructure
 	DBPool             *database.Pool
 	RedisClient        *platformredis.Client
 	KafkaProducer      *kafkaproducer.Producer
+	OutboxStore        *outboxpostgres.Store
 	OutboxWorker       *outboxworker.Worker
 	OutboxMetrics      *outboxmetrics.Metrics
 	KafkaConsumer      *kafkaconsumer.Consumer
@@ -118,26 +140,42 @@ type infraBundle struct {
 }
 
 type authModule struct {
-	Service    *authService.Service
-	Handler    *authHandler.Handler
-	AdminSvc   *admin.Service
-	Cleanup    *cleanupWorker.CleanupService
-	AuditStore audit.Store
+	Service         *authService.Service
+	Handler         *authHandler.Handler
+	AdminSvc        *admin.Service
+	Cleanup         *cleanupWorker.CleanupService
+	AuditStore      audit.Store
+	AuditAnonymizer audit.Anonymizer
+	AuditPager      audit.UserPager
+	SecurityAudit   *security.Publisher // Shares AuditStore so admin overrides appear in recent audit events
+	ResolverBreaker *circuit.Breaker
+	UserViews       adminAdapters.AuthUserContractStore
+	SessionViews    adminAdapters.AuthSessionContractStore
 }
 
 type consentModule struct {
-	Service *consentService.Service
-	Handler *consentHandler.Handler
+	Service    *consentService.Service
+	Handler    *consentHandler.Handler
+	Middleware *consentMW.Middleware
+}
+
+// dataRightsModule serves GDPR data subject requests spanning auth, consent and audit.
+type dataRightsModule struct {
+	Export  *dataexport.Handler
+	Erasure *erasure.Handler
 }
 
 type tenantModule struct {
 	Service *tenantService.Service
 	Handler *tenantHandler.Handler
+	Purge   *tenantPurge.Service
+	Counts  *tenantCounts.Service
 }
 
 type registryModule struct {
-	Service *registryService.Service
-	Handler *registryHandler.Handler
+	Service  *registryService.Service
+	Handler  *registryHandler.Handler
+	Breakers []circuit.Reporter
 }
 
 type vcModule struct {
@@ -166,7 +204,9 @@ func (t *tenantClientLookup) IsConfidentialClient(ctx context.Context, clientID
 func main() {
 	infra, err := buildInfra()
 	if err != nil {
-		panic(err)
+		// Logger is not available until config is loaded; report directly and fail fast.
+		fmt.Fprintln(os.Stderr, "credo: startup failed:", err)
+		os.Exit(1)
 	}
 
 	rlBundle, err := buildRateLimitServices(infra)
@@ -178,6 +218,17 @@ func main() {
 		rlBundle.limiter,
 		infra.Log,
 		rateLimitMW.WithDisabled(infra.Cfg.DemoMode || infra.Cfg.DisableRateLimiting),
+		rateLimitMW.WithDegradedPolicy(rlBundle.cfg.DegradedFailClosed),
+		rateLimitMW.WithStandardHeaders(infra.Cfg.RateLimitStandardHeaders),
+		rateLimitMW.WithDebugHeaders(infra.Cfg.RateLimitDebugHeaders),
+		rateLimitMW.WithMetrics(rlBundle.metrics),
+		rateLimitMW.WithAuditPublisher(rlBundle.auditPublisher),
+		rateLimitMW.WithProbeExemption(rateLimitMW.ProbeExemption{
+			Secret:     infra.Cfg.RateLimitProbe.Secret,
+			Header:     infra.Cfg.RateLimitProbe.Header,
+			UserAgents: infra.Cfg.RateLimitProbe.UserAgents,
+			Paths:      infra.Cfg.RateLimitProbe.Paths,
+		}),
 	)
 
 	appCtx, cancelApp := context.WithCancel(context.Background())
@@ -192,14 +243,28 @@ func main() {
 		infra.Log.Error("failed to initialize auth module", "error", err)
 		os.Exit(1)
 	}
-	clientRateLimitMiddleware, err := buildClientRateLimitMiddleware(infra.Log, tenantMod.Service, rlBundle.cfg, infra.DBPool, infra.Cfg.DemoMode || infra.Cfg.DisableRateLimiting)
+	clientRateLimitMiddleware, err := buildClientRateLimitMiddleware(infra.Log, tenantMod.Service, rlBundle.cfg, infra.DBPool, infra.Cfg.DemoMode || infra.Cfg.DisableRateLimiting,
+		rateLimitMW.WithClientStandardHeaders(infra.Cfg.RateLimitStandardHeaders),
+		rateLimitMW.WithClientMetrics(rlBundle.metrics))
 	if err != nil {
 		infra.Log.Error("failed to initialize client rate limit middleware", "error", err)
 		os.Exit(1)
 	}
-	consentMod := buildConsentModule(infra)
-	registryMod := buildRegistryModule(infra, consentMod.Service)
-	vcMod := buildVCModule(infra, consentMod.Service, registryMod.Service)
+	consentMod, err := buildConsentModule(infra)
+	if err != nil {
+		infra.Log.Error("failed to initialize consent module", "error", err)
+		os.Exit(1)
+	}
+	registryMod, err := buildRegistryModule(infra, consentMod.Service)
+	if err != nil {
+		infra.Log.Error("failed to initialize registry module", "error", err)
+		os.Exit(1)
+	}
+	vcMod, err := buildVCModule(infra, consentMod.Service, registryMod.Service)
+	if err != nil {
+		infra.Log.Error("failed to initialize VC module", "error", err)
+		os.Exit(1)
+	}
 	decisionMod, err := buildDecisionModule(infra, registryMod.Service, vcMod.Service, consentMod.Service)
 	if err != nil {
 		infra.Log.Error("failed to initialize decision module", "error", err)
@@ -208,8 +273,18 @@ func main() {
 
 	startCleanupWorker(appCtx, infra.Log, authMod.Cleanup)
 	go func() {
-		if err := rlBundle.allowlistStore.StartCleanup(appCtx, 5*time.Minute); err != nil && err != context.Canceled {
-			infra.Log.Error("rate limit cleanup stopped", "error", err)
+		if err := tenantMod.Purge.Start(appCtx); err != nil && err != context.Canceled {
+			infra.Log.Error("tenant purge worker stopped", "error", err)
+		}
+	}()
+	go func() {
+		if err := tenantMod.Counts.Start(appCtx); err != nil && err != context.Canceled {
+			infra.Log.Error("tenant count worker stopped", "error", err)
+		}
+	}()
+	go func() {
+		if err := rlBundle.allowlistPurge.Start(appCtx); err != nil && err != context.Canceled {
+			infra.Log.Error("allowlist purge worker stopped", "error", err)
 		}
 	}()
 
@@ -217,15 +292,19 @@ func main() {
 	startPhase2Workers(infra)
 
 	r := setupRouter(infra)
-	registerRoutes(r, infra, authMod, consentMod, tenantMod, registryMod, vcMod, decisionMod, rateLimitMiddleware, clientRateLimitMiddleware)
+	dataRightsMod := buildDataRightsModule(infra, authMod, consentMod)
+	registerRoutes(r, infra, authMod, consentMod, tenantMod, registryMod, vcMod, decisionMod, dataRightsMod, rateLimitMiddleware, clientRateLimitMiddleware)
 
-	mainSrv := httpserver.New(infra.Cfg.Addr, r)
+	// The server buffers the request line and headers that HeaderLimit then checks.
+	headerBudget := httpserver.WithMaxHeaderBytes(infra.Cfg.Security.MaxURLBytes + infra.Cfg.Security.MaxHeaderBytes)
+	mainSrv := httpserver.New(infra.Cfg.Addr, r, headerBudget)
 	startServer(mainSrv, infra.Log, "main API")
 
 	var adminSrv *http.Server
 	if infra.Cfg.Security.AdminAPIToken != "" {
-		adminRouter := setupAdminRouter(infra.Log, authMod.AdminSvc, tenantMod.Handler, infra.Cfg, rateLimitMiddleware)
-		adminSrv = httpserver.New(":8081", adminRouter)
+		breakers := collectCircuitBreakers(rateLimitMiddleware, clientRateLimitMiddleware, authMod, registryMod)
+		adminRouter := setupAdminRouter(infra.Log, authMod.AdminSvc, tenantMod.Handler, breakers, infra.OutboxStore, authMod.SecurityAudit, infra.Cfg, rateLimitMiddleware, rlBundle.adminHandler)
+		adminSrv = httpserver.New(":8081", adminRouter, headerBudget)
 		startServer(adminSrv, infra.Log, "admin")
 	}
 
@@ -239,31 +318,44 @@ type rateLimitBundle struct {
 	requestSvc     *requestlimit.Service
 	allowlistStore interface {
 		requestlimit.AllowlistStore
-		StartCleanup(ctx context.Context, interval time.Duration) error
+		allowlistpurge.AllowlistStore
 	}
-	cfg *rateLimitConfig.Config
+	allowlistPurge *allowlistpurge.Service
+	adminHandler   *rateLimitHandler.Handler
+	cfg            *rateLimitConfig.Config
+	metrics        *rateLimitMetrics.Metrics
+	auditPublisher *security.Publisher
 }
 
 func buildRateLimitServices(infra *infraBundle) (*rateLimitBundle, error) {
 	logger := infra.Log
 	dbPool := infra.DBPool
 	cfg := rateLimitConfig.DefaultConfig()
+	cfg.Global.Shards = infra.Cfg.RateLimitGlobalShards
+	maps.Copy(cfg.DegradedFailClosed, infra.Cfg.RateLimitDegradedPolicy)
 
 	// Create audit system for security events
 	var auditSt audit.Store
 	if dbPool != nil {
-		auditSt = auditpostgres.New(dbPool.DB())
+		auditSt = auditpostgres.New(dbPool.DB(), auditpostgres.WithCategoryRouter(infra.AuditRouter))
 	} else {
 		logger.Warn("no database connection, using in-memory rate limit audit store")
 		auditSt = auditmemory.NewInMemoryStore()
 	}
-	auditSystem := auditpublishers.New(auditSt, auditpublishers.DefaultConfig(), logger)
+	auditSystem, err := newAuditSystem(infra, auditSt, logger)
+	if err != nil {
+		return nil, err
+	}
 
 	// Create stores - use Postgres if available, otherwise fall back to in-memory
-	var bucketStore requestlimit.BucketStore
+	var bucketStore interface {
+		requestlimit.BucketStore
+		rateLimitAdmin.BucketStore
+	}
 	var allowlistStore interface {
 		requestlimit.AllowlistStore
-		StartCleanup(ctx context.Context, interval time.Duration) error
+		allowlistpurge.AllowlistStore
+		rateLimitAdmin.AllowlistStore
 	}
 	var authLockoutSt authlockout.Store
 	var globalThrottleSt globalthrottle.Store
@@ -277,7 +369,13 @@ func buildRateLimitServices(infra *infraBundle) (*rateLimitBundle, error) {
 		bucketStore = rwbucketStore.New()
 		allowlistStore = rwallowlistStore.New()
 		authLockoutSt = authlockoutStore.New()
-		globalThrottleSt = globalthrottleStore.New()
+		globalThrottleSt = newInstanceThrottleStore(&cfg.Global)
+	}
+	// Redis, when configured, holds the cluster-wide throttle count without row locks.
+	sharedGlobalThrottle := dbPool != nil
+	if infra.RedisClient != nil {
+		globalThrottleSt = globalthrottleStore.NewRedis(infra.RedisClient.Client, &cfg.Global)
+		sharedGlobalThrottle = true
 	}
 
 	// Create focused services with security audit publisher
@@ -285,6 +383,11 @@ func buildRateLimitServices(infra *infraBundle) (*rateLimitBundle, error) {
 		requestlimit.WithLogger(logger),
 		requestlimit.WithConfig(cfg),
 		requestlimit.WithAuditPublisher(auditSystem.Security),
+		requestlimit.WithRepeatOffenderBackoff(requestlimit.BackoffConfig{
+			Multiplier:    infra.Cfg.RateLimitBackoff.Multiplier,
+			MaxRetryAfter: infra.Cfg.RateLimitBackoff.MaxRetryAfter,
+			QuietPeriod:   infra.Cfg.RateLimitBackoff.QuietPeriod,
+		}),
 	)
 	if err != nil {
 		logger.Error("failed to create request limit service", "error", err)
@@ -294,16 +397,23 @@ func buildRateLimitServices(infra *infraBundle) (*rateLimitBundle, error) {
 	authLockoutSvc, err := authlockout.New(authLockoutSt,
 		authlockout.WithLogger(logger),
 		authlockout.WithAuditPublisher(auditSystem.Security),
+		authlockout.WithSpikeDetector(authlockout.NewSpikeDetector(cfg.AuthAnomaly)),
 	)
 	if err != nil {
 		logger.Error("failed to create auth lockout service", "error", err)
 		return nil, err
 	}
 
-	globalThrottleSvc, err := globalthrottle.New(globalThrottleSt,
+	globalThrottleOpts := []globalthrottle.Option{
 		globalthrottle.WithLogger(logger),
 		globalthrottle.WithAuditPublisher(auditSystem.Security),
-	)
+		globalthrottle.WithConfig(&cfg.Global),
+	}
+	if sharedGlobalThrottle {
+		// The shared store holds the cluster-wide count; cap each instance locally first.
+		globalThrottleOpts = append(globalThrottleOpts, globalthrottle.WithInstanceStore(newInstanceThrottleStore(&cfg.Global)))
+	}
+	globalThrottleSvc, err := globalthrottle.New(globalThrottleSt, globalThrottleOpts...)
 	if err != nil {
 		logger.Error("failed to create global throttle service", "error", err)
 		return nil, err
@@ -312,16 +422,53 @@ func buildRateLimitServices(infra *infraBundle) (*rateLimitBundle, error) {
 	// Create limiter for middleware (composes requestlimit + globalthrottle)
 	limiter := rateLimitMW.NewLimiter(requestSvc, globalThrottleSvc)
 
+	// Purges are rare, so record every one rather than a sample.
+	auditSystem.Ops.SetSampleRate(string(audit.EventAllowlistPurged), 1)
+	allowlistPurge, err := allowlistpurge.New(allowlistStore,
+		allowlistpurge.WithLogger(logger),
+		allowlistpurge.WithAuditPublisher(auditSystem.Ops),
+	)
+	if err != nil {
+		logger.Error("failed to create allowlist purge worker", "error", err)
+		return nil, err
+	}
+
+	// Admin rate limit routes read the same stores the limiters write.
+	adminSvc, err := rateLimitAdmin.New(allowlistStore, bucketStore,
+		rateLimitAdmin.WithLogger(logger),
+		rateLimitAdmin.WithAuditPublisher(auditSystem.Security),
+		rateLimitAdmin.WithAuthLockoutStore(authLockoutSt),
+		rateLimitAdmin.WithQuotaStore(quotaStore.New(cfg)),
+		rateLimitAdmin.WithConfig(cfg),
+	)
+	if err != nil {
+		logger.Error("failed to create rate limit admin service", "error", err)
+		return nil, err
+	}
+
 	return &rateLimitBundle{
 		limiter:        limiter,
 		authLockoutSvc: authLockoutSvc,
 		requestSvc:     requestSvc,
 		allowlistStore: allowlistStore,
+		allowlistPurge: allowlistPurge,
+		adminHandler:   rateLimitHandler.New(adminSvc, logger),
 		cfg:            cfg,
+		metrics:        rateLimitMetrics.New(),
+		auditPublisher: auditSystem.Security,
 	}, nil
 }
 
-func buildClientRateLimitMiddleware(logger *slog.Logger, tenantSvc *tenantService.Service, cfg *rateLimitConfig.Config, dbPool *database.Pool, disabled bool) (*rateLimitMW.ClientMiddleware, error) {
+// newInstanceThrottleStore builds the in-memory per-instance tier of the global throttle.
+func newInstanceThrottleStore(cfg *rateLimitConfig.GlobalLimit) *globalthrottleStore.InMemoryGlobalThrottleStore {
+	return globalthrottleStore.New(
+		globalthrottleStore.WithPerSecondLimit(cfg.PerInstancePerSecond),
+		globalthrottleStore.WithPerHourLimit(cfg.PerInstancePerHour),
+		globalthrottleStore.WithWindow(cfg.Window),
+	)
+}
+
+func buildClientRateLimitMiddleware(logger *slog.Logger, tenantSvc *tenantService.Service, cfg *rateLimitConfig.Config, dbPool *database.Pool, disabled bool, opts ...rateLimitMW.ClientOption) (*rateLimitMW.ClientMiddleware, error) {
 	if cfg == nil {
 		return nil, fmt.Errorf("rate limit config is required")
 	}
@@ -348,6 +495,7 @@ func buildClientRateLimitMiddleware(logger *slog.Logger, tenantSvc *tenantServic
 		clientLimiter,
 		logger,
 		disabled,
+		opts...,
 	), nil
 }
 
@@ -378,8 +526,30 @@ func buildInfra() (*infraBundle, error) {
 	registryMet := registrymetrics.New()
 	requestMetrics := request.NewMetrics()
 	outboxMet := outboxmetrics.New()
-	jwtService, jwtValidator := initializeJWTService(&cfg)
+	jwtService, jwtValidator, err := initializeJWTService(&cfg)
+	if err != nil {
+		return nil, err
+	}
 	deviceSvc := device.NewService(cfg.Auth.DeviceBindingEnabled)
+	var locations authService.LocationResolver
+	if len(cfg.Auth.LocationNetworks) > 0 {
+		networks, err := device.NewNetworkLocations(cfg.Auth.LocationNetworks)
+		if err != nil {
+			return nil, err
+		}
+		locations = networks
+	}
+	var routerOpts []audit.RouterOption
+	if cfg.Audit.StrictCategories {
+		routerOpts = append(routerOpts, audit.WithStrictCategories(log))
+	}
+	auditRouter, err := audit.NewCategoryRouter(cfg.Audit.CategoryOverrides, routerOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("invalid audit category overrides: %w", err)
+	}
+	if len(cfg.Audit.CategoryOverrides) > 0 {
+		log.Info("audit category overrides active", "overrides", cfg.Audit.CategoryOverrides)
+	}
 
 	bundle := &infraBundle{
 		Cfg:             &cfg,
@@ -392,7 +562,10 @@ func buildInfra() (*infraBundle, error) {
 		RequestMetrics:  requestMetrics,
 		JWTService:      jwtService,
 		JWTValidator:    jwtValidator,
+		DPoPVerifier:    jwttoken.NewDPoPVerifier(cfg.Auth.JWTIssuerBaseURL, 0),
 		DeviceService:   deviceSvc,
+		Locations:       locations,
+		AuditRouter:     auditRouter,
 		OutboxMetrics:   outboxMet,
 	}
 
@@ -449,9 +622,9 @@ func initPhase2Infra(bundle *infraBundle, cfg *config.Server, log *slog.Logger)
 
 	// Initialize outbox worker if both database and Kafka are configured
 	if bundle.DBPool != nil && bundle.KafkaProducer != nil {
-		outboxStore := outboxpostgres.New(bundle.DBPool.DB())
+		bundle.OutboxStore = outboxpostgres.New(bundle.DBPool.DB())
 		bundle.OutboxWorker = outboxworker.New(
-			outboxStore,
+			bundle.OutboxStore,
 			bundle.KafkaProducer,
 			outboxworker.WithTopic(cfg.Kafka.AuditTopic),
 			outboxworker.WithBatchSize(cfg.Outbox.BatchSize),
@@ -489,12 +662,42 @@ func initPhase2Infra(bundle *infraBundle, cfg *config.Server, log *slog.Logger)
 	return nil
 }
 
+// riskScoringConfig maps env-level session risk settings onto the auth service
+// config. Unset weights fall back to the auth defaults.
+func riskScoringConfig(c config.SessionRiskConfig) authService.RiskScoringConfig {
+	cfg := authService.RiskScoringConfig{
+		Enabled:            c.Enabled,
+		Threshold:          c.Threshold,
+		RapidRefreshWindow: c.RapidRefreshWindow,
+		UsualHoursStart:    c.UsualHoursStart,
+		UsualHoursEnd:      c.UsualHoursEnd,
+		StepUp:             c.StepUp,
+	}
+	if len(c.Weights) > 0 {
+		cfg.Weights = make(authModels.RiskWeights, len(c.Weights))
+		for signal, points := range c.Weights {
+			cfg.Weights[authModels.RiskSignal(signal)] = points
+		}
+	}
+	return cfg
+}
+
 func buildAuthModule(infra *infraBundle, tenantService *tenantService.Service, authLockoutSvc *authlockout.Service, requestSvc *requestlimit.Service) (*authModule, error) {
 	authCfg := &authService.Config{
 		SessionTTL:             infra.Cfg.Auth.SessionTTL,
 		TokenTTL:               infra.Cfg.Auth.TokenTTL,
 		AllowedRedirectSchemes: infra.Cfg.Auth.AllowedRedirectSchemes,
 		DeviceBindingEnabled:   infra.Cfg.Auth.DeviceBindingEnabled,
+		RiskScoring:            riskScoringConfig(infra.Cfg.Auth.SessionRisk),
+		MaxSessionsPerDevice:   infra.Cfg.Auth.MaxSessionsPerDevice,
+		DeviceSessionLimitMode: infra.Cfg.Auth.DeviceSessionLimitMode,
+	}
+	for _, raw := range infra.Cfg.Auth.DPoPRequiredClients {
+		clientID, err := id.ParseClientID(raw)
+		if err != nil {
+			return nil, fmt.Errorf("DPOP_REQUIRED_CLIENTS: %w", err)
+		}
+		authCfg.DPoPRequiredClients = append(authCfg.DPoPRequiredClients, clientID)
 	}
 
 	// Wrap tenant service with adapter to map to auth types
@@ -514,18 +717,28 @@ func buildAuthModule(infra *infraBundle, tenantService *tenantService.Service, a
 		rateLimitAdapter = NewRateLimitAdapter(authLockoutSvc, requestSvc)
 	}
 
+	var mod *authModule
+	var err error
 	if infra.DBPool != nil {
-		return buildAuthModulePostgres(infra, resilientClientResolver, authCfg, rateLimitAdapter)
+		mod, err = buildAuthModulePostgres(infra, resilientClientResolver, authCfg, rateLimitAdapter)
+	} else {
+		mod, err = buildAuthModuleInMemory(infra, resilientClientResolver, authCfg, rateLimitAdapter)
+	}
+	if err != nil {
+		return nil, err
 	}
-	return buildAuthModuleInMemory(infra, resilientClientResolver, authCfg, rateLimitAdapter)
+	mod.Handler.SetDPoPVerifier(infra.DPoPVerifier)
+	mod.Handler.SetCookieConfig(infra.Cfg.Auth.Cookie)
+	mod.ResolverBreaker = resilientClientResolver.CircuitBreaker()
+	return mod, nil
 }
 
 func buildAuthModulePostgres(infra *infraBundle, clientResolver authService.ClientResolver, authCfg *authService.Config, rateLimitAdapter authPorts.RateLimitPort) (*authModule, error) {
 	users := userStore.NewPostgres(infra.DBPool.DB())
 	codes := authCodeStore.NewPostgres(infra.DBPool.DB())
-	refreshTokens := refreshTokenStore.NewPostgres(infra.DBPool.DB())
+	refreshTokens := refreshTokenStore.NewPostgres(infra.DBPool.DB(), refreshTokenStore.WithUsedTokenGrace(infra.Cfg.Auth.RefreshTokenUsedGrace))
 	sessions := sessionStore.NewPostgres(infra.DBPool.DB())
-	auditSt := auditpostgres.New(infra.DBPool.DB())
+	auditSt := auditpostgres.New(infra.DBPool.DB(), auditpostgres.WithCategoryRouter(infra.AuditRouter))
 
 	var trl authService.TokenRevocationList
 	if infra.RedisClient != nil {
@@ -537,7 +750,10 @@ func buildAuthModulePostgres(infra *infraBundle, clientResolver authService.Clie
 	}
 
 	// Create security publisher for auth events
-	auditSystem := auditpublishers.New(auditSt, auditpublishers.DefaultConfig(), infra.Log)
+	auditSystem, err := newAuditSystem(infra, auditSt, infra.Log)
+	if err != nil {
+		return nil, err
+	}
 
 	authSvc, err := authService.New(
 		users,
@@ -551,6 +767,7 @@ func buildAuthModulePostgres(infra *infraBundle, clientResolver authService.Clie
 		authService.WithLogger(infra.Log),
 		authService.WithTRL(trl),
 		authService.WithAuditPublisher(auditSystem.Security),
+		authService.WithLocationResolver(infra.Locations),
 	)
 	if err != nil {
 		return nil, err
@@ -575,9 +792,14 @@ func buildAuthModulePostgres(infra *infraBundle, clientResolver authService.Clie
 	return &authModule{
 		Service:    authSvc,
 		Handler:    authHandler.New(authSvc, rateLimitAdapter, infra.AuthMetrics, infra.Log, infra.Cfg.Auth.DeviceCookieName, infra.Cfg.Auth.DeviceCookieMaxAge),
-		AdminSvc:   admin.NewService(adminUserStore, adminSessionStore, auditSt),
-		Cleanup:    cleanupSvc,
-		AuditStore: auditSt,
+		AdminSvc:      admin.NewService(adminUserStore, adminSessionStore, auditSt),
+		Cleanup:       cleanupSvc,
+		AuditStore:      auditSt,
+		AuditAnonymizer: auditSt,
+		AuditPager:      auditSt,
+		SecurityAudit:   auditSystem.Security,
+		UserViews:       users,
+		SessionViews:  sessions,
 	}, nil
 }
 
@@ -586,13 +808,16 @@ func buildAuthModuleInMemory(infra *infraBundle, clientResolver authService.Clie
 
 	users := userStore.New()
 	codes := authCodeStore.New()
-	refreshTokens := refreshTokenStore.New()
+	refreshTokens := refreshTokenStore.New(refreshTokenStore.WithUsedTokenGrace(infra.Cfg.Auth.RefreshTokenUsedGrace))
 	sessions := sessionStore.New()
 	trl := revocationStore.NewInMemoryTRL()
 	auditSt := auditmemory.NewInMemoryStore()
 
 	// Create security publisher for auth events
-	auditSystem := auditpublishers.New(auditSt, auditpublishers.DefaultConfig(), infra.Log)
+	auditSystem, err := newAuditSystem(infra, auditSt, infra.Log)
+	if err != nil {
+		return nil, err
+	}
 
 	authSvc, err := authService.New(
 		users,
@@ -606,6 +831,7 @@ func buildAuthModuleInMemory(infra *infraBundle, clientResolver authService.Clie
 		authService.WithLogger(infra.Log),
 		authService.WithTRL(trl),
 		authService.WithAuditPublisher(auditSystem.Security),
+		authService.WithLocationResolver(infra.Locations),
 	)
 	if err != nil {
 		return nil, err
@@ -619,20 +845,25 @@ func buildAuthModuleInMemory(infra *infraBundle, clientResolver authService.Clie
 	return &authModule{
 		Service:    authSvc,
 		Handler:    authHandler.New(authSvc, rateLimitAdapter, infra.AuthMetrics, infra.Log, infra.Cfg.Auth.DeviceCookieName, infra.Cfg.Auth.DeviceCookieMaxAge),
-		AdminSvc:   admin.NewService(adminUserStore, adminSessionStore, auditSt),
-		Cleanup:    nil,
-		AuditStore: auditSt,
+		AdminSvc:      admin.NewService(adminUserStore, adminSessionStore, auditSt),
+		Cleanup:       nil,
+		AuditStore:      auditSt,
+		AuditAnonymizer: auditSt,
+		AuditPager:      auditSt,
+		SecurityAudit:   auditSystem.Security,
+		UserViews:       users,
+		SessionViews:  sessions,
 	}, nil
 }
 
-func buildConsentModule(infra *infraBundle) *consentModule {
+func buildConsentModule(infra *infraBundle) (*consentModule, error) {
 	var store consentService.Store
 	var auditSt audit.Store
 	var opts []consentService.Option
 
 	if infra.DBPool != nil {
 		store = consentStore.NewPostgres(infra.DBPool.DB())
-		auditSt = auditpostgres.New(infra.DBPool.DB())
+		auditSt = auditpostgres.New(infra.DBPool.DB(), auditpostgres.WithCategoryRouter(infra.AuditRouter))
 		opts = append(opts, consentService.WithTx(newConsentPostgresTx(infra.DBPool.DB())))
 	} else {
 		infra.Log.Warn("no database connection, using in-memory consent stores")
@@ -644,12 +875,16 @@ func buildConsentModule(infra *infraBundle) *consentModule {
 	opts = append(opts,
 		consentService.WithConsentTTL(infra.Cfg.Consent.ConsentTTL),
 		consentService.WithGrantWindow(infra.Cfg.Consent.ConsentGrantWindow),
+		consentService.WithRenewalWindow(infra.Cfg.Consent.RenewalWindow),
 		consentService.WithReGrantCooldown(infra.Cfg.Consent.ReGrantCooldown),
 		consentService.WithMetrics(infra.ConsentMetrics),
 	)
 
 	// Create compliance publisher for consent audit events
-	auditSystem := auditpublishers.New(auditSt, auditpublishers.DefaultConfig(), infra.Log)
+	auditSystem, err := newAuditSystem(infra, auditSt, infra.Log)
+	if err != nil {
+		return nil, err
+	}
 
 	consentSvc := consentService.New(
 		store,
@@ -659,14 +894,41 @@ func buildConsentModule(infra *infraBundle) *consentModule {
 	)
 
 	return &consentModule{
-		Service: consentSvc,
-		Handler: consentHandler.New(consentSvc, infra.Log, infra.ConsentMetrics),
+		Service:    consentSvc,
+		Handler:    consentHandler.New(consentSvc, infra.Log, infra.ConsentMetrics),
+		Middleware: consentMW.New(consentSvc, infra.Log, consentMW.WithOpsTracker(auditSystem.Ops)),
+	}, nil
+}
+
+func buildDataRightsModule(infra *infraBundle, authMod *authModule, consentMod *consentModule) *dataRightsModule {
+	exportSvc := dataexport.NewService(
+		authMod.UserViews,
+		authMod.SessionViews,
+		&consentHistoryAdapter{consents: consentMod.Service},
+		authMod.AuditPager,
+	)
+	erasureSvc := erasure.NewService(
+		authMod.UserViews,
+		authMod.Service,
+		consentMod.Service,
+		compliance.New(authMod.AuditStore, compliance.WithLogger(infra.Log)),
+		authMod.AuditAnonymizer,
+	)
+	return &dataRightsModule{
+		Export:  dataexport.New(exportSvc, infra.Log),
+		Erasure: erasure.New(erasureSvc, infra.Log),
 	}
 }
 
 func buildTenantModule(infra *infraBundle) (*tenantModule, error) {
-	var tenants tenantService.TenantStore
-	var clients tenantService.ClientStore
+	var tenants interface {
+		tenantService.TenantStore
+		tenantPurge.TenantStore
+	}
+	var clients interface {
+		tenantService.ClientStore
+		tenantCounts.ClientCounter
+	}
 	var userCounter tenantService.UserCounter
 	var auditSt audit.Store
 	var opts []tenantService.Option
@@ -675,7 +937,7 @@ func buildTenantModule(infra *infraBundle) (*tenantModule, error) {
 		tenants = tenantstore.NewPostgres(infra.DBPool.DB())
 		clients = clientstore.NewPostgres(infra.DBPool.DB())
 		userCounter = userStore.NewPostgres(infra.DBPool.DB())
-		auditSt = auditpostgres.New(infra.DBPool.DB())
+		auditSt = auditpostgres.New(infra.DBPool.DB(), auditpostgres.WithCategoryRouter(infra.AuditRouter))
 		opts = append(opts, tenantService.WithTx(newTenantPostgresTx(infra.DBPool.DB())))
 	} else {
 		infra.Log.Warn("no database connection, using in-memory tenant stores")
@@ -686,11 +948,17 @@ func buildTenantModule(infra *infraBundle) (*tenantModule, error) {
 	}
 
 	// Create security publisher for tenant lifecycle events
-	auditSystem := auditpublishers.New(auditSt, auditpublishers.DefaultConfig(), infra.Log)
+	auditSystem, err := newAuditSystem(infra, auditSt, infra.Log)
+	if err != nil {
+		return nil, err
+	}
 
 	opts = append(opts,
 		tenantService.WithMetrics(infra.TenantMetrics),
 		tenantService.WithAuditPublisher(auditSystem.Security),
+		tenantService.WithStrictScopes(infra.Cfg.Tenant.StrictScopes),
+		tenantService.WithAllowedScopes(infra.Cfg.Tenant.AllowedScopes...),
+		tenantService.WithSecretRotationGrace(infra.Cfg.Tenant.SecretRotationGrace),
 	)
 
 	service, err := tenantService.New(
@@ -703,13 +971,32 @@ func buildTenantModule(infra *infraBundle) (*tenantModule, error) {
 		return nil, fmt.Errorf("failed to create tenant service: %w", err)
 	}
 
+	purgeSvc, err := tenantPurge.New(tenants,
+		tenantPurge.WithLogger(infra.Log),
+		tenantPurge.WithInterval(infra.Cfg.Tenant.PurgeInterval),
+		tenantPurge.WithRetention(infra.Cfg.Tenant.DeletionRetention),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create tenant purge worker: %w", err)
+	}
+
+	countsSvc, err := tenantCounts.New(tenants, clients, infra.TenantMetrics,
+		tenantCounts.WithLogger(infra.Log),
+		tenantCounts.WithInterval(infra.Cfg.Tenant.CountRefreshInterval),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create tenant count worker: %w", err)
+	}
+
 	return &tenantModule{
 		Service: service,
 		Handler: tenantHandler.New(service, infra.Log),
+		Purge:   purgeSvc,
+		Counts:  countsSvc,
 	}, nil
 }
 
-func buildRegistryModule(infra *infraBundle, consentSvc *consentService.Service) *registryModule {
+func buildRegistryModule(infra *infraBundle, consentSvc *consentService.Service) (*registryModule, error) {
 	// Create provider registry
 	registry := providers.NewProviderRegistry()
 
@@ -740,6 +1027,15 @@ func buildRegistryModule(infra *infraBundle, consentSvc *consentService.Service)
 		Registry:        registry,
 		DefaultStrategy: orchestrator.StrategyFallback,
 		DefaultTimeout:  infra.Cfg.Registry.RegistryTimeout,
+		Logger:          infra.Log,
+		LogLookups:      infra.Cfg.Registry.LogProviderLookups,
+		LatencyAware:    infra.Cfg.Registry.LatencyAware,
+		Metrics:         infra.RegistryMetrics,
+		MinConfidence:   confidenceFloors(infra.Cfg.Registry.MinConfidence),
+		ProviderWeights: infra.Cfg.Registry.ProviderWeights,
+		Rules: []orchestrator.CorrelationRule{
+			correlation.NewNameDOBCorrelationRule(correlation.DefaultNameTolerance),
+		},
 	})
 
 	// Create cache store
@@ -750,11 +1046,12 @@ func buildRegistryModule(infra *infraBundle, consentSvc *consentService.Service)
 			infra.DBPool.DB(),
 			infra.Cfg.Registry.CacheTTL,
 			infra.RegistryMetrics,
+			registryStore.WithPostgresTypeTTLs(infra.Cfg.Registry.CacheTTLs),
 		)
-		auditSt = auditpostgres.New(infra.DBPool.DB())
+		auditSt = auditpostgres.New(infra.DBPool.DB(), auditpostgres.WithCategoryRouter(infra.AuditRouter))
 	} else {
 		infra.Log.Warn("no database connection, using in-memory registry cache")
-		cache = registryStore.NewInMemoryCache(infra.Cfg.Registry.CacheTTL)
+		cache = registryStore.NewInMemoryCache(infra.Cfg.Registry.CacheTTL, registryStore.WithTypeTTLs(infra.Cfg.Registry.CacheTTLs))
 		auditSt = auditmemory.NewInMemoryStore()
 	}
 
@@ -764,7 +1061,10 @@ func buildRegistryModule(infra *infraBundle, consentSvc *consentService.Service)
 	// Create tri-publisher audit system
 	// - Compliance: fail-closed for sanctions checks (service)
 	// - Ops: fire-and-forget for citizen lookups (handler)
-	auditSystem := auditpublishers.New(auditSt, auditpublishers.DefaultConfig(), infra.Log)
+	auditSystem, err := newAuditSystem(infra, auditSt, infra.Log)
+	if err != nil {
+		return nil, err
+	}
 
 	// Create registry service with orchestrator and consent port
 	// Tracing is handled automatically via OpenTelemetry SDK
@@ -775,23 +1075,59 @@ func buildRegistryModule(infra *infraBundle, consentSvc *consentService.Service)
 		infra.Cfg.Security.RegulatedMode,
 		registryService.WithLogger(infra.Log),
 		registryService.WithAuditor(auditSystem.Compliance),
+		registryService.WithNegativeCache(registryStore.NewInMemoryNegativeCache(infra.Cfg.Registry.NegativeCacheTTL)),
+		registryService.WithMinimizationPolicy(infra.Cfg.Registry.Minimization),
 	)
 
 	handler := registryHandler.New(svc, auditSystem.Ops, infra.Log)
 
 	return &registryModule{
-		Service: svc,
-		Handler: handler,
+		Service:  svc,
+		Handler:  handler,
+		Breakers: orch.CircuitBreakers(),
+	}, nil
+}
+
+// confidenceFloors keys the configured confidence floors by provider type.
+// newAuditSystem builds a module's audit publishers over st. Categories that
+// AUDIT_BACKENDS sends to the SIEM are routed to a log sink instead.
+func newAuditSystem(infra *infraBundle, st audit.Store, logger *slog.Logger) (*auditpublishers.System, error) {
+	cfg := auditpublishers.DefaultConfig()
+	cfg.OpsSampleRate = infra.Cfg.Audit.OpsSampleRate
+	if len(infra.Cfg.Audit.Backends) == 0 {
+		return auditpublishers.New(st, cfg, logger), nil
+	}
+	backends := map[audit.EventCategory]audit.Store{
+		audit.CategoryCompliance: st,
+		audit.CategorySecurity:   st,
+		audit.CategoryOperations: st,
+	}
+	for category, backend := range infra.Cfg.Audit.Backends {
+		if backend == config.AuditBackendSIEMLog {
+			backends[audit.EventCategory(category)] = auditlogsink.New(infra.Log)
+		}
 	}
+	return auditpublishers.NewRouted(backends, infra.AuditRouter, cfg, logger)
 }
 
-func buildVCModule(infra *infraBundle, consentSvc *consentService.Service, registrySvc *registryService.Service) *vcModule {
+func confidenceFloors(floors map[string]float64) map[providers.ProviderType]float64 {
+	if len(floors) == 0 {
+		return nil
+	}
+	out := make(map[providers.ProviderType]float64, len(floors))
+	for typ, floor := range floors {
+		out[providers.ProviderType(typ)] = floor
+	}
+	return out
+}
+
+func buildVCModule(infra *infraBundle, consentSvc *consentService.Service, registrySvc *registryService.Service) (*vcModule, error) {
 	var store vcStore.Store
 	var auditSt audit.Store
 
 	if infra.DBPool != nil {
 		store = vcStore.NewPostgres(infra.DBPool.DB())
-		auditSt = auditpostgres.New(infra.DBPool.DB())
+		auditSt = auditpostgres.New(infra.DBPool.DB(), auditpostgres.WithCategoryRouter(infra.AuditRouter))
 	} else {
 		infra.Log.Warn("no database connection, using in-memory VC store")
 		store = vcStore.NewInMemoryStore()
@@ -802,7 +1138,10 @@ func buildVCModule(infra *infraBundle, consentSvc *consentService.Service, regis
 	registryAdapter := vcAdapters.NewRegistryAdapter(registrySvc)
 
 	// Create ops publisher for fire-and-forget VC audit events
-	auditSystem := auditpublishers.New(auditSt, auditpublishers.DefaultConfig(), infra.Log)
+	auditSystem, err := newAuditSystem(infra, auditSt, infra.Log)
+	if err != nil {
+		return nil, err
+	}
 
 	svc := vcService.NewService(
 		store,
@@ -817,7 +1156,7 @@ func buildVCModule(infra *infraBundle, consentSvc *consentService.Service, regis
 		Service: svc,
 		Handler: vcHandler.New(svc, infra.Log),
 		Store:   store,
-	}
+	}, nil
 }
 
 func buildDecisionModule(infra *infraBundle, registrySvc *registryService.Service, vcSvc *vcService.Service, consentSvc *consentService.Service) (*decisionModule, error) {
@@ -829,12 +1168,15 @@ func buildDecisionModule(infra *infraBundle, registrySvc *registryService.Servic
 	// Create audit publisher using tri-publisher architecture
 	var auditSt audit.Store
 	if infra.DBPool != nil {
-		auditSt = auditpostgres.New(infra.DBPool.DB())
+		auditSt = auditpostgres.New(infra.DBPool.DB(), auditpostgres.WithCategoryRouter(infra.AuditRouter))
 	} else {
 		infra.Log.Warn("no database connection, using in-memory decision audit store")
 		auditSt = auditmemory.NewInMemoryStore()
 	}
-	auditSystem := auditpublishers.New(auditSt, auditpublishers.DefaultConfig(), infra.Log)
+	auditSystem, err := newAuditSystem(infra, auditSt, infra.Log)
+	if err != nil {
+		return nil, err
+	}
 
 	// Create metrics
 	metrics := decisionmetrics.New()
@@ -847,6 +1189,7 @@ func buildDecisionModule(infra *infraBundle, registrySvc *registryService.Servic
 		auditSystem.Compliance,
 		decision.WithMetrics(metrics),
 		decision.WithLogger(infra.Log),
+		decision.WithMinCompleteness(infra.Cfg.Decision.MinCompleteness),
 	)
 	if err != nil {
 		return nil, err
@@ -884,9 +1227,13 @@ func startPhase2Workers(infra *infraBundle) {
 }
 
 // initializeJWTService creates and configures the JWT service and validator
-func initializeJWTService(cfg *config.Server) (*jwttoken.JWTService, *jwttoken.JWTServiceAdapter) {
-	jwtService := jwttoken.NewJWTService(
-		cfg.Auth.JWTSigningKey,
+func initializeJWTService(cfg *config.Server) (*jwttoken.JWTService, *jwttoken.JWTServiceAdapter, error) {
+	keys, err := buildSigningKeys(cfg)
+	if err != nil {
+		return nil, nil, err
+	}
+	jwtService := jwttoken.NewJWTServiceWithKeys(
+		keys,
 		cfg.Auth.JWTIssuerBaseURL,
 		cfg.Auth.JWTAudience,
 		cfg.Auth.TokenTTL,
@@ -894,8 +1241,36 @@ func initializeJWTService(cfg *config.Server) (*jwttoken.JWTService, *jwttoken.J
 	if cfg.DemoMode {
 		jwtService.SetEnv("demo")
 	}
+	jwtService.SetResourceAudiences(cfg.Auth.JWTResourceAudiences)
+	if err := jwtService.SetClientSigningAlgs(cfg.Auth.JWTClientSigningAlgs); err != nil {
+		return nil, nil, fmt.Errorf("jwt client signing algorithms: %w", err)
+	}
 	jwtValidator := jwttoken.NewJWTServiceAdapter(jwtService)
-	return jwtService, jwtValidator
+	return jwtService, jwtValidator, nil
+}
+
+// buildSigningKeys assembles the HMAC key set and, when configured, the asymmetric
+// key. The provider for JWT_SIGNING_ALG signs by default; the other only signs for
+// clients pinned to its algorithm but is always accepted for verification.
+func buildSigningKeys(cfg *config.Server) (jwttoken.SigningKeyProvider, error) {
+	hmacKeys := jwttoken.NewHMACKeyProvider(cfg.Auth.JWTSigningKey, cfg.Auth.JWTPreviousSigningKeys...)
+	if cfg.Auth.JWTAsymmetricKeyPEM == "" {
+		return hmacKeys, nil
+	}
+	asymKey, err := jwttoken.ParsePrivateKeyPEM([]byte(cfg.Auth.JWTAsymmetricKeyPEM))
+	if err != nil {
+		return nil, fmt.Errorf("JWT_ASYMMETRIC_KEY_FILE: %w", err)
+	}
+	asymKeys := jwttoken.NewStaticKeyProvider(asymKey)
+	switch cfg.Auth.JWTSigningAlg {
+	case asymKey.Method.Alg():
+		return jwttoken.NewKeyRing(asymKeys, hmacKeys), nil
+	case jwttoken.AlgHS256:
+		return jwttoken.NewKeyRing(hmacKeys, asymKeys), nil
+	default:
+		return nil, fmt.Errorf("JWT_SIGNING_ALG: %s does not match the %s key in JWT_ASYMMETRIC_KEY_FILE",
+			cfg.Auth.JWTSigningAlg, asymKey.Method.Alg())
+	}
 }
 
 // setupRouter creates a new router and configures common middleware
@@ -912,6 +1287,7 @@ func setupRouter(infra *infraBundle) *chi.Mux {
 	r.Use(request.Recovery(infra.Log))
 	r.Use(request.RequestID)
 	r.Use(request.Logger(infra.Log))
+	r.Use(request.HeaderLimit(infra.Cfg.Security.MaxURLBytes, infra.Cfg.Security.MaxHeaderBytes))
 	r.Use(request.Timeout(30 * time.Second)) // TODO: make configurable
 	r.Use(request.ContentTypeJSON)
 	r.Use(request.BodyLimit(validation.MaxBodySize))
@@ -942,11 +1318,14 @@ func setupRouter(infra *infraBundle) *chi.Mux {
 
 	healthHandler.Register(r)
 
+	// JWKS endpoint for resource servers (no auth required)
+	authHandler.NewJWKSHandler(infra.JWTService).Register(r)
+
 	return r
 }
 
 // registerRoutes wires HTTP handlers to the shared router
-func registerRoutes(r *chi.Mux, infra *infraBundle, authMod *authModule, consentMod *consentModule, tenantMod *tenantModule, registryMod *registryModule, vcMod *vcModule, decisionMod *decisionModule, rateLimitMiddleware *rateLimitMW.Middleware, clientRateLimitMiddleware *rateLimitMW.ClientMiddleware) {
+func registerRoutes(r *chi.Mux, infra *infraBundle, authMod *authModule, consentMod *consentModule, tenantMod *tenantModule, registryMod *registryModule, vcMod *vcModule, decisionMod *decisionModule, dataRightsMod *dataRightsModule, rateLimitMiddleware *rateLimitMW.Middleware, clientRateLimitMiddleware *rateLimitMW.ClientMiddleware) {
 	// Demo endpoint (unversioned - not part of public API)
 	if infra.Cfg.DemoMode {
 		r.Get("/demo/info", func(w http.ResponseWriter, _ *http.Request) {
@@ -977,33 +1356,38 @@ func registerRoutes(r *chi.Mux, infra *infraBundle, authMod *authModule, consent
 			r.Post("/auth/revoke", authMod.Handler.HandleRevoke)
 		})
 
-		// Protected read endpoints - ClassRead (100 req/min)
+		// Scope descriptions for consent screens - public, ClassRead (100 req/min)
 		v1.Group(func(r chi.Router) {
-			r.Use(rateLimitMiddleware.RateLimitAuthenticated(rateLimitModels.ClassRead))
-			r.Use(auth.RequireAuth(infra.JWTValidator, authMod.Service, infra.Log))
-			r.Use(versionmw.ValidateTokenVersion(infra.Log))
-			r.Get("/auth/userinfo", authMod.Handler.HandleUserInfo)
-			r.Get("/auth/sessions", authMod.Handler.HandleListSessions)
-			r.Get("/auth/consent", consentMod.Handler.HandleGetConsents)
+			r.Use(rateLimitMiddleware.RateLimit(rateLimitModels.ClassRead))
+			r.Get("/auth/scopes", authMod.Handler.HandleListScopes)
 		})
 
-		// Protected sensitive endpoints - ClassSensitive (30 req/min)
+		// Protected endpoints - class resolved from method and path:
+		// reads are ClassRead (100 req/min), mutations ClassSensitive (30 req/min)
 		v1.Group(func(r chi.Router) {
-			r.Use(rateLimitMiddleware.RateLimitAuthenticated(rateLimitModels.ClassSensitive))
-			r.Use(auth.RequireAuth(infra.JWTValidator, authMod.Service, infra.Log))
+			r.Use(rateLimitMiddleware.RateLimitAuthenticatedResolved(rateLimitModels.DefaultClassResolver()))
+			r.Use(auth.RequireAuth(infra.JWTValidator, authMod.Service, infra.Log, auth.WithDPoPVerifier(infra.DPoPVerifier)))
 			r.Use(versionmw.ValidateTokenVersion(infra.Log))
+			r.Get("/auth/userinfo", authMod.Handler.HandleUserInfo)
+			r.Get("/auth/sessions", authMod.Handler.HandleListSessions)
+			r.Get("/auth/consent", consentMod.Handler.HandleGetConsents)
 			r.Delete("/auth/sessions/{session_id}", authMod.Handler.HandleRevokeSession)
 			r.Post("/auth/logout-all", authMod.Handler.HandleLogoutAll)
 			r.Post("/auth/consent", consentMod.Handler.HandleGrantConsent)
 			r.Post("/auth/consent/revoke", consentMod.Handler.HandleRevokeConsent)
 			r.Post("/auth/consent/revoke-all", consentMod.Handler.HandleRevokeAllConsents)
 			r.Delete("/auth/consent", consentMod.Handler.HandleDeleteAllConsents)
+			// Data subject rights endpoints
+			dataRightsMod.Export.Register(r)
+			dataRightsMod.Erasure.Register(r)
+			// Consent-gated endpoints; the services still record the check itself
+			requireConsent := consentMod.Middleware.RequireConsent
 			// Registry endpoints
-			registryMod.Handler.Register(r)
-			// Verifiable credential endpoints
-			vcMod.Handler.Register(r)
+			r.With(requireConsent(consentModels.PurposeRegistryCheck)).Group(registryMod.Handler.Register)
+			// Verifiable credential endpoints; only issuance needs consent
+			vcMod.Handler.Register(r, requireConsent(consentModels.PurposeVCIssuance))
 			// Decision endpoints
-			decisionMod.Handler.Register(r)
+			r.With(requireConsent(consentModels.PurposeDecision)).Group(decisionMod.Handler.Register)
 		})
 
 		// Admin endpoints - ClassWrite (50 req/min)
@@ -1013,14 +1397,27 @@ func registerRoutes(r *chi.Mux, infra *infraBundle, authMod *authModule, consent
 				r.Use(adminmw.RequireAdminToken(infra.Cfg.Security.AdminAPIToken, infra.Log))
 				authMod.Handler.RegisterAdmin(r)
 				r.Post("/admin/consent/users/{user_id}/revoke-all", consentMod.Handler.HandleAdminRevokeAllConsents)
+				dataRightsMod.Erasure.RegisterAdmin(r)
 				tenantMod.Handler.Register(r)
 			})
 		}
 	})
 }
 
-// setupAdminRouter creates a router for the admin server
-func setupAdminRouter(log *slog.Logger, adminSvc *admin.Service, tenantHandler *tenantHandler.Handler, cfg *config.Server, rateLimitMw *rateLimitMW.Middleware) *chi.Mux {
+// collectCircuitBreakers gathers every circuit breaker for the admin status endpoint.
+func collectCircuitBreakers(rateLimitMw *rateLimitMW.Middleware, clientRateLimitMw *rateLimitMW.ClientMiddleware, authMod *authModule, registryMod *registryModule) *circuit.Registry {
+	breakers := circuit.NewRegistry()
+	for _, b := range rateLimitMw.CircuitBreakers() {
+		breakers.Register(b)
+	}
+	breakers.Register(clientRateLimitMw.CircuitBreaker(), authMod.ResolverBreaker)
+	breakers.Register(registryMod.Breakers...)
+	return breakers
+}
+
+// setupAdminRouter creates a router for the admin server.
+// Outbox replay is only mounted when the outbox pipeline is configured.
+func setupAdminRouter(log *slog.Logger, adminSvc *admin.Service, tenantHandler *tenantHandler.Handler, breakers *circuit.Registry, outboxStore *outboxpostgres.Store, securityAudit *security.Publisher, cfg *config.Server, rateLimitMw *rateLimitMW.Middleware, rateLimitAdminHandler *rateLimitHandler.Handler) *chi.Mux {
 	r := chi.NewRouter()
 
 	// Common middleware for all routes
@@ -1028,6 +1425,7 @@ func setupAdminRouter(log *slog.Logger, adminSvc *admin.Service, tenantHandler *
 	r.Use(request.Recovery(log))
 	r.Use(request.RequestID)
 	r.Use(request.Logger(log))
+	r.Use(request.HeaderLimit(cfg.Security.MaxURLBytes, cfg.Security.MaxHeaderBytes))
 	r.Use(request.Timeout(30 * time.Second))
 	r.Use(request.ContentTypeJSON)
 	r.Use(request.BodyLimit(validation.MaxBodySize))
@@ -1043,7 +1441,12 @@ func setupAdminRouter(log *slog.Logger, adminSvc *admin.Service, tenantHandler *
 		r.Use(rateLimitMw.RateLimit(rateLimitModels.ClassAdmin)) // Rate limit before auth to prevent brute-force
 		r.Use(adminmw.RequireAdminToken(cfg.Security.AdminAPIToken, log))
 		adminHandler.Register(r)
+		admin.NewCircuitBreakerHandler(breakers, securityAudit, log).Register(r)
+		if outboxStore != nil {
+			admin.NewOutboxReplayHandler(outboxStore, securityAudit, log).Register(r)
+		}
 		tenantHandler.Register(r)
+		rateLimitAdminHandler.RegisterAdmin(r)
 	})
 
 	return r
@@ -33,33 +33,39 @@ func (a *RateLimitAdapter) CheckAuthRateLimit(ctx context.Context, identifier, i
 	}
 	if !authResult.Allowed {
 		return &ports.AuthRateLimitResult{
-			Allowed:    false,
-			Remaining:  authResult.Remaining,
-			RetryAfter: authResult.RetryAfter,
-			ResetAt:    authResult.ResetAt,
+			Allowed:         false,
+			Remaining:       authResult.Remaining,
+			RetryAfter:      authResult.RetryAfter,
+			ResetAt:         authResult.ResetAt,
+			Reason:          string(models.LimitReasonAuthLockout),
+			RequiresCaptcha: authResult.RequiresCaptcha,
 		}, nil
 	}
 
 	// Secondary defense: IP rate limit for auth endpoints
-	ipResult, err := a.requests.CheckIP(ctx, ip, models.ClassAuth)
+	ipResult, err := a.requests.CheckIP(ctx, ip, models.ClassAuth, "")
 	if err != nil {
 		return nil, err
 	}
 	if !ipResult.Allowed {
 		return &ports.AuthRateLimitResult{
-			Allowed:    false,
-			Remaining:  ipResult.Remaining,
-			RetryAfter: ipResult.RetryAfter,
-			ResetAt:    ipResult.ResetAt,
+			Allowed:         false,
+			Remaining:       ipResult.Remaining,
+			RetryAfter:      ipResult.RetryAfter,
+			ResetAt:         ipResult.ResetAt,
+			Reason:          string(models.LimitReasonIP),
+			RequiresCaptcha: authResult.RequiresCaptcha,
 		}, nil
 	}
 
 	// Both checks passed - return combined result with IP limit info
 	return &ports.AuthRateLimitResult{
-		Allowed:    true,
-		Remaining:  ipResult.Remaining,
-		RetryAfter: 0,
-		ResetAt:    ipResult.ResetAt,
+		Allowed:         true,
+		Remaining:       ipResult.Remaining,
+		RetryAfter:      0,
+		BackoffMs:       authResult.BackoffMs,
+		ResetAt:         ipResult.ResetAt,
+		RequiresCaptcha: authResult.RequiresCaptcha,
 	}, nil
 }
 
@@ -69,10 +75,13 @@ func (a *RateLimitAdapter) RecordAuthFailure(ctx context.Context, identifier, ip
 		return nil, err
 	}
 
+	remaining, resetAt := a.authLockout.AttemptsRemaining(ctx, lockout)
 	return &ports.AuthLockoutState{
-		FailureCount:    lockout.FailureCount,
-		LockedUntil:     lockout.LockedUntil,
-		RequiresCaptcha: lockout.RequiresCaptcha,
+		FailureCount:      lockout.FailureCount,
+		LockedUntil:       lockout.LockedUntil,
+		RequiresCaptcha:   lockout.RequiresCaptcha,
+		AttemptsRemaining: remaining,
+		ResetAt:           resetAt,
 	}, nil
 }
 
@@ -32,8 +32,9 @@ type FullProvider interface {
 // CitizenRecord is the minimal, non-PII citizen evidence exposed to other modules
 // (e.g., decision). Map richer internal records into this shape at the boundary.
 type CitizenRecord struct {
-	DateOfBirth string `json:"date_of_birth"`
-	Valid       bool   `json:"valid"`
+	DateOfBirth  string  `json:"date_of_birth"`
+	Valid        bool    `json:"valid"`
+	Completeness float64 `json:"completeness"` // Fraction of personal details the registry populated (0.0 to 1.0)
 }
 
 // SanctionsRecord carries the sanctions verdict needed for downstream decisions.
@@ -11,12 +11,14 @@ const ContractVersion = "v0.1.0"
 // ResolvedClient is the minimal client info needed by consuming modules (e.g., auth).
 // Contains only OAuth-relevant fields, no internal metadata.
 type ResolvedClient struct {
-	ID            string
-	TenantID      string
-	OAuthClientID string
-	RedirectURIs  []string
-	AllowedScopes []string
-	Active        bool
+	ID               string
+	TenantID         string
+	OAuthClientID    string
+	RedirectURIs     []string
+	AllowedScopes    []string
+	AllowedResources []string // RFC 8707 resource indicators the client may request
+	AllowedGrants    []string // OAuth grant types the client may use
+	Active           bool
 }
 
 // ResolvedTenant is the minimal tenant info needed by consuming modules.
@@ -0,0 +1,145 @@
+package admin
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+
+	dErrors "credo/pkg/domain-errors"
+	"credo/pkg/platform/audit"
+	"credo/pkg/platform/circuit"
+	"credo/pkg/platform/httputil"
+	adminmw "credo/pkg/platform/middleware/admin"
+	"credo/pkg/requestcontext"
+)
+
+// AuditPublisher records security-relevant operator actions.
+type AuditPublisher interface {
+	Emit(ctx context.Context, event audit.SecurityEvent)
+}
+
+// CircuitBreakerHandler exposes circuit breaker state and manual overrides to operators.
+type CircuitBreakerHandler struct {
+	breakers *circuit.Registry
+	auditor  AuditPublisher
+	logger   *slog.Logger
+}
+
+// NewCircuitBreakerHandler creates a handler for the breakers in registry.
+// Manual overrides are recorded through auditor when it is non-nil.
+func NewCircuitBreakerHandler(breakers *circuit.Registry, auditor AuditPublisher, logger *slog.Logger) *CircuitBreakerHandler {
+	return &CircuitBreakerHandler{
+		breakers: breakers,
+		auditor:  auditor,
+		logger:   logger,
+	}
+}
+
+// Register registers circuit breaker routes with the router.
+// Callers must mount it behind admin authentication.
+func (h *CircuitBreakerHandler) Register(r chi.Router) {
+	r.Get("/admin/circuit-breakers", h.HandleListCircuitBreakers)
+	r.Post("/admin/circuit-breakers/{name}/open", h.HandleForceOpen)
+	r.Post("/admin/circuit-breakers/{name}/close", h.HandleForceClose)
+	r.Post("/admin/circuit-breakers/{name}/clear-override", h.HandleClearOverride)
+}
+
+// HandleListCircuitBreakers returns the state of every registered circuit breaker.
+func (h *CircuitBreakerHandler) HandleListCircuitBreakers(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := requestcontext.RequestID(ctx)
+
+	snapshots := h.breakers.Snapshots()
+
+	h.logger.InfoContext(ctx, "admin circuit breakers retrieved",
+		"request_id", requestID,
+		"count", len(snapshots),
+	)
+
+	httputil.WriteJSON(w, http.StatusOK, toCircuitBreakersResponse(snapshots))
+}
+
+// HandleForceOpen trips the named breaker so callers use their fallback path.
+func (h *CircuitBreakerHandler) HandleForceOpen(w http.ResponseWriter, r *http.Request) {
+	h.override(w, r, audit.EventCircuitBreakerForced, string(circuit.StatusOpen), circuit.Controller.ForceOpen)
+}
+
+// HandleForceClose closes the named breaker so callers return to the primary path.
+func (h *CircuitBreakerHandler) HandleForceClose(w http.ResponseWriter, r *http.Request) {
+	h.override(w, r, audit.EventCircuitBreakerForced, string(circuit.StatusClosed), circuit.Controller.ForceClosed)
+}
+
+// HandleClearOverride returns the named breaker to automatic control.
+func (h *CircuitBreakerHandler) HandleClearOverride(w http.ResponseWriter, r *http.Request) {
+	h.override(w, r, audit.EventCircuitBreakerOverrideCleared, "", circuit.Controller.ClearOverride)
+}
+
+// override applies apply to the breaker named in the URL, audits the actor, and
+// responds with the breaker's resulting state.
+func (h *CircuitBreakerHandler) override(
+	w http.ResponseWriter,
+	r *http.Request,
+	event audit.AuditEvent,
+	reason string,
+	apply func(circuit.Controller),
+) {
+	ctx := r.Context()
+	requestID := requestcontext.RequestID(ctx)
+	name := chi.URLParam(r, "name")
+
+	ctrl, ok := h.breakers.Controller(name)
+	if !ok {
+		httputil.WriteError(w, dErrors.New(dErrors.CodeNotFound, "circuit breaker not found"))
+		return
+	}
+
+	apply(ctrl)
+	actorID := adminmw.GetAdminActorID(ctx)
+
+	h.logger.WarnContext(ctx, "admin circuit breaker override",
+		"request_id", requestID,
+		"event", string(event),
+		"breaker", name,
+		"state", reason,
+		"actor_id", actorID,
+	)
+	if h.auditor != nil {
+		h.auditor.Emit(ctx, audit.SecurityEvent{
+			Subject:   name,
+			Action:    string(event),
+			Reason:    reason,
+			RequestID: requestID,
+			ActorID:   actorID,
+			Severity:  audit.SeverityWarning,
+		})
+	}
+
+	httputil.WriteJSON(w, http.StatusOK, toCircuitBreakerResponse(ctrl.Snapshot()))
+}
+
+func toCircuitBreakersResponse(snapshots []circuit.Snapshot) *CircuitBreakersResponse {
+	responses := make([]*CircuitBreakerResponse, len(snapshots))
+	for i, s := range snapshots {
+		responses[i] = toCircuitBreakerResponse(s)
+	}
+	return &CircuitBreakersResponse{
+		CircuitBreakers: responses,
+		Total:           len(responses),
+	}
+}
+
+func toCircuitBreakerResponse(s circuit.Snapshot) *CircuitBreakerResponse {
+	resp := &CircuitBreakerResponse{
+		Name:         s.Name,
+		State:        string(s.State),
+		FailureCount: s.FailureCount,
+		Manual:       s.Manual,
+	}
+	if !s.LastTransition.IsZero() {
+		t := s.LastTransition
+		resp.LastTransition = &t
+	}
+	return resp
+}
@@ -0,0 +1,130 @@
+package admin
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"credo/pkg/platform/audit"
+	"credo/pkg/platform/circuit"
+	adminmw "credo/pkg/platform/middleware/admin"
+	"credo/pkg/testutil"
+)
+
+func TestHandleListCircuitBreakers(t *testing.T) {
+	tripped := circuit.New("client_resolver", circuit.WithFailureThreshold(2))
+	healthy := circuit.New("healthy")
+	registry := circuit.NewRegistry()
+	registry.Register(tripped, healthy)
+
+	router := chi.NewRouter()
+	NewCircuitBreakerHandler(registry, nil, slog.New(slog.NewTextHandler(io.Discard, nil))).Register(router)
+
+	tripped.RecordFailure()
+	tripped.RecordFailure()
+
+	rr := testutil.DoRequest(router, testutil.NewRequest(t, http.MethodGet, "/admin/circuit-breakers"))
+	testutil.AssertStatusOK(t, rr)
+
+	resp := testutil.UnmarshalResponse[CircuitBreakersResponse](t, rr)
+	require.Equal(t, 2, resp.Total)
+
+	open := resp.CircuitBreakers[0]
+	assert.Equal(t, "client_resolver", open.Name)
+	assert.Equal(t, "open", open.State)
+	assert.Equal(t, 2, open.FailureCount)
+	assert.NotNil(t, open.LastTransition, "tripped breaker reports when it opened")
+
+	closed := resp.CircuitBreakers[1]
+	assert.Equal(t, "healthy", closed.Name)
+	assert.Equal(t, "closed", closed.State)
+	assert.Nil(t, closed.LastTransition)
+}
+
+type recordingAuditor struct {
+	events []audit.SecurityEvent
+}
+
+func (a *recordingAuditor) Emit(_ context.Context, event audit.SecurityEvent) {
+	a.events = append(a.events, event)
+}
+
+func TestCircuitBreakerOverrides(t *testing.T) {
+	newRouter := func(b *circuit.Breaker) (chi.Router, *recordingAuditor) {
+		registry := circuit.NewRegistry()
+		registry.Register(b)
+		auditor := &recordingAuditor{}
+		router := chi.NewRouter()
+		NewCircuitBreakerHandler(registry, auditor, slog.New(slog.NewTextHandler(io.Discard, nil))).Register(router)
+		return router, auditor
+	}
+	asActor := func(req *http.Request) *http.Request {
+		return testutil.WithContextValue(req, adminmw.ContextKeyAdminActorID, "ops-alice")
+	}
+
+	t.Run("force open records the actor", func(t *testing.T) {
+		b := circuit.New("client_resolver")
+		router, auditor := newRouter(b)
+
+		rr := testutil.DoRequest(router, asActor(testutil.NewRequest(t, http.MethodPost, "/admin/circuit-breakers/client_resolver/open")))
+		testutil.AssertStatusOK(t, rr)
+
+		resp := testutil.UnmarshalResponse[CircuitBreakerResponse](t, rr)
+		assert.Equal(t, "open", resp.State)
+		assert.True(t, resp.Manual)
+		assert.True(t, b.IsOpen(), "callers see the open circuit and use their fallback")
+
+		require.Len(t, auditor.events, 1)
+		event := auditor.events[0]
+		assert.Equal(t, string(audit.EventCircuitBreakerForced), event.Action)
+		assert.Equal(t, "client_resolver", event.Subject)
+		assert.Equal(t, "open", event.Reason)
+		assert.Equal(t, "ops-alice", event.ActorID)
+	})
+
+	t.Run("force close resets a tripped breaker", func(t *testing.T) {
+		b := circuit.New("client_resolver", circuit.WithFailureThreshold(1))
+		b.RecordFailure()
+		router, auditor := newRouter(b)
+
+		rr := testutil.DoRequest(router, asActor(testutil.NewRequest(t, http.MethodPost, "/admin/circuit-breakers/client_resolver/close")))
+		testutil.AssertStatusOK(t, rr)
+
+		resp := testutil.UnmarshalResponse[CircuitBreakerResponse](t, rr)
+		assert.Equal(t, "closed", resp.State)
+		assert.Zero(t, resp.FailureCount)
+		assert.False(t, b.IsOpen())
+		require.Len(t, auditor.events, 1)
+		assert.Equal(t, "closed", auditor.events[0].Reason)
+	})
+
+	t.Run("clear override returns breaker to automatic control", func(t *testing.T) {
+		b := circuit.New("client_resolver")
+		b.ForceOpen()
+		router, auditor := newRouter(b)
+
+		rr := testutil.DoRequest(router, asActor(testutil.NewRequest(t, http.MethodPost, "/admin/circuit-breakers/client_resolver/clear-override")))
+		testutil.AssertStatusOK(t, rr)
+
+		resp := testutil.UnmarshalResponse[CircuitBreakerResponse](t, rr)
+		assert.Equal(t, "closed", resp.State)
+		assert.False(t, resp.Manual)
+		require.Len(t, auditor.events, 1)
+		assert.Equal(t, string(audit.EventCircuitBreakerOverrideCleared), auditor.events[0].Action)
+	})
+
+	t.Run("unknown breaker is not found and not audited", func(t *testing.T) {
+		router, auditor := newRouter(circuit.New("client_resolver"))
+
+		rr := testutil.DoRequest(router, asActor(testutil.NewRequest(t, http.MethodPost, "/admin/circuit-breakers/missing/open")))
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+		assert.Contains(t, rr.Body.String(), `"error":"not_found"`)
+		assert.Empty(t, auditor.events)
+	})
+}
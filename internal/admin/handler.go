@@ -30,8 +30,12 @@ func (h *Handler) Register(r chi.Router) {
 	r.Get("/admin/stats", h.HandleGetStats)
 	r.Get("/admin/users", h.HandleGetAllUsers)
 	r.Get("/admin/audit/recent", h.HandleGetRecentAuditEvents)
+	r.Get("/admin/audit/requests/{request_id}", h.HandleGetRequestTrace)
 }
 
+// maxRequestIDLength matches the audit_events.request_id column.
+const maxRequestIDLength = 255
+
 // HandleGetStats returns overall system statistics
 func (h *Handler) HandleGetStats(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -111,6 +115,41 @@ func (h *Handler) HandleGetRecentAuditEvents(w http.ResponseWriter, r *http.Requ
 	})
 }
 
+// HandleGetRequestTrace returns the audit events of a single request in the order they happened
+func (h *Handler) HandleGetRequestTrace(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := requestcontext.RequestID(ctx)
+
+	traceID := chi.URLParam(r, "request_id")
+	if traceID == "" || len(traceID) > maxRequestIDLength {
+		httputil.WriteJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid request_id"})
+		return
+	}
+
+	events, err := h.service.GetRequestTrace(ctx, traceID)
+	if err != nil {
+		h.logger.ErrorContext(ctx, "failed to get request trace",
+			"error", err,
+			"request_id", requestID,
+			"trace_request_id", traceID,
+		)
+		httputil.WriteJSON(w, http.StatusInternalServerError, map[string]string{"error": "failed to get audit events"})
+		return
+	}
+
+	h.logger.InfoContext(ctx, "admin request trace retrieved",
+		"request_id", requestID,
+		"trace_request_id", traceID,
+		"count", len(events),
+	)
+
+	httputil.WriteJSON(w, http.StatusOK, map[string]any{
+		"request_id": traceID,
+		"events":     events,
+		"total":      len(events),
+	})
+}
+
 // Response mapping functions - convert domain objects to HTTP DTOs
 
 func toUsersListResponse(users []*UserInfo) *UsersListResponse {
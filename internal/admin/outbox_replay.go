@@ -0,0 +1,117 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+
+	dErrors "credo/pkg/domain-errors"
+	"credo/pkg/platform/audit"
+	"credo/pkg/platform/httputil"
+	adminmw "credo/pkg/platform/middleware/admin"
+	"credo/pkg/requestcontext"
+)
+
+// OutboxReplayer re-enqueues already published outbox entries.
+type OutboxReplayer interface {
+	RequeueProcessedBetween(ctx context.Context, from, to time.Time) (int64, error)
+}
+
+// OutboxReplayRequest selects outbox entries by creation time, half-open [From, To).
+type OutboxReplayRequest struct {
+	From time.Time `json:"from"`
+	To   time.Time `json:"to"`
+}
+
+// Validate checks that the replay range is present and ordered.
+func (r *OutboxReplayRequest) Validate() error {
+	if r == nil {
+		return dErrors.New(dErrors.CodeBadRequest, "request is required")
+	}
+	if r.From.IsZero() || r.To.IsZero() {
+		return dErrors.New(dErrors.CodeValidation, "from and to are required")
+	}
+	if !r.From.Before(r.To) {
+		return dErrors.New(dErrors.CodeValidation, "from must be before to")
+	}
+	return nil
+}
+
+// OutboxReplayHandler lets operators re-publish audit events after a downstream
+// consumer lost data. Replayed events keep their original IDs, so consumers that
+// deduplicate by event ID absorb any overlap.
+type OutboxReplayHandler struct {
+	replayer OutboxReplayer
+	auditor  AuditPublisher
+	logger   *slog.Logger
+}
+
+// NewOutboxReplayHandler creates a handler that replays entries through replayer.
+// Replays are recorded through auditor when it is non-nil.
+func NewOutboxReplayHandler(replayer OutboxReplayer, auditor AuditPublisher, logger *slog.Logger) *OutboxReplayHandler {
+	return &OutboxReplayHandler{
+		replayer: replayer,
+		auditor:  auditor,
+		logger:   logger,
+	}
+}
+
+// Register registers outbox replay routes with the router.
+// Callers must mount it behind admin authentication.
+func (h *OutboxReplayHandler) Register(r chi.Router) {
+	r.Post("/admin/audit/outbox/replay", h.HandleReplay)
+}
+
+// HandleReplay marks every published entry created in the requested range as
+// pending so the outbox worker publishes it again.
+func (h *OutboxReplayHandler) HandleReplay(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := requestcontext.RequestID(ctx)
+
+	req, ok := httputil.DecodeAndPrepare[OutboxReplayRequest](w, r, h.logger, ctx, requestID)
+	if !ok {
+		return
+	}
+
+	requeued, err := h.replayer.RequeueProcessedBetween(ctx, req.From, req.To)
+	if err != nil {
+		h.logger.ErrorContext(ctx, "failed to replay outbox entries",
+			"request_id", requestID,
+			"error", err,
+		)
+		httputil.WriteError(w, dErrors.Wrap(err, dErrors.CodeInternal, "failed to replay outbox entries"))
+		return
+	}
+
+	actorID := adminmw.GetAdminActorID(ctx)
+	reason := fmt.Sprintf("from=%s to=%s requeued=%d",
+		req.From.UTC().Format(time.RFC3339), req.To.UTC().Format(time.RFC3339), requeued)
+
+	h.logger.WarnContext(ctx, "admin audit outbox replay",
+		"request_id", requestID,
+		"from", req.From,
+		"to", req.To,
+		"requeued", requeued,
+		"actor_id", actorID,
+	)
+	if h.auditor != nil {
+		h.auditor.Emit(ctx, audit.SecurityEvent{
+			Subject:   "audit_outbox",
+			Action:    string(audit.EventOutboxReplayed),
+			Reason:    reason,
+			RequestID: requestID,
+			ActorID:   actorID,
+			Severity:  audit.SeverityWarning,
+		})
+	}
+
+	httputil.WriteJSON(w, http.StatusOK, &OutboxReplayResponse{
+		Requeued: requeued,
+		From:     req.From,
+		To:       req.To,
+	})
+}
@@ -0,0 +1,91 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"credo/pkg/platform/audit"
+	adminmw "credo/pkg/platform/middleware/admin"
+	"credo/pkg/testutil"
+)
+
+type stubReplayer struct {
+	requeued int64
+	err      error
+	calls    int
+	from, to time.Time
+}
+
+func (r *stubReplayer) RequeueProcessedBetween(_ context.Context, from, to time.Time) (int64, error) {
+	r.calls++
+	r.from, r.to = from, to
+	return r.requeued, r.err
+}
+
+func TestHandleOutboxReplay(t *testing.T) {
+	from := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	to := from.Add(time.Hour)
+
+	newRouter := func(replayer *stubReplayer) (chi.Router, *recordingAuditor) {
+		auditor := &recordingAuditor{}
+		router := chi.NewRouter()
+		NewOutboxReplayHandler(replayer, auditor, slog.New(slog.NewTextHandler(io.Discard, nil))).Register(router)
+		return router, auditor
+	}
+	replay := func(t *testing.T, body any) *http.Request {
+		req := testutil.NewJSONRequest(t, http.MethodPost, "/admin/audit/outbox/replay", body)
+		return testutil.WithContextValue(req, adminmw.ContextKeyAdminActorID, "ops-alice")
+	}
+
+	t.Run("requeues the range and records the actor", func(t *testing.T) {
+		replayer := &stubReplayer{requeued: 42}
+		router, auditor := newRouter(replayer)
+
+		rr := testutil.DoRequest(router, replay(t, OutboxReplayRequest{From: from, To: to}))
+		testutil.AssertStatusOK(t, rr)
+
+		resp := testutil.UnmarshalResponse[OutboxReplayResponse](t, rr)
+		assert.Equal(t, int64(42), resp.Requeued)
+		assert.True(t, from.Equal(replayer.from))
+		assert.True(t, to.Equal(replayer.to))
+
+		require.Len(t, auditor.events, 1)
+		assert.Equal(t, string(audit.EventOutboxReplayed), auditor.events[0].Action)
+		assert.Equal(t, "ops-alice", auditor.events[0].ActorID)
+		assert.Equal(t, "from=2026-03-01T00:00:00Z to=2026-03-01T01:00:00Z requeued=42", auditor.events[0].Reason)
+	})
+
+	t.Run("rejects an invalid range without replaying", func(t *testing.T) {
+		for name, body := range map[string]OutboxReplayRequest{
+			"missing to":    {From: from},
+			"reversed":      {From: to, To: from},
+			"empty window":  {From: from, To: from},
+			"missing range": {},
+		} {
+			replayer := &stubReplayer{}
+			router, auditor := newRouter(replayer)
+
+			rr := testutil.DoRequest(router, replay(t, body))
+			testutil.AssertStatus(t, rr, http.StatusBadRequest)
+			assert.Zero(t, replayer.calls, name)
+			assert.Empty(t, auditor.events, name)
+		}
+	})
+
+	t.Run("store failure is not audited", func(t *testing.T) {
+		router, auditor := newRouter(&stubReplayer{err: errors.New("connection refused")})
+
+		rr := testutil.DoRequest(router, replay(t, OutboxReplayRequest{From: from, To: to}))
+		testutil.AssertStatus(t, rr, http.StatusInternalServerError)
+		assert.Empty(t, auditor.events)
+	})
+}
@@ -18,3 +18,25 @@ type UsersListResponse struct {
 	Users []*UserInfoResponse `json:"users"`
 	Total int                 `json:"total"`
 }
+
+// CircuitBreakerResponse is the HTTP response DTO for a circuit breaker's state.
+type CircuitBreakerResponse struct {
+	Name           string     `json:"name"`
+	State          string     `json:"state"`
+	FailureCount   int        `json:"failure_count"`
+	LastTransition *time.Time `json:"last_transition,omitempty"`
+	Manual         bool       `json:"manual"`
+}
+
+// CircuitBreakersResponse wraps the list of circuit breakers for HTTP response.
+type CircuitBreakersResponse struct {
+	CircuitBreakers []*CircuitBreakerResponse `json:"circuit_breakers"`
+	Total           int                       `json:"total"`
+}
+
+// OutboxReplayResponse reports how many outbox entries a replay re-enqueued.
+type OutboxReplayResponse struct {
+	Requeued int64     `json:"requeued"`
+	From     time.Time `json:"from"`
+	To       time.Time `json:"to"`
+}
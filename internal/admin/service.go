@@ -157,3 +157,9 @@ func (s *Service) GetAllUsers(ctx context.Context) ([]*UserInfo, error) {
 func (s *Service) GetRecentAuditEvents(ctx context.Context, limit int) ([]audit.Event, error) {
 	return s.audit.ListRecent(ctx, limit)
 }
+
+// GetRequestTrace returns every audit event recorded for a request, oldest first,
+// so support can reconstruct a single flow end to end.
+func (s *Service) GetRequestTrace(ctx context.Context, requestID string) ([]audit.Event, error) {
+	return s.audit.ListByRequestID(ctx, requestID)
+}
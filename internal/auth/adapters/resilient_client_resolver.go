@@ -12,6 +12,7 @@ import (
 // ClientResolver resolves client metadata and tenant ownership.
 type ClientResolver interface {
 	ResolveClient(ctx context.Context, clientID string) (*types.ResolvedClient, *types.ResolvedTenant, error)
+	VerifyClientSecret(ctx context.Context, clientID, secret string) error
 }
 
 // ResilientClientResolver wraps a ClientResolver with circuit breaker protection.
@@ -85,6 +86,11 @@ func NewResilientClientResolver(
 	}
 }
 
+// CircuitBreaker returns the resolver's breaker for status reporting.
+func (r *ResilientClientResolver) CircuitBreaker() *circuit.Breaker {
+	return r.cb
+}
+
 // ResolveClient resolves a client with circuit breaker protection.
 // On success: caches the result and records success.
 // On failure: records failure, returns cached data if circuit is open.
@@ -138,7 +144,14 @@ func (r *ResilientClientResolver) ResolveClient(ctx context.Context, clientID st
 	return client, tenant, nil
 }
 
+// VerifyClientSecret delegates secret verification without caching or the
+// circuit breaker: a cached answer must never authenticate a client.
+func (r *ResilientClientResolver) VerifyClientSecret(ctx context.Context, clientID, secret string) error {
+	return r.delegate.VerifyClientSecret(ctx, clientID, secret)
+}
+
 // Ensure ResilientClientResolver implements the interface expected by auth service.
 var _ interface {
 	ResolveClient(ctx context.Context, clientID string) (*types.ResolvedClient, *types.ResolvedTenant, error)
+	VerifyClientSecret(ctx context.Context, clientID, secret string) error
 } = (*ResilientClientResolver)(nil)
@@ -13,6 +13,7 @@ import (
 // Uses contract types to eliminate dependency on internal tenant models.
 type tenantContractProvider interface {
 	ResolveClientContract(ctx context.Context, clientID string) (*tenantcontracts.ResolvedClient, *tenantcontracts.ResolvedTenant, error)
+	VerifyClientSecretByOAuthID(ctx context.Context, oauthClientID, providedSecret string) error
 }
 
 // TenantClientResolver adapts tenant service to auth.ClientResolver.
@@ -36,6 +37,11 @@ func (a *TenantClientResolver) ResolveClient(ctx context.Context, clientID strin
 	return mapClient(client), mapTenant(tenant), nil
 }
 
+// VerifyClientSecret authenticates a confidential client by OAuth client ID and secret.
+func (a *TenantClientResolver) VerifyClientSecret(ctx context.Context, clientID, secret string) error {
+	return a.tenantSvc.VerifyClientSecretByOAuthID(ctx, clientID, secret)
+}
+
 func mapClient(c *tenantcontracts.ResolvedClient) *types.ResolvedClient {
 	// IDs come from tenant service which validates them, so parsing should never fail.
 	// If it does, it indicates a bug in the contract producer.
@@ -43,12 +49,14 @@ func mapClient(c *tenantcontracts.ResolvedClient) *types.ResolvedClient {
 	tenantID, _ := id.ParseTenantID(c.TenantID) //nolint:errcheck // IDs from validated source
 
 	return &types.ResolvedClient{
-		ID:            clientID,
-		TenantID:      tenantID,
-		OAuthClientID: c.OAuthClientID,
-		RedirectURIs:  c.RedirectURIs,
-		AllowedScopes: c.AllowedScopes,
-		Active:        c.Active,
+		ID:               clientID,
+		TenantID:         tenantID,
+		OAuthClientID:    c.OAuthClientID,
+		RedirectURIs:     c.RedirectURIs,
+		AllowedScopes:    c.AllowedScopes,
+		AllowedResources: c.AllowedResources,
+		AllowedGrants:    mapGrants(c.AllowedGrants),
+		Active:           c.Active,
 	}
 }
 
@@ -61,3 +69,11 @@ func mapTenant(t *tenantcontracts.ResolvedTenant) *types.ResolvedTenant {
 		Active: t.Active,
 	}
 }
+
+func mapGrants(grants []string) []id.GrantType {
+	out := make([]id.GrantType, len(grants))
+	for i, grant := range grants {
+		out[i] = id.GrantType(grant)
+	}
+	return out
+}
@@ -0,0 +1,55 @@
+package device
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"slices"
+)
+
+// NetworkLocations resolves client IPs to approximate locations from a static
+// table of networks, such as office ranges or a deployment's regional egress
+// blocks. The most specific matching network wins; IPs outside every network
+// resolve to "".
+type NetworkLocations struct {
+	networks []networkLocation
+}
+
+type networkLocation struct {
+	prefix   netip.Prefix
+	location string
+}
+
+// NewNetworkLocations builds a resolver from CIDR -> location pairs
+// (e.g. "203.0.113.0/24" -> "Berlin, DE").
+func NewNetworkLocations(networks map[string]string) (*NetworkLocations, error) {
+	l := &NetworkLocations{networks: make([]networkLocation, 0, len(networks))}
+	for cidr, location := range networks {
+		prefix, err := netip.ParsePrefix(cidr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid location network %q: %w", cidr, err)
+		}
+		l.networks = append(l.networks, networkLocation{prefix: prefix.Masked(), location: location})
+	}
+	// Most specific first, so the first match is the longest prefix
+	slices.SortFunc(l.networks, func(a, b networkLocation) int {
+		return b.prefix.Bits() - a.prefix.Bits()
+	})
+	return l, nil
+}
+
+// ApproximateLocation returns the location of the most specific network
+// containing ip, or "" when ip is unparseable or matches none.
+func (l *NetworkLocations) ApproximateLocation(_ context.Context, ip string) string {
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return ""
+	}
+	addr = addr.Unmap()
+	for _, n := range l.networks {
+		if n.prefix.Contains(addr) {
+			return n.location
+		}
+	}
+	return ""
+}
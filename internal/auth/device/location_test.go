@@ -0,0 +1,33 @@
+package device
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestNetworkLocations verifies longest-prefix matching of client IPs.
+//
+// Justification: E2E traffic comes from one local address, so which network
+// wins cannot be observed through the API.
+func TestNetworkLocations(t *testing.T) {
+	locations, err := NewNetworkLocations(map[string]string{
+		"203.0.113.0/24":   "Berlin, DE",
+		"203.0.113.128/25": "Munich, DE",
+		"2001:db8::/32":    "Paris, FR",
+	})
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	assert.Equal(t, "Berlin, DE", locations.ApproximateLocation(ctx, "203.0.113.7"))
+	assert.Equal(t, "Munich, DE", locations.ApproximateLocation(ctx, "203.0.113.200"), "the most specific network wins")
+	assert.Equal(t, "Berlin, DE", locations.ApproximateLocation(ctx, "::ffff:203.0.113.7"), "IPv4-mapped addresses match IPv4 networks")
+	assert.Equal(t, "Paris, FR", locations.ApproximateLocation(ctx, "2001:db8::1"))
+	assert.Empty(t, locations.ApproximateLocation(ctx, "198.51.100.1"))
+	assert.Empty(t, locations.ApproximateLocation(ctx, "not-an-ip"))
+
+	_, err = NewNetworkLocations(map[string]string{"203.0.113.0": "Berlin, DE"})
+	assert.Error(t, err)
+}
@@ -2,10 +2,12 @@ package handler
 
 import (
 	"context"
+	"errors"
 	"log/slog"
 	"net/http"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/go-chi/chi/v5"
 
@@ -31,14 +33,21 @@ type Service interface {
 	RevokeToken(ctx context.Context, token string, tokenTypeHint string) error
 }
 
+// DPoPProofVerifier validates DPoP proof headers (RFC 9449) and returns the proof key thumbprint.
+type DPoPProofVerifier interface {
+	VerifyProof(proof, method, path, accessToken string, now time.Time) (string, error)
+}
+
 // Handler wires HTTP auth endpoints to the auth service and rate limiting.
 type Handler struct {
 	auth             Service
 	ratelimit        ports.RateLimitPort
 	metrics          *metrics.Metrics
 	logger           *slog.Logger
+	dpop             DPoPProofVerifier
 	deviceCookieName string
 	deviceCookieAge  int
+	cookies          config.CookieConfig
 }
 
 // TODO: pass device config in main.go
@@ -58,13 +67,31 @@ func New(auth Service, ratelimit ports.RateLimitPort, m *metrics.Metrics, logger
 		logger:           logger,
 		deviceCookieName: deviceCookieName,
 		deviceCookieAge:  deviceCookieMaxAge,
+		cookies: config.CookieConfig{
+			SameSite: config.DefaultCookieSameSite,
+			Path:     config.DefaultCookiePath,
+			HTTPOnly: true,
+		},
 	}
 }
 
+// SetCookieConfig overrides the security attributes applied to issued cookies.
+// The config is expected to have passed config.Server validation.
+func (h *Handler) SetCookieConfig(cfg config.CookieConfig) {
+	h.cookies = cfg
+}
+
+// SetDPoPVerifier enables DPoP proof handling at the token endpoint.
+// When unset, DPoP headers are ignored and tokens are issued as bearer tokens.
+func (h *Handler) SetDPoPVerifier(v DPoPProofVerifier) {
+	h.dpop = v
+}
+
 // Register wires public auth routes onto the provided router.
 func (h *Handler) Register(r chi.Router) {
 	r.Post("/auth/authorize", h.HandleAuthorize)
 	r.Post("/auth/token", h.HandleToken)
+	r.Get("/auth/scopes", h.HandleListScopes)
 	r.Get("/auth/userinfo", h.HandleUserInfo)
 	r.Get("/auth/sessions", h.HandleListSessions)
 	r.Delete("/auth/sessions/{session_id}", h.HandleRevokeSession)
@@ -95,10 +122,12 @@ func (h *Handler) HandleAuthorize(w http.ResponseWriter, r *http.Request) {
 
 	// Check auth rate limit using email + IP composite key
 	// Rate limit before validation to count all attempts
-	if rl := h.checkRateLimit(ctx, requestID, req.Email, clientIP, "authorize"); !rl.Allowed {
-		h.writeRateLimitError(w, rl.RetryAfter)
+	rl := h.checkRateLimit(ctx, requestID, req.Email, clientIP, "authorize")
+	if !rl.Allowed {
+		h.writeRateLimitError(w, rl)
 		return
 	}
+	setCaptchaRequired(w, rl)
 
 	// Normalize and validate after rate limit check
 	req.Normalize()
@@ -114,6 +143,9 @@ func (h *Handler) HandleAuthorize(w http.ResponseWriter, r *http.Request) {
 			"request_id", requestID,
 			"client_id", req.ClientID,
 		)
+		if isCredentialFailure(err) {
+			h.recordAuthFailure(ctx, w, requestID, req.Email, clientIP)
+		}
 		httputil.WriteError(w, err)
 		return
 	}
@@ -126,15 +158,7 @@ func (h *Handler) HandleAuthorize(w http.ResponseWriter, r *http.Request) {
 	// Set device ID cookie (Phase 1: soft launch — generate cookie, no enforcement).
 	// Note: Cookie EXTRACTION is handled by Device middleware; cookie SETTING must remain here.
 	if res.DeviceID != "" {
-		http.SetCookie(w, &http.Cookie{
-			Name:     h.deviceCookieName,
-			Value:    res.DeviceID,
-			Path:     "/",
-			MaxAge:   h.deviceCookieAge,
-			HttpOnly: true,
-			Secure:   isHTTPS(r),
-			SameSite: http.SameSiteStrictMode,
-		})
+		http.SetCookie(w, h.newCookie(r, h.deviceCookieName, res.DeviceID, h.deviceCookieAge))
 	}
 
 	httputil.WriteJSON(w, http.StatusOK, res)
@@ -156,10 +180,12 @@ func (h *Handler) HandleToken(w http.ResponseWriter, r *http.Request) {
 	// Check token rate limit using client_id + IP composite key
 	// Rate limit before validation to count all attempts
 	if req.ClientID != "" {
-		if rl := h.checkRateLimit(ctx, requestID, req.ClientID, clientIP, "token"); !rl.Allowed {
-			h.writeRateLimitError(w, rl.RetryAfter)
+		rl := h.checkRateLimit(ctx, requestID, req.ClientID, clientIP, "token")
+		if !rl.Allowed {
+			h.writeRateLimitError(w, rl)
 			return
 		}
+		setCaptchaRequired(w, rl)
 	}
 
 	// Normalize and validate after rate limit check
@@ -169,6 +195,21 @@ func (h *Handler) HandleToken(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// Verify the DPoP proof so the service can bind the access token to its key
+	if proof := r.Header.Get("DPoP"); proof != "" && h.dpop != nil {
+		jkt, err := h.dpop.VerifyProof(proof, r.Method, r.URL.Path, "", requestcontext.Now(ctx))
+		if err != nil {
+			h.logger.WarnContext(ctx, "invalid DPoP proof",
+				"error", err,
+				"request_id", requestID,
+				"client_id", req.ClientID,
+			)
+			httputil.WriteError(w, err)
+			return
+		}
+		ctx = requestcontext.WithDPoPThumbprint(ctx, jkt)
+	}
+
 	res, err := h.auth.Token(ctx, req)
 	if err != nil {
 		h.logger.ErrorContext(ctx, "token exchange failed",
@@ -218,6 +259,32 @@ func (h *Handler) HandleUserInfo(w http.ResponseWriter, r *http.Request) {
 	httputil.WriteJSON(w, http.StatusOK, res)
 }
 
+// HandleListScopes implements GET /auth/scopes.
+// With a space-separated scope parameter, as in an authorization request, it
+// describes those scopes in order so a consent screen can show what a client
+// asks for; unregistered ones are listed under their name. Without it, every
+// registered scope is returned. No authentication is required.
+func (h *Handler) HandleListScopes(w http.ResponseWriter, r *http.Request) {
+	var scopes []id.ScopeInfo
+	if requested := strings.Fields(r.URL.Query().Get("scope")); len(requested) > 0 {
+		scopes = id.DescribeScopes(requested)
+	} else {
+		scopes = id.KnownScopes()
+	}
+
+	res := &models.ScopesResult{Scopes: make([]models.ScopeDescription, 0, len(scopes))}
+	for _, scope := range scopes {
+		res.Scopes = append(res.Scopes, models.ScopeDescription{
+			Name:        scope.Name,
+			Title:       scope.Title,
+			Description: scope.Description,
+			Sensitive:   scope.Sensitive,
+		})
+	}
+	w.Header().Set("Cache-Control", "public, max-age=300")
+	httputil.WriteJSON(w, http.StatusOK, res)
+}
+
 // HandleListSessions implements GET /auth/sessions for the current user.
 func (h *Handler) HandleListSessions(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -339,6 +406,11 @@ type rateLimitResult struct {
 	Allowed bool
 	// RetryAfter is the number of seconds to wait before retrying (0 if Allowed is true).
 	RetryAfter int
+	// Reason names the check that denied the request (empty if Allowed is true).
+	Reason string
+	// RequiresCaptcha tells the client to present a CAPTCHA with its next attempt
+	// (after 3 consecutive lockouts in 24 hours, or during an auth failure spike).
+	RequiresCaptcha bool
 }
 
 // checkRateLimit checks if a request is within rate limits.
@@ -372,16 +444,68 @@ func (h *Handler) checkRateLimit(ctx context.Context, requestID string, identifi
 		)
 	}
 
-	return rateLimitResult{Allowed: result.Allowed, RetryAfter: result.RetryAfter}
+	return rateLimitResult{
+		Allowed:         result.Allowed,
+		RetryAfter:      result.RetryAfter,
+		Reason:          result.Reason,
+		RequiresCaptcha: result.RequiresCaptcha,
+	}
+}
+
+// recordAuthFailure counts a failed attempt against identifier and clientIP and
+// hints the remaining attempts so a legitimate user is not surprised by a lock.
+// The headers are omitted once the identifier is locked; the next attempt
+// receives the lockout response instead.
+func (h *Handler) recordAuthFailure(ctx context.Context, w http.ResponseWriter, requestID, identifier, clientIP string) {
+	if h.ratelimit == nil {
+		return
+	}
+
+	state, err := h.ratelimit.RecordAuthFailure(ctx, identifier, clientIP)
+	if err != nil {
+		h.logger.ErrorContext(ctx, "failed to record auth failure",
+			"error", err,
+			"request_id", requestID,
+		)
+		return
+	}
+
+	locked := state.LockedUntil != nil && state.LockedUntil.After(requestcontext.Now(ctx))
+	if locked || state.AttemptsRemaining <= 0 {
+		return
+	}
+	w.Header().Set("X-Auth-Attempts-Remaining", strconv.Itoa(state.AttemptsRemaining))
+	w.Header().Set("X-Auth-Reset", strconv.FormatInt(state.ResetAt.Unix(), 10))
+}
+
+// isCredentialFailure reports whether err refused the identity presented (an
+// unknown client or an inactive user). Malformed requests and infrastructure
+// failures are not counted against the user.
+func isCredentialFailure(err error) bool {
+	var de *dErrors.Error
+	if !errors.As(err, &de) {
+		return false
+	}
+	return de.Reason == models.RejectionReasonUnknownClient || de.Reason == models.RejectionReasonUserInactive
+}
+
+// setCaptchaRequired flags the response so the frontend shows a CAPTCHA (PRD-017 FR-2b).
+func setCaptchaRequired(w http.ResponseWriter, rl rateLimitResult) {
+	if rl.RequiresCaptcha {
+		w.Header().Set("X-RateLimit-Captcha-Required", "true")
+	}
 }
 
 // writeRateLimitError writes a 429 Too Many Requests response.
-func (h *Handler) writeRateLimitError(w http.ResponseWriter, retryAfter int) {
-	w.Header().Set("Retry-After", strconv.Itoa(retryAfter))
+func (h *Handler) writeRateLimitError(w http.ResponseWriter, rl rateLimitResult) {
+	w.Header().Set("Retry-After", strconv.Itoa(rl.RetryAfter))
+	setCaptchaRequired(w, rl)
 	httputil.WriteJSON(w, http.StatusTooManyRequests, map[string]any{
-		"error":       "rate_limit_exceeded",
-		"message":     "Too many requests. Please try again later.",
-		"retry_after": retryAfter,
+		"error":            "rate_limit_exceeded",
+		"reason":           rl.Reason,
+		"message":          "Too many requests. Please try again later.",
+		"retry_after":      rl.RetryAfter,
+		"requires_captcha": rl.RequiresCaptcha,
 	})
 }
 
@@ -414,11 +538,9 @@ func (h *Handler) HandleAdminDeleteUser(w http.ResponseWriter, r *http.Request)
 	w.WriteHeader(http.StatusNoContent)
 }
 
-func isHTTPS(r *http.Request) bool {
-	if r.TLS != nil {
-		return true
-	}
-	return strings.EqualFold(r.Header.Get("X-Forwarded-Proto"), "https")
+// newCookie builds a cookie carrying the configured security attributes.
+func (h *Handler) newCookie(r *http.Request, name, value string, maxAge int) *http.Cookie {
+	return h.cookies.NewCookie(r, name, value, maxAge)
 }
 
 // HandleRevoke implements POST /auth/revoke
@@ -11,6 +11,7 @@ import (
 	"os"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/google/uuid"
@@ -19,6 +20,8 @@ import (
 
 	"credo/internal/auth/handler/mocks"
 	"credo/internal/auth/models"
+	"credo/internal/auth/ports"
+	"credo/internal/platform/config"
 	id "credo/pkg/domain"
 	dErrors "credo/pkg/domain-errors"
 	"credo/pkg/requestcontext"
@@ -59,6 +62,192 @@ func (s *AuthHandlerSuite) TestAuthorizeHandler_ErrorMapping() {
 	})
 }
 
+// stubRateLimiter denies every auth attempt with a fixed result.
+type stubRateLimiter struct {
+	result *ports.AuthRateLimitResult
+}
+
+func (l *stubRateLimiter) CheckAuthRateLimit(context.Context, string, string) (*ports.AuthRateLimitResult, error) {
+	return l.result, nil
+}
+
+func (l *stubRateLimiter) RecordAuthFailure(context.Context, string, string) (*ports.AuthLockoutState, error) {
+	return &ports.AuthLockoutState{}, nil
+}
+
+func (l *stubRateLimiter) ClearAuthFailures(context.Context, string, string) error {
+	return nil
+}
+
+// countingRateLimiter allows attempts and locks the identifier once limit
+// failures have been recorded.
+type countingRateLimiter struct {
+	limit    int
+	failures int
+	resetAt  time.Time
+}
+
+func (l *countingRateLimiter) CheckAuthRateLimit(context.Context, string, string) (*ports.AuthRateLimitResult, error) {
+	return &ports.AuthRateLimitResult{Allowed: true}, nil
+}
+
+func (l *countingRateLimiter) RecordAuthFailure(context.Context, string, string) (*ports.AuthLockoutState, error) {
+	l.failures++
+	state := &ports.AuthLockoutState{
+		FailureCount:      l.failures,
+		AttemptsRemaining: max(l.limit-l.failures, 0),
+		ResetAt:           l.resetAt,
+	}
+	if l.failures >= l.limit {
+		lockedUntil := time.Now().Add(time.Hour)
+		state.LockedUntil = &lockedUntil
+	}
+	return state, nil
+}
+
+func (l *countingRateLimiter) ClearAuthFailures(context.Context, string, string) error {
+	return nil
+}
+
+func (s *AuthHandlerSuite) TestAuthorizeHandler_AttemptsRemaining() {
+	validRequest := &models.AuthorizationRequest{
+		Email:       "user@example.com",
+		ClientID:    "test-client-id",
+		Scopes:      []string{"openid"},
+		RedirectURI: "https://example.com/redirect",
+	}
+	newRouter := func(limiter ports.RateLimitPort, authErr error) http.Handler {
+		ctrl := gomock.NewController(s.T())
+		mockService := mocks.NewMockService(ctrl)
+		mockService.EXPECT().Authorize(gomock.Any(), gomock.Any()).Return(nil, authErr).AnyTimes()
+		handler := New(mockService, limiter, nil, slog.New(slog.NewTextHandler(io.Discard, nil)), "__Secure-Device-ID", 31536000)
+		router := chi.NewRouter()
+		handler.Register(router)
+		return router
+	}
+	serve := func(router http.Handler) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/auth/authorize", strings.NewReader(s.mustMarshal(validRequest)))
+		req.Header.Set("Content-Type", "application/json")
+		rr := httptest.NewRecorder()
+		router.ServeHTTP(rr, req)
+		return rr
+	}
+
+	s.Run("headers count down across failures and disappear at lockout", func() {
+		resetAt := time.Date(2026, 1, 1, 12, 15, 0, 0, time.UTC)
+		router := newRouter(&countingRateLimiter{limit: 3, resetAt: resetAt}, dErrors.NewWithReason(dErrors.CodeBadRequest, models.RejectionReasonUnknownClient, "invalid client_id"))
+
+		for _, want := range []string{"2", "1"} {
+			rr := serve(router)
+			s.Equal(http.StatusBadRequest, rr.Code)
+			s.Equal(want, rr.Header().Get("X-Auth-Attempts-Remaining"))
+			s.Equal("1767269700", rr.Header().Get("X-Auth-Reset"))
+		}
+
+		rr := serve(router)
+		s.Equal(http.StatusBadRequest, rr.Code)
+		s.Empty(rr.Header().Get("X-Auth-Attempts-Remaining"), "locked identifier gets the lockout response instead")
+		s.Empty(rr.Header().Get("X-Auth-Reset"))
+	})
+
+	s.Run("validation failure is not counted", func() {
+		limiter := &countingRateLimiter{limit: 3}
+		rr := serve(newRouter(limiter, dErrors.New(dErrors.CodeBadRequest, "redirect_uri not allowed for client")))
+
+		s.Equal(http.StatusBadRequest, rr.Code)
+		s.Zero(limiter.failures)
+		s.Empty(rr.Header().Get("X-Auth-Attempts-Remaining"))
+	})
+
+	s.Run("inactive user is counted", func() {
+		limiter := &countingRateLimiter{limit: 3}
+		rr := serve(newRouter(limiter, dErrors.NewWithReason(dErrors.CodeForbidden, models.RejectionReasonUserInactive, "user is inactive")))
+
+		s.Equal(http.StatusForbidden, rr.Code)
+		s.Equal(1, limiter.failures)
+	})
+
+	s.Run("internal failure is not counted", func() {
+		limiter := &countingRateLimiter{limit: 3}
+		rr := serve(newRouter(limiter, errors.New("boom")))
+
+		s.Equal(http.StatusInternalServerError, rr.Code)
+		s.Zero(limiter.failures)
+		s.Empty(rr.Header().Get("X-Auth-Attempts-Remaining"))
+	})
+}
+
+func (s *AuthHandlerSuite) TestAuthorizeHandler_RateLimitReason() {
+	body := s.mustMarshal(&models.AuthorizationRequest{
+		Email:       "user@example.com",
+		ClientID:    "test-client-id",
+		Scopes:      []string{"openid"},
+		RedirectURI: "https://example.com/redirect",
+	})
+
+	for _, reason := range []string{"auth_lockout", "ip"} {
+		s.Run(reason, func() {
+			ctrl := gomock.NewController(s.T())
+			limiter := &stubRateLimiter{result: &ports.AuthRateLimitResult{Allowed: false, RetryAfter: 30, Reason: reason}}
+			handler := New(mocks.NewMockService(ctrl), limiter, nil, slog.New(slog.NewTextHandler(io.Discard, nil)), "__Secure-Device-ID", 31536000)
+			router := chi.NewRouter()
+			handler.Register(router)
+
+			req := httptest.NewRequest(http.MethodPost, "/auth/authorize", strings.NewReader(body))
+			req.Header.Set("Content-Type", "application/json")
+			rr := httptest.NewRecorder()
+			router.ServeHTTP(rr, req)
+
+			s.Equal(http.StatusTooManyRequests, rr.Code)
+			var payload map[string]any
+			s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &payload))
+			s.Equal("rate_limit_exceeded", payload["error"])
+			s.Equal(reason, payload["reason"])
+		})
+	}
+}
+
+func (s *AuthHandlerSuite) TestAuthorizeHandler_CaptchaRequired() {
+	body := s.mustMarshal(&models.AuthorizationRequest{
+		Email:       "user@example.com",
+		ClientID:    "test-client-id",
+		Scopes:      []string{"openid"},
+		RedirectURI: "https://example.com/redirect",
+	})
+	serve := func(result *ports.AuthRateLimitResult) *httptest.ResponseRecorder {
+		ctrl := gomock.NewController(s.T())
+		limiter := &stubRateLimiter{result: result}
+		handler := New(mocks.NewMockService(ctrl), limiter, nil, slog.New(slog.NewTextHandler(io.Discard, nil)), "__Secure-Device-ID", 31536000)
+		router := chi.NewRouter()
+		handler.Register(router)
+
+		req := httptest.NewRequest(http.MethodPost, "/auth/authorize", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		rr := httptest.NewRecorder()
+		router.ServeHTTP(rr, req)
+		return rr
+	}
+
+	s.Run("lockout requiring captcha sets header and body flag", func() {
+		rr := serve(&ports.AuthRateLimitResult{Allowed: false, RetryAfter: 900, Reason: "auth_lockout", RequiresCaptcha: true})
+
+		s.Equal(http.StatusTooManyRequests, rr.Code)
+		s.Equal("true", rr.Header().Get("X-RateLimit-Captcha-Required"))
+		var payload map[string]any
+		s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &payload))
+		s.Equal(true, payload["requires_captcha"])
+	})
+
+	s.Run("lockout without captcha omits header", func() {
+		rr := serve(&ports.AuthRateLimitResult{Allowed: false, RetryAfter: 30, Reason: "auth_lockout"})
+
+		s.Empty(rr.Header().Get("X-RateLimit-Captcha-Required"))
+		var payload map[string]any
+		s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &payload))
+		s.Equal(false, payload["requires_captcha"])
+	})
+}
+
 func (s *AuthHandlerSuite) TestTokenHandler_ResponseShapeAndErrors() {
 	validRequest := &models.TokenRequest{
 		GrantType:   string(models.GrantAuthorizationCode),
@@ -93,6 +282,52 @@ func (s *AuthHandlerSuite) TestTokenHandler_ResponseShapeAndErrors() {
 	})
 }
 
+// Justification: The reason field is how clients tell a support case (inactive
+// client or user) from a re-login (revoked session); E2E features assert the
+// status and error code only.
+func (s *AuthHandlerSuite) TestTokenHandler_RejectionReason() {
+	refreshRequest := &models.TokenRequest{
+		GrantType:    string(models.GrantRefreshToken),
+		RefreshToken: "ref_123",
+		ClientID:     "some-client-id",
+	}
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus int
+		wantCode   string
+		wantReason string
+	}{
+		{"inactive client", dErrors.NewWithReason(dErrors.CodeForbidden, models.RejectionReasonClientInactive, "client is not active"),
+			http.StatusForbidden, "forbidden", "client_inactive"},
+		{"inactive user", dErrors.NewWithReason(dErrors.CodeForbidden, models.RejectionReasonUserInactive, "user inactive"),
+			http.StatusForbidden, "forbidden", "user_inactive"},
+		{"revoked session", dErrors.NewWithReason(dErrors.CodeInvalidGrant, models.RejectionReasonSessionRevoked, "session has been revoked"),
+			http.StatusBadRequest, "invalid_grant", "session_revoked"},
+	}
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			mockService, router := s.newHandler()
+			mockService.EXPECT().Token(gomock.Any(), gomock.Any()).Return(nil, tc.err)
+
+			status, got, errBody := s.doTokenRequest(router, s.mustMarshal(refreshRequest))
+
+			s.assertErrorResponse(status, got, errBody, tc.wantStatus, tc.wantCode)
+			s.Equal(tc.wantReason, errBody["reason"])
+		})
+	}
+
+	s.Run("generic forbidden carries no reason", func() {
+		mockService, router := s.newHandler()
+		mockService.EXPECT().Token(gomock.Any(), gomock.Any()).Return(nil, dErrors.New(dErrors.CodeForbidden, "forbidden"))
+
+		status, got, errBody := s.doTokenRequest(router, s.mustMarshal(refreshRequest))
+
+		s.assertErrorResponse(status, got, errBody, http.StatusForbidden, "forbidden")
+		s.NotContains(errBody, "reason")
+	})
+}
+
 func (s *AuthHandlerSuite) TestUserInfoHandler_ContextValidation() {
 	validSessionID := uuid.New()
 
@@ -124,6 +359,35 @@ func (s *AuthHandlerSuite) TestUserInfoHandler_ContextValidation() {
 	})
 }
 
+func (s *AuthHandlerSuite) TestListScopesHandler() {
+	list := func(router *chi.Mux, target string) models.ScopesResult {
+		rr := httptest.NewRecorder()
+		router.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, target, nil))
+		s.Require().Equal(http.StatusOK, rr.Code)
+		var res models.ScopesResult
+		s.Require().NoError(json.NewDecoder(rr.Body).Decode(&res))
+		return res
+	}
+
+	s.Run("lists every registered scope", func() {
+		_, router := s.newHandler()
+
+		res := list(router, "/auth/scopes")
+		s.Len(res.Scopes, len(id.KnownScopes()))
+	})
+
+	s.Run("describes requested scopes in order", func() {
+		_, router := s.newHandler()
+
+		res := list(router, "/auth/scopes?scope=email%20payments:write")
+		s.Require().Len(res.Scopes, 2)
+		s.Equal("email", res.Scopes[0].Name)
+		s.True(res.Scopes[0].Sensitive)
+		s.NotEmpty(res.Scopes[0].Description)
+		s.Equal(models.ScopeDescription{Name: "payments:write", Title: "payments:write"}, res.Scopes[1])
+	})
+}
+
 func (s *AuthHandlerSuite) TestSessionsHandler_ContextValidation() {
 	userID := id.UserID(uuid.New())
 	currentSessionID := id.SessionID(uuid.New())
@@ -219,6 +483,64 @@ func (s *AuthHandlerSuite) TestLogoutAllHandler_ContextValidation() {
 	})
 }
 
+func (s *AuthHandlerSuite) TestAuthorizeHandler_DeviceCookieAttributes() {
+	body := s.mustMarshal(&models.AuthorizationRequest{
+		Email:       "user@example.com",
+		ClientID:    "test-client-id",
+		Scopes:      []string{"openid"},
+		RedirectURI: "https://example.com/redirect",
+	})
+	serve := func(configure func(*Handler)) *http.Cookie {
+		ctrl := gomock.NewController(s.T())
+		mockService := mocks.NewMockService(ctrl)
+		mockService.EXPECT().Authorize(gomock.Any(), gomock.Any()).
+			Return(&models.AuthorizationResult{Code: "authz_1", DeviceID: "device-1"}, nil)
+		handler := New(mockService, nil, nil, slog.New(slog.NewTextHandler(io.Discard, nil)), "__Secure-Device-ID", 31536000)
+		configure(handler)
+		router := chi.NewRouter()
+		handler.Register(router)
+
+		req := httptest.NewRequest(http.MethodPost, "/auth/authorize", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		rr := httptest.NewRecorder()
+		router.ServeHTTP(rr, req)
+
+		s.Require().Equal(http.StatusOK, rr.Code)
+		cookies := rr.Result().Cookies()
+		s.Require().Len(cookies, 1)
+		return cookies[0]
+	}
+
+	s.Run("defaults are host-only, strict, and HttpOnly", func() {
+		cookie := serve(func(*Handler) {})
+
+		s.Equal("device-1", cookie.Value)
+		s.Equal("/", cookie.Path)
+		s.Empty(cookie.Domain)
+		s.True(cookie.HttpOnly)
+		s.False(cookie.Secure, "plain HTTP request without COOKIE_SECURE")
+		s.Equal(http.SameSiteStrictMode, cookie.SameSite)
+	})
+
+	s.Run("configured attributes are applied", func() {
+		cookie := serve(func(h *Handler) {
+			h.SetCookieConfig(config.CookieConfig{
+				Secure:   true,
+				SameSite: "none",
+				Domain:   "example.com",
+				Path:     "/auth",
+				HTTPOnly: true,
+			})
+		})
+
+		s.True(cookie.Secure)
+		s.Equal(http.SameSiteNoneMode, cookie.SameSite)
+		s.Equal("example.com", cookie.Domain)
+		s.Equal("/auth", cookie.Path)
+		s.True(cookie.HttpOnly)
+	})
+}
+
 func (s *AuthHandlerSuite) TestAdminDeleteUserHandler_Validation() {
 	userID := id.UserID(uuid.New())
 	validPath := "/admin/auth/users/" + userID.String()
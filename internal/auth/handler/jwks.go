@@ -0,0 +1,38 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+
+	jwttoken "credo/internal/jwt_token"
+	"credo/pkg/platform/httputil"
+)
+
+// KeySetProvider exposes the public verification keys for issued tokens.
+type KeySetProvider interface {
+	JWKS() jwttoken.JWKSet
+}
+
+// JWKSHandler serves the JSON Web Key Set used by resource servers to verify tokens.
+type JWKSHandler struct {
+	keys KeySetProvider
+}
+
+// NewJWKSHandler constructs a JWKS handler backed by the given key provider.
+func NewJWKSHandler(keys KeySetProvider) *JWKSHandler {
+	return &JWKSHandler{keys: keys}
+}
+
+// Register wires the JWKS route onto the provided router.
+func (h *JWKSHandler) Register(r chi.Router) {
+	r.Get("/.well-known/jwks.json", h.HandleJWKS)
+}
+
+// HandleJWKS implements GET /.well-known/jwks.json.
+// Keys are read through the signing key provider on every request so rotations
+// are visible without restart; clients may cache for a short period.
+func (h *JWKSHandler) HandleJWKS(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Cache-Control", "public, max-age=300")
+	httputil.WriteJSON(w, http.StatusOK, h.keys.JWKS())
+}
@@ -53,6 +53,10 @@ func (r *stubClientResolver) ResolveClient(ctx context.Context, clientID string)
 		}, nil
 }
 
+func (r *stubClientResolver) VerifyClientSecret(ctx context.Context, clientID, secret string) error {
+	return nil
+}
+
 func SetupSuite(t *testing.T) (
 	*chi.Mux,
 	*userStore.InMemoryUserStore,
@@ -11,6 +11,7 @@ Package models contains the auth domain model.
 	├── Value Objects:
 	│   ├── SessionStatus: pending_consent | active | revoked
 	│   ├── DeviceBinding: {DeviceID, FingerprintHash, DisplayName, Location}
+	│   ├── RiskSignal: new_device | new_location | unusual_time | rapid_refresh
 	│   └── RevocationReason, Scope, TokenType, Grant
 	└── Invariants:
 	    ├── Scopes cannot be empty
@@ -72,6 +73,9 @@ type Session struct {
 	DeviceDisplayName   string // e.g., "Chrome on macOS"
 	ApproximateLocation string // e.g., "San Francisco, US"
 
+	// RiskScore is the activity risk score (0-100) computed at the last refresh.
+	RiskScore int
+
 	// Lifecycle timestamps
 	CreatedAt  time.Time
 	ExpiresAt  time.Time // Session expiry (30+ days)
@@ -172,6 +176,11 @@ func (s *Session) RecordRefresh(at time.Time) {
 	s.RecordActivity(at)
 }
 
+// ApplyRiskScore records the risk score computed for the latest refresh.
+func (s *Session) ApplyRiskScore(score int) {
+	s.RiskScore = min(max(score, 0), MaxRiskScore)
+}
+
 // ApplyTokenJTI records the JTI of the latest access token issued for this session.
 func (s *Session) ApplyTokenJTI(jti string) {
 	if jti != "" {
@@ -198,7 +207,7 @@ func (s *Session) ValidateForAdvance(clientID id.ClientID, at time.Time, allowPe
 		return dErrors.New(dErrors.CodeUnauthorized, "client_id mismatch")
 	}
 	if s.IsRevoked() {
-		return dErrors.New(dErrors.CodeUnauthorized, "session has been revoked")
+		return dErrors.NewWithReason(dErrors.CodeUnauthorized, RejectionReasonSessionRevoked, "session has been revoked")
 	}
 	if !s.CanAdvance(allowPending) {
 		return dErrors.New(dErrors.CodeUnauthorized, "session in invalid state")
@@ -296,6 +305,7 @@ type RefreshTokenRecord struct {
 	Used            bool         // For rotation detection
 	LastRefreshedAt *time.Time
 	CreatedAt       time.Time
+	DPoPJKT         string // Thumbprint of the DPoP key the token is bound to; empty for bearer tokens
 }
 
 // IsValid returns true if the refresh token can be used to obtain new tokens.
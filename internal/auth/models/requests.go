@@ -25,7 +25,8 @@ type AuthorizationRequest struct {
 }
 
 // Normalize trims and deduplicates fields in the authorization request.
-// It also sets a default scope when none is provided.
+// It also sets a default scope when none is provided. State is opaque to the
+// server and is left untouched so the client gets back exactly what it sent.
 func (r *AuthorizationRequest) Normalize() {
 	if r == nil {
 		return
@@ -33,7 +34,6 @@ func (r *AuthorizationRequest) Normalize() {
 	r.Email = strings.TrimSpace(strings.ToLower(r.Email))
 	r.ClientID = strings.TrimSpace(r.ClientID)
 	r.RedirectURI = strings.TrimSpace(r.RedirectURI)
-	r.State = strings.TrimSpace(r.State)
 
 	// Default to "openid" scope if none provided
 	if len(r.Scopes) == 0 {
@@ -60,7 +60,7 @@ func (r *AuthorizationRequest) Validate() error {
 		return dErrors.New(dErrors.CodeValidation, fmt.Sprintf("redirect_uri must be %d characters or less", validation.MaxRedirectURILength))
 	}
 	if len(r.State) > validation.MaxStateLength {
-		return dErrors.New(dErrors.CodeValidation, fmt.Sprintf("state must be %d characters or less", validation.MaxStateLength))
+		return dErrors.New(dErrors.CodeInvalidRequest, fmt.Sprintf("state must be %d characters or less", validation.MaxStateLength))
 	}
 	if len(r.Scopes) > validation.MaxScopes {
 		return dErrors.New(dErrors.CodeValidation, fmt.Sprintf("too many scopes: max %d allowed", validation.MaxScopes))
@@ -105,11 +105,26 @@ func (r *AuthorizationRequest) Validate() error {
 
 // TokenRequest represents the /auth/token payload for supported grant types.
 type TokenRequest struct {
-	GrantType    string `json:"grant_type"`
-	ClientID     string `json:"client_id"`
+	GrantType string `json:"grant_type"`
+	ClientID  string `json:"client_id"`
+	// ClientSecret authenticates a confidential client (client_secret_post).
+	// Required for token exchange.
+	ClientSecret string `json:"client_secret,omitempty"`
 	Code         string `json:"code,omitempty"`
 	RedirectURI  string `json:"redirect_uri,omitempty"`
 	RefreshToken string `json:"refresh_token,omitempty"`
+	// Resource lists the RFC 8707 resource indicators the token should be
+	// issued for; each becomes an audience of the access token.
+	Resource []string `json:"resource,omitempty"`
+	// Token exchange (RFC 8693): the access token to exchange, its type, and
+	// the type wanted back. Only access tokens are exchanged.
+	SubjectToken       string `json:"subject_token,omitempty"`
+	SubjectTokenType   string `json:"subject_token_type,omitempty"`
+	RequestedTokenType string `json:"requested_token_type,omitempty"`
+	// Scope is the space-delimited scope wanted for a refreshed or exchanged
+	// token; it must be a subset of the original grant's or the subject
+	// token's scope (RFC 6749 §6, RFC 8693 §2.1). Empty keeps that scope.
+	Scope string `json:"scope,omitempty"`
 }
 
 // Normalize trims whitespace from token request fields.
@@ -122,6 +137,16 @@ func (r *TokenRequest) Normalize() {
 	r.Code = strings.TrimSpace(r.Code)
 	r.RedirectURI = strings.TrimSpace(r.RedirectURI)
 	r.RefreshToken = strings.TrimSpace(r.RefreshToken)
+	r.Resource = strutil.DedupeAndTrim(r.Resource)
+	r.SubjectToken = strings.TrimSpace(r.SubjectToken)
+	r.SubjectTokenType = strings.TrimSpace(r.SubjectTokenType)
+	r.RequestedTokenType = strings.TrimSpace(r.RequestedTokenType)
+	r.Scope = strings.Join(strings.Fields(r.Scope), " ")
+}
+
+// Scopes returns the requested scope as a list, or nil if none was requested.
+func (r *TokenRequest) Scopes() []string {
+	return strutil.DedupeAndTrim(strings.Fields(r.Scope))
 }
 
 // Validate validates the token request following strict validation order:
@@ -144,6 +169,24 @@ func (r *TokenRequest) Validate() error {
 	if len(r.RefreshToken) > validation.MaxRefreshTokenLength {
 		return dErrors.New(dErrors.CodeValidation, fmt.Sprintf("refresh_token must be %d characters or less", validation.MaxRefreshTokenLength))
 	}
+	if len(r.ClientSecret) > validation.MaxClientSecretLength {
+		return dErrors.New(dErrors.CodeValidation, fmt.Sprintf("client_secret must be %d characters or less", validation.MaxClientSecretLength))
+	}
+	if err := validation.CheckSliceCount("resources", len(r.Resource), validation.MaxResources); err != nil {
+		return err
+	}
+	if err := validation.CheckEachStringLength("resource", r.Resource, validation.MaxResourceLength); err != nil {
+		return err
+	}
+	if len(r.SubjectToken) > validation.MaxSubjectTokenLength {
+		return dErrors.New(dErrors.CodeValidation, fmt.Sprintf("subject_token must be %d characters or less", validation.MaxSubjectTokenLength))
+	}
+	if err := validation.CheckSliceCount("scopes", len(r.Scopes()), validation.MaxScopes); err != nil {
+		return err
+	}
+	if err := validation.CheckEachStringLength("scope", r.Scopes(), validation.MaxScopeLength); err != nil {
+		return err
+	}
 
 	// Phase 2: Required fields (presence checks)
 	if r.GrantType == "" {
@@ -154,11 +197,19 @@ func (r *TokenRequest) Validate() error {
 	}
 
 	// Phase 3: Syntax validation (enum check)
-	if r.GrantType != string(GrantAuthorizationCode) && r.GrantType != string(GrantRefreshToken) {
+	if r.GrantType != string(GrantAuthorizationCode) && r.GrantType != string(GrantRefreshToken) && r.GrantType != string(GrantTokenExchange) {
 		return dErrors.New(dErrors.CodeBadRequest, "unsupported grant_type")
 	}
+	for _, resource := range r.Resource {
+		if !isResourceIndicator(resource) {
+			return dErrors.New(dErrors.CodeInvalidTarget, "resource must be an absolute URI without a fragment")
+		}
+	}
 
 	// Phase 4: Semantic validation (grant-type specific requirements)
+	if param := r.foreignGrantParam(); param != "" {
+		return dErrors.New(dErrors.CodeInvalidRequest, fmt.Sprintf("%s is not allowed for %s grant", param, r.GrantType))
+	}
 	if r.GrantType == string(GrantAuthorizationCode) {
 		if r.Code == "" {
 			return dErrors.New(dErrors.CodeValidation, "code is required for authorization_code grant")
@@ -170,10 +221,60 @@ func (r *TokenRequest) Validate() error {
 		if r.RefreshToken == "" {
 			return dErrors.New(dErrors.CodeValidation, "refresh_token is required for refresh_token grant")
 		}
+	} else if r.GrantType == string(GrantTokenExchange) {
+		if r.ClientSecret == "" {
+			return dErrors.New(dErrors.CodeValidation, "client_secret is required for token exchange")
+		}
+		if r.SubjectToken == "" {
+			return dErrors.New(dErrors.CodeInvalidRequest, "subject_token is required for token exchange")
+		}
+		if r.SubjectTokenType != TokenTypeURIAccessToken {
+			return dErrors.New(dErrors.CodeInvalidRequest, "subject_token_type must be "+TokenTypeURIAccessToken)
+		}
+		if r.RequestedTokenType != "" && r.RequestedTokenType != TokenTypeURIAccessToken {
+			return dErrors.New(dErrors.CodeInvalidRequest, "requested_token_type must be "+TokenTypeURIAccessToken)
+		}
 	}
 	return nil
 }
 
+// grantParams lists the grant-specific parameters each grant type accepts.
+// client_id, client_secret and resource apply to every grant and are not listed.
+var grantParams = map[string][]string{
+	string(GrantAuthorizationCode): {"code", "redirect_uri"},
+	string(GrantRefreshToken):      {"refresh_token", "scope"},
+	string(GrantTokenExchange):     {"subject_token", "subject_token_type", "requested_token_type", "scope"},
+}
+
+// foreignGrantParam returns the first grant-specific parameter the request
+// carries that its grant type does not accept, or "" if there is none. Such
+// parameters are rejected rather than ignored so a client mixing up grants
+// fails loudly instead of running a flow it did not intend.
+func (r *TokenRequest) foreignGrantParam() string {
+	present := []struct{ name, value string }{
+		{"code", r.Code},
+		{"redirect_uri", r.RedirectURI},
+		{"refresh_token", r.RefreshToken},
+		{"subject_token", r.SubjectToken},
+		{"subject_token_type", r.SubjectTokenType},
+		{"requested_token_type", r.RequestedTokenType},
+		{"scope", r.Scope},
+	}
+	accepted := grantParams[r.GrantType]
+	for _, param := range present {
+		if param.value != "" && !slices.Contains(accepted, param.name) {
+			return param.name
+		}
+	}
+	return ""
+}
+
+// isResourceIndicator reports whether s is an absolute URI without a fragment (RFC 8707 §2).
+func isResourceIndicator(s string) bool {
+	parsed, err := url.Parse(s)
+	return err == nil && parsed.IsAbs() && !strings.Contains(s, "#")
+}
+
 // RevokeTokenRequest represents an RFC 7009 token revocation request.
 type RevokeTokenRequest struct {
 	Token         string `json:"token"`
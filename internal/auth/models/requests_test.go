@@ -4,6 +4,7 @@ import (
 	"strings"
 	"testing"
 
+	dErrors "credo/pkg/domain-errors"
 	"credo/pkg/platform/validation"
 
 	"github.com/stretchr/testify/assert"
@@ -117,6 +118,14 @@ func TestAuthorizationRequest_Validate_SizeLimits(t *testing.T) {
 		err := req.Validate()
 		require.Error(t, err)
 		assert.Contains(t, err.Error(), "state must be")
+		assert.True(t, dErrors.HasCode(err, dErrors.CodeInvalidRequest), "oversized state is an OAuth invalid_request")
+	})
+
+	t.Run("state at max length accepted", func(t *testing.T) {
+		req := validRequest()
+		req.State = strings.Repeat("a", validation.MaxStateLength)
+
+		require.NoError(t, req.Validate())
 	})
 }
 
@@ -222,7 +231,7 @@ func TestAuthorizationRequest_Normalize(t *testing.T) {
 		assert.Equal(t, "client-123", req.ClientID)
 		assert.Equal(t, []string{"openid", "profile"}, req.Scopes)
 		assert.Equal(t, "https://example.com/callback", req.RedirectURI)
-		assert.Equal(t, "state123", req.State)
+		assert.Equal(t, "  state123  ", req.State, "state is opaque and round-trips verbatim")
 	})
 
 	t.Run("sets default scope when empty", func(t *testing.T) {
@@ -325,6 +334,105 @@ func TestTokenRequest_Validate(t *testing.T) {
 		require.Error(t, err)
 		assert.Contains(t, err.Error(), "refresh_token is required")
 	})
+
+	t.Run("valid token exchange request", func(t *testing.T) {
+		req := &TokenRequest{
+			GrantType:          string(GrantTokenExchange),
+			ClientID:           "test-client",
+			ClientSecret:       "test-secret",
+			SubjectToken:       "subject-access-token",
+			SubjectTokenType:   TokenTypeURIAccessToken,
+			RequestedTokenType: TokenTypeURIAccessToken,
+			Scope:              "openid",
+		}
+
+		err := req.Validate()
+		assert.NoError(t, err)
+	})
+
+	t.Run("token exchange without subject_token rejected", func(t *testing.T) {
+		req := &TokenRequest{
+			GrantType:        string(GrantTokenExchange),
+			ClientID:         "test-client",
+			ClientSecret:     "test-secret",
+			SubjectTokenType: TokenTypeURIAccessToken,
+		}
+
+		err := req.Validate()
+		require.Error(t, err)
+		assert.True(t, dErrors.HasCode(err, dErrors.CodeInvalidRequest))
+		assert.Contains(t, err.Error(), "subject_token is required")
+	})
+
+	t.Run("refresh_token with scope accepted", func(t *testing.T) {
+		req := &TokenRequest{
+			GrantType:    string(GrantRefreshToken),
+			ClientID:     "test-client",
+			RefreshToken: "refresh-token-123",
+			Scope:        "openid",
+		}
+
+		assert.NoError(t, req.Validate())
+	})
+
+	t.Run("token exchange without client_secret rejected", func(t *testing.T) {
+		req := &TokenRequest{
+			GrantType:        string(GrantTokenExchange),
+			ClientID:         "test-client",
+			SubjectToken:     "subject-access-token",
+			SubjectTokenType: TokenTypeURIAccessToken,
+		}
+
+		err := req.Validate()
+		require.Error(t, err)
+		assert.True(t, dErrors.HasCode(err, dErrors.CodeValidation))
+		assert.Contains(t, err.Error(), "client_secret is required")
+	})
+
+	t.Run("parameters of another grant rejected", func(t *testing.T) {
+		tests := []struct {
+			name  string
+			req   TokenRequest
+			param string
+		}{
+			{
+				name:  "authorization_code with refresh_token",
+				req:   TokenRequest{GrantType: "authorization_code", Code: "auth-code-123", RedirectURI: "https://example.com/callback", RefreshToken: "refresh-token-123"},
+				param: "refresh_token",
+			},
+			{
+				name:  "authorization_code with scope",
+				req:   TokenRequest{GrantType: "authorization_code", Code: "auth-code-123", RedirectURI: "https://example.com/callback", Scope: "openid"},
+				param: "scope",
+			},
+			{
+				name:  "refresh_token with code",
+				req:   TokenRequest{GrantType: "refresh_token", RefreshToken: "refresh-token-123", Code: "auth-code-123"},
+				param: "code",
+			},
+			{
+				name:  "refresh_token with redirect_uri",
+				req:   TokenRequest{GrantType: "refresh_token", RefreshToken: "refresh-token-123", RedirectURI: "https://example.com/callback"},
+				param: "redirect_uri",
+			},
+			{
+				name:  "token exchange with refresh_token",
+				req:   TokenRequest{GrantType: string(GrantTokenExchange), SubjectToken: "subject-access-token", SubjectTokenType: TokenTypeURIAccessToken, RefreshToken: "refresh-token-123"},
+				param: "refresh_token",
+			},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				req := tt.req
+				req.ClientID = "test-client"
+
+				err := req.Validate()
+				require.Error(t, err)
+				assert.True(t, dErrors.HasCode(err, dErrors.CodeInvalidRequest))
+				assert.Contains(t, err.Error(), tt.param+" is not allowed")
+			})
+		}
+	})
 }
 
 func TestRevokeTokenRequest_Validate(t *testing.T) {
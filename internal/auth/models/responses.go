@@ -15,15 +15,20 @@ type AuthorizationResult struct {
 // TokenResult is the response payload for /auth/token.
 type TokenResult struct {
 	AccessToken  string `json:"access_token"`
-	IDToken      string `json:"id_token"`
-	RefreshToken string `json:"refresh_token"`
+	IDToken      string `json:"id_token,omitempty"`
+	RefreshToken string `json:"refresh_token,omitempty"`
 	ExpiresIn    int    `json:"expires_in"` // seconds until token expiration
 	TokenType    string `json:"token_type"`
 	Scope        string `json:"scope,omitempty"` // space-delimited scopes granted
+	// IssuedTokenType is set for token exchange, which issues an access token only (RFC 8693 §2.2.1).
+	IssuedTokenType string `json:"issued_token_type,omitempty"`
 }
 
 const TokenTypeBearer = "Bearer"
 
+// TokenTypeDPoP is returned for access tokens bound to a DPoP key (RFC 9449 §5).
+const TokenTypeDPoP = "DPoP"
+
 // UserInfoResult is the OIDC userinfo response payload.
 type UserInfoResult struct {
 	Sub           string `json:"sub"`            // Subject - Identifier for the End-User at the Issuer.
@@ -34,6 +39,19 @@ type UserInfoResult struct {
 	Name          string `json:"name"`           // End-User's full name.
 }
 
+// ScopeDescription is the display metadata a consent screen shows for one scope.
+type ScopeDescription struct {
+	Name        string `json:"name"`
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"`
+	Sensitive   bool   `json:"sensitive"` // Grants access to personal data
+}
+
+// ScopesResult lists scope display metadata.
+type ScopesResult struct {
+	Scopes []ScopeDescription `json:"scopes"`
+}
+
 // SessionSummary represents a summary of a session for display to the user.
 type SessionSummary struct {
 	SessionID    string    `json:"session_id"`
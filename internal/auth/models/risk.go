@@ -0,0 +1,49 @@
+package models
+
+import "slices"
+
+// MaxRiskScore caps a session's risk score. Scores use the 0-100 bands of the
+// risk-to-action matrix (PRD-027 FR-1).
+const MaxRiskScore = 100
+
+// RiskSignal names an activity signal observed when a session is refreshed.
+type RiskSignal string
+
+const (
+	// RiskSignalNewDevice: the device ID or fingerprint differs from the one bound to the session.
+	RiskSignalNewDevice RiskSignal = "new_device"
+	// RiskSignalNewLocation: the caller resolves to a different location than the session's.
+	RiskSignalNewLocation RiskSignal = "new_location"
+	// RiskSignalUnusualTime: the refresh falls outside the configured usual hours.
+	RiskSignalUnusualTime RiskSignal = "unusual_time"
+	// RiskSignalRapidRefresh: the session was refreshed again within the rapid refresh window.
+	RiskSignalRapidRefresh RiskSignal = "rapid_refresh"
+)
+
+// RiskWeights maps each signal to the points it adds to a risk score.
+// Signals without a weight add nothing.
+type RiskWeights map[RiskSignal]int
+
+// DefaultRiskWeights returns weights under which a device and location change
+// together reach the high band, while any single signal stays medium or below.
+func DefaultRiskWeights() RiskWeights {
+	return RiskWeights{
+		RiskSignalNewDevice:    35,
+		RiskSignalNewLocation:  25,
+		RiskSignalUnusualTime:  10,
+		RiskSignalRapidRefresh: 20,
+	}
+}
+
+// ScoreRisk sums the weights of the observed signals, counting each signal once,
+// and clamps the result to [0, MaxRiskScore].
+func ScoreRisk(signals []RiskSignal, weights RiskWeights) int {
+	score := 0
+	for i, signal := range signals {
+		if slices.Contains(signals[:i], signal) {
+			continue
+		}
+		score += weights[signal]
+	}
+	return min(max(score, 0), MaxRiskScore)
+}
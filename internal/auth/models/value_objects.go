@@ -48,8 +48,13 @@ type Grant = domain.GrantType
 const (
 	GrantAuthorizationCode = domain.GrantTypeAuthorizationCode
 	GrantRefreshToken      = domain.GrantTypeRefreshToken
+	GrantTokenExchange     = domain.GrantTypeTokenExchange
 )
 
+// TokenTypeURIAccessToken identifies an access token in token exchange
+// subject_token_type, requested_token_type, and issued_token_type (RFC 8693 §3).
+const TokenTypeURIAccessToken = "urn:ietf:params:oauth:token-type:access_token"
+
 // Scope represents a valid OAuth 2.0 / OIDC scope.
 type Scope string
 
@@ -155,16 +160,21 @@ const (
 
 	// RevocationReasonTokenRotation means old token was invalidated by rotation.
 	RevocationReasonTokenRotation RevocationReason = "token_rotation"
+
+	// RevocationReasonDeviceSessionLimit means the session was evicted to keep
+	// its device within the per-device session cap.
+	RevocationReasonDeviceSessionLimit RevocationReason = "device_session_limit"
 )
 
 var validRevocationReasons = map[RevocationReason]bool{
-	RevocationReasonUserInitiated:  true,
-	RevocationReasonExpired:        true,
-	RevocationReasonUserDeleted:    true,
-	RevocationReasonAdminRevoked:   true,
-	RevocationReasonSecurityEvent:  true,
-	RevocationReasonReplayDetected: true,
-	RevocationReasonTokenRotation:  true,
+	RevocationReasonUserInitiated:      true,
+	RevocationReasonExpired:            true,
+	RevocationReasonUserDeleted:        true,
+	RevocationReasonAdminRevoked:       true,
+	RevocationReasonSecurityEvent:      true,
+	RevocationReasonReplayDetected:     true,
+	RevocationReasonTokenRotation:      true,
+	RevocationReasonDeviceSessionLimit: true,
 }
 
 // IsValid checks if the revocation reason is one of the supported enum values.
@@ -186,3 +196,15 @@ func ParseRevocationReason(s string) (RevocationReason, error) {
 	}
 	return r, nil
 }
+
+// Token rejection reasons refine a token error so clients can route the user:
+// an inactive client or user needs support, a revoked session needs a new login.
+// They are returned as the "reason" field of the error body.
+const (
+	RejectionReasonClientInactive = "client_inactive"
+	RejectionReasonUserInactive   = "user_inactive"
+	RejectionReasonSessionRevoked = "session_revoked"
+	// RejectionReasonUnknownClient marks an authorize request naming a client
+	// that does not exist.
+	RejectionReasonUnknownClient = "unknown_client"
+)
@@ -21,7 +21,12 @@ type AuthRateLimitResult struct {
 	Allowed    bool
 	Remaining  int
 	RetryAfter int // seconds until retry is allowed
+	BackoffMs  int // progressive delay to apply to an allowed attempt, in milliseconds
 	ResetAt    time.Time
+	Reason     string // machine-readable check that denied the request, e.g. "auth_lockout" or "ip"
+	// RequiresCaptcha is set for a locked-out account or for every attempt while
+	// a population-wide failure spike is being defended against.
+	RequiresCaptcha bool
 }
 
 // AuthLockoutState reports the current lockout and captcha requirements.
@@ -29,4 +34,8 @@ type AuthLockoutState struct {
 	FailureCount    int
 	LockedUntil     *time.Time
 	RequiresCaptcha bool
+	// AttemptsRemaining is how many more failures are allowed before the window
+	// limit locks the identifier; ResetAt is when the window's failures expire.
+	AttemptsRemaining int
+	ResetAt           time.Time
 }
@@ -2,7 +2,10 @@ package service
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
+	"strings"
 
 	"credo/internal/auth/models"
 	id "credo/pkg/domain"
@@ -14,6 +17,20 @@ import (
 // DeleteUser deletes a user and revokes their sessions as an admin operation.
 // Uses RunInTx to ensure atomic deletion of sessions and user.
 func (s *Service) DeleteUser(ctx context.Context, userID id.UserID) error {
+	return s.deleteUser(ctx, userID, true)
+}
+
+// EraseUser deletes a user for a right-to-erasure request. It behaves like
+// DeleteUser but leaves the user_deleted record to the erasure orchestrator,
+// which attributes it to the requesting actor.
+func (s *Service) EraseUser(ctx context.Context, userID id.UserID) error {
+	return s.deleteUser(ctx, userID, false)
+}
+
+// deleteUser revokes the access tokens of every session before deleting the
+// sessions and user: if deletion then fails the user only has to log in again,
+// whereas the reverse order could leave tokens of a deleted user valid.
+func (s *Service) deleteUser(ctx context.Context, userID id.UserID, recordUserDeleted bool) error {
 	if userID.IsNil() {
 		return dErrors.New(dErrors.CodeBadRequest, "user ID required")
 	}
@@ -27,9 +44,14 @@ func (s *Service) DeleteUser(ctx context.Context, userID id.UserID) error {
 		return dErrors.Wrap(err, dErrors.CodeInternal, "failed to lookup user")
 	}
 
+	if err := s.revokeUserAccessTokens(ctx, userID); err != nil {
+		return err
+	}
+
+	// The address is logged as a hash so logs do not outlive an erasure with it.
 	auditAttrs := []any{
 		"user_id", userID.String(),
-		"email", user.Email,
+		"email_hash", hashEmail(user.Email),
 		"reason", models.RevocationReasonUserDeleted.String(),
 	}
 
@@ -57,7 +79,37 @@ func (s *Service) DeleteUser(ctx context.Context, userID id.UserID) error {
 
 	// Audit events after successful transaction commit
 	s.logAudit(ctx, string(audit.EventSessionsRevoked), auditAttrs...)
-	s.logAudit(ctx, string(audit.EventUserDeleted), auditAttrs...)
+	if recordUserDeleted {
+		s.logAudit(ctx, string(audit.EventUserDeleted), auditAttrs...)
+	}
 
 	return nil
 }
+
+// revokeUserAccessTokens adds the last access token of each of the user's
+// sessions to the revocation list, so they stop working once the sessions
+// are gone rather than at expiry.
+func (s *Service) revokeUserAccessTokens(ctx context.Context, userID id.UserID) error {
+	sessions, err := s.sessions.ListByUser(ctx, userID)
+	if err != nil {
+		return dErrors.Wrap(err, dErrors.CodeInternal, "failed to list user sessions")
+	}
+	for _, session := range sessions {
+		if session.LastAccessTokenJTI == "" {
+			continue
+		}
+		if err := s.trl.RevokeToken(ctx, session.LastAccessTokenJTI, s.TokenTTL); err != nil {
+			s.logger.ErrorContext(ctx, "failed to add token to revocation list", "error", err, "jti", session.LastAccessTokenJTI)
+			if s.TRLFailureMode == TRLFailureModeFail {
+				return dErrors.Wrap(err, dErrors.CodeInternal, "failed to add token to revocation list")
+			}
+		}
+	}
+	return nil
+}
+
+// hashEmail identifies an address in logs without retaining it.
+func hashEmail(email string) string {
+	h := sha256.Sum256([]byte(strings.ToLower(email)))
+	return hex.EncodeToString(h[:])
+}
@@ -36,8 +36,31 @@ func (s *ServiceSuite) TestAdminUserDeletion_ErrorPropagation() {
 		s.True(dErrors.HasCode(err, dErrors.CodeNotFound))
 	})
 
+	s.Run("session listing fails before anything is deleted", func() {
+		s.mockUserStore.EXPECT().FindByID(ctx, userID).Return(existingUser, nil)
+		s.mockSessionStore.EXPECT().ListByUser(ctx, userID).Return(nil, errors.New("redis down"))
+
+		err := s.service.DeleteUser(ctx, userID)
+		s.Require().Error(err)
+		s.True(dErrors.HasCode(err, dErrors.CodeInternal))
+	})
+
+	s.Run("access tokens are revoked before sessions are deleted", func() {
+		s.mockUserStore.EXPECT().FindByID(ctx, userID).Return(existingUser, nil)
+		s.mockSessionStore.EXPECT().ListByUser(ctx, userID).Return([]*models.Session{
+			{ID: id.SessionID(uuid.New()), UserID: userID, LastAccessTokenJTI: "jti-1"},
+			{ID: id.SessionID(uuid.New()), UserID: userID},
+		}, nil)
+		revoke := s.mockTRL.EXPECT().RevokeToken(ctx, "jti-1", s.service.TokenTTL).Return(nil)
+		s.mockSessionStore.EXPECT().DeleteSessionsByUser(ctx, userID).Return(nil).After(revoke)
+		s.mockUserStore.EXPECT().Delete(ctx, userID).Return(nil)
+
+		s.Require().NoError(s.service.EraseUser(ctx, userID))
+	})
+
 	s.Run("session delete fails", func() {
 		s.mockUserStore.EXPECT().FindByID(ctx, userID).Return(existingUser, nil)
+		s.mockSessionStore.EXPECT().ListByUser(ctx, userID).Return(nil, nil)
 		s.mockSessionStore.EXPECT().DeleteSessionsByUser(ctx, userID).Return(errors.New("redis down"))
 
 		err := s.service.DeleteUser(ctx, userID)
@@ -47,6 +70,7 @@ func (s *ServiceSuite) TestAdminUserDeletion_ErrorPropagation() {
 
 	s.Run("user delete fails", func() {
 		s.mockUserStore.EXPECT().FindByID(ctx, userID).Return(existingUser, nil)
+		s.mockSessionStore.EXPECT().ListByUser(ctx, userID).Return(nil, nil)
 		s.mockSessionStore.EXPECT().DeleteSessionsByUser(ctx, userID).Return(nil)
 		s.mockUserStore.EXPECT().Delete(ctx, userID).Return(errors.New("write fail"))
 
@@ -27,6 +27,7 @@ type authorizeParams struct {
 	DeviceID          string
 	DeviceFingerprint string
 	DeviceDisplayName string
+	Location          string
 	Client            *types.ResolvedClient
 	Tenant            *types.ResolvedTenant
 }
@@ -36,6 +37,10 @@ type authorizeResult struct {
 	Session        *models.Session
 	AuthCode       *models.AuthorizationCodeRecord
 	UserWasCreated bool
+
+	// EvictedSessions are the device's sessions revoked to keep it within
+	// MaxSessionsPerDevice.
+	EvictedSessions []*models.Session
 }
 
 // Authorize starts an authorization flow for a user and client.
@@ -68,7 +73,7 @@ func (s *Service) Authorize(ctx context.Context, req *models.AuthorizationReques
 	client, tnt, err := s.clientResolver.ResolveClient(ctx, req.ClientID)
 	if err != nil {
 		if dErrors.HasCode(err, dErrors.CodeNotFound) {
-			return nil, dErrors.New(dErrors.CodeBadRequest, "invalid client_id")
+			return nil, dErrors.NewWithReason(dErrors.CodeBadRequest, models.RejectionReasonUnknownClient, "invalid client_id")
 		}
 		return nil, dErrors.Wrap(err, dErrors.CodeBadRequest, "failed to resolve client")
 	}
@@ -87,20 +92,24 @@ func (s *Service) Authorize(ctx context.Context, req *models.AuthorizationReques
 		DeviceID:          deviceID,
 		DeviceFingerprint: requestcontext.DeviceFingerprint(ctx),
 		DeviceDisplayName: device.ParseUserAgent(requestcontext.UserAgent(ctx)),
+		Location:          s.approximateLocation(ctx),
 		Client:            client,
 		Tenant:            tnt,
 	}
 
 	if err = validateRequestedScopes(params.Scopes, client.AllowedScopes); err != nil {
+		s.emitLoginFailed(ctx, nil, loginFailureScopeNotAllowed, params)
 		return nil, err
 	}
 
 	result, err := s.authorizeInTx(ctx, params)
 	if err != nil {
+		s.emitLoginFailed(ctx, result.User, loginFailureReason(err), params)
 		return nil, err
 	}
 
 	s.emitAuthorizeAuditEvents(ctx, result, req.ClientID)
+	s.emitLoginSucceeded(ctx, result.User, params)
 	return s.buildAuthorizeResponse(parsedURI, result.AuthCode, req.State, deviceIDToSet), nil
 }
 
@@ -128,12 +137,17 @@ func (s *Service) authorizeInTx(ctx context.Context, params authorizeParams) (*a
 	txErr := s.tx.RunInTx(ctx, func(stores txAuthStores) error {
 		// Step 1: Find or create user
 		user, wasCreated, err := s.findOrCreateUser(ctx, stores.Users, params.Tenant.ID, params.Email)
+		result.User = user
 		if err != nil {
 			return err
 		}
-		result.User = user
 		result.UserWasCreated = wasCreated
 
+		toEvict, err := s.deviceSessionsToEvict(ctx, stores.Sessions, user.ID, params.DeviceFingerprint, params.Now)
+		if err != nil {
+			return err
+		}
+
 		// Step 2: Create session (pending consent)
 		// Note: Session must be created before auth code due to FK constraint
 		sessionID := id.SessionID(uuid.New())
@@ -157,7 +171,7 @@ func (s *Service) authorizeInTx(ctx context.Context, params authorizeParams) (*a
 			DeviceID:            params.DeviceID,
 			FingerprintHash:     params.DeviceFingerprint,
 			DisplayName:         params.DeviceDisplayName,
-			ApproximateLocation: "",
+			ApproximateLocation: params.Location,
 		})
 
 		if err = stores.Sessions.Create(ctx, session); err != nil {
@@ -183,15 +197,21 @@ func (s *Service) authorizeInTx(ctx context.Context, params authorizeParams) (*a
 		}
 		result.AuthCode = authCode
 
-		return nil
+		// Step 5: Evict the device's oldest sessions last, so a failed step
+		// above never revokes sessions for a login that did not happen
+		result.EvictedSessions, err = s.evictDeviceSessions(ctx, toEvict)
+		return err
 	})
 
 	if txErr != nil {
-		return nil, txErr
+		// The user is kept when known so the failure can be attributed to them.
+		return &result, txErr
 	}
 	return &result, nil
 }
 
+// findOrCreateUser returns the tenant user for userEmail, creating it on first login.
+// An inactive user is returned alongside the error so the failure can be audited.
 func (s *Service) findOrCreateUser(ctx context.Context, users UserStore, tenantID id.TenantID, userEmail string) (*models.User, bool, error) {
 	firstName, lastName := email.DeriveNameFromEmail(userEmail)
 	newUser, err := models.NewUser(id.UserID(uuid.New()), tenantID, userEmail, firstName, lastName, false)
@@ -204,7 +224,7 @@ func (s *Service) findOrCreateUser(ctx context.Context, users UserStore, tenantI
 		return nil, false, dErrors.Wrap(err, dErrors.CodeInternal, "failed to find or create user")
 	}
 	if !user.IsActive() {
-		return nil, false, dErrors.New(dErrors.CodeForbidden, "user is inactive")
+		return user, false, dErrors.NewWithReason(dErrors.CodeForbidden, models.RejectionReasonUserInactive, "user is inactive")
 	}
 
 	wasCreated := user.ID == newUser.ID
@@ -220,6 +240,7 @@ func (s *Service) emitAuthorizeAuditEvents(ctx context.Context, result *authoriz
 		s.incrementUserCreated()
 	}
 
+	s.emitDeviceEvictionEvents(ctx, result.EvictedSessions)
 	s.logAudit(ctx, string(audit.EventSessionCreated),
 		"user_id", result.User.ID.String(),
 		"session_id", result.Session.ID.String(),
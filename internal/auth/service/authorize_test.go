@@ -2,12 +2,17 @@ package service
 
 import (
 	"context"
+	"net/url"
+	"strings"
 
 	authdevice "credo/internal/auth/device"
 	"credo/internal/auth/models"
+	"credo/internal/auth/service/mocks"
 	"credo/internal/auth/types"
 	id "credo/pkg/domain"
 	dErrors "credo/pkg/domain-errors"
+	"credo/pkg/platform/audit"
+	"credo/pkg/platform/validation"
 	"credo/pkg/requestcontext"
 
 	"github.com/google/uuid"
@@ -109,6 +114,34 @@ func (s *ServiceSuite) TestAuthorizationCodeFlow() {
 		s.Contains(err.Error(), "redirect_uri scheme")
 	})
 
+	s.Run("state round-trips verbatim to the redirect", func() {
+		for _, state := range []string{"xyz", "  a b&c=d/é+%#?  "} {
+			req := baseReq
+			req.State = state
+
+			s.mockClientResolver.EXPECT().ResolveClient(gomock.Any(), req.ClientID).Return(mockClient, mockTenant, nil)
+			s.mockUserStore.EXPECT().FindOrCreateByTenantAndEmail(gomock.Any(), tenantID, req.Email, gomock.Any()).Return(existingUser, nil)
+			s.mockSessionStore.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+			s.mockCodeStore.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+
+			result, err := s.service.Authorize(context.Background(), &req)
+			s.Require().NoError(err)
+			redirect, err := url.Parse(result.RedirectURI)
+			s.Require().NoError(err)
+			s.Equal(state, redirect.Query().Get("state"))
+		}
+	})
+
+	s.Run("oversized state rejected as invalid_request", func() {
+		req := baseReq
+		req.State = strings.Repeat("s", validation.MaxStateLength+1)
+
+		result, err := s.service.Authorize(context.Background(), &req)
+		s.Require().Error(err)
+		s.Nil(result)
+		s.True(dErrors.HasCode(err, dErrors.CodeInvalidRequest))
+	})
+
 	s.Run("user store error", func() {
 		req := baseReq
 		ctx := context.Background()
@@ -162,3 +195,128 @@ func (s *ServiceSuite) TestAuthorizationClientStatusValidation() {
 			"expected invalid_client error code")
 	})
 }
+
+// TestLoginAuditEvents verifies the login_succeeded/login_failed security
+// timeline carries the anonymized IP, device, location, and failure reason.
+func (s *ServiceSuite) TestLoginAuditEvents() {
+	tenantID := id.TenantID(uuid.New())
+	clientID := id.ClientID(uuid.New())
+	mockClient := &types.ResolvedClient{
+		ID:            clientID,
+		TenantID:      tenantID,
+		OAuthClientID: "client-123",
+		RedirectURIs:  []string{"https://client.app/callback"},
+		AllowedScopes: []string{"openid"},
+		Active:        true,
+	}
+	mockTenant := &types.ResolvedTenant{ID: tenantID, Active: true}
+	userAgent := "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36"
+
+	locations := mocks.NewMockLocationResolver(s.ctrl)
+	s.service.locations = locations
+	locations.EXPECT().ApproximateLocation(gomock.Any(), "203.0.113.42").Return("Berlin, DE").AnyTimes()
+
+	loginEvents := func(action audit.AuditEvent) []audit.Event {
+		s.Require().NoError(s.auditPublisher.Flush(context.Background()))
+		all, err := s.auditStore.ListAll(context.Background())
+		s.Require().NoError(err)
+		var events []audit.Event
+		for _, e := range all {
+			if e.Action == string(action) {
+				events = append(events, e)
+			}
+		}
+		return events
+	}
+
+	s.Run("success emits login_succeeded with device and location", func() {
+		s.auditStore.Clear()
+		user := &models.User{
+			ID:       id.UserID(uuid.New()),
+			TenantID: tenantID,
+			Email:    "email@test.com",
+			Status:   models.UserStatusActive,
+		}
+		req := models.AuthorizationRequest{
+			ClientID:    "client-123",
+			Scopes:      []string{"openid"},
+			RedirectURI: "https://client.app/callback",
+			Email:       user.Email,
+		}
+		ctx := requestcontext.WithClientMetadata(context.Background(), "203.0.113.42", userAgent)
+
+		s.mockClientResolver.EXPECT().ResolveClient(gomock.Any(), req.ClientID).Return(mockClient, mockTenant, nil)
+		s.mockUserStore.EXPECT().FindOrCreateByTenantAndEmail(gomock.Any(), tenantID, req.Email, gomock.Any()).Return(user, nil)
+		s.mockSessionStore.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, session *models.Session) error {
+				s.Equal("Berlin, DE", session.ApproximateLocation)
+				return nil
+			})
+		s.mockCodeStore.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+
+		_, err := s.service.Authorize(ctx, &req)
+		s.Require().NoError(err)
+
+		events := loginEvents(audit.EventLoginSucceeded)
+		s.Require().Len(events, 1)
+		s.Equal(user.ID, events[0].UserID)
+		s.Equal(audit.CategorySecurity, events[0].Category)
+		s.Equal("203.0.113.0", events[0].Metadata[audit.MetadataIP], "IP must be anonymized")
+		s.Equal(authdevice.ParseUserAgent(userAgent), events[0].Metadata[audit.MetadataDevice])
+		s.Equal("Berlin, DE", events[0].Metadata[audit.MetadataLocation])
+		s.Empty(loginEvents(audit.EventLoginFailed))
+	})
+
+	s.Run("inactive user emits login_failed with reason", func() {
+		s.auditStore.Clear()
+		user := &models.User{
+			ID:       id.UserID(uuid.New()),
+			TenantID: tenantID,
+			Email:    "inactive@test.com",
+			Status:   models.UserStatusInactive,
+		}
+		req := models.AuthorizationRequest{
+			ClientID:    "client-123",
+			Scopes:      []string{"openid"},
+			RedirectURI: "https://client.app/callback",
+			Email:       user.Email,
+		}
+		ctx := requestcontext.WithClientMetadata(context.Background(), "203.0.113.42", userAgent)
+
+		s.mockClientResolver.EXPECT().ResolveClient(gomock.Any(), req.ClientID).Return(mockClient, mockTenant, nil)
+		s.mockUserStore.EXPECT().FindOrCreateByTenantAndEmail(gomock.Any(), tenantID, req.Email, gomock.Any()).Return(user, nil)
+
+		_, err := s.service.Authorize(ctx, &req)
+		s.Require().Error(err)
+
+		events := loginEvents(audit.EventLoginFailed)
+		s.Require().Len(events, 1)
+		s.Equal(user.ID, events[0].UserID)
+		s.Equal(loginFailureUserInactive, events[0].Reason)
+		s.Equal("203.0.113.0", events[0].Metadata[audit.MetadataIP])
+		s.Equal(authdevice.ParseUserAgent(userAgent), events[0].Metadata[audit.MetadataDevice])
+		s.Empty(loginEvents(audit.EventLoginSucceeded))
+	})
+
+	s.Run("disallowed scope emits login_failed attributed to the client", func() {
+		s.auditStore.Clear()
+		req := models.AuthorizationRequest{
+			ClientID:    "client-123",
+			Scopes:      []string{"openid", "profile"},
+			RedirectURI: "https://client.app/callback",
+			Email:       "email@test.com",
+		}
+		ctx := requestcontext.WithClientMetadata(context.Background(), "203.0.113.42", userAgent)
+
+		s.mockClientResolver.EXPECT().ResolveClient(gomock.Any(), req.ClientID).Return(mockClient, mockTenant, nil)
+
+		_, err := s.service.Authorize(ctx, &req)
+		s.Require().Error(err)
+
+		events := loginEvents(audit.EventLoginFailed)
+		s.Require().Len(events, 1)
+		s.Equal(loginFailureScopeNotAllowed, events[0].Reason)
+		s.Equal(clientID.String(), events[0].Subject)
+		s.True(events[0].UserID.IsNil())
+	})
+}
@@ -0,0 +1,90 @@
+package service
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"credo/internal/auth/models"
+	id "credo/pkg/domain"
+	dErrors "credo/pkg/domain-errors"
+	"credo/pkg/platform/audit"
+)
+
+// DeviceSessionLimitEvict revokes the device's oldest sessions to make room (default).
+const DeviceSessionLimitEvict = "evict"
+
+// DeviceSessionLimitReject refuses new sessions once the device is at its cap.
+const DeviceSessionLimitReject = "reject"
+
+// deviceSessionsToEvict checks a user's live sessions on one device against
+// MaxSessionsPerDevice before a new session is created. It returns the oldest
+// sessions that must go to make room, or an access denied error in reject
+// mode. Devices are matched by fingerprint; requests without one are not
+// limited. Nothing is revoked here, so a later failed step leaves the
+// device's sessions untouched.
+func (s *Service) deviceSessionsToEvict(ctx context.Context, sessions SessionStore, userID id.UserID, fingerprint string, now time.Time) ([]*models.Session, error) {
+	if s.MaxSessionsPerDevice <= 0 || fingerprint == "" {
+		return nil, nil
+	}
+
+	all, err := sessions.ListByUser(ctx, userID)
+	if err != nil {
+		return nil, dErrors.Wrap(err, dErrors.CodeInternal, "failed to list sessions")
+	}
+	onDevice := liveDeviceSessions(all, fingerprint, now)
+	excess := len(onDevice) - s.MaxSessionsPerDevice + 1
+	if excess <= 0 {
+		return nil, nil
+	}
+
+	if s.DeviceSessionLimitMode == DeviceSessionLimitReject {
+		return nil, dErrors.New(dErrors.CodeAccessDenied, "too many active sessions on this device")
+	}
+	return onDevice[:excess], nil
+}
+
+// evictDeviceSessions revokes the sessions chosen by deviceSessionsToEvict and
+// returns the ones this call revoked, for auditing once authorization succeeds.
+// Revocation is not transactional, so it must be the last step that can fail.
+func (s *Service) evictDeviceSessions(ctx context.Context, sessions []*models.Session) ([]*models.Session, error) {
+	var revoked []*models.Session
+	for _, session := range sessions {
+		outcome, err := s.revokeSessionInternal(ctx, session, "", models.RevocationReasonDeviceSessionLimit)
+		if err != nil {
+			return revoked, dErrors.Wrap(err, dErrors.CodeInternal, "failed to evict device session")
+		}
+		if outcome == revokeSessionOutcomeRevoked {
+			revoked = append(revoked, session)
+		}
+	}
+	return revoked, nil
+}
+
+// emitDeviceEvictionEvents records each session evicted to make room on its device.
+func (s *Service) emitDeviceEvictionEvents(ctx context.Context, evicted []*models.Session) {
+	for _, session := range evicted {
+		s.logAudit(ctx, string(audit.EventSessionRevoked),
+			"user_id", session.UserID.String(),
+			"session_id", session.ID.String(),
+			"client_id", session.ClientID,
+			"reason", models.RevocationReasonDeviceSessionLimit.String(),
+		)
+	}
+}
+
+// liveDeviceSessions returns the unrevoked, unexpired sessions bound to
+// fingerprint, oldest first.
+func liveDeviceSessions(sessions []*models.Session, fingerprint string, now time.Time) []*models.Session {
+	var live []*models.Session
+	for _, session := range sessions {
+		if session.DeviceFingerprintHash != fingerprint || session.IsRevoked() || !session.ExpiresAt.After(now) {
+			continue
+		}
+		live = append(live, session)
+	}
+	slices.SortFunc(live, func(a, b *models.Session) int {
+		return a.CreatedAt.Compare(b.CreatedAt)
+	})
+	return live
+}
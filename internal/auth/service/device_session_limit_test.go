@@ -0,0 +1,151 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/mock/gomock"
+
+	"credo/internal/auth/models"
+	id "credo/pkg/domain"
+	dErrors "credo/pkg/domain-errors"
+	"credo/pkg/requestcontext"
+)
+
+// TestDeviceSessionLimit tests the per-device session cap at authorization.
+//
+// Justification: Counting depends on fingerprint matching, revocation and expiry
+// of stored sessions, and the configured mode. E2E runs with the cap disabled
+// and cannot present one fingerprint across many logins.
+func (s *ServiceSuite) TestDeviceSessionLimit() {
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+	tenantID := id.TenantID(uuid.New())
+	clientUUID := id.ClientID(uuid.New())
+	userID := id.UserID(uuid.New())
+	mockClient, mockTenant := s.newTestClient(tenantID, clientUUID)
+	mockClient.RedirectURIs = []string{"https://client.app/callback"}
+	mockUser := s.newTestUser(userID, tenantID)
+
+	req := models.AuthorizationRequest{
+		ClientID:    mockClient.OAuthClientID,
+		Scopes:      []string{"openid"},
+		RedirectURI: "https://client.app/callback",
+		Email:       mockUser.Email,
+	}
+
+	deviceSession := func(fingerprint string, age time.Duration) *models.Session {
+		return &models.Session{
+			ID:                    id.SessionID(uuid.New()),
+			UserID:                userID,
+			ClientID:              clientUUID,
+			TenantID:              tenantID,
+			Status:                models.SessionStatusActive,
+			DeviceFingerprintHash: fingerprint,
+			CreatedAt:             now.Add(-age),
+			ExpiresAt:             now.Add(time.Hour),
+		}
+	}
+
+	setLimit := func(limit int, mode string) {
+		prevLimit, prevMode := s.service.MaxSessionsPerDevice, s.service.DeviceSessionLimitMode
+		s.service.MaxSessionsPerDevice, s.service.DeviceSessionLimitMode = limit, mode
+		s.T().Cleanup(func() {
+			s.service.MaxSessionsPerDevice, s.service.DeviceSessionLimitMode = prevLimit, prevMode
+		})
+	}
+
+	authorizeFrom := func(fingerprint string, existing []*models.Session) (*models.AuthorizationResult, error) {
+		ctx := requestcontext.WithTime(context.Background(), now)
+		ctx = requestcontext.WithDeviceFingerprint(ctx, fingerprint)
+		s.mockClientResolver.EXPECT().ResolveClient(gomock.Any(), req.ClientID).Return(mockClient, mockTenant, nil)
+		s.mockUserStore.EXPECT().FindOrCreateByTenantAndEmail(gomock.Any(), tenantID, req.Email, gomock.Any()).Return(mockUser, nil)
+		s.mockSessionStore.EXPECT().ListByUser(gomock.Any(), userID).Return(existing, nil)
+		r := req
+		return s.service.Authorize(ctx, &r)
+	}
+
+	expectCreated := func() {
+		s.mockSessionStore.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+		s.mockCodeStore.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+	}
+
+	s.Run("other devices and dead sessions do not count", func() {
+		setLimit(2, DeviceSessionLimitReject)
+		revoked := deviceSession("fp-a", 3*time.Hour)
+		revoked.Status = models.SessionStatusRevoked
+		expired := deviceSession("fp-a", 2*time.Hour)
+		expired.ExpiresAt = now.Add(-time.Minute)
+		existing := []*models.Session{
+			deviceSession("fp-a", time.Hour),
+			deviceSession("fp-b", time.Hour),
+			deviceSession("fp-b", 2*time.Hour),
+			revoked,
+			expired,
+		}
+		expectCreated()
+
+		_, err := authorizeFrom("fp-a", existing)
+		s.Require().NoError(err)
+	})
+
+	s.Run("reject mode refuses a session past the cap", func() {
+		setLimit(2, DeviceSessionLimitReject)
+		existing := []*models.Session{deviceSession("fp-a", time.Hour), deviceSession("fp-a", 2*time.Hour)}
+
+		result, err := authorizeFrom("fp-a", existing)
+		s.Require().Error(err)
+		s.Nil(result)
+		s.True(dErrors.HasCode(err, dErrors.CodeAccessDenied))
+	})
+
+	s.Run("reject mode leaves a different device unaffected", func() {
+		setLimit(2, DeviceSessionLimitReject)
+		existing := []*models.Session{deviceSession("fp-a", time.Hour), deviceSession("fp-a", 2*time.Hour)}
+		expectCreated()
+
+		_, err := authorizeFrom("fp-b", existing)
+		s.Require().NoError(err)
+	})
+
+	s.Run("evict mode revokes the oldest sessions on the device", func() {
+		setLimit(2, DeviceSessionLimitEvict)
+		oldest := deviceSession("fp-a", 3*time.Hour)
+		oldest.LastAccessTokenJTI = "jti-oldest"
+		older := deviceSession("fp-a", 2*time.Hour)
+		newest := deviceSession("fp-a", time.Hour)
+		existing := []*models.Session{newest, oldest, deviceSession("fp-b", 4*time.Hour), older}
+
+		for _, evicted := range []*models.Session{oldest, older} {
+			s.mockSessionStore.EXPECT().RevokeSessionIfActive(gomock.Any(), evicted.ID, now).Return(nil)
+			s.mockRefreshStore.EXPECT().DeleteBySessionID(gomock.Any(), evicted.ID).Return(nil)
+		}
+		s.mockTRL.EXPECT().RevokeToken(gomock.Any(), "jti-oldest", s.service.TokenTTL).Return(nil)
+		expectCreated()
+
+		_, err := authorizeFrom("fp-a", existing)
+		s.Require().NoError(err)
+	})
+
+	s.Run("evict mode revokes nothing when the authorization fails", func() {
+		setLimit(1, DeviceSessionLimitEvict)
+		existing := []*models.Session{deviceSession("fp-a", time.Hour)}
+		s.mockSessionStore.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+		s.mockCodeStore.EXPECT().Create(gomock.Any(), gomock.Any()).Return(dErrors.New(dErrors.CodeInternal, "db down"))
+
+		_, err := authorizeFrom("fp-a", existing)
+		s.Require().Error(err)
+	})
+
+	s.Run("requests without a fingerprint are not limited", func() {
+		setLimit(1, DeviceSessionLimitReject)
+		ctx := requestcontext.WithTime(context.Background(), now)
+		s.mockClientResolver.EXPECT().ResolveClient(gomock.Any(), req.ClientID).Return(mockClient, mockTenant, nil)
+		s.mockUserStore.EXPECT().FindOrCreateByTenantAndEmail(gomock.Any(), tenantID, req.Email, gomock.Any()).Return(mockUser, nil)
+		expectCreated()
+
+		r := req
+		_, err := s.service.Authorize(ctx, &r)
+		s.Require().NoError(err)
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 
+	"credo/internal/auth/models"
 	sessionStore "credo/internal/auth/store/session"
 	dErrors "credo/pkg/domain-errors"
 	"credo/pkg/platform/sentinel"
@@ -18,17 +19,18 @@ type tokenErrorMapping struct {
 	codeMsg    string // message for TokenFlowCode (empty = use err.Error())
 	refreshMsg string // message for TokenFlowRefresh (empty = use err.Error())
 	logReason  string
+	reason     string // machine-readable reason returned to clients (empty = none)
 }
 
 // tokenErrorMappings defines error translations in priority order.
 // First match wins; more specific errors should come first.
 // Note: Domain-errors from validation are passed through directly (see handleTokenError).
 var tokenErrorMappings = []tokenErrorMapping{
-	{sentinel.ErrNotFound, dErrors.CodeInvalidGrant, "invalid authorization code", "invalid refresh token", "not_found"},
-	{sentinel.ErrExpired, dErrors.CodeInvalidGrant, "authorization code expired", "refresh token expired", "expired"},
-	{sentinel.ErrAlreadyUsed, dErrors.CodeInvalidGrant, "authorization code already used", "invalid refresh token", "already_used"},
-	{sessionStore.ErrSessionRevoked, dErrors.CodeInvalidGrant, "session has been revoked", "session has been revoked", "session_revoked"},
-	{sentinel.ErrInvalidState, dErrors.CodeInvalidGrant, "session not active", "session not active", "invalid_state"},
+	{sentinel.ErrNotFound, dErrors.CodeInvalidGrant, "invalid authorization code", "invalid refresh token", "not_found", ""},
+	{sentinel.ErrExpired, dErrors.CodeInvalidGrant, "authorization code expired", "refresh token expired", "expired", ""},
+	{sentinel.ErrAlreadyUsed, dErrors.CodeInvalidGrant, "authorization code already used", "invalid refresh token", "already_used", ""},
+	{sessionStore.ErrSessionRevoked, dErrors.CodeInvalidGrant, "session has been revoked", "session has been revoked", "session_revoked", models.RejectionReasonSessionRevoked},
+	{sentinel.ErrInvalidState, dErrors.CodeInvalidGrant, "session not active", "session not active", "invalid_state", ""},
 }
 
 // handleTokenError translates dependency errors into domain errors.
@@ -50,9 +52,9 @@ func (s *Service) handleTokenError(ctx context.Context, err error, clientID stri
 	var de *dErrors.Error
 	if errors.As(err, &de) {
 		if de.Code == dErrors.CodeUnauthorized {
-			// Map session validation failures to OAuth invalid_grant
+			// Map session validation failures to OAuth invalid_grant, keeping the reason
 			s.authFailure(ctx, "session_validation_failed", false, attrs...)
-			return dErrors.New(dErrors.CodeInvalidGrant, de.Message)
+			return dErrors.NewWithReason(dErrors.CodeInvalidGrant, de.Reason, de.Message)
 		}
 		// Other domain errors pass through unchanged
 		s.authFailure(ctx, string(de.Code), false, attrs...)
@@ -70,7 +72,7 @@ func (s *Service) handleTokenError(ctx context.Context, err error, clientID stri
 				msg = err.Error()
 			}
 			s.authFailure(ctx, m.logReason, false, attrs...)
-			return dErrors.Wrap(err, m.code, msg)
+			return dErrors.WrapWithReason(err, m.code, m.reason, msg)
 		}
 	}
 
@@ -544,24 +544,33 @@ func (mr *MockTokenGeneratorMockRecorder) CreateRefreshToken() *gomock.Call {
 }
 
 // GenerateAccessToken mocks base method.
-func (m *MockTokenGenerator) GenerateAccessToken(ctx context.Context, userID domain.UserID, sessionID domain.SessionID, clientID domain.ClientID, tenantID domain.TenantID, scopes []string, apiVersion domain.APIVersion) (string, error) {
+func (m *MockTokenGenerator) GenerateAccessToken(ctx context.Context, userID domain.UserID, sessionID domain.SessionID, clientID domain.ClientID, tenantID domain.TenantID, scopes []string, apiVersion domain.APIVersion, opts ...jwttoken.AccessTokenOption) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GenerateAccessToken", ctx, userID, sessionID, clientID, tenantID, scopes, apiVersion)
+	varargs := []any{ctx, userID, sessionID, clientID, tenantID, scopes, apiVersion}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GenerateAccessToken", varargs...)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GenerateAccessToken indicates an expected call of GenerateAccessToken.
-func (mr *MockTokenGeneratorMockRecorder) GenerateAccessToken(ctx, userID, sessionID, clientID, tenantID, scopes, apiVersion any) *gomock.Call {
+func (mr *MockTokenGeneratorMockRecorder) GenerateAccessToken(ctx, userID, sessionID, clientID, tenantID, scopes, apiVersion any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateAccessToken", reflect.TypeOf((*MockTokenGenerator)(nil).GenerateAccessToken), ctx, userID, sessionID, clientID, tenantID, scopes, apiVersion)
+	varargs := append([]any{ctx, userID, sessionID, clientID, tenantID, scopes, apiVersion}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateAccessToken", reflect.TypeOf((*MockTokenGenerator)(nil).GenerateAccessToken), varargs...)
 }
 
 // GenerateAccessTokenWithJTI mocks base method.
-func (m *MockTokenGenerator) GenerateAccessTokenWithJTI(ctx context.Context, userID domain.UserID, sessionID domain.SessionID, clientID domain.ClientID, tenantID domain.TenantID, scopes []string, apiVersion domain.APIVersion) (string, string, error) {
+func (m *MockTokenGenerator) GenerateAccessTokenWithJTI(ctx context.Context, userID domain.UserID, sessionID domain.SessionID, clientID domain.ClientID, tenantID domain.TenantID, scopes []string, apiVersion domain.APIVersion, opts ...jwttoken.AccessTokenOption) (string, string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GenerateAccessTokenWithJTI", ctx, userID, sessionID, clientID, tenantID, scopes, apiVersion)
+	varargs := []any{ctx, userID, sessionID, clientID, tenantID, scopes, apiVersion}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GenerateAccessTokenWithJTI", varargs...)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(string)
 	ret2, _ := ret[2].(error)
@@ -569,9 +578,10 @@ func (m *MockTokenGenerator) GenerateAccessTokenWithJTI(ctx context.Context, use
 }
 
 // GenerateAccessTokenWithJTI indicates an expected call of GenerateAccessTokenWithJTI.
-func (mr *MockTokenGeneratorMockRecorder) GenerateAccessTokenWithJTI(ctx, userID, sessionID, clientID, tenantID, scopes, apiVersion any) *gomock.Call {
+func (mr *MockTokenGeneratorMockRecorder) GenerateAccessTokenWithJTI(ctx, userID, sessionID, clientID, tenantID, scopes, apiVersion any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateAccessTokenWithJTI", reflect.TypeOf((*MockTokenGenerator)(nil).GenerateAccessTokenWithJTI), ctx, userID, sessionID, clientID, tenantID, scopes, apiVersion)
+	varargs := append([]any{ctx, userID, sessionID, clientID, tenantID, scopes, apiVersion}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateAccessTokenWithJTI", reflect.TypeOf((*MockTokenGenerator)(nil).GenerateAccessTokenWithJTI), varargs...)
 }
 
 // GenerateIDToken mocks base method.
@@ -618,6 +628,59 @@ func (mr *MockTokenGeneratorMockRecorder) TokenType() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TokenType", reflect.TypeOf((*MockTokenGenerator)(nil).TokenType))
 }
 
+// ValidateToken mocks base method.
+func (m *MockTokenGenerator) ValidateToken(token string) (*jwttoken.AccessTokenClaims, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateToken", token)
+	ret0, _ := ret[0].(*jwttoken.AccessTokenClaims)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ValidateToken indicates an expected call of ValidateToken.
+func (mr *MockTokenGeneratorMockRecorder) ValidateToken(token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateToken", reflect.TypeOf((*MockTokenGenerator)(nil).ValidateToken), token)
+}
+
+// MockLocationResolver is a mock of LocationResolver interface.
+type MockLocationResolver struct {
+	ctrl     *gomock.Controller
+	recorder *MockLocationResolverMockRecorder
+	isgomock struct{}
+}
+
+// MockLocationResolverMockRecorder is the mock recorder for MockLocationResolver.
+type MockLocationResolverMockRecorder struct {
+	mock *MockLocationResolver
+}
+
+// NewMockLocationResolver creates a new mock instance.
+func NewMockLocationResolver(ctrl *gomock.Controller) *MockLocationResolver {
+	mock := &MockLocationResolver{ctrl: ctrl}
+	mock.recorder = &MockLocationResolverMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLocationResolver) EXPECT() *MockLocationResolverMockRecorder {
+	return m.recorder
+}
+
+// ApproximateLocation mocks base method.
+func (m *MockLocationResolver) ApproximateLocation(ctx context.Context, ip string) string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApproximateLocation", ctx, ip)
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// ApproximateLocation indicates an expected call of ApproximateLocation.
+func (mr *MockLocationResolverMockRecorder) ApproximateLocation(ctx, ip any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApproximateLocation", reflect.TypeOf((*MockLocationResolver)(nil).ApproximateLocation), ctx, ip)
+}
+
 // MockClientResolver is a mock of ClientResolver interface.
 type MockClientResolver struct {
 	ctrl     *gomock.Controller
@@ -657,3 +720,17 @@ func (mr *MockClientResolverMockRecorder) ResolveClient(ctx, clientID any) *gomo
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveClient", reflect.TypeOf((*MockClientResolver)(nil).ResolveClient), ctx, clientID)
 }
+
+// VerifyClientSecret mocks base method.
+func (m *MockClientResolver) VerifyClientSecret(ctx context.Context, clientID, secret string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyClientSecret", ctx, clientID, secret)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// VerifyClientSecret indicates an expected call of VerifyClientSecret.
+func (mr *MockClientResolverMockRecorder) VerifyClientSecret(ctx, clientID, secret any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyClientSecret", reflect.TypeOf((*MockClientResolver)(nil).VerifyClientSecret), ctx, clientID, secret)
+}
@@ -3,9 +3,12 @@ package service
 import (
 	"context"
 
+	"credo/internal/auth/models"
 	id "credo/pkg/domain"
+	dErrors "credo/pkg/domain-errors"
 	"credo/pkg/platform/attrs"
 	"credo/pkg/platform/audit"
+	"credo/pkg/platform/privacy"
 	"credo/pkg/requestcontext"
 )
 
@@ -100,6 +103,67 @@ func (s *Service) emitAuthFailure(ctx context.Context, reason string, parsed aut
 	})
 }
 
+// Login failure reasons recorded on login_failed events.
+const (
+	loginFailureScopeNotAllowed = "scope_not_allowed"
+	loginFailureUserInactive    = "user_inactive"
+	loginFailureDeviceLimit     = "device_session_limit"
+	loginFailureInternal        = "internal_error"
+)
+
+// loginFailureReason classifies an authorize failure for the security timeline.
+func loginFailureReason(err error) string {
+	if dErrors.HasCode(err, dErrors.CodeForbidden) {
+		return loginFailureUserInactive
+	}
+	if dErrors.HasCode(err, dErrors.CodeAccessDenied) {
+		return loginFailureDeviceLimit
+	}
+	return loginFailureInternal
+}
+
+// approximateLocation resolves the caller's location when a resolver is configured.
+func (s *Service) approximateLocation(ctx context.Context) string {
+	if s.locations == nil {
+		return ""
+	}
+	return s.locations.ApproximateLocation(ctx, requestcontext.ClientIP(ctx))
+}
+
+// emitLoginSucceeded records a successful login with its device context.
+func (s *Service) emitLoginSucceeded(ctx context.Context, user *models.User, params authorizeParams) {
+	s.emitLoginAttempt(ctx, audit.EventLoginSucceeded, user, "", audit.SeverityInfo, params)
+}
+
+// emitLoginFailed records a failed login. user is nil when the failure happened
+// before the user was resolved; the event is then attributed to the client.
+func (s *Service) emitLoginFailed(ctx context.Context, user *models.User, reason string, params authorizeParams) {
+	s.emitLoginAttempt(ctx, audit.EventLoginFailed, user, reason, audit.SeverityWarning, params)
+}
+
+// emitLoginAttempt emits a login event carrying the anonymized IP, device name,
+// and approximate location so security can build a per-user login timeline.
+func (s *Service) emitLoginAttempt(ctx context.Context, action audit.AuditEvent, user *models.User, reason string, severity audit.Severity, params authorizeParams) {
+	if s.auditPublisher == nil {
+		return
+	}
+	event := audit.SecurityEvent{
+		Subject:   params.Client.ID.String(),
+		Action:    string(action),
+		Reason:    reason,
+		IP:        privacy.AnonymizeIP(requestcontext.ClientIP(ctx)),
+		Device:    params.DeviceDisplayName,
+		Location:  params.Location,
+		RequestID: requestcontext.RequestID(ctx),
+		Severity:  severity,
+	}
+	if user != nil {
+		event.UserID = user.ID
+		event.Subject = user.ID.String()
+	}
+	s.auditPublisher.Emit(ctx, event)
+}
+
 // incrementUserCreated increments the users created metric if metrics are enabled
 func (s *Service) incrementUserCreated() {
 	if s.metrics != nil {
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log/slog"
 	"net/url"
+	"slices"
 	"strings"
 	"sync"
 	"time"
@@ -99,25 +100,37 @@ type RefreshTokenStore interface {
 
 // TokenGenerator issues signed access/ID tokens and generates refresh tokens.
 type TokenGenerator interface {
-	GenerateAccessToken(ctx context.Context, userID id.UserID, sessionID id.SessionID, clientID id.ClientID, tenantID id.TenantID, scopes []string, apiVersion id.APIVersion) (string, error)
-	GenerateAccessTokenWithJTI(ctx context.Context, userID id.UserID, sessionID id.SessionID, clientID id.ClientID, tenantID id.TenantID, scopes []string, apiVersion id.APIVersion) (string, string, error)
+	GenerateAccessToken(ctx context.Context, userID id.UserID, sessionID id.SessionID, clientID id.ClientID, tenantID id.TenantID, scopes []string, apiVersion id.APIVersion, opts ...jwttoken.AccessTokenOption) (string, error)
+	GenerateAccessTokenWithJTI(ctx context.Context, userID id.UserID, sessionID id.SessionID, clientID id.ClientID, tenantID id.TenantID, scopes []string, apiVersion id.APIVersion, opts ...jwttoken.AccessTokenOption) (string, string, error)
 	GenerateIDToken(ctx context.Context, userID id.UserID, sessionID id.SessionID, clientID id.ClientID, tenantID id.TenantID, apiVersion id.APIVersion) (string, error)
 	CreateRefreshToken() (string, error)
 	TokenType() string
 	// ParseTokenSkipClaimsValidation parses a JWT with signature verification but skips claims validation (e.g., expiration)
 	// This is used for token revocation where we need to verify the signature but accept expired tokens
 	ParseTokenSkipClaimsValidation(token string) (*jwttoken.AccessTokenClaims, error)
+	// ValidateToken parses a JWT and validates its signature, expiry, audience, and issuer
+	ValidateToken(token string) (*jwttoken.AccessTokenClaims, error)
 }
 
 // AuditPublisher is now the security publisher for auth events.
 // Auth events (auth failures, session revocations) are security-relevant.
 type AuditPublisher = *security.Publisher
 
+// LocationResolver maps a client IP to an approximate, human-readable location
+// (e.g., "Berlin, DE") shown on sessions and recorded on login events.
+// Implementations return "" when the IP cannot be resolved.
+type LocationResolver interface {
+	ApproximateLocation(ctx context.Context, ip string) string
+}
+
 // ClientResolver resolves client metadata and tenant ownership for a client ID.
 type ClientResolver interface {
 	// ResolveClient maps client_id -> client and tenant as a single choke point.
 	// If the client or tenant is inactive, returns an invalid_client error.
 	ResolveClient(ctx context.Context, clientID string) (*types.ResolvedClient, *types.ResolvedTenant, error)
+	// VerifyClientSecret authenticates a confidential client by its secret.
+	// Returns an invalid_client error for a wrong secret or a public client.
+	VerifyClientSecret(ctx context.Context, clientID, secret string) error
 }
 
 // Service orchestrates auth workflows across stores, tokens, audits, and metrics.
@@ -133,6 +146,7 @@ type Service struct {
 	auditPublisher AuditPublisher
 	jwt            TokenGenerator
 	clientResolver ClientResolver
+	locations      LocationResolver
 	metrics        *metrics.Metrics
 	*Config
 }
@@ -149,6 +163,8 @@ type TokenFlow string
 const (
 	TokenFlowCode    TokenFlow = "code"
 	TokenFlowRefresh TokenFlow = "refresh"
+	// TokenFlowExchange is RFC 8693 token exchange.
+	TokenFlowExchange TokenFlow = "exchange"
 )
 
 // TRLFailureModeWarn logs TRL failures but continues (default).
@@ -164,10 +180,22 @@ type Config struct {
 	RefreshTokenTTL        time.Duration
 	AllowedRedirectSchemes []string
 	DeviceBindingEnabled   bool
+	// DPoPRequiredClients opts clients into sender-constrained tokens (RFC 9449).
+	// Token requests for these clients are rejected unless they carry a valid DPoP proof.
+	DPoPRequiredClients []id.ClientID
 	// TRLFailureMode controls behavior when token revocation list write fails.
 	// "warn" (default): log the error and continue
 	// "fail": return an error, failing the operation
 	TRLFailureMode string
+	// RiskScoring scores session activity on refresh. Disabled by default.
+	RiskScoring RiskScoringConfig
+	// MaxSessionsPerDevice caps a user's live sessions on one device fingerprint.
+	// Zero disables the cap.
+	MaxSessionsPerDevice int
+	// DeviceSessionLimitMode controls what happens at the cap.
+	// "evict" (default): revoke the device's oldest sessions
+	// "reject": refuse the new session
+	DeviceSessionLimitMode string
 }
 
 // applyDefaults sets default values for any unset config fields.
@@ -187,6 +215,10 @@ func (c *Config) applyDefaults() {
 	if c.TRLFailureMode == "" {
 		c.TRLFailureMode = TRLFailureModeWarn
 	}
+	if c.DeviceSessionLimitMode == "" {
+		c.DeviceSessionLimitMode = DeviceSessionLimitEvict
+	}
+	c.RiskScoring.applyDefaults()
 }
 
 // tokenArtifacts bundles generated tokens and their associated records.
@@ -288,6 +320,14 @@ func WithDeviceBindingEnabled(enabled bool) Option {
 	}
 }
 
+// WithLocationResolver sets the resolver used to attach an approximate location
+// to sessions and login events. Without one, location is left empty.
+func WithLocationResolver(resolver LocationResolver) Option {
+	return func(s *Service) {
+		s.locations = resolver
+	}
+}
+
 // WithTRL sets the token revocation list implementation.
 func WithTRL(trl TokenRevocationList) Option {
 	return func(s *Service) {
@@ -295,6 +335,17 @@ func WithTRL(trl TokenRevocationList) Option {
 	}
 }
 
+// requireDPoP rejects token requests from DPoP-required clients that lack a verified proof.
+func (s *Service) requireDPoP(ctx context.Context, clientID id.ClientID) error {
+	if !slices.Contains(s.DPoPRequiredClients, clientID) {
+		return nil
+	}
+	if requestcontext.DPoPThumbprint(ctx) == "" {
+		return dErrors.New(dErrors.CodeInvalidDPoPProof, "DPoP proof required for this client")
+	}
+	return nil
+}
+
 // validateRequiredDeps checks that all required dependencies are provided.
 func validateRequiredDeps(users UserStore, sessions SessionStore, codes AuthCodeStore, refreshTokens RefreshTokenStore, jwt TokenGenerator, clientResolver ClientResolver) error {
 	if users == nil || sessions == nil || codes == nil || refreshTokens == nil {
@@ -374,14 +425,21 @@ func (s *Service) isRedirectSchemeAllowed(uri *url.URL) bool {
 
 // generateTokenArtifacts creates access, ID, and refresh tokens along with their records.
 // Used internally during token issuance flows.
+// The access token carries scopes and targets resources when any were requested;
+// the refresh token keeps the session's grant.
 // Returns a tokenArtifacts struct bundling all generated tokens and records.
-func (s *Service) generateTokenArtifacts(ctx context.Context, session *models.Session) (*tokenArtifacts, error) {
+func (s *Service) generateTokenArtifacts(ctx context.Context, session *models.Session, scopes, resources []string) (*tokenArtifacts, error) {
 	// Get API version from context (set by version middleware), default to v1
 	apiVersion := requestcontext.APIVersion(ctx)
 	if apiVersion.IsNil() {
 		apiVersion = id.APIVersionV1
 	}
 
+	var opts []jwttoken.AccessTokenOption
+	if len(resources) > 0 {
+		opts = append(opts, jwttoken.WithResources(resources))
+	}
+
 	// Generate tokens before mutating persistence state so failures do not leave partial writes.
 	accessToken, accessTokenJTI, err := s.jwt.GenerateAccessTokenWithJTI(
 		ctx,
@@ -389,8 +447,9 @@ func (s *Service) generateTokenArtifacts(ctx context.Context, session *models.Se
 		session.ID,
 		session.ClientID,
 		session.TenantID,
-		session.RequestedScope,
+		scopes,
 		apiVersion,
+		opts...,
 	)
 	if err != nil {
 		return nil, dErrors.Wrap(err, dErrors.CodeInternal, "failed to generate access token")
@@ -406,6 +465,11 @@ func (s *Service) generateTokenArtifacts(ctx context.Context, session *models.Se
 		return nil, dErrors.Wrap(err, dErrors.CodeInternal, "failed to create refresh token")
 	}
 
+	tokenType := s.jwt.TokenType()
+	if requestcontext.DPoPThumbprint(ctx) != "" {
+		tokenType = models.TokenTypeDPoP
+	}
+
 	now := requestcontext.Now(ctx)
 	tokenRecord, err := models.NewRefreshToken(
 		uuid.New(),
@@ -418,6 +482,8 @@ func (s *Service) generateTokenArtifacts(ctx context.Context, session *models.Se
 	if err != nil {
 		return nil, dErrors.Wrap(err, dErrors.CodeInternal, "failed to create refresh token record")
 	}
+	// Bind the refresh token to the proof key so only its holder can redeem it (RFC 9449 §5)
+	tokenRecord.DPoPJKT = requestcontext.DPoPThumbprint(ctx)
 
 	return &tokenArtifacts{
 		accessToken:    accessToken,
@@ -425,7 +491,7 @@ func (s *Service) generateTokenArtifacts(ctx context.Context, session *models.Se
 		idToken:        idToken,
 		refreshToken:   refreshToken,
 		refreshRecord:  tokenRecord,
-		tokenType:      s.jwt.TokenType(),
+		tokenType:      tokenType,
 	}, nil
 }
 
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 
+	"credo/internal/auth/models"
 	sessionStore "credo/internal/auth/store/session"
 	dErrors "credo/pkg/domain-errors"
 	"credo/pkg/platform/sentinel"
@@ -45,6 +46,20 @@ func (s *ServiceSuite) TestHandleTokenError() {
 	assertTokenError("internal error passthrough", dErrors.New(dErrors.CodeInternal, "db connection failed"), TokenFlowCode, dErrors.CodeInternal, "db connection failed")
 
 	assertTokenError("unknown error", errors.New("random error"), TokenFlowCode, dErrors.CodeInternal, "token handling failed")
+
+	s.Run("revoked session carries the session_revoked reason", func() {
+		for _, err := range []error{
+			sessionStore.ErrSessionRevoked,
+			dErrors.NewWithReason(dErrors.CodeUnauthorized, models.RejectionReasonSessionRevoked, "session has been revoked"),
+		} {
+			result := s.service.handleTokenError(context.Background(), err, clientID, &recordID, TokenFlowRefresh)
+
+			var de *dErrors.Error
+			s.Require().ErrorAs(result, &de)
+			s.Equal(dErrors.CodeInvalidGrant, de.Code)
+			s.Equal(models.RejectionReasonSessionRevoked, de.Reason)
+		}
+	})
 }
 
 func (s *ServiceSuite) TestHandleTokenError_AuditAttributes() {
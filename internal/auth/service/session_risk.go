@@ -0,0 +1,168 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"credo/internal/auth/models"
+	dErrors "credo/pkg/domain-errors"
+	"credo/pkg/platform/audit"
+	"credo/pkg/platform/privacy"
+	"credo/pkg/requestcontext"
+)
+
+const (
+	// defaultRiskThreshold is the lower bound of the high risk band (PRD-027 FR-1).
+	defaultRiskThreshold      = 51
+	defaultRapidRefreshWindow = time.Minute
+)
+
+// RiskScoringConfig controls session activity scoring at refresh time.
+type RiskScoringConfig struct {
+	Enabled bool
+	// Weights assigns points per signal. Nil uses models.DefaultRiskWeights.
+	Weights models.RiskWeights
+	// Threshold is the score at which a session counts as high risk.
+	Threshold int
+	// RapidRefreshWindow flags refreshes that follow the previous one this closely.
+	RapidRefreshWindow time.Duration
+	// UsualHoursStart and UsualHoursEnd bound the UTC hours [start, end) in which
+	// refreshes are expected; the range may wrap midnight. Equal values disable
+	// the unusual time signal.
+	UsualHoursStart int
+	UsualHoursEnd   int
+	// StepUp rejects refreshes at or above Threshold so the user must
+	// re-authenticate through the authorization flow.
+	StepUp bool
+}
+
+func (c *RiskScoringConfig) applyDefaults() {
+	if !c.Enabled {
+		return
+	}
+	if c.Weights == nil {
+		c.Weights = models.DefaultRiskWeights()
+	}
+	if c.Threshold <= 0 {
+		c.Threshold = defaultRiskThreshold
+	}
+	if c.RapidRefreshWindow <= 0 {
+		c.RapidRefreshWindow = defaultRapidRefreshWindow
+	}
+}
+
+// refreshActivity captures the request context a refresh is scored against.
+type refreshActivity struct {
+	DeviceID    string
+	Fingerprint string
+	Location    string
+	At          time.Time
+}
+
+// sessionRisk is the outcome of scoring one refresh.
+type sessionRisk struct {
+	Signals  []models.RiskSignal
+	Score    int
+	Location string // caller's location at refresh time
+}
+
+// detectRiskSignals compares a refresh against the session it advances.
+// It has no side effects so scoring can be exercised without a request.
+func detectRiskSignals(session *models.Session, activity refreshActivity, cfg RiskScoringConfig) []models.RiskSignal {
+	var signals []models.RiskSignal
+	if changed(session.DeviceID, activity.DeviceID) || changed(session.DeviceFingerprintHash, activity.Fingerprint) {
+		signals = append(signals, models.RiskSignalNewDevice)
+	}
+	if session.ApproximateLocation != "" && activity.Location != "" &&
+		!strings.EqualFold(session.ApproximateLocation, activity.Location) {
+		signals = append(signals, models.RiskSignalNewLocation)
+	}
+	if !withinUsualHours(activity.At, cfg.UsualHoursStart, cfg.UsualHoursEnd) {
+		signals = append(signals, models.RiskSignalUnusualTime)
+	}
+	if session.LastRefreshedAt != nil && activity.At.Sub(*session.LastRefreshedAt) < cfg.RapidRefreshWindow {
+		signals = append(signals, models.RiskSignalRapidRefresh)
+	}
+	return signals
+}
+
+// changed reports whether both values are known and differ.
+func changed(bound, current string) bool {
+	return bound != "" && current != "" && bound != current
+}
+
+func withinUsualHours(at time.Time, start, end int) bool {
+	if start == end {
+		return true
+	}
+	hour := at.UTC().Hour()
+	if start < end {
+		return hour >= start && hour < end
+	}
+	return hour >= start || hour < end
+}
+
+// assessRefreshRisk scores a refresh of session. It emits session_risk_elevated
+// when the score crosses the threshold from below, and with step-up enabled
+// returns invalid_grant for high risk refreshes. Disabled scoring yields a zero score.
+func (s *Service) assessRefreshRisk(ctx context.Context, session *models.Session) (sessionRisk, error) {
+	cfg := s.RiskScoring
+	if !cfg.Enabled {
+		return sessionRisk{}, nil
+	}
+
+	activity := refreshActivity{
+		DeviceID:    requestcontext.DeviceID(ctx),
+		Fingerprint: requestcontext.DeviceFingerprint(ctx),
+		Location:    s.approximateLocation(ctx),
+		At:          requestcontext.Now(ctx),
+	}
+	signals := detectRiskSignals(session, activity, cfg)
+	risk := sessionRisk{
+		Signals:  signals,
+		Score:    models.ScoreRisk(signals, cfg.Weights),
+		Location: activity.Location,
+	}
+
+	if risk.Score < cfg.Threshold {
+		return risk, nil
+	}
+	if session.RiskScore < cfg.Threshold {
+		s.emitSessionRiskElevated(ctx, session, risk)
+	}
+	if cfg.StepUp {
+		return risk, dErrors.New(dErrors.CodeInvalidGrant, "re-authentication required")
+	}
+	return risk, nil
+}
+
+// emitSessionRiskElevated records the signals behind a high risk refresh.
+func (s *Service) emitSessionRiskElevated(ctx context.Context, session *models.Session, risk sessionRisk) {
+	reasons := make([]string, len(risk.Signals))
+	for i, signal := range risk.Signals {
+		reasons[i] = string(signal)
+	}
+	if s.logger != nil {
+		s.logger.WarnContext(ctx, string(audit.EventSessionRiskElevated),
+			"session_id", session.ID.String(),
+			"user_id", session.UserID.String(),
+			"risk_score", risk.Score,
+			"signals", reasons,
+		)
+	}
+	if s.auditPublisher == nil {
+		return
+	}
+	s.auditPublisher.Emit(ctx, audit.SecurityEvent{
+		Subject:   session.UserID.String(),
+		UserID:    session.UserID,
+		Action:    string(audit.EventSessionRiskElevated),
+		Reason:    strings.Join(reasons, ","),
+		IP:        privacy.AnonymizeIP(requestcontext.ClientIP(ctx)),
+		Device:    session.DeviceDisplayName,
+		Location:  risk.Location,
+		RequestID: requestcontext.RequestID(ctx),
+		Severity:  audit.SeverityWarning,
+	})
+}
@@ -0,0 +1,247 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/mock/gomock"
+
+	"credo/internal/auth/models"
+	id "credo/pkg/domain"
+	dErrors "credo/pkg/domain-errors"
+	"credo/pkg/platform/audit"
+	"credo/pkg/requestcontext"
+)
+
+type fixedLocation string
+
+func (l fixedLocation) ApproximateLocation(context.Context, string) string { return string(l) }
+
+// TestRefreshRiskScoring tests activity risk scoring on refresh (PRD-027 FR-1).
+//
+// Justification: Scores depend on weights, signal composition, and the stored
+// score from the previous refresh. E2E refresh scenarios run with scoring
+// disabled and cannot shape device, location, or timing signals.
+func (s *ServiceSuite) TestRefreshRiskScoring() {
+	now := time.Date(2026, 3, 10, 3, 0, 0, 0, time.UTC) // 03:00 UTC, outside 07-22
+	lastRefresh := now.Add(-20 * time.Second)
+	riskCfg := RiskScoringConfig{Enabled: true, UsualHoursStart: 7, UsualHoursEnd: 22}
+	riskCfg.applyDefaults()
+
+	baseSession := models.Session{
+		DeviceID:            "device-a",
+		ApproximateLocation: "Berlin, DE",
+	}
+
+	s.Run("signals compose into weighted scores", func() {
+		for _, tc := range []struct {
+			name     string
+			session  func(*models.Session)
+			activity refreshActivity
+			want     []models.RiskSignal
+			score    int
+		}{
+			{
+				name:     "matching activity scores zero",
+				activity: refreshActivity{DeviceID: "device-a", Location: "Berlin, DE", At: now.Add(10 * time.Hour)},
+				score:    0,
+			},
+			{
+				name:     "new device and location reach the high band",
+				activity: refreshActivity{DeviceID: "device-b", Location: "Lagos, NG", At: now.Add(10 * time.Hour)},
+				want:     []models.RiskSignal{models.RiskSignalNewDevice, models.RiskSignalNewLocation},
+				score:    60,
+			},
+			{
+				name:     "rapid refresh at an unusual hour",
+				session:  func(sess *models.Session) { sess.LastRefreshedAt = &lastRefresh },
+				activity: refreshActivity{DeviceID: "device-a", At: now},
+				want:     []models.RiskSignal{models.RiskSignalUnusualTime, models.RiskSignalRapidRefresh},
+				score:    30,
+			},
+			{
+				name:     "every signal caps at the maximum score",
+				session:  func(sess *models.Session) { sess.LastRefreshedAt = &lastRefresh },
+				activity: refreshActivity{DeviceID: "device-b", Location: "Lagos, NG", At: now},
+				want: []models.RiskSignal{
+					models.RiskSignalNewDevice, models.RiskSignalNewLocation,
+					models.RiskSignalUnusualTime, models.RiskSignalRapidRefresh,
+				},
+				score: 90,
+			},
+			{
+				name:     "missing context is not a change",
+				activity: refreshActivity{At: now.Add(10 * time.Hour)},
+				score:    0,
+			},
+		} {
+			s.Run(tc.name, func() {
+				sess := baseSession
+				if tc.session != nil {
+					tc.session(&sess)
+				}
+				signals := detectRiskSignals(&sess, tc.activity, riskCfg)
+				s.Equal(tc.want, signals)
+				s.Equal(tc.score, models.ScoreRisk(signals, riskCfg.Weights))
+			})
+		}
+	})
+
+	s.Run("custom weights replace the defaults", func() {
+		weights := models.RiskWeights{models.RiskSignalRapidRefresh: 80, models.RiskSignalUnusualTime: 40}
+		signals := []models.RiskSignal{models.RiskSignalRapidRefresh, models.RiskSignalUnusualTime, models.RiskSignalRapidRefresh}
+		s.Equal(models.MaxRiskScore, models.ScoreRisk(signals, weights))
+		s.Equal(0, models.ScoreRisk([]models.RiskSignal{models.RiskSignalNewDevice}, weights))
+	})
+
+	sessionID := id.SessionID(uuid.New())
+	userID := id.UserID(uuid.New())
+	clientUUID := id.ClientID(uuid.New())
+	tenantID := id.TenantID(uuid.New())
+	clientID := "client-123"
+	refreshTokenString := "ref_risk"
+	mockClient, mockTenant := s.newTestClient(tenantID, clientUUID)
+	mockUser := s.newTestUser(userID, tenantID)
+
+	enableRisk := func(stepUp bool) {
+		prevRisk, prevLocations := s.service.RiskScoring, s.service.locations
+		cfg := riskCfg
+		cfg.StepUp = stepUp
+		s.service.RiskScoring = cfg
+		s.service.locations = fixedLocation("Lagos, NG")
+		s.T().Cleanup(func() {
+			s.service.RiskScoring = prevRisk
+			s.service.locations = prevLocations
+		})
+		s.auditStore.Clear()
+	}
+	highRiskCtx := func() context.Context {
+		ctx := requestcontext.WithTime(context.Background(), now.Add(10*time.Hour))
+		return requestcontext.WithDeviceID(ctx, "device-b")
+	}
+	newSession := func(previousScore int) *models.Session {
+		return &models.Session{
+			ID:                  sessionID,
+			UserID:              userID,
+			ClientID:            clientUUID,
+			TenantID:            tenantID,
+			RequestedScope:      []string{"openid"},
+			Status:              models.SessionStatusActive,
+			DeviceID:            "device-a",
+			ApproximateLocation: "Berlin, DE",
+			RiskScore:           previousScore,
+			CreatedAt:           now.Add(-time.Hour),
+			ExpiresAt:           now.Add(23 * time.Hour),
+		}
+	}
+	expectValidation := func(sess *models.Session) *models.RefreshTokenRecord {
+		refreshRec := &models.RefreshTokenRecord{
+			Token:     refreshTokenString,
+			SessionID: sessionID,
+			CreatedAt: now.Add(-time.Hour),
+			ExpiresAt: now.Add(29 * 24 * time.Hour),
+		}
+		s.mockRefreshStore.EXPECT().Find(gomock.Any(), refreshTokenString).Return(refreshRec, nil)
+		s.mockSessionStore.EXPECT().FindByID(gomock.Any(), sessionID).Return(sess, nil)
+		s.mockClientResolver.EXPECT().ResolveClient(gomock.Any(), clientID).Return(mockClient, mockTenant, nil)
+		s.mockUserStore.EXPECT().FindByID(gomock.Any(), userID).Return(mockUser, nil)
+		return refreshRec
+	}
+	expectRefreshTx := func(sess *models.Session, refreshRec *models.RefreshTokenRecord) *models.Session {
+		stored := *sess
+		s.mockRefreshStore.EXPECT().Execute(gomock.Any(), refreshTokenString, gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ string, validate func(*models.RefreshTokenRecord) error, mutate func(*models.RefreshTokenRecord)) (*models.RefreshTokenRecord, error) {
+				if err := validate(refreshRec); err != nil {
+					return refreshRec, err
+				}
+				mutate(refreshRec)
+				return refreshRec, nil
+			})
+		s.expectTokenGeneration(userID, sessionID, clientUUID, tenantID, sess.RequestedScope)
+		s.mockSessionStore.EXPECT().Execute(gomock.Any(), sessionID, gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ id.SessionID, validate func(*models.Session) error, mutate func(*models.Session)) (*models.Session, error) {
+				if err := validate(&stored); err != nil {
+					return nil, err
+				}
+				mutate(&stored)
+				return &stored, nil
+			})
+		s.mockRefreshStore.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+		return &stored
+	}
+	riskEvents := func() []audit.Event {
+		s.Require().NoError(s.auditPublisher.Flush(context.Background()))
+		all, err := s.auditStore.ListAll(context.Background())
+		s.Require().NoError(err)
+		var out []audit.Event
+		for _, e := range all {
+			if e.Action == string(audit.EventSessionRiskElevated) {
+				out = append(out, e)
+			}
+		}
+		return out
+	}
+	req := func() *models.TokenRequest {
+		return &models.TokenRequest{
+			GrantType:    string(models.GrantRefreshToken),
+			RefreshToken: refreshTokenString,
+			ClientID:     clientID,
+		}
+	}
+
+	s.Run("crossing the threshold records the score and emits an event", func() {
+		enableRisk(false)
+		sess := newSession(0)
+		stored := expectRefreshTx(sess, expectValidation(sess))
+
+		result, err := s.service.Token(highRiskCtx(), req())
+		s.Require().NoError(err)
+		s.NotNil(result)
+		s.Equal(60, stored.RiskScore)
+
+		events := riskEvents()
+		s.Require().Len(events, 1)
+		s.Equal(userID, events[0].UserID)
+		s.Equal("new_device,new_location", events[0].Reason)
+		s.Equal("Lagos, NG", events[0].Metadata[audit.MetadataLocation])
+	})
+
+	s.Run("staying above the threshold does not repeat the event", func() {
+		enableRisk(false)
+		sess := newSession(60)
+		expectRefreshTx(sess, expectValidation(sess))
+
+		_, err := s.service.Token(highRiskCtx(), req())
+		s.Require().NoError(err)
+		s.Empty(riskEvents())
+	})
+
+	s.Run("low risk refresh lowers the stored score", func() {
+		enableRisk(false)
+		s.service.locations = fixedLocation("Berlin, DE")
+		sess := newSession(60)
+		stored := expectRefreshTx(sess, expectValidation(sess))
+
+		ctx := requestcontext.WithDeviceID(requestcontext.WithTime(context.Background(), now.Add(10*time.Hour)), "device-a")
+		_, err := s.service.Token(ctx, req())
+		s.Require().NoError(err)
+		s.Equal(0, stored.RiskScore)
+		s.Empty(riskEvents())
+	})
+
+	s.Run("step-up rejects high risk refreshes before consuming the token", func() {
+		enableRisk(true)
+		sess := newSession(0)
+		expectValidation(sess)
+		s.expectTokenGeneration(userID, sessionID, clientUUID, tenantID, sess.RequestedScope)
+		// No refresh token Execute: the token stays valid for a lower risk retry.
+
+		result, err := s.service.Token(highRiskCtx(), req())
+		s.Require().Error(err)
+		s.Nil(result)
+		s.True(dErrors.HasCode(err, dErrors.CodeInvalidGrant))
+		s.Contains(err.Error(), "re-authentication required")
+		s.Len(riskEvents(), 1)
+	})
+}
@@ -29,6 +29,7 @@ type ServiceSuite struct {
 	mockRefreshStore   *mocks.MockRefreshTokenStore
 	mockJWT            *mocks.MockTokenGenerator
 	auditPublisher     *security.Publisher
+	auditStore         *auditmemory.InMemoryStore
 	mockTRL            *mocks.MockTokenRevocationList
 	mockClientResolver *mocks.MockClientResolver
 	service            *Service
@@ -41,7 +42,8 @@ func (s *ServiceSuite) SetupTest() {
 	s.mockCodeStore = mocks.NewMockAuthCodeStore(s.ctrl)
 	s.mockRefreshStore = mocks.NewMockRefreshTokenStore(s.ctrl)
 	s.mockJWT = mocks.NewMockTokenGenerator(s.ctrl)
-	s.auditPublisher = security.New(auditmemory.NewInMemoryStore())
+	s.auditStore = auditmemory.NewInMemoryStore()
+	s.auditPublisher = security.New(s.auditStore)
 	s.mockTRL = mocks.NewMockTokenRevocationList(s.ctrl)
 	s.mockClientResolver = mocks.NewMockClientResolver(s.ctrl)
 
@@ -2,6 +2,8 @@ package service
 
 import (
 	"context"
+	"fmt"
+	"slices"
 
 	"credo/internal/auth/models"
 	"credo/internal/auth/types"
@@ -12,6 +14,7 @@ import (
 // Currently supported grant types are:
 // - authorization_code: exchanges an authorization code for tokens
 // - refresh_token: issues new tokens using a valid refresh token
+// - token-exchange (RFC 8693): exchanges an access token for a delegated one
 // The function validates the request, routes to the appropriate flow handler,
 // and returns the token result or an error.
 func (s *Service) Token(ctx context.Context, req *models.TokenRequest) (*models.TokenResult, error) {
@@ -29,6 +32,8 @@ func (s *Service) Token(ctx context.Context, req *models.TokenRequest) (*models.
 		return s.exchangeAuthorizationCode(ctx, req)
 	case string(models.GrantRefreshToken):
 		return s.refreshWithRefreshToken(ctx, req)
+	case string(models.GrantTokenExchange):
+		return s.exchangeSubjectToken(ctx, req)
 	default:
 		return nil, dErrors.New(dErrors.CodeBadRequest, "unsupported grant_type")
 	}
@@ -60,7 +65,7 @@ func (s *Service) resolveTokenContext(
 		return nil, err
 	}
 	if !user.IsActive() {
-		return nil, dErrors.New(dErrors.CodeForbidden, "user inactive")
+		return nil, dErrors.NewWithReason(dErrors.CodeForbidden, models.RejectionReasonUserInactive, "user inactive")
 	}
 
 	return &tokenContext{
@@ -80,11 +85,14 @@ type tokenContext struct {
 
 // prepareTokenFlow validates the session context and generates token artifacts.
 // This is shared between authorization code exchange and refresh token flows.
+// scopes is the access token's scope, which the refresh flow may narrow.
 // It consolidates: resolveTokenContext + client active check + generateTokenArtifacts.
 func (s *Service) prepareTokenFlow(
 	ctx context.Context,
 	session *models.Session,
 	clientID string,
+	resources []string,
+	scopes []string,
 	sessionIDPtr *string,
 	flow TokenFlow,
 ) (*tokenContext, *tokenArtifacts, error) {
@@ -94,14 +102,33 @@ func (s *Service) prepareTokenFlow(
 	}
 
 	if !tc.Client.IsActive() {
-		return nil, nil, dErrors.New(dErrors.CodeForbidden, "client is not active")
+		return nil, nil, dErrors.NewWithReason(dErrors.CodeForbidden, models.RejectionReasonClientInactive, "client is not active")
+	}
+	if err := s.requireDPoP(ctx, tc.Client.ID); err != nil {
+		return nil, nil, err
+	}
+	if len(resources) > 0 {
+		if err := validateRequestedResources(resources, tc.Client.AllowedResources); err != nil {
+			return nil, nil, err
+		}
 	}
 
 	// Generate tokens BEFORE entering transaction to avoid holding mutex during JWT generation
-	artifacts, err := s.generateTokenArtifacts(ctx, session)
+	artifacts, err := s.generateTokenArtifacts(ctx, session, scopes, resources)
 	if err != nil {
 		return nil, nil, s.handleTokenError(ctx, dErrors.Wrap(err, dErrors.CodeInternal, "failed to generate tokens"), clientID, sessionIDPtr, flow)
 	}
 
 	return tc, artifacts, nil
 }
+
+// validateRequestedResources rejects any resource indicator outside the client's
+// allowlist (RFC 8707 §2). A client without an allowlist cannot target resources.
+func validateRequestedResources(requested, allowed []string) error {
+	for _, resource := range requested {
+		if !slices.Contains(allowed, resource) {
+			return dErrors.New(dErrors.CodeInvalidTarget, fmt.Sprintf("resource '%s' not allowed for client", resource))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,176 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"credo/internal/auth/models"
+	"credo/internal/auth/types"
+	jwttoken "credo/internal/jwt_token"
+	id "credo/pkg/domain"
+	dErrors "credo/pkg/domain-errors"
+	"credo/pkg/platform/audit"
+	"credo/pkg/platform/sentinel"
+	"credo/pkg/requestcontext"
+)
+
+// exchangeSubjectToken handles RFC 8693 token exchange: the requesting client
+// trades a user's access token for an access token issued to itself.
+//
+// Exchange policy:
+//   - the client must be confidential and authenticate with its client secret
+//   - the client must list the token-exchange grant in its allowed grants
+//   - the subject token must be valid, unrevoked, and from an active session in the client's tenant
+//   - scope can only narrow: every requested scope must be in the subject token and allowed for the client
+//   - audience changes go through resource indicators on the client's allowlist
+//   - a DPoP-bound subject token can only be exchanged with a proof from the same key
+//
+// When another client exchanges the token, the new token's act claim names it,
+// nesting any earlier actors. Exchange issues neither an ID token nor a refresh
+// token, and the new token stays tied to the subject token's session.
+func (s *Service) exchangeSubjectToken(ctx context.Context, req *models.TokenRequest) (*models.TokenResult, error) {
+	client, tenant, err := s.clientResolver.ResolveClient(ctx, req.ClientID)
+	if err != nil {
+		return nil, s.handleTokenError(ctx, err, req.ClientID, nil, TokenFlowExchange)
+	}
+	if !client.IsActive() {
+		return nil, dErrors.NewWithReason(dErrors.CodeForbidden, models.RejectionReasonClientInactive, "client is not active")
+	}
+	if err := s.clientResolver.VerifyClientSecret(ctx, req.ClientID, req.ClientSecret); err != nil {
+		s.authFailure(ctx, "client_authentication_failed", false, "client_id", req.ClientID)
+		return nil, dErrors.New(dErrors.CodeInvalidClient, "client authentication failed")
+	}
+	if !client.CanUseGrant(models.GrantTokenExchange) {
+		s.authFailure(ctx, "token_exchange_not_allowed", false, "client_id", req.ClientID)
+		return nil, dErrors.New(dErrors.CodeUnauthorizedClient, "client is not allowed to exchange tokens")
+	}
+	if err := s.requireDPoP(ctx, client.ID); err != nil {
+		return nil, err
+	}
+
+	subject, session, err := s.validateSubjectToken(ctx, req.SubjectToken, tenant)
+	if err != nil {
+		return nil, s.handleTokenError(ctx, err, req.ClientID, nil, TokenFlowExchange)
+	}
+	if subject.Confirmation != nil && subject.Confirmation.JKT != requestcontext.DPoPThumbprint(ctx) {
+		return nil, dErrors.New(dErrors.CodeInvalidDPoPProof, "subject_token is DPoP-bound; proof of its key is required")
+	}
+
+	scopes, err := exchangeScopes(req.Scopes(), subject.Scope, client.AllowedScopes)
+	if err != nil {
+		return nil, err
+	}
+	if len(req.Resource) > 0 {
+		if err := validateRequestedResources(req.Resource, client.AllowedResources); err != nil {
+			return nil, err
+		}
+	}
+	actors := delegationChain(client, subject)
+
+	accessToken, _, err := s.jwt.GenerateAccessTokenWithJTI(ctx, session.UserID, session.ID, client.ID, tenant.ID, scopes, subject.APIVersion(),
+		jwttoken.WithActors(actors), jwttoken.WithResources(req.Resource))
+	if err != nil {
+		return nil, dErrors.Wrap(err, dErrors.CodeInternal, "failed to generate access token")
+	}
+	tokenType := s.jwt.TokenType()
+	if requestcontext.DPoPThumbprint(ctx) != "" {
+		tokenType = models.TokenTypeDPoP
+	}
+
+	s.logAudit(ctx,
+		string(audit.EventTokenIssued),
+		"grant_type", string(models.GrantTokenExchange),
+		"session_id", session.ID.String(),
+		"user_id", session.UserID.String(),
+		"client_id", client.ID.String(),
+		"subject_client_id", subject.ClientID,
+		"actors", strings.Join(actors, " "),
+	)
+	s.incrementTokenRequests()
+
+	return &models.TokenResult{
+		AccessToken:     accessToken,
+		TokenType:       tokenType,
+		ExpiresIn:       int(s.TokenTTL.Seconds()),
+		Scope:           strings.Join(scopes, " "),
+		IssuedTokenType: models.TokenTypeURIAccessToken,
+	}, nil
+}
+
+// validateSubjectToken verifies the subject token and returns its claims with
+// the session it belongs to. The token must be valid and unrevoked, for the
+// tenant of the requesting client, and for an active session of an active user.
+func (s *Service) validateSubjectToken(ctx context.Context, token string, tenant *types.ResolvedTenant) (*jwttoken.AccessTokenClaims, *models.Session, error) {
+	claims, err := s.jwt.ValidateToken(token)
+	if err != nil {
+		return nil, nil, dErrors.New(dErrors.CodeInvalidGrant, "invalid subject_token")
+	}
+	if claims.TenantID != tenant.ID.String() {
+		return nil, nil, dErrors.New(dErrors.CodeInvalidGrant, "tenant mismatch")
+	}
+	revoked, err := s.trl.IsRevoked(ctx, claims.ID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("check subject_token revocation: %w", err)
+	}
+	if revoked {
+		return nil, nil, dErrors.New(dErrors.CodeInvalidGrant, "subject_token has been revoked")
+	}
+
+	sessionID, err := id.ParseSessionID(claims.SessionID)
+	if err != nil {
+		return nil, nil, dErrors.New(dErrors.CodeInvalidGrant, "invalid subject_token")
+	}
+	session, err := s.sessions.FindByID(ctx, sessionID)
+	if err != nil {
+		if errors.Is(err, sentinel.ErrNotFound) {
+			return nil, nil, dErrors.New(dErrors.CodeInvalidGrant, "invalid subject_token")
+		}
+		return nil, nil, err
+	}
+	if session.IsRevoked() {
+		return nil, nil, dErrors.NewWithReason(dErrors.CodeInvalidGrant, models.RejectionReasonSessionRevoked, "session has been revoked")
+	}
+	if !session.IsActive() || !session.ExpiresAt.After(requestcontext.Now(ctx)) {
+		return nil, nil, dErrors.New(dErrors.CodeInvalidGrant, "session not active")
+	}
+
+	user, err := s.users.FindByID(ctx, session.UserID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !user.IsActive() {
+		return nil, nil, dErrors.NewWithReason(dErrors.CodeForbidden, models.RejectionReasonUserInactive, "user inactive")
+	}
+	return claims, session, nil
+}
+
+// exchangeScopes resolves the scope of an exchanged token. With no requested
+// scope the subject token's scope is kept; either way the result must stay
+// within both the subject token's scope and the client's allowed scopes.
+func exchangeScopes(requested, granted, allowed []string) ([]string, error) {
+	if len(requested) == 0 {
+		requested = granted
+	}
+	for _, scope := range requested {
+		if !slices.Contains(granted, scope) {
+			return nil, dErrors.New(dErrors.CodeInvalidScope, fmt.Sprintf("scope '%s' exceeds the subject_token scope", scope))
+		}
+		if !slices.Contains(allowed, scope) {
+			return nil, dErrors.New(dErrors.CodeInvalidScope, fmt.Sprintf("scope '%s' not allowed for client", scope))
+		}
+	}
+	return requested, nil
+}
+
+// delegationChain returns the actors for the exchanged token, current actor
+// first. A client exchanging its own token only downscopes it and adds no actor.
+func delegationChain(client *types.ResolvedClient, subject *jwttoken.AccessTokenClaims) []string {
+	previous := subject.Actor.Chain()
+	if subject.ClientID == client.ID.String() {
+		return previous
+	}
+	return append([]string{client.ID.String()}, previous...)
+}
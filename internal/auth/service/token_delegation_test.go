@@ -0,0 +1,212 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"credo/internal/auth/models"
+	"credo/internal/auth/types"
+	jwttoken "credo/internal/jwt_token"
+	id "credo/pkg/domain"
+	dErrors "credo/pkg/domain-errors"
+
+	"github.com/google/uuid"
+	"go.uber.org/mock/gomock"
+)
+
+// TestTokenDelegation tests RFC 8693 token exchange at the token endpoint.
+//
+// AGENTS.MD JUSTIFICATION: The exchange policy (client authentication, grant
+// allowlist, downscoping, act chaining) decides who may act for a user; each rule is pinned
+// here because feature tests only cover the happy path.
+func (s *ServiceSuite) TestTokenDelegation() {
+	sessionID := id.SessionID(uuid.New())
+	userID := id.UserID(uuid.New())
+	tenantID := id.TenantID(uuid.New())
+	frontendID := id.ClientID(uuid.New())
+	backendID := id.ClientID(uuid.New())
+	subjectToken := "subject-access-token"
+
+	newBackend := func(grants ...id.GrantType) (*types.ResolvedClient, *types.ResolvedTenant) {
+		client, tenant := s.newTestClient(tenantID, backendID)
+		client.OAuthClientID = "backend"
+		client.AllowedScopes = []string{"openid", "profile", "billing:read"}
+		client.AllowedResources = []string{"https://billing.example.com"}
+		client.AllowedGrants = grants
+		return client, tenant
+	}
+	newSubject := func() *jwttoken.AccessTokenClaims {
+		claims := &jwttoken.AccessTokenClaims{
+			UserID:    userID.String(),
+			SessionID: sessionID.String(),
+			ClientID:  frontendID.String(),
+			TenantID:  tenantID.String(),
+			Scope:     []string{"openid", "profile", "billing:read"},
+		}
+		claims.ID = "subject-jti"
+		return claims
+	}
+	newRequest := func(scope string, resources ...string) *models.TokenRequest {
+		return &models.TokenRequest{
+			GrantType:        string(models.GrantTokenExchange),
+			ClientID:         "backend",
+			ClientSecret:     "backend-secret",
+			SubjectToken:     subjectToken,
+			SubjectTokenType: models.TokenTypeURIAccessToken,
+			Scope:            scope,
+			Resource:         resources,
+		}
+	}
+	expectClient := func(client *types.ResolvedClient, tenant *types.ResolvedTenant) {
+		s.mockClientResolver.EXPECT().ResolveClient(gomock.Any(), "backend").Return(client, tenant, nil)
+		s.mockClientResolver.EXPECT().VerifyClientSecret(gomock.Any(), "backend", "backend-secret").Return(nil)
+	}
+	expectValidSubject := func(claims *jwttoken.AccessTokenClaims) {
+		s.mockJWT.EXPECT().ValidateToken(subjectToken).Return(claims, nil)
+		s.mockTRL.EXPECT().IsRevoked(gomock.Any(), claims.ID).Return(false, nil)
+		s.mockSessionStore.EXPECT().FindByID(gomock.Any(), sessionID).Return(&models.Session{
+			ID:             sessionID,
+			UserID:         userID,
+			ClientID:       frontendID,
+			TenantID:       tenantID,
+			RequestedScope: claims.Scope,
+			Status:         models.SessionStatusActive,
+			CreatedAt:      time.Now().Add(-time.Hour),
+			ExpiresAt:      time.Now().Add(time.Hour),
+		}, nil)
+		s.mockUserStore.EXPECT().FindByID(gomock.Any(), userID).Return(s.newTestUser(userID, tenantID), nil)
+	}
+
+	s.Run("downscoped delegation to another audience carries act", func() {
+		client, tenant := newBackend(models.GrantTokenExchange)
+		expectClient(client, tenant)
+		expectValidSubject(newSubject())
+		var actors, resources []string
+		s.mockJWT.EXPECT().GenerateAccessTokenWithJTI(gomock.Any(), userID, sessionID, backendID, tenantID, []string{"billing:read"}, id.APIVersionV1, gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ id.UserID, _ id.SessionID, _ id.ClientID, _ id.TenantID, _ []string, _ id.APIVersion, opts ...jwttoken.AccessTokenOption) (string, string, error) {
+				options := applyAccessTokenOptions(opts)
+				actors, resources = options.Actors, options.Resources
+				return "exchanged-access-token", "exchanged-jti", nil
+			})
+		s.mockJWT.EXPECT().TokenType().Return("Bearer")
+
+		result, err := s.service.Token(context.Background(), newRequest("billing:read", "https://billing.example.com"))
+		s.Require().NoError(err)
+		s.Equal("exchanged-access-token", result.AccessToken)
+		s.Equal(models.TokenTypeURIAccessToken, result.IssuedTokenType)
+		s.Equal("billing:read", result.Scope)
+		s.Empty(result.RefreshToken, "exchange issues no refresh token")
+		s.Empty(result.IDToken)
+		s.Equal([]string{backendID.String()}, actors)
+		s.Equal([]string{"https://billing.example.com"}, resources)
+	})
+
+	s.Run("earlier actors are nested under the new actor", func() {
+		client, tenant := newBackend(models.GrantTokenExchange)
+		expectClient(client, tenant)
+		subject := newSubject()
+		subject.Actor = &jwttoken.Actor{Subject: "gateway"}
+		expectValidSubject(subject)
+		var actors []string
+		s.mockJWT.EXPECT().GenerateAccessTokenWithJTI(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, _ id.UserID, _ id.SessionID, _ id.ClientID, _ id.TenantID, _ []string, _ id.APIVersion, opts ...jwttoken.AccessTokenOption) (string, string, error) {
+				actors = applyAccessTokenOptions(opts).Actors
+				return "exchanged-access-token", "exchanged-jti", nil
+			})
+		s.mockJWT.EXPECT().TokenType().Return("Bearer")
+
+		_, err := s.service.Token(context.Background(), newRequest(""))
+		s.Require().NoError(err)
+		s.Equal([]string{backendID.String(), "gateway"}, actors)
+	})
+
+	s.Run("client without the token-exchange grant is rejected", func() {
+		client, tenant := newBackend(models.GrantAuthorizationCode, models.GrantRefreshToken)
+		expectClient(client, tenant)
+
+		result, err := s.service.Token(context.Background(), newRequest("billing:read"))
+		s.Require().Error(err)
+		s.Nil(result)
+		s.True(dErrors.HasCode(err, dErrors.CodeUnauthorizedClient))
+	})
+
+	s.Run("scope beyond the subject token is rejected", func() {
+		client, tenant := newBackend(models.GrantTokenExchange)
+		client.AllowedScopes = append(client.AllowedScopes, "billing:write")
+		expectClient(client, tenant)
+		expectValidSubject(newSubject())
+
+		result, err := s.service.Token(context.Background(), newRequest("billing:read billing:write"))
+		s.Require().Error(err)
+		s.Nil(result)
+		s.True(dErrors.HasCode(err, dErrors.CodeInvalidScope))
+		s.Contains(err.Error(), "billing:write")
+	})
+
+	s.Run("wrong client secret is rejected before the subject token is read", func() {
+		client, tenant := newBackend(models.GrantTokenExchange)
+		s.mockClientResolver.EXPECT().ResolveClient(gomock.Any(), "backend").Return(client, tenant, nil)
+		s.mockClientResolver.EXPECT().VerifyClientSecret(gomock.Any(), "backend", "backend-secret").
+			Return(dErrors.New(dErrors.CodeInvalidClient, "invalid client credentials"))
+
+		result, err := s.service.Token(context.Background(), newRequest("billing:read"))
+		s.Require().Error(err)
+		s.Nil(result)
+		s.True(dErrors.HasCode(err, dErrors.CodeInvalidClient))
+	})
+
+	s.Run("missing client secret is rejected before lookup", func() {
+		req := newRequest("")
+		req.ClientSecret = ""
+
+		result, err := s.service.Token(context.Background(), req)
+		s.Require().Error(err)
+		s.Nil(result)
+		s.True(dErrors.HasCode(err, dErrors.CodeValidation))
+	})
+
+	s.Run("DPoP-bound subject token without a matching proof is rejected", func() {
+		client, tenant := newBackend(models.GrantTokenExchange)
+		expectClient(client, tenant)
+		subject := newSubject()
+		subject.Confirmation = &jwttoken.Confirmation{JKT: "subject-key-thumbprint"}
+		expectValidSubject(subject)
+
+		result, err := s.service.Token(context.Background(), newRequest(""))
+		s.Require().Error(err)
+		s.Nil(result)
+		s.True(dErrors.HasCode(err, dErrors.CodeInvalidDPoPProof))
+	})
+
+	s.Run("revoked subject token is rejected", func() {
+		client, tenant := newBackend(models.GrantTokenExchange)
+		expectClient(client, tenant)
+		subject := newSubject()
+		s.mockJWT.EXPECT().ValidateToken(subjectToken).Return(subject, nil)
+		s.mockTRL.EXPECT().IsRevoked(gomock.Any(), subject.ID).Return(true, nil)
+
+		result, err := s.service.Token(context.Background(), newRequest(""))
+		s.Require().Error(err)
+		s.Nil(result)
+		s.True(dErrors.HasCode(err, dErrors.CodeInvalidGrant))
+	})
+
+	s.Run("subject_token_type other than access token is rejected before lookup", func() {
+		req := newRequest("")
+		req.SubjectTokenType = "urn:ietf:params:oauth:token-type:refresh_token"
+
+		result, err := s.service.Token(context.Background(), req)
+		s.Require().Error(err)
+		s.Nil(result)
+		s.True(dErrors.HasCode(err, dErrors.CodeInvalidRequest))
+	})
+}
+
+// applyAccessTokenOptions resolves the per-token options a mock generator received.
+func applyAccessTokenOptions(opts []jwttoken.AccessTokenOption) jwttoken.AccessTokenOptions {
+	var options jwttoken.AccessTokenOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+	return options
+}
@@ -39,7 +39,7 @@ func (s *Service) exchangeAuthorizationCode(ctx context.Context, req *models.Tok
 		return nil, s.handleTokenError(ctx, err, req.ClientID, &sessionID, TokenFlowCode)
 	}
 
-	tc, artifacts, err := s.prepareTokenFlow(ctx, session, req.ClientID, &sessionID, TokenFlowCode)
+	tc, artifacts, err := s.prepareTokenFlow(ctx, session, req.ClientID, req.Resource, session.RequestedScope, &sessionID, TokenFlowCode)
 	if err != nil {
 		return nil, err
 	}
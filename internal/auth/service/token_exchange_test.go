@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"credo/internal/auth/models"
+	jwttoken "credo/internal/jwt_token"
 	id "credo/pkg/domain"
 	dErrors "credo/pkg/domain-errors"
 
@@ -129,6 +130,20 @@ func (s *ServiceSuite) TestTokenExchangeFlow_ValidationAndErrorMapping() {
 		s.mockUserStore.EXPECT().FindByID(gomock.Any(), userID).Return(mockUser, nil)
 	}
 
+	s.Run("DPoP-required client without proof is rejected", func() {
+		req := baseReq
+		codeRec := *validCodeRecord
+		sess := *validSession
+		setupPreTx(req, codeRec, sess)
+		s.service.DPoPRequiredClients = []id.ClientID{mockClient.ID}
+		defer func() { s.service.DPoPRequiredClients = nil }()
+
+		result, err := s.service.Token(context.Background(), &req)
+		s.Require().Error(err)
+		s.Nil(result)
+		s.True(dErrors.HasCode(err, dErrors.CodeInvalidDPoPProof))
+	})
+
 	s.Run("JWT generation error: access token generation fails", func() {
 		req := baseReq
 		codeRec := *validCodeRecord
@@ -183,3 +198,96 @@ func (s *ServiceSuite) TestTokenExchangeFlow_ValidationAndErrorMapping() {
 		s.Contains(err.Error(), "failed to generate tokens")
 	})
 }
+
+// TestTokenExchangeFlow_ResourceIndicators verifies RFC 8707 resource handling:
+// allowlisted resources reach token generation and others are rejected with
+// invalid_target before any token is minted.
+func (s *ServiceSuite) TestTokenExchangeFlow_ResourceIndicators() {
+	sessionID := id.SessionID(uuid.New())
+	userID := id.UserID(uuid.New())
+	tenantID := id.TenantID(uuid.New())
+	clientUUID := id.ClientID(uuid.New())
+	code := "authz_12345"
+	redirectURI := "https://client.app/callback"
+
+	mockClient, mockTenant := s.newTestClient(tenantID, clientUUID)
+	mockClient.AllowedResources = []string{"https://api.example.com", "https://billing.example.com"}
+	mockUser := s.newTestUser(userID, tenantID)
+
+	newRequest := func(resources ...string) *models.TokenRequest {
+		return &models.TokenRequest{
+			GrantType:   string(models.GrantAuthorizationCode),
+			Code:        code,
+			RedirectURI: redirectURI,
+			ClientID:    "client-123",
+			Resource:    resources,
+		}
+	}
+	setupPreTx := func() {
+		s.mockCodeStore.EXPECT().FindByCode(gomock.Any(), code).Return(&models.AuthorizationCodeRecord{
+			Code:        code,
+			SessionID:   sessionID,
+			RedirectURI: redirectURI,
+			ExpiresAt:   time.Now().Add(5 * time.Minute),
+			CreatedAt:   time.Now().Add(-1 * time.Minute),
+		}, nil)
+		s.mockSessionStore.EXPECT().FindByID(gomock.Any(), sessionID).Return(&models.Session{
+			ID:             sessionID,
+			UserID:         userID,
+			ClientID:       clientUUID,
+			TenantID:       tenantID,
+			RequestedScope: []string{"openid"},
+			Status:         models.SessionStatusPendingConsent,
+			CreatedAt:      time.Now().Add(-5 * time.Minute),
+			ExpiresAt:      time.Now().Add(24 * time.Hour),
+		}, nil)
+		s.mockClientResolver.EXPECT().ResolveClient(gomock.Any(), "client-123").Return(mockClient, mockTenant, nil)
+		s.mockUserStore.EXPECT().FindByID(gomock.Any(), userID).Return(mockUser, nil)
+	}
+	// captureResources records the resources handed to access token generation,
+	// then fails generation so the test stops before the transaction.
+	captureResources := func() *[]string {
+		var captured []string
+		s.mockJWT.EXPECT().GenerateAccessTokenWithJTI(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ id.UserID, _ id.SessionID, _ id.ClientID, _ id.TenantID, _ []string, _ id.APIVersion, opts ...jwttoken.AccessTokenOption) (string, string, error) {
+				captured = applyAccessTokenOptions(opts).Resources
+				return "", "", errors.New("stop after capture")
+			})
+		return &captured
+	}
+
+	s.Run("single allowlisted resource is passed to token generation", func() {
+		setupPreTx()
+		captured := captureResources()
+
+		_, err := s.service.Token(context.Background(), newRequest("https://api.example.com"))
+		s.Require().Error(err)
+		s.Equal([]string{"https://api.example.com"}, *captured)
+	})
+
+	s.Run("multiple allowlisted resources are all passed to token generation", func() {
+		setupPreTx()
+		captured := captureResources()
+
+		_, err := s.service.Token(context.Background(), newRequest("https://api.example.com", "https://billing.example.com"))
+		s.Require().Error(err)
+		s.Equal([]string{"https://api.example.com", "https://billing.example.com"}, *captured)
+	})
+
+	s.Run("resource outside the allowlist is rejected", func() {
+		setupPreTx()
+
+		result, err := s.service.Token(context.Background(), newRequest("https://api.example.com", "https://evil.example.com"))
+		s.Require().Error(err)
+		s.Nil(result)
+		s.True(dErrors.HasCode(err, dErrors.CodeInvalidTarget))
+		s.Contains(err.Error(), "https://evil.example.com")
+	})
+
+	s.Run("resource with a fragment is rejected before lookup", func() {
+		result, err := s.service.Token(context.Background(), newRequest("https://api.example.com#section"))
+		s.Require().Error(err)
+		s.Nil(result)
+		s.True(dErrors.HasCode(err, dErrors.CodeInvalidTarget))
+	})
+}
@@ -24,6 +24,8 @@ type tokenFlowTxParams struct {
 	// Artifacts are pre-generated BEFORE entering the transaction to avoid
 	// holding the mutex during CPU-intensive JWT generation.
 	Artifacts *tokenArtifacts
+	// RiskScore is recorded on the session for refreshes.
+	RiskScore int
 }
 
 // tokenFlowTxResult holds the outputs from a successful token transaction.
@@ -66,6 +68,7 @@ func (s *Service) executeTokenFlowTx(
 				}
 			} else {
 				sess.RecordRefresh(params.Now)
+				sess.ApplyRiskScore(params.RiskScore)
 			}
 			sess.ApplyTokenJTI(artifacts.accessTokenJTI)
 			sess.ApplyDeviceInfo(deviceState.DeviceID, deviceState.DeviceFingerprintHash)
@@ -3,9 +3,11 @@ package service
 import (
 	"context"
 	"fmt"
+	"slices"
 	"time"
 
 	"credo/internal/auth/models"
+	dErrors "credo/pkg/domain-errors"
 	"credo/pkg/platform/audit"
 	"credo/pkg/requestcontext"
 )
@@ -32,6 +34,10 @@ func (s *Service) refreshWithRefreshToken(ctx context.Context, req *models.Token
 	if err != nil {
 		return nil, s.handleTokenError(ctx, err, req.ClientID, nil, TokenFlowRefresh)
 	}
+	// A DPoP-bound refresh token is redeemable only with a proof from its key (RFC 9449 §5)
+	if refreshRecord.DPoPJKT != "" && refreshRecord.DPoPJKT != requestcontext.DPoPThumbprint(ctx) {
+		return nil, dErrors.New(dErrors.CodeInvalidDPoPProof, "refresh_token is DPoP-bound; proof of its key is required")
+	}
 
 	sessionID := refreshRecord.SessionID.String()
 	session, err = s.sessions.FindByID(ctx, refreshRecord.SessionID)
@@ -39,8 +45,13 @@ func (s *Service) refreshWithRefreshToken(ctx context.Context, req *models.Token
 		return nil, s.handleTokenError(ctx, err, req.ClientID, &sessionID, TokenFlowRefresh)
 	}
 
+	scopes, err := refreshScopes(req.Scopes(), session.RequestedScope)
+	if err != nil {
+		return nil, err
+	}
+
 	// Validate client and user status before issuing new tokens (PRD-026A FR-4.5.4)
-	tc, artifacts, err := s.prepareTokenFlow(ctx, session, req.ClientID, &sessionID, TokenFlowRefresh)
+	tc, artifacts, err := s.prepareTokenFlow(ctx, session, req.ClientID, req.Resource, scopes, &sessionID, TokenFlowRefresh)
 	if err != nil {
 		return nil, err
 	}
@@ -48,6 +59,11 @@ func (s *Service) refreshWithRefreshToken(ctx context.Context, req *models.Token
 	tenantID = tc.Tenant.ID.String()
 	clientID = tc.Client.ID.String()
 
+	risk, err := s.assessRefreshRisk(ctx, session)
+	if err != nil {
+		return nil, err
+	}
+
 	txErr := s.tx.RunInTx(ctx, func(stores txAuthStores) error {
 		// Step 1: Consume refresh token with replay protection
 		var err error
@@ -66,6 +82,7 @@ func (s *Service) refreshWithRefreshToken(ctx context.Context, req *models.Token
 			Now:                now,
 			ActivateOnFirstUse: false,
 			Artifacts:          artifacts,
+			RiskScore:          risk.Score,
 		})
 		if err != nil {
 			return err
@@ -90,7 +107,23 @@ func (s *Service) refreshWithRefreshToken(ctx context.Context, req *models.Token
 	)
 	s.incrementTokenRequests()
 
-	return s.buildTokenResult(artifacts, session.RequestedScope), nil
+	return s.buildTokenResult(artifacts, scopes), nil
+}
+
+// refreshScopes resolves the scope of a refreshed access token. With no
+// requested scope the original grant is kept; otherwise every requested scope
+// must be in it (RFC 6749 §6). The session keeps the original grant, so a
+// later refresh can ask for it again.
+func refreshScopes(requested, granted []string) ([]string, error) {
+	if len(requested) == 0 {
+		return granted, nil
+	}
+	for _, scope := range requested {
+		if !slices.Contains(granted, scope) {
+			return nil, dErrors.New(dErrors.CodeInvalidScope, fmt.Sprintf("scope '%s' exceeds the original grant", scope))
+		}
+	}
+	return requested, nil
 }
 
 // consumeRefreshTokenWithReplayProtection consumes a refresh token and handles replay attacks.
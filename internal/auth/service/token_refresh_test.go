@@ -112,6 +112,9 @@ func (s *ServiceSuite) TestTokenRefreshFlow() {
 		// Should get forbidden error for inactive client (client validation is after token context)
 		s.True(dErrors.HasCode(err, dErrors.CodeForbidden))
 		s.Contains(err.Error(), "client is not active")
+		var de *dErrors.Error
+		s.Require().ErrorAs(err, &de)
+		s.Equal(models.RejectionReasonClientInactive, de.Reason)
 	})
 
 	s.Run("inactive user returns forbidden - PRD-026A FR-4.5.4", func() {
@@ -134,6 +137,120 @@ func (s *ServiceSuite) TestTokenRefreshFlow() {
 		// User inactive propagates as Forbidden from token context
 		s.True(dErrors.HasCode(err, dErrors.CodeForbidden))
 		s.Contains(err.Error(), "user inactive")
+		var de *dErrors.Error
+		s.Require().ErrorAs(err, &de)
+		s.Equal(models.RejectionReasonUserInactive, de.Reason)
+	})
+
+	// RFC 6749 §6: the requested scope must not include any scope not originally granted.
+	s.Run("scope beyond the original grant returns invalid_scope", func() {
+		req := newReq()
+		req.Scope = "openid admin"
+		refreshRec := *validRefreshToken
+		sess := *validSession
+
+		s.mockRefreshStore.EXPECT().Find(gomock.Any(), refreshTokenString).Return(&refreshRec, nil)
+		s.mockSessionStore.EXPECT().FindByID(gomock.Any(), sessionID).Return(&sess, nil)
+
+		result, err := s.service.Token(context.Background(), &req)
+		s.Require().Error(err)
+		s.Nil(result)
+		s.True(dErrors.HasCode(err, dErrors.CodeInvalidScope))
+		s.Contains(err.Error(), "admin")
+	})
+
+	s.Run("narrowed scope is issued without shrinking the session grant", func() {
+		req := newReq()
+		req.Scope = "openid"
+		refreshRec := *validRefreshToken
+		sess := *validSession
+
+		s.mockRefreshStore.EXPECT().Find(gomock.Any(), refreshTokenString).Return(&refreshRec, nil)
+		s.mockSessionStore.EXPECT().FindByID(gomock.Any(), sessionID).Return(&sess, nil)
+		s.mockClientResolver.EXPECT().ResolveClient(gomock.Any(), clientID).Return(mockClient, mockTenant, nil)
+		s.mockUserStore.EXPECT().FindByID(gomock.Any(), userID).Return(mockUser, nil)
+		s.mockRefreshStore.EXPECT().Execute(gomock.Any(), refreshTokenString, gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, token string, validate func(*models.RefreshTokenRecord) error, mutate func(*models.RefreshTokenRecord)) (*models.RefreshTokenRecord, error) {
+				if err := validate(&refreshRec); err != nil {
+					return &refreshRec, err
+				}
+				mutate(&refreshRec)
+				return &refreshRec, nil
+			})
+		s.expectTokenGeneration(userID, sessionID, clientUUID, tenantID, []string{"openid"})
+		s.mockSessionStore.EXPECT().Execute(gomock.Any(), sess.ID, gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, sessionID id.SessionID, validate func(*models.Session) error, mutate func(*models.Session)) (*models.Session, error) {
+				if err := validate(&sess); err != nil {
+					return nil, err
+				}
+				mutate(&sess)
+				return &sess, nil
+			})
+		s.mockRefreshStore.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+
+		result, err := s.service.Token(context.Background(), &req)
+		s.Require().NoError(err)
+		s.Equal("openid", result.Scope)
+		s.Equal([]string{"openid", "profile"}, sess.RequestedScope)
+	})
+
+	// RFC 9449 §5: a refresh token issued to a DPoP client is bound to the proof key.
+	s.Run("DPoP-bound refresh token with a different key is rejected", func() {
+		req := newReq()
+		refreshRec := *validRefreshToken
+		refreshRec.DPoPJKT = "issued-key-thumbprint"
+		ctx := requestcontext.WithDPoPThumbprint(context.Background(), "attacker-key-thumbprint")
+
+		// Rejected before the session is read or the token consumed
+		s.mockRefreshStore.EXPECT().Find(gomock.Any(), refreshTokenString).Return(&refreshRec, nil)
+
+		result, err := s.service.Token(ctx, &req)
+		s.Require().Error(err)
+		s.Nil(result)
+		s.True(dErrors.HasCode(err, dErrors.CodeInvalidDPoPProof))
+		s.False(refreshRec.Used)
+	})
+
+	s.Run("DPoP-bound refresh token rotates to a token bound to the same key", func() {
+		req := newReq()
+		refreshRec := *validRefreshToken
+		refreshRec.DPoPJKT = "issued-key-thumbprint"
+		sess := *validSession
+		ctx := requestcontext.WithDPoPThumbprint(context.Background(), "issued-key-thumbprint")
+
+		s.mockRefreshStore.EXPECT().Find(gomock.Any(), refreshTokenString).Return(&refreshRec, nil)
+		s.mockSessionStore.EXPECT().FindByID(gomock.Any(), sessionID).Return(&sess, nil)
+		s.mockClientResolver.EXPECT().ResolveClient(gomock.Any(), clientID).Return(mockClient, mockTenant, nil)
+		s.mockUserStore.EXPECT().FindByID(gomock.Any(), userID).Return(mockUser, nil)
+		s.mockRefreshStore.EXPECT().Execute(gomock.Any(), refreshTokenString, gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, token string, validate func(*models.RefreshTokenRecord) error, mutate func(*models.RefreshTokenRecord)) (*models.RefreshTokenRecord, error) {
+				if err := validate(&refreshRec); err != nil {
+					return &refreshRec, err
+				}
+				mutate(&refreshRec)
+				return &refreshRec, nil
+			})
+		s.expectTokenGeneration(userID, sessionID, clientUUID, tenantID, sess.RequestedScope)
+		s.mockSessionStore.EXPECT().Execute(gomock.Any(), sess.ID, gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, sessionID id.SessionID, validate func(*models.Session) error, mutate func(*models.Session)) (*models.Session, error) {
+				if err := validate(&sess); err != nil {
+					return nil, err
+				}
+				mutate(&sess)
+				return &sess, nil
+			})
+		var rotated *models.RefreshTokenRecord
+		s.mockRefreshStore.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, rec *models.RefreshTokenRecord) error {
+				rotated = rec
+				return nil
+			})
+
+		result, err := s.service.Token(ctx, &req)
+		s.Require().NoError(err)
+		s.Equal(models.TokenTypeDPoP, result.TokenType)
+		s.Require().NotNil(rotated)
+		s.Equal("issued-key-thumbprint", rotated.DPoPJKT)
 	})
 
 	s.Run("device binding ignores mismatched cookie device_id", func() {
@@ -0,0 +1,76 @@
+package refreshtoken
+
+import (
+	"time"
+
+	"credo/internal/auth/models"
+)
+
+// DefaultUsedTokenGrace is how long a rotated (used) token is kept. While it is
+// kept, presenting it again is recognized as a replay and revokes its session;
+// after it is purged, a replay is just an unknown token.
+const DefaultUsedTokenGrace = 24 * time.Hour
+
+// defaultPurgeBatchSize bounds the rows one purge statement deletes, so a large
+// backlog never holds locks for long.
+const defaultPurgeBatchSize = 500
+
+// purgePolicy decides which tokens PurgeExpiredRefreshTokens removes.
+type purgePolicy struct {
+	usedGrace time.Duration
+	batchSize int
+}
+
+// Option configures a refresh token store.
+type Option func(*purgePolicy)
+
+// WithUsedTokenGrace sets how long used tokens are kept for replay detection.
+// Non-positive values keep the default.
+func WithUsedTokenGrace(grace time.Duration) Option {
+	return func(p *purgePolicy) {
+		if grace > 0 {
+			p.usedGrace = grace
+		}
+	}
+}
+
+// WithPurgeBatchSize sets how many tokens one purge batch deletes.
+// Non-positive values keep the default.
+func WithPurgeBatchSize(n int) Option {
+	return func(p *purgePolicy) {
+		if n > 0 {
+			p.batchSize = n
+		}
+	}
+}
+
+func newPurgePolicy(opts []Option) purgePolicy {
+	p := purgePolicy{usedGrace: DefaultUsedTokenGrace, batchSize: defaultPurgeBatchSize}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(&p)
+		}
+	}
+	return p
+}
+
+// usedBefore is the cutoff for used tokens: those last used before it are purged.
+func (p purgePolicy) usedBefore(now time.Time) time.Time {
+	return now.Add(-p.usedGrace)
+}
+
+// purgeable reports whether a token is expired, or used and past the grace
+// window. Tokens used before LastRefreshedAt was recorded count from CreatedAt.
+func (p purgePolicy) purgeable(r *models.RefreshTokenRecord, now time.Time) bool {
+	if r.ExpiresAt.Before(now) {
+		return true
+	}
+	if !r.Used {
+		return false
+	}
+	usedAt := r.CreatedAt
+	if r.LastRefreshedAt != nil {
+		usedAt = *r.LastRefreshedAt
+	}
+	return usedAt.Before(p.usedBefore(now))
+}
@@ -20,11 +20,15 @@ import (
 type InMemoryRefreshTokenStore struct {
 	mu     sync.RWMutex
 	tokens map[string]*models.RefreshTokenRecord
+	purge  purgePolicy
 }
 
 // New constructs an empty in-memory refresh token store.
-func New() *InMemoryRefreshTokenStore {
-	return &InMemoryRefreshTokenStore{tokens: make(map[string]*models.RefreshTokenRecord)}
+func New(opts ...Option) *InMemoryRefreshTokenStore {
+	return &InMemoryRefreshTokenStore{
+		tokens: make(map[string]*models.RefreshTokenRecord),
+		purge:  newPurgePolicy(opts),
+	}
 }
 
 func (s *InMemoryRefreshTokenStore) Create(_ context.Context, token *models.RefreshTokenRecord) error {
@@ -84,27 +88,14 @@ func (s *InMemoryRefreshTokenStore) DeleteBySessionID(_ context.Context, session
 	return nil
 }
 
-// DeleteExpiredTokens removes all refresh tokens that have expired as of the given time.
-// The time parameter is injected for testability (no hidden time.Now() calls).
-func (s *InMemoryRefreshTokenStore) DeleteExpiredTokens(_ context.Context, now time.Time) (int, error) {
+// PurgeExpiredRefreshTokens removes tokens expired as of now and used tokens
+// past the used-token grace window, returning how many were removed.
+func (s *InMemoryRefreshTokenStore) PurgeExpiredRefreshTokens(_ context.Context, now time.Time) (int, error) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 	deletedCount := 0
 	for key, token := range s.tokens {
-		if token.ExpiresAt.Before(now) {
-			delete(s.tokens, key)
-			deletedCount++
-		}
-	}
-	return deletedCount, nil
-}
-
-func (s *InMemoryRefreshTokenStore) DeleteUsedTokens(ctx context.Context) (int, error) {
-	s.mu.Lock()
-	defer s.mu.Unlock()
-	deletedCount := 0
-	for key, token := range s.tokens {
-		if token.Used {
+		if s.purge.purgeable(token, now) {
 			delete(s.tokens, key)
 			deletedCount++
 		}
@@ -19,13 +19,15 @@ import (
 type PostgresStore struct {
 	db      *sql.DB
 	queries *authsqlc.Queries
+	purge   purgePolicy
 }
 
 // NewPostgres constructs a PostgreSQL-backed refresh token store.
-func NewPostgres(db *sql.DB) *PostgresStore {
+func NewPostgres(db *sql.DB, opts ...Option) *PostgresStore {
 	return &PostgresStore{
 		db:      db,
 		queries: authsqlc.New(db),
+		purge:   newPurgePolicy(opts),
 	}
 }
 
@@ -41,6 +43,7 @@ func (s *PostgresStore) Create(ctx context.Context, token *models.RefreshTokenRe
 		Used:            token.Used,
 		LastRefreshedAt: nullTime(token.LastRefreshedAt),
 		CreatedAt:       token.CreatedAt,
+		DpopJkt:         token.DPoPJKT,
 	})
 	if err != nil {
 		return fmt.Errorf("create refresh token: %w", err)
@@ -88,29 +91,34 @@ func (s *PostgresStore) DeleteBySessionID(ctx context.Context, sessionID id.Sess
 	return nil
 }
 
-// DeleteExpiredTokens removes all refresh tokens that have expired as of the given time.
-func (s *PostgresStore) DeleteExpiredTokens(ctx context.Context, now time.Time) (int, error) {
-	res, err := s.queries.DeleteExpiredRefreshTokens(ctx, now)
-	if err != nil {
-		return 0, fmt.Errorf("delete expired refresh tokens: %w", err)
-	}
-	rows, err := res.RowsAffected()
-	if err != nil {
-		return 0, fmt.Errorf("delete expired refresh tokens rows: %w", err)
-	}
-	return int(rows), nil
-}
-
-func (s *PostgresStore) DeleteUsedTokens(ctx context.Context) (int, error) {
-	res, err := s.queries.DeleteUsedRefreshTokens(ctx)
-	if err != nil {
-		return 0, fmt.Errorf("delete used refresh tokens: %w", err)
-	}
-	rows, err := res.RowsAffected()
-	if err != nil {
-		return 0, fmt.Errorf("delete used refresh tokens rows: %w", err)
+// PurgeExpiredRefreshTokens removes tokens expired as of now and used tokens
+// past the used-token grace window, returning how many were removed.
+//
+// Deletes run in batches, each its own statement. Batches skip rows locked by
+// another purge or by a refresh in progress (FOR UPDATE SKIP LOCKED), so
+// instances running the purge concurrently neither block nor double count, and
+// a token being rotated is left for the next run.
+func (s *PostgresStore) PurgeExpiredRefreshTokens(ctx context.Context, now time.Time) (int, error) {
+	params := authsqlc.PurgeExpiredRefreshTokensParams{
+		ExpiredBefore: now,
+		UsedBefore:    s.purge.usedBefore(now),
+		BatchSize:     int32(s.purge.batchSize), //nolint:gosec // batch size is a small positive option
+	}
+	total := 0
+	for {
+		res, err := s.queries.PurgeExpiredRefreshTokens(ctx, params)
+		if err != nil {
+			return total, fmt.Errorf("purge refresh tokens: %w", err)
+		}
+		rows, err := res.RowsAffected()
+		if err != nil {
+			return total, fmt.Errorf("purge refresh tokens rows: %w", err)
+		}
+		total += int(rows)
+		if rows < int64(params.BatchSize) {
+			return total, nil
+		}
 	}
-	return int(rows), nil
 }
 
 // Execute atomically validates and mutates a refresh token under lock.
@@ -160,6 +168,7 @@ func toRefreshToken(record authsqlc.RefreshToken) *models.RefreshTokenRecord {
 		ExpiresAt: record.ExpiresAt,
 		Used:      record.Used,
 		CreatedAt: record.CreatedAt,
+		DPoPJKT:   record.DpopJkt,
 	}
 	if record.LastRefreshedAt.Valid {
 		token.LastRefreshedAt = &record.LastRefreshedAt.Time
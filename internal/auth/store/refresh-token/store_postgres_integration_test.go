@@ -174,33 +174,66 @@ func (s *PostgresStoreSuite) TestTokenRotationRace() {
 	s.False(found.Used)
 }
 
-// TestDeleteExpiredTokensConcurrency verifies delete expired behavior.
-func (s *PostgresStoreSuite) TestDeleteExpiredTokensConcurrency() {
+// TestPurgeExpiredRefreshTokens verifies the purge removes expired tokens and
+// used tokens past the grace window across batches, keeps recently used tokens
+// for replay detection, and counts each deletion once when instances race.
+func (s *PostgresStoreSuite) TestPurgeExpiredRefreshTokens() {
 	ctx := context.Background()
+	now := time.Now()
 
-	// Create expired tokens
-	for i := 0; i < 10; i++ {
-		token := s.newTestToken()
-		token.ExpiresAt = time.Now().Add(-1 * time.Hour)
-		err := s.store.Create(ctx, token)
-		s.Require().NoError(err)
+	seed := func(count int, mutate func(*models.RefreshTokenRecord)) []string {
+		tokens := make([]string, 0, count)
+		for i := 0; i < count; i++ {
+			token := s.newTestToken()
+			mutate(token)
+			s.Require().NoError(s.store.Create(ctx, token))
+			tokens = append(tokens, token.Token)
+		}
+		return tokens
+	}
+	usedAt := func(at time.Time) func(*models.RefreshTokenRecord) {
+		return func(r *models.RefreshTokenRecord) {
+			r.Used = true
+			r.LastRefreshedAt = &at
+		}
 	}
 
-	// Create active tokens
-	for i := 0; i < 5; i++ {
-		token := s.newTestToken()
-		err := s.store.Create(ctx, token)
-		s.Require().NoError(err)
+	expired := seed(7, func(r *models.RefreshTokenRecord) { r.ExpiresAt = now.Add(-time.Hour) })
+	usedOld := seed(4, usedAt(now.Add(-48*time.Hour)))
+	usedRecent := seed(3, usedAt(now.Add(-time.Hour)))
+	active := seed(2, func(*models.RefreshTokenRecord) {})
+
+	// Two instances with small batches purge concurrently.
+	instances := []*refreshtoken.PostgresStore{
+		refreshtoken.NewPostgres(s.postgres.DB, refreshtoken.WithPurgeBatchSize(2)),
+		refreshtoken.NewPostgres(s.postgres.DB, refreshtoken.WithPurgeBatchSize(2)),
+	}
+	var wg sync.WaitGroup
+	var deleted atomic.Int32
+	for _, store := range instances {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			n, err := store.PurgeExpiredRefreshTokens(ctx, now)
+			s.NoError(err)
+			deleted.Add(int32(n)) //nolint:gosec // small test counts
+		}()
 	}
+	wg.Wait()
 
-	deleted, err := s.store.DeleteExpiredTokens(ctx, time.Now())
+	// Rows skipped while locked by the other instance are left for the next run.
+	n, err := s.store.PurgeExpiredRefreshTokens(ctx, now)
 	s.Require().NoError(err)
-	s.Equal(10, deleted)
+	s.Equal(int32(len(expired)+len(usedOld)), deleted.Load()+int32(n)) //nolint:gosec // small test counts
 
-	// Active token should still be findable
-	found, err := s.store.FindBySessionID(ctx, s.sessionID, time.Now())
-	s.Require().NoError(err)
-	s.NotNil(found)
+	for _, token := range append(expired, usedOld...) {
+		_, err := s.store.Find(ctx, token)
+		s.ErrorIs(err, sentinel.ErrNotFound)
+	}
+	for _, token := range append(usedRecent, active...) {
+		_, err := s.store.Find(ctx, token)
+		s.NoError(err)
+	}
 }
 
 // TestExecuteAtomicity verifies validation errors prevent mutation.
@@ -231,6 +264,18 @@ func (s *PostgresStoreSuite) TestExecuteAtomicity() {
 	s.False(found.Used, "token should not be marked as used")
 }
 
+// TestDPoPBindingPersisted verifies the DPoP key thumbprint survives a round trip.
+func (s *PostgresStoreSuite) TestDPoPBindingPersisted() {
+	ctx := context.Background()
+	token := s.newTestToken()
+	token.DPoPJKT = "0ZcOCORZNYy-DWpqq30jZyJGHTN0d2HglBV3uiguA4I"
+	s.Require().NoError(s.store.Create(ctx, token))
+
+	found, err := s.store.Find(ctx, token.Token)
+	s.Require().NoError(err)
+	s.Equal(token.DPoPJKT, found.DPoPJKT)
+}
+
 // TestNotFoundError verifies proper error handling.
 func (s *PostgresStoreSuite) TestNotFoundError() {
 	ctx := context.Background()
@@ -56,6 +56,7 @@ func (s *PostgresStore) Create(ctx context.Context, session *models.Session) err
 		ExpiresAt:             session.ExpiresAt,
 		LastSeenAt:            session.LastSeenAt,
 		RevokedAt:             nullTime(session.RevokedAt),
+		RiskScore:             int32(session.RiskScore),
 	})
 	if err != nil {
 		return fmt.Errorf("create session: %w", err)
@@ -245,6 +246,7 @@ func toSession(row authsqlc.Session) (*models.Session, error) {
 		CreatedAt:             row.CreatedAt,
 		ExpiresAt:             row.ExpiresAt,
 		LastSeenAt:            row.LastSeenAt,
+		RiskScore:             int(row.RiskScore),
 	}
 	if row.LastRefreshedAt.Valid {
 		session.LastRefreshedAt = &row.LastRefreshedAt.Time
@@ -278,6 +280,7 @@ func (s *PostgresStore) updateSession(ctx context.Context, queries *authsqlc.Que
 		ExpiresAt:             session.ExpiresAt,
 		LastSeenAt:            session.LastSeenAt,
 		RevokedAt:             nullTime(session.RevokedAt),
+		RiskScore:             int32(session.RiskScore),
 	})
 	if err != nil {
 		return fmt.Errorf("update session: %w", err)
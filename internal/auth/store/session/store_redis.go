@@ -47,6 +47,7 @@ type sessionJSON struct {
 	ExpiresAt             int64    `json:"expires_at"`           // Unix nano
 	LastSeenAt            int64    `json:"last_seen_at"`         // Unix nano
 	RevokedAt             *int64   `json:"revoked_at,omitempty"` // Unix nano
+	RiskScore             int      `json:"risk_score,omitempty"`
 }
 
 func sessionToJSON(s *models.Session) *sessionJSON {
@@ -65,6 +66,7 @@ func sessionToJSON(s *models.Session) *sessionJSON {
 		CreatedAt:             s.CreatedAt.UnixNano(),
 		ExpiresAt:             s.ExpiresAt.UnixNano(),
 		LastSeenAt:            s.LastSeenAt.UnixNano(),
+		RiskScore:             s.RiskScore,
 	}
 	if s.LastRefreshedAt != nil {
 		ts := s.LastRefreshedAt.UnixNano()
@@ -110,6 +112,7 @@ func sessionFromJSON(j *sessionJSON) (*models.Session, error) {
 		CreatedAt:             time.Unix(0, j.CreatedAt),
 		ExpiresAt:             time.Unix(0, j.ExpiresAt),
 		LastSeenAt:            time.Unix(0, j.LastSeenAt),
+		RiskScore:             j.RiskScore,
 	}
 	if j.LastRefreshedAt != nil {
 		t := time.Unix(0, *j.LastRefreshedAt)
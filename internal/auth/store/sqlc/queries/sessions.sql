@@ -2,21 +2,21 @@
 INSERT INTO sessions (
     id, user_id, client_id, tenant_id, requested_scope, status,
     last_refreshed_at, last_access_token_jti, device_id, device_fingerprint_hash,
-    device_display_name, approximate_location, created_at, expires_at, last_seen_at, revoked_at
+    device_display_name, approximate_location, created_at, expires_at, last_seen_at, revoked_at, risk_score
 )
-VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16);
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17);
 
 -- name: GetSessionByID :one
 SELECT id, user_id, client_id, tenant_id, requested_scope, status,
     last_refreshed_at, last_access_token_jti, device_id, device_fingerprint_hash,
-    device_display_name, approximate_location, created_at, expires_at, last_seen_at, revoked_at
+    device_display_name, approximate_location, created_at, expires_at, last_seen_at, revoked_at, risk_score
 FROM sessions
 WHERE id = $1;
 
 -- name: ListSessionsByUser :many
 SELECT id, user_id, client_id, tenant_id, requested_scope, status,
     last_refreshed_at, last_access_token_jti, device_id, device_fingerprint_hash,
-    device_display_name, approximate_location, created_at, expires_at, last_seen_at, revoked_at
+    device_display_name, approximate_location, created_at, expires_at, last_seen_at, revoked_at, risk_score
 FROM sessions
 WHERE user_id = $1;
 
@@ -36,7 +36,8 @@ SET user_id = $2,
     created_at = $13,
     expires_at = $14,
     last_seen_at = $15,
-    revoked_at = $16
+    revoked_at = $16,
+    risk_score = $17
 WHERE id = $1;
 
 -- name: DeleteSessionsByUser :execresult
@@ -45,7 +46,7 @@ DELETE FROM sessions WHERE user_id = $1;
 -- name: GetSessionForUpdate :one
 SELECT id, user_id, client_id, tenant_id, requested_scope, status,
     last_refreshed_at, last_access_token_jti, device_id, device_fingerprint_hash,
-    device_display_name, approximate_location, created_at, expires_at, last_seen_at, revoked_at
+    device_display_name, approximate_location, created_at, expires_at, last_seen_at, revoked_at, risk_score
 FROM sessions
 WHERE id = $1
 FOR UPDATE;
@@ -56,5 +57,5 @@ DELETE FROM sessions WHERE expires_at < $1;
 -- name: ListSessions :many
 SELECT id, user_id, client_id, tenant_id, requested_scope, status,
     last_refreshed_at, last_access_token_jti, device_id, device_fingerprint_hash,
-    device_display_name, approximate_location, created_at, expires_at, last_seen_at, revoked_at
+    device_display_name, approximate_location, created_at, expires_at, last_seen_at, revoked_at, risk_score
 FROM sessions;
@@ -14,8 +14,8 @@ import (
 )
 
 const createRefreshToken = `-- name: CreateRefreshToken :exec
-INSERT INTO refresh_tokens (id, token, session_id, expires_at, used, last_refreshed_at, created_at)
-VALUES ($1, $2, $3, $4, $5, $6, $7)
+INSERT INTO refresh_tokens (id, token, session_id, expires_at, used, last_refreshed_at, created_at, dpop_jkt)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
 `
 
 type CreateRefreshTokenParams struct {
@@ -26,6 +26,7 @@ type CreateRefreshTokenParams struct {
 	Used            bool
 	LastRefreshedAt sql.NullTime
 	CreatedAt       time.Time
+	DpopJkt         string
 }
 
 func (q *Queries) CreateRefreshToken(ctx context.Context, arg CreateRefreshTokenParams) error {
@@ -37,18 +38,11 @@ func (q *Queries) CreateRefreshToken(ctx context.Context, arg CreateRefreshToken
 		arg.Used,
 		arg.LastRefreshedAt,
 		arg.CreatedAt,
+		arg.DpopJkt,
 	)
 	return err
 }
 
-const deleteExpiredRefreshTokens = `-- name: DeleteExpiredRefreshTokens :execresult
-DELETE FROM refresh_tokens WHERE expires_at < $1
-`
-
-func (q *Queries) DeleteExpiredRefreshTokens(ctx context.Context, expiresAt time.Time) (sql.Result, error) {
-	return q.db.ExecContext(ctx, deleteExpiredRefreshTokens, expiresAt)
-}
-
 const deleteRefreshTokensBySession = `-- name: DeleteRefreshTokensBySession :execresult
 DELETE FROM refresh_tokens WHERE session_id = $1
 `
@@ -57,16 +51,8 @@ func (q *Queries) DeleteRefreshTokensBySession(ctx context.Context, sessionID uu
 	return q.db.ExecContext(ctx, deleteRefreshTokensBySession, sessionID)
 }
 
-const deleteUsedRefreshTokens = `-- name: DeleteUsedRefreshTokens :execresult
-DELETE FROM refresh_tokens WHERE used = TRUE
-`
-
-func (q *Queries) DeleteUsedRefreshTokens(ctx context.Context) (sql.Result, error) {
-	return q.db.ExecContext(ctx, deleteUsedRefreshTokens)
-}
-
 const getRefreshTokenBySession = `-- name: GetRefreshTokenBySession :one
-SELECT id, token, session_id, expires_at, used, last_refreshed_at, created_at
+SELECT id, token, session_id, expires_at, used, last_refreshed_at, created_at, dpop_jkt
 FROM refresh_tokens
 WHERE session_id = $1 AND used = FALSE AND expires_at > $2
 ORDER BY created_at DESC
@@ -89,12 +75,13 @@ func (q *Queries) GetRefreshTokenBySession(ctx context.Context, arg GetRefreshTo
 		&i.Used,
 		&i.LastRefreshedAt,
 		&i.CreatedAt,
+		&i.DpopJkt,
 	)
 	return i, err
 }
 
 const getRefreshTokenByToken = `-- name: GetRefreshTokenByToken :one
-SELECT id, token, session_id, expires_at, used, last_refreshed_at, created_at
+SELECT id, token, session_id, expires_at, used, last_refreshed_at, created_at, dpop_jkt
 FROM refresh_tokens
 WHERE token = $1
 `
@@ -110,12 +97,13 @@ func (q *Queries) GetRefreshTokenByToken(ctx context.Context, token string) (Ref
 		&i.Used,
 		&i.LastRefreshedAt,
 		&i.CreatedAt,
+		&i.DpopJkt,
 	)
 	return i, err
 }
 
 const getRefreshTokenForUpdate = `-- name: GetRefreshTokenForUpdate :one
-SELECT id, token, session_id, expires_at, used, last_refreshed_at, created_at
+SELECT id, token, session_id, expires_at, used, last_refreshed_at, created_at, dpop_jkt
 FROM refresh_tokens
 WHERE token = $1
 FOR UPDATE
@@ -132,10 +120,32 @@ func (q *Queries) GetRefreshTokenForUpdate(ctx context.Context, token string) (R
 		&i.Used,
 		&i.LastRefreshedAt,
 		&i.CreatedAt,
+		&i.DpopJkt,
 	)
 	return i, err
 }
 
+const purgeExpiredRefreshTokens = `-- name: PurgeExpiredRefreshTokens :execresult
+DELETE FROM refresh_tokens
+WHERE id IN (
+    SELECT id FROM refresh_tokens
+    WHERE expires_at < $1::timestamptz
+       OR (used = TRUE AND COALESCE(last_refreshed_at, created_at) < $2::timestamptz)
+    LIMIT $3
+    FOR UPDATE SKIP LOCKED
+)
+`
+
+type PurgeExpiredRefreshTokensParams struct {
+	ExpiredBefore time.Time
+	UsedBefore    time.Time
+	BatchSize     int32
+}
+
+func (q *Queries) PurgeExpiredRefreshTokens(ctx context.Context, arg PurgeExpiredRefreshTokensParams) (sql.Result, error) {
+	return q.db.ExecContext(ctx, purgeExpiredRefreshTokens, arg.ExpiredBefore, arg.UsedBefore, arg.BatchSize)
+}
+
 const updateRefreshTokenUsage = `-- name: UpdateRefreshTokenUsage :exec
 UPDATE refresh_tokens
 SET used = $2, last_refreshed_at = $3
@@ -18,9 +18,9 @@ const createSession = `-- name: CreateSession :exec
 INSERT INTO sessions (
     id, user_id, client_id, tenant_id, requested_scope, status,
     last_refreshed_at, last_access_token_jti, device_id, device_fingerprint_hash,
-    device_display_name, approximate_location, created_at, expires_at, last_seen_at, revoked_at
+    device_display_name, approximate_location, created_at, expires_at, last_seen_at, revoked_at, risk_score
 )
-VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17)
 `
 
 type CreateSessionParams struct {
@@ -40,6 +40,7 @@ type CreateSessionParams struct {
 	ExpiresAt             time.Time
 	LastSeenAt            time.Time
 	RevokedAt             sql.NullTime
+	RiskScore             int32
 }
 
 func (q *Queries) CreateSession(ctx context.Context, arg CreateSessionParams) error {
@@ -60,6 +61,7 @@ func (q *Queries) CreateSession(ctx context.Context, arg CreateSessionParams) er
 		arg.ExpiresAt,
 		arg.LastSeenAt,
 		arg.RevokedAt,
+		arg.RiskScore,
 	)
 	return err
 }
@@ -83,7 +85,7 @@ func (q *Queries) DeleteSessionsByUser(ctx context.Context, userID uuid.UUID) (s
 const getSessionByID = `-- name: GetSessionByID :one
 SELECT id, user_id, client_id, tenant_id, requested_scope, status,
     last_refreshed_at, last_access_token_jti, device_id, device_fingerprint_hash,
-    device_display_name, approximate_location, created_at, expires_at, last_seen_at, revoked_at
+    device_display_name, approximate_location, created_at, expires_at, last_seen_at, revoked_at, risk_score
 FROM sessions
 WHERE id = $1
 `
@@ -108,6 +110,7 @@ func (q *Queries) GetSessionByID(ctx context.Context, id uuid.UUID) (Session, er
 		&i.ExpiresAt,
 		&i.LastSeenAt,
 		&i.RevokedAt,
+		&i.RiskScore,
 	)
 	return i, err
 }
@@ -115,7 +118,7 @@ func (q *Queries) GetSessionByID(ctx context.Context, id uuid.UUID) (Session, er
 const getSessionForUpdate = `-- name: GetSessionForUpdate :one
 SELECT id, user_id, client_id, tenant_id, requested_scope, status,
     last_refreshed_at, last_access_token_jti, device_id, device_fingerprint_hash,
-    device_display_name, approximate_location, created_at, expires_at, last_seen_at, revoked_at
+    device_display_name, approximate_location, created_at, expires_at, last_seen_at, revoked_at, risk_score
 FROM sessions
 WHERE id = $1
 FOR UPDATE
@@ -141,6 +144,7 @@ func (q *Queries) GetSessionForUpdate(ctx context.Context, id uuid.UUID) (Sessio
 		&i.ExpiresAt,
 		&i.LastSeenAt,
 		&i.RevokedAt,
+		&i.RiskScore,
 	)
 	return i, err
 }
@@ -148,7 +152,7 @@ func (q *Queries) GetSessionForUpdate(ctx context.Context, id uuid.UUID) (Sessio
 const listSessions = `-- name: ListSessions :many
 SELECT id, user_id, client_id, tenant_id, requested_scope, status,
     last_refreshed_at, last_access_token_jti, device_id, device_fingerprint_hash,
-    device_display_name, approximate_location, created_at, expires_at, last_seen_at, revoked_at
+    device_display_name, approximate_location, created_at, expires_at, last_seen_at, revoked_at, risk_score
 FROM sessions
 `
 
@@ -178,6 +182,7 @@ func (q *Queries) ListSessions(ctx context.Context) ([]Session, error) {
 			&i.ExpiresAt,
 			&i.LastSeenAt,
 			&i.RevokedAt,
+			&i.RiskScore,
 		); err != nil {
 			return nil, err
 		}
@@ -195,7 +200,7 @@ func (q *Queries) ListSessions(ctx context.Context) ([]Session, error) {
 const listSessionsByUser = `-- name: ListSessionsByUser :many
 SELECT id, user_id, client_id, tenant_id, requested_scope, status,
     last_refreshed_at, last_access_token_jti, device_id, device_fingerprint_hash,
-    device_display_name, approximate_location, created_at, expires_at, last_seen_at, revoked_at
+    device_display_name, approximate_location, created_at, expires_at, last_seen_at, revoked_at, risk_score
 FROM sessions
 WHERE user_id = $1
 `
@@ -226,6 +231,7 @@ func (q *Queries) ListSessionsByUser(ctx context.Context, userID uuid.UUID) ([]S
 			&i.ExpiresAt,
 			&i.LastSeenAt,
 			&i.RevokedAt,
+			&i.RiskScore,
 		); err != nil {
 			return nil, err
 		}
@@ -256,7 +262,8 @@ SET user_id = $2,
     created_at = $13,
     expires_at = $14,
     last_seen_at = $15,
-    revoked_at = $16
+    revoked_at = $16,
+    risk_score = $17
 WHERE id = $1
 `
 
@@ -277,6 +284,7 @@ type UpdateSessionParams struct {
 	ExpiresAt             time.Time
 	LastSeenAt            time.Time
 	RevokedAt             sql.NullTime
+	RiskScore             int32
 }
 
 func (q *Queries) UpdateSession(ctx context.Context, arg UpdateSessionParams) (sql.Result, error) {
@@ -297,5 +305,6 @@ func (q *Queries) UpdateSession(ctx context.Context, arg UpdateSessionParams) (s
 		arg.ExpiresAt,
 		arg.LastSeenAt,
 		arg.RevokedAt,
+		arg.RiskScore,
 	)
 }
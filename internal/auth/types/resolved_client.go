@@ -1,16 +1,22 @@
 package types
 
-import id "credo/pkg/domain"
+import (
+	"slices"
+
+	id "credo/pkg/domain"
+)
 
 // ResolvedClient contains the client fields needed by auth flows.
 // This is an auth-local DTO to avoid coupling to tenant models.
 type ResolvedClient struct {
-	ID            id.ClientID
-	TenantID      id.TenantID
-	OAuthClientID string
-	RedirectURIs  []string
-	AllowedScopes []string
-	Active        bool
+	ID               id.ClientID
+	TenantID         id.TenantID
+	OAuthClientID    string
+	RedirectURIs     []string
+	AllowedScopes    []string
+	AllowedResources []string // RFC 8707 resource indicators the client may request
+	AllowedGrants    []id.GrantType
+	Active           bool
 }
 
 // IsActive returns whether the client is active.
@@ -18,6 +24,11 @@ func (c *ResolvedClient) IsActive() bool {
 	return c.Active
 }
 
+// CanUseGrant returns whether the client is registered for the grant type.
+func (c *ResolvedClient) CanUseGrant(grant id.GrantType) bool {
+	return slices.Contains(c.AllowedGrants, grant)
+}
+
 // ResolvedTenant contains the tenant fields needed by auth flows.
 // This is an auth-local DTO to avoid coupling to tenant models.
 type ResolvedTenant struct {
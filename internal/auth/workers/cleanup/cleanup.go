@@ -14,9 +14,9 @@ type AuthorizationCodeStore interface {
 }
 
 // RefreshTokenStore exposes cleanup for refresh tokens and rotation artifacts.
+// Used tokens are kept for a grace window so replays are still detected.
 type RefreshTokenStore interface {
-	DeleteExpiredTokens(ctx context.Context, now time.Time) (int, error)
-	DeleteUsedTokens(ctx context.Context) (int, error)
+	PurgeExpiredRefreshTokens(ctx context.Context, now time.Time) (int, error)
 }
 
 // SessionStore exposes cleanup for expired sessions.
@@ -27,8 +27,7 @@ type SessionStore interface {
 // CleanupResult summarizes the deletions performed by a cleanup run.
 type CleanupResult struct {
 	DeletedAuthorizationCodes int
-	DeletedRefreshTokens      int
-	DeletedUsedRefreshTokens  int
+	DeletedRefreshTokens      int // Expired tokens and used tokens past the grace window
 	DeletedSessions           int
 }
 
@@ -105,7 +104,8 @@ func (s *CleanupService) Start(ctx context.Context) error {
 }
 
 // RunOnce performs a single cleanup operation.
-// It removes expired authorization codes, expired refresh tokens, used refresh tokens, and expired sessions.
+// It removes expired authorization codes, expired refresh tokens, used refresh tokens past
+// their grace window, and expired sessions.
 // It returns a CleanupResult summarizing the deletions performed.
 // If any errors occur during cleanup, they are aggregated and returned.
 func (s *CleanupService) RunOnce(ctx context.Context) (CleanupResult, error) {
@@ -120,18 +120,11 @@ func (s *CleanupService) RunOnce(ctx context.Context) (CleanupResult, error) {
 		res.DeletedAuthorizationCodes = deletedCodes
 	}
 
-	deletedExpiredRefresh, err := s.refreshTokenStore.DeleteExpiredTokens(ctx, now)
+	deletedRefresh, err := s.refreshTokenStore.PurgeExpiredRefreshTokens(ctx, now)
 	if err != nil {
-		errs = append(errs, fmt.Errorf("delete expired refresh tokens: %w", err))
+		errs = append(errs, fmt.Errorf("purge refresh tokens: %w", err))
 	} else {
-		res.DeletedRefreshTokens = deletedExpiredRefresh
-	}
-
-	deletedUsedRefresh, err := s.refreshTokenStore.DeleteUsedTokens(ctx)
-	if err != nil {
-		errs = append(errs, fmt.Errorf("delete used refresh tokens: %w", err))
-	} else {
-		res.DeletedUsedRefreshTokens = deletedUsedRefresh
+		res.DeletedRefreshTokens = deletedRefresh
 	}
 
 	deletedSessions, err := s.sessionStore.DeleteExpiredSessions(ctx, now)
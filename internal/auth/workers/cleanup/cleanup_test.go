@@ -56,24 +56,38 @@ func TestCleanupService_RunOnce_Integration(t *testing.T) {
 	}
 	require.NoError(t, refreshTokens.Create(ctx, expiredRefresh))
 
+	usedAt := time.Now().Add(-48 * time.Hour)
 	usedRefresh := &models.RefreshTokenRecord{
-		ID:        uuid.New(),
-		Token:     "ref_used",
-		SessionID: expiredSessionID,
-		CreatedAt: time.Now().Add(-2 * time.Hour),
-		ExpiresAt: time.Now().Add(24 * time.Hour),
-		Used:      true,
+		ID:              uuid.New(),
+		Token:           "ref_used",
+		SessionID:       expiredSessionID,
+		CreatedAt:       time.Now().Add(-72 * time.Hour),
+		LastRefreshedAt: &usedAt,
+		ExpiresAt:       time.Now().Add(24 * time.Hour),
+		Used:            true,
 	}
 	require.NoError(t, refreshTokens.Create(ctx, usedRefresh))
 
+	// Used within the grace window: kept so a replay is still detected.
+	recentlyUsedAt := time.Now().Add(-1 * time.Hour)
+	recentlyUsedRefresh := &models.RefreshTokenRecord{
+		ID:              uuid.New(),
+		Token:           "ref_recently_used",
+		SessionID:       expiredSessionID,
+		CreatedAt:       time.Now().Add(-2 * time.Hour),
+		LastRefreshedAt: &recentlyUsedAt,
+		ExpiresAt:       time.Now().Add(24 * time.Hour),
+		Used:            true,
+	}
+	require.NoError(t, refreshTokens.Create(ctx, recentlyUsedRefresh))
+
 	svc, err := New(sessions, codes, refreshTokens, WithCleanupInterval(10*time.Second))
 	require.NoError(t, err)
 
 	res, err := svc.RunOnce(ctx)
 	require.NoError(t, err)
 	require.Equal(t, 1, res.DeletedAuthorizationCodes)
-	require.Equal(t, 1, res.DeletedRefreshTokens)
-	require.Equal(t, 1, res.DeletedUsedRefreshTokens)
+	require.Equal(t, 2, res.DeletedRefreshTokens)
 	require.Equal(t, 1, res.DeletedSessions)
 
 	// Verify expired artifacts are actually removed
@@ -83,6 +97,12 @@ func TestCleanupService_RunOnce_Integration(t *testing.T) {
 	_, err = refreshTokens.Find(ctx, expiredRefresh.Token)
 	require.ErrorIs(t, err, sentinel.ErrNotFound)
 
+	_, err = refreshTokens.Find(ctx, usedRefresh.Token)
+	require.ErrorIs(t, err, sentinel.ErrNotFound)
+
+	_, err = refreshTokens.Find(ctx, recentlyUsedRefresh.Token)
+	require.NoError(t, err)
+
 	allSessions, err := sessions.ListAll(ctx)
 	require.NoError(t, err)
 	require.NotContains(t, allSessions, expiredSessionID)
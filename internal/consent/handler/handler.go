@@ -22,6 +22,7 @@ import (
 // Returns domain objects, not HTTP response DTOs.
 type Service interface {
 	Grant(ctx context.Context, userID id.UserID, purposes []models.Purpose) ([]*models.Record, error)
+	GrantPartial(ctx context.Context, userID id.UserID, purposes []string) ([]models.PurposeOutcome, error)
 	Revoke(ctx context.Context, userID id.UserID, purposes []models.Purpose) ([]*models.Record, error)
 	RevokeAll(ctx context.Context, userID id.UserID) (int, error)
 	DeleteAll(ctx context.Context, userID id.UserID) error
@@ -66,6 +67,19 @@ func (h *Handler) HandleGrantConsent(w http.ResponseWriter, r *http.Request) {
 	if !ok {
 		return
 	}
+	if grantReq.Partial {
+		outcomes, err := h.consent.GrantPartial(ctx, userID, grantReq.Purposes)
+		if err != nil {
+			h.logger.ErrorContext(ctx, "failed to grant consent",
+				"request_id", requestID,
+				"error", err,
+			)
+			httputil.WriteError(w, err)
+			return
+		}
+		httputil.WriteJSON(w, http.StatusOK, toPartialGrantResponse(outcomes, requestcontext.Now(ctx)))
+		return
+	}
 	purposes, err := grantReq.ToPurposes()
 	if err != nil {
 		httputil.WriteError(w, dErrors.New(dErrors.CodeValidation, err.Error()))
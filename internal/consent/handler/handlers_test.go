@@ -20,6 +20,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 	"go.uber.org/mock/gomock"
@@ -113,6 +114,95 @@ func (s *ConsentHandlerSuite) TestHandleGrantConsent_ErrorMapping() {
 	})
 }
 
+// =============================================================================
+// Grant Consent Tests - Partial Mode
+// =============================================================================
+
+// TestHandleGrantConsent_PartialMode verifies strict and partial bulk grants.
+// Reason not a feature test: mapping service outcomes onto per-purpose results
+// needs a mocked service returning a mix of outcomes.
+func (s *ConsentHandlerSuite) TestHandleGrantConsent_PartialMode() {
+	testUserIDStr := "550e8400-e29b-41d4-a716-446655440000"
+	userID, _ := id.ParseUserID(testUserIDStr)
+	record := func(purpose consentModel.Purpose) []*consentModel.Record {
+		return []*consentModel.Record{{UserID: userID, Purpose: purpose, GrantedAt: time.Now()}}
+	}
+
+	s.Run("strict mode fails every purpose when one is invalid", func() {
+		handler, _ := newTestHandler(s.T()) // no Grant expectation: nothing is granted
+		req, err := newRequestWithBody(http.MethodPost, "/auth/consent",
+			GrantRequest{Purposes: []string{consentModel.PurposeLogin.String(), "invalid_purpose"}}, testUserIDStr)
+		s.Require().NoError(err)
+
+		w := httptest.NewRecorder()
+		handler.HandleGrantConsent(w, req)
+
+		s.assertStatusAndError(w, http.StatusBadRequest, "validation_error")
+	})
+
+	s.Run("partial mode maps per-purpose outcomes", func() {
+		handler, mockService := newTestHandler(s.T())
+		purposes := []string{
+			consentModel.PurposeLogin.String(),
+			"invalid_purpose",
+			consentModel.PurposeVCIssuance.String(),
+		}
+		mockService.EXPECT().GrantPartial(gomock.Any(), userID, purposes).Return([]consentModel.PurposeOutcome{
+			{Purpose: purposes[0], Record: record(consentModel.PurposeLogin)[0]},
+			{Purpose: purposes[1], Reason: "invalid purpose"},
+			{Purpose: purposes[2], Record: record(consentModel.PurposeVCIssuance)[0]},
+		}, nil)
+
+		req, err := newRequestWithBody(http.MethodPost, "/auth/consent", GrantRequest{
+			Purposes: purposes,
+			Partial:  true,
+		}, testUserIDStr)
+		s.Require().NoError(err)
+
+		w := httptest.NewRecorder()
+		handler.HandleGrantConsent(w, req)
+
+		s.Require().Equal(http.StatusOK, w.Code)
+		var resp GrantResponse
+		s.Require().NoError(json.Unmarshal(w.Body.Bytes(), &resp))
+		s.Len(resp.Granted, 2)
+		s.Equal([]*PurposeResult{
+			{Purpose: consentModel.PurposeLogin.String(), Status: PurposeGranted},
+			{Purpose: "invalid_purpose", Status: PurposeFailed, Reason: "invalid purpose"},
+			{Purpose: consentModel.PurposeVCIssuance.String(), Status: PurposeGranted},
+		}, resp.Results)
+	})
+
+	s.Run("partial mode still fails the request on internal errors", func() {
+		handler, mockService := newTestHandler(s.T())
+		mockService.EXPECT().GrantPartial(gomock.Any(), userID, gomock.Any()).
+			Return(nil, dErrors.New(dErrors.CodeInternal, "storage system unavailable"))
+
+		req, err := newRequestWithBody(http.MethodPost, "/auth/consent", GrantRequest{
+			Purposes: []string{consentModel.PurposeLogin.String(), consentModel.PurposeVCIssuance.String()},
+			Partial:  true,
+		}, testUserIDStr)
+		s.Require().NoError(err)
+
+		w := httptest.NewRecorder()
+		handler.HandleGrantConsent(w, req)
+
+		s.assertStatusAndError(w, http.StatusInternalServerError, string(dErrors.CodeInternal))
+	})
+
+	s.Run("partial mode still requires purposes", func() {
+		handler, _ := newTestHandler(s.T())
+		req, err := newRequestWithBody(http.MethodPost, "/auth/consent",
+			GrantRequest{Purposes: []string{}, Partial: true}, testUserIDStr)
+		s.Require().NoError(err)
+
+		w := httptest.NewRecorder()
+		handler.HandleGrantConsent(w, req)
+
+		s.assertStatusAndError(w, http.StatusBadRequest, "validation_error")
+	})
+}
+
 // =============================================================================
 // Get Consents Tests - Error Mapping & Validation
 // =============================================================================
@@ -71,6 +71,21 @@ func (mr *MockServiceMockRecorder) Grant(ctx, userID, purposes any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Grant", reflect.TypeOf((*MockService)(nil).Grant), ctx, userID, purposes)
 }
 
+// GrantPartial mocks base method.
+func (m *MockService) GrantPartial(ctx context.Context, userID id.UserID, purposes []string) ([]models.PurposeOutcome, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GrantPartial", ctx, userID, purposes)
+	ret0, _ := ret[0].([]models.PurposeOutcome)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GrantPartial indicates an expected call of GrantPartial.
+func (mr *MockServiceMockRecorder) GrantPartial(ctx, userID, purposes any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GrantPartial", reflect.TypeOf((*MockService)(nil).GrantPartial), ctx, userID, purposes)
+}
+
 // List mocks base method.
 func (m *MockService) List(ctx context.Context, userID id.UserID, filter *models.RecordFilter) ([]*models.Record, error) {
 	m.ctrl.T.Helper()
@@ -12,6 +12,9 @@ import (
 // GrantRequest specifies which purposes to grant consent for.
 type GrantRequest struct {
 	Purposes []string `json:"purposes"`
+	// Partial grants the acceptable purposes and reports the rest per purpose
+	// instead of rejecting the whole request. Strict all-or-nothing is the default.
+	Partial bool `json:"partial,omitempty"`
 }
 
 // Normalize applies business defaults and sanitizes inputs.
@@ -22,11 +25,15 @@ func (r *GrantRequest) Normalize() {
 	r.Purposes = dedupePurposes(r.Purposes)
 }
 
-// Validate checks that the request is well-formed.
+// Validate checks that the request is well-formed. In partial mode only the
+// purpose count is checked; invalid purposes are reported per purpose.
 func (r *GrantRequest) Validate() error {
 	if r == nil {
 		return dErrors.New(dErrors.CodeBadRequest, "request is required")
 	}
+	if r.Partial {
+		return validatePurposeCount(r.Purposes)
+	}
 	return validatePurposes(r.Purposes)
 }
 
@@ -70,13 +77,8 @@ func (r *RevokeRequest) ToPurposes() ([]models.Purpose, error) {
 // validatePurposes validates a list of purpose strings.
 // Enforces size limits, required fields, and syntax validation.
 func validatePurposes(purposes []string) error {
-	// Phase 1: Size validation
-	if len(purposes) > validation.MaxPurposes {
-		return dErrors.New(dErrors.CodeValidation, fmt.Sprintf("too many purposes: max %d allowed", validation.MaxPurposes))
-	}
-	// Phase 2: Required fields
-	if len(purposes) == 0 {
-		return dErrors.New(dErrors.CodeValidation, "purposes are required")
+	if err := validatePurposeCount(purposes); err != nil {
+		return err
 	}
 	// Phase 3: Syntax validation
 	for _, p := range purposes {
@@ -90,6 +92,19 @@ func validatePurposes(purposes []string) error {
 	return nil
 }
 
+// validatePurposeCount enforces the size limit and that purposes are present.
+func validatePurposeCount(purposes []string) error {
+	// Phase 1: Size validation
+	if len(purposes) > validation.MaxPurposes {
+		return dErrors.New(dErrors.CodeValidation, fmt.Sprintf("too many purposes: max %d allowed", validation.MaxPurposes))
+	}
+	// Phase 2: Required fields
+	if len(purposes) == 0 {
+		return dErrors.New(dErrors.CodeValidation, "purposes are required")
+	}
+	return nil
+}
+
 func toDomainPurposes(purposes []string) ([]models.Purpose, error) {
 	parsed := make([]models.Purpose, 0, len(purposes))
 	for _, p := range purposes {
@@ -9,8 +9,22 @@ import (
 
 // GrantResponse is returned after granting consent.
 type GrantResponse struct {
-	Granted []*Grant `json:"granted"`
-	Message string   `json:"message,omitempty"`
+	Granted []*Grant         `json:"granted"`
+	Results []*PurposeResult `json:"results,omitempty"` // Per-purpose outcomes, partial mode only
+	Message string           `json:"message,omitempty"`
+}
+
+// Partial grant outcomes for PurposeResult.Status.
+const (
+	PurposeGranted = "granted"
+	PurposeFailed  = "failed"
+)
+
+// PurposeResult reports the outcome of one purpose in a partial grant.
+type PurposeResult struct {
+	Purpose string `json:"purpose"`
+	Status  string `json:"status"`
+	Reason  string `json:"reason,omitempty"`
 }
 
 // Grant represents a granted consent in HTTP responses.
@@ -54,6 +68,23 @@ type ConsentWithStatus struct {
 	Status models.Status `json:"status"`
 }
 
+// toPartialGrantResponse lists the granted records and reports every purpose's outcome.
+func toPartialGrantResponse(outcomes []models.PurposeOutcome, now time.Time) *GrantResponse {
+	var records []*models.Record
+	results := make([]*PurposeResult, 0, len(outcomes))
+	for _, outcome := range outcomes {
+		if !outcome.Granted() {
+			results = append(results, &PurposeResult{Purpose: outcome.Purpose, Status: PurposeFailed, Reason: outcome.Reason})
+			continue
+		}
+		records = append(records, outcome.Record)
+		results = append(results, &PurposeResult{Purpose: outcome.Purpose, Status: PurposeGranted})
+	}
+	resp := toGrantResponse(records, now)
+	resp.Results = results
+	return resp
+}
+
 func toGrantResponse(records []*models.Record, now time.Time) *GrantResponse {
 	granted := make([]*Grant, 0, len(records))
 	for _, record := range records {
@@ -362,12 +362,14 @@ func TestIdempotencyWindowBoundary(t *testing.T) {
 	require.NoError(t, err)
 	assert.Len(t, auditEvents, 1, "should only have 1 audit event (idempotent request didn't create new event)")
 
-	t.Log("Step 4: Manipulate timestamp to simulate time passing (6 minutes ago)")
+	t.Log("Step 4: Manipulate timestamps to simulate time passing (6 minutes ago, now close to expiry)")
 	scope, err = consentModel.NewConsentScope(h.userID, consentModel.PurposeLogin)
 	require.NoError(t, err)
 	record, err := h.consentStore.FindByScope(context.Background(), scope)
 	require.NoError(t, err)
 	record.GrantedAt = time.Now().Add(-6 * time.Minute)
+	soon := time.Now().Add(24 * time.Hour) // inside the renewal window, so the re-grant extends it
+	record.ExpiresAt = &soon
 	require.NoError(t, h.consentStore.Update(context.Background(), record))
 
 	t.Log("Step 5: Grant again (now outside 5-min window) - should update timestamps")
@@ -20,8 +20,19 @@ type Metrics struct {
 
 	// PRD-020 FR-0: Consent enforcement metrics with failure reason
 	ConsentCheckFailedByReason *prometheus.CounterVec
+
+	// ConsentChecksTotal counts every consent check by purpose and outcome
+	ConsentChecksTotal *prometheus.CounterVec
 }
 
+// Consent check outcome label values.
+const (
+	CheckOutcomeActive   = "active"
+	CheckOutcomeExpired  = "expired"
+	CheckOutcomeNotFound = "not_found"
+	CheckOutcomeRevoked  = "revoked"
+)
+
 // New registers and returns consent metrics collectors.
 func New() *Metrics {
 	return &Metrics{
@@ -68,6 +79,10 @@ func New() *Metrics {
 			Name: "credo_consent_checks_failed_by_reason_total",
 			Help: "Total number of consent checks that failed, labeled by purpose and reason",
 		}, []string{"purpose", "reason"}),
+		ConsentChecksTotal: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name: "credo_consent_checks_total",
+			Help: "Total number of consent checks, labeled by purpose and outcome (active, expired, not_found, revoked)",
+		}, []string{"purpose", "outcome"}),
 	}
 }
 
@@ -147,3 +162,12 @@ func (m *Metrics) IncrementConsentCheckFailedByReason(purpose, reason string) {
 	}
 	m.ConsentCheckFailedByReason.WithLabelValues(purpose, reason).Inc()
 }
+
+// IncrementConsentCheck records a consent check outcome for a purpose.
+// Outcome values: CheckOutcomeActive, CheckOutcomeExpired, CheckOutcomeNotFound, CheckOutcomeRevoked
+func (m *Metrics) IncrementConsentCheck(purpose, outcome string) {
+	if m == nil {
+		return
+	}
+	m.ConsentChecksTotal.WithLabelValues(purpose, outcome).Inc()
+}
@@ -0,0 +1,130 @@
+// Package middleware enforces consent at the HTTP boundary.
+//
+// RequireConsent rejects a request before its handler runs when the
+// authenticated user lacks active consent for the route's purpose. Services
+// keep their own consent checks so non-HTTP callers stay guarded; the
+// middleware gives sensitive routes one uniform gate and response. It reads
+// consent without recording the check, so each request is recorded once:
+// by the service when the request goes through, by the middleware when it
+// is denied.
+package middleware
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+
+	"credo/internal/consent/models"
+	id "credo/pkg/domain"
+	dErrors "credo/pkg/domain-errors"
+	audit "credo/pkg/platform/audit"
+	"credo/pkg/platform/audit/publishers/ops"
+	"credo/pkg/platform/httputil"
+	"credo/pkg/requestcontext"
+)
+
+// ConsentChecker verifies that a user has active consent for a purpose.
+// Check only reads; Require also records the outcome in the compliance audit.
+// Implemented by consent/service.Service.
+type ConsentChecker interface {
+	Check(ctx context.Context, userID id.UserID, purpose models.Purpose) error
+	Require(ctx context.Context, userID id.UserID, purpose models.Purpose) error
+}
+
+// Middleware gates routes on consent.
+type Middleware struct {
+	consent    ConsentChecker
+	logger     *slog.Logger
+	opsTracker *ops.Publisher
+}
+
+// Option configures a Middleware instance.
+type Option func(*Middleware)
+
+// WithOpsTracker emits a consent_checked operational event for every check
+// that reaches a decision. Events are skipped when unset.
+func WithOpsTracker(tracker *ops.Publisher) Option {
+	return func(m *Middleware) {
+		m.opsTracker = tracker
+	}
+}
+
+// New creates consent middleware backed by consent.
+func New(consent ConsentChecker, logger *slog.Logger, opts ...Option) *Middleware {
+	m := &Middleware{
+		consent: consent,
+		logger:  logger,
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// RequireConsent returns middleware that lets the request through only when
+// the authenticated user has active consent for purpose. Missing consent is a
+// 403 missing_consent; revoked or expired consent is a 403 invalid_consent.
+// It must run after auth.RequireAuth, which puts the user ID in the context.
+func (m *Middleware) RequireConsent(purpose models.Purpose) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := r.Context()
+			requestID := requestcontext.RequestID(ctx)
+
+			userID, err := httputil.RequireUserID(ctx, m.logger, requestID)
+			if err != nil {
+				httputil.WriteError(w, err)
+				return
+			}
+
+			err = m.consent.Check(ctx, userID, purpose)
+			if isConsentDenial(err) {
+				// A denied request never reaches the service, so the denial is recorded here.
+				err = m.consent.Require(ctx, userID, purpose)
+			}
+			if err != nil && !isConsentDenial(err) {
+				m.logger.ErrorContext(ctx, "consent check failed",
+					"request_id", requestID,
+					"user_id", userID,
+					"purpose", purpose,
+					"error", err,
+				)
+				httputil.WriteError(w, err)
+				return
+			}
+
+			decision := models.AuditDecisionGranted
+			if err != nil {
+				decision = models.AuditDecisionDenied
+			}
+			m.track(userID, purpose, decision, requestID)
+
+			if err != nil {
+				httputil.WriteError(w, err)
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// isConsentDenial reports whether err is the user's consent state rather than
+// a failure to read it.
+func isConsentDenial(err error) bool {
+	return dErrors.HasCode(err, dErrors.CodeMissingConsent) || dErrors.HasCode(err, dErrors.CodeInvalidConsent)
+}
+
+func (m *Middleware) track(userID id.UserID, purpose models.Purpose, decision, requestID string) {
+	if m.opsTracker == nil {
+		return
+	}
+	m.opsTracker.Track(audit.OpsEvent{
+		Action:    string(audit.EventConsentChecked),
+		Subject:   userID.String(),
+		RequestID: requestID,
+		Metadata: map[string]string{
+			audit.MetadataPurpose:  string(purpose),
+			audit.MetadataDecision: decision,
+		},
+	})
+}
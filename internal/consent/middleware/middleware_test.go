@@ -0,0 +1,144 @@
+package middleware
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"credo/internal/consent/models"
+	id "credo/pkg/domain"
+	dErrors "credo/pkg/domain-errors"
+	audit "credo/pkg/platform/audit"
+	"credo/pkg/platform/audit/publishers/ops"
+	auditmemory "credo/pkg/platform/audit/store/memory"
+	"credo/pkg/requestcontext"
+)
+
+// Justification: The middleware decides whether a handler runs at all; the
+// 403 body and the ops event are checked here because feature tests only see
+// the combined behaviour of the middleware and the service-level check.
+
+type stubConsentChecker struct {
+	err      error
+	recorded int // calls to Require, the recording check
+}
+
+func (c *stubConsentChecker) Check(context.Context, id.UserID, models.Purpose) error {
+	return c.err
+}
+
+func (c *stubConsentChecker) Require(context.Context, id.UserID, models.Purpose) error {
+	c.recorded++
+	return c.err
+}
+
+type RequireConsentSuite struct {
+	suite.Suite
+	auditStore *auditmemory.InMemoryStore
+	userID     id.UserID
+}
+
+func TestRequireConsentSuite(t *testing.T) {
+	suite.Run(t, new(RequireConsentSuite))
+}
+
+func (s *RequireConsentSuite) SetupSubTest() {
+	s.auditStore = auditmemory.NewInMemoryStore()
+	s.userID = id.UserID(uuid.New())
+}
+
+// serve runs one request through RequireConsent and reports whether the
+// handler behind it was reached.
+func (s *RequireConsentSuite) serve(checker ConsentChecker, userID id.UserID) (*httptest.ResponseRecorder, bool) {
+	mw := New(checker, slog.New(slog.NewTextHandler(io.Discard, nil)),
+		WithOpsTracker(ops.New(s.auditStore, ops.WithSampleRate(1))))
+	reached := false
+	next := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		reached = true
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	req := httptest.NewRequest(http.MethodPost, "/registry/citizen", nil)
+	if !userID.IsNil() {
+		req = req.WithContext(requestcontext.WithUserID(req.Context(), userID))
+	}
+	rr := httptest.NewRecorder()
+	mw.RequireConsent(models.PurposeRegistryCheck)(next).ServeHTTP(rr, req)
+	return rr, reached
+}
+
+// checkedEvent waits for the single consent_checked event.
+func (s *RequireConsentSuite) checkedEvent() audit.Event {
+	s.Eventually(func() bool {
+		events, err := s.auditStore.ListAll(context.Background())
+		return err == nil && len(events) == 1
+	}, time.Second, 10*time.Millisecond)
+	events, err := s.auditStore.ListAll(context.Background())
+	s.Require().NoError(err)
+	s.Require().Len(events, 1)
+	s.Equal(string(audit.EventConsentChecked), events[0].Action)
+	s.Equal(s.userID.String(), events[0].Subject)
+	s.Equal(string(models.PurposeRegistryCheck), events[0].Metadata[audit.MetadataPurpose])
+	return events[0]
+}
+
+func (s *RequireConsentSuite) TestRequireConsent() {
+	s.Run("active consent proceeds without recording the check", func() {
+		checker := &stubConsentChecker{}
+		rr, reached := s.serve(checker, s.userID)
+
+		s.True(reached)
+		s.Equal(http.StatusNoContent, rr.Code)
+		s.Zero(checker.recorded, "the service behind the route records the check")
+		s.Equal(models.AuditDecisionGranted, s.checkedEvent().Metadata[audit.MetadataDecision])
+	})
+
+	s.Run("missing consent is rejected with missing_consent", func() {
+		checker := &stubConsentChecker{err: dErrors.New(dErrors.CodeMissingConsent, "consent not granted for required purpose")}
+		rr, reached := s.serve(checker, s.userID)
+
+		s.False(reached)
+		s.Equal(http.StatusForbidden, rr.Code)
+		var body map[string]string
+		s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &body))
+		s.Equal("missing_consent", body["error"])
+		s.Equal("consent not granted for required purpose", body["error_description"])
+		s.Equal(models.AuditDecisionDenied, s.checkedEvent().Metadata[audit.MetadataDecision])
+		s.Equal(1, checker.recorded, "a denial is recorded once by the middleware")
+	})
+
+	s.Run("revoked consent is rejected with invalid_consent", func() {
+		rr, reached := s.serve(&stubConsentChecker{err: dErrors.New(dErrors.CodeInvalidConsent, "consent revoked")}, s.userID)
+
+		s.False(reached)
+		s.Equal(http.StatusForbidden, rr.Code)
+		s.Contains(rr.Body.String(), "invalid_consent")
+		s.Equal(models.AuditDecisionDenied, s.checkedEvent().Metadata[audit.MetadataDecision])
+	})
+
+	s.Run("store failure is an internal error without a decision event", func() {
+		rr, reached := s.serve(&stubConsentChecker{err: dErrors.New(dErrors.CodeInternal, "failed to read consent")}, s.userID)
+
+		s.False(reached)
+		s.Equal(http.StatusInternalServerError, rr.Code)
+		time.Sleep(20 * time.Millisecond)
+		events, err := s.auditStore.ListAll(context.Background())
+		s.Require().NoError(err)
+		s.Empty(events)
+	})
+
+	s.Run("missing user context is rejected before the check", func() {
+		rr, reached := s.serve(&stubConsentChecker{}, id.UserID{})
+
+		s.False(reached)
+		s.Equal(http.StatusInternalServerError, rr.Code)
+	})
+}
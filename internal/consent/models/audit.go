@@ -24,4 +24,5 @@ const (
 	AuditReasonSecurityConcern    = "security_concern"     // Admin revoked due to security incident
 	AuditReasonGdprSelfService    = "gdpr_self_service"    // User requested own data deletion
 	AuditReasonGdprErasureRequest = "gdpr_erasure_request" // Admin processed GDPR Art.17 erasure request
+	AuditReasonExpiryExtended     = "expiry_extended"      // Re-grant pushed out an expiring consent's expiry
 )
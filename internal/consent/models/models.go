@@ -111,14 +111,31 @@ type GrantEvaluation struct {
 	// WasActive indicates whether the record was active before evaluation.
 	// Used by callers to track metrics (e.g., new active consent vs renewal).
 	WasActive bool
+	// Extended indicates an active consent had its expiry pushed out to a fresh window.
+	Extended bool
 }
 
-// EvaluateGrant applies idempotency and re-grant cooldown rules to determine if a grant should proceed.
+// PurposeOutcome reports how one requested purpose fared in a partial grant.
+// Record is set when the purpose was granted; otherwise Reason says why not.
+type PurposeOutcome struct {
+	// Purpose is the purpose as requested, so unknown purposes can be reported.
+	Purpose string
+	Record  *Record
+	Reason  string
+}
+
+// Granted reports whether the purpose was granted.
+func (o PurposeOutcome) Granted() bool {
+	return o.Record != nil
+}
+
+// EvaluateGrant applies idempotency, renewal and re-grant cooldown rules to determine if a grant should proceed.
 // Returns a GrantEvaluation describing the outcome:
 //   - If active and within idempotencyWindow: no change (idempotent)
+//   - If active and not expiring within renewalWindow: no change (nothing to extend)
 //   - If recently revoked (within cooldown): returns error
-//   - Otherwise: returns renewed record with Changed=true
-func (c Record) EvaluateGrant(now time.Time, idempotencyWindow, reGrantCooldown, ttl time.Duration) (GrantEvaluation, error) {
+//   - Otherwise: returns renewed record with Changed=true (Extended=true if it was active)
+func (c Record) EvaluateGrant(now time.Time, idempotencyWindow, renewalWindow, reGrantCooldown, ttl time.Duration) (GrantEvaluation, error) {
 	eval := GrantEvaluation{WasActive: c.IsActive(now)}
 
 	// Idempotency: if active and recently granted, skip update
@@ -126,6 +143,12 @@ func (c Record) EvaluateGrant(now time.Time, idempotencyWindow, reGrantCooldown,
 		return eval, nil
 	}
 
+	// Renewal: only extend an active consent once it is close to expiring,
+	// so repeat grants don't churn records that are valid for months.
+	if eval.WasActive && !c.ExpiresWithin(now, renewalWindow) {
+		return eval, nil
+	}
+
 	// Re-grant cooldown: prevent rapid revoke→grant cycles
 	if !c.CanReGrant(now, reGrantCooldown) {
 		return GrantEvaluation{}, dErrors.New(dErrors.CodeBadRequest,
@@ -140,9 +163,16 @@ func (c Record) EvaluateGrant(now time.Time, idempotencyWindow, reGrantCooldown,
 
 	eval.Updated = updated
 	eval.Changed = true
+	eval.Extended = eval.WasActive
 	return eval, nil
 }
 
+// ExpiresWithin reports whether the consent expires no later than window from now.
+// Consents without an expiry never do.
+func (c Record) ExpiresWithin(now time.Time, window time.Duration) bool {
+	return c.ExpiresAt != nil && c.ExpiresAt.Sub(now) <= window
+}
+
 // RenewAt returns an updated record with a new grant window applied.
 // It clears RevokedAt and sets ExpiresAt based on the provided TTL.
 func (c Record) RenewAt(now time.Time, ttl time.Duration) (Record, error) {
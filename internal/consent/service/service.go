@@ -28,6 +28,7 @@ import (
 type Store interface {
 	Save(ctx context.Context, consent *models.Record) error
 	FindByScope(ctx context.Context, scope models.ConsentScope) (*models.Record, error)
+	// ListByUser returns records newest grant first, ties broken by ID.
 	ListByUser(ctx context.Context, userID id.UserID, filter *models.RecordFilter) ([]*models.Record, error)
 	Update(ctx context.Context, consent *models.Record) error
 	RevokeAllByUser(ctx context.Context, userID id.UserID, now time.Time) (int, error)
@@ -41,6 +42,7 @@ type Option func(*Service)
 const (
 	defaultConsentTTL             = 365 * 24 * time.Hour // 1 year
 	defaultGrantIdempotencyWindow = 5 * time.Minute
+	defaultRenewalWindow          = 30 * 24 * time.Hour // extend active consents expiring within 30 days
 	defaultReGrantCooldown        = models.DefaultReGrantCooldown
 )
 
@@ -54,6 +56,7 @@ type Service struct {
 	logger                 *slog.Logger
 	consentTTL             time.Duration
 	grantIdempotencyWindow time.Duration
+	renewalWindow          time.Duration
 	reGrantCooldown        time.Duration
 }
 
@@ -66,6 +69,7 @@ func New(store Store, auditor *compliance.Publisher, logger *slog.Logger, opts .
 		logger:                 logger,
 		consentTTL:             defaultConsentTTL,
 		grantIdempotencyWindow: defaultGrantIdempotencyWindow,
+		renewalWindow:          defaultRenewalWindow,
 		reGrantCooldown:        defaultReGrantCooldown,
 	}
 	for _, opt := range opts {
@@ -78,6 +82,9 @@ func New(store Store, auditor *compliance.Publisher, logger *slog.Logger, opts .
 	if svc.grantIdempotencyWindow <= 0 {
 		svc.grantIdempotencyWindow = defaultGrantIdempotencyWindow
 	}
+	if svc.renewalWindow <= 0 {
+		svc.renewalWindow = defaultRenewalWindow
+	}
 	if svc.reGrantCooldown <= 0 {
 		svc.reGrantCooldown = defaultReGrantCooldown
 	}
@@ -125,6 +132,17 @@ func WithGrantWindow(window time.Duration) Option {
 	}
 }
 
+// WithRenewalWindow configures how close to expiry an active consent must be
+// before a re-grant extends it. Active consents expiring later are left untouched.
+// If not set or set to zero/negative, defaults to 30 days.
+func WithRenewalWindow(window time.Duration) Option {
+	return func(s *Service) {
+		if window > 0 {
+			s.renewalWindow = window
+		}
+	}
+}
+
 // WithReGrantCooldown configures the minimum time after revocation before re-grant is allowed.
 // This prevents rapid revoke→grant cycles that could be abused.
 // If not set or set to zero/negative, defaults to 5 minutes.
@@ -223,7 +241,7 @@ func (s *Service) Grant(ctx context.Context, userID id.UserID, purposes []models
 	}
 
 	for _, effect := range effects {
-		s.emitGrantAudit(ctx, effect.record.UserID, effect.record.Purpose, effect.timestamp)
+		s.emitGrantAudit(ctx, effect.record.UserID, effect.record.Purpose, effect.extended, effect.timestamp)
 		s.metrics.IncrementConsentsGranted(string(effect.record.Purpose))
 		if !effect.wasActive {
 			s.metrics.IncrementActiveConsents(1)
@@ -233,9 +251,82 @@ func (s *Service) Grant(ctx context.Context, userID id.UserID, purposes []models
 	return granted, nil
 }
 
+// GrantPartial grants every acceptable purpose and reports the rest per
+// purpose instead of rejecting the whole request. Unknown purposes and purposes
+// the grant rules reject are reported in their outcome; any other error fails
+// the request, since it is not specific to one purpose. The accepted purposes
+// are granted in one transaction. Outcomes follow the order of purposes.
+func (s *Service) GrantPartial(ctx context.Context, userID id.UserID, purposes []string) ([]models.PurposeOutcome, error) {
+	if userID.IsNil() {
+		return nil, pkgerrors.New(pkgerrors.CodeUnauthorized, "user ID required")
+	}
+	if len(purposes) == 0 {
+		return nil, pkgerrors.New(pkgerrors.CodeBadRequest, "purposes array must not be empty")
+	}
+
+	var (
+		outcomes []models.PurposeOutcome
+		effects  []*grantEffect
+	)
+	txErr := s.withUserTx(ctx, userID, func(txCtx context.Context, txStore Store) error {
+		outcomes = make([]models.PurposeOutcome, 0, len(purposes))
+		effects = nil
+		for _, raw := range purposes {
+			outcome := models.PurposeOutcome{Purpose: raw}
+			record, effect, err := s.grantRequestedPurposeTx(txCtx, txStore, userID, raw)
+			switch {
+			case isPurposeRejection(err):
+				outcome.Reason = err.Error()
+			case err != nil:
+				return err
+			default:
+				outcome.Record = record
+				if effect != nil {
+					effects = append(effects, effect)
+				}
+			}
+			outcomes = append(outcomes, outcome)
+		}
+		return nil
+	})
+	if txErr != nil {
+		return nil, txErr
+	}
+
+	for _, effect := range effects {
+		s.emitGrantAudit(ctx, effect.record.UserID, effect.record.Purpose, effect.extended, effect.timestamp)
+		s.metrics.IncrementConsentsGranted(string(effect.record.Purpose))
+		if !effect.wasActive {
+			s.metrics.IncrementActiveConsents(1)
+		}
+	}
+
+	return outcomes, nil
+}
+
+// grantRequestedPurposeTx parses one requested purpose and grants it.
+func (s *Service) grantRequestedPurposeTx(ctx context.Context, txStore Store, userID id.UserID, raw string) (*models.Record, *grantEffect, error) {
+	purpose, err := models.ParsePurpose(raw)
+	if err != nil {
+		return nil, nil, pkgerrors.New(pkgerrors.CodeBadRequest, "invalid purpose")
+	}
+	scope, err := scopeForPurpose(userID, purpose)
+	if err != nil {
+		return nil, nil, err
+	}
+	return s.upsertGrantTx(ctx, txStore, scope)
+}
+
+// isPurposeRejection reports whether err rejects a single purpose rather than
+// the whole request.
+func isPurposeRejection(err error) bool {
+	return pkgerrors.HasCode(err, pkgerrors.CodeBadRequest) || pkgerrors.HasCode(err, pkgerrors.CodeValidation)
+}
+
 type grantEffect struct {
 	record    *models.Record
 	wasActive bool
+	extended  bool // an active consent's expiry was pushed out
 	timestamp time.Time
 }
 
@@ -292,7 +383,7 @@ func (s *Service) upsertGrantTx(ctx context.Context, txStore Store, scope models
 		record, err := txStore.Execute(ctx, scope,
 			func(existing *models.Record) error {
 				var err error
-				eval, err = existing.EvaluateGrant(now, s.grantIdempotencyWindow, s.reGrantCooldown, s.consentTTL)
+				eval, err = existing.EvaluateGrant(now, s.grantIdempotencyWindow, s.renewalWindow, s.reGrantCooldown, s.consentTTL)
 				return err
 			},
 			func(existing *models.Record) bool {
@@ -307,7 +398,7 @@ func (s *Service) upsertGrantTx(ctx context.Context, txStore Store, scope models
 			if !eval.Changed {
 				return record, nil, nil
 			}
-			return record, &grantEffect{record: record, wasActive: eval.WasActive, timestamp: now}, nil
+			return record, &grantEffect{record: record, wasActive: eval.WasActive, extended: eval.Extended, timestamp: now}, nil
 		}
 
 		if errors.Is(err, sentinel.ErrNotFound) {
@@ -356,15 +447,20 @@ func (s *Service) createGrantTx(ctx context.Context, txStore Store, scope models
 	return record, nil
 }
 
-// emitGrantAudit emits the audit event for a consent grant.
-func (s *Service) emitGrantAudit(ctx context.Context, userID id.UserID, purpose models.Purpose, now time.Time) {
-	s.emitAudit(ctx, audit.ComplianceEvent{
+// emitGrantAudit emits the audit event for a consent grant. Extensions of an
+// active consent are recorded as grants with the expiry_extended reason.
+func (s *Service) emitGrantAudit(ctx context.Context, userID id.UserID, purpose models.Purpose, extended bool, now time.Time) {
+	event := audit.ComplianceEvent{
 		UserID:    userID,
 		Purpose:   string(purpose),
 		Action:    models.AuditActionConsentGranted,
 		Decision:  models.AuditDecisionGranted,
 		Timestamp: now,
-	})
+	}
+	if extended {
+		event.Reason = models.AuditReasonExpiryExtended
+	}
+	s.emitAudit(ctx, event)
 }
 
 // Revoke revokes consent for the specified purposes.
@@ -483,7 +579,7 @@ func (s *Service) DeleteAll(ctx context.Context, userID id.UserID) error {
 	return nil
 }
 
-// List returns all consent records for a user.
+// List returns all consent records for a user, most recently granted first.
 // It applies optional filters and returns domain objects, not HTTP DTOs.
 func (s *Service) List(ctx context.Context, userID id.UserID, filter *models.RecordFilter) ([]*models.Record, error) {
 	if userID.IsNil() {
@@ -529,39 +625,53 @@ func filterRecords(records []*models.Record, filter *models.RecordFilter, now ti
 // Require enforces that a user has active consent for the given purpose.
 // It records audit/metrics outcomes for missing, revoked, expired, or active states.
 func (s *Service) Require(ctx context.Context, userID id.UserID, purpose models.Purpose) error {
+	outcome, err := s.evaluateConsent(ctx, userID, purpose)
+	if outcome != nil {
+		s.recordConsentCheckOutcome(ctx, userID, purpose, *outcome)
+	}
+	return err
+}
+
+// Check reports whether a user has active consent for the given purpose,
+// returning the same errors as Require but recording nothing. It suits a
+// pre-check ahead of a service that calls Require, which then records the
+// outcome once.
+func (s *Service) Check(ctx context.Context, userID id.UserID, purpose models.Purpose) error {
+	_, err := s.evaluateConsent(ctx, userID, purpose)
+	return err
+}
+
+// evaluateConsent reads the consent for purpose and decides the check. The
+// outcome is nil when no decision was reached: invalid input or a failed read.
+func (s *Service) evaluateConsent(ctx context.Context, userID id.UserID, purpose models.Purpose) (*consentCheckOutcome, error) {
 	if userID.IsNil() {
-		return pkgerrors.New(pkgerrors.CodeUnauthorized, "user ID required")
+		return nil, pkgerrors.New(pkgerrors.CodeUnauthorized, "user ID required")
 	}
 	if !purpose.IsValid() {
-		return pkgerrors.New(pkgerrors.CodeBadRequest, "invalid purpose")
+		return nil, pkgerrors.New(pkgerrors.CodeBadRequest, "invalid purpose")
 	}
 
 	scope, err := models.NewConsentScope(userID, purpose)
 	if err != nil {
-		return pkgerrors.New(pkgerrors.CodeBadRequest, "invalid consent scope")
+		return nil, pkgerrors.New(pkgerrors.CodeBadRequest, "invalid consent scope")
 	}
 
 	record, err := s.store.FindByScope(ctx, scope)
 	if err != nil {
 		if errors.Is(err, sentinel.ErrNotFound) {
-			s.recordConsentCheckOutcome(ctx, userID, purpose, outcomeMissing)
-			return pkgerrors.New(pkgerrors.CodeMissingConsent, "consent not granted for required purpose")
+			return &outcomeMissing, pkgerrors.New(pkgerrors.CodeMissingConsent, "consent not granted for required purpose")
 		}
-		return pkgerrors.Wrap(err, pkgerrors.CodeInternal, "failed to read consent")
+		return nil, pkgerrors.Wrap(err, pkgerrors.CodeInternal, "failed to read consent")
 	}
 
 	now := requestcontext.Now(ctx)
 	switch record.ComputeStatus(now) {
 	case models.StatusRevoked:
-		s.recordConsentCheckOutcome(ctx, userID, purpose, outcomeRevoked)
-		return pkgerrors.New(pkgerrors.CodeInvalidConsent, "consent revoked")
+		return &outcomeRevoked, pkgerrors.New(pkgerrors.CodeInvalidConsent, "consent revoked")
 	case models.StatusExpired:
-		s.recordConsentCheckOutcome(ctx, userID, purpose, outcomeExpired)
-		return pkgerrors.New(pkgerrors.CodeInvalidConsent, "consent expired")
+		return &outcomeExpired, pkgerrors.New(pkgerrors.CodeInvalidConsent, "consent expired")
 	}
-
-	s.recordConsentCheckOutcome(ctx, userID, purpose, outcomePassed)
-	return nil
+	return &outcomePassed, nil
 }
 
 // emitAudit publishes an audit event and logs any persistence failures.
@@ -614,6 +724,14 @@ func (o consentCheckOutcome) statusState() string {
 	return string(*o.status)
 }
 
+// metricOutcome returns the consent check outcome label for metrics.
+func (o consentCheckOutcome) metricOutcome() string {
+	if o.status == nil {
+		return consentmetrics.CheckOutcomeNotFound
+	}
+	return string(*o.status)
+}
+
 var (
 	statusRevoked  = models.StatusRevoked
 	statusExpired  = models.StatusExpired
@@ -644,6 +762,7 @@ func (s *Service) recordConsentCheckOutcome(ctx context.Context, userID id.UserI
 		Timestamp: now,
 	})
 	s.logConsentCheck(ctx, logLevel, logMsg, userID, purpose, outcome.statusState())
+	s.metrics.IncrementConsentCheck(string(purpose), outcome.metricOutcome())
 	if outcome.passed {
 		s.metrics.IncrementConsentCheckPassed(string(purpose))
 	} else {
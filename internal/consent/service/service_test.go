@@ -22,10 +22,12 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
 	"go.uber.org/mock/gomock"
 
+	consentmetrics "credo/internal/consent/metrics"
 	"credo/internal/consent/models"
 	"credo/internal/consent/service/mocks"
 	id "credo/pkg/domain"
@@ -221,6 +223,171 @@ func (s *ServiceSuite) TestGrant_IdempotentSkip() {
 	s.Assert().Len(events, 0)
 }
 
+// TestGrant_RenewalWindow verifies re-grants extend only consents close to expiry.
+// Invariant: An active consent expiring within the renewal window gets a fresh TTL and an
+// audited extension; one expiring later is left untouched with no audit event.
+func (s *ServiceSuite) TestGrant_RenewalWindow() {
+	now := time.Now()
+	ctx := requestcontext.WithTime(context.Background(), now)
+
+	grantExisting := func(existing *models.Record) {
+		s.mockStore.EXPECT().
+			Execute(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ models.ConsentScope, validate func(*models.Record) error, mutate func(*models.Record) bool) (*models.Record, error) {
+				if err := validate(existing); err != nil { //nolint:govet // callback scope, not shadowing outer err
+					return nil, err
+				}
+				mutate(existing)
+				return existing, nil
+			})
+	}
+
+	s.Run("soon-to-expire consent is extended in place", func() {
+		userID := id.UserID(uuid.New())
+		consentID := id.ConsentID(uuid.New())
+		existing := &models.Record{
+			ID:        consentID,
+			UserID:    userID,
+			Purpose:   models.PurposeLogin,
+			GrantedAt: now.Add(-360 * 24 * time.Hour),
+			ExpiresAt: ptrTime(now.Add(5 * 24 * time.Hour)),
+		}
+		grantExisting(existing)
+
+		records, err := s.service.Grant(ctx, userID, []models.Purpose{models.PurposeLogin})
+		s.Require().NoError(err)
+		s.Require().Len(records, 1)
+		s.Equal(consentID, records[0].ID, "extension reuses the existing record")
+		s.Require().NotNil(records[0].ExpiresAt)
+		s.WithinDuration(now.Add(365*24*time.Hour), *records[0].ExpiresAt, time.Second)
+
+		events, err := s.auditStore.ListByUser(context.Background(), userID)
+		s.Require().NoError(err)
+		s.Require().Len(events, 1)
+		s.Equal(models.AuditActionConsentGranted, events[0].Action)
+		s.Equal(models.AuditReasonExpiryExtended, events[0].Reason)
+	})
+
+	s.Run("far-future consent is not churned", func() {
+		userID := id.UserID(uuid.New())
+		grantedAt := now.Add(-24 * time.Hour)
+		expiresAt := grantedAt.Add(365 * 24 * time.Hour)
+		existing := &models.Record{
+			ID:        id.ConsentID(uuid.New()),
+			UserID:    userID,
+			Purpose:   models.PurposeLogin,
+			GrantedAt: grantedAt,
+			ExpiresAt: ptrTime(expiresAt),
+		}
+		grantExisting(existing)
+
+		records, err := s.service.Grant(ctx, userID, []models.Purpose{models.PurposeLogin})
+		s.Require().NoError(err)
+		s.Require().Len(records, 1)
+		s.Equal(grantedAt, records[0].GrantedAt)
+		s.Equal(expiresAt, *records[0].ExpiresAt)
+
+		events, err := s.auditStore.ListByUser(context.Background(), userID)
+		s.Require().NoError(err)
+		s.Empty(events)
+	})
+}
+
+// countingTx runs each transaction inline and counts how many were opened.
+type countingTx struct {
+	store Store
+	runs  int
+}
+
+func (t *countingTx) RunInTx(ctx context.Context, fn func(ctx context.Context, store Store) error) error {
+	t.runs++
+	return fn(ctx, t.store)
+}
+
+// TestGrantPartial verifies per-purpose outcomes of a partial grant.
+// Invariant: Unknown or rule-rejected purposes are reported without blocking the rest,
+// every accepted purpose is granted in one transaction, and other errors fail the request.
+// Reason not a feature test: forcing a re-grant cooldown for one purpose needs a mocked store.
+func (s *ServiceSuite) TestGrantPartial() {
+	now := time.Now()
+	ctx := requestcontext.WithTime(context.Background(), now)
+	newService := func() (*Service, *countingTx) {
+		tx := &countingTx{store: s.mockStore}
+		return New(s.mockStore, compliance.New(s.auditStore), slog.New(slog.NewTextHandler(io.Discard, nil)),
+			WithTx(tx), WithConsentTTL(365*24*time.Hour), WithReGrantCooldown(time.Hour)), tx
+	}
+
+	s.Run("grants accepted purposes and reports the rest in one transaction", func() {
+		userID := id.UserID(uuid.New())
+		svc, tx := newService()
+		recentlyRevoked := &models.Record{
+			ID:        id.ConsentID(uuid.New()),
+			UserID:    userID,
+			Purpose:   models.PurposeRegistryCheck,
+			GrantedAt: now.Add(-24 * time.Hour),
+			RevokedAt: ptrTime(now.Add(-time.Minute)),
+		}
+		s.mockStore.EXPECT().
+			Execute(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, scope models.ConsentScope, validate func(*models.Record) error, mutate func(*models.Record) bool) (*models.Record, error) {
+				if scope.Purpose != models.PurposeRegistryCheck {
+					return nil, sentinel.ErrNotFound
+				}
+				if err := validate(recentlyRevoked); err != nil {
+					return nil, err
+				}
+				mutate(recentlyRevoked)
+				return recentlyRevoked, nil
+			}).Times(3)
+		s.mockStore.EXPECT().Save(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+
+		outcomes, err := svc.GrantPartial(ctx, userID, []string{
+			models.PurposeLogin.String(),
+			"invalid_purpose",
+			models.PurposeRegistryCheck.String(),
+			models.PurposeVCIssuance.String(),
+		})
+		s.Require().NoError(err)
+		s.Equal(1, tx.runs)
+		s.Require().Len(outcomes, 4)
+		s.True(outcomes[0].Granted())
+		s.Equal(models.PurposeLogin, outcomes[0].Record.Purpose)
+		s.False(outcomes[1].Granted())
+		s.Equal("invalid purpose", outcomes[1].Reason)
+		s.False(outcomes[2].Granted())
+		s.Contains(outcomes[2].Reason, "recently revoked")
+		s.True(outcomes[3].Granted())
+
+		events, err := s.auditStore.ListByUser(context.Background(), userID)
+		s.Require().NoError(err)
+		s.Len(events, 2, "only granted purposes are audited")
+	})
+
+	s.Run("store failure fails the whole request", func() {
+		userID := id.UserID(uuid.New())
+		svc, _ := newService()
+		s.mockStore.EXPECT().
+			Execute(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(nil, assert.AnError)
+
+		outcomes, err := svc.GrantPartial(ctx, userID, []string{models.PurposeLogin.String(), models.PurposeVCIssuance.String()})
+		s.Require().Error(err)
+		s.Nil(outcomes)
+		s.True(dErrors.HasCode(err, dErrors.CodeInternal))
+
+		events, err := s.auditStore.ListByUser(context.Background(), userID)
+		s.Require().NoError(err)
+		s.Empty(events)
+	})
+
+	s.Run("empty purposes returns CodeBadRequest", func() {
+		svc, _ := newService()
+		_, err := svc.GrantPartial(ctx, id.UserID(uuid.New()), nil)
+		s.Require().Error(err)
+		s.True(dErrors.HasCode(err, dErrors.CodeBadRequest))
+	})
+}
+
 func ptrTime(t time.Time) *time.Time {
 	return &t
 }
@@ -313,6 +480,86 @@ func (s *ServiceSuite) TestRequire_ConsentStates() {
 	})
 }
 
+// TestRequire_OutcomeMetrics verifies each consent check outcome increments its counter.
+// Invariant: every Require call that reaches the store is counted exactly once by purpose and outcome.
+// Reason not a feature test: metric labels are not observable through the HTTP API.
+func (s *ServiceSuite) TestRequire_OutcomeMetrics() {
+	// Collectors register globally, so one instance serves every subtest
+	m := consentmetrics.New()
+	s.service.metrics = m
+
+	now := time.Now()
+	future := now.Add(time.Hour)
+	expired := now.Add(-time.Hour)
+
+	tests := []struct {
+		name    string
+		purpose models.Purpose
+		record  *models.Record
+		outcome string
+	}{
+		{"missing consent counts not_found", models.PurposeLogin, nil, consentmetrics.CheckOutcomeNotFound},
+		{"revoked consent counts revoked", models.PurposeRegistryCheck, &models.Record{RevokedAt: &now, ExpiresAt: &future}, consentmetrics.CheckOutcomeRevoked},
+		{"expired consent counts expired", models.PurposeVCIssuance, &models.Record{ExpiresAt: &expired}, consentmetrics.CheckOutcomeExpired},
+		{"active consent counts active", models.PurposeDecision, &models.Record{ExpiresAt: &future}, consentmetrics.CheckOutcomeActive},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			if tc.record == nil {
+				s.mockStore.EXPECT().FindByScope(gomock.Any(), gomock.Any()).Return(nil, sentinel.ErrNotFound)
+			} else {
+				tc.record.ID = id.ConsentID(uuid.New())
+				tc.record.Purpose = tc.purpose
+				s.mockStore.EXPECT().FindByScope(gomock.Any(), gomock.Any()).Return(tc.record, nil)
+			}
+
+			_ = s.service.Require(context.Background(), id.UserID(uuid.New()), tc.purpose) //nolint:errcheck // only the metrics are under test
+
+			s.InDelta(1.0, testutil.ToFloat64(m.ConsentChecksTotal.WithLabelValues(string(tc.purpose), tc.outcome)), 0)
+			for _, other := range []string{
+				consentmetrics.CheckOutcomeActive, consentmetrics.CheckOutcomeExpired,
+				consentmetrics.CheckOutcomeNotFound, consentmetrics.CheckOutcomeRevoked,
+			} {
+				if other != tc.outcome {
+					s.InDelta(0.0, testutil.ToFloat64(m.ConsentChecksTotal.WithLabelValues(string(tc.purpose), other)), 0)
+				}
+			}
+		})
+	}
+}
+
+// TestCheck_RecordsNothing verifies Check decides like Require without side effects.
+// Invariant: Check returns Require's error codes but writes no audit event.
+// Reason not a feature test: the consent middleware relies on Check not double-recording, which HTTP responses cannot show.
+func (s *ServiceSuite) TestCheck_RecordsNothing() {
+	s.Run("missing consent returns CodeMissingConsent without an audit event", func() {
+		s.mockStore.EXPECT().FindByScope(gomock.Any(), gomock.Any()).Return(nil, sentinel.ErrNotFound)
+
+		err := s.service.Check(context.Background(), id.UserID(uuid.New()), models.PurposeVCIssuance)
+		s.True(dErrors.HasCode(err, dErrors.CodeMissingConsent))
+
+		events, listErr := s.auditStore.ListAll(context.Background())
+		s.Require().NoError(listErr)
+		s.Empty(events)
+	})
+
+	s.Run("active consent returns nil without an audit event", func() {
+		future := time.Now().Add(time.Hour)
+		s.mockStore.EXPECT().FindByScope(gomock.Any(), gomock.Any()).Return(&models.Record{
+			ID:        id.ConsentID(uuid.New()),
+			Purpose:   models.PurposeVCIssuance,
+			ExpiresAt: &future,
+		}, nil)
+
+		s.Require().NoError(s.service.Check(context.Background(), id.UserID(uuid.New()), models.PurposeVCIssuance))
+
+		events, err := s.auditStore.ListAll(context.Background())
+		s.Require().NoError(err)
+		s.Empty(events)
+	})
+}
+
 // TestRequire_TimeBoundary verifies the exact boundary behavior for consent expiry.
 // Invariant: Consent with ExpiresAt == now (or 1 nanosecond ago) should be treated as expired.
 // Reason not a feature test: Tests precise timing boundary that cannot be controlled in e2e.
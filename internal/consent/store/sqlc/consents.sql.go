@@ -107,6 +107,7 @@ const listConsentsByUser = `-- name: ListConsentsByUser :many
 SELECT id, user_id, purpose, granted_at, expires_at, revoked_at
 FROM consents
 WHERE user_id = $1
+ORDER BY granted_at DESC, id
 `
 
 func (q *Queries) ListConsentsByUser(ctx context.Context, userID uuid.UUID) ([]Consent, error) {
@@ -143,6 +144,7 @@ const listConsentsByUserAndPurpose = `-- name: ListConsentsByUserAndPurpose :man
 SELECT id, user_id, purpose, granted_at, expires_at, revoked_at
 FROM consents
 WHERE user_id = $1 AND purpose = $2
+ORDER BY granted_at DESC, id
 `
 
 type ListConsentsByUserAndPurposeParams struct {
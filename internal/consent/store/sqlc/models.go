@@ -80,6 +80,16 @@ type Client struct {
 	Status        string
 	CreatedAt     time.Time
 	UpdatedAt     time.Time
+	// Display-only metadata for consent and admin UIs; never used in authorization decisions.
+	DisplayName    string
+	LogoUri        string
+	Description    string
+	SupportContact string
+	// bcrypt hash of the secret replaced by the last rotation, accepted until previous_secret_expires_at.
+	PreviousSecretHash      sql.NullString
+	PreviousSecretExpiresAt sql.NullTime
+	// Absolute URIs the client may request as token audiences via the resource parameter (RFC 8707).
+	AllowedResources json.RawMessage
 }
 
 // Purpose-based user consent records. Unique per (user_id, purpose).
@@ -110,6 +120,8 @@ type Outbox struct {
 	CreatedAt     time.Time
 	// NULL = pending, non-NULL = published. Enables at-least-once delivery.
 	ProcessedAt sql.NullTime
+	// Optional caller-supplied key; duplicate appends with the same key are ignored.
+	DedupKey sql.NullString
 }
 
 type RateLimitAllowlist struct {
@@ -175,10 +187,19 @@ type Session struct {
 type Tenant struct {
 	ID   uuid.UUID
 	Name string
-	// Lifecycle: active <-> inactive. Enforced in service layer.
+	// Lifecycle: active <-> inactive, either -> deleted (terminal). Enforced in service layer.
 	Status    string
 	CreatedAt time.Time
 	UpdatedAt time.Time
+	// Soft-delete time; the tenant is hard-deleted once this is older than the retention window.
+	DeletedAt sql.NullTime
+}
+
+// Idempotency keys of tenant create requests. A retry with the same key and name returns the original tenant.
+type TenantIdempotencyKey struct {
+	IdempotencyKey string
+	TenantID       uuid.UUID
+	CreatedAt      time.Time
 }
 
 type TokenRevocation struct {
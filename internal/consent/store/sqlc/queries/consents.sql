@@ -18,12 +18,14 @@ FOR UPDATE;
 -- name: ListConsentsByUser :many
 SELECT id, user_id, purpose, granted_at, expires_at, revoked_at
 FROM consents
-WHERE user_id = $1;
+WHERE user_id = $1
+ORDER BY granted_at DESC, id;
 
 -- name: ListConsentsByUserAndPurpose :many
 SELECT id, user_id, purpose, granted_at, expires_at, revoked_at
 FROM consents
-WHERE user_id = $1 AND purpose = $2;
+WHERE user_id = $1 AND purpose = $2
+ORDER BY granted_at DESC, id;
 
 -- name: UpdateConsent :execresult
 UPDATE consents
@@ -3,6 +3,8 @@ package store
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strings"
 	"sync"
 	"time"
 
@@ -79,6 +81,7 @@ func (s *InMemoryStore) ListByUser(ctx context.Context, userID id.UserID, filter
 		filtered = append(filtered, &copyRecord)
 	}
 
+	sortNewestFirst(filtered)
 	return filtered, nil
 }
 
@@ -149,3 +152,14 @@ func (s *InMemoryStore) Execute(_ context.Context, scope models.ConsentScope, va
 	}
 	return &copyRecord, nil
 }
+
+// sortNewestFirst orders records by GrantedAt descending, breaking ties by ID so
+// the order matches the Postgres store.
+func sortNewestFirst(records []*models.Record) {
+	slices.SortFunc(records, func(a, b *models.Record) int {
+		if c := b.GrantedAt.Compare(a.GrantedAt); c != 0 {
+			return c
+		}
+		return strings.Compare(a.ID.String(), b.ID.String())
+	})
+}
@@ -227,6 +227,46 @@ func (s *InMemoryStoreSuite) TestCopySemanticsPreventsMutation() {
 	})
 }
 
+// TestListByUserOrder verifies listings are newest grant first.
+// Invariant: Order is stable across calls and does not depend on map iteration.
+func (s *InMemoryStoreSuite) TestListByUserOrder() {
+	base := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	userID := id.UserID(uuid.New())
+	save := func(purpose models.Purpose, grantedAt time.Time) {
+		s.Require().NoError(s.store.Save(s.ctx, &models.Record{
+			ID:        id.ConsentID(uuid.New()),
+			UserID:    userID,
+			Purpose:   purpose,
+			GrantedAt: grantedAt,
+		}))
+	}
+	purposes := func() []models.Purpose {
+		records, err := s.store.ListByUser(s.ctx, userID, nil)
+		s.Require().NoError(err)
+		out := make([]models.Purpose, 0, len(records))
+		for _, record := range records {
+			out = append(out, record.Purpose)
+		}
+		return out
+	}
+
+	save(models.PurposeRegistryCheck, base.Add(time.Hour))
+	save(models.PurposeLogin, base)
+	save(models.PurposeDecision, base.Add(2*time.Hour))
+
+	s.Run("orders by grant time descending on every call", func() {
+		want := []models.Purpose{models.PurposeDecision, models.PurposeRegistryCheck, models.PurposeLogin}
+		for range 10 {
+			s.Equal(want, purposes())
+		}
+	})
+
+	s.Run("a newer grant lands first", func() {
+		save(models.PurposeVCIssuance, base.Add(3*time.Hour))
+		s.Equal(models.PurposeVCIssuance, purposes()[0])
+	})
+}
+
 // =============================================================================
 // Delete - GDPR Erasure
 // =============================================================================
@@ -331,6 +331,48 @@ func (s *PostgresStoreSuite) TestListByUserUnderConcurrentModification() {
 	s.Equal(int32(0), listErrors.Load(), "no list errors expected")
 }
 
+// TestListByUserOrder verifies the query orders consents newest grant first and
+// that re-granting an existing purpose moves it to the front.
+func (s *PostgresStoreSuite) TestListByUserOrder() {
+	ctx := context.Background()
+	userID := s.createTestUser(ctx)
+	base := time.Now().UTC().Truncate(time.Microsecond).Add(-time.Hour)
+
+	for i, purpose := range []models.Purpose{models.PurposeLogin, models.PurposeRegistryCheck, models.PurposeDecision} {
+		consent := testutil.NewConsentRecordBuilder().
+			WithUserID(userID).
+			WithPurpose(purpose).
+			WithGrantedAt(base.Add(time.Duration(i) * time.Minute)).
+			Build()
+		s.Require().NoError(s.store.Save(ctx, consent))
+	}
+	purposes := func() []models.Purpose {
+		records, err := s.store.ListByUser(ctx, userID, nil)
+		s.Require().NoError(err)
+		out := make([]models.Purpose, 0, len(records))
+		for _, record := range records {
+			out = append(out, record.Purpose)
+		}
+		return out
+	}
+
+	want := []models.Purpose{models.PurposeDecision, models.PurposeRegistryCheck, models.PurposeLogin}
+	for range 5 {
+		s.Equal(want, purposes())
+	}
+
+	scope := models.ConsentScope{UserID: userID, Purpose: models.PurposeLogin}
+	_, err := s.store.Execute(ctx, scope,
+		func(r *models.Record) error { return nil },
+		func(r *models.Record) bool {
+			r.GrantedAt = base.Add(time.Hour)
+			return true
+		},
+	)
+	s.Require().NoError(err)
+	s.Equal([]models.Purpose{models.PurposeLogin, models.PurposeDecision, models.PurposeRegistryCheck}, purposes())
+}
+
 // TestNotFoundError verifies proper error handling for non-existent records.
 func (s *PostgresStoreSuite) TestNotFoundError() {
 	ctx := context.Background()
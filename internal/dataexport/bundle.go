@@ -0,0 +1,144 @@
+package dataexport
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"time"
+
+	id "credo/pkg/domain"
+)
+
+// FormatVersion identifies the export document layout. Bump on breaking changes.
+const FormatVersion = "1"
+
+// Bundle is the data held about a single user. The profile is loaded up
+// front; the other sections are read from their stores while streaming.
+type Bundle struct {
+	ExportedAt time.Time
+	Profile    Profile
+
+	service *Service
+	userID  id.UserID
+	filter  Filter
+}
+
+// Summary reports what a streamed export contained.
+type Summary struct {
+	Bytes       int64
+	Sessions    int
+	Consents    int
+	AuditEvents int
+}
+
+// Profile is the user's account record.
+type Profile struct {
+	UserID    string `json:"user_id"`
+	TenantID  string `json:"tenant_id"`
+	Email     string `json:"email"`
+	FirstName string `json:"first_name"`
+	LastName  string `json:"last_name"`
+	Verified  bool   `json:"verified"`
+	Active    bool   `json:"active"`
+}
+
+// Session is session metadata; tokens are never exported.
+type Session struct {
+	ID        string    `json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+	ExpiresAt time.Time `json:"expires_at"`
+	Active    bool      `json:"active"`
+}
+
+// Consent is one consent record with its lifecycle timestamps.
+type Consent struct {
+	ID        string     `json:"id"`
+	Purpose   string     `json:"purpose"`
+	Status    string     `json:"status"`
+	GrantedAt time.Time  `json:"granted_at"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	RevokedAt *time.Time `json:"revoked_at,omitempty"`
+}
+
+// AuditEvent is an audit trail entry about the user.
+type AuditEvent struct {
+	Timestamp       time.Time `json:"timestamp"`
+	Category        string    `json:"category"`
+	Action          string    `json:"action"`
+	Purpose         string    `json:"purpose,omitempty"`
+	RequestingParty string    `json:"requesting_party,omitempty"`
+	Decision        string    `json:"decision,omitempty"`
+	Reason          string    `json:"reason,omitempty"`
+	RequestID       string    `json:"request_id,omitempty"`
+}
+
+// Stream writes the bundle as a single JSON document. Each section is read
+// and written in turn, audit events one page at a time, so neither the data
+// nor the serialized export is held in memory. A failure part-way leaves the
+// document truncated.
+func (b *Bundle) Stream(ctx context.Context, w io.Writer) (summary Summary, err error) {
+	sw := &streamWriter{w: w}
+	sw.enc = json.NewEncoder(sw)
+	defer func() { summary.Bytes = sw.n }()
+
+	sw.raw(`{"format_version":`)
+	sw.value(FormatVersion)
+	sw.raw(`,"exported_at":`)
+	sw.value(b.ExportedAt)
+	sw.raw(`,"profile":`)
+	sw.value(b.Profile)
+	sw.raw(`,"sessions":`)
+	if summary.Sessions, err = b.service.writeSessions(ctx, b.userID, sw); err != nil {
+		return summary, err
+	}
+	sw.raw(`,"consents":`)
+	if summary.Consents, err = b.service.writeConsents(ctx, b.userID, sw); err != nil {
+		return summary, err
+	}
+	sw.raw(`,"audit_events":`)
+	if summary.AuditEvents, err = b.service.writeAuditEvents(ctx, b.userID, b.filter, sw); err != nil {
+		return summary, err
+	}
+	sw.raw("}\n")
+	return summary, sw.err
+}
+
+// streamWriter writes JSON fragments, counting bytes and remembering the first
+// error so callers can chain writes and check once.
+type streamWriter struct {
+	w   io.Writer
+	enc *json.Encoder
+	n   int64
+	err error
+}
+
+func (sw *streamWriter) Write(p []byte) (int, error) {
+	n, err := sw.w.Write(p)
+	sw.n += int64(n)
+	return n, err
+}
+
+func (sw *streamWriter) raw(s string) {
+	if sw.err != nil {
+		return
+	}
+	_, sw.err = io.WriteString(sw, s)
+}
+
+func (sw *streamWriter) value(v any) {
+	if sw.err != nil {
+		return
+	}
+	sw.err = sw.enc.Encode(v)
+}
+
+func writeArray[T any](sw *streamWriter, items []T) {
+	sw.raw("[")
+	for i := range items {
+		if i > 0 {
+			sw.raw(",")
+		}
+		sw.value(items[i])
+	}
+	sw.raw("]")
+}
@@ -0,0 +1,121 @@
+package dataexport
+
+import (
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+
+	dErrors "credo/pkg/domain-errors"
+	"credo/pkg/platform/httputil"
+	"credo/pkg/requestcontext"
+)
+
+// Handler serves the subject access export for the authenticated user
+type Handler struct {
+	service *Service
+	logger  *slog.Logger
+}
+
+// New creates a new data export handler
+func New(service *Service, logger *slog.Logger) *Handler {
+	return &Handler{
+		service: service,
+		logger:  logger,
+	}
+}
+
+// Register registers data export routes with the router
+func (h *Handler) Register(r chi.Router) {
+	r.Get("/me/data-export", h.HandleDataExport)
+}
+
+// HandleDataExport implements GET /me/data-export.
+// The user is taken from the access token only, so a caller can never export
+// another user's data. Optional from, to and action query parameters narrow
+// the audit events section.
+func (h *Handler) HandleDataExport(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := requestcontext.RequestID(ctx)
+
+	userID := requestcontext.UserID(ctx)
+	if userID.IsNil() {
+		h.logger.WarnContext(ctx, "user_id missing from auth context",
+			"request_id", requestID,
+		)
+		httputil.WriteError(w, dErrors.New(dErrors.CodeUnauthorized, "invalid token"))
+		return
+	}
+
+	filter, err := parseFilter(r.URL.Query())
+	if err != nil {
+		httputil.WriteError(w, err)
+		return
+	}
+
+	bundle, err := h.service.Export(ctx, userID, filter)
+	if err != nil {
+		h.logger.ErrorContext(ctx, "failed to assemble data export",
+			"error", err,
+			"request_id", requestID,
+			"user_id", userID.String(),
+		)
+		httputil.WriteError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Content-Disposition", `attachment; filename="credo-data-export.json"`)
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(http.StatusOK)
+
+	// Headers are sent, so a failure from here on can only truncate the body.
+	summary, err := bundle.Stream(ctx, w)
+	if err != nil {
+		h.logger.WarnContext(ctx, "data export stream interrupted",
+			"error", err,
+			"request_id", requestID,
+			"user_id", userID.String(),
+			"bytes_written", summary.Bytes,
+		)
+		return
+	}
+
+	h.logger.InfoContext(ctx, "data export delivered",
+		"request_id", requestID,
+		"user_id", userID.String(),
+		"sessions", summary.Sessions,
+		"consents", summary.Consents,
+		"audit_events", summary.AuditEvents,
+	)
+}
+
+// parseFilter reads the audit event filter from the query string. from and to
+// are RFC 3339 timestamps.
+func parseFilter(query url.Values) (Filter, error) {
+	filter := Filter{Action: query.Get("action")}
+	for _, bound := range []struct {
+		name   string
+		target *time.Time
+	}{
+		{"from", &filter.From},
+		{"to", &filter.To},
+	} {
+		raw := query.Get(bound.name)
+		if raw == "" {
+			continue
+		}
+		parsed, err := time.Parse(time.RFC3339, raw)
+		if err != nil {
+			return Filter{}, dErrors.New(dErrors.CodeValidation, fmt.Sprintf("%s must be an RFC 3339 timestamp", bound.name))
+		}
+		*bound.target = parsed
+	}
+	if !filter.From.IsZero() && !filter.To.IsZero() && filter.From.After(filter.To) {
+		return Filter{}, dErrors.New(dErrors.CodeValidation, "from must not be after to")
+	}
+	return filter, nil
+}
@@ -0,0 +1,162 @@
+package dataexport
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sessionStore "credo/internal/auth/store/session"
+	userStore "credo/internal/auth/store/user"
+	id "credo/pkg/domain"
+	"credo/pkg/platform/audit"
+	auditmemory "credo/pkg/platform/audit/store/memory"
+	"credo/pkg/testutil"
+)
+
+type stubConsentHistory struct {
+	consents map[id.UserID][]Consent
+}
+
+func (s *stubConsentHistory) ListConsents(_ context.Context, userID id.UserID) ([]Consent, error) {
+	return s.consents[userID], nil
+}
+
+func TestHandleDataExport(t *testing.T) {
+	ctx := context.Background()
+	userID := testutil.TestIDs.UserID1
+	otherID := testutil.TestIDs.UserID2
+
+	users := userStore.New()
+	require.NoError(t, users.Save(ctx, testutil.NewUserBuilder().WithID(userID).WithEmail("alice@example.com").Build()))
+	require.NoError(t, users.Save(ctx, testutil.NewUserBuilder().WithID(otherID).WithEmail("bob@example.com").Build()))
+
+	sessions := sessionStore.New()
+	own := testutil.NewSessionBuilder().WithUserID(userID).WithDeviceID("device-secret").Build()
+	own.LastAccessTokenJTI = "jti-secret"
+	own.DeviceFingerprintHash = "fingerprint-secret"
+	require.NoError(t, sessions.Create(ctx, own))
+	require.NoError(t, sessions.Create(ctx, testutil.NewSessionBuilder().WithUserID(otherID).Build()))
+
+	auditStore := auditmemory.NewInMemoryStore()
+	require.NoError(t, auditStore.Append(ctx, audit.Event{
+		Category:      audit.CategoryCompliance,
+		Timestamp:     time.Now(),
+		UserID:        userID,
+		Action:        "decision_made",
+		Purpose:       "decision_evaluation",
+		Decision:      "pass",
+		ActorID:       "admin-actor-secret",
+		SubjectIDHash: "subject-hash-secret",
+	}))
+	require.NoError(t, auditStore.Append(ctx, audit.Event{
+		Category:  audit.CategorySecurity,
+		Timestamp: time.Now(),
+		UserID:    otherID,
+		Action:    "user_created",
+		Email:     "bob@example.com",
+	}))
+
+	consents := &stubConsentHistory{consents: map[id.UserID][]Consent{
+		userID:  {{ID: "consent-1", Purpose: "login", Status: "revoked", GrantedAt: time.Now()}},
+		otherID: {{ID: "consent-other", Purpose: "login", Status: "active", GrantedAt: time.Now()}},
+	}}
+
+	service := NewService(users, sessions, consents, auditStore)
+	router := chi.NewRouter()
+	New(service, slog.New(slog.NewTextHandler(io.Discard, nil))).Register(router)
+
+	exportAuditEvents := func(t *testing.T, target string) []AuditEvent {
+		t.Helper()
+		req := testutil.WithUserID(testutil.NewRequest(t, http.MethodGet, target), userID.String())
+		rr := testutil.DoRequest(router, req)
+		testutil.AssertStatusOK(t, rr)
+		var bundle struct {
+			AuditEvents []AuditEvent `json:"audit_events"`
+		}
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &bundle))
+		return bundle.AuditEvents
+	}
+
+	t.Run("contains every section for the caller only", func(t *testing.T) {
+		req := testutil.WithUserID(testutil.NewRequest(t, http.MethodGet, "/me/data-export"), userID.String())
+		rr := testutil.DoRequest(router, req)
+		testutil.AssertStatusOK(t, rr)
+		assert.Contains(t, rr.Header().Get("Content-Disposition"), "attachment")
+		assert.Equal(t, "no-store", rr.Header().Get("Cache-Control"))
+
+		var doc map[string]json.RawMessage
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &doc))
+		for _, section := range []string{"format_version", "exported_at", "profile", "sessions", "consents", "audit_events"} {
+			assert.Contains(t, doc, section)
+		}
+
+		var bundle struct {
+			Profile     Profile      `json:"profile"`
+			Sessions    []Session    `json:"sessions"`
+			Consents    []Consent    `json:"consents"`
+			AuditEvents []AuditEvent `json:"audit_events"`
+		}
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &bundle))
+		assert.Equal(t, "alice@example.com", bundle.Profile.Email)
+		require.Len(t, bundle.Sessions, 1)
+		assert.Equal(t, own.ID.String(), bundle.Sessions[0].ID)
+		require.Len(t, bundle.Consents, 1)
+		assert.Equal(t, "consent-1", bundle.Consents[0].ID)
+		require.Len(t, bundle.AuditEvents, 1)
+		assert.Equal(t, "decision_made", bundle.AuditEvents[0].Action)
+
+		body := rr.Body.String()
+		for _, leaked := range []string{"bob@example.com", otherID.String(), "consent-other"} {
+			assert.NotContains(t, body, leaked, "export must not include other users' data")
+		}
+		for _, secret := range []string{"jti-secret", "fingerprint-secret", "device-secret", "admin-actor-secret", "subject-hash-secret"} {
+			assert.NotContains(t, body, secret, "export must not include secret material")
+		}
+	})
+
+	t.Run("audit events are read in pages and filtered", func(t *testing.T) {
+		base := time.Date(2025, 12, 1, 9, 0, 0, 0, time.UTC)
+		for i := range 5 {
+			require.NoError(t, auditStore.Append(ctx, audit.Event{
+				Category:  audit.CategorySecurity,
+				Timestamp: base.Add(time.Duration(i) * time.Hour),
+				UserID:    userID,
+				Action:    "token_issued",
+			}))
+		}
+		service.auditPageSize = 2
+		t.Cleanup(func() { service.auditPageSize = defaultAuditPageSize })
+
+		events := exportAuditEvents(t, "/me/data-export?action=token_issued")
+		require.Len(t, events, 5)
+		for i := 1; i < len(events); i++ {
+			assert.True(t, events[i-1].Timestamp.After(events[i].Timestamp), "events are newest first")
+		}
+
+		events = exportAuditEvents(t, "/me/data-export?action=token_issued&from=2025-12-01T10:00:00Z&to=2025-12-01T12:00:00Z")
+		require.Len(t, events, 3)
+		assert.Equal(t, base.Add(3*time.Hour), events[0].Timestamp.UTC())
+		assert.Equal(t, base.Add(time.Hour), events[2].Timestamp.UTC())
+	})
+
+	t.Run("invalid filter is rejected", func(t *testing.T) {
+		for _, query := range []string{"from=yesterday", "from=2025-12-02T00:00:00Z&to=2025-12-01T00:00:00Z"} {
+			req := testutil.WithUserID(testutil.NewRequest(t, http.MethodGet, "/me/data-export?"+query), userID.String())
+			rr := testutil.DoRequest(router, req)
+			assert.Equal(t, http.StatusBadRequest, rr.Code, query)
+		}
+	})
+
+	t.Run("requires an authenticated user", func(t *testing.T) {
+		rr := testutil.DoRequest(router, testutil.NewRequest(t, http.MethodGet, "/me/data-export"))
+		assert.Equal(t, http.StatusUnauthorized, rr.Code)
+	})
+}
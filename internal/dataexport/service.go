@@ -0,0 +1,207 @@
+package dataexport
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	authcontracts "credo/contracts/auth"
+	id "credo/pkg/domain"
+	dErrors "credo/pkg/domain-errors"
+	"credo/pkg/platform/audit"
+	"credo/pkg/platform/sentinel"
+	"credo/pkg/requestcontext"
+)
+
+// UserStore provides the user profile through the auth contract view,
+// which carries no credential material.
+type UserStore interface {
+	FindByIDContract(ctx context.Context, userID id.UserID) (*authcontracts.AdminUserView, error)
+}
+
+// SessionStore provides session metadata through the auth contract view.
+// Tokens and device fingerprints are not part of the view.
+type SessionStore interface {
+	ListByUserContract(ctx context.Context, userID id.UserID) ([]*authcontracts.AdminSessionView, error)
+}
+
+// ConsentHistory provides every consent record of a user, including revoked
+// and expired ones.
+type ConsentHistory interface {
+	ListConsents(ctx context.Context, userID id.UserID) ([]Consent, error)
+}
+
+// defaultAuditPageSize is how many audit events are read per page while the
+// export streams; a long history is never loaded whole.
+const defaultAuditPageSize = 500
+
+// Filter narrows the audit events section of an export. Zero-valued fields do
+// not filter; From and To bound the event timestamp inclusively. The other
+// sections are always exported in full.
+type Filter struct {
+	From   time.Time
+	To     time.Time
+	Action string
+}
+
+// matches reports whether e passes the filter.
+func (f Filter) matches(e audit.Event) bool {
+	if f.Action != "" && e.Action != f.Action {
+		return false
+	}
+	if !f.From.IsZero() && e.Timestamp.Before(f.From) {
+		return false
+	}
+	return f.To.IsZero() || !e.Timestamp.After(f.To)
+}
+
+// Service assembles subject access export bundles from the auth, consent and
+// audit stores. It only ever queries by the requesting user's ID.
+type Service struct {
+	users         UserStore
+	sessions      SessionStore
+	consents      ConsentHistory
+	audit         audit.UserPager
+	auditPageSize int
+}
+
+// NewService creates a new data export service
+func NewService(users UserStore, sessions SessionStore, consents ConsentHistory, auditPager audit.UserPager) *Service {
+	return &Service{
+		users:         users,
+		sessions:      sessions,
+		consents:      consents,
+		audit:         auditPager,
+		auditPageSize: defaultAuditPageSize,
+	}
+}
+
+// Export starts the export of the data held about userID. Only the profile is
+// read here, so an unknown user fails before anything is written; the other
+// sections are read as the returned bundle is streamed.
+//
+// Unlike the admin aggregations, no section is best-effort: a subject access
+// response missing sessions or audit history would misrepresent what is
+// stored, so any lookup failure fails the whole export.
+func (s *Service) Export(ctx context.Context, userID id.UserID, filter Filter) (*Bundle, error) {
+	user, err := s.users.FindByIDContract(ctx, userID)
+	if err != nil {
+		if errors.Is(err, sentinel.ErrNotFound) {
+			return nil, dErrors.New(dErrors.CodeNotFound, "user not found")
+		}
+		return nil, dErrors.Wrap(err, dErrors.CodeInternal, "failed to load user profile")
+	}
+
+	return &Bundle{
+		ExportedAt: requestcontext.Now(ctx),
+		Profile:    toProfile(user),
+		service:    s,
+		userID:     userID,
+		filter:     filter,
+	}, nil
+}
+
+// writeSessions streams the user's session metadata.
+func (s *Service) writeSessions(ctx context.Context, userID id.UserID, sw *streamWriter) (int, error) {
+	views, err := s.sessions.ListByUserContract(ctx, userID)
+	if err != nil {
+		return 0, dErrors.Wrap(err, dErrors.CodeInternal, "failed to load sessions")
+	}
+	sessions := toSessions(userID, views)
+	writeArray(sw, sessions)
+	return len(sessions), sw.err
+}
+
+// writeConsents streams the user's full consent history.
+func (s *Service) writeConsents(ctx context.Context, userID id.UserID, sw *streamWriter) (int, error) {
+	consents, err := s.consents.ListConsents(ctx, userID)
+	if err != nil {
+		return 0, dErrors.Wrap(err, dErrors.CodeInternal, "failed to load consent history")
+	}
+	writeArray(sw, consents)
+	return len(consents), sw.err
+}
+
+// writeAuditEvents streams the user's audit events, newest first, one page at
+// a time. Pages are newest first, so paging stops once it passes filter.From.
+func (s *Service) writeAuditEvents(ctx context.Context, userID id.UserID, filter Filter, sw *streamWriter) (int, error) {
+	written := 0
+	sw.raw("[")
+	var cursor *audit.AuditCursor
+	for {
+		events, next, err := s.audit.ListByUserPaged(ctx, userID, cursor, s.auditPageSize)
+		if err != nil {
+			return written, dErrors.Wrap(err, dErrors.CodeInternal, "failed to load audit events")
+		}
+		for _, e := range toAuditEvents(userID, events, filter) {
+			if written > 0 {
+				sw.raw(",")
+			}
+			sw.value(e)
+			written++
+		}
+		if sw.err != nil {
+			return written, sw.err
+		}
+		if next == nil || (!filter.From.IsZero() && next.Timestamp.Before(filter.From)) {
+			break
+		}
+		cursor = next
+	}
+	sw.raw("]")
+	return written, sw.err
+}
+
+func toProfile(u *authcontracts.AdminUserView) Profile {
+	return Profile{
+		UserID:    u.ID,
+		TenantID:  u.TenantID,
+		Email:     u.Email,
+		FirstName: u.FirstName,
+		LastName:  u.LastName,
+		Verified:  u.Verified,
+		Active:    u.Active,
+	}
+}
+
+// toSessions keeps only sessions owned by userID, guarding against a store
+// returning rows for another user.
+func toSessions(userID id.UserID, views []*authcontracts.AdminSessionView) []Session {
+	sessions := make([]Session, 0, len(views))
+	for _, v := range views {
+		if v.UserID != userID.String() {
+			continue
+		}
+		sessions = append(sessions, Session{
+			ID:        v.ID,
+			CreatedAt: v.CreatedAt,
+			ExpiresAt: v.ExpiresAt,
+			Active:    v.Active,
+		})
+	}
+	return sessions
+}
+
+// toAuditEvents keeps only events about userID that pass filter and drops
+// fields that identify other parties: the acting admin, the evaluated
+// third-party subject and the free-form subject, which holds client IDs and IP
+// addresses for security events.
+func toAuditEvents(userID id.UserID, events []audit.Event, filter Filter) []AuditEvent {
+	out := make([]AuditEvent, 0, len(events))
+	for _, e := range events {
+		if e.UserID != userID || !filter.matches(e) {
+			continue
+		}
+		out = append(out, AuditEvent{
+			Timestamp:       e.Timestamp,
+			Category:        string(e.Category),
+			Action:          e.Action,
+			Purpose:         e.Purpose,
+			RequestingParty: e.RequestingParty,
+			Decision:        e.Decision,
+			Reason:          e.Reason,
+			RequestID:       e.RequestID,
+		})
+	}
+	return out
+}
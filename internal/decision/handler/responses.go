@@ -17,10 +17,11 @@ type EvaluateResponse struct {
 
 // EvidenceResponse is the evidence portion of the response.
 type EvidenceResponse struct {
-	CitizenValid    *bool `json:"citizen_valid,omitempty"`
-	SanctionsListed bool  `json:"sanctions_listed"`
-	HasCredential   *bool `json:"has_credential,omitempty"`
-	IsOver18        *bool `json:"is_over_18,omitempty"`
+	CitizenValid        *bool    `json:"citizen_valid,omitempty"`
+	CitizenCompleteness *float64 `json:"citizen_completeness,omitempty"`
+	SanctionsListed     bool     `json:"sanctions_listed"`
+	HasCredential       *bool    `json:"has_credential,omitempty"`
+	IsOver18            *bool    `json:"is_over_18,omitempty"`
 }
 
 // FromResult converts a domain EvaluateResult to an HTTP response.
@@ -30,10 +31,11 @@ func FromResult(result *decision.EvaluateResult) *EvaluateResponse {
 		Reason:     string(result.Reason),
 		Conditions: result.Conditions,
 		Evidence: EvidenceResponse{
-			CitizenValid:    result.Evidence.CitizenValid,
-			SanctionsListed: result.Evidence.SanctionsListed,
-			HasCredential:   result.Evidence.HasCredential,
-			IsOver18:        result.Evidence.IsOver18,
+			CitizenValid:        result.Evidence.CitizenValid,
+			CitizenCompleteness: result.Evidence.CitizenCompleteness,
+			SanctionsListed:     result.Evidence.SanctionsListed,
+			HasCredential:       result.Evidence.HasCredential,
+			IsOver18:            result.Evidence.IsOver18,
 		},
 		EvaluatedAt: result.EvaluatedAt,
 	}
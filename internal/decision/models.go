@@ -25,6 +25,7 @@ type DerivedIdentity struct {
 	PseudonymousID id.UserID
 	IsOver18       bool
 	CitizenValid   bool
+	Completeness   float64 // Fraction of citizen details the registry populated
 }
 
 // DecisionInput groups the signals considered by the decision engine. It avoids
@@ -33,6 +34,9 @@ type DecisionInput struct {
 	Identity   DerivedIdentity
 	Sanctions  registrycontracts.SanctionsRecord
 	Credential map[string]any
+
+	// MinCompleteness is the citizen completeness the decision requires; 0 accepts any.
+	MinCompleteness float64
 }
 
 // IsSanctioned returns true if the subject is on a sanctions list.
@@ -44,6 +48,11 @@ func (di DecisionInput) IsCitizenValid() bool { return di.Identity.CitizenValid
 // IsOfLegalAge returns true if the subject is 18 or older.
 func (di DecisionInput) IsOfLegalAge() bool { return di.Identity.IsOver18 }
 
+// IsCitizenComplete returns true if the registry populated enough citizen details.
+func (di DecisionInput) IsCitizenComplete() bool {
+	return di.Identity.Completeness >= di.MinCompleteness
+}
+
 // DerivedIdentityFromCitizen strips PII while producing attributes required for
 // decisions in regulated mode. Time is injected for deterministic testing.
 func DerivedIdentityFromCitizen(userID id.UserID, citizen registrycontracts.CitizenRecord, now time.Time) DerivedIdentity {
@@ -52,6 +61,7 @@ func DerivedIdentityFromCitizen(userID id.UserID, citizen registrycontracts.Citi
 		PseudonymousID: userID, // treat as pseudonymous identifier; avoid emails/names.
 		IsOver18:       isOver18,
 		CitizenValid:   citizen.Valid,
+		Completeness:   citizen.Completeness,
 	}
 }
 
@@ -96,6 +106,7 @@ const (
 	ReasonSanctioned        DecisionReason = "sanctioned"
 	ReasonInvalidCitizen    DecisionReason = "invalid_citizen"
 	ReasonUnderage          DecisionReason = "underage"
+	ReasonIncompleteCitizen DecisionReason = "incomplete_citizen"
 	ReasonMissingCredential DecisionReason = "missing_credential"
 	ReasonNotSanctioned     DecisionReason = "not_sanctioned"
 )
@@ -118,10 +129,11 @@ type EvaluateResult struct {
 
 // EvidenceSummary captures the non-PII evidence used in the decision.
 type EvidenceSummary struct {
-	CitizenValid    *bool
-	SanctionsListed bool
-	HasCredential   *bool
-	IsOver18        *bool
+	CitizenValid        *bool
+	CitizenCompleteness *float64
+	SanctionsListed     bool
+	HasCredential       *bool
+	IsOver18            *bool
 }
 
 // GatheredEvidence holds raw evidence before rule evaluation.
@@ -18,8 +18,9 @@ func EvaluateDecision(purpose Purpose, input DecisionInput) DecisionOutcome {
 // Rule priority (fail-fast):
 //  1. Sanctions check (hard fail) - compliance-critical
 //  2. Citizen validity - identity baseline
-//  3. Age requirement - purpose-specific
-//  4. Credential check (soft requirement for full pass)
+//  3. Citizen completeness - enough registry detail to trust the record
+//  4. Age requirement - purpose-specific
+//  5. Credential check (soft requirement for full pass)
 func evaluateAgeVerification(input DecisionInput) DecisionOutcome {
 	// Rule 1: Sanctions check (hard fail) - compliance-critical
 	if input.IsSanctioned() {
@@ -31,12 +32,17 @@ func evaluateAgeVerification(input DecisionInput) DecisionOutcome {
 		return DecisionFail
 	}
 
-	// Rule 3: Age requirement - purpose-specific
+	// Rule 3: Citizen completeness - enough registry detail to trust the record
+	if !input.IsCitizenComplete() {
+		return DecisionFail
+	}
+
+	// Rule 4: Age requirement - purpose-specific
 	if !input.IsOfLegalAge() {
 		return DecisionFail
 	}
 
-	// Rule 4: Credential check (soft requirement for full pass)
+	// Rule 5: Credential check (soft requirement for full pass)
 	if len(input.Credential) > 0 {
 		return DecisionPass
 	}
@@ -53,7 +59,7 @@ func evaluateSanctionsScreening(input DecisionInput) DecisionOutcome {
 }
 
 // BuildResult constructs an EvaluateResult from the evaluation outcome.
-func BuildResult(purpose Purpose, outcome DecisionOutcome, evidence *GatheredEvidence, derived DerivedIdentity, evalTime time.Time) *EvaluateResult {
+func BuildResult(purpose Purpose, outcome DecisionOutcome, evidence *GatheredEvidence, input DecisionInput, evalTime time.Time) *EvaluateResult {
 	result := &EvaluateResult{
 		Status:      outcome,
 		EvaluatedAt: evalTime,
@@ -65,7 +71,7 @@ func BuildResult(purpose Purpose, outcome DecisionOutcome, evidence *GatheredEvi
 
 	switch purpose {
 	case PurposeAgeVerification:
-		return buildAgeVerificationResult(result, outcome, evidence, derived)
+		return buildAgeVerificationResult(result, outcome, evidence, input)
 	case PurposeSanctionsScreening:
 		return buildSanctionsResult(result, outcome)
 	}
@@ -73,10 +79,10 @@ func BuildResult(purpose Purpose, outcome DecisionOutcome, evidence *GatheredEvi
 	return result
 }
 
-func buildAgeVerificationResult(result *EvaluateResult, outcome DecisionOutcome, evidence *GatheredEvidence, derived DerivedIdentity) *EvaluateResult {
-	setAgeVerificationEvidence(result, evidence, derived)
+func buildAgeVerificationResult(result *EvaluateResult, outcome DecisionOutcome, evidence *GatheredEvidence, input DecisionInput) *EvaluateResult {
+	setAgeVerificationEvidence(result, evidence, input.Identity)
 
-	reason, conditions := reasonForAgeVerification(outcome, evidence, derived)
+	reason, conditions := reasonForAgeVerification(outcome, evidence, input)
 	result.Reason = reason
 	if len(conditions) > 0 {
 		result.Conditions = conditions
@@ -89,6 +95,8 @@ func setAgeVerificationEvidence(result *EvaluateResult, evidence *GatheredEviden
 	if evidence.Citizen != nil {
 		valid := evidence.Citizen.Valid
 		result.Evidence.CitizenValid = &valid
+		completeness := evidence.Citizen.Completeness
+		result.Evidence.CitizenCompleteness = &completeness
 	}
 	over18 := derived.IsOver18
 	result.Evidence.IsOver18 = &over18
@@ -96,14 +104,16 @@ func setAgeVerificationEvidence(result *EvaluateResult, evidence *GatheredEviden
 	result.Evidence.HasCredential = &hasCred
 }
 
-func reasonForAgeVerification(outcome DecisionOutcome, evidence *GatheredEvidence, derived DerivedIdentity) (DecisionReason, []string) {
+func reasonForAgeVerification(outcome DecisionOutcome, evidence *GatheredEvidence, input DecisionInput) (DecisionReason, []string) {
 	switch outcome {
 	case DecisionFail:
 		if evidence.Sanctions != nil && evidence.Sanctions.Listed {
 			return ReasonSanctioned, nil
 		} else if evidence.Citizen == nil || !evidence.Citizen.Valid {
 			return ReasonInvalidCitizen, nil
-		} else if !derived.IsOver18 {
+		} else if !input.IsCitizenComplete() {
+			return ReasonIncompleteCitizen, nil
+		} else if !input.IsOfLegalAge() {
 			return ReasonUnderage, nil
 		}
 	case DecisionPass:
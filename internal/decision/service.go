@@ -35,6 +35,8 @@ type Service struct {
 	auditor  *compliance.Publisher
 	metrics  *metrics.Metrics
 	logger   *slog.Logger
+
+	minCompleteness float64
 }
 
 // Option configures the Service.
@@ -54,6 +56,14 @@ func WithLogger(l *slog.Logger) Option {
 	}
 }
 
+// WithMinCompleteness fails age verification when the registry populated less
+// than minimum (0.0 to 1.0) of the citizen's details. The default 0 accepts any.
+func WithMinCompleteness(minimum float64) Option {
+	return func(s *Service) {
+		s.minCompleteness = minimum
+	}
+}
+
 // New creates a new decision service with required dependencies.
 // Returns an error when required dependencies are nil; treat this as startup
 // misconfiguration. All ports are required for compliance: consent gates data
@@ -140,7 +150,7 @@ func (s *Service) Evaluate(ctx context.Context, req EvaluateRequest) (*EvaluateR
 	outcome := EvaluateDecision(req.Purpose, input)
 
 	// Build result (pure domain logic)
-	result := BuildResult(req.Purpose, outcome, evidence, derived, evalTime)
+	result := BuildResult(req.Purpose, outcome, evidence, input, evalTime)
 
 	// Emit audit event (fail-open for non-sanctions, fail-closed for sanctions)
 	if err := s.emitAudit(ctx, req, result, evalTime); err != nil {
@@ -169,6 +179,7 @@ func (s *Service) deriveIdentity(userID id.UserID, evidence *GatheredEvidence, e
 	if evidence.Citizen != nil {
 		derived.CitizenValid = evidence.Citizen.Valid
 		derived.IsOver18 = deriveIsOver18(evidence.Citizen.DateOfBirth, evalTime)
+		derived.Completeness = evidence.Citizen.Completeness
 	}
 
 	return derived
@@ -176,7 +187,8 @@ func (s *Service) deriveIdentity(userID id.UserID, evidence *GatheredEvidence, e
 
 func (s *Service) buildInput(evidence *GatheredEvidence, derived DerivedIdentity) DecisionInput {
 	input := DecisionInput{
-		Identity: derived,
+		Identity:        derived,
+		MinCompleteness: s.minCompleteness,
 	}
 
 	if evidence.Sanctions != nil {
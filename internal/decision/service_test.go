@@ -172,6 +172,76 @@ func (s *RuleEvaluationSuite) TestAgeVerificationRuleChain() {
 	})
 }
 
+func (s *RuleEvaluationSuite) TestCitizenCompletenessRule() {
+	s.Run("fails below the minimum completeness", func() {
+		var err error
+		s.service, err = New(s.registry, s.vc, s.consent, s.auditor, WithMinCompleteness(2.0/3))
+		s.Require().NoError(err)
+		s.registry.citizen = &registrycontracts.CitizenRecord{
+			Valid:        true,
+			DateOfBirth:  "1990-01-15",
+			Completeness: 1.0 / 3,
+		}
+		s.registry.sanctions = &registrycontracts.SanctionsRecord{Listed: false}
+		s.vc.credential = nil
+
+		result, err := s.service.Evaluate(context.Background(), EvaluateRequest{
+			UserID:     s.testUserID,
+			Purpose:    PurposeAgeVerification,
+			NationalID: s.testNatID,
+		})
+
+		s.Require().NoError(err)
+		s.Equal(DecisionFail, result.Status)
+		s.Equal(ReasonIncompleteCitizen, result.Reason)
+		s.Require().NotNil(result.Evidence.CitizenCompleteness)
+		s.InDelta(1.0/3, *result.Evidence.CitizenCompleteness, 1e-9)
+	})
+
+	s.Run("passes at the minimum completeness", func() {
+		var err error
+		s.service, err = New(s.registry, s.vc, s.consent, s.auditor, WithMinCompleteness(2.0/3))
+		s.Require().NoError(err)
+		s.registry.citizen = &registrycontracts.CitizenRecord{
+			Valid:        true,
+			DateOfBirth:  "1990-01-15",
+			Completeness: 2.0 / 3,
+		}
+		s.registry.sanctions = &registrycontracts.SanctionsRecord{Listed: false}
+		s.vc.credential = nil
+
+		result, err := s.service.Evaluate(context.Background(), EvaluateRequest{
+			UserID:     s.testUserID,
+			Purpose:    PurposeAgeVerification,
+			NationalID: s.testNatID,
+		})
+
+		s.Require().NoError(err)
+		s.Equal(DecisionPassWithConditions, result.Status)
+	})
+
+	s.Run("no minimum accepts any completeness", func() {
+		var err error
+		s.service, err = New(s.registry, s.vc, s.consent, s.auditor)
+		s.Require().NoError(err)
+		s.registry.citizen = &registrycontracts.CitizenRecord{
+			Valid:       true,
+			DateOfBirth: "1990-01-15",
+		}
+		s.registry.sanctions = &registrycontracts.SanctionsRecord{Listed: false}
+		s.vc.credential = nil
+
+		result, err := s.service.Evaluate(context.Background(), EvaluateRequest{
+			UserID:     s.testUserID,
+			Purpose:    PurposeAgeVerification,
+			NationalID: s.testNatID,
+		})
+
+		s.Require().NoError(err)
+		s.Equal(DecisionPassWithConditions, result.Status)
+	})
+}
+
 func (s *RuleEvaluationSuite) TestSanctionsScreeningRules() {
 	s.Run("passes when not listed", func() {
 		s.registry.sanctions = &registrycontracts.SanctionsRecord{Listed: false}
@@ -466,3 +536,7 @@ func (f *failingAuditStore) ListAll(_ context.Context) ([]audit.Event, error) {
 func (f *failingAuditStore) ListRecent(_ context.Context, _ int) ([]audit.Event, error) {
 	return nil, f.err
 }
+
+func (f *failingAuditStore) ListByRequestID(_ context.Context, _ string) ([]audit.Event, error) {
+	return nil, f.err
+}
@@ -0,0 +1,106 @@
+package erasure
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+
+	id "credo/pkg/domain"
+	dErrors "credo/pkg/domain-errors"
+	"credo/pkg/platform/httputil"
+	adminmw "credo/pkg/platform/middleware/admin"
+	"credo/pkg/requestcontext"
+)
+
+// Handler serves account erasure for users and admins
+type Handler struct {
+	service *Service
+	logger  *slog.Logger
+}
+
+// New creates a new erasure handler
+func New(service *Service, logger *slog.Logger) *Handler {
+	return &Handler{
+		service: service,
+		logger:  logger,
+	}
+}
+
+// Register registers self-service erasure routes with the router
+func (h *Handler) Register(r chi.Router) {
+	r.Delete("/me", h.HandleDeleteMe)
+}
+
+// RegisterAdmin registers admin erasure routes with the router
+func (h *Handler) RegisterAdmin(r chi.Router) {
+	r.Delete("/admin/users/{user_id}/account", h.HandleAdminDeleteAccount)
+}
+
+// HandleDeleteMe implements DELETE /me.
+// The authenticated user erases their own account and is the acting party.
+func (h *Handler) HandleDeleteMe(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := requestcontext.RequestID(ctx)
+
+	userID := requestcontext.UserID(ctx)
+	if userID.IsNil() {
+		h.logger.WarnContext(ctx, "user_id missing from auth context",
+			"request_id", requestID,
+		)
+		httputil.WriteError(w, dErrors.New(dErrors.CodeUnauthorized, "invalid token"))
+		return
+	}
+
+	h.deleteAccount(w, r, userID, userID.String())
+}
+
+// HandleAdminDeleteAccount implements DELETE /admin/users/{user_id}/account.
+// The admin actor from X-Admin-Actor-ID is recorded as the acting party.
+func (h *Handler) HandleAdminDeleteAccount(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := requestcontext.RequestID(ctx)
+
+	userIDStr := chi.URLParam(r, "user_id")
+	userID, err := id.ParseUserID(userIDStr)
+	if err != nil {
+		h.logger.WarnContext(ctx, "invalid user_id in path",
+			"user_id", userIDStr,
+			"request_id", requestID,
+		)
+		httputil.WriteError(w, dErrors.New(dErrors.CodeBadRequest, "invalid user_id"))
+		return
+	}
+
+	actorID := adminmw.GetAdminActorID(ctx)
+	if actorID == "" {
+		httputil.WriteError(w, dErrors.New(dErrors.CodeBadRequest, "X-Admin-Actor-ID header required"))
+		return
+	}
+
+	h.deleteAccount(w, r, userID, actorID)
+}
+
+func (h *Handler) deleteAccount(w http.ResponseWriter, r *http.Request, userID id.UserID, actorID string) {
+	ctx := r.Context()
+	requestID := requestcontext.RequestID(ctx)
+
+	if err := h.service.DeleteAccount(ctx, userID, actorID); err != nil {
+		h.logger.ErrorContext(ctx, "failed to erase account",
+			"error", err,
+			"user_id", userID.String(),
+			"actor_id", actorID,
+			"request_id", requestID,
+		)
+		httputil.WriteError(w, err)
+		return
+	}
+
+	h.logger.InfoContext(ctx, "account erased",
+		"user_id", userID.String(),
+		"actor_id", actorID,
+		"request_id", requestID,
+	)
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,158 @@
+package erasure
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strings"
+
+	authcontracts "credo/contracts/auth"
+	id "credo/pkg/domain"
+	dErrors "credo/pkg/domain-errors"
+	"credo/pkg/platform/audit"
+	"credo/pkg/platform/sentinel"
+	"credo/pkg/requestcontext"
+)
+
+// Erasure steps recorded as the Subject of erasure_step_completed events.
+const (
+	StepAuditAnonymized = "audit_anonymized"
+	StepConsentsRevoked = "consents_revoked"
+	StepAccountDeleted  = "sessions_revoked_and_user_deleted"
+)
+
+// ReasonErasureRequested is the audit reason for every event of an erasure.
+const ReasonErasureRequested = "erasure_requested"
+
+// UserStore looks up the account before deletion.
+type UserStore interface {
+	FindByIDContract(ctx context.Context, userID id.UserID) (*authcontracts.AdminUserView, error)
+}
+
+// AccountDeleter revokes a user's access tokens, then removes their sessions
+// and user record in one transaction. It records the session revocation but
+// not user_deleted, which the erasure records itself.
+type AccountDeleter interface {
+	EraseUser(ctx context.Context, userID id.UserID) error
+}
+
+// ConsentRevoker revokes every active consent of a user. Records are kept,
+// revoked, as evidence of what the user had agreed to.
+type ConsentRevoker interface {
+	RevokeAll(ctx context.Context, userID id.UserID) (int, error)
+}
+
+// AuditPublisher persists compliance events. Emit is fail-closed: an error
+// means the event was not recorded.
+type AuditPublisher interface {
+	Emit(ctx context.Context, event audit.ComplianceEvent) error
+}
+
+// Service orchestrates GDPR right-to-erasure across the auth and consent modules.
+type Service struct {
+	users      UserStore
+	accounts   AccountDeleter
+	consents   ConsentRevoker
+	auditor    AuditPublisher
+	anonymizer audit.Anonymizer
+}
+
+// NewService creates a new erasure service
+func NewService(users UserStore, accounts AccountDeleter, consents ConsentRevoker, auditor AuditPublisher, anonymizer audit.Anonymizer) *Service {
+	return &Service{
+		users:      users,
+		accounts:   accounts,
+		consents:   consents,
+		auditor:    auditor,
+		anonymizer: anonymizer,
+	}
+}
+
+// DeleteAccount erases userID on behalf of actorID, which is the user ID for
+// self-service requests and the admin actor ID for admin requests.
+//
+// The modules own separate stores, so there is no transaction spanning them.
+// The user record goes last: a failure part-way leaves the account in place
+// and the request can be retried, since each step is idempotent. Within the
+// auth module access tokens are revoked first, then sessions and user are
+// removed atomically. Consents are revoked rather than deleted.
+//
+// Past audit events are retained as legally required but anonymized first:
+// email and subject are replaced by a hash of the email, so the events stay
+// queryable by that hash. Anonymizing before the erasure's own events are
+// written keeps their step names intact; those events carry no PII.
+// Each completed step is recorded as a compliance event attributed to actorID.
+func (s *Service) DeleteAccount(ctx context.Context, userID id.UserID, actorID string) error {
+	if userID.IsNil() {
+		return dErrors.New(dErrors.CodeBadRequest, "user ID required")
+	}
+	if actorID == "" {
+		return dErrors.New(dErrors.CodeBadRequest, "actor ID required")
+	}
+
+	user, err := s.users.FindByIDContract(ctx, userID)
+	if err != nil {
+		if errors.Is(err, sentinel.ErrNotFound) {
+			return dErrors.New(dErrors.CodeNotFound, "user not found")
+		}
+		return dErrors.Wrap(err, dErrors.CodeInternal, "failed to lookup user")
+	}
+	subjectHash := hashSubject(user.Email)
+
+	if _, err := s.anonymizer.AnonymizeUser(ctx, userID, subjectHash); err != nil {
+		return dErrors.Wrap(err, dErrors.CodeInternal, "failed to anonymize audit events")
+	}
+	if err := s.recordStep(ctx, userID, actorID, subjectHash, StepAuditAnonymized); err != nil {
+		return err
+	}
+
+	if _, err := s.consents.RevokeAll(ctx, userID); err != nil {
+		return err
+	}
+	if err := s.recordStep(ctx, userID, actorID, subjectHash, StepConsentsRevoked); err != nil {
+		return err
+	}
+
+	if err := s.accounts.EraseUser(ctx, userID); err != nil {
+		return err
+	}
+	if err := s.recordStep(ctx, userID, actorID, subjectHash, StepAccountDeleted); err != nil {
+		return err
+	}
+
+	return s.emit(ctx, audit.ComplianceEvent{
+		UserID:        userID,
+		Action:        string(audit.EventUserDeleted),
+		Decision:      "deleted",
+		Reason:        ReasonErasureRequested,
+		SubjectIDHash: subjectHash,
+		ActorID:       actorID,
+	})
+}
+
+func (s *Service) recordStep(ctx context.Context, userID id.UserID, actorID, subjectHash, step string) error {
+	return s.emit(ctx, audit.ComplianceEvent{
+		UserID:        userID,
+		Subject:       step,
+		Action:        string(audit.EventErasureStep),
+		Reason:        ReasonErasureRequested,
+		SubjectIDHash: subjectHash,
+		ActorID:       actorID,
+	})
+}
+
+func (s *Service) emit(ctx context.Context, event audit.ComplianceEvent) error {
+	event.Timestamp = requestcontext.Now(ctx)
+	event.RequestID = requestcontext.RequestID(ctx)
+	if err := s.auditor.Emit(ctx, event); err != nil {
+		return dErrors.Wrap(err, dErrors.CodeInternal, "failed to record erasure audit event")
+	}
+	return nil
+}
+
+// hashSubject lets compliance confirm an address was erased without retaining it.
+func hashSubject(email string) string {
+	h := sha256.Sum256([]byte(strings.ToLower(email)))
+	return hex.EncodeToString(h[:])
+}
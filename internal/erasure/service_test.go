@@ -0,0 +1,189 @@
+package erasure
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sessionStore "credo/internal/auth/store/session"
+	userStore "credo/internal/auth/store/user"
+	consentmodels "credo/internal/consent/models"
+	consentService "credo/internal/consent/service"
+	consentStore "credo/internal/consent/store"
+	id "credo/pkg/domain"
+	dErrors "credo/pkg/domain-errors"
+	"credo/pkg/platform/audit"
+	"credo/pkg/platform/audit/publishers/compliance"
+	auditmemory "credo/pkg/platform/audit/store/memory"
+	"credo/pkg/testutil"
+)
+
+// storeAccountDeleter mirrors the auth service's EraseUser over in-memory stores.
+type storeAccountDeleter struct {
+	users    *userStore.InMemoryUserStore
+	sessions *sessionStore.InMemorySessionStore
+	err      error
+}
+
+func (d *storeAccountDeleter) EraseUser(ctx context.Context, userID id.UserID) error {
+	if d.err != nil {
+		return d.err
+	}
+	if err := d.sessions.DeleteSessionsByUser(ctx, userID); err != nil {
+		return err
+	}
+	return d.users.Delete(ctx, userID)
+}
+
+type erasureFixture struct {
+	users    *userStore.InMemoryUserStore
+	sessions *sessionStore.InMemorySessionStore
+	consents *consentService.Service
+	audit    *auditmemory.InMemoryStore
+	accounts *storeAccountDeleter
+	service  *Service
+}
+
+func newErasureFixture(t *testing.T, userID id.UserID) *erasureFixture {
+	t.Helper()
+	ctx := context.Background()
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	f := &erasureFixture{
+		users:    userStore.New(),
+		sessions: sessionStore.New(),
+		audit:    auditmemory.NewInMemoryStore(),
+	}
+	publisher := compliance.New(f.audit)
+	f.consents = consentService.New(consentStore.New(), publisher, logger)
+	f.accounts = &storeAccountDeleter{users: f.users, sessions: f.sessions}
+	f.service = NewService(f.users, f.accounts, f.consents, publisher, f.audit)
+
+	require.NoError(t, f.users.Save(ctx, testutil.NewUserBuilder().WithID(userID).WithEmail("alice@example.com").Build()))
+	require.NoError(t, f.sessions.Create(ctx, testutil.NewSessionBuilder().WithUserID(userID).Build()))
+	require.NoError(t, f.sessions.Create(ctx, testutil.NewSessionBuilder().WithUserID(userID).Build()))
+	_, err := f.consents.Grant(ctx, userID, []consentmodels.Purpose{consentmodels.PurposeLogin, consentmodels.PurposeRegistryCheck})
+	require.NoError(t, err)
+	return f
+}
+
+func eventsWithAction(events []audit.Event, action audit.AuditEvent) []audit.Event {
+	var out []audit.Event
+	for _, e := range events {
+		if e.Action == string(action) {
+			out = append(out, e)
+		}
+	}
+	return out
+}
+
+func TestDeleteAccount(t *testing.T) {
+	ctx := context.Background()
+	userID := testutil.TestIDs.UserID1
+
+	t.Run("removes sessions, revokes consents and emits user_deleted once", func(t *testing.T) {
+		f := newErasureFixture(t, userID)
+
+		require.NoError(t, f.service.DeleteAccount(ctx, userID, "admin-42"))
+
+		sessions, err := f.sessions.ListByUser(ctx, userID)
+		require.NoError(t, err)
+		assert.Empty(t, sessions)
+
+		records, err := f.consents.List(ctx, userID, nil)
+		require.NoError(t, err)
+		require.Len(t, records, 2, "consent records are kept as evidence")
+		for _, record := range records {
+			assert.NotNil(t, record.RevokedAt, "consent %s must be revoked", record.Purpose)
+		}
+
+		_, err = f.users.FindByID(ctx, userID)
+		assert.Error(t, err, "user record must be gone")
+
+		events, err := f.audit.ListByUser(ctx, userID)
+		require.NoError(t, err)
+
+		deleted := eventsWithAction(events, audit.EventUserDeleted)
+		require.Len(t, deleted, 1)
+		assert.Equal(t, audit.CategoryCompliance, deleted[0].Category)
+		assert.Equal(t, "admin-42", deleted[0].ActorID)
+		assert.Equal(t, ReasonErasureRequested, deleted[0].Reason)
+		assert.Equal(t, hashSubject("alice@example.com"), deleted[0].SubjectIDHash)
+		assert.Empty(t, deleted[0].Email, "erasure audit must not retain the address")
+
+		steps := eventsWithAction(events, audit.EventErasureStep)
+		require.Len(t, steps, 3)
+		assert.Equal(t, StepAuditAnonymized, steps[0].Subject)
+		assert.Equal(t, StepConsentsRevoked, steps[1].Subject)
+		assert.Equal(t, StepAccountDeleted, steps[2].Subject)
+		for _, step := range steps {
+			assert.Equal(t, "admin-42", step.ActorID)
+		}
+	})
+
+	t.Run("anonymizes historical events but keeps them queryable by hash", func(t *testing.T) {
+		f := newErasureFixture(t, userID)
+		for _, action := range []audit.AuditEvent{audit.EventUserCreated, audit.EventSessionCreated} {
+			require.NoError(t, f.audit.Append(ctx, audit.Event{
+				Category: action.Category(),
+				UserID:   userID,
+				Action:   string(action),
+				Subject:  "alice@example.com",
+				Email:    "alice@example.com",
+			}))
+		}
+
+		require.NoError(t, f.service.DeleteAccount(ctx, userID, userID.String()))
+
+		hash := hashSubject("alice@example.com")
+		byHash, err := f.audit.ListBySubjectHash(ctx, hash)
+		require.NoError(t, err)
+		for _, action := range []audit.AuditEvent{audit.EventUserCreated, audit.EventSessionCreated} {
+			matched := eventsWithAction(byHash, action)
+			require.Len(t, matched, 1, "%s event must be retained", action)
+			assert.Empty(t, matched[0].Email)
+			assert.Equal(t, hash, matched[0].Subject)
+			assert.Equal(t, hash, matched[0].SubjectIDHash)
+		}
+
+		all, err := f.audit.ListAll(ctx)
+		require.NoError(t, err)
+		for _, e := range all {
+			assert.NotEqual(t, "alice@example.com", e.Email)
+			assert.NotEqual(t, "alice@example.com", e.Subject)
+		}
+	})
+
+	t.Run("account failure keeps the user for retry and skips user_deleted", func(t *testing.T) {
+		f := newErasureFixture(t, userID)
+		f.accounts.err = dErrors.New(dErrors.CodeInternal, "failed to delete user")
+
+		err := f.service.DeleteAccount(ctx, userID, userID.String())
+		require.Error(t, err)
+
+		_, err = f.users.FindByID(ctx, userID)
+		require.NoError(t, err)
+
+		events, err := f.audit.ListByUser(ctx, userID)
+		require.NoError(t, err)
+		assert.Empty(t, eventsWithAction(events, audit.EventUserDeleted))
+	})
+
+	t.Run("unknown user", func(t *testing.T) {
+		f := newErasureFixture(t, userID)
+
+		err := f.service.DeleteAccount(ctx, testutil.TestIDs.UserID2, "admin-42")
+		assert.True(t, dErrors.HasCode(err, dErrors.CodeNotFound))
+	})
+
+	t.Run("actor required", func(t *testing.T) {
+		f := newErasureFixture(t, userID)
+
+		err := f.service.DeleteAccount(ctx, userID, "")
+		assert.True(t, dErrors.HasCode(err, dErrors.CodeBadRequest))
+	})
+}
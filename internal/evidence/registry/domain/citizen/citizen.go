@@ -21,22 +21,44 @@ import (
 )
 
 // PersonalDetails is a value object containing PII from the citizen registry.
-// In regulated mode, these details are stripped during minimization.
+// In regulated mode, these details are stripped during minimization; a
+// MinimizationPolicy may keep coarse forms in BirthYear and City.
 //
 // Invariants:
 //   - FullName should be non-empty for valid records
 //   - DateOfBirth should be in YYYY-MM-DD format
+//   - BirthYear and City are only set by minimization
 type PersonalDetails struct {
 	FullName    string
 	DateOfBirth string
 	Address     string
+
+	BirthYear string // Year of DateOfBirth kept by a coarsening policy
+	City      string // City of Address kept by a coarsening policy
 }
 
-// IsEmpty returns true if all personal details are empty (minimized state).
+// personalDetailFields is the number of optional fields counted by Completeness.
+const personalDetailFields = 3
+
+// IsEmpty returns true if all full personal details are empty (minimized state).
+// Coarse BirthYear and City do not count.
 func (p PersonalDetails) IsEmpty() bool {
 	return p.FullName == "" && p.DateOfBirth == "" && p.Address == ""
 }
 
+// Completeness returns the fraction of personal details that are populated,
+// from 0.0 (none) to 1.0 (all). Registries may legitimately omit any of them,
+// so a partial record is valid; callers decide how complete is complete enough.
+func (p PersonalDetails) Completeness() float64 {
+	populated := 0
+	for _, field := range []string{p.FullName, p.DateOfBirth, p.Address} {
+		if field != "" {
+			populated++
+		}
+	}
+	return float64(populated) / personalDetailFields
+}
+
 // VerificationStatus represents the outcome of a citizen registry lookup.
 type VerificationStatus struct {
 	Valid     bool
@@ -59,14 +81,18 @@ func (v VerificationStatus) IsValid() bool {
 // Invariants:
 //   - NationalID is always present and valid
 //   - CheckedAt is always set
-//   - Minimized records have empty PersonalDetails
+//   - Minimized records have empty PersonalDetails, apart from the fields
+//     their MinimizationPolicy keeps
+//   - Minimization cannot be undone: re-minimizing never restores a stripped detail
+//   - Completeness reflects the details as received and survives minimization
 type CitizenVerification struct {
-	nationalID id.NationalID
-	details    PersonalDetails
-	status     VerificationStatus
-	providerID shared.ProviderID
-	confidence shared.Confidence
-	minimized  bool
+	nationalID   id.NationalID
+	details      PersonalDetails
+	status       VerificationStatus
+	providerID   shared.ProviderID
+	confidence   shared.Confidence
+	completeness float64
+	minimized    bool
 }
 
 var (
@@ -101,9 +127,10 @@ func New(
 			Valid:     valid,
 			CheckedAt: checkedAt,
 		},
-		providerID: providerID,
-		confidence: confidence,
-		minimized:  false,
+		providerID:   providerID,
+		confidence:   confidence,
+		completeness: details.Completeness(),
+		minimized:    false,
 	}, nil
 }
 
@@ -127,6 +154,14 @@ func (c CitizenVerification) Address() string {
 	return c.details.Address
 }
 
+func (c CitizenVerification) BirthYear() string {
+	return c.details.BirthYear
+}
+
+func (c CitizenVerification) City() string {
+	return c.details.City
+}
+
 func (c CitizenVerification) IsValid() bool {
 	return c.status.Valid
 }
@@ -143,6 +178,27 @@ func (c CitizenVerification) Confidence() shared.Confidence {
 	return c.confidence
 }
 
+// Completeness returns how fully the registry populated the personal details
+// (0.0 to 1.0), as measured when the verification was created.
+func (c CitizenVerification) Completeness() float64 {
+	return c.completeness
+}
+
+// MeetsCompleteness reports whether at least minimum of the personal details were populated.
+func (c CitizenVerification) MeetsCompleteness(minimum float64) bool {
+	return c.completeness >= minimum
+}
+
+// WithRecordedCompleteness returns a copy carrying the completeness measured when
+// the details were first received. Records minimized before they were stored have
+// lost details, so re-measuring them would undercount. A value below what the
+// current details show is ignored.
+func (c *CitizenVerification) WithRecordedCompleteness(completeness float64) *CitizenVerification {
+	restored := *c
+	restored.completeness = max(c.completeness, min(completeness, 1))
+	return &restored
+}
+
 func (c CitizenVerification) IsMinimized() bool {
 	return c.minimized
 }
@@ -151,26 +207,40 @@ func (c CitizenVerification) IsMinimized() bool {
 // This is the GDPR-compliant representation for regulated environments.
 //
 // The returned value:
-//   - Retains: NationalID, Valid status, CheckedAt, ProviderID, Confidence
+//   - Retains: NationalID, Valid status, CheckedAt, ProviderID, Confidence, Completeness
 //   - Strips: FullName, DateOfBirth, Address
 //   - Is marked as minimized (IsMinimized returns true)
 //
 // This method is pure - it returns a new value without modifying the original.
 func (c *CitizenVerification) Minimized() *CitizenVerification {
+	return c.MinimizedUnder(MinimizationPolicy{})
+}
+
+// MinimizedUnder is Minimized with the details policy allows kept, so a
+// regulation can retain year of birth or city while the full values are
+// stripped, or keep date of birth for age checks while name and address go.
+// Minimizing an already minimized record under any policy only narrows it.
+func (c *CitizenVerification) MinimizedUnder(policy MinimizationPolicy) *CitizenVerification {
 	return &CitizenVerification{
-		nationalID: c.nationalID,
-		details:    PersonalDetails{}, // Empty - PII stripped
-		status:     c.status,
-		providerID: c.providerID,
-		confidence: c.confidence,
-		minimized:  true,
+		nationalID:   c.nationalID,
+		details:      policy.Apply(c.details),
+		status:       c.status,
+		providerID:   c.providerID,
+		confidence:   c.confidence,
+		completeness: c.completeness,
+		minimized:    true,
 	}
 }
 
 // WithoutNationalID returns a minimized version that also clears the national ID.
 // Use this for maximum data minimization where even the lookup key should be hidden.
 func (c *CitizenVerification) WithoutNationalID() *CitizenVerification {
-	minimized := c.Minimized()
+	return c.WithoutNationalIDUnder(MinimizationPolicy{})
+}
+
+// WithoutNationalIDUnder is WithoutNationalID with details minimized under policy.
+func (c *CitizenVerification) WithoutNationalIDUnder(policy MinimizationPolicy) *CitizenVerification {
+	minimized := c.MinimizedUnder(policy)
 	minimized.nationalID = id.NationalID{} // Zero value
 	return minimized
 }
@@ -0,0 +1,109 @@
+package citizen
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// DetailRule says what minimization keeps of one personal detail.
+type DetailRule string
+
+const (
+	// RuleClear strips the detail entirely (default).
+	RuleClear DetailRule = "clear"
+
+	// RuleYear keeps the year of DateOfBirth as BirthYear.
+	RuleYear DetailRule = "year"
+
+	// RuleCity keeps the city of Address as City.
+	RuleCity DetailRule = "city"
+
+	// RuleKeep keeps the detail in full. The result still carries PII, so
+	// ParseMinimizationPolicy does not accept it for regulated mode, whose cache
+	// guards refuse minimized records with full details.
+	RuleKeep DetailRule = "keep"
+)
+
+// ErrUnsupportedRule is returned for a rule a personal detail cannot take.
+var ErrUnsupportedRule = errors.New("unsupported minimization rule")
+
+// MinimizationPolicy selects what survives minimization of each personal detail.
+// The zero value clears everything, the default full minimization. FullName is
+// always cleared.
+type MinimizationPolicy struct {
+	DateOfBirth DetailRule // RuleClear, RuleYear or RuleKeep
+	Address     DetailRule // RuleClear, RuleCity or RuleKeep
+}
+
+// ParseMinimizationPolicy builds a policy from field=rule pairs, such as
+// {"date_of_birth": "year", "address": "city"}. Unlisted fields are cleared.
+func ParseMinimizationPolicy(rules map[string]string) (MinimizationPolicy, error) {
+	var policy MinimizationPolicy
+	for field, raw := range rules {
+		rule := DetailRule(raw)
+		switch {
+		case field == "date_of_birth" && (rule == RuleClear || rule == RuleYear):
+			policy.DateOfBirth = rule
+		case field == "address" && (rule == RuleClear || rule == RuleCity):
+			policy.Address = rule
+		case field == "full_name" && rule == RuleClear:
+		default:
+			return MinimizationPolicy{}, fmt.Errorf("%w: %s=%s", ErrUnsupportedRule, field, raw)
+		}
+	}
+	return policy, nil
+}
+
+// Apply returns what remains of details after minimization: only the fields
+// the policy keeps, in full or coarse form. Apply only ever drops or coarsens
+// details, never restores them, so applying a policy to minimized details is a
+// no-op.
+func (p MinimizationPolicy) Apply(details PersonalDetails) PersonalDetails {
+	var kept PersonalDetails
+	switch p.DateOfBirth {
+	case RuleKeep:
+		kept.DateOfBirth = details.DateOfBirth
+		kept.BirthYear = details.BirthYear
+	case RuleYear:
+		kept.BirthYear = details.BirthYear
+		if details.DateOfBirth != "" {
+			kept.BirthYear = birthYear(details.DateOfBirth)
+		}
+	}
+	switch p.Address {
+	case RuleKeep:
+		kept.Address = details.Address
+		kept.City = details.City
+	case RuleCity:
+		kept.City = details.City
+		if details.Address != "" {
+			kept.City = city(details.Address)
+		}
+	}
+	return kept
+}
+
+// birthYear returns the YYYY prefix of a YYYY-MM-DD date, or "" if there is none.
+func birthYear(dateOfBirth string) string {
+	year, _, ok := strings.Cut(dateOfBirth, "-")
+	if !ok || len(year) != 4 {
+		return ""
+	}
+	for _, r := range year {
+		if r < '0' || r > '9' {
+			return ""
+		}
+	}
+	return year
+}
+
+// city returns the second comma-separated part of a "street, city[, region]"
+// address. An address without one has no recognizable city and yields "".
+func city(address string) string {
+	parts := strings.Split(address, ",")
+	if len(parts) < 2 {
+		return ""
+	}
+	return strings.TrimSpace(parts[1])
+}
@@ -0,0 +1,114 @@
+package citizen
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"credo/internal/evidence/registry/domain/shared"
+	id "credo/pkg/domain"
+)
+
+type MinimizationSuite struct {
+	suite.Suite
+}
+
+func TestMinimizationSuite(t *testing.T) {
+	suite.Run(t, new(MinimizationSuite))
+}
+
+var coarsening = MinimizationPolicy{DateOfBirth: RuleYear, Address: RuleCity}
+
+// TestApply verifies what each policy keeps of the personal details.
+// Invariant: Full details never survive minimization; only coarse forms the policy names do.
+func (s *MinimizationSuite) TestApply() {
+	details := PersonalDetails{FullName: "Jane Doe", DateOfBirth: "1985-05-15", Address: "456 Oak Ave, Springfield, IL 62701"}
+
+	s.Run("zero policy clears everything", func() {
+		s.Equal(PersonalDetails{}, MinimizationPolicy{}.Apply(details))
+	})
+
+	s.Run("coarsens date of birth to year and address to city", func() {
+		s.Equal(PersonalDetails{BirthYear: "1985", City: "Springfield"}, coarsening.Apply(details))
+	})
+
+	s.Run("unrecognizable values are cleared", func() {
+		kept := coarsening.Apply(PersonalDetails{DateOfBirth: "15/05/1985", Address: "456 Oak Ave"})
+		s.Equal(PersonalDetails{}, kept)
+	})
+
+	s.Run("keeps date of birth in full while clearing name and address", func() {
+		kept := MinimizationPolicy{DateOfBirth: RuleKeep}.Apply(details)
+		s.Equal(PersonalDetails{DateOfBirth: "1985-05-15"}, kept)
+	})
+
+	s.Run("re-applying to minimized details keeps coarse fields", func() {
+		minimized := coarsening.Apply(details)
+		s.Equal(minimized, coarsening.Apply(minimized))
+	})
+}
+
+func (s *MinimizationSuite) TestMinimizedUnder() {
+	nationalID, err := id.ParseNationalID("123456789012")
+	s.Require().NoError(err)
+	verification, err := New(nationalID,
+		PersonalDetails{FullName: "Jane Doe", DateOfBirth: "1985-05-15", Address: "456 Oak Ave, Springfield"},
+		true, shared.NewCheckedAt(time.Date(2025, 12, 11, 10, 0, 0, 0, time.UTC)), shared.NewProviderID("citizen-provider"), shared.Authoritative())
+	s.Require().NoError(err)
+
+	minimized := verification.WithoutNationalIDUnder(coarsening)
+	s.True(minimized.IsMinimized())
+	s.True(minimized.NationalID().IsNil())
+	s.True(minimized.PersonalDetails().IsEmpty())
+	s.Equal("1985", minimized.BirthYear())
+	s.Equal("Springfield", minimized.City())
+	s.Equal(verification.Completeness(), minimized.Completeness())
+
+	s.Run("keeping date of birth strips name and address", func() {
+		minimized := verification.MinimizedUnder(MinimizationPolicy{DateOfBirth: RuleKeep})
+		s.True(minimized.IsMinimized())
+		s.Equal("1985-05-15", minimized.DateOfBirth())
+		s.Empty(minimized.FullName())
+		s.Empty(minimized.Address())
+		s.Equal("Jane Doe", verification.FullName(), "the original is not modified")
+	})
+
+	s.Run("re-minimizing is idempotent and never restores details", func() {
+		policy := MinimizationPolicy{DateOfBirth: RuleKeep}
+		once := verification.MinimizedUnder(policy)
+		s.Equal(once, once.MinimizedUnder(policy))
+
+		widened := once.MinimizedUnder(MinimizationPolicy{DateOfBirth: RuleKeep, Address: RuleKeep})
+		s.True(widened.IsMinimized())
+		s.Empty(widened.Address(), "a stripped detail stays stripped")
+		s.Equal(once.PersonalDetails(), widened.PersonalDetails())
+	})
+}
+
+func (s *MinimizationSuite) TestParseMinimizationPolicy() {
+	s.Run("no rules is full minimization", func() {
+		policy, err := ParseMinimizationPolicy(nil)
+		s.Require().NoError(err)
+		s.Equal(MinimizationPolicy{}, policy)
+	})
+
+	s.Run("parses coarsening rules", func() {
+		policy, err := ParseMinimizationPolicy(map[string]string{"date_of_birth": "year", "address": "city", "full_name": "clear"})
+		s.Require().NoError(err)
+		s.Equal(coarsening, policy)
+	})
+
+	s.Run("rejects rules a field cannot take", func() {
+		for _, rules := range []map[string]string{
+			{"full_name": "year"},
+			{"date_of_birth": "city"},
+			{"address": "year"},
+			{"date_of_birth": "keep"},
+			{"email": "clear"},
+		} {
+			_, err := ParseMinimizationPolicy(rules)
+			s.ErrorIs(err, ErrUnsupportedRule)
+		}
+	})
+}
@@ -0,0 +1,162 @@
+package domain
+
+// Justification: The domain packages promise no I/O, no context.Context, and no
+// wall-clock or randomness reads, but the compiler cannot enforce it. This test
+// parses every non-test file under domain/ so a forbidden import, time.Now()
+// call, or context.Context parameter fails the build instead of eroding the
+// architecture one convenience at a time.
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+// forbiddenImports are packages whose presence implies I/O, ambient context,
+// or nondeterminism.
+var forbiddenImports = map[string]string{
+	"context":       "domain functions must not take a context",
+	"crypto/rand":   "randomness must be injected by the application layer",
+	"database/sql":  "domain packages must not touch the database",
+	"io/ioutil":     "domain packages must not touch the filesystem",
+	"math/rand":     "randomness must be injected by the application layer",
+	"math/rand/v2":  "randomness must be injected by the application layer",
+	"net":           "domain packages must not perform network I/O",
+	"net/http":      "domain packages must not perform network I/O",
+	"os":            "domain packages must not touch the filesystem or environment",
+	"os/exec":       "domain packages must not spawn processes",
+	"path/filepath": "domain packages must not touch the filesystem",
+}
+
+// forbiddenTimeCalls read the wall clock; time is received as a parameter instead.
+var forbiddenTimeCalls = map[string]bool{"Now": true, "Since": true, "Until": true}
+
+type DomainPuritySuite struct {
+	suite.Suite
+}
+
+func TestDomainPuritySuite(t *testing.T) {
+	suite.Run(t, new(DomainPuritySuite))
+}
+
+func (s *DomainPuritySuite) TestDomainPackagesArePure() {
+	fset := token.NewFileSet()
+	var scanned int
+	err := filepath.WalkDir(".", func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || filepath.Ext(path) != ".go" || strings.HasSuffix(path, "_test.go") {
+			return nil
+		}
+		file, err := parser.ParseFile(fset, path, nil, parser.SkipObjectResolution)
+		if err != nil {
+			return err
+		}
+		scanned++
+		for _, violation := range purityViolations(fset, file) {
+			s.Fail(violation)
+		}
+		return nil
+	})
+	s.Require().NoError(err)
+	s.Require().NotZero(scanned, "no domain sources found; the scan root is wrong")
+}
+
+func (s *DomainPuritySuite) TestDetectsViolations() {
+	cases := map[string]string{
+		"forbidden import": `package bad
+import "net/http"
+var _ = http.MethodGet`,
+		"time.Now call": `package bad
+import "time"
+func Stamp() time.Time { return time.Now() }`,
+		"aliased time.Now call": `package bad
+import clock "time"
+func Stamp() clock.Time { return clock.Now() }`,
+		"context parameter": `package bad
+import ctxpkg "context"
+func Do(ctx ctxpkg.Context) {}`,
+	}
+	for name, src := range cases {
+		s.Run(name, func() {
+			fset := token.NewFileSet()
+			file, err := parser.ParseFile(fset, "bad.go", src, parser.SkipObjectResolution)
+			s.Require().NoError(err)
+			s.NotEmpty(purityViolations(fset, file))
+		})
+	}
+
+	s.Run("time values received as parameters are allowed", func() {
+		fset := token.NewFileSet()
+		file, err := parser.ParseFile(fset, "good.go", `package good
+import "time"
+func Expired(checkedAt, now time.Time, ttl time.Duration) bool { return now.Sub(checkedAt) > ttl }`, parser.SkipObjectResolution)
+		s.Require().NoError(err)
+		s.Empty(purityViolations(fset, file))
+	})
+}
+
+// purityViolations reports forbidden imports, wall-clock reads, and
+// context.Context parameters in file, resolving import aliases.
+func purityViolations(fset *token.FileSet, file *ast.File) []string {
+	var violations []string
+	report := func(pos token.Pos, msg string) {
+		violations = append(violations, fset.Position(pos).String()+": "+msg)
+	}
+
+	names := make(map[string]string) // local package name -> import path
+	for _, imp := range file.Imports {
+		path, err := strconv.Unquote(imp.Path.Value)
+		if err != nil {
+			continue
+		}
+		if reason, ok := forbiddenImports[path]; ok {
+			report(imp.Pos(), "imports "+path+": "+reason)
+		}
+		name := path[strings.LastIndex(path, "/")+1:]
+		if imp.Name != nil {
+			name = imp.Name.Name
+		}
+		names[name] = path
+	}
+
+	isPkgSelector := func(expr ast.Expr, pkgPath string) (string, bool) {
+		sel, ok := expr.(*ast.SelectorExpr)
+		if !ok {
+			return "", false
+		}
+		ident, ok := sel.X.(*ast.Ident)
+		if !ok || names[ident.Name] != pkgPath {
+			return "", false
+		}
+		return sel.Sel.Name, true
+	}
+
+	ast.Inspect(file, func(n ast.Node) bool {
+		switch node := n.(type) {
+		case *ast.CallExpr:
+			if fn, ok := isPkgSelector(node.Fun, "time"); ok && forbiddenTimeCalls[fn] {
+				report(node.Pos(), "calls time."+fn+"(); receive the current time as a parameter")
+			}
+		case *ast.FuncType:
+			if node.Params == nil {
+				return true
+			}
+			for _, param := range node.Params.List {
+				if typ, ok := isPkgSelector(param.Type, "context"); ok && typ == "Context" {
+					report(param.Pos(), "takes a context.Context parameter")
+				}
+			}
+		}
+		return true
+	})
+	return violations
+}
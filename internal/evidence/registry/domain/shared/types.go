@@ -14,6 +14,7 @@ package shared
 
 import (
 	"errors"
+	"strings"
 	"time"
 )
 
@@ -22,6 +23,9 @@ import (
 //
 // Invariants:
 //   - Value must be between 0.0 and 1.0 inclusive
+//
+// All confidence arithmetic goes through Combine and Scale, which clamp their
+// results, so derived scores can never leave the valid range.
 type Confidence struct {
 	value float64
 }
@@ -29,14 +33,42 @@ type Confidence struct {
 // ErrInvalidConfidence indicates the confidence score is out of range.
 var ErrInvalidConfidence = errors.New("invalid confidence: must be between 0.0 and 1.0")
 
-// New creates a validated Confidence score.
-func New(value float64) (Confidence, error) {
-	if value < 0.0 || value > 1.0 {
+// NewConfidence creates a validated Confidence score.
+// Out-of-range and NaN inputs are rejected rather than clamped, since they
+// indicate a misbehaving provider rather than rounding error.
+func NewConfidence(value float64) (Confidence, error) {
+	if !(value >= 0.0 && value <= 1.0) {
 		return Confidence{}, ErrInvalidConfidence
 	}
 	return Confidence{value: value}, nil
 }
 
+// clampConfidence pins a derived score into [0.0, 1.0]; NaN becomes 0.0.
+func clampConfidence(value float64) Confidence {
+	switch {
+	case value >= 1.0:
+		return Confidence{value: 1.0}
+	case value > 0.0:
+		return Confidence{value: value}
+	default:
+		return Confidence{}
+	}
+}
+
+// Combine blends other into c, giving other the share weight of the result:
+// c*(1-weight) + other*weight. A weight of 0.5 is a plain average. The weight
+// is clamped to [0.0, 1.0], so the result always lies between the two inputs.
+func (c Confidence) Combine(other Confidence, weight float64) Confidence {
+	share := clampConfidence(weight).value
+	return clampConfidence(c.value*(1-share) + other.value*share)
+}
+
+// Scale multiplies c by factor, clamping the result to [0.0, 1.0].
+// Use it to decay a score (factor < 1) or boost a corroborated one (factor > 1).
+func (c Confidence) Scale(factor float64) Confidence {
+	return clampConfidence(c.value * factor)
+}
+
 // Authoritative returns a Confidence of 1.0 (fully trusted source).
 func Authoritative() Confidence {
 	return Confidence{value: 1.0}
@@ -83,13 +115,45 @@ func (c CheckedAt) IsZero() bool {
 
 // ProviderID identifies the source of evidence.
 // This is used to track which registry or provider produced a piece of evidence.
+//
+// Invariants:
+//   - Value is lowercase, so ids differing only by case are the same provider
+//   - Value is 1-64 characters of a-z, 0-9, '.', '_', ':' or '-', starting with a letter or digit
 type ProviderID struct {
 	value string
 }
 
-// NewProviderID creates a ProviderID.
+// ErrInvalidProviderID indicates the provider identifier is empty or uses disallowed characters.
+var ErrInvalidProviderID = errors.New("invalid provider id: must be 1-64 characters of a-z, 0-9, '.', '_', ':' or '-'")
+
+const maxProviderIDLength = 64
+
+// NewProviderID creates a ProviderID, normalizing case and surrounding whitespace.
+// It does not validate; use ParseProviderID for identifiers from untrusted sources.
 func NewProviderID(value string) ProviderID {
-	return ProviderID{value: value}
+	return ProviderID{value: NormalizeProviderID(value)}
+}
+
+// ParseProviderID normalizes and validates a provider identifier.
+func ParseProviderID(value string) (ProviderID, error) {
+	normalized := NormalizeProviderID(value)
+	if normalized == "" || len(normalized) > maxProviderIDLength {
+		return ProviderID{}, ErrInvalidProviderID
+	}
+	for i := 0; i < len(normalized); i++ {
+		c := normalized[i]
+		alnum := (c >= 'a' && c <= 'z') || (c >= '0' && c <= '9')
+		if !alnum && (i == 0 || !strings.ContainsRune("._:-", rune(c))) {
+			return ProviderID{}, ErrInvalidProviderID
+		}
+	}
+	return ProviderID{value: normalized}, nil
+}
+
+// NormalizeProviderID returns the canonical form of a provider identifier:
+// trimmed and lowercased. Use it wherever provider ids are compared or used as keys.
+func NormalizeProviderID(value string) string {
+	return strings.ToLower(strings.TrimSpace(value))
 }
 
 func (p ProviderID) String() string {
@@ -0,0 +1,122 @@
+package shared
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type SharedKernelSuite struct {
+	suite.Suite
+}
+
+func TestSharedKernelSuite(t *testing.T) {
+	suite.Run(t, new(SharedKernelSuite))
+}
+
+func (s *SharedKernelSuite) mustConfidence(value float64) Confidence {
+	c, err := NewConfidence(value)
+	s.Require().NoError(err)
+	return c
+}
+
+// TestNewConfidence verifies the constructor guards the range invariant.
+// Invariant: Confidence values must be between 0.0 and 1.0 inclusive.
+func (s *SharedKernelSuite) TestNewConfidence() {
+	s.Run("accepts the inclusive bounds", func() {
+		s.InDelta(0.0, s.mustConfidence(0.0).Value(), 0)
+		s.InDelta(1.0, s.mustConfidence(1.0).Value(), 0)
+	})
+
+	s.Run("rejects out-of-range values", func() {
+		for _, value := range []float64{-0.01, 1.01, math.NaN(), math.Inf(1)} {
+			_, err := NewConfidence(value)
+			s.ErrorIs(err, ErrInvalidConfidence, "value %v", value)
+		}
+	})
+}
+
+// TestCombine verifies blending two confidences stays between the inputs.
+func (s *SharedKernelSuite) TestCombine() {
+	low := s.mustConfidence(0.4)
+	high := s.mustConfidence(0.8)
+
+	s.Run("half weight is a plain average", func() {
+		s.InDelta(0.6, low.Combine(high, 0.5).Value(), 1e-9)
+	})
+
+	s.Run("weight selects the share given to the other score", func() {
+		s.InDelta(0.4, low.Combine(high, 0).Value(), 1e-9)
+		s.InDelta(0.8, low.Combine(high, 1).Value(), 1e-9)
+		s.InDelta(0.7, low.Combine(high, 0.75).Value(), 1e-9)
+	})
+
+	s.Run("out-of-range weight is clamped", func() {
+		s.InDelta(0.8, low.Combine(high, 3).Value(), 1e-9)
+		s.InDelta(0.4, low.Combine(high, -1).Value(), 1e-9)
+		s.InDelta(0.4, low.Combine(high, math.NaN()).Value(), 1e-9)
+	})
+}
+
+// TestScale verifies scaling clamps into the valid range.
+func (s *SharedKernelSuite) TestScale() {
+	c := s.mustConfidence(0.6)
+
+	s.Run("decays within range", func() {
+		s.InDelta(0.3, c.Scale(0.5).Value(), 1e-9)
+	})
+
+	s.Run("clamps to authoritative", func() {
+		s.True(c.Scale(2).IsAuthoritative())
+	})
+
+	s.Run("clamps to zero", func() {
+		s.InDelta(0.0, c.Scale(-1).Value(), 0)
+		s.InDelta(0.0, c.Scale(math.NaN()).Value(), 0)
+	})
+}
+
+// TestProviderID verifies provider ids are validated and normalized to a stable key.
+// Invariant: ids differing only by case identify the same provider.
+func (s *SharedKernelSuite) TestProviderID() {
+	s.Run("accepts a valid id", func() {
+		pid, err := ParseProviderID("gov-citizen-registry.v1")
+		s.Require().NoError(err)
+		s.Equal("gov-citizen-registry.v1", pid.String())
+	})
+
+	s.Run("accepts synthetic correlation ids", func() {
+		_, err := ParseProviderID("correlation:citizen_name")
+		s.NoError(err)
+	})
+
+	s.Run("rejects an empty id", func() {
+		for _, value := range []string{"", "   "} {
+			_, err := ParseProviderID(value)
+			s.ErrorIs(err, ErrInvalidProviderID)
+		}
+	})
+
+	s.Run("rejects disallowed characters", func() {
+		for _, value := range []string{"gov citizen", "gov/citizen", "-leading-dash", "gové"} {
+			_, err := ParseProviderID(value)
+			s.ErrorIs(err, ErrInvalidProviderID, "value %q", value)
+		}
+	})
+
+	s.Run("rejects overlong ids", func() {
+		_, err := ParseProviderID(strings.Repeat("a", maxProviderIDLength+1))
+		s.ErrorIs(err, ErrInvalidProviderID)
+	})
+
+	s.Run("casing normalization produces a stable key", func() {
+		upper, err := ParseProviderID("  Gov-Citizen-Registry ")
+		s.Require().NoError(err)
+		lower, err := ParseProviderID("gov-citizen-registry")
+		s.Require().NoError(err)
+		s.Equal(lower, upper)
+		s.Equal(lower, NewProviderID("GOV-CITIZEN-REGISTRY"))
+	})
+}
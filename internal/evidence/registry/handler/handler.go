@@ -36,15 +36,34 @@ type Handler struct {
 	service    RegistryService
 	opsTracker *ops.Publisher
 	logger     *slog.Logger
+	checksums  *id.ChecksumRegistry
+}
+
+// Option configures a Handler.
+type Option func(*Handler)
+
+// WithChecksums sets the per-country checksums applied to national IDs sent
+// with a country. Defaults to id.DefaultChecksums().
+func WithChecksums(checksums *id.ChecksumRegistry) Option {
+	return func(h *Handler) {
+		h.checksums = checksums
+	}
 }
 
 // New creates a new registry handler.
-func New(service RegistryService, opsTracker *ops.Publisher, logger *slog.Logger) *Handler {
-	return &Handler{
+func New(service RegistryService, opsTracker *ops.Publisher, logger *slog.Logger, opts ...Option) *Handler {
+	h := &Handler{
 		service:    service,
 		opsTracker: opsTracker,
 		logger:     logger,
 	}
+	for _, opt := range opts {
+		opt(h)
+	}
+	if h.checksums == nil {
+		h.checksums = id.DefaultChecksums()
+	}
+	return h
 }
 
 // Register mounts the handler routes on the given router.
@@ -56,6 +75,7 @@ func (h *Handler) Register(r chi.Router) {
 // CitizenLookupRequest is the request body for citizen lookup.
 type CitizenLookupRequest struct {
 	NationalID string `json:"national_id"`
+	Country    string `json:"country,omitempty"` // Optional ISO 3166-1 alpha-2 issuer; enables its checksum
 
 	// parsedNationalID holds the validated domain primitive after Validate() succeeds.
 	// This avoids double-parsing: Validate() parses once, handler uses the result.
@@ -81,18 +101,22 @@ func (r *CitizenLookupRequest) ParsedNationalID() id.NationalID {
 
 // CitizenLookupResponse is the response body for citizen lookup.
 type CitizenLookupResponse struct {
-	NationalID  string `json:"national_id,omitempty"`
-	FullName    string `json:"full_name,omitempty"`
-	DateOfBirth string `json:"date_of_birth,omitempty"`
-	Address     string `json:"address,omitempty"`
-	Valid       bool   `json:"valid"`
-	Source      string `json:"source"`
-	CheckedAt   string `json:"checked_at"`
+	NationalID   string  `json:"national_id,omitempty"`
+	FullName     string  `json:"full_name,omitempty"`
+	DateOfBirth  string  `json:"date_of_birth,omitempty"`
+	Address      string  `json:"address,omitempty"`
+	BirthYear    string  `json:"birth_year,omitempty"`
+	City         string  `json:"city,omitempty"`
+	Completeness float64 `json:"completeness"`
+	Valid        bool    `json:"valid"`
+	Source       string  `json:"source"`
+	CheckedAt    string  `json:"checked_at"`
 }
 
 // SanctionsCheckRequest is the request body for sanctions lookup.
 type SanctionsCheckRequest struct {
 	NationalID string `json:"national_id"`
+	Country    string `json:"country,omitempty"` // Optional ISO 3166-1 alpha-2 issuer; enables its checksum
 
 	// parsedNationalID holds the validated domain primitive after Validate() succeeds.
 	parsedNationalID id.NationalID
@@ -115,6 +139,15 @@ func (r *SanctionsCheckRequest) ParsedNationalID() id.NationalID {
 	return r.parsedNationalID
 }
 
+// forCountry applies the checksum of the request's issuing country, if given.
+func (h *Handler) forCountry(country string, nationalID id.NationalID) (id.NationalID, error) {
+	checked, err := h.checksums.ForCountry(id.CountryCode(country), nationalID)
+	if err != nil {
+		return id.NationalID{}, dErrors.New(dErrors.CodeBadRequest, err.Error())
+	}
+	return checked, nil
+}
+
 // SanctionsCheckResponse is the response body for sanctions lookup.
 type SanctionsCheckResponse struct {
 	NationalID string `json:"national_id"`
@@ -142,7 +175,11 @@ func (h *Handler) HandleCitizenLookup(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Use the already-parsed domain primitive from validation
-	nationalID := req.ParsedNationalID()
+	nationalID, err := h.forCountry(req.Country, req.ParsedNationalID())
+	if err != nil {
+		httputil.WriteError(w, err)
+		return
+	}
 
 	// Perform citizen lookup (consent check is atomic within service)
 	record, err := h.service.Citizen(ctx, userID, nationalID)
@@ -166,13 +203,16 @@ func (h *Handler) HandleCitizenLookup(w http.ResponseWriter, r *http.Request) {
 
 	// Map to response
 	response := CitizenLookupResponse{
-		NationalID:  record.NationalID,
-		FullName:    record.FullName,
-		DateOfBirth: record.DateOfBirth,
-		Address:     record.Address,
-		Valid:       record.Valid,
-		Source:      record.Source,
-		CheckedAt:   record.CheckedAt.Format(time.RFC3339),
+		NationalID:   record.NationalID,
+		FullName:     record.FullName,
+		DateOfBirth:  record.DateOfBirth,
+		Address:      record.Address,
+		BirthYear:    record.BirthYear,
+		City:         record.City,
+		Completeness: record.Completeness,
+		Valid:        record.Valid,
+		Source:       record.Source,
+		CheckedAt:    record.CheckedAt.Format(time.RFC3339),
 	}
 
 	httputil.WriteJSON(w, http.StatusOK, response)
@@ -197,7 +237,11 @@ func (h *Handler) HandleSanctionsLookup(w http.ResponseWriter, r *http.Request)
 	}
 
 	// Use the already-parsed domain primitive from validation
-	nationalID := req.ParsedNationalID()
+	nationalID, err := h.forCountry(req.Country, req.ParsedNationalID())
+	if err != nil {
+		httputil.WriteError(w, err)
+		return
+	}
 
 	// Perform sanctions lookup (consent check and audit are atomic within service)
 	// The service implements fail-closed audit semantics for listed sanctions.
@@ -121,6 +121,22 @@ func TestHandleSanctionsLookup_InvalidNationalIDFormat(t *testing.T) {
 	}
 }
 
+func TestHandleSanctionsLookup_CountryChecksum(t *testing.T) {
+	// No service: a failed checksum must be rejected before any lookup
+	handler := newTestRegistryHandler(nil, nil)
+	body, err := json.Marshal(map[string]string{"national_id": "8112189875", "country": "SE"})
+	require.NoError(t, err)
+	req := httptest.NewRequest(http.MethodPost, "/registry/sanctions", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	req = req.WithContext(requestcontext.WithUserID(req.Context(), validUserID()))
+
+	w := httptest.NewRecorder()
+	handler.HandleSanctionsLookup(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assertErrorResponse(t, w, "bad_request")
+}
+
 func TestHandleSanctionsLookup_MissingConsent(t *testing.T) {
 	// Consent is now checked atomically in the service layer to prevent TOCTOU.
 	// The service returns the consent error, which the handler maps to 403.
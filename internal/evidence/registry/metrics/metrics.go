@@ -24,8 +24,16 @@ type Metrics struct {
 
 	// PRD-020 FR-0: PII minimization violations in regulated mode
 	PIIMinimizationViolations prometheus.Counter
+
+	// Provider lookup metrics (per-provider health)
+	ProviderLookupDurationSeconds *prometheus.HistogramVec // Provider Lookup latency by provider_id
+	ProviderLookupsTotal          *prometheus.CounterVec   // Provider lookups by provider_id, outcome, error_class
+	ProviderRetriesShedTotal      *prometheus.CounterVec   // Retries skipped by the orchestrator retry budget, by provider_id
 }
 
+// ErrorClassNone is the error_class label for successful provider lookups.
+const ErrorClassNone = "none"
+
 // New creates a new Metrics instance with all metrics registered.
 func New() *Metrics {
 	return &Metrics{
@@ -65,6 +73,22 @@ func New() *Metrics {
 			Name: "credo_pii_minimization_violations_total",
 			Help: "Total number of PII minimization violations detected in regulated mode",
 		}),
+
+		ProviderLookupDurationSeconds: promauto.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "credo_registry_provider_lookup_duration_seconds",
+			Help:    "Duration of registry provider lookups by provider",
+			Buckets: []float64{0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5}, // Network calls up to the default 5s timeout
+		}, []string{"provider_id"}),
+
+		ProviderLookupsTotal: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name: "credo_registry_provider_lookups_total",
+			Help: "Total number of registry provider lookups by provider, outcome, and error class",
+		}, []string{"provider_id", "outcome", "error_class"}),
+
+		ProviderRetriesShedTotal: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name: "credo_registry_provider_retries_shed_total",
+			Help: "Total number of provider retries skipped because the orchestrator retry budget was exhausted",
+		}, []string{"provider_id"}),
 	}
 }
 
@@ -108,3 +132,19 @@ func CacheHitRate(hits, misses float64) float64 {
 func (m *Metrics) IncrementPIIMinimizationViolations() {
 	m.PIIMinimizationViolations.Inc()
 }
+
+// ObserveProviderLookup records a provider lookup's latency and outcome.
+// errorClass is empty for successful lookups.
+func (m *Metrics) ObserveProviderLookup(providerID string, durationSeconds float64, errorClass string) {
+	m.ProviderLookupDurationSeconds.WithLabelValues(providerID).Observe(durationSeconds)
+	if errorClass == "" {
+		m.ProviderLookupsTotal.WithLabelValues(providerID, "success", ErrorClassNone).Inc()
+		return
+	}
+	m.ProviderLookupsTotal.WithLabelValues(providerID, "error", errorClass).Inc()
+}
+
+// IncrementRetriesShed records a retry skipped because the retry budget was spent.
+func (m *Metrics) IncrementRetriesShed(providerID string) {
+	m.ProviderRetriesShedTotal.WithLabelValues(providerID).Inc()
+}
@@ -9,9 +9,21 @@ type CitizenRecord struct {
 	FullName    string
 	DateOfBirth string
 	Address     string
+	BirthYear   string // Coarse year of birth a minimization policy may keep
+	City        string // Coarse city a minimization policy may keep
 	Valid       bool
 	Source      string
 	CheckedAt   time.Time
+	// Completeness is the fraction of personal details the registry populated,
+	// measured before minimization so minimized records keep it.
+	Completeness float64
+}
+
+// HasPII reports whether any full personal detail is populated. Minimized records
+// carried in regulated mode must not have any; the coarse BirthYear and City a
+// minimization policy keeps do not count.
+func (r *CitizenRecord) HasPII() bool {
+	return r.FullName != "" || r.DateOfBirth != "" || r.Address != ""
 }
 
 // SanctionsRecord captures sanctions lookups.
@@ -0,0 +1,163 @@
+package orchestrator
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"credo/internal/evidence/registry/domain/shared"
+	"credo/internal/evidence/registry/providers"
+	"credo/pkg/platform/circuit"
+)
+
+// ErrCircuitOpen is reported for a provider skipped because its circuit breaker
+// is open.
+var ErrCircuitOpen = errors.New("provider circuit open")
+
+// BreakerConfig configures the per-provider circuit breakers.
+//
+// A provider's breaker opens after FailureThreshold consecutive outage-class
+// failures (timeouts, outages, rate limits). While open, lookups skip the
+// provider, so fallback moves straight to the next provider in the chain.
+// After Cooldown, lookups try the provider again; SuccessThreshold consecutive
+// successes close the breaker, and a failure restarts the cooldown.
+type BreakerConfig struct {
+	FailureThreshold int           // Consecutive failures that open a breaker (default: 5; negative disables breakers)
+	SuccessThreshold int           // Consecutive successes that close it (default: 1)
+	Cooldown         time.Duration // How long an open provider is skipped (default: 30s)
+}
+
+// providerBreaker pairs a provider's breaker with the end of its cooldown.
+type providerBreaker struct {
+	breaker  *circuit.Breaker
+	cooldown time.Duration
+
+	mu      sync.Mutex
+	retryAt time.Time // while open, the provider is skipped until this time
+}
+
+// allow reports whether a lookup may call the provider. The cooldown runs from
+// the later of the breaker opening (on its own or by operator override) and
+// the last failed trial.
+func (b *providerBreaker) allow() bool {
+	snapshot := b.breaker.Snapshot()
+	if snapshot.State == circuit.StatusClosed {
+		return true
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	retryAt := snapshot.LastTransition.Add(b.cooldown)
+	if b.retryAt.After(retryAt) {
+		retryAt = b.retryAt
+	}
+	return !time.Now().Before(retryAt)
+}
+
+// record feeds one provider outcome to the breaker. Outage-class errors count
+// as failures; any other answer, including not-found or bad data, shows the
+// provider is up and counts as a success. Cancellation is not the provider's
+// fault and is ignored.
+func (b *providerBreaker) record(err error) {
+	switch {
+	case errors.Is(err, context.Canceled):
+	case err == nil || (!providers.IsRetryable(err) && !errors.Is(err, context.DeadlineExceeded)):
+		b.breaker.RecordSuccess()
+	default:
+		b.breaker.RecordFailure()
+		if b.breaker.IsOpen() {
+			b.mu.Lock()
+			b.retryAt = time.Now().Add(b.cooldown)
+			b.mu.Unlock()
+		}
+	}
+}
+
+// breakerSet holds one breaker per provider. New creates breakers for the
+// registered providers; any other provider gets one on first use.
+type breakerSet struct {
+	cfg BreakerConfig
+
+	mu       sync.Mutex
+	breakers map[string]*providerBreaker
+}
+
+// newBreakerSet returns nil when breakers are disabled.
+func newBreakerSet(cfg BreakerConfig) *breakerSet {
+	if cfg.FailureThreshold < 0 {
+		return nil
+	}
+	if cfg.FailureThreshold == 0 {
+		cfg.FailureThreshold = 5
+	}
+	if cfg.SuccessThreshold <= 0 {
+		cfg.SuccessThreshold = 1
+	}
+	if cfg.Cooldown <= 0 {
+		cfg.Cooldown = 30 * time.Second
+	}
+	return &breakerSet{cfg: cfg, breakers: make(map[string]*providerBreaker)}
+}
+
+func (s *breakerSet) get(providerID string) *providerBreaker {
+	id := shared.NormalizeProviderID(providerID)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	b, ok := s.breakers[id]
+	if !ok {
+		b = &providerBreaker{
+			breaker: circuit.New("registry_"+id,
+				circuit.WithFailureThreshold(s.cfg.FailureThreshold),
+				circuit.WithSuccessThreshold(s.cfg.SuccessThreshold)),
+			cooldown: s.cfg.Cooldown,
+		}
+		s.breakers[id] = b
+	}
+	return b
+}
+
+// allow reports whether providerID may be called. A nil set allows everything.
+func (s *breakerSet) allow(providerID string) bool {
+	return s == nil || s.get(providerID).allow()
+}
+
+// record feeds a provider outcome to its breaker. A nil set ignores it.
+func (s *breakerSet) record(providerID string, err error) {
+	if s != nil {
+		s.get(providerID).record(err)
+	}
+}
+
+// isOpen reports whether providerID's breaker is open.
+func (s *breakerSet) isOpen(providerID string) bool {
+	return s != nil && s.get(providerID).breaker.IsOpen()
+}
+
+// circuitOpenError is the error reported for a skipped provider.
+func circuitOpenError(providerID string) error {
+	return providers.NewProviderError(providers.ErrorProviderOutage, providerID, "provider skipped while its circuit is open", ErrCircuitOpen)
+}
+
+// CircuitBreakers returns the provider breakers ordered by name, for status
+// reporting alongside the platform's other breakers.
+func (o *Orchestrator) CircuitBreakers() []circuit.Reporter {
+	if o.breakers == nil {
+		return nil
+	}
+	o.breakers.mu.Lock()
+	defer o.breakers.mu.Unlock()
+	breakers := make([]*circuit.Breaker, 0, len(o.breakers.breakers))
+	for _, b := range o.breakers.breakers {
+		breakers = append(breakers, b.breaker)
+	}
+	slices.SortFunc(breakers, func(a, b *circuit.Breaker) int {
+		return strings.Compare(a.Name(), b.Name())
+	})
+	reporters := make([]circuit.Reporter, 0, len(breakers))
+	for _, b := range breakers {
+		reporters = append(reporters, b)
+	}
+	return reporters
+}
@@ -0,0 +1,163 @@
+package correlation
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"strings"
+
+	"credo/internal/evidence/registry/providers"
+)
+
+// ErrIdentityMismatch is returned when citizen and sanctions evidence cannot
+// describe the same person: their national IDs differ, or every field both
+// sources report disagrees.
+var ErrIdentityMismatch = errors.New("citizen and sanctions evidence describe different people")
+
+// DefaultNameTolerance accepts about one typo per ten characters of a name.
+const DefaultNameTolerance = 0.1
+
+// NameDOBCorrelationRule cross-checks citizen evidence against the sanctions
+// evidence returned for the same national_id. A sanctions result only says
+// something about the citizen if both registries matched the same person, so
+// the rule compares full_name and date_of_birth wherever both sources report
+// them and lowers the citizen record's confidence by the share that disagree.
+type NameDOBCorrelationRule struct {
+	tolerance float64
+}
+
+// NewNameDOBCorrelationRule creates a NameDOBCorrelationRule. Tolerance is the
+// largest edit distance between two names, as a fraction of the longer name,
+// that still counts as agreement (0.1 accepts "Jon Smith" for "John Smith").
+// Names are compared case- and whitespace-insensitively; dates must match
+// exactly. Tolerance is clamped to [0, 1].
+func NewNameDOBCorrelationRule(tolerance float64) *NameDOBCorrelationRule {
+	return &NameDOBCorrelationRule{tolerance: min(max(tolerance, 0), 1)}
+}
+
+// Applicable returns true when both citizen and sanctions evidence are present.
+func (r *NameDOBCorrelationRule) Applicable(types []providers.ProviderType) bool {
+	var citizen, sanctions bool
+	for _, t := range types {
+		switch t {
+		case providers.ProviderTypeCitizen:
+			citizen = true
+		case providers.ProviderTypeSanctions:
+			sanctions = true
+		}
+	}
+	return citizen && sanctions
+}
+
+// Merge cross-checks the most confident citizen record against the most
+// confident sanctions record.
+//
+// The returned Evidence is a copy of the citizen record, keeping its ProviderID, with:
+//   - Confidence: the citizen confidence scaled by the share of compared fields that agree
+//   - Metadata["merge_strategy"]: "name_dob_cross_check"
+//   - Metadata["compared_fields"]: comma-separated fields both sources reported
+//   - Metadata["conflicts"]: comma-separated fields that disagree (if any)
+//
+// Sanctions evidence carries no name or date of birth unless the provider
+// returns them; with nothing to compare, the citizen record passes through with
+// its confidence unchanged. Merge returns ErrIdentityMismatch when the national
+// IDs differ or every compared field disagrees.
+func (r *NameDOBCorrelationRule) Merge(evidence []*providers.Evidence) (*providers.Evidence, error) {
+	citizen := mostConfidentOfType(evidence, providers.ProviderTypeCitizen)
+	sanctions := mostConfidentOfType(evidence, providers.ProviderTypeSanctions)
+	if citizen == nil || sanctions == nil {
+		return nil, fmt.Errorf("name/dob cross-check needs citizen and sanctions evidence")
+	}
+
+	citizenID, _ := citizen.Data["national_id"].(string)
+	sanctionsID, _ := sanctions.Data["national_id"].(string)
+	if citizenID != sanctionsID {
+		return nil, fmt.Errorf("%w: national_id", ErrIdentityMismatch)
+	}
+
+	var compared, conflicts []string
+	for _, field := range []string{"full_name", "date_of_birth"} {
+		a, okA := citizen.Data[field].(string)
+		b, okB := sanctions.Data[field].(string)
+		if !okA || !okB || a == "" || b == "" {
+			continue
+		}
+		compared = append(compared, field)
+		if !r.agree(field, a, b) {
+			conflicts = append(conflicts, field)
+		}
+	}
+	if len(compared) > 0 && len(conflicts) == len(compared) {
+		return nil, fmt.Errorf("%w: %s", ErrIdentityMismatch, strings.Join(conflicts, ","))
+	}
+
+	merged := &providers.Evidence{
+		ProviderID:   citizen.ProviderID,
+		ProviderType: providers.ProviderTypeCitizen,
+		Confidence:   citizen.Confidence,
+		Data:         make(map[string]any, len(citizen.Data)),
+		CheckedAt:    citizen.CheckedAt,
+		Metadata: map[string]string{
+			"merge_strategy":  "name_dob_cross_check",
+			"compared_fields": strings.Join(compared, ","),
+		},
+	}
+	maps.Copy(merged.Data, citizen.Data)
+	if len(conflicts) > 0 {
+		agreed := len(compared) - len(conflicts)
+		merged.Confidence = citizen.Confidence * float64(agreed) / float64(len(compared))
+		merged.Metadata["conflicts"] = strings.Join(conflicts, ",")
+	}
+
+	return merged, nil
+}
+
+// agree reports whether two values of field describe the same person.
+func (r *NameDOBCorrelationRule) agree(field, a, b string) bool {
+	if field != "full_name" {
+		return a == b
+	}
+	x, y := []rune(normalizeName(a)), []rune(normalizeName(b))
+	longest := max(len(x), len(y))
+	if longest == 0 {
+		return true
+	}
+	return float64(editDistance(x, y))/float64(longest) <= r.tolerance
+}
+
+// normalizeName lowercases a name and collapses runs of whitespace.
+func normalizeName(name string) string {
+	return strings.Join(strings.Fields(strings.ToLower(name)), " ")
+}
+
+// editDistance returns the Levenshtein distance between a and b.
+func editDistance(a, b []rune) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(b)]
+}
+
+// mostConfidentOfType returns the highest-confidence evidence of typ, or nil.
+func mostConfidentOfType(evidence []*providers.Evidence, typ providers.ProviderType) *providers.Evidence {
+	var best *providers.Evidence
+	for _, e := range evidence {
+		if e.ProviderType == typ && (best == nil || e.Confidence > best.Confidence) {
+			best = e
+		}
+	}
+	return best
+}
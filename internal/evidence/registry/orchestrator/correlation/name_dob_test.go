@@ -0,0 +1,96 @@
+package correlation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"credo/internal/evidence/registry/providers"
+)
+
+type NameDOBCorrelationRuleSuite struct {
+	suite.Suite
+}
+
+func TestNameDOBCorrelationRuleSuite(t *testing.T) {
+	suite.Run(t, new(NameDOBCorrelationRuleSuite))
+}
+
+func evidenceWith(providerType providers.ProviderType, confidence float64, data map[string]any) *providers.Evidence {
+	e := evidence(providerType, confidence)
+	e.Data = data
+	return e
+}
+
+func (s *NameDOBCorrelationRuleSuite) TestApplicable() {
+	rule := NewNameDOBCorrelationRule(0.1)
+
+	s.True(rule.Applicable([]providers.ProviderType{providers.ProviderTypeCitizen, providers.ProviderTypeSanctions}))
+	s.False(rule.Applicable([]providers.ProviderType{providers.ProviderTypeCitizen, providers.ProviderTypeCitizen}))
+	s.False(rule.Applicable([]providers.ProviderType{providers.ProviderTypeSanctions}))
+}
+
+// Justification: Name tolerance, the confidence penalty, and the mismatch error
+// decide whether a sanctions result is trusted for a citizen; registry E2E
+// fixtures always return agreeing records.
+func (s *NameDOBCorrelationRuleSuite) TestMerge() {
+	rule := NewNameDOBCorrelationRule(0.1)
+	citizen := evidenceWith(providers.ProviderTypeCitizen, 0.9, map[string]any{
+		"national_id":   "ABC123",
+		"full_name":     "John Smith",
+		"date_of_birth": "1990-01-15",
+		"valid":         true,
+	})
+	sanctions := func(name, dob string) *providers.Evidence {
+		return evidenceWith(providers.ProviderTypeSanctions, 1.0, map[string]any{
+			"national_id":   "ABC123",
+			"full_name":     name,
+			"date_of_birth": dob,
+			"listed":        false,
+		})
+	}
+
+	s.Run("agreeing records keep the citizen confidence", func() {
+		merged, err := rule.Merge([]*providers.Evidence{citizen, sanctions("JON  smith", "1990-01-15")})
+		s.Require().NoError(err)
+		s.Equal(citizen.ProviderID, merged.ProviderID, "the citizen source is kept")
+		s.Equal(providers.ProviderTypeCitizen, merged.ProviderType)
+		s.InDelta(0.9, merged.Confidence, 1e-9)
+		s.Equal("full_name,date_of_birth", merged.Metadata["compared_fields"])
+		s.NotContains(merged.Metadata, "conflicts")
+		s.Equal("John Smith", merged.Data["full_name"])
+	})
+
+	s.Run("partial mismatch lowers confidence", func() {
+		merged, err := rule.Merge([]*providers.Evidence{citizen, sanctions("John Smith", "1991-01-15")})
+		s.Require().NoError(err)
+		s.InDelta(0.45, merged.Confidence, 1e-9)
+		s.Equal("date_of_birth", merged.Metadata["conflicts"])
+	})
+
+	s.Run("names beyond tolerance conflict", func() {
+		merged, err := rule.Merge([]*providers.Evidence{citizen, sanctions("Joan Smythe", "1990-01-15")})
+		s.Require().NoError(err)
+		s.Equal("full_name", merged.Metadata["conflicts"])
+	})
+
+	s.Run("total mismatch is an error", func() {
+		_, err := rule.Merge([]*providers.Evidence{citizen, sanctions("Maria Garcia", "1975-06-30")})
+		s.ErrorIs(err, ErrIdentityMismatch)
+	})
+
+	s.Run("different national ids are an error", func() {
+		other := sanctions("John Smith", "1990-01-15")
+		other.Data["national_id"] = "XYZ789"
+		_, err := rule.Merge([]*providers.Evidence{citizen, other})
+		s.ErrorIs(err, ErrIdentityMismatch)
+	})
+
+	s.Run("sanctions without name or dob leave confidence unchanged", func() {
+		bare := evidenceWith(providers.ProviderTypeSanctions, 1.0, map[string]any{"national_id": "ABC123", "listed": false})
+		merged, err := rule.Merge([]*providers.Evidence{bare, citizen})
+		s.Require().NoError(err)
+		s.InDelta(0.9, merged.Confidence, 1e-9)
+		s.Empty(merged.Metadata["compared_fields"])
+	})
+}
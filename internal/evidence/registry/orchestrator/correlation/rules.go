@@ -5,6 +5,7 @@ import (
 	"maps"
 	"strings"
 
+	"credo/internal/evidence/registry/domain/shared"
 	"credo/internal/evidence/registry/providers"
 )
 
@@ -150,7 +151,7 @@ func (r *CitizenNameRule) detectConflicts(evidence []*providers.Evidence) []stri
 // using configurable weights per provider type. This is useful when some providers
 // are considered more authoritative than others (e.g., government sources vs. commercial).
 //
-// Provider types without explicit weights default to 1.0.
+// Provider types without explicit (positive) weights default to 1.0.
 type WeightedAverageRule struct {
 	Weights map[providers.ProviderType]float64
 }
@@ -165,7 +166,8 @@ func (r *WeightedAverageRule) Applicable(types []providers.ProviderType) bool {
 //
 // Algorithm:
 //  1. For each evidence, looks up weight by ProviderType (defaults to 1.0 if not configured)
-//  2. Calculates weighted average: sum(confidence * weight) / sum(weights)
+//  2. Calculates weighted average: sum(confidence * weight) / sum(weights), via Confidence.Combine
+//     so the result stays in [0.0, 1.0]; an out-of-range input confidence is an error
 //  3. Merges data fields from all sources (later sources override earlier ones)
 //
 // The returned Evidence has:
@@ -180,26 +182,29 @@ func (r *WeightedAverageRule) Merge(evidence []*providers.Evidence) (*providers.
 		return nil, fmt.Errorf("no evidence to merge")
 	}
 
-	// Calculate weighted confidence
+	// Calculate weighted confidence as a running average: each source takes its
+	// share of the weight seen so far, keeping the math inside shared.Confidence.
 	var totalWeight float64
-	var weightedSum float64
+	var avgConfidence shared.Confidence
 
 	for _, e := range evidence {
+		confidence, err := shared.NewConfidence(e.Confidence)
+		if err != nil {
+			return nil, fmt.Errorf("evidence from %s: %w", e.ProviderID, err)
+		}
 		weight := r.Weights[e.ProviderType]
-		if weight == 0 {
+		if weight <= 0 {
 			weight = 1.0 // Default weight
 		}
 		totalWeight += weight
-		weightedSum += e.Confidence * weight
+		avgConfidence = avgConfidence.Combine(confidence, weight/totalWeight)
 	}
 
-	avgConfidence := weightedSum / totalWeight
-
 	// Create merged evidence
 	merged := &providers.Evidence{
 		ProviderID:   "correlation:weighted_average",
 		ProviderType: evidence[0].ProviderType, // Use first type as representative
-		Confidence:   avgConfidence,
+		Confidence:   avgConfidence.Value(),
 		Data:         make(map[string]any),
 		CheckedAt:    evidence[0].CheckedAt,
 		Metadata: map[string]string{
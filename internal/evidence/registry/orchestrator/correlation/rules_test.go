@@ -0,0 +1,67 @@
+package correlation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"credo/internal/evidence/registry/domain/shared"
+	"credo/internal/evidence/registry/providers"
+)
+
+type WeightedAverageRuleSuite struct {
+	suite.Suite
+}
+
+func TestWeightedAverageRuleSuite(t *testing.T) {
+	suite.Run(t, new(WeightedAverageRuleSuite))
+}
+
+func evidence(providerType providers.ProviderType, confidence float64) *providers.Evidence {
+	return &providers.Evidence{
+		ProviderID:   "provider-" + string(providerType),
+		ProviderType: providerType,
+		Confidence:   confidence,
+		Data:         map[string]any{},
+		CheckedAt:    time.Date(2025, 12, 11, 10, 0, 0, 0, time.UTC),
+	}
+}
+
+func (s *WeightedAverageRuleSuite) TestMerge() {
+	s.Run("averages confidence by provider weight", func() {
+		rule := &WeightedAverageRule{Weights: map[providers.ProviderType]float64{
+			providers.ProviderTypeCitizen:   3,
+			providers.ProviderTypeSanctions: 1,
+		}}
+
+		merged, err := rule.Merge([]*providers.Evidence{
+			evidence(providers.ProviderTypeCitizen, 0.9),
+			evidence(providers.ProviderTypeSanctions, 0.5),
+		})
+		s.Require().NoError(err)
+		s.InDelta(0.8, merged.Confidence, 1e-9) // (0.9*3 + 0.5*1) / 4
+	})
+
+	s.Run("unweighted sources count equally", func() {
+		rule := &WeightedAverageRule{}
+
+		merged, err := rule.Merge([]*providers.Evidence{
+			evidence(providers.ProviderTypeCitizen, 1.0),
+			evidence(providers.ProviderTypeCitizen, 0.4),
+			evidence(providers.ProviderTypeCitizen, 0.7),
+		})
+		s.Require().NoError(err)
+		s.InDelta(0.7, merged.Confidence, 1e-9)
+	})
+
+	s.Run("rejects out-of-range source confidence", func() {
+		rule := &WeightedAverageRule{}
+
+		_, err := rule.Merge([]*providers.Evidence{
+			evidence(providers.ProviderTypeCitizen, 0.9),
+			evidence(providers.ProviderTypeCitizen, 1.5),
+		})
+		s.ErrorIs(err, shared.ErrInvalidConfidence)
+	})
+}
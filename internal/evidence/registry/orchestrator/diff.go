@@ -0,0 +1,109 @@
+package orchestrator
+
+import (
+	"cmp"
+	"context"
+	"log/slog"
+	"maps"
+	"reflect"
+	"slices"
+
+	"credo/internal/evidence/registry/providers"
+)
+
+// FieldDiff reports one evidence data field on which two providers disagree.
+// A field present in only one record is a disagreement with a nil value on the other side.
+type FieldDiff struct {
+	Field     string
+	ProviderA string
+	ProviderB string
+	ValueA    any  // nil when absent from A or redacted
+	ValueB    any  // nil when absent from B or redacted
+	Redacted  bool // Values withheld because the lookup ran in regulated mode
+}
+
+// DiffEvidence reports every Data field on which a and b disagree, sorted by field name.
+// Identical records, or a nil on either side, produce no diffs.
+func DiffEvidence(a, b *providers.Evidence) []FieldDiff {
+	if a == nil || b == nil {
+		return nil
+	}
+
+	fields := make(map[string]struct{}, len(a.Data)+len(b.Data))
+	for k := range a.Data {
+		fields[k] = struct{}{}
+	}
+	for k := range b.Data {
+		fields[k] = struct{}{}
+	}
+
+	var diffs []FieldDiff
+	for field := range fields {
+		valueA, okA := a.Data[field]
+		valueB, okB := b.Data[field]
+		if okA == okB && reflect.DeepEqual(valueA, valueB) {
+			continue
+		}
+		diffs = append(diffs, FieldDiff{
+			Field:     field,
+			ProviderA: a.ProviderID,
+			ProviderB: b.ProviderID,
+			ValueA:    valueA,
+			ValueB:    valueB,
+		})
+	}
+	slices.SortFunc(diffs, func(x, y FieldDiff) int { return cmp.Compare(x.Field, y.Field) })
+	return diffs
+}
+
+// RedactDiffs returns diffs with values stripped, keeping only which fields and
+// providers disagree. Regulated lookups surface this form so PII never leaves the
+// orchestrator through a discrepancy report.
+func RedactDiffs(diffs []FieldDiff) []FieldDiff {
+	redacted := make([]FieldDiff, len(diffs))
+	for i, d := range diffs {
+		redacted[i] = FieldDiff{Field: d.Field, ProviderA: d.ProviderA, ProviderB: d.ProviderB, Redacted: true}
+	}
+	return redacted
+}
+
+// findDiscrepancies diffs multi-source evidence of the same type before correlation
+// merges it away. Each type's records are ordered by provider ID and compared against
+// the first, so the report is stable regardless of which provider answered first.
+func findDiscrepancies(evidence []*providers.Evidence, regulated bool) []FieldDiff {
+	byType := make(map[providers.ProviderType][]*providers.Evidence)
+	for _, e := range evidence {
+		byType[e.ProviderType] = append(byType[e.ProviderType], e)
+	}
+
+	var diffs []FieldDiff
+	for _, typ := range slices.Sorted(maps.Keys(byType)) {
+		group := byType[typ]
+		if len(group) < 2 {
+			continue
+		}
+		slices.SortFunc(group, func(x, y *providers.Evidence) int { return cmp.Compare(x.ProviderID, y.ProviderID) })
+		for _, other := range group[1:] {
+			diffs = append(diffs, DiffEvidence(group[0], other)...)
+		}
+	}
+	if regulated {
+		return RedactDiffs(diffs)
+	}
+	return diffs
+}
+
+// logDiscrepancies emits a debug record per disagreeing field. Only field names and
+// provider IDs are logged, never values, matching the lookup log's PII policy.
+func (o *Orchestrator) logDiscrepancies(ctx context.Context, diffs []FieldDiff) {
+	if !o.logLookups || len(diffs) == 0 || !o.logger.Enabled(ctx, slog.LevelDebug) {
+		return
+	}
+	for _, d := range diffs {
+		o.logger.LogAttrs(ctx, slog.LevelDebug, "registry evidence discrepancy",
+			slog.String("field", d.Field),
+			slog.String("provider_a", d.ProviderA),
+			slog.String("provider_b", d.ProviderB),
+		)
+	}
+}
@@ -0,0 +1,88 @@
+package orchestrator
+
+import (
+	"context"
+	"time"
+
+	"credo/internal/evidence/registry/providers"
+)
+
+func (s *OrchestratorSuite) TestDiffEvidence() {
+	base := map[string]any{"national_id": "ABC123", "valid": true, "full_name": "Jane Doe"}
+
+	s.Run("identical evidence has no diff", func() {
+		a := s.evidenceWithData("citizen-1", 0.9, base)
+		b := s.evidenceWithData("citizen-2", 0.8, map[string]any{"national_id": "ABC123", "valid": true, "full_name": "Jane Doe"})
+
+		s.Empty(DiffEvidence(a, b))
+	})
+
+	s.Run("single-field difference reports both values", func() {
+		a := s.evidenceWithData("citizen-1", 0.9, base)
+		b := s.evidenceWithData("citizen-2", 0.8, map[string]any{"national_id": "ABC123", "valid": true, "full_name": "Jane Doe-Smith"})
+
+		s.Equal([]FieldDiff{{
+			Field:     "full_name",
+			ProviderA: "citizen-1",
+			ProviderB: "citizen-2",
+			ValueA:    "Jane Doe",
+			ValueB:    "Jane Doe-Smith",
+		}}, DiffEvidence(a, b))
+	})
+
+	s.Run("field present on one side only is a diff", func() {
+		a := s.evidenceWithData("citizen-1", 0.9, base)
+		b := s.evidenceWithData("citizen-2", 0.8, map[string]any{"national_id": "ABC123", "valid": true, "full_name": "Jane Doe", "address": "1 Main St"})
+
+		diffs := DiffEvidence(a, b)
+		s.Require().Len(diffs, 1)
+		s.Equal("address", diffs[0].Field)
+		s.Nil(diffs[0].ValueA)
+		s.Equal("1 Main St", diffs[0].ValueB)
+	})
+}
+
+func (s *OrchestratorSuite) TestLookupDiscrepancies() {
+	lookup := func(regulated bool) *LookupResult {
+		prov1 := newStubProvider("citizen-1", providers.ProviderTypeCitizen)
+		prov1.lookupFn = func(_ context.Context, _ map[string]string) (*providers.Evidence, error) {
+			return s.evidenceWithData("citizen-1", 0.9, map[string]any{"national_id": "ABC123", "valid": true, "full_name": "Jane Doe", "date_of_birth": "1990-01-01"}), nil
+		}
+		prov2 := newStubProvider("citizen-2", providers.ProviderTypeCitizen)
+		prov2.lookupFn = func(_ context.Context, _ map[string]string) (*providers.Evidence, error) {
+			return s.evidenceWithData("citizen-2", 0.8, map[string]any{"national_id": "ABC123", "valid": true, "full_name": "Jane Smith", "date_of_birth": "1990-01-02"}), nil
+		}
+
+		orch := s.newOrchestrator([]*stubProvider{prov1, prov2}, OrchestratorConfig{DefaultTimeout: 5 * time.Second})
+		req := s.citizenRequestWithStrategy(StrategyVoting)
+		req.Regulated = regulated
+		result, err := orch.Lookup(context.Background(), req)
+		s.Require().NoError(err)
+		return result
+	}
+
+	s.Run("surfaces field diffs with values", func() {
+		result := lookup(false)
+
+		s.Require().Len(result.Discrepancies, 2)
+		s.Equal("date_of_birth", result.Discrepancies[0].Field)
+		s.Equal("full_name", result.Discrepancies[1].Field)
+		s.Equal("Jane Doe", result.Discrepancies[1].ValueA)
+		s.Equal("Jane Smith", result.Discrepancies[1].ValueB)
+		s.False(result.Discrepancies[1].Redacted)
+	})
+
+	s.Run("regulated lookups report keys only", func() {
+		result := lookup(true)
+
+		s.Require().Len(result.Discrepancies, 2)
+		for _, d := range result.Discrepancies {
+			s.True(d.Redacted)
+			s.Nil(d.ValueA)
+			s.Nil(d.ValueB)
+			s.Equal("citizen-1", d.ProviderA)
+			s.Equal("citizen-2", d.ProviderB)
+		}
+		s.Equal("full_name", result.Discrepancies[1].Field)
+	})
+}
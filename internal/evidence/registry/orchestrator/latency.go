@@ -0,0 +1,102 @@
+package orchestrator
+
+import (
+	"cmp"
+	"slices"
+	"sync"
+	"time"
+
+	"credo/internal/evidence/registry/domain/shared"
+)
+
+// latencySmoothing is the EWMA weight of the newest sample; lower values
+// smooth out one-off spikes at the cost of reacting more slowly.
+const latencySmoothing = 0.2
+
+// latencyFailurePenalty is added to a failed call's response time, so a
+// provider that fails fast does not look like the fastest one.
+const latencyFailurePenalty = time.Second
+
+// latencyStaleAfter is how long an average stays valid without a new sample.
+// A provider not called for that long counts as unmeasured again, so it is
+// re-probed and can win back its place once it has recovered.
+const latencyStaleAfter = time.Minute
+
+type latencySample struct {
+	ewma    time.Duration
+	sampled time.Time
+}
+
+// latencyTracker keeps an exponentially weighted moving average of each
+// provider's response time. It is safe for concurrent use.
+type latencyTracker struct {
+	mu      sync.RWMutex
+	samples map[string]latencySample
+	now     func() time.Time
+}
+
+func newLatencyTracker() *latencyTracker {
+	return &latencyTracker{samples: make(map[string]latencySample), now: time.Now}
+}
+
+// observe folds one response time into the provider's average; a failed call
+// counts as its response time plus latencyFailurePenalty. A nil tracker
+// records nothing.
+func (t *latencyTracker) observe(providerID string, latency time.Duration, failed bool) {
+	if t == nil {
+		return
+	}
+	if failed {
+		latency += latencyFailurePenalty
+	}
+	key := shared.NormalizeProviderID(providerID)
+	now := t.now()
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	current, ok := t.samples[key]
+	if !ok || now.Sub(current.sampled) > latencyStaleAfter {
+		t.samples[key] = latencySample{ewma: latency, sampled: now}
+		return
+	}
+	t.samples[key] = latencySample{
+		ewma:    time.Duration(latencySmoothing*float64(latency) + (1-latencySmoothing)*float64(current.ewma)),
+		sampled: now,
+	}
+}
+
+// average returns the provider's smoothed response time, if it has a sample
+// newer than latencyStaleAfter.
+func (t *latencyTracker) average(providerID string) (time.Duration, bool) {
+	now := t.now()
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	sample, ok := t.samples[shared.NormalizeProviderID(providerID)]
+	if !ok || now.Sub(sample.sampled) > latencyStaleAfter {
+		return 0, false
+	}
+	return sample.ewma, true
+}
+
+// order returns the chain's providers fastest first. Providers without a
+// current sample sort ahead of measured ones so each is tried and measured;
+// ties keep chain order. A nil tracker returns the chain order unchanged.
+func (t *latencyTracker) order(chain ProviderChain) []string {
+	ids := append([]string{chain.Primary}, chain.Secondary...)
+	if t == nil {
+		return ids
+	}
+	slices.SortStableFunc(ids, func(a, b string) int {
+		latencyA, measuredA := t.average(a)
+		latencyB, measuredB := t.average(b)
+		switch {
+		case !measuredA && !measuredB:
+			return 0
+		case !measuredA:
+			return -1
+		case !measuredB:
+			return 1
+		}
+		return cmp.Compare(latencyA, latencyB)
+	})
+	return ids
+}
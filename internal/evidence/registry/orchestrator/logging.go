@@ -0,0 +1,50 @@
+package orchestrator
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+	"time"
+
+	"credo/internal/evidence/registry/providers"
+)
+
+// logLookup emits a debug record describing a provider lookup without PII.
+//
+// Only filter keys are logged, never their values (national IDs are low-entropy,
+// so even a hash would be reversible). Evidence data values and raw error messages
+// are omitted for the same reason; the result is summarized by confidence,
+// validity, and the normalized error category.
+func (o *Orchestrator) logLookup(ctx context.Context, providerID string, filters map[string]string, latency time.Duration, evidence *providers.Evidence, err error) {
+	if !o.logger.Enabled(ctx, slog.LevelDebug) {
+		return
+	}
+
+	keys := make([]string, 0, len(filters))
+	for k := range filters {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+
+	attrs := []slog.Attr{
+		slog.String("provider_id", providerID),
+		slog.Any("filter_keys", keys),
+		slog.Int64("latency_ms", latency.Milliseconds()),
+	}
+	if err != nil {
+		attrs = append(attrs,
+			slog.String("outcome", "error"),
+			slog.String("error_category", string(providers.GetCategory(err))),
+		)
+	} else if evidence != nil {
+		attrs = append(attrs,
+			slog.String("outcome", "success"),
+			slog.Float64("confidence", evidence.Confidence),
+		)
+		if valid, ok := evidence.Data["valid"].(bool); ok {
+			attrs = append(attrs, slog.Bool("valid", valid))
+		}
+	}
+
+	o.logger.LogAttrs(ctx, slog.LevelDebug, "registry provider lookup", attrs...)
+}
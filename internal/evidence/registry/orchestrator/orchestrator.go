@@ -2,11 +2,16 @@ package orchestrator
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"log/slog"
+	"slices"
 	"sync"
 	"sync/atomic"
 	"time"
 
+	"credo/internal/evidence/registry/domain/shared"
+	"credo/internal/evidence/registry/metrics"
 	"credo/internal/evidence/registry/providers"
 )
 
@@ -18,7 +23,7 @@ type retryBudget struct {
 
 // newRetryBudget creates a new retry budget with the given limit.
 func newRetryBudget(limit int) *retryBudget {
-	limit = max(limit, 1000)
+	limit = min(limit, 1000)
 	return &retryBudget{remaining: int32(limit)} //nolint:gosec
 }
 
@@ -50,12 +55,15 @@ const (
 	// StrategyParallel queries all providers in parallel and merges results
 	StrategyParallel LookupStrategy = "parallel"
 
-	// StrategyVoting queries multiple providers and uses majority vote
+	// StrategyVoting queries all providers and settles each type's decision by
+	// trust-weighted majority vote
 	StrategyVoting LookupStrategy = "voting"
 )
 
 // CorrelationRule defines how to reconcile and merge evidence from multiple sources.
-// Rules are applied after parallel lookups to resolve conflicts between providers.
+// Rules are applied after every lookup strategy, so evidence gathered for several
+// types by one primary or fallback chain each is cross-checked as well as
+// evidence gathered in parallel.
 type CorrelationRule interface {
 	// Merge combines evidence from multiple providers into a single authoritative record.
 	// Returns the merged evidence or an error if merging is not possible.
@@ -72,7 +80,11 @@ type CorrelationRule interface {
 type ProviderChain struct {
 	Primary   string   // Primary provider ID
 	Secondary []string // Fallback provider IDs, tried in order
-	Timeout   time.Duration
+
+	// Timeout bounds each provider in the chain, retries included, so a slow
+	// primary leaves the rest of the lookup deadline to its fallbacks. Zero
+	// leaves providers bounded by the lookup deadline only.
+	Timeout time.Duration
 }
 
 // BackoffConfig configures retry backoff for retryable errors
@@ -82,6 +94,12 @@ type BackoffConfig struct {
 	MaxRetries        int           // Maximum number of retries per provider (default: 3)
 	Multiplier        float64       // Multiplier for exponential backoff (default: 2.0)
 	GlobalRetryBudget int           // Maximum total retries across all providers (default: 10)
+
+	// RetryRate and RetryBurst bound retries across all lookups as a token bucket:
+	// up to RetryBurst retries at once, refilled at RetryRate per second. When
+	// the bucket is empty, retryable failures are returned without retrying.
+	RetryRate  float64 // Sustained retries per second (default: 10; negative disables)
+	RetryBurst int     // Bucket capacity (default: 20)
 }
 
 // OrchestratorConfig configures the evidence orchestrator
@@ -98,6 +116,37 @@ type OrchestratorConfig struct {
 
 	// Backoff configures retry behavior for retryable errors
 	Backoff BackoffConfig
+
+	// ProviderWeights sets how much each provider's vote counts in voting
+	// lookups, keyed by provider ID. Providers without a weight count as 1; a
+	// weight of 0 lets a provider report evidence without voting.
+	ProviderWeights map[string]float64
+
+	// MinConfidence sets a per-type confidence floor. Evidence below the floor is
+	// discarded as a low-confidence provider error, so fallback continues to the
+	// next provider in the chain. Types without a floor accept any confidence.
+	MinConfidence map[providers.ProviderType]float64
+
+	// Logger receives debug-level provider lookup records when LogLookups is set.
+	Logger *slog.Logger
+
+	// LogLookups enables redacted per-provider lookup logging (off by default).
+	// Records carry provider ID, filter keys, latency, and result summary only.
+	LogLookups bool
+
+	// Metrics records per-provider lookup latency and outcomes (optional).
+	Metrics *metrics.Metrics
+
+	// Breaker configures the per-provider circuit breakers that skip providers
+	// failing repeatedly; on by default.
+	Breaker BreakerConfig
+
+	// LatencyAware makes primary and fallback lookups try a chain's providers
+	// fastest first, by a moving average of their recent response times,
+	// instead of always starting with Primary. Failed calls count as slow, and
+	// a provider not called for a while is re-probed. Providers with an open
+	// circuit are still skipped.
+	LatencyAware bool
 }
 
 // Orchestrator coordinates multi-source evidence gathering from registry providers.
@@ -112,6 +161,15 @@ type Orchestrator struct {
 	strategy LookupStrategy
 	timeout  time.Duration
 	backoff  BackoffConfig
+	floors   map[providers.ProviderType]float64
+	weights  map[string]float64
+	retries  *retryLimiter
+	breakers *breakerSet
+	latency  *latencyTracker // nil unless LatencyAware
+
+	logger     *slog.Logger
+	logLookups bool
+	metrics    *metrics.Metrics
 }
 
 // New creates a new evidence orchestrator
@@ -139,6 +197,29 @@ func New(cfg OrchestratorConfig) *Orchestrator {
 	if cfg.Backoff.GlobalRetryBudget == 0 {
 		cfg.Backoff.GlobalRetryBudget = 10
 	}
+	if cfg.Backoff.RetryRate == 0 {
+		cfg.Backoff.RetryRate = 10
+	}
+	if cfg.Backoff.RetryBurst == 0 {
+		cfg.Backoff.RetryBurst = 20
+	}
+
+	weights := make(map[string]float64, len(cfg.ProviderWeights))
+	for providerID, weight := range cfg.ProviderWeights {
+		weights[shared.NormalizeProviderID(providerID)] = weight
+	}
+
+	breakers := newBreakerSet(cfg.Breaker)
+	if breakers != nil && cfg.Registry != nil {
+		for _, p := range cfg.Registry.All() {
+			breakers.get(p.ID())
+		}
+	}
+
+	var latency *latencyTracker
+	if cfg.LatencyAware {
+		latency = newLatencyTracker()
+	}
 
 	return &Orchestrator{
 		registry: cfg.Registry,
@@ -147,22 +228,38 @@ func New(cfg OrchestratorConfig) *Orchestrator {
 		strategy: cfg.DefaultStrategy,
 		timeout:  cfg.DefaultTimeout,
 		backoff:  cfg.Backoff,
+		floors:   cfg.MinConfidence,
+		weights:  weights,
+		retries:  newRetryLimiter(cfg.Backoff.RetryRate, cfg.Backoff.RetryBurst),
+		breakers: breakers,
+		latency:  latency,
+
+		logger:     cfg.Logger,
+		logLookups: cfg.LogLookups && cfg.Logger != nil,
+		metrics:    cfg.Metrics,
 	}
 }
 
 // LookupRequest describes what evidence to gather
 // and how to perform the lookup.
 type LookupRequest struct {
-	Types    []providers.ProviderType // What types of evidence to gather
-	Filters  map[string]string        // Input filters (national_id, etc.)
-	Strategy LookupStrategy           // Override default strategy
-	Timeout  time.Duration            // Override default timeout
+	Types     []providers.ProviderType // What types of evidence to gather
+	Filters   map[string]string        // Input filters (national_id, etc.)
+	Strategy  LookupStrategy           // Override default strategy
+	Timeout   time.Duration            // Override default timeout
+	Regulated bool                     // Redact values from Discrepancies
+
+	// MinEvidence lets parallel and voting lookups return once every requested
+	// type has this many evidence records, cancelling providers still running.
+	// Zero waits for every provider or the deadline.
+	MinEvidence int
 }
 
 // LookupResult contains all gathered evidence
 type LookupResult struct {
-	Evidence []*providers.Evidence
-	Errors   map[string]error // Provider ID -> error
+	Evidence      []*providers.Evidence
+	Errors        map[string]error // Normalized provider ID -> error
+	Discrepancies []FieldDiff      // Multi-source field disagreements found before correlation
 }
 
 // Lookup gathers evidence according to the request using the specified or default strategy.
@@ -202,7 +299,8 @@ func (o *Orchestrator) Lookup(ctx context.Context, req LookupRequest) (*LookupRe
 	}
 }
 
-// lookupPrimary uses only the primary provider for each type
+// lookupPrimary uses only one provider for each type: the chain's primary, or
+// its fastest provider when the orchestrator is latency-aware.
 func (o *Orchestrator) lookupPrimary(ctx context.Context, req LookupRequest) (*LookupResult, error) {
 	result := &LookupResult{
 		Evidence: make([]*providers.Evidence, 0, len(req.Types)),
@@ -216,21 +314,35 @@ func (o *Orchestrator) lookupPrimary(ctx context.Context, req LookupRequest) (*L
 			continue
 		}
 
-		provider, ok := o.registry.Get(chain.Primary)
+		providerID := o.latency.order(chain)[0]
+		provider, ok := o.registry.Get(providerID)
 		if !ok {
-			result.Errors[chain.Primary] = providers.ErrProviderNotFound
+			result.Errors[shared.NormalizeProviderID(providerID)] = providers.ErrProviderNotFound
+			continue
+		}
+		if !o.breakers.allow(provider.ID()) {
+			result.Errors[shared.NormalizeProviderID(provider.ID())] = circuitOpenError(provider.ID())
 			continue
 		}
 
-		evidence, err := provider.Lookup(ctx, req.Filters)
+		providerCtx, cancel := chain.providerContext(ctx)
+		evidence, err := o.queryProvider(providerCtx, provider, req.Filters)
+		err = chainTimeoutError(ctx, providerCtx, provider.ID(), err)
+		cancel()
+		// A lookup the caller abandoned says nothing about the provider's health.
+		if ctx.Err() == nil {
+			o.breakers.record(provider.ID(), err)
+		}
 		if err != nil {
-			result.Errors[provider.ID()] = err
+			result.Errors[shared.NormalizeProviderID(provider.ID())] = err
 			continue
 		}
 
 		result.Evidence = append(result.Evidence, evidence)
 	}
 
+	o.applyCorrelationRules(result)
+
 	if len(result.Evidence) == 0 && len(result.Errors) > 0 {
 		return result, providers.ErrAllProvidersFailed
 	}
@@ -261,6 +373,8 @@ func (o *Orchestrator) lookupFallback(ctx context.Context, req LookupRequest) (*
 		}
 	}
 
+	o.applyCorrelationRules(result)
+
 	if len(result.Evidence) == 0 && len(result.Errors) > 0 {
 		return result, providers.ErrAllProvidersFailed
 	}
@@ -269,7 +383,8 @@ func (o *Orchestrator) lookupFallback(ctx context.Context, req LookupRequest) (*
 }
 
 // getChainForType returns the provider chain for a given type.
-// If no chain is configured, it creates one from the first available provider.
+// If no chain is configured, it builds one from every registered provider of
+// the type, ordered by ID so the primary is stable across lookups.
 func (o *Orchestrator) getChainForType(typ providers.ProviderType) (ProviderChain, error) {
 	if chain, ok := o.chains[typ]; ok {
 		return chain, nil
@@ -280,70 +395,179 @@ func (o *Orchestrator) getChainForType(typ providers.ProviderType) (ProviderChai
 		return ProviderChain{}, providers.ErrNoProvidersAvailable
 	}
 
-	return ProviderChain{Primary: provs[0].ID()}, nil
+	ids := make([]string, len(provs))
+	for i, p := range provs {
+		ids[i] = p.ID()
+	}
+	slices.Sort(ids)
+	return ProviderChain{Primary: ids[0], Secondary: ids[1:]}, nil
 }
 
 // tryChainWithFallback attempts the primary provider, then falls back to secondaries.
 // Records errors in the provided map and returns evidence if any provider succeeds.
 // The budget parameter limits total retries across all providers in this lookup.
-func (o *Orchestrator) tryChainWithFallback(ctx context.Context, chain ProviderChain, filters map[string]string, errors map[string]error, budget *retryBudget) *providers.Evidence {
-	// Try primary first with backoff for retryable errors
-	evidence, err := o.tryProviderWithBackoff(ctx, chain.Primary, filters, budget)
-	if err == nil {
-		return evidence
-	}
-	errors[chain.Primary] = err
-
-	// Try fallbacks if primary failed
-	for _, secondaryID := range chain.Secondary {
-		evidence, err := o.tryProviderWithBackoff(ctx, secondaryID, filters, budget)
+// Providers whose circuit is open are skipped without being called. When the
+// orchestrator is latency-aware, the chain is tried fastest first instead.
+func (o *Orchestrator) tryChainWithFallback(ctx context.Context, chain ProviderChain, filters map[string]string, errs map[string]error, budget *retryBudget) *providers.Evidence {
+	// Primary first, then each fallback in order
+	for _, providerID := range o.latency.order(chain) {
+		if !o.breakers.allow(providerID) {
+			errs[shared.NormalizeProviderID(providerID)] = circuitOpenError(providerID)
+			continue
+		}
+		providerCtx, cancel := chain.providerContext(ctx)
+		evidence, err := o.tryProviderWithBackoff(providerCtx, providerID, filters, budget)
+		err = chainTimeoutError(ctx, providerCtx, providerID, err)
+		cancel()
+		// A lookup the caller abandoned says nothing about the provider's health.
+		if ctx.Err() == nil && !errors.Is(err, providers.ErrProviderNotFound) {
+			o.breakers.record(providerID, err)
+		}
 		if err == nil {
 			return evidence
 		}
-		errors[secondaryID] = err
+		errs[shared.NormalizeProviderID(providerID)] = err
 	}
 
 	return nil
 }
 
+// providerContext derives the context one provider in the chain runs under.
+// The returned cancel must be called once the provider is done.
+func (c ProviderChain) providerContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	if c.Timeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, c.Timeout)
+}
+
+// chainTimeoutError reports a provider that ran out its chain timeout as a
+// provider timeout. Errors from the lookup context itself pass through.
+func chainTimeoutError(lookupCtx, providerCtx context.Context, providerID string, err error) error {
+	if err == nil || lookupCtx.Err() != nil || providerCtx.Err() == nil {
+		return err
+	}
+	return providers.NewProviderError(providers.ErrorTimeout, providerID, "provider did not respond within the chain timeout", err)
+}
+
+// queryProvider performs a single provider lookup.
+// Every strategy routes provider calls through here so cross-cutting concerns
+// (response validation, the confidence floor, latency tracking, metrics and debug logging) apply
+// uniformly regardless of strategy. Evidence failing validation or falling below
+// the floor is discarded and reported as a provider error, so it is never cached
+// or used in a decision.
+func (o *Orchestrator) queryProvider(ctx context.Context, p providers.Provider, filters map[string]string) (*providers.Evidence, error) {
+	start := time.Now()
+	evidence, err := p.Lookup(ctx, filters)
+	latency := time.Since(start)
+	if err == nil {
+		err = validateEvidence(p, filters, evidence)
+		if err == nil {
+			err = o.checkConfidenceFloor(p, evidence)
+		}
+		if err != nil {
+			evidence = nil
+		}
+	}
+	// A call the caller cancelled ends early whatever the provider's speed, so it is not sampled.
+	if !errors.Is(ctx.Err(), context.Canceled) {
+		o.latency.observe(p.ID(), latency, err != nil)
+	}
+	if o.metrics != nil {
+		var errorClass string
+		if err != nil {
+			errorClass = string(providers.GetCategory(err))
+		}
+		o.metrics.ObserveProviderLookup(p.ID(), latency.Seconds(), errorClass)
+	}
+	if o.logLookups {
+		o.logLookup(ctx, p.ID(), filters, latency, evidence, err)
+	}
+	return evidence, err
+}
+
 // lookupParallel queries all providers of each requested type concurrently.
 //
-// For each provider type, it spawns goroutines to query all registered providers simultaneously.
-// Results are collected with mutex protection. After all goroutines complete, correlation rules
-// are applied to merge multiple evidence records if applicable. This strategy prioritizes
-// completeness over latency by waiting for all providers to respond (or timeout).
+// Each provider runs in its own goroutine under a context derived from ctx, and a
+// single collector records results as they arrive. Collection stops when every
+// provider has answered, when the deadline passes, or (with req.MinEvidence set)
+// once every requested type has enough evidence. Remaining provider contexts are
+// then cancelled; providers still running past the deadline are reported as
+// timeouts, while those cancelled because evidence sufficed or because the
+// caller cancelled are left out of Errors and of their circuit breakers, as
+// neither says anything about the provider's health. Result channels are buffered, so late providers never block on send.
+// Providers whose circuit is open are not queried and are reported in Errors.
+// Correlation rules are applied to whatever evidence was collected.
 func (o *Orchestrator) lookupParallel(ctx context.Context, req LookupRequest) (*LookupResult, error) {
 	result := &LookupResult{
 		Evidence: make([]*providers.Evidence, 0),
 		Errors:   make(map[string]error),
 	}
 
-	var wg sync.WaitGroup
-	var mu sync.Mutex
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
 
+	type outcome struct {
+		provider providers.Provider
+		evidence *providers.Evidence
+		err      error
+	}
+	var provs []providers.Provider
 	for _, typ := range req.Types {
-		provs := o.registry.ListByType(typ)
-
-		for _, prov := range provs {
-			wg.Add(1)
-			go func(p providers.Provider) {
-				defer wg.Done()
-
-				evidence, err := p.Lookup(ctx, req.Filters)
+		provs = append(provs, o.registry.ListByType(typ)...)
+	}
+	outcomes := make(chan outcome, len(provs))
+	pending := make(map[string]providers.Provider, len(provs))
+	for _, prov := range provs {
+		if !o.breakers.allow(prov.ID()) {
+			result.Errors[shared.NormalizeProviderID(prov.ID())] = circuitOpenError(prov.ID())
+			continue
+		}
+		pending[prov.ID()] = prov
+		go func(p providers.Provider) {
+			evidence, err := o.queryProvider(ctx, p, req.Filters)
+			if ctx.Err() == nil {
+				o.breakers.record(p.ID(), err)
+			}
+			outcomes <- outcome{provider: p, evidence: evidence, err: err}
+		}(prov)
+	}
 
-				mu.Lock()
-				defer mu.Unlock()
+	perType := make(map[providers.ProviderType]int, len(req.Types))
+	deadlinePassed := false
+collect:
+	for len(pending) > 0 {
+		select {
+		case out := <-outcomes:
+			delete(pending, out.provider.ID())
+			if out.err != nil {
+				result.Errors[shared.NormalizeProviderID(out.provider.ID())] = out.err
+				continue
+			}
+			result.Evidence = append(result.Evidence, out.evidence)
+			perType[out.evidence.ProviderType]++
+			if enoughEvidence(req, perType) {
+				break collect
+			}
+		case <-ctx.Done():
+			deadlinePassed = errors.Is(ctx.Err(), context.DeadlineExceeded)
+			break collect
+		}
+	}
+	cancel()
 
-				if err != nil {
-					result.Errors[p.ID()] = err
-				} else {
-					result.Evidence = append(result.Evidence, evidence)
-				}
-			}(prov)
+	if deadlinePassed {
+		for id, p := range pending {
+			err := providers.NewProviderError(
+				providers.ErrorTimeout, p.ID(), "provider did not respond before the lookup deadline", ctx.Err())
+			result.Errors[shared.NormalizeProviderID(id)] = err
+			o.breakers.record(id, err)
 		}
 	}
 
-	wg.Wait()
+	// Record disagreements before correlation merges them into one record
+	result.Discrepancies = findDiscrepancies(result.Evidence, req.Regulated)
+	o.logDiscrepancies(ctx, result.Discrepancies)
 
 	// Apply correlation rules to merge evidence from multiple sources
 	o.applyCorrelationRules(result)
@@ -355,9 +579,30 @@ func (o *Orchestrator) lookupParallel(ctx context.Context, req LookupRequest) (*
 	return result, nil
 }
 
+// enoughEvidence reports whether every requested type has req.MinEvidence
+// records. A zero MinEvidence never suffices early, so all providers are awaited.
+func enoughEvidence(req LookupRequest, perType map[providers.ProviderType]int) bool {
+	if req.MinEvidence <= 0 {
+		return false
+	}
+	for _, typ := range req.Types {
+		if perType[typ] < req.MinEvidence {
+			return false
+		}
+	}
+	return true
+}
+
+// correlationErrorKey files correlation rule failures in LookupResult.Errors,
+// alongside provider errors keyed by provider ID.
+const correlationErrorKey = "correlation"
+
 // applyCorrelationRules merges evidence from multiple providers using configured rules.
-// If multiple evidence records exist and an applicable rule succeeds, the evidence is
-// replaced with the merged result. This separates correlation logic from concurrency concerns.
+// If multiple evidence records exist and an applicable rule succeeds, the evidence of
+// the merged record's type is replaced with the merged result; evidence of other types
+// is kept, so a citizen merge never drops sanctions evidence. Failed merges are joined
+// into Errors under correlationErrorKey and the next applicable rule is tried. This
+// separates correlation logic from concurrency concerns.
 func (o *Orchestrator) applyCorrelationRules(result *LookupResult) {
 	if len(o.rules) == 0 || len(result.Evidence) < 2 {
 		return
@@ -368,22 +613,39 @@ func (o *Orchestrator) applyCorrelationRules(result *LookupResult) {
 		types = append(types, e.ProviderType)
 	}
 
+	var mergeErrs []error
 	for _, rule := range o.rules {
-		if rule.Applicable(types) {
-			if merged, err := rule.Merge(result.Evidence); err == nil {
-				result.Evidence = []*providers.Evidence{merged}
-				return
+		if !rule.Applicable(types) {
+			continue
+		}
+		merged, err := rule.Merge(result.Evidence)
+		if err != nil {
+			mergeErrs = append(mergeErrs, err)
+			continue
+		}
+		kept := []*providers.Evidence{merged}
+		for _, e := range result.Evidence {
+			if e.ProviderType != merged.ProviderType {
+				kept = append(kept, e)
 			}
 		}
+		result.Evidence = kept
+		break
+	}
+	if len(mergeErrs) > 0 {
+		result.Errors[correlationErrorKey] = errors.Join(mergeErrs...)
 	}
 }
 
-// lookupVoting queries multiple providers and selects evidence by highest confidence per type.
+// lookupVoting queries all providers and settles each type by weighted majority.
 //
-// First performs a parallel lookup, then for each provider type, keeps only the evidence
-// with the highest confidence score. This is a simplified voting strategy that currently
-// does not implement true majority voting - it assumes higher confidence indicates more
-// authoritative data.
+// After a parallel lookup, each provider's evidence votes for its decision value
+// (valid for citizen evidence, listed for sanctions) with its trust weight times
+// its confidence. The value with the greatest summed vote wins, so several
+// agreeing low-confidence sources can outvote one confident outlier. The type's
+// result is the winning side's most confident record, with Confidence replaced
+// by the winning share of the total vote. Types without a decision field keep
+// the single most confident record.
 func (o *Orchestrator) lookupVoting(ctx context.Context, req LookupRequest) (*LookupResult, error) {
 	// First do parallel lookup
 	result, err := o.lookupParallel(ctx, req)
@@ -391,26 +653,84 @@ func (o *Orchestrator) lookupVoting(ctx context.Context, req LookupRequest) (*Lo
 		return result, err
 	}
 
-	// Select highest confidence evidence per type.
-	// Design choice: confidence-based selection is simpler and sufficient for current
-	// use cases. True majority voting (quorum-based) can be added if multiple
-	// providers return conflicting data with similar confidence scores.
-	typeMap := make(map[providers.ProviderType]*providers.Evidence)
+	byType := make(map[providers.ProviderType][]*providers.Evidence)
+	var order []providers.ProviderType
 	for _, e := range result.Evidence {
-		existing, ok := typeMap[e.ProviderType]
-		if !ok || e.Confidence > existing.Confidence {
-			typeMap[e.ProviderType] = e
+		if _, seen := byType[e.ProviderType]; !seen {
+			order = append(order, e.ProviderType)
 		}
+		byType[e.ProviderType] = append(byType[e.ProviderType], e)
 	}
 
-	result.Evidence = make([]*providers.Evidence, 0, len(typeMap))
-	for _, e := range typeMap {
-		result.Evidence = append(result.Evidence, e)
+	result.Evidence = make([]*providers.Evidence, 0, len(order))
+	for _, typ := range order {
+		result.Evidence = append(result.Evidence, o.vote(byType[typ]))
 	}
 
 	return result, nil
 }
 
+// decisionFields names the boolean each evidence type votes on.
+var decisionFields = map[providers.ProviderType]string{
+	providers.ProviderTypeCitizen:   "valid",
+	providers.ProviderTypeSanctions: "listed",
+}
+
+// vote settles one type's evidence by weighted majority. Ties go to the side
+// holding the most confident record.
+func (o *Orchestrator) vote(evidence []*providers.Evidence) *providers.Evidence {
+	best := mostConfident(evidence)
+	field, ok := decisionFields[best.ProviderType]
+	if !ok {
+		return best
+	}
+
+	tally := make(map[bool]float64, 2)
+	var total float64
+	for _, e := range evidence {
+		decision, _ := e.Data[field].(bool)
+		weighted := o.providerWeight(e.ProviderID) * e.Confidence
+		tally[decision] += weighted
+		total += weighted
+	}
+
+	winner, _ := best.Data[field].(bool)
+	if tally[!winner] > tally[winner] {
+		winner = !winner
+	}
+
+	var side []*providers.Evidence
+	for _, e := range evidence {
+		if decision, _ := e.Data[field].(bool); decision == winner {
+			side = append(side, e)
+		}
+	}
+	merged := *mostConfident(side)
+	merged.Confidence = 0
+	if total > 0 {
+		merged.Confidence = tally[winner] / total
+	}
+	return &merged
+}
+
+// providerWeight returns the configured vote weight for a provider, default 1.
+func (o *Orchestrator) providerWeight(providerID string) float64 {
+	if weight, ok := o.weights[shared.NormalizeProviderID(providerID)]; ok {
+		return weight
+	}
+	return 1
+}
+
+func mostConfident(evidence []*providers.Evidence) *providers.Evidence {
+	best := evidence[0]
+	for _, e := range evidence[1:] {
+		if e.Confidence > best.Confidence {
+			best = e
+		}
+	}
+	return best
+}
+
 // tryProviderWithBackoff attempts to get evidence with exponential backoff for retryable errors.
 //
 // The method retries up to MaxRetries times for errors marked as retryable (timeouts, rate limits,
@@ -419,7 +739,8 @@ func (o *Orchestrator) lookupVoting(ctx context.Context, req LookupRequest) (*Lo
 // Respects context cancellation between retry attempts.
 //
 // The budget parameter enforces a global retry limit across all providers. If the budget is
-// exhausted, retries stop even if per-provider MaxRetries hasn't been reached.
+// exhausted, retries stop even if per-provider MaxRetries hasn't been reached. The
+// orchestrator-wide retry limiter applies the same way across concurrent lookups.
 func (o *Orchestrator) tryProviderWithBackoff(ctx context.Context, providerID string, filters map[string]string, budget *retryBudget) (*providers.Evidence, error) {
 	provider, ok := o.registry.Get(providerID)
 	if !ok {
@@ -436,6 +757,12 @@ func (o *Orchestrator) tryProviderWithBackoff(ctx context.Context, providerID st
 			if budget != nil && !budget.tryConsume() {
 				return nil, lastErr
 			}
+			if !o.retries.allow() {
+				if o.metrics != nil {
+					o.metrics.IncrementRetriesShed(providerID)
+				}
+				return nil, lastErr
+			}
 
 			select {
 			case <-ctx.Done():
@@ -450,7 +777,7 @@ func (o *Orchestrator) tryProviderWithBackoff(ctx context.Context, providerID st
 			}
 		}
 
-		evidence, err := provider.Lookup(ctx, filters)
+		evidence, err := o.queryProvider(ctx, provider, filters)
 		if err == nil {
 			return evidence, nil
 		}
@@ -468,9 +795,10 @@ func (o *Orchestrator) tryProviderWithBackoff(ctx context.Context, providerID st
 
 // HealthCheck checks the health of all registered providers concurrently.
 //
-// Each provider's Health method is called in parallel. The returned map contains provider IDs
+// Each provider's Health method is called in parallel. The returned map contains normalized provider IDs
 // as keys; nil values indicate healthy providers, non-nil values contain the health check error.
-// This is useful for monitoring dashboards and readiness probes.
+// A provider whose circuit is open is reported unhealthy with ErrCircuitOpen even when its Health
+// passes, since lookups are skipping it. This is useful for monitoring dashboards and readiness probes.
 func (o *Orchestrator) HealthCheck(ctx context.Context) map[string]error {
 	provs := o.registry.All()
 	results := make(map[string]error, len(provs))
@@ -483,9 +811,12 @@ func (o *Orchestrator) HealthCheck(ctx context.Context) map[string]error {
 		go func(p providers.Provider) {
 			defer wg.Done()
 			err := p.Health(ctx)
+			if o.breakers.isOpen(p.ID()) {
+				err = errors.Join(err, circuitOpenError(p.ID()))
+			}
 
 			mu.Lock()
-			results[p.ID()] = err
+			results[shared.NormalizeProviderID(p.ID())] = err
 			mu.Unlock()
 		}(prov)
 	}
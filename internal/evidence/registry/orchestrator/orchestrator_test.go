@@ -1,14 +1,22 @@
 package orchestrator
 
 import (
+	"bytes"
 	"context"
+	"log/slog"
 	"sync/atomic"
 	"testing"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	dto "github.com/prometheus/client_model/go"
 	"github.com/stretchr/testify/suite"
 
+	"credo/internal/evidence/registry/metrics"
+	"credo/internal/evidence/registry/orchestrator/correlation"
 	"credo/internal/evidence/registry/providers"
+	"credo/pkg/platform/circuit"
 )
 
 // stubProvider is a test double for providers.Provider
@@ -22,7 +30,7 @@ type stubProvider struct {
 	callTimesMu chan struct{} // mutex for callTimes
 }
 
-func newStubProvider(id string, provType providers.ProviderType) *stubProvider { //nolint:unparam // provType allows future test scenarios with heterogeneous providers
+func newStubProvider(id string, provType providers.ProviderType) *stubProvider {
 	return &stubProvider{
 		id:          id,
 		provType:    provType,
@@ -59,7 +67,7 @@ func (p *stubProvider) Lookup(ctx context.Context, filters map[string]string) (*
 		ProviderID:   p.id,
 		ProviderType: p.provType,
 		Confidence:   1.0,
-		Data:         map[string]any{"national_id": filters["national_id"]},
+		Data:         map[string]any{"national_id": filters["national_id"], "valid": true},
 		CheckedAt:    time.Now(),
 	}, nil
 }
@@ -86,7 +94,7 @@ func (s *OrchestratorSuite) evidence(providerID string, confidence float64) *pro
 		ProviderID:   providerID,
 		ProviderType: providers.ProviderTypeCitizen,
 		Confidence:   confidence,
-		Data:         map[string]any{"valid": true},
+		Data:         map[string]any{"national_id": "ABC123", "valid": true},
 		CheckedAt:    time.Now(),
 	}
 }
@@ -233,6 +241,110 @@ func (s *OrchestratorSuite) TestBackoffBehavior() {
 	})
 }
 
+func (s *OrchestratorSuite) TestRetryBudget() {
+	failing := func(attempts *atomic.Int32) *stubProvider {
+		prov := newStubProvider("test-citizen", providers.ProviderTypeCitizen)
+		prov.lookupFn = func(_ context.Context, _ map[string]string) (*providers.Evidence, error) {
+			attempts.Add(1)
+			return nil, providerError(providers.ErrorProviderOutage, "test-citizen")
+		}
+		return prov
+	}
+	// frozenClock pins the retry limiter so refills happen only when a test advances it
+	frozenClock := func(orch *Orchestrator) *time.Time {
+		now := time.Now()
+		orch.retries.now = func() time.Time { return now }
+		orch.retries.last = now
+		return &now
+	}
+
+	s.Run("per-lookup budget caps retries below max retries", func() {
+		attempts := atomic.Int32{}
+		orch := s.newOrchestrator([]*stubProvider{failing(&attempts)}, OrchestratorConfig{
+			DefaultStrategy: StrategyFallback,
+			Backoff: BackoffConfig{
+				InitialDelay:      time.Millisecond,
+				MaxRetries:        5,
+				GlobalRetryBudget: 2,
+			},
+		})
+
+		_, err := orch.Lookup(context.Background(), s.citizenRequest())
+		s.Require().ErrorIs(err, providers.ErrAllProvidersFailed)
+		s.Equal(int32(3), attempts.Load(), "initial attempt plus 2 budgeted retries")
+	})
+
+	s.Run("high failure rate exhausts the budget and later lookups fail fast", func() {
+		attempts := atomic.Int32{}
+		orch := s.newOrchestrator([]*stubProvider{failing(&attempts)}, OrchestratorConfig{
+			DefaultStrategy: StrategyFallback,
+			Backoff: BackoffConfig{
+				InitialDelay: 50 * time.Millisecond,
+				MaxDelay:     50 * time.Millisecond,
+				MaxRetries:   2,
+				RetryRate:    1,
+				RetryBurst:   3,
+			},
+		})
+		frozenClock(orch)
+
+		_, err := orch.Lookup(context.Background(), s.citizenRequest())
+		s.Require().ErrorIs(err, providers.ErrAllProvidersFailed)
+		_, err = orch.Lookup(context.Background(), s.citizenRequest())
+		s.Require().ErrorIs(err, providers.ErrAllProvidersFailed)
+		s.Equal(int32(5), attempts.Load(), "two retries, then the last token, then the budget is spent")
+
+		for range 3 {
+			attempts.Store(0)
+			start := time.Now()
+			_, err = orch.Lookup(context.Background(), s.citizenRequest())
+			s.Require().ErrorIs(err, providers.ErrAllProvidersFailed)
+			s.Equal(int32(1), attempts.Load(), "exhausted budget allows no retries")
+			s.Less(time.Since(start), 50*time.Millisecond, "no backoff wait once the budget is spent")
+		}
+	})
+
+	s.Run("budget refills at the retry rate", func() {
+		attempts := atomic.Int32{}
+		orch := s.newOrchestrator([]*stubProvider{failing(&attempts)}, OrchestratorConfig{
+			DefaultStrategy: StrategyFallback,
+			Backoff: BackoffConfig{
+				InitialDelay: time.Millisecond,
+				MaxRetries:   3,
+				RetryRate:    2,
+				RetryBurst:   3,
+			},
+		})
+		now := frozenClock(orch)
+
+		_, _ = orch.Lookup(context.Background(), s.citizenRequest()) //nolint:errcheck // drains the bucket
+		*now = now.Add(time.Second)
+		attempts.Store(0)
+
+		_, err := orch.Lookup(context.Background(), s.citizenRequest())
+		s.Require().ErrorIs(err, providers.ErrAllProvidersFailed)
+		s.Equal(int32(3), attempts.Load(), "one second at 2/s refills two retries")
+	})
+
+	s.Run("negative retry rate disables the budget", func() {
+		attempts := atomic.Int32{}
+		orch := s.newOrchestrator([]*stubProvider{failing(&attempts)}, OrchestratorConfig{
+			DefaultStrategy: StrategyFallback,
+			Backoff: BackoffConfig{
+				InitialDelay: time.Millisecond,
+				MaxRetries:   2,
+				RetryRate:    -1,
+				RetryBurst:   1,
+			},
+		})
+
+		for range 3 {
+			_, _ = orch.Lookup(context.Background(), s.citizenRequest()) //nolint:errcheck // only attempts are under test
+		}
+		s.Equal(int32(9), attempts.Load())
+	})
+}
+
 func (s *OrchestratorSuite) TestContextCancellation() {
 	s.Run("cancels lookup when context is cancelled", func() {
 		prov := newStubProvider("test-citizen", providers.ProviderTypeCitizen)
@@ -391,21 +503,122 @@ func (s *OrchestratorSuite) TestParallelStrategy() {
 	}
 }
 
+func (s *OrchestratorSuite) TestParallelCancellation() {
+	// slowProviders block until their context ends and report the context error
+	// on exited, so tests can assert each goroutine was cancelled and returned.
+	slowProviders := func(n int) ([]*stubProvider, chan error) {
+		exited := make(chan error, n)
+		provs := make([]*stubProvider, 0, n)
+		for i := range n {
+			id := "citizen-slow-" + string(rune('a'+i))
+			prov := newStubProvider(id, providers.ProviderTypeCitizen)
+			prov.lookupFn = func(ctx context.Context, _ map[string]string) (*providers.Evidence, error) {
+				<-ctx.Done()
+				exited <- ctx.Err()
+				return nil, providers.NewProviderError(providers.ErrorTimeout, id, "cancelled", ctx.Err())
+			}
+			provs = append(provs, prov)
+		}
+		return provs, exited
+	}
+	assertAllExited := func(exited chan error, n int, want error) {
+		for range n {
+			select {
+			case err := <-exited:
+				s.ErrorIs(err, want)
+			case <-time.After(time.Second):
+				s.Fail("slow provider was not cancelled")
+				return
+			}
+		}
+	}
+	fast := newStubProvider("citizen-fast", providers.ProviderTypeCitizen)
+
+	s.Run("sufficient evidence cancels slow providers and returns promptly", func() {
+		slow, exited := slowProviders(3)
+		orch := s.newOrchestrator(append([]*stubProvider{fast}, slow...), OrchestratorConfig{
+			DefaultStrategy: StrategyParallel,
+			DefaultTimeout:  5 * time.Second,
+		})
+		req := s.citizenRequestWithStrategy(StrategyParallel)
+		req.MinEvidence = 1
+
+		start := time.Now()
+		result, err := orch.Lookup(context.Background(), req)
+		s.Require().NoError(err)
+		s.Less(time.Since(start), time.Second)
+		s.Len(result.Evidence, 1)
+		s.Empty(result.Errors, "providers cancelled because evidence sufficed are not failures")
+		assertAllExited(exited, 3, context.Canceled)
+	})
+
+	s.Run("deadline cancels slow providers and reports them as timeouts", func() {
+		slow, exited := slowProviders(3)
+		orch := s.newOrchestrator(append([]*stubProvider{fast}, slow...), OrchestratorConfig{
+			DefaultStrategy: StrategyParallel,
+		})
+		req := s.citizenRequestWithStrategy(StrategyParallel)
+		req.Timeout = 50 * time.Millisecond
+
+		start := time.Now()
+		result, err := orch.Lookup(context.Background(), req)
+		s.Require().NoError(err)
+		s.Less(time.Since(start), time.Second)
+		s.Len(result.Evidence, 1)
+		s.Len(result.Errors, 3)
+		for _, perr := range result.Errors {
+			s.Equal(providers.ErrorTimeout, providers.GetCategory(perr))
+		}
+		assertAllExited(exited, 3, context.DeadlineExceeded)
+	})
+
+	s.Run("provider ignoring cancellation does not hold the lookup past its deadline", func() {
+		release := make(chan struct{})
+		exited := make(chan struct{})
+		stuck := newStubProvider("citizen-stuck", providers.ProviderTypeCitizen)
+		stuck.lookupFn = func(_ context.Context, _ map[string]string) (*providers.Evidence, error) {
+			defer close(exited)
+			<-release
+			return s.evidence("citizen-stuck", 0.9), nil
+		}
+		orch := s.newOrchestrator([]*stubProvider{fast, stuck}, OrchestratorConfig{
+			DefaultStrategy: StrategyParallel,
+		})
+		req := s.citizenRequestWithStrategy(StrategyParallel)
+		req.Timeout = 50 * time.Millisecond
+
+		start := time.Now()
+		result, err := orch.Lookup(context.Background(), req)
+		s.Require().NoError(err)
+		s.Less(time.Since(start), time.Second)
+		s.Len(result.Evidence, 1)
+		s.Contains(result.Errors, "citizen-stuck")
+
+		// The late result lands in the buffered channel, so the goroutine still exits
+		close(release)
+		select {
+		case <-exited:
+		case <-time.After(time.Second):
+			s.Fail("stuck provider goroutine did not exit")
+		}
+	})
+}
+
 func (s *OrchestratorSuite) TestVotingStrategy() {
-	s.Run("selects highest confidence evidence", func() {
+	s.Run("unanimous vote keeps the most confident record", func() {
 		prov1 := newStubProvider("citizen-1", providers.ProviderTypeCitizen)
 		prov1.lookupFn = func(_ context.Context, _ map[string]string) (*providers.Evidence, error) {
-			return s.evidenceWithData("citizen-1", 0.7, map[string]any{"valid": true, "source": "gov"}), nil
+			return s.evidenceWithData("citizen-1", 0.7, map[string]any{"national_id": "ABC123", "valid": true, "source": "gov"}), nil
 		}
 
 		prov2 := newStubProvider("citizen-2", providers.ProviderTypeCitizen)
 		prov2.lookupFn = func(_ context.Context, _ map[string]string) (*providers.Evidence, error) {
-			return s.evidenceWithData("citizen-2", 0.95, map[string]any{"valid": true, "source": "verified"}), nil
+			return s.evidenceWithData("citizen-2", 0.95, map[string]any{"national_id": "ABC123", "valid": true, "source": "verified"}), nil
 		}
 
 		prov3 := newStubProvider("citizen-3", providers.ProviderTypeCitizen)
 		prov3.lookupFn = func(_ context.Context, _ map[string]string) (*providers.Evidence, error) {
-			return s.evidenceWithData("citizen-3", 0.8, map[string]any{"valid": true, "source": "bank"}), nil
+			return s.evidenceWithData("citizen-3", 0.8, map[string]any{"national_id": "ABC123", "valid": true, "source": "bank"}), nil
 		}
 
 		orch := s.newOrchestrator([]*stubProvider{prov1, prov2, prov3}, OrchestratorConfig{
@@ -418,7 +631,145 @@ func (s *OrchestratorSuite) TestVotingStrategy() {
 		s.Require().NoError(err)
 		s.Len(result.Evidence, 1, "voting should select one result per type")
 		s.Equal("citizen-2", result.Evidence[0].ProviderID, "should select highest confidence")
-		s.Equal(0.95, result.Evidence[0].Confidence)
+		s.Equal(1.0, result.Evidence[0].Confidence, "every vote went to the winning value")
+	})
+
+	sanctionsProvider := func(providerID string, confidence float64, listed bool) *stubProvider {
+		prov := newStubProvider(providerID, providers.ProviderTypeSanctions)
+		prov.lookupFn = func(_ context.Context, _ map[string]string) (*providers.Evidence, error) {
+			return &providers.Evidence{
+				ProviderID:   providerID,
+				ProviderType: providers.ProviderTypeSanctions,
+				Confidence:   confidence,
+				Data:         map[string]any{"national_id": "ABC123", "listed": listed, "source": providerID},
+				CheckedAt:    time.Now(),
+			}, nil
+		}
+		return prov
+	}
+	sanctionsVote := func(weights map[string]float64, provs ...*stubProvider) *providers.Evidence {
+		orch := s.newOrchestrator(provs, OrchestratorConfig{
+			DefaultStrategy: StrategyVoting,
+			ProviderWeights: weights,
+		})
+		result, err := orch.Lookup(context.Background(), LookupRequest{
+			Types:   []providers.ProviderType{providers.ProviderTypeSanctions},
+			Filters: map[string]string{"national_id": "ABC123"},
+		})
+		s.Require().NoError(err)
+		s.Require().Len(result.Evidence, 1)
+		return result.Evidence[0]
+	}
+	minorityAndMajority := func() []*stubProvider {
+		return []*stubProvider{
+			sanctionsProvider("watchlist-premium", 0.95, false),
+			sanctionsProvider("watchlist-a", 0.4, true),
+			sanctionsProvider("watchlist-b", 0.4, true),
+			sanctionsProvider("watchlist-c", 0.4, true),
+		}
+	}
+
+	s.Run("low-confidence majority beats a high-confidence minority", func() {
+		winner := sanctionsVote(nil, minorityAndMajority()...)
+
+		s.Equal(true, winner.Data["listed"])
+		s.Contains([]string{"watchlist-a", "watchlist-b", "watchlist-c"}, winner.ProviderID)
+		s.InDelta(1.2/2.15, winner.Confidence, 1e-9, "confidence is the winning share of the vote")
+	})
+
+	s.Run("trust weights can outvote the majority", func() {
+		winner := sanctionsVote(map[string]float64{"watchlist-premium": 2}, minorityAndMajority()...)
+
+		s.Equal(false, winner.Data["listed"])
+		s.Equal("watchlist-premium", winner.ProviderID)
+		s.InDelta(1.9/3.1, winner.Confidence, 1e-9)
+	})
+
+	s.Run("zero-weight providers do not vote", func() {
+		winner := sanctionsVote(map[string]float64{"watchlist-a": 0, "watchlist-b": 0},
+			sanctionsProvider("watchlist-premium", 0.6, false),
+			sanctionsProvider("watchlist-a", 0.9, true),
+			sanctionsProvider("watchlist-b", 0.9, true),
+		)
+
+		s.Equal(false, winner.Data["listed"])
+		s.Equal(1.0, winner.Confidence)
+	})
+}
+
+// TestCorrelationRules verifies how merged evidence and rule failures land in
+// the lookup result.
+func (s *OrchestratorSuite) TestCorrelationRules() {
+	crossCheckWith := func(strategy LookupStrategy, sanctionsName string) *LookupResult {
+		citizen := newStubProvider("citizen-1", providers.ProviderTypeCitizen)
+		citizen.lookupFn = func(_ context.Context, _ map[string]string) (*providers.Evidence, error) {
+			return s.evidenceWithData("citizen-1", 0.9, map[string]any{
+				"national_id": "ABC123", "valid": true, "full_name": "John Smith", "date_of_birth": "1990-01-15",
+			}), nil
+		}
+		sanctions := newStubProvider("sanctions-1", providers.ProviderTypeSanctions)
+		sanctions.lookupFn = func(_ context.Context, _ map[string]string) (*providers.Evidence, error) {
+			return &providers.Evidence{
+				ProviderID:   "sanctions-1",
+				ProviderType: providers.ProviderTypeSanctions,
+				Confidence:   1.0,
+				Data: map[string]any{
+					"national_id": "ABC123", "listed": false, "source": "watchlist",
+					"full_name": sanctionsName, "date_of_birth": "1975-06-30",
+				},
+				CheckedAt: time.Now(),
+			}, nil
+		}
+
+		orch := s.newOrchestrator([]*stubProvider{citizen, sanctions}, OrchestratorConfig{
+			DefaultStrategy: strategy,
+			Rules:           []CorrelationRule{correlation.NewNameDOBCorrelationRule(0.1)},
+		})
+		result, err := orch.Lookup(context.Background(), LookupRequest{
+			Types:   []providers.ProviderType{providers.ProviderTypeCitizen, providers.ProviderTypeSanctions},
+			Filters: map[string]string{"national_id": "ABC123"},
+		})
+		s.Require().NoError(err)
+		return result
+	}
+	crossCheck := func(sanctionsName string) *LookupResult {
+		return crossCheckWith(StrategyParallel, sanctionsName)
+	}
+	byType := func(result *LookupResult) map[providers.ProviderType]*providers.Evidence {
+		out := make(map[providers.ProviderType]*providers.Evidence)
+		for _, e := range result.Evidence {
+			out[e.ProviderType] = e
+		}
+		return out
+	}
+
+	s.Run("merged citizen record keeps sanctions evidence alongside", func() {
+		result := crossCheck("John Smith")
+
+		s.Require().Len(result.Evidence, 2)
+		evidence := byType(result)
+		s.Equal("citizen-1", evidence[providers.ProviderTypeCitizen].ProviderID)
+		s.Equal("name_dob_cross_check", evidence[providers.ProviderTypeCitizen].Metadata["merge_strategy"])
+		s.InDelta(0.45, evidence[providers.ProviderTypeCitizen].Confidence, 1e-9, "date of birth disagrees")
+		s.Equal("sanctions-1", evidence[providers.ProviderTypeSanctions].ProviderID)
+		s.Empty(result.Errors)
+	})
+
+	s.Run("fallback lookups are cross-checked too", func() {
+		result := crossCheckWith(StrategyFallback, "John Smith")
+
+		s.Require().Len(result.Evidence, 2)
+		citizen := byType(result)[providers.ProviderTypeCitizen]
+		s.Equal("citizen-1", citizen.ProviderID)
+		s.InDelta(0.45, citizen.Confidence, 1e-9)
+	})
+
+	s.Run("total mismatch is reported in Errors", func() {
+		result := crossCheck("Maria Garcia")
+
+		s.ErrorIs(result.Errors[correlationErrorKey], correlation.ErrIdentityMismatch)
+		s.Len(result.Evidence, 2, "unmerged evidence is returned as found")
+		s.Equal("citizen-1", byType(result)[providers.ProviderTypeCitizen].ProviderID)
 	})
 }
 
@@ -478,4 +829,453 @@ func (s *OrchestratorSuite) TestFallbackStrategy() {
 			s.Equal(tc.wantProviderID, result.Evidence[0].ProviderID)
 		})
 	}
+
+	s.Run("without a configured chain falls back across every provider of the type", func() {
+		failing := newStubProvider("citizen-a", providers.ProviderTypeCitizen)
+		failing.lookupFn = func(_ context.Context, _ map[string]string) (*providers.Evidence, error) {
+			return nil, providerError(providers.ErrorBadData, "citizen-a")
+		}
+		working := newStubProvider("citizen-b", providers.ProviderTypeCitizen)
+		working.lookupFn = func(_ context.Context, _ map[string]string) (*providers.Evidence, error) {
+			return s.evidence("citizen-b", 0.9), nil
+		}
+		orch := s.newOrchestrator([]*stubProvider{working, failing}, OrchestratorConfig{
+			DefaultStrategy: StrategyFallback,
+			DefaultTimeout:  5 * time.Second,
+		})
+
+		result, err := orch.Lookup(context.Background(), s.citizenRequest())
+
+		s.Require().NoError(err)
+		s.Require().Len(result.Evidence, 1)
+		s.Equal("citizen-b", result.Evidence[0].ProviderID)
+		s.Equal(int32(1), failing.callCount.Load(), "providers are tried in ID order")
+	})
+}
+
+// Justification: Latency-aware ordering depends on measured response times,
+// which E2E providers do not vary.
+func (s *OrchestratorSuite) TestLatencyAwareFallback() {
+	// newProvider answers after *delay, so a test can slow a provider down.
+	newProvider := func(id string, delay *atomic.Int64) *stubProvider {
+		p := newStubProvider(id, providers.ProviderTypeCitizen)
+		p.lookupFn = func(_ context.Context, _ map[string]string) (*providers.Evidence, error) {
+			time.Sleep(time.Duration(delay.Load()))
+			return s.evidence(id, 1.0), nil
+		}
+		return p
+	}
+	newOrchestrator := func(latencyAware bool, provs ...*stubProvider) *Orchestrator {
+		return s.newOrchestrator(provs, OrchestratorConfig{
+			DefaultStrategy: StrategyFallback,
+			DefaultTimeout:  5 * time.Second,
+			Chains: map[providers.ProviderType]ProviderChain{
+				providers.ProviderTypeCitizen: {
+					Primary:   "citizen-primary",
+					Secondary: []string{"citizen-secondary"},
+				},
+			},
+			LatencyAware: latencyAware,
+		})
+	}
+	lookup := func(orch *Orchestrator) string {
+		result, err := orch.Lookup(context.Background(), s.citizenRequest())
+		s.Require().NoError(err)
+		s.Require().Len(result.Evidence, 1)
+		return result.Evidence[0].ProviderID
+	}
+	var slow, fast atomic.Int64
+	slow.Store(int64(30 * time.Millisecond))
+	fast.Store(int64(time.Millisecond))
+
+	s.Run("prefers the faster provider once both are measured", func() {
+		primary := newProvider("citizen-primary", &slow)
+		secondary := newProvider("citizen-secondary", &fast)
+		orch := newOrchestrator(true, primary, secondary)
+
+		s.Equal("citizen-primary", lookup(orch), "unmeasured providers keep chain order")
+		s.Equal("citizen-secondary", lookup(orch), "the unmeasured fallback is tried once to measure it")
+		for range 3 {
+			s.Equal("citizen-secondary", lookup(orch))
+		}
+		s.Equal(int32(1), primary.callCount.Load())
+	})
+
+	s.Run("switches back when the preferred provider slows down", func() {
+		var secondaryDelay atomic.Int64
+		secondaryDelay.Store(int64(time.Millisecond))
+		primary := newProvider("citizen-primary", &slow)
+		secondary := newProvider("citizen-secondary", &secondaryDelay)
+		orch := newOrchestrator(true, primary, secondary)
+		lookup(orch)
+		lookup(orch)
+
+		secondaryDelay.Store(int64(100 * time.Millisecond))
+		switched := false
+		for range 10 {
+			if lookup(orch) == "citizen-primary" {
+				switched = true
+				break
+			}
+		}
+		s.True(switched, "the primary is preferred again once its average is lower")
+	})
+
+	s.Run("failed calls count as slow", func() {
+		primary := newStubProvider("citizen-primary", providers.ProviderTypeCitizen)
+		primary.lookupFn = func(_ context.Context, _ map[string]string) (*providers.Evidence, error) {
+			return nil, providerError(providers.ErrorBadData, "citizen-primary")
+		}
+		secondary := newProvider("citizen-secondary", &slow)
+		orch := newOrchestrator(true, primary, secondary)
+
+		for range 3 {
+			s.Equal("citizen-secondary", lookup(orch))
+		}
+		s.Equal(int32(1), primary.callCount.Load(), "a fast failure is not preferred")
+	})
+
+	s.Run("re-probes providers whose average has gone stale", func() {
+		primary := newProvider("citizen-primary", &slow)
+		secondary := newProvider("citizen-secondary", &fast)
+		orch := newOrchestrator(true, primary, secondary)
+		clock := time.Now()
+		orch.latency.now = func() time.Time { return clock }
+		lookup(orch)
+		lookup(orch)
+		s.Equal("citizen-secondary", lookup(orch))
+
+		clock = clock.Add(latencyStaleAfter + time.Second)
+		s.Equal("citizen-primary", lookup(orch), "stale averages count as unmeasured")
+		s.Equal(int32(2), primary.callCount.Load())
+		s.Equal("citizen-secondary", lookup(orch))
+	})
+
+	s.Run("primary strategy uses the fastest provider", func() {
+		primary := newProvider("citizen-primary", &slow)
+		secondary := newProvider("citizen-secondary", &fast)
+		orch := newOrchestrator(true, primary, secondary)
+		lookupPrimary := func() string {
+			result, err := orch.Lookup(context.Background(), s.citizenRequestWithStrategy(StrategyPrimary))
+			s.Require().NoError(err)
+			s.Require().Len(result.Evidence, 1)
+			return result.Evidence[0].ProviderID
+		}
+
+		s.Equal("citizen-primary", lookupPrimary())
+		s.Equal("citizen-secondary", lookupPrimary(), "the unmeasured provider is tried once to measure it")
+		for range 3 {
+			s.Equal("citizen-secondary", lookupPrimary())
+		}
+		s.Equal(int32(1), primary.callCount.Load())
+	})
+
+	s.Run("disabled keeps the chain order", func() {
+		primary := newProvider("citizen-primary", &slow)
+		secondary := newProvider("citizen-secondary", &fast)
+		orch := newOrchestrator(false, primary, secondary)
+
+		for range 3 {
+			s.Equal("citizen-primary", lookup(orch))
+		}
+		s.Zero(secondary.callCount.Load())
+	})
+}
+
+// Justification: A caller abandoning lookups must not open a healthy
+// provider's circuit; E2E cannot cancel a request mid-lookup.
+func (s *OrchestratorSuite) TestCircuitBreakerIgnoresCallerCancellation() {
+	for _, strategy := range []LookupStrategy{StrategyPrimary, StrategyFallback} {
+		s.Run(string(strategy), func() {
+			primary := newStubProvider("citizen-primary", providers.ProviderTypeCitizen)
+			primary.lookupFn = func(ctx context.Context, _ map[string]string) (*providers.Evidence, error) {
+				<-ctx.Done()
+				return nil, providers.NewProviderError(providers.ErrorTimeout, "citizen-primary", "cancelled", ctx.Err())
+			}
+			orch := s.newOrchestrator([]*stubProvider{primary}, OrchestratorConfig{
+				DefaultStrategy: strategy,
+				DefaultTimeout:  5 * time.Second,
+				Breaker:         BreakerConfig{FailureThreshold: 1, Cooldown: time.Minute},
+			})
+
+			for range 2 {
+				ctx, cancel := context.WithTimeout(context.Background(), 5*time.Millisecond)
+				_, _ = orch.Lookup(ctx, s.citizenRequest())
+				cancel()
+			}
+
+			s.NoError(orch.HealthCheck(context.Background())["citizen-primary"])
+		})
+	}
+
+	s.Run("parallel", func() {
+		slow := newStubProvider("citizen-slow", providers.ProviderTypeCitizen)
+		slow.lookupFn = func(ctx context.Context, _ map[string]string) (*providers.Evidence, error) {
+			<-ctx.Done()
+			return nil, providers.NewProviderError(providers.ErrorTimeout, "citizen-slow", "cancelled", ctx.Err())
+		}
+		fast := newStubProvider("citizen-fast", providers.ProviderTypeCitizen)
+		orch := s.newOrchestrator([]*stubProvider{fast, slow}, OrchestratorConfig{
+			DefaultStrategy: StrategyParallel,
+			DefaultTimeout:  5 * time.Second,
+			Breaker:         BreakerConfig{FailureThreshold: 1, Cooldown: time.Minute},
+		})
+
+		for range 2 {
+			ctx, cancel := context.WithCancel(context.Background())
+			time.AfterFunc(20*time.Millisecond, cancel)
+			result, _ := orch.Lookup(ctx, s.citizenRequestWithStrategy(StrategyParallel))
+			cancel()
+			s.NotContains(result.Errors, "citizen-slow", "a cancelled lookup is not a provider timeout")
+		}
+
+		s.NoError(orch.HealthCheck(context.Background())["citizen-slow"])
+	})
+}
+
+// Justification: The chain timeout decides how much of the lookup deadline a
+// slow primary may spend before its fallback runs; E2E providers answer quickly.
+func (s *OrchestratorSuite) TestChainTimeout() {
+	newChainOrchestrator := func(strategy LookupStrategy) *Orchestrator {
+		primary := newStubProvider("citizen-primary", providers.ProviderTypeCitizen)
+		primary.lookupFn = func(ctx context.Context, _ map[string]string) (*providers.Evidence, error) {
+			<-ctx.Done()
+			return nil, ctx.Err()
+		}
+		secondary := newStubProvider("citizen-secondary", providers.ProviderTypeCitizen)
+		return s.newOrchestrator([]*stubProvider{primary, secondary}, OrchestratorConfig{
+			DefaultStrategy: strategy,
+			DefaultTimeout:  5 * time.Second,
+			Chains: map[providers.ProviderType]ProviderChain{
+				providers.ProviderTypeCitizen: {
+					Primary:   "citizen-primary",
+					Secondary: []string{"citizen-secondary"},
+					Timeout:   20 * time.Millisecond,
+				},
+			},
+			Backoff: BackoffConfig{MaxRetries: 0},
+		})
+	}
+
+	s.Run("slow primary leaves the lookup deadline to its fallback", func() {
+		orch := newChainOrchestrator(StrategyFallback)
+
+		start := time.Now()
+		result, err := orch.Lookup(context.Background(), s.citizenRequest())
+		s.Require().NoError(err)
+		s.Less(time.Since(start), time.Second)
+		s.Require().Len(result.Evidence, 1)
+		s.Equal("citizen-secondary", result.Evidence[0].ProviderID)
+		s.Equal(providers.ErrorTimeout, providers.GetCategory(result.Errors["citizen-primary"]))
+	})
+
+	s.Run("primary strategy reports the chain timeout", func() {
+		orch := newChainOrchestrator(StrategyPrimary)
+
+		result, err := orch.Lookup(context.Background(), s.citizenRequest())
+		s.Require().ErrorIs(err, providers.ErrAllProvidersFailed)
+		s.Empty(result.Evidence)
+		s.Equal(providers.ErrorTimeout, providers.GetCategory(result.Errors["citizen-primary"]))
+	})
+}
+
+// Justification: Breaker thresholds and cooldown decide when an outage stops
+// costing every lookup a provider timeout, and when traffic returns to the
+// provider; E2E providers never fail repeatedly.
+func (s *OrchestratorSuite) TestCircuitBreaker() {
+	var down atomic.Bool
+	primary := newStubProvider("citizen-primary", providers.ProviderTypeCitizen)
+	primary.lookupFn = func(_ context.Context, _ map[string]string) (*providers.Evidence, error) {
+		if down.Load() {
+			return nil, providerError(providers.ErrorProviderOutage, "citizen-primary")
+		}
+		return s.evidence("citizen-primary", 1.0), nil
+	}
+	secondary := newStubProvider("citizen-secondary", providers.ProviderTypeCitizen)
+	orch := s.newOrchestrator([]*stubProvider{primary, secondary}, OrchestratorConfig{
+		DefaultStrategy: StrategyFallback,
+		DefaultTimeout:  5 * time.Second,
+		Chains: map[providers.ProviderType]ProviderChain{
+			providers.ProviderTypeCitizen: {
+				Primary:   "citizen-primary",
+				Secondary: []string{"citizen-secondary"},
+			},
+		},
+		Backoff: BackoffConfig{InitialDelay: time.Millisecond, MaxRetries: 1},
+		Breaker: BreakerConfig{FailureThreshold: 2, Cooldown: 50 * time.Millisecond},
+	})
+
+	lookup := func() *LookupResult {
+		result, err := orch.Lookup(context.Background(), s.citizenRequest())
+		s.Require().NoError(err)
+		s.Require().Len(result.Evidence, 1)
+		return result
+	}
+
+	down.Store(true)
+	for range 2 {
+		s.Equal("citizen-secondary", lookup().Evidence[0].ProviderID)
+	}
+	s.ErrorIs(orch.HealthCheck(context.Background())["citizen-primary"], ErrCircuitOpen)
+	s.NoError(orch.HealthCheck(context.Background())["citizen-secondary"])
+
+	s.Run("open provider is skipped and fallback fills its slot", func() {
+		calls := primary.callCount.Load()
+		result := lookup()
+		s.Equal("citizen-secondary", result.Evidence[0].ProviderID)
+		s.ErrorIs(result.Errors["citizen-primary"], ErrCircuitOpen)
+		s.Equal(calls, primary.callCount.Load(), "open provider must not be called")
+	})
+
+	s.Run("parallel lookups skip the open provider", func() {
+		calls := primary.callCount.Load()
+		result, err := orch.Lookup(context.Background(), s.citizenRequestWithStrategy(StrategyParallel))
+		s.Require().NoError(err)
+		s.Require().Len(result.Evidence, 1)
+		s.Equal("citizen-secondary", result.Evidence[0].ProviderID)
+		s.ErrorIs(result.Errors["citizen-primary"], ErrCircuitOpen)
+		s.Equal(calls, primary.callCount.Load())
+	})
+
+	s.Run("recovered provider closes the breaker after the cooldown", func() {
+		down.Store(false)
+		time.Sleep(60 * time.Millisecond)
+
+		result := lookup()
+		s.Equal("citizen-primary", result.Evidence[0].ProviderID)
+		s.Empty(result.Errors)
+		s.NoError(orch.HealthCheck(context.Background())["citizen-primary"])
+	})
+
+	s.Run("breakers are reported for status", func() {
+		reporters := orch.CircuitBreakers()
+		s.Require().Len(reporters, 2)
+		s.Equal("registry_citizen-primary", reporters[0].Snapshot().Name)
+		s.Equal(circuit.StatusClosed, reporters[0].Snapshot().State)
+	})
+}
+
+func (s *OrchestratorSuite) TestLookupLogging() {
+	const nationalID = "NID-987654321"
+	const fullName = "Jane Example"
+
+	newLoggedOrchestrator := func(buf *bytes.Buffer, enabled bool, prov *stubProvider) *Orchestrator {
+		logger := slog.New(slog.NewJSONHandler(buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+		return s.newOrchestrator([]*stubProvider{prov}, OrchestratorConfig{
+			DefaultStrategy: StrategyPrimary,
+			Logger:          logger,
+			LogLookups:      enabled,
+		})
+	}
+	request := LookupRequest{
+		Types:   []providers.ProviderType{providers.ProviderTypeCitizen},
+		Filters: map[string]string{"national_id": nationalID},
+	}
+
+	s.Run("logs metadata without filter values or PII", func() {
+		prov := newStubProvider("citizen-registry", providers.ProviderTypeCitizen)
+		prov.lookupFn = func(_ context.Context, filters map[string]string) (*providers.Evidence, error) {
+			return s.evidenceWithData("citizen-registry", 0.95, map[string]any{
+				"national_id": filters["national_id"],
+				"full_name":   fullName,
+				"valid":       true,
+			}), nil
+		}
+		var buf bytes.Buffer
+		orch := newLoggedOrchestrator(&buf, true, prov)
+
+		_, err := orch.Lookup(context.Background(), request)
+		s.Require().NoError(err)
+
+		out := buf.String()
+		s.Contains(out, `"msg":"registry provider lookup"`)
+		s.Contains(out, `"provider_id":"citizen-registry"`)
+		s.Contains(out, `"filter_keys":["national_id"]`)
+		s.Contains(out, `"latency_ms"`)
+		s.Contains(out, `"confidence":0.95`)
+		s.Contains(out, `"valid":true`)
+		s.NotContains(out, nationalID)
+		s.NotContains(out, fullName)
+	})
+
+	s.Run("logs error category without raw message", func() {
+		prov := newStubProvider("citizen-registry", providers.ProviderTypeCitizen)
+		prov.lookupFn = func(_ context.Context, _ map[string]string) (*providers.Evidence, error) {
+			return nil, providers.NewProviderError(providers.ErrorNotFound, "citizen-registry", "no record for "+nationalID, nil)
+		}
+		var buf bytes.Buffer
+		orch := newLoggedOrchestrator(&buf, true, prov)
+
+		_, _ = orch.Lookup(context.Background(), request) //nolint:errcheck // only the log output is under test
+
+		out := buf.String()
+		s.Contains(out, `"error_category":"not_found"`)
+		s.NotContains(out, nationalID)
+	})
+
+	s.Run("disabled by default", func() {
+		prov := newStubProvider("citizen-registry", providers.ProviderTypeCitizen)
+		var buf bytes.Buffer
+		orch := newLoggedOrchestrator(&buf, false, prov)
+
+		_, err := orch.Lookup(context.Background(), request)
+		s.Require().NoError(err)
+		s.Empty(buf.String())
+	})
+}
+
+func (s *OrchestratorSuite) TestLookupMetrics() {
+	// Metrics register globally, so a single instance is shared across subtests;
+	// each subtest uses its own provider ID to keep label series independent.
+	m := metrics.New()
+	newMeteredOrchestrator := func(prov *stubProvider) *Orchestrator {
+		return s.newOrchestrator([]*stubProvider{prov}, OrchestratorConfig{
+			DefaultStrategy: StrategyPrimary,
+			Metrics:         m,
+		})
+	}
+
+	s.Run("successful lookup observes latency and increments success", func() {
+		prov := newStubProvider("metrics-ok", providers.ProviderTypeCitizen)
+		orch := newMeteredOrchestrator(prov)
+
+		_, err := orch.Lookup(context.Background(), s.citizenRequest())
+		s.Require().NoError(err)
+
+		hist, ok := m.ProviderLookupDurationSeconds.WithLabelValues("metrics-ok").(prometheus.Histogram)
+		s.Require().True(ok)
+		var sample dto.Metric
+		s.Require().NoError(hist.Write(&sample))
+		s.Equal(uint64(1), sample.GetHistogram().GetSampleCount())
+		s.InDelta(1.0, testutil.ToFloat64(m.ProviderLookupsTotal.WithLabelValues("metrics-ok", "success", metrics.ErrorClassNone)), 0)
+	})
+
+	s.Run("failed lookup increments error counter with error class", func() {
+		prov := newStubProvider("metrics-fail", providers.ProviderTypeCitizen)
+		prov.lookupFn = func(_ context.Context, _ map[string]string) (*providers.Evidence, error) {
+			return nil, providerError(providers.ErrorNotFound, "metrics-fail")
+		}
+		orch := newMeteredOrchestrator(prov)
+
+		_, _ = orch.Lookup(context.Background(), s.citizenRequest()) //nolint:errcheck // only the metrics are under test
+
+		s.InDelta(1.0, testutil.ToFloat64(m.ProviderLookupsTotal.WithLabelValues("metrics-fail", "error", string(providers.ErrorNotFound))), 0)
+		s.InDelta(0.0, testutil.ToFloat64(m.ProviderLookupsTotal.WithLabelValues("metrics-fail", "success", metrics.ErrorClassNone)), 0)
+	})
+
+	s.Run("exhausted retry budget counts shed retries", func() {
+		prov := newStubProvider("metrics-shed", providers.ProviderTypeCitizen)
+		prov.lookupFn = func(_ context.Context, _ map[string]string) (*providers.Evidence, error) {
+			return nil, providerError(providers.ErrorTimeout, "metrics-shed")
+		}
+		orch := s.newOrchestrator([]*stubProvider{prov}, OrchestratorConfig{
+			DefaultStrategy: StrategyFallback,
+			Metrics:         m,
+			Backoff:         BackoffConfig{InitialDelay: time.Millisecond, RetryRate: 0.001, RetryBurst: 1},
+		})
+
+		_, _ = orch.Lookup(context.Background(), s.citizenRequest()) //nolint:errcheck // only the metrics are under test
+
+		s.InDelta(1.0, testutil.ToFloat64(m.ProviderRetriesShedTotal.WithLabelValues("metrics-shed")), 0)
+	})
 }
@@ -0,0 +1,56 @@
+package orchestrator
+
+import (
+	"sync"
+	"time"
+)
+
+// retryLimiter is a token bucket over retries shared by every lookup on an
+// orchestrator. The per-lookup retryBudget bounds one request; this bounds the
+// recent retry rate across all of them, so when a provider is failing for
+// everyone the orchestrator fails fast instead of multiplying client retries.
+type retryLimiter struct {
+	mu     sync.Mutex
+	rate   float64 // tokens added per second
+	burst  float64
+	tokens float64
+	last   time.Time
+	now    func() time.Time
+}
+
+// newRetryLimiter returns a limiter starting with a full bucket, or nil when
+// rate is negative (disabled).
+func newRetryLimiter(rate float64, burst int) *retryLimiter {
+	if rate < 0 {
+		return nil
+	}
+	l := &retryLimiter{
+		rate:   rate,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		now:    time.Now,
+	}
+	l.last = l.now()
+	return l
+}
+
+// allow consumes one retry token, reporting false when the budget is spent.
+// A nil limiter always allows.
+func (l *retryLimiter) allow() bool {
+	if l == nil {
+		return true
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	if elapsed := now.Sub(l.last); elapsed > 0 {
+		l.tokens = min(l.burst, l.tokens+elapsed.Seconds()*l.rate)
+	}
+	l.last = now
+	if l.tokens < 1 {
+		return false
+	}
+	l.tokens--
+	return true
+}
@@ -0,0 +1,68 @@
+package orchestrator
+
+import (
+	"fmt"
+
+	"credo/internal/evidence/registry/providers"
+)
+
+// validateEvidence checks a provider response against the evidence schema before
+// the orchestrator accepts it, so a misbehaving provider cannot feed malformed
+// evidence into the cache or a decision.
+//
+// Schema violations are reported as ErrorContractMismatch: the provider broke
+// its contract, which is neither retryable nor the caller's fault. Messages name
+// fields only, never their values, because the data may contain PII.
+func validateEvidence(p providers.Provider, filters map[string]string, evidence *providers.Evidence) error {
+	if evidence == nil {
+		return contractError(p, "provider returned no evidence")
+	}
+
+	expectedType := p.Capabilities().Type
+	if evidence.ProviderType != expectedType {
+		return contractError(p, fmt.Sprintf("evidence type %q does not match provider type %q", evidence.ProviderType, expectedType))
+	}
+
+	// NaN fails both comparisons, so it is rejected too
+	if !(evidence.Confidence >= 0 && evidence.Confidence <= 1) {
+		return contractError(p, "confidence must be between 0 and 1")
+	}
+
+	if requested, ok := filters["national_id"]; ok {
+		if echoed, _ := evidence.Data["national_id"].(string); echoed != requested {
+			return contractError(p, "national_id does not echo the requested identity")
+		}
+	}
+
+	switch evidence.ProviderType {
+	case providers.ProviderTypeCitizen:
+		if _, ok := evidence.Data["valid"].(bool); !ok {
+			return contractError(p, "citizen evidence requires a boolean valid field")
+		}
+	case providers.ProviderTypeSanctions:
+		if _, ok := evidence.Data["listed"].(bool); !ok {
+			return contractError(p, "sanctions evidence requires a boolean listed field")
+		}
+		if source, _ := evidence.Data["source"].(string); source == "" {
+			return contractError(p, "sanctions evidence requires a non-empty source")
+		}
+	}
+
+	return nil
+}
+
+// checkConfidenceFloor rejects evidence below the floor configured for its type.
+// Such evidence is well-formed but too uncertain to act on, so it is treated as
+// no evidence and the fallback chain moves on to a higher-quality source.
+func (o *Orchestrator) checkConfidenceFloor(p providers.Provider, evidence *providers.Evidence) error {
+	floor, ok := o.floors[evidence.ProviderType]
+	if !ok || evidence.Confidence >= floor {
+		return nil
+	}
+	return providers.NewProviderError(providers.ErrorLowConfidence, p.ID(),
+		fmt.Sprintf("confidence %.2f is below the %.2f floor for %s evidence", evidence.Confidence, floor, evidence.ProviderType), nil)
+}
+
+func contractError(p providers.Provider, message string) error {
+	return providers.NewProviderError(providers.ErrorContractMismatch, p.ID(), message, nil)
+}
@@ -0,0 +1,163 @@
+package orchestrator
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"credo/internal/evidence/registry/providers"
+)
+
+func (s *OrchestratorSuite) TestResponseValidation() {
+	sanctionsRequest := LookupRequest{
+		Types:    []providers.ProviderType{providers.ProviderTypeSanctions},
+		Strategy: StrategyPrimary,
+		Filters:  map[string]string{"national_id": "ABC123"},
+	}
+	sanctionsEvidence := func(confidence float64, data map[string]any) *providers.Evidence {
+		return &providers.Evidence{
+			ProviderID:   "sanctions-registry",
+			ProviderType: providers.ProviderTypeSanctions,
+			Confidence:   confidence,
+			Data:         data,
+			CheckedAt:    time.Now(),
+		}
+	}
+	lookup := func(evidence *providers.Evidence) (*LookupResult, error) {
+		prov := newStubProvider("sanctions-registry", providers.ProviderTypeSanctions)
+		prov.lookupFn = func(_ context.Context, _ map[string]string) (*providers.Evidence, error) {
+			return evidence, nil
+		}
+		orch := s.newOrchestrator([]*stubProvider{prov}, OrchestratorConfig{DefaultStrategy: StrategyPrimary})
+		return orch.Lookup(context.Background(), sanctionsRequest)
+	}
+	assertRejected := func(result *LookupResult, err error) {
+		s.Require().ErrorIs(err, providers.ErrAllProvidersFailed)
+		s.Empty(result.Evidence, "invalid evidence must not be returned")
+		s.Require().Contains(result.Errors, "sanctions-registry")
+		s.Equal(providers.ErrorContractMismatch, providers.GetCategory(result.Errors["sanctions-registry"]))
+	}
+
+	s.Run("valid response is accepted", func() {
+		result, err := lookup(sanctionsEvidence(1.0, map[string]any{
+			"national_id": "ABC123",
+			"listed":      false,
+			"source":      "Mock International Sanctions Database",
+		}))
+		s.Require().NoError(err)
+		s.Require().Len(result.Evidence, 1)
+		s.Empty(result.Errors)
+	})
+
+	s.Run("confidence out of range is rejected", func() {
+		for _, confidence := range []float64{1.5, -0.1, math.NaN()} {
+			result, err := lookup(sanctionsEvidence(confidence, map[string]any{
+				"national_id": "ABC123",
+				"listed":      false,
+				"source":      "gov",
+			}))
+			assertRejected(result, err)
+		}
+	})
+
+	s.Run("missing source is rejected", func() {
+		result, err := lookup(sanctionsEvidence(1.0, map[string]any{
+			"national_id": "ABC123",
+			"listed":      true,
+		}))
+		assertRejected(result, err)
+	})
+
+	s.Run("national id that does not echo the request is rejected", func() {
+		result, err := lookup(sanctionsEvidence(1.0, map[string]any{
+			"national_id": "OTHER999",
+			"listed":      false,
+			"source":      "gov",
+		}))
+		assertRejected(result, err)
+	})
+
+	s.Run("citizen evidence without validity is rejected", func() {
+		prov := newStubProvider("citizen-registry", providers.ProviderTypeCitizen)
+		prov.lookupFn = func(_ context.Context, filters map[string]string) (*providers.Evidence, error) {
+			return s.evidenceWithData("citizen-registry", 1.0, map[string]any{"national_id": filters["national_id"]}), nil
+		}
+		orch := s.newOrchestrator([]*stubProvider{prov}, OrchestratorConfig{DefaultStrategy: StrategyPrimary})
+
+		result, err := orch.Lookup(context.Background(), s.citizenRequestWithStrategy(StrategyPrimary))
+		s.Require().Error(err)
+		s.Equal(providers.ErrorContractMismatch, providers.GetCategory(result.Errors["citizen-registry"]))
+	})
+}
+
+func (s *OrchestratorSuite) TestConfidenceFloor() {
+	chainConfig := func(floor float64) OrchestratorConfig {
+		return OrchestratorConfig{
+			DefaultStrategy: StrategyFallback,
+			Chains: map[providers.ProviderType]ProviderChain{
+				providers.ProviderTypeCitizen: {
+					Primary:   "citizen-primary",
+					Secondary: []string{"citizen-secondary"},
+				},
+			},
+			Backoff:       BackoffConfig{MaxRetries: 0},
+			MinConfidence: map[providers.ProviderType]float64{providers.ProviderTypeCitizen: floor},
+		}
+	}
+	providersWith := func(primaryConfidence float64) (*stubProvider, *stubProvider) {
+		primary := newStubProvider("citizen-primary", providers.ProviderTypeCitizen)
+		primary.lookupFn = func(_ context.Context, _ map[string]string) (*providers.Evidence, error) {
+			return s.evidence("citizen-primary", primaryConfidence), nil
+		}
+		secondary := newStubProvider("citizen-secondary", providers.ProviderTypeCitizen)
+		secondary.lookupFn = func(_ context.Context, _ map[string]string) (*providers.Evidence, error) {
+			return s.evidence("citizen-secondary", 0.95), nil
+		}
+		return primary, secondary
+	}
+
+	s.Run("below-floor primary falls back to secondary", func() {
+		primary, secondary := providersWith(0.4)
+		orch := s.newOrchestrator([]*stubProvider{primary, secondary}, chainConfig(0.8))
+
+		result, err := orch.Lookup(context.Background(), s.citizenRequest())
+		s.Require().NoError(err)
+		s.Require().Len(result.Evidence, 1)
+		s.Equal("citizen-secondary", result.Evidence[0].ProviderID)
+		s.Equal(providers.ErrorLowConfidence, providers.GetCategory(result.Errors["citizen-primary"]),
+			"discarded evidence is recorded as a soft error")
+		s.Equal(int32(1), secondary.callCount.Load())
+	})
+
+	s.Run("above-floor primary is accepted", func() {
+		primary, secondary := providersWith(0.85)
+		orch := s.newOrchestrator([]*stubProvider{primary, secondary}, chainConfig(0.8))
+
+		result, err := orch.Lookup(context.Background(), s.citizenRequest())
+		s.Require().NoError(err)
+		s.Require().Len(result.Evidence, 1)
+		s.Equal("citizen-primary", result.Evidence[0].ProviderID)
+		s.Empty(result.Errors)
+		s.Zero(secondary.callCount.Load(), "secondary must not be queried")
+	})
+
+	s.Run("floor applies only to its type", func() {
+		prov := newStubProvider("sanctions-registry", providers.ProviderTypeSanctions)
+		prov.lookupFn = func(_ context.Context, _ map[string]string) (*providers.Evidence, error) {
+			return &providers.Evidence{
+				ProviderID:   "sanctions-registry",
+				ProviderType: providers.ProviderTypeSanctions,
+				Confidence:   0.3,
+				Data:         map[string]any{"national_id": "ABC123", "listed": false, "source": "gov"},
+			}, nil
+		}
+		orch := s.newOrchestrator([]*stubProvider{prov}, chainConfig(0.8))
+
+		result, err := orch.Lookup(context.Background(), LookupRequest{
+			Types:   []providers.ProviderType{providers.ProviderTypeSanctions},
+			Filters: map[string]string{"national_id": "ABC123"},
+		})
+		s.Require().NoError(err)
+		s.Len(result.Evidence, 1)
+	})
+}
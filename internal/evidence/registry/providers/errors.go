@@ -34,6 +34,9 @@ const (
 	// ErrorRateLimited indicates too many requests
 	ErrorRateLimited ErrorCategory = "rate_limited"
 
+	// ErrorLowConfidence indicates evidence fell below the configured confidence floor
+	ErrorLowConfidence ErrorCategory = "low_confidence"
+
 	// ErrorInternal indicates an unexpected internal error
 	ErrorInternal ErrorCategory = "internal"
 )
@@ -67,7 +70,7 @@ func (e *ProviderError) Unwrap() error {
 // NewProviderError creates a new normalized provider error with automatic retry classification.
 //
 // The Retryable flag is automatically set to true for transient failures (timeout, outage, rate-limited)
-// and false for permanent failures (bad data, not found, auth, contract mismatch). Provider adapters
+// and false for permanent failures (bad data, not found, auth, contract mismatch, low confidence). Provider adapters
 // should use this constructor to ensure consistent error handling across all implementations.
 func NewProviderError(category ErrorCategory, providerID, message string, underlying error) *ProviderError {
 	retryable := category == ErrorTimeout ||
@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"time"
+
+	"credo/internal/evidence/registry/domain/shared"
 )
 
 // Protocol defines the supported communication protocols for registry providers
@@ -95,19 +97,24 @@ func NewProviderRegistry() *ProviderRegistry {
 	}
 }
 
-// Register adds a provider to the registry, keyed by its ID.
-// Returns an error if a provider with the same ID is already registered.
+// Register adds a provider to the registry, keyed by its normalized ID.
+// Returns an error if the ID is invalid or a provider with the same normalized ID
+// is already registered, so ids differing only by case cannot collide.
 func (r *ProviderRegistry) Register(p Provider) error {
-	id := p.ID()
-	if _, exists := r.providers[id]; exists {
+	id, err := shared.ParseProviderID(p.ID())
+	if err != nil {
+		return fmt.Errorf("provider %q: %w", p.ID(), err)
+	}
+	if _, exists := r.providers[id.String()]; exists {
 		return fmt.Errorf("provider %s already registered", id)
 	}
-	r.providers[id] = p
+	r.providers[id.String()] = p
 	return nil
 }
 
+// Get returns the provider registered under id, matching case-insensitively.
 func (r *ProviderRegistry) Get(id string) (Provider, bool) {
-	p, ok := r.providers[id]
+	p, ok := r.providers[shared.NormalizeProviderID(id)]
 	return p, ok
 }
 
@@ -0,0 +1,63 @@
+package providers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"credo/internal/evidence/registry/domain/shared"
+)
+
+type namedProvider struct {
+	id string
+}
+
+func (p namedProvider) ID() string { return p.id }
+
+func (p namedProvider) Capabilities() Capabilities {
+	return Capabilities{Type: ProviderTypeCitizen}
+}
+
+func (p namedProvider) Lookup(context.Context, map[string]string) (*Evidence, error) {
+	return nil, ErrProviderNotFound
+}
+
+func (p namedProvider) Health(context.Context) error { return nil }
+
+type ProviderRegistrySuite struct {
+	suite.Suite
+}
+
+func TestProviderRegistrySuite(t *testing.T) {
+	suite.Run(t, new(ProviderRegistrySuite))
+}
+
+func (s *ProviderRegistrySuite) TestRegister() {
+	s.Run("registers a provider with a valid id", func() {
+		registry := NewProviderRegistry()
+		s.Require().NoError(registry.Register(namedProvider{id: "citizen-registry"}))
+
+		p, ok := registry.Get("citizen-registry")
+		s.True(ok)
+		s.Equal("citizen-registry", p.ID())
+	})
+
+	s.Run("rejects an invalid id", func() {
+		registry := NewProviderRegistry()
+		for _, id := range []string{"", "citizen registry"} {
+			err := registry.Register(namedProvider{id: id})
+			s.ErrorIs(err, shared.ErrInvalidProviderID, "id %q", id)
+		}
+		s.Empty(registry.All())
+	})
+
+	s.Run("ids differing only by case collide", func() {
+		registry := NewProviderRegistry()
+		s.Require().NoError(registry.Register(namedProvider{id: "Citizen-Registry"}))
+		s.Error(registry.Register(namedProvider{id: "citizen-registry"}))
+
+		_, ok := registry.Get("CITIZEN-REGISTRY")
+		s.True(ok, "lookups match the normalized key")
+	})
+}
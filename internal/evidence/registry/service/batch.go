@@ -0,0 +1,131 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/sync/errgroup"
+
+	"credo/internal/evidence/registry/models"
+	id "credo/pkg/domain"
+	dErrors "credo/pkg/domain-errors"
+)
+
+// Batch defaults bound the provider load one bulk request can generate.
+const (
+	DefaultBatchConcurrency = 8
+	DefaultMaxBatchSize     = 100
+)
+
+// BatchConfig bounds CheckBatch.
+type BatchConfig struct {
+	Concurrency int // Maximum identities checked at once (default: DefaultBatchConcurrency)
+	MaxSize     int // Maximum identities per batch (default: DefaultMaxBatchSize)
+}
+
+// WithBatchConfig overrides the batch concurrency and size limit.
+func WithBatchConfig(cfg BatchConfig) Option {
+	return func(s *Service) {
+		s.batch = cfg
+	}
+}
+
+// CheckResult is the outcome of one identity in a batch: Result on success,
+// Err otherwise.
+type CheckResult struct {
+	NationalID id.NationalID
+	Result     *models.RegistryResult
+	Err        error
+}
+
+// CheckBatch runs Check for each national ID, for bulk onboarding.
+//
+// Consent is verified once for the batch; a consent failure fails the whole
+// batch. Repeated IDs are checked once and share the outcome. Unique IDs are
+// checked by a bounded worker pool, each exactly as Check would (cache, negative
+// cache, in-flight coalescing, regulated mode minimization).
+//
+// The results follow the input order, one per input ID. A failed identity is
+// reported in its CheckResult.Err and does not fail the batch; identities not
+// reached before ctx is cancelled carry ctx's error, which is also returned.
+func (s *Service) CheckBatch(ctx context.Context, userID id.UserID, nationalIDs []id.NationalID) (results []*CheckResult, err error) {
+	cfg := s.batchConfig()
+	if len(nationalIDs) > cfg.MaxSize {
+		return nil, dErrors.New(dErrors.CodeValidation, fmt.Sprintf("batch exceeds %d national IDs", cfg.MaxSize))
+	}
+
+	ctx, span := registryTracer.Start(ctx, "registry.check_batch",
+		trace.WithAttributes(
+			attribute.Int("batch.size", len(nationalIDs)),
+			attribute.Bool("regulated_mode", s.regulated),
+		),
+	)
+	defer func() { endSpan(span, err) }()
+
+	if err = s.requireConsent(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	// Index each input by its unique ID so repeats share one check.
+	slots := make([]int, len(nationalIDs))
+	seen := make(map[string]int, len(nationalIDs))
+	var unique []*CheckResult
+	for i, nationalID := range nationalIDs {
+		slot, ok := seen[nationalID.String()]
+		if !ok {
+			slot = len(unique)
+			seen[nationalID.String()] = slot
+			unique = append(unique, &CheckResult{NationalID: nationalID})
+		}
+		slots[i] = slot
+	}
+	span.SetAttributes(attribute.Int("batch.unique", len(unique)))
+
+	group := new(errgroup.Group)
+	group.SetLimit(cfg.Concurrency)
+	for _, outcome := range unique {
+		group.Go(func() error {
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				outcome.Err = ctxErr
+				return nil
+			}
+			outcome.Result, outcome.Err = s.checkBatchIdentity(ctx, outcome.NationalID)
+			return nil
+		})
+	}
+	_ = group.Wait() //nolint:errcheck // workers never return errors
+
+	results = make([]*CheckResult, len(nationalIDs))
+	for i, slot := range slots {
+		outcome := *unique[slot]
+		results[i] = &outcome
+	}
+	return results, ctx.Err()
+}
+
+// checkBatchIdentity checks one batch identity under its own registry.check span.
+func (s *Service) checkBatchIdentity(ctx context.Context, nationalID id.NationalID) (result *models.RegistryResult, err error) {
+	ctx, span := registryTracer.Start(ctx, "registry.check",
+		trace.WithAttributes(
+			attribute.String("national_id", hashNationalID(nationalID.String())),
+			attribute.Bool("regulated_mode", s.regulated),
+		),
+	)
+	defer func() { endSpan(span, err) }()
+
+	return s.checkIdentity(ctx, span, nationalID)
+}
+
+// batchConfig applies defaults to the configured batch bounds.
+func (s *Service) batchConfig() BatchConfig {
+	cfg := s.batch
+	if cfg.Concurrency <= 0 {
+		cfg.Concurrency = DefaultBatchConcurrency
+	}
+	if cfg.MaxSize <= 0 {
+		cfg.MaxSize = DefaultMaxBatchSize
+	}
+	return cfg
+}
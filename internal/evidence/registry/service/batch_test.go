@@ -0,0 +1,148 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"credo/internal/evidence/registry/models"
+	"credo/internal/evidence/registry/providers"
+	"credo/internal/evidence/registry/store"
+	id "credo/pkg/domain"
+	dErrors "credo/pkg/domain-errors"
+)
+
+func (s *ServiceSuite) TestCheckBatch() {
+	ctx := context.Background()
+	userID := testUserID()
+	failing := testNationalID("BATCHFAIL")
+
+	// newProviders echoes the requested national ID so each identity gets its
+	// own record; the failing identity hits a provider outage.
+	newProviders := func() (*stubProvider, *stubProvider) {
+		citizenProv := &stubProvider{
+			id:       "test-citizen",
+			provType: providers.ProviderTypeCitizen,
+			lookupFn: func(_ context.Context, filters map[string]string) (*providers.Evidence, error) {
+				if filters["national_id"] == failing.String() {
+					return nil, providers.NewProviderError(providers.ErrorProviderOutage, "test-citizen", "registry down", nil)
+				}
+				return citizenEvidence(&models.CitizenRecord{
+					NationalID:  filters["national_id"],
+					FullName:    "Test User",
+					DateOfBirth: "1990-01-01",
+					Valid:       true,
+					CheckedAt:   time.Now(),
+				}), nil
+			},
+		}
+		sanctionsProv := &stubProvider{
+			id:       "test-sanctions",
+			provType: providers.ProviderTypeSanctions,
+			lookupFn: func(_ context.Context, filters map[string]string) (*providers.Evidence, error) {
+				return sanctionsEvidence(&models.SanctionsRecord{
+					NationalID: filters["national_id"],
+					Source:     "test-source",
+					CheckedAt:  time.Now(),
+				}), nil
+			},
+		}
+		return citizenProv, sanctionsProv
+	}
+	// Batch checks write to the cache concurrently, so these cases use the
+	// thread-safe in-memory cache rather than stubCache.
+	newService := func(citizenProv, sanctionsProv *stubProvider, opts ...Option) *Service {
+		return New(newTestOrchestrator(citizenProv, sanctionsProv), store.NewInMemoryCache(time.Hour), nil, false, opts...)
+	}
+
+	s.Run("results follow input order", func() {
+		citizenProv, sanctionsProv := newProviders()
+		svc := newService(citizenProv, sanctionsProv, WithBatchConfig(BatchConfig{Concurrency: 3}))
+		nationalIDs := []id.NationalID{
+			testNationalID("BATCH0003"),
+			testNationalID("BATCH0001"),
+			testNationalID("BATCH0004"),
+			testNationalID("BATCH0002"),
+		}
+
+		results, err := svc.CheckBatch(ctx, userID, nationalIDs)
+		s.Require().NoError(err)
+		s.Require().Len(results, len(nationalIDs))
+		for i, result := range results {
+			s.Require().NoError(result.Err)
+			s.Equal(nationalIDs[i], result.NationalID)
+			s.Equal(nationalIDs[i].String(), result.Result.Citizen.NationalID)
+		}
+	})
+
+	s.Run("repeated IDs are checked once and share the outcome", func() {
+		citizenProv, sanctionsProv := newProviders()
+		svc := newService(citizenProv, sanctionsProv)
+		first, second := testNationalID("BATCH0001"), testNationalID("BATCH0002")
+
+		results, err := svc.CheckBatch(ctx, userID, []id.NationalID{first, second, first, first})
+		s.Require().NoError(err)
+		s.Require().Len(results, 4)
+		s.Equal(int32(2), citizenProv.calls.Load())
+		s.Equal(int32(2), sanctionsProv.calls.Load())
+		s.Equal(results[0].Result, results[2].Result)
+		s.Equal(results[0].Result, results[3].Result)
+		s.Equal(second.String(), results[1].Result.Citizen.NationalID)
+	})
+
+	s.Run("cache hits, cache misses and provider errors are reported per ID", func() {
+		citizenProv, sanctionsProv := newProviders()
+		svc := newService(citizenProv, sanctionsProv)
+		cached, missed := testNationalID("BATCHHIT1"), testNationalID("BATCHMISS")
+		_, err := svc.Check(ctx, userID, cached)
+		s.Require().NoError(err)
+		var mu sync.Mutex
+		var looked []string
+		lookup := citizenProv.lookupFn
+		citizenProv.lookupFn = func(ctx context.Context, filters map[string]string) (*providers.Evidence, error) {
+			mu.Lock()
+			looked = append(looked, filters["national_id"])
+			mu.Unlock()
+			return lookup(ctx, filters)
+		}
+
+		results, err := svc.CheckBatch(ctx, userID, []id.NationalID{cached, failing, missed})
+		s.Require().NoError(err, "a failed identity does not fail the batch")
+		s.Require().Len(results, 3)
+
+		s.Require().NoError(results[0].Err)
+		s.Equal(cached.String(), results[0].Result.Citizen.NationalID)
+
+		s.Require().Error(results[1].Err)
+		s.Nil(results[1].Result)
+
+		s.Require().NoError(results[2].Err)
+		s.Equal(missed.String(), results[2].Result.Citizen.NationalID)
+
+		s.NotContains(looked, cached.String(), "cached identities do not reach the provider")
+		s.Contains(looked, missed.String())
+	})
+
+	s.Run("consent failure fails the whole batch", func() {
+		citizenProv, sanctionsProv := newProviders()
+		consentPort := &stubConsentPort{err: &consentError{message: "consent required for purpose: registry_check"}}
+		svc := New(newTestOrchestrator(citizenProv, sanctionsProv), store.NewInMemoryCache(time.Hour), consentPort, false)
+
+		results, err := svc.CheckBatch(ctx, userID, []id.NationalID{testNationalID("BATCH0001")})
+		s.Require().Error(err)
+		s.Nil(results)
+		s.Zero(citizenProv.calls.Load())
+	})
+
+	s.Run("batch over the size limit is rejected", func() {
+		citizenProv, sanctionsProv := newProviders()
+		svc := newService(citizenProv, sanctionsProv, WithBatchConfig(BatchConfig{MaxSize: 2}))
+		nationalIDs := []id.NationalID{testNationalID("BATCH0001"), testNationalID("BATCH0002"), testNationalID("BATCH0003")}
+
+		results, err := svc.CheckBatch(ctx, userID, nationalIDs)
+		s.Require().Error(err)
+		s.True(dErrors.HasCode(err, dErrors.CodeValidation))
+		s.Nil(results)
+		s.Zero(citizenProv.calls.Load())
+	})
+}
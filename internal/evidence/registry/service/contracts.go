@@ -16,8 +16,9 @@ func (s *Service) CitizenContract(ctx context.Context, userID id.UserID, nationa
 		return nil, err
 	}
 	return &registrycontracts.CitizenRecord{
-		DateOfBirth: record.DateOfBirth,
-		Valid:       record.Valid,
+		DateOfBirth:  record.DateOfBirth,
+		Valid:        record.Valid,
+		Completeness: record.Completeness,
 	}, nil
 }
 
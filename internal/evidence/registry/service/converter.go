@@ -4,6 +4,7 @@ package service
 
 import (
 	"fmt"
+	"strings"
 
 	"credo/internal/evidence/registry/domain/citizen"
 	"credo/internal/evidence/registry/domain/sanctions"
@@ -36,7 +37,7 @@ func EvidenceToCitizenVerification(ev *providers.Evidence) (*citizen.CitizenVeri
 		return nil, dErrors.Wrap(err, dErrors.CodeBadRequest, "invalid national_id format")
 	}
 
-	confidence, err := shared.New(ev.Confidence)
+	confidence, err := shared.NewConfidence(ev.Confidence)
 	if err != nil {
 		return nil, dErrors.Wrap(err, dErrors.CodeBadRequest, "invalid confidence value")
 	}
@@ -48,13 +49,16 @@ func EvidenceToCitizenVerification(ev *providers.Evidence) (*citizen.CitizenVeri
 	}
 
 	checkedAt := shared.NewCheckedAt(ev.CheckedAt)
-	providerID := shared.NewProviderID(ev.ProviderID)
+	providerID, err := shared.ParseProviderID(ev.ProviderID)
+	if err != nil {
+		return nil, dErrors.Wrap(err, dErrors.CodeBadRequest, "invalid provider_id")
+	}
 
-	// Optional fields: personal details (may be empty in regulated mode)
-	details := citizen.PersonalDetails{
-		FullName:    getString(ev.Data, "full_name"),
-		DateOfBirth: getString(ev.Data, "date_of_birth"),
-		Address:     getString(ev.Data, "address"),
+	// Optional fields: personal details. Registries may omit any of them (and do in
+	// regulated mode); absence lowers Completeness but never invalidates the record.
+	details, err := personalDetailsFromData(ev.Data)
+	if err != nil {
+		return nil, dErrors.Wrap(err, dErrors.CodeBadRequest, "invalid provider response")
 	}
 
 	verification, err := citizen.New(
@@ -93,7 +97,7 @@ func EvidenceToSanctionsCheck(ev *providers.Evidence) (*sanctions.SanctionsCheck
 		return nil, dErrors.Wrap(err, dErrors.CodeBadRequest, "invalid national_id format")
 	}
 
-	confidence, err := shared.New(ev.Confidence)
+	confidence, err := shared.NewConfidence(ev.Confidence)
 	if err != nil {
 		return nil, dErrors.Wrap(err, dErrors.CodeBadRequest, "invalid confidence value")
 	}
@@ -105,7 +109,10 @@ func EvidenceToSanctionsCheck(ev *providers.Evidence) (*sanctions.SanctionsCheck
 	}
 
 	checkedAt := shared.NewCheckedAt(ev.CheckedAt)
-	providerID := shared.NewProviderID(ev.ProviderID)
+	providerID, err := shared.ParseProviderID(ev.ProviderID)
+	if err != nil {
+		return nil, dErrors.Wrap(err, dErrors.CodeBadRequest, "invalid provider_id")
+	}
 	source := sanctions.NewSource(getString(ev.Data, "source"))
 
 	if listed {
@@ -142,13 +149,16 @@ func EvidenceToSanctionsCheck(ev *providers.Evidence) (*sanctions.SanctionsCheck
 // This is the outbound conversion for persistence and transport.
 func CitizenVerificationToRecord(cv *citizen.CitizenVerification) *models.CitizenRecord {
 	return &models.CitizenRecord{
-		NationalID:  cv.NationalID().String(),
-		FullName:    cv.FullName(),
-		DateOfBirth: cv.DateOfBirth(),
-		Address:     cv.Address(),
-		Valid:       cv.IsValid(),
-		Source:      cv.ProviderID().String(),
-		CheckedAt:   cv.CheckedAt().Time(),
+		NationalID:   cv.NationalID().String(),
+		FullName:     cv.FullName(),
+		DateOfBirth:  cv.DateOfBirth(),
+		Address:      cv.Address(),
+		BirthYear:    cv.BirthYear(),
+		City:         cv.City(),
+		Valid:        cv.IsValid(),
+		Source:       cv.ProviderID().String(),
+		CheckedAt:    cv.CheckedAt().Time(),
+		Completeness: cv.Completeness(),
 	}
 }
 
@@ -163,6 +173,92 @@ func SanctionsCheckToRecord(sc *sanctions.SanctionsCheck) *models.SanctionsRecor
 	}
 }
 
+// CitizenRecordToVerification converts an infrastructure CitizenRecord back to a domain
+// CitizenVerification. This is the inbound conversion for records read from persistence.
+//
+// Records do not carry everything the aggregate needs, so the caller supplies the rest:
+//   - key is the national ID the record was stored under; regulated mode blanks the
+//     record's own NationalID, and a non-empty one must match key
+//   - confidence is not persisted and is assigned by the caller
+//
+// The record's Completeness is kept, since a minimized record's details no longer
+// show how complete the registry's response was.
+//
+// Source maps to the aggregate's ProviderID. A record with a blank NationalID is
+// reconstructed as minimized, keeping whatever coarse details it carries, so
+// converting it back yields the same record.
+func CitizenRecordToVerification(key id.NationalID, record *models.CitizenRecord, confidence shared.Confidence) (*citizen.CitizenVerification, error) {
+	if record == nil {
+		return nil, dErrors.New(dErrors.CodeBadRequest, "citizen record is nil")
+	}
+	if record.NationalID != "" && record.NationalID != key.String() {
+		return nil, dErrors.New(dErrors.CodeInvariantViolation, "citizen record national_id does not match lookup key")
+	}
+
+	providerID, err := shared.ParseProviderID(record.Source)
+	if err != nil {
+		return nil, dErrors.Wrap(err, dErrors.CodeInvariantViolation, "invalid citizen record source")
+	}
+	verification, err := citizen.New(
+		key,
+		citizen.PersonalDetails{
+			FullName:    record.FullName,
+			DateOfBirth: record.DateOfBirth,
+			Address:     record.Address,
+			BirthYear:   record.BirthYear,
+			City:        record.City,
+		},
+		record.Valid,
+		shared.NewCheckedAt(record.CheckedAt),
+		providerID,
+		confidence,
+	)
+	if err != nil {
+		return nil, dErrors.Wrap(err, dErrors.CodeInvariantViolation, "invalid citizen record")
+	}
+	verification = verification.WithRecordedCompleteness(record.Completeness)
+	if record.NationalID == "" {
+		if record.HasPII() {
+			return nil, dErrors.New(dErrors.CodeInvariantViolation, "minimized citizen record must not contain PII")
+		}
+		return verification.WithoutNationalIDUnder(retainCoarseDetails), nil
+	}
+	return verification, nil
+}
+
+// retainCoarseDetails keeps every coarse detail when re-minimizing a record that
+// is already minimized, whatever policy produced it.
+var retainCoarseDetails = citizen.MinimizationPolicy{DateOfBirth: citizen.RuleYear, Address: citizen.RuleCity}
+
+// SanctionsRecordToCheck converts an infrastructure SanctionsRecord back to a domain
+// SanctionsCheck. This is the inbound conversion for records read from persistence.
+//
+// Records persist only the listed flag and the list source, so the caller supplies
+// the provenance: providerID and confidence. Listed records come back as
+// ListTypeSanctions with empty reason and date, matching the evidence conversion.
+func SanctionsRecordToCheck(record *models.SanctionsRecord, providerID shared.ProviderID, confidence shared.Confidence) (*sanctions.SanctionsCheck, error) {
+	if record == nil {
+		return nil, dErrors.New(dErrors.CodeBadRequest, "sanctions record is nil")
+	}
+	nationalID, err := id.ParseNationalID(record.NationalID)
+	if err != nil {
+		return nil, dErrors.Wrap(err, dErrors.CodeInvariantViolation, "invalid sanctions record national_id")
+	}
+	source := sanctions.NewSource(record.Source)
+	checkedAt := shared.NewCheckedAt(record.CheckedAt)
+
+	var check *sanctions.SanctionsCheck
+	if record.Listed {
+		check, err = sanctions.NewListedSanctionsCheck(nationalID, sanctions.ListTypeSanctions, "", "", source, checkedAt, providerID, confidence)
+	} else {
+		check, err = sanctions.NewSanctionsCheck(nationalID, source, checkedAt, providerID, confidence)
+	}
+	if err != nil {
+		return nil, dErrors.Wrap(err, dErrors.CodeInvariantViolation, "invalid sanctions record")
+	}
+	return check, nil
+}
+
 // EvidenceToCitizenRecord converts generic Evidence to a CitizenRecord via domain aggregate.
 // This is a convenience function that chains Evidence → Domain → Infrastructure.
 // Returns an error if conversion fails.
@@ -215,6 +311,41 @@ func getRequiredBool(data map[string]any, key string) (bool, error) {
 	return b, nil
 }
 
+// personalDetailsFromData extracts the optional personal detail fields.
+func personalDetailsFromData(data map[string]any) (citizen.PersonalDetails, error) {
+	fullName, err := getOptionalString(data, "full_name")
+	if err != nil {
+		return citizen.PersonalDetails{}, err
+	}
+	dateOfBirth, err := getOptionalString(data, "date_of_birth")
+	if err != nil {
+		return citizen.PersonalDetails{}, err
+	}
+	address, err := getOptionalString(data, "address")
+	if err != nil {
+		return citizen.PersonalDetails{}, err
+	}
+	return citizen.PersonalDetails{FullName: fullName, DateOfBirth: dateOfBirth, Address: address}, nil
+}
+
+// getOptionalString extracts an optional string field from provider data.
+// A missing, null, or blank field is absent and returns "" without error; a
+// present field of the wrong type is an error rather than being silently dropped.
+func getOptionalString(data map[string]any, key string) (string, error) {
+	v, ok := data[key]
+	if !ok || v == nil {
+		return "", nil
+	}
+	s, ok := v.(string)
+	if !ok {
+		return "", dErrors.New(dErrors.CodeBadRequest, fmt.Sprintf("field %s has wrong type: expected string, got %T", key, v))
+	}
+	if strings.TrimSpace(s) == "" {
+		return "", nil
+	}
+	return s, nil
+}
+
 // getString extracts an optional string field from provider data.
 // Returns empty string if missing or wrong type (for optional fields only).
 func getString(data map[string]any, key string) string {
@@ -6,10 +6,13 @@ import (
 
 	"github.com/stretchr/testify/suite"
 
+	"credo/internal/evidence/registry/domain/citizen"
 	"credo/internal/evidence/registry/domain/sanctions"
 	"credo/internal/evidence/registry/domain/shared"
+	"credo/internal/evidence/registry/models"
 	"credo/internal/evidence/registry/providers"
 	id "credo/pkg/domain"
+	dErrors "credo/pkg/domain-errors"
 )
 
 type ConverterSuite struct {
@@ -421,6 +424,228 @@ func (s *ConverterSuite) TestEvidenceToCitizenRecord() {
 	})
 }
 
+// =============================================================================
+// Record ↔ Domain Round-Trip Tests
+// =============================================================================
+
+func (s *ConverterSuite) TestCitizenRecordRoundTrip() {
+	key := s.mustParseNationalID("123456789012")
+	checkedAt := shared.NewCheckedAt(time.Date(2025, 12, 11, 10, 0, 0, 0, time.UTC))
+	providerID := shared.NewProviderID("citizen-provider")
+	confidence, err := shared.NewConfidence(0.8)
+	s.Require().NoError(err)
+	details := citizen.PersonalDetails{FullName: "Jane Doe", DateOfBirth: "1985-05-15", Address: "456 Oak Ave"}
+
+	s.Run("full verification survives domain to record to domain", func() {
+		original, err := citizen.New(key, details, true, checkedAt, providerID, confidence)
+		s.Require().NoError(err)
+
+		record := CitizenVerificationToRecord(original)
+		s.Equal("123456789012", record.NationalID)
+		s.Equal("citizen-provider", record.Source)
+		s.Equal(checkedAt.Time(), record.CheckedAt)
+
+		restored, err := CitizenRecordToVerification(key, record, confidence)
+		s.Require().NoError(err)
+		s.Equal(key, restored.NationalID())
+		s.Equal(details, restored.PersonalDetails())
+		s.True(restored.IsValid())
+		s.Equal(checkedAt, restored.CheckedAt())
+		s.Equal(providerID, restored.ProviderID())
+		s.Equal(confidence, restored.Confidence())
+		s.False(restored.IsMinimized())
+	})
+
+	s.Run("minimized verification survives domain to record to domain", func() {
+		original, err := citizen.New(key, details, false, checkedAt, providerID, confidence)
+		s.Require().NoError(err)
+
+		record := CitizenVerificationToRecord(original.WithoutNationalID())
+		s.Empty(record.NationalID)
+		s.False(record.HasPII())
+
+		restored, err := CitizenRecordToVerification(key, record, confidence)
+		s.Require().NoError(err)
+		s.True(restored.IsMinimized())
+		s.True(restored.NationalID().IsNil(), "minimized aggregate keeps the lookup key hidden")
+		s.True(restored.PersonalDetails().IsEmpty())
+		s.InDelta(1.0, restored.Completeness(), 1e-9, "completeness is measured before minimization")
+		s.False(restored.IsValid())
+		s.Equal(checkedAt, restored.CheckedAt())
+		s.Equal(providerID, restored.ProviderID())
+		s.Equal(confidence, restored.Confidence())
+		s.Equal(record, CitizenVerificationToRecord(restored))
+	})
+
+	s.Run("coarsened verification survives domain to record to domain", func() {
+		original, err := citizen.New(key, citizen.PersonalDetails{
+			FullName: "Jane Doe", DateOfBirth: "1985-05-15", Address: "456 Oak Ave, Springfield, IL 62701",
+		}, true, checkedAt, providerID, confidence)
+		s.Require().NoError(err)
+
+		policy := citizen.MinimizationPolicy{DateOfBirth: citizen.RuleYear, Address: citizen.RuleCity}
+		record := CitizenVerificationToRecord(original.WithoutNationalIDUnder(policy))
+		s.False(record.HasPII())
+		s.Equal("1985", record.BirthYear)
+		s.Equal("Springfield", record.City)
+
+		restored, err := CitizenRecordToVerification(key, record, confidence)
+		s.Require().NoError(err)
+		s.True(restored.IsMinimized())
+		s.Equal(record, CitizenVerificationToRecord(restored))
+	})
+
+	s.Run("record survives record to domain to record", func() {
+		record := &models.CitizenRecord{
+			NationalID:   "123456789012",
+			FullName:     "Jane Doe",
+			DateOfBirth:  "1985-05-15",
+			Address:      "456 Oak Ave",
+			Valid:        true,
+			Source:       "citizen-provider",
+			CheckedAt:    checkedAt.Time(),
+			Completeness: 1,
+		}
+		restored, err := CitizenRecordToVerification(key, record, confidence)
+		s.Require().NoError(err)
+		s.Equal(record, CitizenVerificationToRecord(restored))
+	})
+
+	s.Run("rejects nil record", func() {
+		_, err := CitizenRecordToVerification(key, nil, confidence)
+		s.True(dErrors.HasCode(err, dErrors.CodeBadRequest))
+	})
+
+	s.Run("rejects national_id that does not match the key", func() {
+		record := &models.CitizenRecord{NationalID: "999999999999", Source: "citizen-provider", CheckedAt: checkedAt.Time()}
+		_, err := CitizenRecordToVerification(key, record, confidence)
+		s.True(dErrors.HasCode(err, dErrors.CodeInvariantViolation))
+	})
+
+	s.Run("rejects minimized record that still carries PII", func() {
+		record := &models.CitizenRecord{FullName: "Jane Doe", Source: "citizen-provider", CheckedAt: checkedAt.Time()}
+		_, err := CitizenRecordToVerification(key, record, confidence)
+		s.True(dErrors.HasCode(err, dErrors.CodeInvariantViolation))
+	})
+
+	s.Run("rejects record missing source", func() {
+		record := &models.CitizenRecord{NationalID: "123456789012", CheckedAt: checkedAt.Time()}
+		_, err := CitizenRecordToVerification(key, record, confidence)
+		s.True(dErrors.HasCode(err, dErrors.CodeInvariantViolation))
+	})
+}
+
+func (s *ConverterSuite) TestSanctionsRecordRoundTrip() {
+	nationalID := s.mustParseNationalID("123456789012")
+	source := sanctions.NewSource("OFAC-SDN")
+	checkedAt := shared.NewCheckedAt(time.Date(2025, 12, 11, 10, 0, 0, 0, time.UTC))
+	providerID := shared.NewProviderID("sanctions-provider")
+	confidence := shared.Authoritative()
+
+	s.Run("unlisted check survives domain to record to domain", func() {
+		original, err := sanctions.NewSanctionsCheck(nationalID, source, checkedAt, providerID, confidence)
+		s.Require().NoError(err)
+
+		restored, err := SanctionsRecordToCheck(SanctionsCheckToRecord(original), providerID, confidence)
+		s.Require().NoError(err)
+		s.Equal(original, restored)
+	})
+
+	s.Run("listed check survives domain to record to domain", func() {
+		original, err := sanctions.NewListedSanctionsCheck(nationalID, sanctions.ListTypeSanctions, "", "", source, checkedAt, providerID, confidence)
+		s.Require().NoError(err)
+
+		restored, err := SanctionsRecordToCheck(SanctionsCheckToRecord(original), providerID, confidence)
+		s.Require().NoError(err)
+		s.Equal(original, restored)
+		s.True(restored.IsSanctioned())
+	})
+
+	s.Run("record survives record to domain to record", func() {
+		record := &models.SanctionsRecord{NationalID: "123456789012", Listed: true, Source: "OFAC-SDN", CheckedAt: checkedAt.Time()}
+		restored, err := SanctionsRecordToCheck(record, providerID, confidence)
+		s.Require().NoError(err)
+		s.Equal(source, restored.Source())
+		s.Equal(checkedAt, restored.CheckedAt())
+		s.Equal(providerID, restored.ProviderID())
+		s.Equal(confidence, restored.Confidence())
+		s.Equal(record, SanctionsCheckToRecord(restored))
+	})
+
+	s.Run("rejects nil record", func() {
+		_, err := SanctionsRecordToCheck(nil, providerID, confidence)
+		s.True(dErrors.HasCode(err, dErrors.CodeBadRequest))
+	})
+
+	s.Run("rejects invalid national_id", func() {
+		record := &models.SanctionsRecord{NationalID: "", Source: "OFAC-SDN", CheckedAt: checkedAt.Time()}
+		_, err := SanctionsRecordToCheck(record, providerID, confidence)
+		s.True(dErrors.HasCode(err, dErrors.CodeInvalidInput))
+	})
+
+	s.Run("rejects record missing source", func() {
+		record := &models.SanctionsRecord{NationalID: "123456789012", CheckedAt: checkedAt.Time()}
+		_, err := SanctionsRecordToCheck(record, providerID, confidence)
+		s.True(dErrors.HasCode(err, dErrors.CodeInvariantViolation))
+	})
+}
+
+// =============================================================================
+// Partial Citizen Data Tests
+// =============================================================================
+
+func (s *ConverterSuite) TestEvidenceToCitizenVerification_PartialData() {
+	s.Run("fully populated record is complete", func() {
+		verification, err := EvidenceToCitizenVerification(s.citizenEvidence(map[string]any{
+			"full_name":     "John Doe",
+			"date_of_birth": "1990-01-01",
+			"address":       "123 Main St",
+		}))
+		s.Require().NoError(err)
+		s.InDelta(1.0, verification.Completeness(), 1e-9)
+		s.True(verification.MeetsCompleteness(1.0))
+	})
+
+	s.Run("partial record converts with reduced completeness", func() {
+		verification, err := EvidenceToCitizenVerification(s.citizenEvidence(map[string]any{
+			"full_name": "John Doe",
+		}))
+		s.Require().NoError(err)
+		s.Equal("John Doe", verification.FullName())
+		s.Empty(verification.Address())
+		s.True(verification.IsValid())
+		s.InDelta(1.0/3, verification.Completeness(), 1e-9)
+		s.False(verification.MeetsCompleteness(0.5))
+	})
+
+	s.Run("missing, null, and blank optional fields are all absent", func() {
+		verification, err := EvidenceToCitizenVerification(s.citizenEvidence(map[string]any{
+			"date_of_birth": nil,
+			"address":       "   ",
+		}))
+		s.Require().NoError(err)
+		s.True(verification.PersonalDetails().IsEmpty())
+		s.InDelta(0.0, verification.Completeness(), 0)
+		s.True(verification.MeetsCompleteness(0))
+	})
+
+	s.Run("wrong-typed optional field returns error", func() {
+		_, err := EvidenceToCitizenVerification(s.citizenEvidence(map[string]any{
+			"address": 42,
+		}))
+		s.ErrorContains(err, "invalid provider response")
+	})
+
+	s.Run("completeness survives minimization", func() {
+		verification, err := EvidenceToCitizenVerification(s.citizenEvidence(map[string]any{
+			"full_name":     "John Doe",
+			"date_of_birth": "1990-01-01",
+		}))
+		s.Require().NoError(err)
+		s.InDelta(2.0/3, verification.Minimized().Completeness(), 1e-9)
+	})
+}
+
 // =============================================================================
 // Helpers
 // =============================================================================
@@ -439,6 +664,21 @@ func (s *ConverterSuite) sanctionsEvidence(nationalID string, listed bool, sourc
 	}
 }
 
+// citizenEvidence builds valid citizen evidence with the given optional fields.
+func (s *ConverterSuite) citizenEvidence(optional map[string]any) *providers.Evidence {
+	data := map[string]any{"national_id": "123456789012", "valid": true}
+	for k, v := range optional {
+		data[k] = v
+	}
+	return &providers.Evidence{
+		ProviderID:   "citizen-provider",
+		ProviderType: providers.ProviderTypeCitizen,
+		Confidence:   1.0,
+		Data:         data,
+		CheckedAt:    time.Now(),
+	}
+}
+
 func (s *ConverterSuite) mustParseNationalID(str string) id.NationalID {
 	nid, err := id.ParseNationalID(str)
 	s.Require().NoError(err, "invalid national ID in test")
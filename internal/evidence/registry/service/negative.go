@@ -0,0 +1,61 @@
+package service
+
+import (
+	"context"
+
+	id "credo/pkg/domain"
+	dErrors "credo/pkg/domain-errors"
+)
+
+// NegativeCache records national IDs that no citizen registry holds, so repeat
+// lookups for them return not-found without a provider call.
+//
+// Implementations keep negative entries apart from positive records and expire
+// them on a shorter TTL than the positive cache; an expired entry must report false.
+type NegativeCache interface {
+	IsNotFound(ctx context.Context, nationalID id.NationalID) (bool, error)
+	SaveNotFound(ctx context.Context, key id.NationalID) error
+}
+
+// WithNegativeCache enables negative caching of citizen not-found lookups.
+func WithNegativeCache(cache NegativeCache) Option {
+	return func(s *Service) {
+		s.negative = cache
+	}
+}
+
+// errCitizenNotFound matches the error providers' not-found results translate to.
+func errCitizenNotFound() error {
+	return dErrors.New(dErrors.CodeNotFound, "citizen record not found")
+}
+
+// knownNotFound reports whether nationalID has a live negative entry.
+// Negative cache failures fall through to the providers.
+func (s *Service) knownNotFound(ctx context.Context, nationalID id.NationalID) bool {
+	if s.negative == nil {
+		return false
+	}
+	found, err := s.negative.IsNotFound(ctx, nationalID)
+	if err != nil {
+		if s.logger != nil {
+			s.logger.WarnContext(ctx, "failed to read negative cache",
+				"national_id", hashNationalID(nationalID.String()),
+				"error", err,
+			)
+		}
+		return false
+	}
+	return found
+}
+
+// rememberNotFound records nationalID when a citizen-only lookup failed with not-found.
+// Only citizen-only lookups qualify: in a combined lookup a not-found may come
+// from the sanctions provider instead.
+func (s *Service) rememberNotFound(ctx context.Context, nationalID id.NationalID, err error) {
+	if s.negative == nil || !dErrors.HasCode(err, dErrors.CodeNotFound) {
+		return
+	}
+	if saveErr := s.negative.SaveNotFound(ctx, nationalID); saveErr != nil {
+		s.logCacheSaveError(ctx, "negative", nationalID, saveErr)
+	}
+}
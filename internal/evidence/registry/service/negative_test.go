@@ -0,0 +1,80 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"credo/internal/evidence/registry/providers"
+	"credo/internal/evidence/registry/store"
+	dErrors "credo/pkg/domain-errors"
+)
+
+func (s *ServiceSuite) TestNegativeCache() {
+	ctx := context.Background()
+	userID := testUserID()
+	nationalID := testNationalID("MISSING01")
+
+	notFoundProvider := func() *stubProvider {
+		return &stubProvider{
+			id:       "test-citizen",
+			provType: providers.ProviderTypeCitizen,
+			lookupFn: func(_ context.Context, _ map[string]string) (*providers.Evidence, error) {
+				return nil, providers.NewProviderError(providers.ErrorNotFound, "test-citizen", "no such citizen", nil)
+			},
+		}
+	}
+
+	s.Run("not-found lookup populates the negative cache and the next lookup hits it", func() {
+		prov := notFoundProvider()
+		negative := store.NewInMemoryNegativeCache(time.Minute)
+		svc := New(newTestOrchestrator(prov, nil), newStubCache(), nil, false, WithNegativeCache(negative))
+
+		_, err := svc.Citizen(ctx, userID, nationalID)
+		s.True(dErrors.HasCode(err, dErrors.CodeNotFound))
+		s.Equal(int32(1), prov.calls.Load())
+
+		found, err := negative.IsNotFound(ctx, nationalID)
+		s.Require().NoError(err)
+		s.True(found)
+
+		_, err = svc.Citizen(ctx, userID, nationalID)
+		s.True(dErrors.HasCode(err, dErrors.CodeNotFound))
+		_, err = svc.CitizenWithDetails(ctx, userID, nationalID)
+		s.True(dErrors.HasCode(err, dErrors.CodeNotFound))
+		s.Equal(int32(1), prov.calls.Load(), "negative hits must not reach the provider")
+	})
+
+	s.Run("expired negative entry queries providers again", func() {
+		prov := notFoundProvider()
+		negative := store.NewInMemoryNegativeCache(10 * time.Millisecond)
+		svc := New(newTestOrchestrator(prov, nil), newStubCache(), nil, false, WithNegativeCache(negative))
+
+		_, err := svc.Citizen(ctx, userID, nationalID)
+		s.True(dErrors.HasCode(err, dErrors.CodeNotFound))
+
+		s.Require().Eventually(func() bool {
+			found, _ := negative.IsNotFound(ctx, nationalID)
+			return !found
+		}, 200*time.Millisecond, 5*time.Millisecond)
+
+		_, err = svc.Citizen(ctx, userID, nationalID)
+		s.True(dErrors.HasCode(err, dErrors.CodeNotFound))
+		s.Equal(int32(2), prov.calls.Load())
+	})
+
+	s.Run("other failures are not cached as not-found", func() {
+		prov := &stubProvider{
+			id:       "test-citizen",
+			provType: providers.ProviderTypeCitizen,
+			lookupFn: func(_ context.Context, _ map[string]string) (*providers.Evidence, error) {
+				return nil, providers.NewProviderError(providers.ErrorAuthentication, "test-citizen", "bad key", nil)
+			},
+		}
+		negative := store.NewInMemoryNegativeCache(time.Minute)
+		svc := New(newTestOrchestrator(prov, nil), newStubCache(), nil, false, WithNegativeCache(negative))
+
+		_, err := svc.Citizen(ctx, userID, nationalID)
+		s.Require().Error(err)
+		s.Zero(negative.Size())
+	})
+}
@@ -6,12 +6,14 @@ import (
 	"encoding/hex"
 	"errors"
 	"log/slog"
+	"strings"
 
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/codes"
 	"go.opentelemetry.io/otel/trace"
 
+	"credo/internal/evidence/registry/domain/citizen"
 	"credo/internal/evidence/registry/models"
 	"credo/internal/evidence/registry/orchestrator"
 	"credo/internal/evidence/registry/ports"
@@ -24,6 +26,7 @@ import (
 	"credo/pkg/requestcontext"
 
 	"golang.org/x/sync/errgroup"
+	"golang.org/x/sync/singleflight"
 )
 
 // Tracer for distributed tracing of registry operations.
@@ -40,6 +43,12 @@ var registryTracer = otel.Tracer("credo/registry")
 // Consent is checked atomically within service methods to prevent TOCTOU races between
 // consent verification and the actual lookup operation.
 //
+// Citizen not-found results can be cached negatively (WithNegativeCache) on a shorter TTL,
+// so lookups for identities no registry holds stop reaching the providers.
+//
+// Concurrent identical provider lookups are coalesced so a burst for the same national ID
+// results in one provider call whose result (or error) every caller shares.
+//
 // Distributed tracing is supported via OpenTelemetry. The service emits spans for
 // registry.check (parent), registry.citizen, and registry.sanctions operations
 // with cache hit/miss annotations.
@@ -52,7 +61,12 @@ type Service struct {
 	consentPort  ports.ConsentPort
 	auditor      *compliance.Publisher
 	regulated    bool
+	minimization citizen.MinimizationPolicy
 	logger       *slog.Logger
+	inflight     singleflight.Group
+	warm         WarmConfig
+	batch        BatchConfig
+	negative     NegativeCache
 }
 
 // CacheStore defines the interface for registry caching operations.
@@ -91,6 +105,14 @@ func WithAuditor(auditor *compliance.Publisher) Option {
 	}
 }
 
+// WithMinimizationPolicy sets what regulated mode keeps of citizen personal
+// details. Without it every detail is cleared.
+func WithMinimizationPolicy(policy citizen.MinimizationPolicy) Option {
+	return func(s *Service) {
+		s.minimization = policy
+	}
+}
+
 // New creates a new registry service using the orchestrator pattern.
 // The consentPort enables atomic consent verification within service methods.
 func New(orch *orchestrator.Orchestrator, cache CacheStore, consentPort ports.ConsentPort, regulated bool, opts ...Option) *Service {
@@ -135,6 +157,12 @@ func (s *Service) Check(ctx context.Context, userID id.UserID, nationalID id.Nat
 		return nil, err
 	}
 
+	return s.checkIdentity(ctx, span, nationalID)
+}
+
+// checkIdentity runs phases 2-4 of Check for one national ID once consent has
+// been verified, annotating span with cache hit/miss attributes.
+func (s *Service) checkIdentity(ctx context.Context, span trace.Span, nationalID id.NationalID) (*models.RegistryResult, error) {
 	// Phase 2: Check cache with tracing
 	cached, err := s.checkCache(ctx, nationalID)
 	if err != nil {
@@ -148,6 +176,10 @@ func (s *Service) Check(ctx context.Context, userID id.UserID, nationalID id.Nat
 	if cached.AllCached() {
 		return &models.RegistryResult{Citizen: cached.citizen, Sanction: cached.sanction}, nil
 	}
+	if !cached.citizenCached && s.knownNotFound(ctx, nationalID) {
+		span.SetAttributes(attribute.Bool("cache.citizen.negative_hit", true))
+		return nil, errCitizenNotFound()
+	}
 
 	// Phase 3: Fetch missing from orchestrator
 	fetchResult, err := s.fetchMissing(ctx, nationalID, cached.citizenCached, cached.sanctionsCached)
@@ -173,8 +205,7 @@ func (s *Service) Check(ctx context.Context, userID id.UserID, nationalID id.Nat
 
 	// Validate we got all required evidence
 	if citizen == nil || sanction == nil {
-		err = s.translateOrchestratorError(providers.ErrAllProvidersFailed, fetchResult)
-		return nil, err
+		return nil, s.translateOrchestratorError(providers.ErrAllProvidersFailed, fetchResult)
 	}
 
 	// Phase 4: Atomic cache commit - only cache if both lookups succeeded
@@ -274,19 +305,61 @@ func (s *Service) fetchMissing(ctx context.Context, nationalID id.NationalID, ci
 		typesToFetch = append(typesToFetch, providers.ProviderTypeSanctions)
 	}
 
-	result, err := s.orchestrator.Lookup(ctx, orchestrator.LookupRequest{
-		Types:    typesToFetch,
-		Filters:  map[string]string{"national_id": nationalID.String()},
-		Strategy: orchestrator.StrategyFallback,
-	})
+	result, err := s.lookup(ctx, s.recordShape(), nationalID, typesToFetch)
 	if err != nil {
 		return nil, s.translateOrchestratorError(err, result)
 	}
 	return result, nil
 }
 
+// Record shapes partition coalesced lookups so a caller never receives a
+// result produced for a different minimization policy.
+const (
+	shapeFull      = "full"
+	shapeMinimized = "minimized"
+)
+
+// recordShape returns the shape this service returns from its public lookups.
+func (s *Service) recordShape() string {
+	if s.regulated {
+		return shapeMinimized
+	}
+	return shapeFull
+}
+
+// lookup queries the orchestrator, coalescing concurrent identical lookups into
+// one provider call. Followers share the leader's result and error.
+//
+// The shared call runs detached from the leader's cancellation so one caller
+// giving up does not fail the others; the orchestrator's timeout still bounds it.
+// Each caller stops waiting when its own context is done.
+func (s *Service) lookup(ctx context.Context, shape string, nationalID id.NationalID, types []providers.ProviderType) (*orchestrator.LookupResult, error) {
+	names := make([]string, len(types))
+	for i, t := range types {
+		names[i] = string(t)
+	}
+	key := shape + "|" + strings.Join(names, ",") + "|" + nationalID.String()
+
+	ch := s.inflight.DoChan(key, func() (any, error) {
+		return s.orchestrator.Lookup(context.WithoutCancel(ctx), orchestrator.LookupRequest{
+			Types:     types,
+			Filters:   map[string]string{"national_id": nationalID.String()},
+			Strategy:  orchestrator.StrategyFallback,
+			Regulated: s.regulated,
+		})
+	})
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case res := <-ch:
+		result, _ := res.Val.(*orchestrator.LookupResult)
+		return result, res.Err
+	}
+}
+
 // convertEvidence transforms orchestrator evidence into domain models via domain aggregates.
-// Applies regulated mode minimization using the domain aggregate's Minimized() method.
+// Applies regulated mode minimization under the service's MinimizationPolicy.
 //
 // Flow: providers.Evidence → domain aggregate (validates invariants) → models.*Record
 func (s *Service) convertEvidence(result *orchestrator.LookupResult) (*models.CitizenRecord, *models.SanctionsRecord, error) {
@@ -319,7 +392,7 @@ func (s *Service) citizenRecordFromEvidence(ev *providers.Evidence) (*models.Cit
 		return nil, dErrors.Wrap(err, dErrors.CodeInternal, "failed to convert citizen evidence")
 	}
 	if s.regulated {
-		verification = verification.WithoutNationalID()
+		verification = verification.WithoutNationalIDUnder(s.minimization)
 	}
 	return CitizenVerificationToRecord(verification), nil
 }
@@ -399,16 +472,17 @@ func (s *Service) Citizen(ctx context.Context, userID id.UserID, nationalID id.N
 		}
 	}
 	span.SetAttributes(attribute.Bool("cache.hit", false))
+	if s.knownNotFound(ctx, nationalID) {
+		span.SetAttributes(attribute.Bool("cache.negative_hit", true))
+		err = errCitizenNotFound()
+		return nil, err
+	}
 
-	result, err := s.orchestrator.Lookup(ctx, orchestrator.LookupRequest{
-		Types: []providers.ProviderType{providers.ProviderTypeCitizen},
-		Filters: map[string]string{
-			"national_id": nationalID.String(),
-		},
-		Strategy: orchestrator.StrategyFallback,
-	})
+	result, err := s.lookup(ctx, s.recordShape(), nationalID, []providers.ProviderType{providers.ProviderTypeCitizen})
 	if err != nil {
-		return nil, s.translateOrchestratorError(err, result)
+		err = s.translateOrchestratorError(err, result)
+		s.rememberNotFound(ctx, nationalID, err)
+		return nil, err
 	}
 
 	// Find citizen evidence and convert via domain aggregate
@@ -424,6 +498,7 @@ func (s *Service) Citizen(ctx context.Context, userID id.UserID, nationalID id.N
 
 	if record == nil {
 		err = s.translateOrchestratorError(providers.ErrAllProvidersFailed, result)
+		s.rememberNotFound(ctx, nationalID, err)
 		return nil, err
 	}
 
@@ -462,17 +537,18 @@ func (s *Service) CitizenWithDetails(ctx context.Context, userID id.UserID, nati
 		return nil, err
 	}
 
-	// No cache for internal calls - prevents unminimized PII in shared cache
+	// No positive cache for internal calls - prevents unminimized PII in shared cache.
+	// Negative entries hold no PII, so they are shared.
+	if s.knownNotFound(ctx, nationalID) {
+		err = errCitizenNotFound()
+		return nil, err
+	}
 
-	result, err := s.orchestrator.Lookup(ctx, orchestrator.LookupRequest{
-		Types: []providers.ProviderType{providers.ProviderTypeCitizen},
-		Filters: map[string]string{
-			"national_id": nationalID.String(),
-		},
-		Strategy: orchestrator.StrategyFallback,
-	})
+	result, err := s.lookup(ctx, shapeFull, nationalID, []providers.ProviderType{providers.ProviderTypeCitizen})
 	if err != nil {
-		return nil, s.translateOrchestratorError(err, result)
+		err = s.translateOrchestratorError(err, result)
+		s.rememberNotFound(ctx, nationalID, err)
+		return nil, err
 	}
 
 	// Find citizen evidence and convert via domain aggregate - NO minimization
@@ -491,6 +567,7 @@ func (s *Service) CitizenWithDetails(ctx context.Context, userID id.UserID, nati
 
 	if record == nil {
 		err = s.translateOrchestratorError(providers.ErrAllProvidersFailed, result)
+		s.rememberNotFound(ctx, nationalID, err)
 		return nil, err
 	}
 
@@ -539,13 +616,7 @@ func (s *Service) Sanctions(ctx context.Context, userID id.UserID, nationalID id
 	}
 	span.SetAttributes(attribute.Bool("cache.hit", false))
 
-	result, err := s.orchestrator.Lookup(ctx, orchestrator.LookupRequest{
-		Types: []providers.ProviderType{providers.ProviderTypeSanctions},
-		Filters: map[string]string{
-			"national_id": nationalID.String(),
-		},
-		Strategy: orchestrator.StrategyFallback,
-	})
+	result, err := s.lookup(ctx, s.recordShape(), nationalID, []providers.ProviderType{providers.ProviderTypeSanctions})
 	if err != nil {
 		return nil, s.translateOrchestratorError(err, result)
 	}
@@ -666,7 +737,7 @@ func (s *Service) translateProviderError(err error) error {
 		case providers.ErrorTimeout:
 			return dErrors.New(dErrors.CodeTimeout, "registry lookup timed out")
 		case providers.ErrorNotFound:
-			return dErrors.New(dErrors.CodeNotFound, "citizen record not found")
+			return errCitizenNotFound()
 		case providers.ErrorAuthentication:
 			return dErrors.New(dErrors.CodeInternal, "registry authentication failed")
 		case providers.ErrorRateLimited:
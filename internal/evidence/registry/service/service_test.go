@@ -3,11 +3,14 @@ package service
 import (
 	"context"
 	"errors"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/suite"
 
+	"credo/internal/evidence/registry/domain/citizen"
 	"credo/internal/evidence/registry/models"
 	"credo/internal/evidence/registry/orchestrator"
 	"credo/internal/evidence/registry/providers"
@@ -98,7 +101,7 @@ type stubProvider struct {
 	id       string
 	provType providers.ProviderType
 	lookupFn func(ctx context.Context, filters map[string]string) (*providers.Evidence, error)
-	called   bool
+	calls    atomic.Int32
 }
 
 func (p *stubProvider) ID() string { return p.id }
@@ -111,7 +114,7 @@ func (p *stubProvider) Capabilities() providers.Capabilities {
 }
 
 func (p *stubProvider) Lookup(ctx context.Context, filters map[string]string) (*providers.Evidence, error) {
-	p.called = true
+	p.calls.Add(1)
 	if p.lookupFn != nil {
 		return p.lookupFn(ctx, filters)
 	}
@@ -337,7 +340,7 @@ func (s *ServiceSuite) TestCheckTransactionSemantics() {
 		s.Equal(sanctionsRecord.NationalID, result.Sanction.NationalID)
 
 		// Citizen provider should NOT be called (cache hit)
-		s.False(citizenProv.called)
+		s.Zero(citizenProv.calls.Load())
 
 		// Only sanctions should be cached (citizen was already cached)
 		s.Len(cache.saveCitizenCalls, 0)
@@ -372,7 +375,7 @@ func (s *ServiceSuite) TestCheckTransactionSemantics() {
 		s.Equal(sanctionsRecord, result.Sanction)
 
 		// Sanctions provider should NOT be called (cache hit)
-		s.False(sanctionsProv.called)
+		s.Zero(sanctionsProv.calls.Load())
 
 		// Only citizen should be cached (sanctions was already cached)
 		s.Len(cache.saveCitizenCalls, 1)
@@ -408,8 +411,8 @@ func (s *ServiceSuite) TestCheckTransactionSemantics() {
 		s.Equal(sanctionsRecord, result.Sanction)
 
 		// No provider calls expected (both cached)
-		s.False(citizenProv.called)
-		s.False(sanctionsProv.called)
+		s.Zero(citizenProv.calls.Load())
+		s.Zero(sanctionsProv.calls.Load())
 
 		// No cache saves expected (both already cached)
 		s.Len(cache.saveCitizenCalls, 0)
@@ -531,6 +534,34 @@ func (s *ServiceSuite) TestCitizenMinimization() {
 		s.Equal("123 Test St", result.Address)
 		s.True(result.Valid)
 	})
+
+	s.Run("coarsening policy keeps birth year and city in regulated mode", func() {
+		cache := newStubCache()
+		withCity := *citizenRecord
+		withCity.Address = "123 Test St, Springfield, IL 62701"
+		citizenProv := &stubProvider{
+			id:       "test-citizen",
+			provType: providers.ProviderTypeCitizen,
+			lookupFn: func(_ context.Context, _ map[string]string) (*providers.Evidence, error) {
+				return citizenEvidence(&withCity), nil
+			},
+		}
+
+		svc := New(newTestOrchestrator(citizenProv, nil), cache, nil, true,
+			WithMinimizationPolicy(citizen.MinimizationPolicy{DateOfBirth: citizen.RuleYear, Address: citizen.RuleCity}))
+
+		result, err := svc.Citizen(ctx, userID, nationalID)
+		s.Require().NoError(err)
+
+		s.Equal("", result.NationalID)
+		s.Equal("", result.FullName)
+		s.Equal("", result.DateOfBirth)
+		s.Equal("", result.Address)
+		s.Equal("1990", result.BirthYear)
+		s.Equal("Springfield", result.City)
+		s.False(result.HasPII(), "coarse details may enter the regulated cache")
+		s.True(result.Valid)
+	})
 }
 
 func (s *ServiceSuite) TestCitizenWithDetailsNoMinimizationNoCache() {
@@ -574,6 +605,128 @@ func (s *ServiceSuite) TestCitizenWithDetailsNoMinimizationNoCache() {
 	s.Len(cache.saveCitizenCalls, 0)
 }
 
+// TestConcurrentLookupsCoalesce verifies that a burst of identical lookups
+// reaches the provider once and every caller shares the outcome.
+func (s *ServiceSuite) TestConcurrentLookupsCoalesce() {
+	const callers = 20
+	ctx := context.Background()
+	nationalIDStr := "ABC123456"
+	nationalID := testNationalID(nationalIDStr)
+	userID := testUserID()
+
+	citizenRecord := &models.CitizenRecord{
+		NationalID:  nationalIDStr,
+		FullName:    "Test User",
+		DateOfBirth: "1990-01-01",
+		Address:     "123 Test St",
+		Valid:       true,
+		CheckedAt:   time.Now(),
+	}
+
+	// gatedProvider blocks every lookup until release is closed so callers overlap.
+	gatedProvider := func(release <-chan struct{}, evidence *providers.Evidence, err error) *stubProvider {
+		return &stubProvider{
+			id:       "test-citizen",
+			provType: providers.ProviderTypeCitizen,
+			lookupFn: func(_ context.Context, _ map[string]string) (*providers.Evidence, error) {
+				<-release
+				return evidence, err
+			},
+		}
+	}
+
+	// burst runs lookup from n goroutines, releasing the provider once the
+	// leader is inside it and the followers have had time to join.
+	burst := func(prov *stubProvider, release chan struct{}, n int, lookup func() error) []error {
+		errs := make([]error, n)
+		var wg sync.WaitGroup
+		for i := range n {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				errs[i] = lookup()
+			}()
+		}
+		s.Eventually(func() bool { return prov.calls.Load() >= 1 }, time.Second, time.Millisecond)
+		time.Sleep(50 * time.Millisecond)
+		close(release)
+		wg.Wait()
+		return errs
+	}
+
+	s.Run("identical lookups share one provider call", func() {
+		release := make(chan struct{})
+		prov := gatedProvider(release, citizenEvidence(citizenRecord), nil)
+		svc := New(newTestOrchestrator(prov, nil), nil, nil, false)
+
+		var mu sync.Mutex
+		var records []*models.CitizenRecord
+		errs := burst(prov, release, callers, func() error {
+			record, err := svc.Citizen(ctx, userID, nationalID)
+			mu.Lock()
+			records = append(records, record)
+			mu.Unlock()
+			return err
+		})
+
+		s.Equal(int32(1), prov.calls.Load())
+		for _, err := range errs {
+			s.NoError(err)
+		}
+		s.Require().Len(records, callers)
+		for _, record := range records {
+			s.Equal("Test User", record.FullName)
+		}
+	})
+
+	s.Run("errors are shared with every caller", func() {
+		release := make(chan struct{})
+		prov := gatedProvider(release, nil, providers.NewProviderError(
+			providers.ErrorNotFound, "test-citizen", "not found", nil,
+		))
+		svc := New(newTestOrchestrator(prov, nil), nil, nil, false)
+
+		errs := burst(prov, release, callers, func() error {
+			_, err := svc.Citizen(ctx, userID, nationalID)
+			return err
+		})
+
+		s.Equal(int32(1), prov.calls.Load())
+		for _, err := range errs {
+			s.True(dErrors.HasCode(err, dErrors.CodeNotFound))
+		}
+	})
+
+	s.Run("minimized and full lookups do not coalesce", func() {
+		release := make(chan struct{})
+		prov := gatedProvider(release, citizenEvidence(citizenRecord), nil)
+		svc := New(newTestOrchestrator(prov, nil), nil, nil, true) // regulated
+
+		var minimized, full *models.CitizenRecord
+		var minErr, fullErr error
+		var wg sync.WaitGroup
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			minimized, minErr = svc.Citizen(ctx, userID, nationalID)
+		}()
+		go func() {
+			defer wg.Done()
+			full, fullErr = svc.CitizenWithDetails(ctx, userID, nationalID)
+		}()
+
+		// Both must reach the provider while the other is still in flight
+		s.Eventually(func() bool { return prov.calls.Load() == 2 }, time.Second, time.Millisecond)
+		close(release)
+		wg.Wait()
+
+		s.Require().NoError(minErr)
+		s.Require().NoError(fullErr)
+		s.Empty(minimized.FullName)
+		s.Equal("Test User", full.FullName)
+	})
+}
+
 func (s *ServiceSuite) TestCacheSaveErrorsDoNotFail() {
 	ctx := context.Background()
 	nationalIDStr := "ABC123456"
@@ -740,6 +893,10 @@ func (f *failingAuditStore) ListRecent(_ context.Context, _ int) ([]audit.Event,
 	return nil, f.err
 }
 
+func (f *failingAuditStore) ListByRequestID(_ context.Context, _ string) ([]audit.Event, error) {
+	return nil, f.err
+}
+
 // newFailingAuditor creates a compliance publisher that will fail on emit.
 func newFailingAuditor(err error) *compliance.Publisher {
 	return compliance.New(&failingAuditStore{err: err})
@@ -0,0 +1,241 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+
+	"credo/internal/evidence/registry/domain/shared"
+	"credo/internal/evidence/registry/models"
+	"credo/internal/evidence/registry/providers"
+	"credo/internal/evidence/registry/store"
+	id "credo/pkg/domain"
+	dErrors "credo/pkg/domain-errors"
+)
+
+// Warm-up defaults keep a cold-start preload from competing with live traffic.
+const (
+	DefaultWarmConcurrency = 4
+	DefaultWarmInterval    = 100 * time.Millisecond
+)
+
+// WarmConfig bounds the provider load generated by Warm.
+type WarmConfig struct {
+	Concurrency int           // Maximum lookups in flight (default: DefaultWarmConcurrency)
+	Interval    time.Duration // Minimum spacing between provider lookups (default: DefaultWarmInterval)
+	MaxLookups  int           // Provider lookup budget per run; 0 means one per identity
+}
+
+// WithWarmConfig overrides the warm-up concurrency, pacing and budget.
+func WithWarmConfig(cfg WarmConfig) Option {
+	return func(s *Service) {
+		s.warm = cfg
+	}
+}
+
+// WarmResult summarizes a warm-up run.
+type WarmResult struct {
+	Warmed        int // Fetched from providers and cached
+	AlreadyCached int // Both records were already cached
+	Failed        int // Lookup or conversion failed; left for live traffic
+	Skipped       int // Not attempted: lookup budget spent, provider rate limited or cancelled
+}
+
+// Warm pre-populates the cache for frequently-checked identities, typically from
+// a startup job after a deploy so the first live requests do not pay full
+// provider latency.
+//
+// Lookups run with bounded concurrency, are paced by the configured interval and
+// stop once the lookup budget is spent. A provider rate-limit response stops the
+// run early: the remaining identities are skipped rather than hammering a
+// throttled provider. Records are cached exactly as Check would cache them, so
+// regulated mode minimization applies.
+//
+// Warm performs no consent check or sanctions audit because no data subject
+// request is being served; callers must only warm identities that are already
+// being checked under a lawful basis. Individual failures are logged and counted,
+// not returned; the error is non-nil only when ctx is cancelled.
+func (s *Service) Warm(ctx context.Context, nationalIDs []id.NationalID) (*WarmResult, error) {
+	if s.cache == nil {
+		return nil, dErrors.New(dErrors.CodeInternal, "registry cache not configured")
+	}
+
+	cfg := s.warmConfig(len(nationalIDs))
+	ticker := time.NewTicker(cfg.Interval)
+	defer ticker.Stop()
+
+	runCtx, stop := context.WithCancel(ctx)
+	defer stop()
+
+	var (
+		mu      sync.Mutex
+		result  WarmResult
+		lookups atomic.Int64
+	)
+	count := func(field *int) {
+		mu.Lock()
+		*field++
+		mu.Unlock()
+	}
+
+	group := new(errgroup.Group)
+	group.SetLimit(cfg.Concurrency)
+	for _, nationalID := range nationalIDs {
+		group.Go(func() error {
+			if runCtx.Err() != nil {
+				count(&result.Skipped)
+				return nil
+			}
+
+			cached, err := s.checkCache(runCtx, nationalID)
+			if err != nil {
+				s.logWarmFailure(runCtx, nationalID, err)
+				count(&result.Failed)
+				return nil
+			}
+			if cached.AllCached() {
+				count(&result.AlreadyCached)
+				return nil
+			}
+
+			if lookups.Add(1) > int64(cfg.MaxLookups) {
+				count(&result.Skipped)
+				return nil
+			}
+			select {
+			case <-runCtx.Done():
+				count(&result.Skipped)
+				return nil
+			case <-ticker.C:
+			}
+
+			rateLimited, err := s.warmOne(runCtx, nationalID, cached)
+			if rateLimited {
+				stop()
+			}
+			if err != nil {
+				s.logWarmFailure(runCtx, nationalID, err)
+				count(&result.Failed)
+				return nil
+			}
+			count(&result.Warmed)
+			return nil
+		})
+	}
+	_ = group.Wait() //nolint:errcheck // workers never return errors
+
+	if err := ctx.Err(); err != nil {
+		return &result, err
+	}
+	return &result, nil
+}
+
+// CitizenWarmer is implemented by caches that can store many citizen records in
+// one round trip, such as store.PostgresCache.
+type CitizenWarmer interface {
+	WarmCitizen(ctx context.Context, entries []store.CitizenEntry, regulated bool) error
+}
+
+// WarmFromRecords pre-populates the citizen cache from records the caller already
+// holds, such as an export of hot identities, without any provider lookup. Each
+// record is keyed by its NationalID and validated through the domain aggregate;
+// in regulated mode it is minimized before caching, exactly as Check would.
+//
+// Caches implementing CitizenWarmer store the whole set in one call; others get
+// one SaveCitizen per record. Like Warm, it performs no consent check. The first
+// invalid record fails the call before anything is cached.
+func (s *Service) WarmFromRecords(ctx context.Context, records []*models.CitizenRecord) error {
+	if s.cache == nil {
+		return dErrors.New(dErrors.CodeInternal, "registry cache not configured")
+	}
+
+	entries := make([]store.CitizenEntry, 0, len(records))
+	for _, record := range records {
+		if record == nil {
+			return dErrors.New(dErrors.CodeValidation, "citizen record is required")
+		}
+		key, err := id.ParseNationalID(record.NationalID)
+		if err != nil {
+			return dErrors.Wrap(err, dErrors.CodeValidation, "invalid citizen record national_id")
+		}
+		verification, err := CitizenRecordToVerification(key, record, shared.Authoritative())
+		if err != nil {
+			return err
+		}
+		if s.regulated {
+			verification = verification.WithoutNationalIDUnder(s.minimization)
+		}
+		entries = append(entries, store.CitizenEntry{Key: key, Record: CitizenVerificationToRecord(verification)})
+	}
+
+	if warmer, ok := s.cache.(CitizenWarmer); ok {
+		return warmer.WarmCitizen(ctx, entries, s.regulated)
+	}
+	for _, entry := range entries {
+		if err := s.cache.SaveCitizen(ctx, entry.Key, entry.Record, s.regulated); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// warmOne fetches and caches the records missing for nationalID, reporting
+// whether any provider rate-limited the lookup.
+func (s *Service) warmOne(ctx context.Context, nationalID id.NationalID, cached cacheCheckResult) (rateLimited bool, err error) {
+	fetchResult, err := s.fetchMissing(ctx, nationalID, cached.citizenCached, cached.sanctionsCached)
+	if fetchResult != nil {
+		for _, provErr := range fetchResult.Errors {
+			if providers.GetCategory(provErr) == providers.ErrorRateLimited {
+				rateLimited = true
+			}
+		}
+	}
+	if err != nil {
+		return rateLimited, err
+	}
+
+	citizen, sanction, err := s.convertEvidence(fetchResult)
+	if err != nil {
+		return rateLimited, err
+	}
+	if cached.citizenCached {
+		citizen = cached.citizen
+	}
+	if cached.sanctionsCached {
+		sanction = cached.sanction
+	}
+	if citizen == nil || sanction == nil {
+		return rateLimited, s.translateOrchestratorError(providers.ErrAllProvidersFailed, fetchResult)
+	}
+
+	s.cacheNewlyFetched(ctx, nationalID, citizen, sanction, cached)
+	return rateLimited, nil
+}
+
+// warmConfig applies defaults to the configured warm-up bounds.
+func (s *Service) warmConfig(identities int) WarmConfig {
+	cfg := s.warm
+	if cfg.Concurrency <= 0 {
+		cfg.Concurrency = DefaultWarmConcurrency
+	}
+	if cfg.Interval <= 0 {
+		cfg.Interval = DefaultWarmInterval
+	}
+	if cfg.MaxLookups <= 0 {
+		cfg.MaxLookups = identities
+	}
+	return cfg
+}
+
+func (s *Service) logWarmFailure(ctx context.Context, nationalID id.NationalID, err error) {
+	if s.logger == nil {
+		return
+	}
+	s.logger.WarnContext(ctx, "registry cache warm-up lookup failed",
+		"national_id", hashNationalID(nationalID.String()),
+		"error", err,
+	)
+}
@@ -0,0 +1,183 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"credo/internal/evidence/registry/models"
+	"credo/internal/evidence/registry/providers"
+	"credo/internal/evidence/registry/store"
+	id "credo/pkg/domain"
+)
+
+func (s *ServiceSuite) TestWarm() {
+	ctx := context.Background()
+	userID := testUserID()
+	nationalIDs := []id.NationalID{
+		testNationalID("WARM00001"),
+		testNationalID("WARM00002"),
+		testNationalID("WARM00003"),
+	}
+
+	// newProviders echoes the requested national ID so each identity gets its own record.
+	newProviders := func() (*stubProvider, *stubProvider) {
+		citizenProv := &stubProvider{
+			id:       "test-citizen",
+			provType: providers.ProviderTypeCitizen,
+			lookupFn: func(_ context.Context, filters map[string]string) (*providers.Evidence, error) {
+				return citizenEvidence(&models.CitizenRecord{
+					NationalID:  filters["national_id"],
+					FullName:    "Test User",
+					DateOfBirth: "1990-01-01",
+					Valid:       true,
+					CheckedAt:   time.Now(),
+				}), nil
+			},
+		}
+		sanctionsProv := &stubProvider{
+			id:       "test-sanctions",
+			provType: providers.ProviderTypeSanctions,
+			lookupFn: func(_ context.Context, filters map[string]string) (*providers.Evidence, error) {
+				return sanctionsEvidence(&models.SanctionsRecord{
+					NationalID: filters["national_id"],
+					Source:     "test-source",
+					CheckedAt:  time.Now(),
+				}), nil
+			},
+		}
+		return citizenProv, sanctionsProv
+	}
+	// Warm-up writes to the cache concurrently, so these cases use the
+	// thread-safe in-memory cache rather than stubCache.
+	fastWarm := WithWarmConfig(WarmConfig{Concurrency: 2, Interval: time.Millisecond})
+
+	s.Run("warmed identities are served from cache", func() {
+		cache := store.NewInMemoryCache(time.Hour)
+		citizenProv, sanctionsProv := newProviders()
+		svc := New(newTestOrchestrator(citizenProv, sanctionsProv), cache, nil, false, fastWarm)
+
+		result, err := svc.Warm(ctx, nationalIDs)
+		s.Require().NoError(err)
+		s.Equal(&WarmResult{Warmed: len(nationalIDs)}, result)
+
+		citizenCalls, sanctionsCalls := citizenProv.calls.Load(), sanctionsProv.calls.Load()
+		for _, nationalID := range nationalIDs {
+			record, err := svc.Check(ctx, userID, nationalID)
+			s.Require().NoError(err)
+			s.Equal(nationalID.String(), record.Citizen.NationalID)
+		}
+		s.Equal(citizenCalls, citizenProv.calls.Load(), "lookups after warm-up must be cache hits")
+		s.Equal(sanctionsCalls, sanctionsProv.calls.Load(), "lookups after warm-up must be cache hits")
+	})
+
+	s.Run("already cached identities are not fetched again", func() {
+		cache := store.NewInMemoryCache(time.Hour)
+		citizenProv, sanctionsProv := newProviders()
+		svc := New(newTestOrchestrator(citizenProv, sanctionsProv), cache, nil, false, fastWarm)
+
+		_, err := svc.Warm(ctx, nationalIDs)
+		s.Require().NoError(err)
+		calls := citizenProv.calls.Load()
+
+		result, err := svc.Warm(ctx, nationalIDs)
+		s.Require().NoError(err)
+		s.Equal(&WarmResult{AlreadyCached: len(nationalIDs)}, result)
+		s.Equal(calls, citizenProv.calls.Load())
+	})
+
+	s.Run("stops at the lookup budget", func() {
+		cache := store.NewInMemoryCache(time.Hour)
+		citizenProv, sanctionsProv := newProviders()
+		svc := New(newTestOrchestrator(citizenProv, sanctionsProv), cache, nil, false,
+			WithWarmConfig(WarmConfig{Concurrency: 1, Interval: time.Millisecond, MaxLookups: 1}))
+
+		result, err := svc.Warm(ctx, nationalIDs)
+		s.Require().NoError(err)
+		s.Equal(&WarmResult{Warmed: 1, Skipped: 2}, result)
+		s.Equal(int32(1), citizenProv.calls.Load())
+	})
+
+	s.Run("stops when a provider rate limits", func() {
+		cache := store.NewInMemoryCache(time.Hour)
+		citizenProv, sanctionsProv := newProviders()
+		citizenProv.lookupFn = func(_ context.Context, _ map[string]string) (*providers.Evidence, error) {
+			return nil, providers.NewProviderError(providers.ErrorRateLimited, "test-citizen", "slow down", nil)
+		}
+		svc := New(newTestOrchestrator(citizenProv, sanctionsProv), cache, nil, false,
+			WithWarmConfig(WarmConfig{Concurrency: 1, Interval: time.Millisecond}))
+
+		result, err := svc.Warm(ctx, nationalIDs)
+		s.Require().NoError(err)
+		s.Equal(&WarmResult{Failed: 1, Skipped: 2}, result)
+		citizens, _ := cache.Size()
+		s.Zero(citizens)
+	})
+
+	s.Run("requires a cache", func() {
+		citizenProv, sanctionsProv := newProviders()
+		svc := New(newTestOrchestrator(citizenProv, sanctionsProv), nil, nil, false)
+
+		_, err := svc.Warm(ctx, nationalIDs)
+		s.Error(err)
+	})
+}
+
+// bulkCache records WarmCitizen calls on top of the in-memory cache.
+type bulkCache struct {
+	*store.InMemoryCache
+	calls int
+}
+
+func (c *bulkCache) WarmCitizen(ctx context.Context, entries []store.CitizenEntry, regulated bool) error {
+	c.calls++
+	for _, entry := range entries {
+		if err := c.SaveCitizen(ctx, entry.Key, entry.Record, regulated); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *ServiceSuite) TestWarmFromRecords() {
+	ctx := context.Background()
+	newRecords := func() []*models.CitizenRecord {
+		return []*models.CitizenRecord{
+			{NationalID: "PRELOAD01", FullName: "Test User", DateOfBirth: "1990-01-01", Address: "1 Main St, Springfield", Valid: true, Source: "test-citizen", CheckedAt: time.Now()},
+			{NationalID: "PRELOAD02", FullName: "Other User", DateOfBirth: "1985-05-15", Valid: true, Source: "test-citizen", CheckedAt: time.Now()},
+		}
+	}
+
+	s.Run("caches every record in one bulk call without provider lookups", func() {
+		citizenProv := &stubProvider{id: "test-citizen", provType: providers.ProviderTypeCitizen}
+		cache := &bulkCache{InMemoryCache: store.NewInMemoryCache(time.Hour)}
+		svc := New(newTestOrchestrator(citizenProv, nil), cache, nil, false)
+
+		s.Require().NoError(svc.WarmFromRecords(ctx, newRecords()))
+		s.Equal(1, cache.calls)
+		s.Zero(citizenProv.calls.Load())
+		found, err := cache.FindCitizen(ctx, testNationalID("PRELOAD02"), false)
+		s.Require().NoError(err)
+		s.Equal("Other User", found.FullName)
+	})
+
+	s.Run("regulated mode caches minimized records", func() {
+		cache := store.NewInMemoryCache(time.Hour)
+		svc := New(newTestOrchestrator(nil, nil), cache, nil, true)
+
+		s.Require().NoError(svc.WarmFromRecords(ctx, newRecords()))
+		found, err := cache.FindCitizen(ctx, testNationalID("PRELOAD01"), true)
+		s.Require().NoError(err)
+		s.False(found.HasPII())
+		s.True(found.Valid)
+	})
+
+	s.Run("an invalid record caches nothing", func() {
+		cache := store.NewInMemoryCache(time.Hour)
+		svc := New(newTestOrchestrator(nil, nil), cache, nil, false)
+		records := append(newRecords(), &models.CitizenRecord{NationalID: "PRELOAD03", Valid: true, CheckedAt: time.Now()})
+
+		s.Error(svc.WarmFromRecords(ctx, records), "a record without a source is rejected")
+		citizens, _ := cache.Size()
+		s.Zero(citizens)
+	})
+}
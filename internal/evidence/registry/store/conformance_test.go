@@ -0,0 +1,17 @@
+package store_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"credo/internal/evidence/registry/store"
+	"credo/internal/evidence/registry/store/storetest"
+)
+
+func TestInMemoryCacheConformance(t *testing.T) {
+	suite.Run(t, &storetest.CacheSuite{
+		NewCache: func(ttl time.Duration) storetest.Cache { return store.NewInMemoryCache(ttl) },
+	})
+}
@@ -0,0 +1,98 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	id "credo/pkg/domain"
+)
+
+// InMemoryNegativeCache remembers national IDs that no citizen registry knows,
+// so repeated lookups for them skip the providers until the entry expires.
+//
+// Negative entries live apart from positive records: a not-found marker can never
+// be mistaken for a cached record. They use their own, shorter TTL because a
+// missing identity may be registered at any time, and an expired entry is never
+// served. At capacity, expired entries are purged first and then the oldest
+// entry is dropped. That scan is O(n), unlike the positive LRU, which is
+// acceptable because it only runs when full and a dropped entry costs one
+// extra provider call.
+type InMemoryNegativeCache struct {
+	mu      sync.Mutex
+	entries map[string]time.Time // key -> storedAt
+	ttl     time.Duration
+	maxSize int
+}
+
+// NewInMemoryNegativeCache creates a negative cache whose entries expire after ttl.
+func NewInMemoryNegativeCache(ttl time.Duration) *InMemoryNegativeCache {
+	return &InMemoryNegativeCache{
+		entries: make(map[string]time.Time),
+		ttl:     ttl,
+		maxSize: DefaultMaxCacheSize,
+	}
+}
+
+// SaveNotFound records that no registry holds a citizen for key.
+func (c *InMemoryNegativeCache) SaveNotFound(_ context.Context, key id.NationalID) error {
+	if key.IsNil() {
+		return errors.New("cannot cache not-found entry with nil key")
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	keyStr := key.String()
+	if _, ok := c.entries[keyStr]; !ok && len(c.entries) >= c.maxSize {
+		c.evictLocked()
+	}
+	c.entries[keyStr] = time.Now()
+	return nil
+}
+
+// IsNotFound reports whether nationalID has an unexpired not-found entry.
+// Expired entries are removed on access.
+func (c *InMemoryNegativeCache) IsNotFound(_ context.Context, nationalID id.NationalID) (bool, error) {
+	keyStr := nationalID.String()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	storedAt, ok := c.entries[keyStr]
+	if !ok {
+		return false, nil
+	}
+	if time.Since(storedAt) >= c.ttl {
+		delete(c.entries, keyStr)
+		return false, nil
+	}
+	return true, nil
+}
+
+// Size returns the number of stored entries, including any not yet lazily expired.
+func (c *InMemoryNegativeCache) Size() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.entries)
+}
+
+// evictLocked purges expired entries, falling back to dropping the oldest.
+// Must be called with mu held.
+func (c *InMemoryNegativeCache) evictLocked() {
+	var oldestKey string
+	var oldestAt time.Time
+	for key, storedAt := range c.entries {
+		if time.Since(storedAt) >= c.ttl {
+			delete(c.entries, key)
+			continue
+		}
+		if oldestKey == "" || storedAt.Before(oldestAt) {
+			oldestKey, oldestAt = key, storedAt
+		}
+	}
+	if len(c.entries) >= c.maxSize && oldestKey != "" {
+		delete(c.entries, oldestKey)
+	}
+}
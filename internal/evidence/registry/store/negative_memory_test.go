@@ -0,0 +1,80 @@
+package store
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"credo/internal/evidence/registry/models"
+)
+
+func TestInMemoryNegativeCache(t *testing.T) {
+	ctx := context.Background()
+	key := testNationalID("MISSING01")
+
+	t.Run("remembers not-found until the TTL", func(t *testing.T) {
+		cache := NewInMemoryNegativeCache(time.Minute)
+
+		found, err := cache.IsNotFound(ctx, key)
+		require.NoError(t, err)
+		assert.False(t, found)
+
+		require.NoError(t, cache.SaveNotFound(ctx, key))
+		found, err = cache.IsNotFound(ctx, key)
+		require.NoError(t, err)
+		assert.True(t, found)
+	})
+
+	t.Run("never serves an expired entry", func(t *testing.T) {
+		cache := NewInMemoryNegativeCache(10 * time.Millisecond)
+		start := time.Now()
+		require.NoError(t, cache.SaveNotFound(ctx, key))
+
+		require.Eventually(t, func() bool {
+			return time.Since(start) >= 15*time.Millisecond
+		}, 200*time.Millisecond, 5*time.Millisecond)
+
+		found, err := cache.IsNotFound(ctx, key)
+		require.NoError(t, err)
+		assert.False(t, found)
+		assert.Zero(t, cache.Size(), "expired entry is removed on access")
+	})
+
+	t.Run("is independent of positive records", func(t *testing.T) {
+		negative := NewInMemoryNegativeCache(time.Minute)
+		positive := NewInMemoryCache(time.Minute)
+		require.NoError(t, negative.SaveNotFound(ctx, key))
+
+		_, err := positive.FindCitizen(ctx, key, false)
+		assert.ErrorIs(t, err, ErrNotFound, "a negative entry must not surface as a record")
+
+		require.NoError(t, positive.SaveCitizen(ctx, key, &models.CitizenRecord{Valid: true}, false))
+		found, err := negative.IsNotFound(ctx, key)
+		require.NoError(t, err)
+		assert.True(t, found)
+	})
+
+	t.Run("evicts the oldest entry at capacity", func(t *testing.T) {
+		cache := NewInMemoryNegativeCache(time.Minute)
+		cache.maxSize = 2
+		first, second, third := testNationalID("MISSING01"), testNationalID("MISSING02"), testNationalID("MISSING03")
+
+		require.NoError(t, cache.SaveNotFound(ctx, first))
+		time.Sleep(time.Millisecond) // Ensure different timestamps
+		require.NoError(t, cache.SaveNotFound(ctx, second))
+		require.NoError(t, cache.SaveNotFound(ctx, third))
+
+		assert.Equal(t, 2, cache.Size())
+		found, err := cache.IsNotFound(ctx, first)
+		require.NoError(t, err)
+		assert.False(t, found)
+	})
+
+	t.Run("rejects nil key", func(t *testing.T) {
+		cache := NewInMemoryNegativeCache(time.Minute)
+		assert.Error(t, cache.SaveNotFound(ctx, testNationalID("")))
+	})
+}
@@ -8,10 +8,12 @@ package sqlc
 import (
 	"context"
 	"time"
+
+	"github.com/lib/pq"
 )
 
 const getCitizenCache = `-- name: GetCitizenCache :one
-SELECT national_id, full_name, date_of_birth, address, valid, source, checked_at, regulated
+SELECT national_id, full_name, date_of_birth, address, valid, source, checked_at, regulated, birth_year, city, completeness
 FROM citizen_cache
 WHERE national_id = $1 AND regulated = $2 AND checked_at >= $3
 `
@@ -34,6 +36,9 @@ func (q *Queries) GetCitizenCache(ctx context.Context, arg GetCitizenCacheParams
 		&i.Source,
 		&i.CheckedAt,
 		&i.Regulated,
+		&i.BirthYear,
+		&i.City,
+		&i.Completeness,
 	)
 	return i, err
 }
@@ -63,27 +68,33 @@ func (q *Queries) GetSanctionsCache(ctx context.Context, arg GetSanctionsCachePa
 
 const upsertCitizenCache = `-- name: UpsertCitizenCache :exec
 INSERT INTO citizen_cache (
-    national_id, full_name, date_of_birth, address, valid, source, checked_at, regulated
+    national_id, full_name, date_of_birth, address, valid, source, checked_at, regulated, birth_year, city, completeness
 )
-VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
 ON CONFLICT (national_id, regulated) DO UPDATE SET
     full_name = EXCLUDED.full_name,
     date_of_birth = EXCLUDED.date_of_birth,
     address = EXCLUDED.address,
     valid = EXCLUDED.valid,
     source = EXCLUDED.source,
-    checked_at = EXCLUDED.checked_at
+    checked_at = EXCLUDED.checked_at,
+    birth_year = EXCLUDED.birth_year,
+    city = EXCLUDED.city,
+    completeness = EXCLUDED.completeness
 `
 
 type UpsertCitizenCacheParams struct {
-	NationalID  string
-	FullName    string
-	DateOfBirth string
-	Address     string
-	Valid       bool
-	Source      string
-	CheckedAt   time.Time
-	Regulated   bool
+	NationalID   string
+	FullName     string
+	DateOfBirth  string
+	Address      string
+	Valid        bool
+	Source       string
+	CheckedAt    time.Time
+	Regulated    bool
+	BirthYear    string
+	City         string
+	Completeness float64
 }
 
 func (q *Queries) UpsertCitizenCache(ctx context.Context, arg UpsertCitizenCacheParams) error {
@@ -96,6 +107,63 @@ func (q *Queries) UpsertCitizenCache(ctx context.Context, arg UpsertCitizenCache
 		arg.Source,
 		arg.CheckedAt,
 		arg.Regulated,
+		arg.BirthYear,
+		arg.City,
+		arg.Completeness,
+	)
+	return err
+}
+
+const upsertCitizenCacheBatch = `-- name: UpsertCitizenCacheBatch :exec
+INSERT INTO citizen_cache (
+    national_id, full_name, date_of_birth, address, valid, source, checked_at, regulated, birth_year, city, completeness
+)
+SELECT entry.national_id, entry.full_name, entry.date_of_birth, entry.address, entry.valid, entry.source, entry.checked_at,
+    $1::boolean, entry.birth_year, entry.city, entry.completeness
+FROM unnest(
+    $2::text[], $3::text[], $4::text[], $5::text[], $6::boolean[],
+    $7::text[], $8::timestamptz[], $9::text[], $10::text[],
+    $11::double precision[]
+) AS entry(national_id, full_name, date_of_birth, address, valid, source, checked_at, birth_year, city, completeness)
+ON CONFLICT (national_id, regulated) DO UPDATE SET
+    full_name = EXCLUDED.full_name,
+    date_of_birth = EXCLUDED.date_of_birth,
+    address = EXCLUDED.address,
+    valid = EXCLUDED.valid,
+    source = EXCLUDED.source,
+    checked_at = EXCLUDED.checked_at,
+    birth_year = EXCLUDED.birth_year,
+    city = EXCLUDED.city,
+    completeness = EXCLUDED.completeness
+`
+
+type UpsertCitizenCacheBatchParams struct {
+	Regulated    bool
+	NationalIds  []string
+	FullNames    []string
+	DatesOfBirth []string
+	Addresses    []string
+	Valid        []bool
+	Sources      []string
+	CheckedAt    []time.Time
+	BirthYears   []string
+	Cities       []string
+	Completeness []float64
+}
+
+func (q *Queries) UpsertCitizenCacheBatch(ctx context.Context, arg UpsertCitizenCacheBatchParams) error {
+	_, err := q.db.ExecContext(ctx, upsertCitizenCacheBatch,
+		arg.Regulated,
+		pq.Array(arg.NationalIds),
+		pq.Array(arg.FullNames),
+		pq.Array(arg.DatesOfBirth),
+		pq.Array(arg.Addresses),
+		pq.Array(arg.Valid),
+		pq.Array(arg.Sources),
+		pq.Array(arg.CheckedAt),
+		pq.Array(arg.BirthYears),
+		pq.Array(arg.Cities),
+		pq.Array(arg.Completeness),
 	)
 	return err
 }
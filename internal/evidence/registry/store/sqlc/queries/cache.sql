@@ -1,20 +1,45 @@
 -- name: GetCitizenCache :one
-SELECT national_id, full_name, date_of_birth, address, valid, source, checked_at, regulated
+SELECT national_id, full_name, date_of_birth, address, valid, source, checked_at, regulated, birth_year, city, completeness
 FROM citizen_cache
 WHERE national_id = $1 AND regulated = $2 AND checked_at >= $3;
 
 -- name: UpsertCitizenCache :exec
 INSERT INTO citizen_cache (
-    national_id, full_name, date_of_birth, address, valid, source, checked_at, regulated
+    national_id, full_name, date_of_birth, address, valid, source, checked_at, regulated, birth_year, city, completeness
 )
-VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
 ON CONFLICT (national_id, regulated) DO UPDATE SET
     full_name = EXCLUDED.full_name,
     date_of_birth = EXCLUDED.date_of_birth,
     address = EXCLUDED.address,
     valid = EXCLUDED.valid,
     source = EXCLUDED.source,
-    checked_at = EXCLUDED.checked_at;
+    checked_at = EXCLUDED.checked_at,
+    birth_year = EXCLUDED.birth_year,
+    city = EXCLUDED.city,
+    completeness = EXCLUDED.completeness;
+
+-- name: UpsertCitizenCacheBatch :exec
+INSERT INTO citizen_cache (
+    national_id, full_name, date_of_birth, address, valid, source, checked_at, regulated, birth_year, city, completeness
+)
+SELECT entry.national_id, entry.full_name, entry.date_of_birth, entry.address, entry.valid, entry.source, entry.checked_at,
+    @regulated::boolean, entry.birth_year, entry.city, entry.completeness
+FROM unnest(
+    @national_ids::text[], @full_names::text[], @dates_of_birth::text[], @addresses::text[], @valid::boolean[],
+    @sources::text[], @checked_at::timestamptz[], @birth_years::text[], @cities::text[],
+    @completeness::double precision[]
+) AS entry(national_id, full_name, date_of_birth, address, valid, source, checked_at, birth_year, city, completeness)
+ON CONFLICT (national_id, regulated) DO UPDATE SET
+    full_name = EXCLUDED.full_name,
+    date_of_birth = EXCLUDED.date_of_birth,
+    address = EXCLUDED.address,
+    valid = EXCLUDED.valid,
+    source = EXCLUDED.source,
+    checked_at = EXCLUDED.checked_at,
+    birth_year = EXCLUDED.birth_year,
+    city = EXCLUDED.city,
+    completeness = EXCLUDED.completeness;
 
 -- name: GetSanctionsCache :one
 SELECT national_id, listed, source, checked_at
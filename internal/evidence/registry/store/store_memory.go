@@ -145,16 +145,19 @@ contention. Each operation acquires only the lock it needs.
 
 # TTL and Eviction Strategy
 
-  - TTL Expiration: Entries expire after cacheTTL duration
+  - TTL Expiration: Entries expire once their CheckedAt is more than cacheTTL
+    before the request time (requestcontext.Now), matching the Postgres cache.
+    WithTypeTTLs gives citizen and sanctions entries their own TTLs
   - Lazy Cleanup: Expired entries are removed on access (no background scan needed)
   - LRU Eviction: When at capacity, the least recently accessed entry is evicted
   - Periodic Cleanup: CleanupExpired() can be called by a background goroutine
 
 # Regulated Mode
 
-Citizen records track whether they were stored in regulated (PII-minimized) mode.
-Cache lookups require matching regulated state to prevent serving stale PII when
-the system switches modes.
+Citizen entries are keyed by (national ID, regulated), like the Postgres cache's
+composite key. A full record and a minimized record for the same person are
+cached independently, and a lookup only ever sees the entry for its own mode, so
+switching modes can never serve stale PII.
 */
 package store
 
@@ -167,7 +170,10 @@ import (
 
 	"credo/internal/evidence/registry/metrics"
 	"credo/internal/evidence/registry/models"
+	"credo/internal/evidence/registry/providers"
 	id "credo/pkg/domain"
+	dErrors "credo/pkg/domain-errors"
+	"credo/pkg/requestcontext"
 )
 
 // Default cache configuration
@@ -176,32 +182,30 @@ const (
 )
 
 type cachedCitizen struct {
-	key       string // Cache key for LRU list reference
-	record    models.CitizenRecord
-	storedAt  time.Time
-	regulated bool // Track whether this record was stored in minimized (regulated) mode
+	key    string // Composite cache key for LRU list reference
+	record models.CitizenRecord
 }
 
 type cachedSanction struct {
-	key      string // Cache key for LRU list reference
-	record   models.SanctionsRecord
-	storedAt time.Time
+	key    string // Cache key for LRU list reference
+	record models.SanctionsRecord
 }
 
 // InMemoryCache provides an in-memory cache for registry records with TTL expiration
-// and LRU eviction. Citizen records track their regulated mode to prevent serving
-// stale PII when the system switches between regulated and non-regulated modes.
+// and LRU eviction. Citizen records are keyed by national ID and regulated mode to
+// prevent serving stale PII when the system switches between modes.
 //
 // The cache has separate locks for citizens and sanctions to reduce contention.
 // Expired entries are cleaned up lazily on access and periodically via background cleanup.
 type InMemoryCache struct {
 	citizenMu   sync.Mutex
 	sanctionMu  sync.Mutex
-	citizens    map[string]*list.Element // key -> LRU list element containing *cachedCitizen
+	citizens    map[string]*list.Element // citizenCacheKey -> LRU list element containing *cachedCitizen
 	sanctions   map[string]*list.Element // key -> LRU list element containing *cachedSanction
 	citizenLRU  *list.List               // Front = most recent, Back = least recent
 	sanctionLRU *list.List
 	cacheTTL    time.Duration
+	typeTTLs    TypeTTLs
 	maxSize     int
 	metrics     *metrics.Metrics
 }
@@ -209,6 +213,33 @@ type InMemoryCache struct {
 // ErrNotFound is returned when a requested record does not exist in the cache.
 var ErrNotFound = errors.New("not found")
 
+// ErrUnminimizedRecord is returned when a citizen record saved in regulated mode
+// still carries PII. Callers must minimize the record before caching it.
+var ErrUnminimizedRecord = dErrors.New(dErrors.CodeInvariantViolation, "regulated citizen record must be minimized before caching")
+
+// citizenCacheKey is the composite (national ID, regulated) key for citizen entries.
+func citizenCacheKey(nationalID id.NationalID, regulated bool) string {
+	if regulated {
+		return nationalID.String() + ":regulated"
+	}
+	return nationalID.String() + ":full"
+}
+
+// isExpired reports whether a recordType entry checked at checkedAt is stale at
+// now. A record exactly TTL old is still fresh, matching the Postgres cutoff.
+func (c *InMemoryCache) isExpired(recordType providers.ProviderType, checkedAt, now time.Time) bool {
+	return now.Sub(checkedAt) > c.typeTTLs.ttlFor(recordType, c.cacheTTL)
+}
+
+// requireMinimized rejects PII-bearing records on the regulated write path so a
+// caller that skipped minimization cannot leak personal details into a shared cache.
+func requireMinimized(record *models.CitizenRecord, regulated bool) error {
+	if regulated && record.HasPII() {
+		return ErrUnminimizedRecord
+	}
+	return nil
+}
+
 // CacheOption configures the InMemoryCache.
 type CacheOption func(*InMemoryCache)
 
@@ -219,6 +250,13 @@ func WithMaxSize(size int) CacheOption {
 	}
 }
 
+// WithTypeTTLs sets per-record-type TTLs that override the default cache TTL.
+func WithTypeTTLs(ttls TypeTTLs) CacheOption {
+	return func(c *InMemoryCache) {
+		c.typeTTLs = ttls
+	}
+}
+
 // WithMetrics enables Prometheus metrics collection for cache operations.
 func WithMetrics(m *metrics.Metrics) CacheOption {
 	return func(c *InMemoryCache) {
@@ -245,8 +283,10 @@ func NewInMemoryCache(cacheTTL time.Duration, opts ...CacheOption) *InMemoryCach
 // SaveCitizen stores a citizen record in the cache.
 // The key parameter is the original lookup key (national ID), used to avoid cache
 // collisions when records are minimized (regulated mode blanks NationalID in record).
-// The regulated parameter indicates whether the record is in minimized form.
+// The regulated parameter indicates whether the record is in minimized form; full and
+// minimized records for the same key are stored independently.
 // If record is nil, the operation is a no-op and returns nil.
+// Returns ErrUnminimizedRecord if regulated is set and the record still carries PII.
 func (c *InMemoryCache) SaveCitizen(_ context.Context, key id.NationalID, record *models.CitizenRecord, regulated bool) error {
 	if record == nil {
 		return nil
@@ -254,8 +294,11 @@ func (c *InMemoryCache) SaveCitizen(_ context.Context, key id.NationalID, record
 	if key.IsNil() {
 		return errors.New("cannot cache citizen record with nil key")
 	}
+	if err := requireMinimized(record, regulated); err != nil {
+		return err
+	}
 
-	keyStr := key.String()
+	keyStr := citizenCacheKey(key, regulated)
 	c.citizenMu.Lock()
 	defer c.citizenMu.Unlock()
 
@@ -263,8 +306,6 @@ func (c *InMemoryCache) SaveCitizen(_ context.Context, key id.NationalID, record
 	if elem, ok := c.citizens[keyStr]; ok {
 		cached := elem.Value.(*cachedCitizen) //nolint:errcheck // type-safe: citizenLRU only stores *cachedCitizen
 		cached.record = *record
-		cached.storedAt = time.Now()
-		cached.regulated = regulated
 		c.citizenLRU.MoveToFront(elem)
 		return nil
 	}
@@ -276,24 +317,21 @@ func (c *InMemoryCache) SaveCitizen(_ context.Context, key id.NationalID, record
 
 	// Add new entry at front of LRU list
 	cached := &cachedCitizen{
-		key:       keyStr,
-		record:    *record,
-		storedAt:  time.Now(),
-		regulated: regulated,
+		key:    keyStr,
+		record: *record,
 	}
 	elem := c.citizenLRU.PushFront(cached)
 	c.citizens[keyStr] = elem
 	return nil
 }
 
-// FindCitizen retrieves a cached citizen record by national ID.
+// FindCitizen retrieves a cached citizen record by national ID and regulated mode.
 // Returns ErrNotFound if:
-//   - The record does not exist
+//   - No record exists for this national ID in the requested mode (prevents stale PII)
 //   - The record has expired past the cache TTL
-//   - The stored regulated mode doesn't match the requested mode (prevents stale PII)
-func (c *InMemoryCache) FindCitizen(_ context.Context, nationalID id.NationalID, regulated bool) (*models.CitizenRecord, error) {
+func (c *InMemoryCache) FindCitizen(ctx context.Context, nationalID id.NationalID, regulated bool) (*models.CitizenRecord, error) {
 	start := time.Now()
-	keyStr := nationalID.String()
+	keyStr := citizenCacheKey(nationalID, regulated)
 
 	c.citizenMu.Lock()
 	defer c.citizenMu.Unlock()
@@ -307,7 +345,7 @@ func (c *InMemoryCache) FindCitizen(_ context.Context, nationalID id.NationalID,
 	cached := elem.Value.(*cachedCitizen) //nolint:errcheck // type-safe: citizenLRU only stores *cachedCitizen
 
 	// Check TTL expiration
-	if time.Since(cached.storedAt) >= c.cacheTTL {
+	if c.isExpired(providers.ProviderTypeCitizen, cached.record.CheckedAt, requestcontext.Now(ctx)) {
 		// Lazy cleanup: remove expired entry
 		c.citizenLRU.Remove(elem)
 		delete(c.citizens, keyStr)
@@ -315,12 +353,6 @@ func (c *InMemoryCache) FindCitizen(_ context.Context, nationalID id.NationalID,
 		return nil, ErrNotFound
 	}
 
-	// Cache miss if regulated mode changed - prevents serving stale PII
-	if cached.regulated != regulated {
-		c.recordMiss("citizen", start)
-		return nil, ErrNotFound
-	}
-
 	// Move to front of LRU list (O(1) access update)
 	c.citizenLRU.MoveToFront(elem)
 
@@ -347,7 +379,6 @@ func (c *InMemoryCache) SaveSanction(_ context.Context, key id.NationalID, recor
 	if elem, ok := c.sanctions[keyStr]; ok {
 		cached := elem.Value.(*cachedSanction) //nolint:errcheck // type-safe: sanctionLRU only stores *cachedSanction
 		cached.record = *record
-		cached.storedAt = time.Now()
 		c.sanctionLRU.MoveToFront(elem)
 		return nil
 	}
@@ -359,9 +390,8 @@ func (c *InMemoryCache) SaveSanction(_ context.Context, key id.NationalID, recor
 
 	// Add new entry at front of LRU list
 	cached := &cachedSanction{
-		key:      keyStr,
-		record:   *record,
-		storedAt: time.Now(),
+		key:    keyStr,
+		record: *record,
 	}
 	elem := c.sanctionLRU.PushFront(cached)
 	c.sanctions[keyStr] = elem
@@ -370,7 +400,7 @@ func (c *InMemoryCache) SaveSanction(_ context.Context, key id.NationalID, recor
 
 // FindSanction retrieves a cached sanctions record by national ID.
 // Returns ErrNotFound if the record does not exist or has expired past the cache TTL.
-func (c *InMemoryCache) FindSanction(_ context.Context, nationalID id.NationalID) (*models.SanctionsRecord, error) {
+func (c *InMemoryCache) FindSanction(ctx context.Context, nationalID id.NationalID) (*models.SanctionsRecord, error) {
 	start := time.Now()
 	keyStr := nationalID.String()
 
@@ -386,7 +416,7 @@ func (c *InMemoryCache) FindSanction(_ context.Context, nationalID id.NationalID
 	cached := elem.Value.(*cachedSanction) //nolint:errcheck // type-safe: sanctionLRU only stores *cachedSanction
 
 	// Check TTL expiration
-	if time.Since(cached.storedAt) >= c.cacheTTL {
+	if c.isExpired(providers.ProviderTypeSanctions, cached.record.CheckedAt, requestcontext.Now(ctx)) {
 		// Lazy cleanup: remove expired entry
 		c.sanctionLRU.Remove(elem)
 		delete(c.sanctions, keyStr)
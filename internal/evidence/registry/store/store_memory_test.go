@@ -12,7 +12,10 @@ import (
 
 	"credo/internal/evidence/registry/metrics"
 	"credo/internal/evidence/registry/models"
+	"credo/internal/evidence/registry/providers"
 	id "credo/pkg/domain"
+	dErrors "credo/pkg/domain-errors"
+	"credo/pkg/requestcontext"
 )
 
 type InMemoryCacheSuite struct {
@@ -80,6 +83,18 @@ func (s *InMemoryCacheSuite) TestSaveCitizen() {
 		s.Error(err)
 	})
 
+	s.Run("rejects PII-bearing record in regulated mode", func() {
+		cache := NewInMemoryCache(5 * time.Minute)
+		record := &models.CitizenRecord{NationalID: "ABC123456", FullName: "Test User", Valid: true, CheckedAt: time.Now()}
+
+		err := cache.SaveCitizen(ctx, key, record, true)
+		s.Require().ErrorIs(err, ErrUnminimizedRecord)
+		s.True(dErrors.HasCode(err, dErrors.CodeInvariantViolation))
+
+		_, err = cache.FindCitizen(ctx, key, true)
+		s.ErrorIs(err, ErrNotFound, "rejected record must not be cached")
+	})
+
 	s.Run("uses key parameter not record NationalID for cache key", func() {
 		// This tests the fix for cache key collision in regulated mode
 		key1 := testNationalID("KEY123")
@@ -309,6 +324,38 @@ func (s *InMemoryCacheSuite) TestFindSanction() {
 	})
 }
 
+func (s *InMemoryCacheSuite) TestTypeTTLs() {
+	key := testNationalID("TTL123456")
+	checkedAt := time.Date(2026, time.March, 2, 9, 0, 0, 0, time.UTC)
+	at := func(d time.Duration) context.Context {
+		return requestcontext.WithTime(context.Background(), checkedAt.Add(d))
+	}
+
+	s.Run("sanctions entries expire before citizen entries", func() {
+		cache := NewInMemoryCache(5*time.Minute, WithTypeTTLs(TypeTTLs{providers.ProviderTypeCitizen: 24 * time.Hour, providers.ProviderTypeSanctions: time.Hour}))
+		s.Require().NoError(cache.SaveCitizen(at(0), key, &models.CitizenRecord{NationalID: key.String(), Valid: true, CheckedAt: checkedAt}, false))
+		s.Require().NoError(cache.SaveSanction(at(0), key, &models.SanctionsRecord{NationalID: key.String(), CheckedAt: checkedAt}))
+
+		_, err := cache.FindSanction(at(time.Hour), key)
+		s.NoError(err, "an entry exactly its TTL old is fresh")
+		_, err = cache.FindSanction(at(2*time.Hour), key)
+		s.ErrorIs(err, ErrNotFound)
+
+		_, err = cache.FindCitizen(at(2*time.Hour), key, false)
+		s.NoError(err, "citizen entries keep their longer TTL")
+		_, err = cache.FindCitizen(at(25*time.Hour), key, false)
+		s.ErrorIs(err, ErrNotFound)
+	})
+
+	s.Run("types without an override use the default TTL", func() {
+		cache := NewInMemoryCache(5*time.Minute, WithTypeTTLs(TypeTTLs{providers.ProviderTypeSanctions: time.Hour}))
+		s.Require().NoError(cache.SaveCitizen(at(0), key, &models.CitizenRecord{NationalID: key.String(), Valid: true, CheckedAt: checkedAt}, false))
+
+		_, err := cache.FindCitizen(at(10*time.Minute), key, false)
+		s.ErrorIs(err, ErrNotFound)
+	})
+}
+
 func (s *InMemoryCacheSuite) TestCacheSeparation() {
 	ctx := context.Background()
 	key := testNationalID("ABC123456")
@@ -9,6 +9,7 @@ import (
 
 	"credo/internal/evidence/registry/metrics"
 	"credo/internal/evidence/registry/models"
+	"credo/internal/evidence/registry/providers"
 	registrysqlc "credo/internal/evidence/registry/store/sqlc"
 	id "credo/pkg/domain"
 	"credo/pkg/requestcontext"
@@ -18,27 +19,46 @@ import (
 type PostgresCache struct {
 	db       *sql.DB
 	cacheTTL time.Duration
+	typeTTLs TypeTTLs
 	metrics  *metrics.Metrics
 	queries  *registrysqlc.Queries
 }
 
+// PostgresCacheOption configures the PostgresCache.
+type PostgresCacheOption func(*PostgresCache)
+
+// WithPostgresTypeTTLs sets per-record-type TTLs that override cacheTTL.
+func WithPostgresTypeTTLs(ttls TypeTTLs) PostgresCacheOption {
+	return func(c *PostgresCache) {
+		c.typeTTLs = ttls
+	}
+}
+
 // NewPostgresCache constructs a PostgreSQL-backed registry cache.
-func NewPostgresCache(db *sql.DB, cacheTTL time.Duration, metrics *metrics.Metrics) *PostgresCache {
-	return &PostgresCache{
+func NewPostgresCache(db *sql.DB, cacheTTL time.Duration, metrics *metrics.Metrics, opts ...PostgresCacheOption) *PostgresCache {
+	c := &PostgresCache{
 		db:       db,
 		cacheTTL: cacheTTL,
 		metrics:  metrics,
 		queries:  registrysqlc.New(db),
 	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// cutoff is the oldest CheckedAt still fresh for recordType at the request time.
+func (c *PostgresCache) cutoff(ctx context.Context, recordType providers.ProviderType) time.Time {
+	return requestcontext.Now(ctx).Add(-c.typeTTLs.ttlFor(recordType, c.cacheTTL))
 }
 
 func (c *PostgresCache) FindCitizen(ctx context.Context, nationalID id.NationalID, regulated bool) (*models.CitizenRecord, error) {
 	start := time.Now()
-	cutoff := requestcontext.Now(ctx).Add(-c.cacheTTL)
 	record, err := c.queries.GetCitizenCache(ctx, registrysqlc.GetCitizenCacheParams{
 		NationalID: nationalID.String(),
 		Regulated:  regulated,
-		CheckedAt:  cutoff,
+		CheckedAt:  c.cutoff(ctx, providers.ProviderTypeCitizen),
 	})
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -55,15 +75,21 @@ func (c *PostgresCache) SaveCitizen(ctx context.Context, key id.NationalID, reco
 	if record == nil {
 		return fmt.Errorf("citizen record is required")
 	}
+	if err := requireMinimized(record, regulated); err != nil {
+		return err
+	}
 	err := c.queries.UpsertCitizenCache(ctx, registrysqlc.UpsertCitizenCacheParams{
-		NationalID:  key.String(),
-		FullName:    record.FullName,
-		DateOfBirth: record.DateOfBirth,
-		Address:     record.Address,
-		Valid:       record.Valid,
-		Source:      record.Source,
-		CheckedAt:   record.CheckedAt,
-		Regulated:   regulated,
+		NationalID:   key.String(),
+		FullName:     record.FullName,
+		DateOfBirth:  record.DateOfBirth,
+		Address:      record.Address,
+		Valid:        record.Valid,
+		Source:       record.Source,
+		CheckedAt:    record.CheckedAt,
+		Regulated:    regulated,
+		BirthYear:    record.BirthYear,
+		City:         record.City,
+		Completeness: record.Completeness,
 	})
 	if err != nil {
 		return fmt.Errorf("save citizen cache: %w", err)
@@ -71,12 +97,63 @@ func (c *PostgresCache) SaveCitizen(ctx context.Context, key id.NationalID, reco
 	return nil
 }
 
+// CitizenEntry is a citizen record with the lookup key it is cached under.
+// The key is separate because regulated mode blanks the record's NationalID.
+type CitizenEntry struct {
+	Key    id.NationalID
+	Record *models.CitizenRecord
+}
+
+// WarmCitizen upserts many citizen records in a single statement, so a cold
+// cache can be preloaded with one round trip instead of one SaveCitizen per
+// record. When entries repeat a key the last one wins. Like SaveCitizen, it
+// rejects records carrying PII when regulated is set; nothing is written then.
+func (c *PostgresCache) WarmCitizen(ctx context.Context, entries []CitizenEntry, regulated bool) error {
+	latest := make(map[string]int, len(entries))
+	for i, entry := range entries {
+		if entry.Key.IsNil() {
+			return fmt.Errorf("cannot cache citizen record with nil key")
+		}
+		if entry.Record == nil {
+			return fmt.Errorf("citizen record is required")
+		}
+		if err := requireMinimized(entry.Record, regulated); err != nil {
+			return err
+		}
+		latest[entry.Key.String()] = i
+	}
+	if len(latest) == 0 {
+		return nil
+	}
+
+	params := registrysqlc.UpsertCitizenCacheBatchParams{Regulated: regulated}
+	for i, entry := range entries {
+		if latest[entry.Key.String()] != i {
+			continue
+		}
+		record := entry.Record
+		params.NationalIds = append(params.NationalIds, entry.Key.String())
+		params.FullNames = append(params.FullNames, record.FullName)
+		params.DatesOfBirth = append(params.DatesOfBirth, record.DateOfBirth)
+		params.Addresses = append(params.Addresses, record.Address)
+		params.Valid = append(params.Valid, record.Valid)
+		params.Sources = append(params.Sources, record.Source)
+		params.CheckedAt = append(params.CheckedAt, record.CheckedAt)
+		params.BirthYears = append(params.BirthYears, record.BirthYear)
+		params.Cities = append(params.Cities, record.City)
+		params.Completeness = append(params.Completeness, record.Completeness)
+	}
+	if err := c.queries.UpsertCitizenCacheBatch(ctx, params); err != nil {
+		return fmt.Errorf("warm citizen cache: %w", err)
+	}
+	return nil
+}
+
 func (c *PostgresCache) FindSanction(ctx context.Context, nationalID id.NationalID) (*models.SanctionsRecord, error) {
 	start := time.Now()
-	cutoff := requestcontext.Now(ctx).Add(-c.cacheTTL)
 	record, err := c.queries.GetSanctionsCache(ctx, registrysqlc.GetSanctionsCacheParams{
 		NationalID: nationalID.String(),
-		CheckedAt:  cutoff,
+		CheckedAt:  c.cutoff(ctx, providers.ProviderTypeSanctions),
 	})
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -107,13 +184,16 @@ func (c *PostgresCache) SaveSanction(ctx context.Context, key id.NationalID, rec
 
 func toCitizenRecord(record registrysqlc.CitizenCache) *models.CitizenRecord {
 	return &models.CitizenRecord{
-		NationalID:  record.NationalID,
-		FullName:    record.FullName,
-		DateOfBirth: record.DateOfBirth,
-		Address:     record.Address,
-		Valid:       record.Valid,
-		Source:      record.Source,
-		CheckedAt:   record.CheckedAt,
+		NationalID:   record.NationalID,
+		FullName:     record.FullName,
+		DateOfBirth:  record.DateOfBirth,
+		Address:      record.Address,
+		BirthYear:    record.BirthYear,
+		City:         record.City,
+		Valid:        record.Valid,
+		Source:       record.Source,
+		CheckedAt:    record.CheckedAt,
+		Completeness: record.Completeness,
 	}
 }
 
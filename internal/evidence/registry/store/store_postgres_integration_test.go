@@ -4,16 +4,22 @@ package store_test
 
 import (
 	"context"
+	"fmt"
 	"sync"
 	"sync/atomic"
 	"testing"
 	"time"
 
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/stdlib"
 	"github.com/stretchr/testify/suite"
 
 	"credo/internal/evidence/registry/models"
+	"credo/internal/evidence/registry/providers"
 	"credo/internal/evidence/registry/store"
+	"credo/internal/evidence/registry/store/storetest"
 	id "credo/pkg/domain"
+	"credo/pkg/requestcontext"
 	"credo/pkg/testutil/containers"
 )
 
@@ -164,6 +170,34 @@ func (s *PostgresCacheSuite) TestCacheTTLBoundary() {
 	s.ErrorIs(err, store.ErrNotFound)
 }
 
+// TestTypeTTLs verifies the freshness cutoff uses each record type's own TTL.
+func (s *PostgresCacheSuite) TestTypeTTLs() {
+	cache := store.NewPostgresCache(s.postgres.DB, 5*time.Minute, nil,
+		store.WithPostgresTypeTTLs(store.TypeTTLs{providers.ProviderTypeCitizen: 24 * time.Hour, providers.ProviderTypeSanctions: time.Hour}))
+	key := testNationalID("TYPETTL1")
+	checkedAt := time.Now().UTC().Truncate(time.Microsecond)
+	at := func(d time.Duration) context.Context {
+		return requestcontext.WithTime(context.Background(), checkedAt.Add(d))
+	}
+
+	s.Require().NoError(cache.SaveCitizen(at(0), key, &models.CitizenRecord{
+		NationalID: key.String(), FullName: "TTL User", DateOfBirth: "1990-01-01", Valid: true, Source: "test", CheckedAt: checkedAt,
+	}, false))
+	s.Require().NoError(cache.SaveSanction(at(0), key, &models.SanctionsRecord{
+		NationalID: key.String(), Source: "test", CheckedAt: checkedAt,
+	}))
+
+	_, err := cache.FindSanction(at(30*time.Minute), key)
+	s.NoError(err)
+	_, err = cache.FindSanction(at(2*time.Hour), key)
+	s.ErrorIs(err, store.ErrNotFound, "sanctions expire after an hour")
+
+	_, err = cache.FindCitizen(at(2*time.Hour), key, false)
+	s.NoError(err, "citizen entries outlive the default and sanctions TTLs")
+	_, err = cache.FindCitizen(at(25*time.Hour), key, false)
+	s.ErrorIs(err, store.ErrNotFound)
+}
+
 // TestConcurrentMixedOperations verifies concurrent saves and finds don't interfere.
 func (s *PostgresCacheSuite) TestConcurrentMixedOperations() {
 	ctx := context.Background()
@@ -291,3 +325,81 @@ func (s *PostgresCacheSuite) TestConcurrentRegulatedUpserts() {
 	_, err = s.cache.FindCitizen(ctx, key, true)
 	s.Require().NoError(err)
 }
+
+// queryCounter counts the statements pgx sends to the server.
+type queryCounter struct {
+	queries atomic.Int32
+}
+
+func (c *queryCounter) TraceQueryStart(ctx context.Context, _ *pgx.Conn, _ pgx.TraceQueryStartData) context.Context {
+	c.queries.Add(1)
+	return ctx
+}
+
+func (c *queryCounter) TraceQueryEnd(context.Context, *pgx.Conn, pgx.TraceQueryEndData) {}
+
+// TestWarmCitizen verifies a bulk warm-up stores every record in one round trip.
+func (s *PostgresCacheSuite) TestWarmCitizen() {
+	ctx := context.Background()
+	config, err := pgx.ParseConfig(s.postgres.DSN)
+	s.Require().NoError(err)
+	counter := &queryCounter{}
+	config.Tracer = counter
+	db := stdlib.OpenDB(*config)
+	defer db.Close()
+	s.Require().NoError(db.PingContext(ctx))
+	cache := store.NewPostgresCache(db, 5*time.Minute, nil)
+
+	const records = 50
+	entries := make([]store.CitizenEntry, 0, records+1)
+	for i := range records {
+		key := testNationalID(fmt.Sprintf("WARM%05d", i))
+		entries = append(entries, store.CitizenEntry{Key: key, Record: &models.CitizenRecord{
+			NationalID:  key.String(),
+			FullName:    fmt.Sprintf("User %d", i),
+			DateOfBirth: "1990-01-01",
+			Valid:       true,
+			Source:      "test",
+			CheckedAt:   time.Now(),
+		}})
+	}
+	// A repeated key is written once, with its last record.
+	repeated := entries[0]
+	entries = append(entries, store.CitizenEntry{Key: repeated.Key, Record: &models.CitizenRecord{
+		NationalID: repeated.Key.String(), FullName: "Latest", DateOfBirth: "1990-01-01", Valid: true, Source: "test", CheckedAt: time.Now(),
+	}})
+
+	counter.queries.Store(0)
+	s.Require().NoError(cache.WarmCitizen(ctx, entries, false))
+	s.Equal(int32(1), counter.queries.Load(), "warm-up uses a single round trip")
+
+	for i, entry := range entries[:records] {
+		found, err := s.cache.FindCitizen(ctx, entry.Key, false)
+		s.Require().NoError(err)
+		if i == 0 {
+			s.Equal("Latest", found.FullName)
+			continue
+		}
+		s.Equal(entry.Record.FullName, found.FullName)
+	}
+
+	s.Run("regulated warm-up rejects unminimized records", func() {
+		err := cache.WarmCitizen(ctx, entries, true)
+		s.ErrorIs(err, store.ErrUnminimizedRecord)
+	})
+}
+
+func TestPostgresCacheConformance(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+	postgres := containers.GetManager().GetPostgres(t)
+	suite.Run(t, &storetest.CacheSuite{
+		NewCache: func(ttl time.Duration) storetest.Cache {
+			if err := postgres.TruncateTables(context.Background(), "citizen_cache", "sanctions_cache"); err != nil {
+				t.Fatalf("truncate cache tables: %v", err)
+			}
+			return store.NewPostgresCache(postgres.DB, ttl, nil)
+		},
+	})
+}
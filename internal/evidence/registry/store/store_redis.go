@@ -55,11 +55,15 @@ func (c *RedisCache) FindCitizen(ctx context.Context, nationalID id.NationalID,
 //
 // Side effects: performs a Redis SET; overwrites any existing entry.
 //
-// Errors: returns an error if the record is nil, cannot be encoded, or the write fails.
+// Errors: returns an error if the record is nil, cannot be encoded, or the write fails;
+// returns ErrUnminimizedRecord if regulated is set and the record still carries PII.
 func (c *RedisCache) SaveCitizen(ctx context.Context, key id.NationalID, record *models.CitizenRecord, regulated bool) error {
 	if record == nil {
 		return fmt.Errorf("citizen record is required")
 	}
+	if err := requireMinimized(record, regulated); err != nil {
+		return err
+	}
 	return c.setJSON(ctx, citizenKey(key, regulated), record, "citizen")
 }
 
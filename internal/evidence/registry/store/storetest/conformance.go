@@ -0,0 +1,206 @@
+// Package storetest provides a conformance suite every registry cache
+// implementation must pass, so test doubles and production stores cannot drift.
+package storetest
+
+import (
+	"context"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"credo/internal/evidence/registry/models"
+	"credo/internal/evidence/registry/store"
+	id "credo/pkg/domain"
+	"credo/pkg/requestcontext"
+)
+
+// Cache is the registry cache surface covered by the conformance suite.
+type Cache interface {
+	FindCitizen(ctx context.Context, nationalID id.NationalID, regulated bool) (*models.CitizenRecord, error)
+	SaveCitizen(ctx context.Context, key id.NationalID, record *models.CitizenRecord, regulated bool) error
+	FindSanction(ctx context.Context, nationalID id.NationalID) (*models.SanctionsRecord, error)
+	SaveSanction(ctx context.Context, key id.NationalID, record *models.SanctionsRecord) error
+}
+
+// CacheSuite verifies TTL boundaries, regulated isolation, and upsert semantics.
+// Embed it in a package test and set NewCache to return an empty cache; it is
+// called before every test.
+type CacheSuite struct {
+	suite.Suite
+	NewCache func(ttl time.Duration) Cache
+
+	cache Cache
+}
+
+const conformanceTTL = 5 * time.Minute
+
+func (s *CacheSuite) SetupTest() {
+	s.Require().NotNil(s.NewCache, "CacheSuite.NewCache must be set")
+	s.cache = s.NewCache(conformanceTTL)
+}
+
+// checkedAt is truncated to the second so stores with coarser timestamp
+// precision compare equal on the TTL boundary.
+func checkedAt() time.Time {
+	return time.Now().UTC().Truncate(time.Second)
+}
+
+func nationalID(s *CacheSuite, value string) id.NationalID {
+	nid, err := id.ParseNationalID(value)
+	s.Require().NoError(err)
+	return nid
+}
+
+func fullCitizen(nid id.NationalID, name string, at time.Time) *models.CitizenRecord {
+	return &models.CitizenRecord{
+		NationalID:  nid.String(),
+		FullName:    name,
+		DateOfBirth: "1990-01-01",
+		Address:     "1 Main St",
+		Valid:       true,
+		Source:      "conformance",
+		CheckedAt:   at,
+	}
+}
+
+func minimizedCitizen(nid id.NationalID, valid bool, at time.Time) *models.CitizenRecord {
+	return &models.CitizenRecord{
+		NationalID: nid.String(),
+		Valid:      valid,
+		Source:     "conformance",
+		CheckedAt:  at,
+	}
+}
+
+// TestTTLBoundary verifies a record exactly TTL old is still served and one
+// second past TTL is not.
+func (s *CacheSuite) TestTTLBoundary() {
+	ctx := context.Background()
+
+	s.Run("citizen", func() {
+		key := nationalID(s, "TTLCITIZEN1")
+		at := checkedAt()
+		s.Require().NoError(s.cache.SaveCitizen(ctx, key, fullCitizen(key, "Jane Doe", at), false))
+
+		_, err := s.cache.FindCitizen(requestcontext.WithTime(ctx, at.Add(conformanceTTL)), key, false)
+		s.NoError(err, "record exactly TTL old must be served")
+
+		_, err = s.cache.FindCitizen(requestcontext.WithTime(ctx, at.Add(conformanceTTL+time.Second)), key, false)
+		s.ErrorIs(err, store.ErrNotFound)
+	})
+
+	s.Run("sanction", func() {
+		key := nationalID(s, "TTLSANCTION1")
+		at := checkedAt()
+		s.Require().NoError(s.cache.SaveSanction(ctx, key, &models.SanctionsRecord{
+			NationalID: key.String(), Listed: true, Source: "conformance", CheckedAt: at,
+		}))
+
+		_, err := s.cache.FindSanction(requestcontext.WithTime(ctx, at.Add(conformanceTTL)), key)
+		s.NoError(err, "record exactly TTL old must be served")
+
+		_, err = s.cache.FindSanction(requestcontext.WithTime(ctx, at.Add(conformanceTTL+time.Second)), key)
+		s.ErrorIs(err, store.ErrNotFound)
+	})
+}
+
+// TestRegulatedIsolation verifies full and minimized records for one national ID
+// are stored and served independently.
+func (s *CacheSuite) TestRegulatedIsolation() {
+	ctx := context.Background()
+
+	s.Run("lookup in the other mode misses", func() {
+		key := nationalID(s, "ISOLATED1")
+		s.Require().NoError(s.cache.SaveCitizen(ctx, key, fullCitizen(key, "Jane Doe", checkedAt()), false))
+
+		_, err := s.cache.FindCitizen(ctx, key, true)
+		s.ErrorIs(err, store.ErrNotFound, "full record must never satisfy a regulated lookup")
+	})
+
+	s.Run("both modes coexist under one key", func() {
+		key := nationalID(s, "ISOLATED2")
+		at := checkedAt()
+		s.Require().NoError(s.cache.SaveCitizen(ctx, key, fullCitizen(key, "Jane Doe", at), false))
+		s.Require().NoError(s.cache.SaveCitizen(ctx, key, minimizedCitizen(key, false, at), true))
+
+		full, err := s.cache.FindCitizen(ctx, key, false)
+		s.Require().NoError(err)
+		s.Equal("Jane Doe", full.FullName)
+		s.True(full.Valid)
+
+		minimized, err := s.cache.FindCitizen(ctx, key, true)
+		s.Require().NoError(err)
+		s.False(minimized.HasPII())
+		s.False(minimized.Valid)
+	})
+
+	s.Run("minimized record keeps coarse details", func() {
+		key := nationalID(s, "ISOLATED3")
+		record := minimizedCitizen(key, true, checkedAt())
+		record.BirthYear = "1990"
+		record.City = "Springfield"
+		record.Completeness = 2.0 / 3
+		s.Require().NoError(s.cache.SaveCitizen(ctx, key, record, true))
+
+		minimized, err := s.cache.FindCitizen(ctx, key, true)
+		s.Require().NoError(err)
+		s.False(minimized.HasPII())
+		s.Equal("1990", minimized.BirthYear)
+		s.Equal("Springfield", minimized.City)
+		s.InDelta(2.0/3, minimized.Completeness, 1e-9)
+	})
+}
+
+// TestUpsert verifies a save replaces the entry for its own key and mode only.
+func (s *CacheSuite) TestUpsert() {
+	ctx := context.Background()
+
+	s.Run("citizen save overwrites within a mode", func() {
+		key := nationalID(s, "UPSERT1")
+		at := checkedAt()
+		s.Require().NoError(s.cache.SaveCitizen(ctx, key, fullCitizen(key, "Jane Doe", at), false))
+		s.Require().NoError(s.cache.SaveCitizen(ctx, key, minimizedCitizen(key, true, at), true))
+		s.Require().NoError(s.cache.SaveCitizen(ctx, key, fullCitizen(key, "Jane Smith", at), false))
+
+		full, err := s.cache.FindCitizen(ctx, key, false)
+		s.Require().NoError(err)
+		s.Equal("Jane Smith", full.FullName)
+
+		minimized, err := s.cache.FindCitizen(ctx, key, true)
+		s.Require().NoError(err)
+		s.True(minimized.Valid, "overwriting the full record must not touch the minimized one")
+	})
+
+	s.Run("upsert refreshes the TTL", func() {
+		key := nationalID(s, "UPSERT2")
+		at := checkedAt()
+		s.Require().NoError(s.cache.SaveCitizen(ctx, key, fullCitizen(key, "Jane Doe", at.Add(-2*conformanceTTL)), false))
+		s.Require().NoError(s.cache.SaveCitizen(ctx, key, fullCitizen(key, "Jane Doe", at), false))
+
+		_, err := s.cache.FindCitizen(requestcontext.WithTime(ctx, at), key, false)
+		s.NoError(err)
+	})
+
+	s.Run("sanction save overwrites", func() {
+		key := nationalID(s, "UPSERT3")
+		at := checkedAt()
+		s.Require().NoError(s.cache.SaveSanction(ctx, key, &models.SanctionsRecord{NationalID: key.String(), Listed: false, Source: "conformance", CheckedAt: at}))
+		s.Require().NoError(s.cache.SaveSanction(ctx, key, &models.SanctionsRecord{NationalID: key.String(), Listed: true, Source: "conformance", CheckedAt: at}))
+
+		found, err := s.cache.FindSanction(ctx, key)
+		s.Require().NoError(err)
+		s.True(found.Listed)
+	})
+}
+
+// TestMiss verifies unknown keys report ErrNotFound.
+func (s *CacheSuite) TestMiss() {
+	ctx := context.Background()
+	key := nationalID(s, "MISSING1")
+
+	_, err := s.cache.FindCitizen(ctx, key, false)
+	s.ErrorIs(err, store.ErrNotFound)
+
+	_, err = s.cache.FindSanction(ctx, key)
+	s.ErrorIs(err, store.ErrNotFound)
+}
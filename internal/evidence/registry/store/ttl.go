@@ -0,0 +1,21 @@
+package store
+
+import (
+	"time"
+
+	"credo/internal/evidence/registry/providers"
+)
+
+// TypeTTLs overrides the cache TTL per record type (citizen or sanctions).
+// Citizen data changes rarely while sanctions lists update daily, so each type
+// can expire on its own schedule. Types without a positive entry use the
+// cache's default TTL.
+type TypeTTLs map[providers.ProviderType]time.Duration
+
+// ttlFor returns the TTL for recordType, falling back to fallback.
+func (t TypeTTLs) ttlFor(recordType providers.ProviderType, fallback time.Duration) time.Duration {
+	if ttl, ok := t[recordType]; ok && ttl > 0 {
+		return ttl
+	}
+	return fallback
+}
@@ -33,9 +33,10 @@ func New(service Service, logger *slog.Logger) *Handler {
 	return &Handler{service: service, logger: logger}
 }
 
-// Register mounts VC endpoints on the router.
-func (h *Handler) Register(r chi.Router) {
-	r.Post("/vc/issue", h.HandleIssue)
+// Register mounts VC endpoints on the router. issueMiddlewares wrap only the
+// issuance route, which is gated on the user's consent; verification needs none.
+func (h *Handler) Register(r chi.Router, issueMiddlewares ...func(http.Handler) http.Handler) {
+	r.With(issueMiddlewares...).Post("/vc/issue", h.HandleIssue)
 	r.Post("/vc/verify", h.HandleVerify)
 }
 
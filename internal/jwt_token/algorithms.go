@@ -0,0 +1,141 @@
+package jwttoken
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// Supported signing algorithms.
+const (
+	AlgHS256 = "HS256"
+	AlgRS256 = "RS256"
+	AlgES256 = "ES256"
+)
+
+var errUnsupportedKey = errors.New("unsupported private key type")
+
+// NewRSAKey builds an RS256 key. The kid is derived from the public key so every
+// instance loading the same PEM advertises the same kid.
+func NewRSAKey(priv *rsa.PrivateKey) (Key, error) {
+	kid, err := publicKeyID("rs-", &priv.PublicKey)
+	if err != nil {
+		return Key{}, err
+	}
+	return Key{ID: kid, Method: jwt.SigningMethodRS256, SignKey: priv, VerifyKey: &priv.PublicKey}, nil
+}
+
+// NewECDSAKey builds an ES256 key. Only P-256 is accepted because ES256 is
+// defined over that curve (RFC 7518 §3.4).
+func NewECDSAKey(priv *ecdsa.PrivateKey) (Key, error) {
+	if priv.Curve != elliptic.P256() {
+		return Key{}, fmt.Errorf("ES256 requires a P-256 key, got %s", priv.Curve.Params().Name)
+	}
+	kid, err := publicKeyID("es-", &priv.PublicKey)
+	if err != nil {
+		return Key{}, err
+	}
+	return Key{ID: kid, Method: jwt.SigningMethodES256, SignKey: priv, VerifyKey: &priv.PublicKey}, nil
+}
+
+// ParsePrivateKeyPEM loads an RSA or P-256 ECDSA private key from PEM
+// (PKCS#1, SEC 1, or PKCS#8) and returns the matching RS256/ES256 key.
+func ParsePrivateKeyPEM(data []byte) (Key, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return Key{}, errors.New("no PEM block found")
+	}
+
+	var parsed any
+	var err error
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		parsed, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "EC PRIVATE KEY":
+		parsed, err = x509.ParseECPrivateKey(block.Bytes)
+	default:
+		parsed, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	}
+	if err != nil {
+		return Key{}, fmt.Errorf("parse private key: %w", err)
+	}
+
+	switch priv := parsed.(type) {
+	case *rsa.PrivateKey:
+		return NewRSAKey(priv)
+	case *ecdsa.PrivateKey:
+		return NewECDSAKey(priv)
+	default:
+		return Key{}, errUnsupportedKey
+	}
+}
+
+func publicKeyID(prefix string, pub any) (string, error) {
+	der, err := x509.MarshalPKIXPublicKey(pub)
+	if err != nil {
+		return "", fmt.Errorf("marshal public key: %w", err)
+	}
+	sum := sha256.Sum256(der)
+	return prefix + hex.EncodeToString(sum[:8]), nil
+}
+
+// KeyRing combines one provider per algorithm so the service can sign with a
+// different algorithm per client while verifying against all of them.
+// The primary provider supplies the default signing key.
+type KeyRing struct {
+	primary    SigningKeyProvider
+	additional []SigningKeyProvider
+}
+
+// NewKeyRing creates a ring signing with primary by default.
+func NewKeyRing(primary SigningKeyProvider, additional ...SigningKeyProvider) *KeyRing {
+	return &KeyRing{primary: primary, additional: additional}
+}
+
+// Current returns the primary provider's signing key.
+func (r *KeyRing) Current() Key {
+	return r.primary.Current()
+}
+
+// Verifiers returns the verification keys of every provider in the ring.
+func (r *KeyRing) Verifiers() []Key {
+	keys := r.primary.Verifiers()
+	for _, p := range r.additional {
+		keys = append(keys, p.Verifiers()...)
+	}
+	return keys
+}
+
+// CurrentFor returns the current signing key for alg, if any provider holds one.
+func (r *KeyRing) CurrentFor(alg string) (Key, bool) {
+	for _, p := range append([]SigningKeyProvider{r.primary}, r.additional...) {
+		if k := p.Current(); k.Method.Alg() == alg {
+			return k, true
+		}
+	}
+	return Key{}, false
+}
+
+// algorithmSelector is implemented by providers that can sign with more than one algorithm.
+type algorithmSelector interface {
+	CurrentFor(alg string) (Key, bool)
+}
+
+// signingKeyForAlg returns the current key bound to alg from keys.
+func signingKeyForAlg(keys SigningKeyProvider, alg string) (Key, bool) {
+	if sel, ok := keys.(algorithmSelector); ok {
+		return sel.CurrentFor(alg)
+	}
+	if k := keys.Current(); k.Method.Alg() == alg {
+		return k, true
+	}
+	return Key{}, false
+}
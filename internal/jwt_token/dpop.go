@@ -0,0 +1,254 @@
+package jwttoken
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	dErrors "credo/pkg/domain-errors"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// DPoP proof parameters (RFC 9449).
+const (
+	DPoPProofType       = "dpop+jwt"
+	TokenTypeDPoP       = "DPoP"
+	defaultDPoPMaxAge   = 60 * time.Second
+	dpopClockSkewFuture = 5 * time.Second
+)
+
+// Confirmation is the cnf claim binding an access token to a proof-of-possession key (RFC 7800).
+type Confirmation struct {
+	JKT string `json:"jkt"` // RFC 7638 thumbprint of the DPoP public key
+}
+
+// DPoPProofClaims are the claims of a DPoP proof JWT (RFC 9449 §4.2).
+type DPoPProofClaims struct {
+	HTM string `json:"htm"`
+	HTU string `json:"htu"`
+	ATH string `json:"ath,omitempty"` // Hash of the access token, required at resource access
+	jwt.RegisteredClaims
+}
+
+// DPoPVerifier validates DPoP proofs presented at the token endpoint and on
+// resource access. The expected htu is built from the configured base URL rather
+// than the Host header, so proofs cannot be minted for a spoofed origin.
+//
+// Proof jti values are remembered for the acceptance window to reject replays.
+// The cache is per-instance; a proof replayed against another instance is still
+// bounded by the short maxAge.
+type DPoPVerifier struct {
+	baseURL string
+	maxAge  time.Duration
+
+	mu   sync.Mutex
+	seen map[string]time.Time // jti -> expiry
+}
+
+// NewDPoPVerifier creates a verifier for proofs targeting baseURL.
+// A non-positive maxAge uses the default acceptance window.
+func NewDPoPVerifier(baseURL string, maxAge time.Duration) *DPoPVerifier {
+	if maxAge <= 0 {
+		maxAge = defaultDPoPMaxAge
+	}
+	return &DPoPVerifier{
+		baseURL: strings.TrimRight(baseURL, "/"),
+		maxAge:  maxAge,
+		seen:    make(map[string]time.Time),
+	}
+}
+
+// VerifyProof validates a DPoP proof for an HTTP method and request path and
+// returns the thumbprint of the proof key. When accessToken is non-empty the
+// proof must carry a matching ath claim.
+func (v *DPoPVerifier) VerifyProof(proof, method, path, accessToken string, now time.Time) (string, error) {
+	if proof == "" {
+		return "", dErrors.New(dErrors.CodeInvalidDPoPProof, "DPoP proof required")
+	}
+
+	var jwk JWK
+	claims := new(DPoPProofClaims)
+	_, err := jwt.ParseWithClaims(proof, claims, func(t *jwt.Token) (any, error) {
+		if typ, _ := t.Header["typ"].(string); typ != DPoPProofType { //nolint:errcheck // absent typ rejected
+			return nil, errors.New("typ must be dpop+jwt")
+		}
+		var keyErr error
+		jwk, keyErr = proofJWK(t.Header["jwk"])
+		if keyErr != nil {
+			return nil, keyErr
+		}
+		return jwk.PublicKey()
+	},
+		jwt.WithValidMethods([]string{AlgRS256, AlgES256}),
+		jwt.WithoutClaimsValidation(),
+	)
+	if err != nil {
+		return "", dErrors.New(dErrors.CodeInvalidDPoPProof, "invalid DPoP proof")
+	}
+
+	if err := v.checkClaims(claims, method, path, accessToken, now); err != nil {
+		return "", err
+	}
+
+	jkt, err := JWKThumbprint(jwk)
+	if err != nil {
+		return "", dErrors.New(dErrors.CodeInvalidDPoPProof, "invalid DPoP proof key")
+	}
+	return jkt, nil
+}
+
+func (v *DPoPVerifier) checkClaims(claims *DPoPProofClaims, method, path, accessToken string, now time.Time) error {
+	if !strings.EqualFold(claims.HTM, method) {
+		return dErrors.New(dErrors.CodeInvalidDPoPProof, "DPoP proof htm mismatch")
+	}
+	if normalizeHTU(claims.HTU) != v.baseURL+path {
+		return dErrors.New(dErrors.CodeInvalidDPoPProof, "DPoP proof htu mismatch")
+	}
+	if claims.IssuedAt == nil {
+		return dErrors.New(dErrors.CodeInvalidDPoPProof, "DPoP proof missing iat")
+	}
+	iat := claims.IssuedAt.Time
+	if iat.Before(now.Add(-v.maxAge)) || iat.After(now.Add(dpopClockSkewFuture)) {
+		return dErrors.New(dErrors.CodeInvalidDPoPProof, "DPoP proof expired or not yet valid")
+	}
+	if accessToken != "" && claims.ATH != AccessTokenHash(accessToken) {
+		return dErrors.New(dErrors.CodeInvalidDPoPProof, "DPoP proof ath mismatch")
+	}
+	if claims.ID == "" {
+		return dErrors.New(dErrors.CodeInvalidDPoPProof, "DPoP proof missing jti")
+	}
+	if !v.markSeen(claims.ID, iat.Add(v.maxAge), now) {
+		return dErrors.New(dErrors.CodeInvalidDPoPProof, "DPoP proof replayed")
+	}
+	return nil
+}
+
+// markSeen records jti and reports whether it was unused. Expired entries are
+// pruned on each call so the cache stays bounded by the acceptance window.
+func (v *DPoPVerifier) markSeen(jti string, expiry, now time.Time) bool {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	for k, exp := range v.seen {
+		if now.After(exp) {
+			delete(v.seen, k)
+		}
+	}
+	if _, dup := v.seen[jti]; dup {
+		return false
+	}
+	v.seen[jti] = expiry
+	return true
+}
+
+// normalizeHTU strips query and fragment, which are excluded from htu comparison (RFC 9449 §4.3).
+func normalizeHTU(htu string) string {
+	u, err := url.Parse(htu)
+	if err != nil {
+		return ""
+	}
+	u.RawQuery, u.Fragment = "", ""
+	return u.String()
+}
+
+// AccessTokenHash returns the ath value for an access token: base64url(SHA-256(token)).
+func AccessTokenHash(accessToken string) string {
+	sum := sha256.Sum256([]byte(accessToken))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// proofJWK decodes the jwk header of a DPoP proof. Headers carrying private
+// key material are rejected.
+func proofJWK(raw any) (JWK, error) {
+	fields, ok := raw.(map[string]any)
+	if !ok {
+		return JWK{}, errors.New("missing jwk header")
+	}
+	if _, private := fields["d"]; private {
+		return JWK{}, errors.New("jwk header must not contain a private key")
+	}
+	data, err := json.Marshal(fields)
+	if err != nil {
+		return JWK{}, err
+	}
+	var jwk JWK
+	if err := json.Unmarshal(data, &jwk); err != nil {
+		return JWK{}, err
+	}
+	return jwk, nil
+}
+
+// PublicKey decodes an RSA or P-256 EC JWK into a crypto public key.
+func (k JWK) PublicKey() (any, error) {
+	dec := base64.RawURLEncoding.DecodeString
+	switch k.Kty {
+	case "RSA":
+		n, err := dec(k.N)
+		if err != nil {
+			return nil, fmt.Errorf("invalid n: %w", err)
+		}
+		e, err := dec(k.E)
+		if err != nil {
+			return nil, fmt.Errorf("invalid e: %w", err)
+		}
+		exp := new(big.Int).SetBytes(e)
+		if !exp.IsInt64() || exp.Int64() > 1<<31-1 {
+			return nil, errors.New("invalid e")
+		}
+		return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(exp.Int64())}, nil
+	case "EC":
+		if k.Crv != elliptic.P256().Params().Name {
+			return nil, fmt.Errorf("unsupported curve %q", k.Crv)
+		}
+		x, err := dec(k.X)
+		if err != nil {
+			return nil, fmt.Errorf("invalid x: %w", err)
+		}
+		y, err := dec(k.Y)
+		if err != nil {
+			return nil, fmt.Errorf("invalid y: %w", err)
+		}
+		// ParseUncompressedPublicKey rejects points that are not on the curve.
+		raw := append([]byte{0x04}, append(leftPad(x, 32), leftPad(y, 32)...)...)
+		pub, err := ecdsa.ParseUncompressedPublicKey(elliptic.P256(), raw)
+		if err != nil {
+			return nil, fmt.Errorf("invalid EC point: %w", err)
+		}
+		return pub, nil
+	default:
+		return nil, fmt.Errorf("unsupported kty %q", k.Kty)
+	}
+}
+
+func leftPad(b []byte, size int) []byte {
+	if len(b) >= size {
+		return b
+	}
+	return append(make([]byte, size-len(b)), b...)
+}
+
+// JWKThumbprint computes the RFC 7638 SHA-256 thumbprint of a public JWK.
+// Members are serialized in lexicographic order with no whitespace.
+func JWKThumbprint(k JWK) (string, error) {
+	var canonical string
+	switch k.Kty {
+	case "RSA":
+		canonical = fmt.Sprintf(`{"e":%q,"kty":"RSA","n":%q}`, k.E, k.N)
+	case "EC":
+		canonical = fmt.Sprintf(`{"crv":%q,"kty":"EC","x":%q,"y":%q}`, k.Crv, k.X, k.Y)
+	default:
+		return "", fmt.Errorf("unsupported kty %q", k.Kty)
+	}
+	sum := sha256.Sum256([]byte(canonical))
+	return base64.RawURLEncoding.EncodeToString(sum[:]), nil
+}
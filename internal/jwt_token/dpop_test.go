@@ -0,0 +1,126 @@
+package jwttoken
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"testing"
+	"time"
+
+	id "credo/pkg/domain"
+	"credo/pkg/requestcontext"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const dpopBaseURL = "https://auth.example.com"
+
+type dpopHolder struct {
+	priv *ecdsa.PrivateKey
+	jwk  JWK
+}
+
+func newDPoPHolder(t *testing.T) dpopHolder {
+	t.Helper()
+	priv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	key, err := NewECDSAKey(priv)
+	require.NoError(t, err)
+	jwk, ok := toJWK(key)
+	require.True(t, ok)
+	return dpopHolder{priv: priv, jwk: jwk}
+}
+
+func (h dpopHolder) proof(t *testing.T, method, htu, accessToken string, iat time.Time) string {
+	t.Helper()
+	claims := DPoPProofClaims{
+		HTM: method,
+		HTU: htu,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:       uuid.NewString(),
+			IssuedAt: jwt.NewNumericDate(iat),
+		},
+	}
+	if accessToken != "" {
+		claims.ATH = AccessTokenHash(accessToken)
+	}
+	token := jwt.NewWithClaims(jwt.SigningMethodES256, claims)
+	token.Header["typ"] = DPoPProofType
+	token.Header["jwk"] = map[string]any{"kty": h.jwk.Kty, "crv": h.jwk.Crv, "x": h.jwk.X, "y": h.jwk.Y}
+	signed, err := token.SignedString(h.priv)
+	require.NoError(t, err)
+	return signed
+}
+
+func Test_DPoP_BindsAccessTokenToProofKey(t *testing.T) {
+	now := time.Now()
+	verifier := NewDPoPVerifier(dpopBaseURL, time.Minute)
+	holder := newDPoPHolder(t)
+
+	// Token endpoint: verify the proof and bind the issued access token to its key
+	jkt, err := verifier.VerifyProof(holder.proof(t, "POST", dpopBaseURL+"/auth/token", "", now), "POST", "/auth/token", "", now)
+	require.NoError(t, err)
+	expected, err := JWKThumbprint(holder.jwk)
+	require.NoError(t, err)
+	assert.Equal(t, expected, jkt)
+
+	ctx := requestcontext.WithDPoPThumbprint(context.Background(), jkt)
+	accessToken, err := jwtService.GenerateAccessToken(ctx, userID, sessionID, clientID, tenantID, []string{"read"}, id.APIVersionV1)
+	require.NoError(t, err)
+	claims, err := jwtService.ValidateToken(accessToken)
+	require.NoError(t, err)
+	require.NotNil(t, claims.Confirmation)
+	assert.Equal(t, jkt, claims.Confirmation.JKT)
+	assert.Equal(t, jkt, ToMiddlewareClaims(claims).DPoPJKT)
+
+	// Resource access: a fresh proof from the same key, bound to the token via ath
+	resourceJKT, err := verifier.VerifyProof(holder.proof(t, "GET", dpopBaseURL+"/auth/userinfo", accessToken, now), "GET", "/auth/userinfo", accessToken, now)
+	require.NoError(t, err)
+	assert.Equal(t, claims.Confirmation.JKT, resourceJKT)
+}
+
+func Test_DPoP_RejectsReplayedTokenAndProof(t *testing.T) {
+	now := time.Now()
+	verifier := NewDPoPVerifier(dpopBaseURL, time.Minute)
+	holder := newDPoPHolder(t)
+	attacker := newDPoPHolder(t)
+	accessToken := "stolen-access-token"
+
+	t.Run("proof from a different key yields a mismatched thumbprint", func(t *testing.T) {
+		holderJKT, err := JWKThumbprint(holder.jwk)
+		require.NoError(t, err)
+		jkt, err := verifier.VerifyProof(attacker.proof(t, "GET", dpopBaseURL+"/auth/userinfo", accessToken, now), "GET", "/auth/userinfo", accessToken, now)
+		require.NoError(t, err)
+		assert.NotEqual(t, holderJKT, jkt)
+	})
+
+	t.Run("proof replayed by jti", func(t *testing.T) {
+		proof := holder.proof(t, "GET", dpopBaseURL+"/auth/userinfo", accessToken, now)
+		_, err := verifier.VerifyProof(proof, "GET", "/auth/userinfo", accessToken, now)
+		require.NoError(t, err)
+		_, err = verifier.VerifyProof(proof, "GET", "/auth/userinfo", accessToken, now)
+		require.ErrorContains(t, err, "replayed")
+	})
+
+	t.Run("proof for another access token", func(t *testing.T) {
+		proof := holder.proof(t, "GET", dpopBaseURL+"/auth/userinfo", "other-token", now)
+		_, err := verifier.VerifyProof(proof, "GET", "/auth/userinfo", accessToken, now)
+		require.ErrorContains(t, err, "ath mismatch")
+	})
+
+	t.Run("proof for another endpoint", func(t *testing.T) {
+		proof := holder.proof(t, "GET", dpopBaseURL+"/auth/sessions", accessToken, now)
+		_, err := verifier.VerifyProof(proof, "GET", "/auth/userinfo", accessToken, now)
+		require.ErrorContains(t, err, "htu mismatch")
+	})
+
+	t.Run("stale proof", func(t *testing.T) {
+		proof := holder.proof(t, "GET", dpopBaseURL+"/auth/userinfo", accessToken, now.Add(-2*time.Minute))
+		_, err := verifier.VerifyProof(proof, "GET", "/auth/userinfo", accessToken, now)
+		require.ErrorContains(t, err, "expired")
+	})
+}
@@ -0,0 +1,76 @@
+package jwttoken
+
+import (
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"encoding/base64"
+	"math/big"
+)
+
+// JWK is a public key in JSON Web Key format (RFC 7517).
+type JWK struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	// RSA
+	N string `json:"n,omitempty"`
+	E string `json:"e,omitempty"`
+	// EC
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+	Y   string `json:"y,omitempty"`
+}
+
+// JWKSet is the JWKS document served to resource servers (RFC 7517 §5).
+type JWKSet struct {
+	Keys []JWK `json:"keys"`
+}
+
+// JWKS returns the public verification keys for all asymmetric verifiers.
+// Symmetric (HMAC) keys are shared secrets and are never included.
+func (s *JWTService) JWKS() JWKSet {
+	set := JWKSet{Keys: []JWK{}}
+	for _, k := range s.keys.Verifiers() {
+		if k.IsSymmetric() {
+			continue
+		}
+		if jwk, ok := toJWK(k); ok {
+			set.Keys = append(set.Keys, jwk)
+		}
+	}
+	return set
+}
+
+func toJWK(k Key) (JWK, bool) {
+	b64 := base64.RawURLEncoding.EncodeToString
+	switch pub := k.VerifyKey.(type) {
+	case *rsa.PublicKey:
+		return JWK{
+			Kty: "RSA",
+			Kid: k.ID,
+			Use: "sig",
+			Alg: k.Method.Alg(),
+			N:   b64(pub.N.Bytes()),
+			E:   b64(big.NewInt(int64(pub.E)).Bytes()),
+		}, true
+	case *ecdsa.PublicKey:
+		ecdhKey, err := pub.ECDH()
+		if err != nil {
+			return JWK{}, false
+		}
+		// Uncompressed point encoding: 0x04 || X || Y
+		raw := ecdhKey.Bytes()[1:]
+		size := len(raw) / 2
+		return JWK{
+			Kty: "EC",
+			Kid: k.ID,
+			Use: "sig",
+			Alg: k.Method.Alg(),
+			Crv: pub.Curve.Params().Name,
+			X:   b64(raw[:size]),
+			Y:   b64(raw[size:]),
+		}, true
+	}
+	return JWK{}, false
+}
@@ -26,9 +26,65 @@ type AccessTokenClaims struct {
 	TenantID  string   `json:"tenant_id,omitempty"`
 	Env       string   `json:"env,omitempty"`
 	Scope     []string `json:"scope"`
+	// Confirmation binds the token to a DPoP key; bound tokens must be presented with a proof.
+	Confirmation *Confirmation `json:"cnf,omitempty"`
+	// Actor names who acts for the user on a token issued by token exchange (RFC 8693 §4.1).
+	Actor *Actor `json:"act,omitempty"`
 	jwt.RegisteredClaims
 }
 
+// Actor identifies a delegated party by client ID. A nested Actor is the party
+// that acted before it, so the outermost actor is the current one.
+type Actor struct {
+	Subject string `json:"sub"`
+	Actor   *Actor `json:"act,omitempty"`
+}
+
+// Chain returns the actor subjects, current actor first.
+func (a *Actor) Chain() []string {
+	var chain []string
+	for ; a != nil; a = a.Actor {
+		chain = append(chain, a.Subject)
+	}
+	return chain
+}
+
+// actorFromChain nests a delegation chain (current actor first) into an act claim.
+func actorFromChain(chain []string) *Actor {
+	var act *Actor
+	for i := len(chain) - 1; i >= 0; i-- {
+		act = &Actor{Subject: chain[i], Actor: act}
+	}
+	return act
+}
+
+// AccessTokenOptions carries claims specific to one issued access token.
+type AccessTokenOptions struct {
+	// Actors is the delegation chain for an exchanged token, current actor first.
+	Actors []string
+	// Resources are the resource indicators (RFC 8707) the token is requested for.
+	Resources []string
+}
+
+// AccessTokenOption configures a single access token.
+type AccessTokenOption func(*AccessTokenOptions)
+
+// WithActors issues the token for a delegation chain (current actor first),
+// nested into the act claim.
+func WithActors(chain []string) AccessTokenOption {
+	return func(o *AccessTokenOptions) {
+		o.Actors = chain
+	}
+}
+
+// WithResources issues the token for the requested resource servers, which
+// replace the configured resource audiences.
+func WithResources(resources []string) AccessTokenOption {
+	return func(o *AccessTokenOptions) {
+		o.Resources = resources
+	}
+}
+
 // APIVersion extracts the API version from the token's audience claim.
 // The versioned audience format is "credo-client:v1" where the suffix after ":" is the version.
 // If no versioned audience is found, returns APIVersionV1 for backward compatibility.
@@ -55,27 +111,118 @@ type IDTokenClaims struct {
 	jwt.RegisteredClaims
 }
 
-// JWTService handles JWT creation and validation
+// JWTService handles JWT creation and validation.
+// Key material is read through a SigningKeyProvider so keys can rotate without
+// code changes; tokens carry a kid header identifying the signing key.
 type JWTService struct {
-	signingKey    []byte
+	keys          SigningKeyProvider
 	issuerBaseURL string // Base URL for per-tenant issuers (RFC 8414)
-	audience      string
+	audience      string // Credo's own audience; every token carries it and validation requires it
+	resourceAuds  []string
 	tokenTTL      time.Duration
 	env           string
+	clientAlgs    map[string]string // client ID -> signing alg; unset clients use keys.Current()
 }
 
 // TokenTypeBearer is the OAuth2 token_type value for bearer access tokens.
 const TokenTypeBearer = "Bearer"
 
+// NewJWTService creates a JWT service that signs with a single HS256 secret.
 func NewJWTService(signingKey string, issuerBaseURL string, audience string, tokenTTL time.Duration) *JWTService {
+	return NewJWTServiceWithKeys(NewHMACKeyProvider(signingKey), issuerBaseURL, audience, tokenTTL)
+}
+
+// NewJWTServiceWithKeys creates a JWT service backed by the given key provider.
+func NewJWTServiceWithKeys(keys SigningKeyProvider, issuerBaseURL string, audience string, tokenTTL time.Duration) *JWTService {
 	return &JWTService{
-		signingKey:    []byte(signingKey),
+		keys:          keys,
 		issuerBaseURL: issuerBaseURL,
 		audience:      audience,
 		tokenTTL:      tokenTTL,
 	}
 }
 
+// SetResourceAudiences adds resource server identifiers to the audience of
+// every access token, so downstream APIs can require their own identifier.
+func (s *JWTService) SetResourceAudiences(audiences []string) {
+	s.resourceAuds = audiences
+}
+
+// tokenAudience builds the aud claim: the base audience (backward compat), the
+// versioned audience (e.g. "credo-client:v1"), the requesting client and, for
+// access tokens, the resource servers. Resource indicators requested for this
+// token (RFC 8707) replace the configured resource audiences.
+func (s *JWTService) tokenAudience(clientID id.ClientID, apiVersion id.APIVersion, includeResources bool, requested []string) []string {
+	audience := []string{
+		s.audience,
+		fmt.Sprintf("%s:%s", s.audience, apiVersion),
+	}
+	if !clientID.IsNil() {
+		audience = append(audience, clientID.String())
+	}
+	if !includeResources {
+		return audience
+	}
+	if len(requested) > 0 {
+		return append(audience, requested...)
+	}
+	return append(audience, s.resourceAuds...)
+}
+
+// validateIssuer requires iss to be the base issuer or a per-tenant issuer under
+// it. When tenantID is known the per-tenant issuer must name that tenant, so a
+// token cannot claim one tenant while being issued under another.
+func (s *JWTService) validateIssuer(issuer, tenantID string) error {
+	issuerTenant, err := s.ExtractTenantFromIssuer(issuer)
+	if err != nil {
+		return dErrors.New(dErrors.CodeInvalidInput, "invalid token issuer")
+	}
+	if issuerTenant != "" && tenantID != "" && issuerTenant != tenantID {
+		return dErrors.New(dErrors.CodeInvalidInput, "invalid token issuer")
+	}
+	return nil
+}
+
+// parseError maps claim validation failures to domain errors.
+func parseError(err error) error {
+	switch {
+	case errors.Is(err, jwt.ErrTokenExpired):
+		return dErrors.New(dErrors.CodeInvalidGrant, "token expired")
+	case errors.Is(err, jwt.ErrTokenInvalidAudience):
+		return dErrors.New(dErrors.CodeInvalidInput, "invalid token audience")
+	default:
+		return dErrors.New(dErrors.CodeInvalidInput, "invalid token")
+	}
+}
+
+// SetClientSigningAlgs configures per-client signing algorithms, keyed by client ID.
+// Every configured algorithm must have a signing key available; otherwise the
+// client would receive tokens it cannot verify (e.g. an HS256 client handed an
+// RS256 token), so configuration is rejected up front.
+func (s *JWTService) SetClientSigningAlgs(algs map[string]string) error {
+	for clientID, alg := range algs {
+		if _, ok := signingKeyForAlg(s.keys, alg); !ok {
+			return fmt.Errorf("client %s: no signing key configured for %s", clientID, alg)
+		}
+	}
+	s.clientAlgs = algs
+	return nil
+}
+
+// signingKey returns the key to sign tokens for clientID. Clients pinned to an
+// algorithm never fall back to a different one.
+func (s *JWTService) signingKey(clientID id.ClientID) (Key, error) {
+	alg, ok := s.clientAlgs[clientID.String()]
+	if !ok {
+		return s.keys.Current(), nil
+	}
+	key, ok := signingKeyForAlg(s.keys, alg)
+	if !ok {
+		return Key{}, fmt.Errorf("no signing key for %s", alg)
+	}
+	return key, nil
+}
+
 // BuildIssuer constructs a per-tenant issuer URL following RFC 8414 format.
 // Format: {baseURL}/tenants/{tenantID}
 func (s *JWTService) BuildIssuer(tenantID id.TenantID) string {
@@ -107,15 +254,14 @@ func (s *JWTService) GenerateAccessTokenWithJTI(
 	tenantID id.TenantID,
 	scopes []string,
 	apiVersion id.APIVersion,
+	opts ...AccessTokenOption,
 ) (string, string, error) {
-	newToken, err := s.GenerateAccessToken(ctx, userID, sessionID, clientID, tenantID, scopes, apiVersion)
+	newToken, err := s.GenerateAccessToken(ctx, userID, sessionID, clientID, tenantID, scopes, apiVersion, opts...)
 	if err != nil {
 		return "", "", err
 	}
 	// Extract the JTI from the token
-	parsed, err := jwt.ParseWithClaims(newToken, &AccessTokenClaims{}, func(token *jwt.Token) (interface{}, error) {
-		return s.signingKey, nil
-	})
+	parsed, err := jwt.ParseWithClaims(newToken, &AccessTokenClaims{}, verificationKeyFunc(s.keys))
 	if err != nil {
 		return "", "", err
 	}
@@ -139,10 +285,15 @@ func (s *JWTService) GenerateAccessToken(
 	tenantID id.TenantID,
 	scopes []string,
 	apiVersion id.APIVersion,
+	opts ...AccessTokenOption,
 ) (string, error) {
 	if len(scopes) == 0 {
 		return "", dErrors.New(dErrors.CodeInvalidInput, "scopes cannot be empty")
 	}
+	var options AccessTokenOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
 
 	// Default to v1 if no version specified
 	if apiVersion.IsNil() {
@@ -157,14 +308,13 @@ func (s *JWTService) GenerateAccessToken(
 	jti := hex.EncodeToString(b)
 	now := requestcontext.Now(ctx)
 
-	// Build versioned audience: includes both base audience (backward compat)
-	// and versioned audience (e.g., "credo-client:v1")
-	audience := []string{
-		s.audience,
-		fmt.Sprintf("%s:%s", s.audience, apiVersion),
-	}
+	audience := s.tokenAudience(clientID, apiVersion, true, options.Resources)
 
-	newToken := jwt.NewWithClaims(jwt.SigningMethodHS256, AccessTokenClaims{
+	key, err := s.signingKey(clientID)
+	if err != nil {
+		return "", err
+	}
+	claims := AccessTokenClaims{
 		UserID:    userID.String(),
 		SessionID: sessionID.String(),
 		ClientID:  clientID.String(),
@@ -178,9 +328,14 @@ func (s *JWTService) GenerateAccessToken(
 			Audience:  audience,
 			ID:        jti,
 		},
-	})
+	}
+	// Bind to the DPoP key verified at the token endpoint (RFC 9449 §6)
+	if jkt := requestcontext.DPoPThumbprint(ctx); jkt != "" {
+		claims.Confirmation = &Confirmation{JKT: jkt}
+	}
+	claims.Actor = actorFromChain(options.Actors)
 
-	signedToken, err := newToken.SignedString(s.signingKey)
+	signedToken, err := sign(key, claims)
 	if err != nil {
 		return "", err
 	}
@@ -194,8 +349,8 @@ func (s *JWTService) GenerateAccessToken(
 //   - Token revocation where we need to extract JTI from expired tokens
 //
 // This method STILL validates:
-//   - Signature (token must be signed with our key)
-//   - Algorithm (must be HS256)
+//   - Signature (token must be signed with one of our verification keys)
+//   - Algorithm (must match the algorithm bound to that key)
 //
 // Callers MUST perform additional business validation:
 //   - Check refresh token validity in database
@@ -208,16 +363,11 @@ func (s *JWTService) ParseTokenSkipClaimsValidation(tokenString string) (*Access
 
 	claims := new(AccessTokenClaims)
 
-	token, err := jwt.ParseWithClaims(tokenString, claims, func(t *jwt.Token) (any, error) {
-		if t.Method.Alg() != jwt.SigningMethodHS256.Alg() {
-			return nil, dErrors.New(dErrors.CodeInvalidInput, "unexpected signing algorithm")
-		}
-		return s.signingKey, nil
-	},
+	token, err := jwt.ParseWithClaims(tokenString, claims, verificationKeyFunc(s.keys),
 		jwt.WithoutClaimsValidation(),
 	)
 	if err != nil {
-		if errors.Is(err, jwt.ErrSignatureInvalid) {
+		if errors.Is(err, jwt.ErrSignatureInvalid) || errors.Is(err, errNoVerificationKey) {
 			return nil, dErrors.New(dErrors.CodeInvalidInput, "invalid jwt signature")
 		}
 		return nil, dErrors.New(dErrors.CodeInvalidInput, "jwt parse failed")
@@ -245,14 +395,13 @@ func (s *JWTService) GenerateIDToken(
 
 	now := requestcontext.Now(ctx)
 
-	// Build versioned audience: includes both base audience (backward compat)
-	// and versioned audience (e.g., "credo-client:v1")
-	audience := []string{
-		s.audience,
-		fmt.Sprintf("%s:%s", s.audience, apiVersion),
-	}
+	audience := s.tokenAudience(clientID, apiVersion, false, nil)
 
-	newToken := jwt.NewWithClaims(jwt.SigningMethodHS256, IDTokenClaims{
+	key, err := s.signingKey(clientID)
+	if err != nil {
+		return "", err
+	}
+	signedToken, err := sign(key, IDTokenClaims{
 		SessionID: sessionID.String(),
 		ClientID:  clientID.String(),
 		Env:       s.env,
@@ -265,8 +414,6 @@ func (s *JWTService) GenerateIDToken(
 			ID:        uuid.NewString(),
 		},
 	})
-
-	signedToken, err := newToken.SignedString(s.signingKey)
 	if err != nil {
 		return "", err
 	}
@@ -278,19 +425,15 @@ func (s *JWTService) SetEnv(env string) {
 	s.env = env
 }
 
+// ValidateToken verifies an access token's signature, expiry, audience and issuer.
+// The audience must include the configured base audience and the issuer must be
+// the per-tenant issuer for the token's tenant.
 func (s *JWTService) ValidateToken(tokenString string) (*AccessTokenClaims, error) {
-	parsed, err := jwt.ParseWithClaims(tokenString, &AccessTokenClaims{}, func(token *jwt.Token) (any, error) {
-		if token.Method.Alg() != jwt.SigningMethodHS256.Alg() {
-			return nil, jwt.ErrTokenUnverifiable
-		}
-		return s.signingKey, nil
-	})
-
+	parsed, err := jwt.ParseWithClaims(tokenString, &AccessTokenClaims{}, verificationKeyFunc(s.keys),
+		jwt.WithAudience(s.audience),
+	)
 	if err != nil {
-		if errors.Is(err, jwt.ErrTokenExpired) {
-			return nil, dErrors.New(dErrors.CodeInvalidGrant, "token expired")
-		}
-		return nil, dErrors.New(dErrors.CodeInvalidInput, "invalid token")
+		return nil, parseError(err)
 	}
 
 	if !parsed.Valid {
@@ -302,27 +445,20 @@ func (s *JWTService) ValidateToken(tokenString string) (*AccessTokenClaims, erro
 		return nil, dErrors.New(dErrors.CodeInvalidInput, "invalid token claims")
 	}
 
-	// Explicit issuer validation: token issuer must match our configured base URL
-	if !strings.HasPrefix(claims.Issuer, s.issuerBaseURL) {
-		return nil, dErrors.New(dErrors.CodeInvalidInput, "invalid token issuer")
+	if err := s.validateIssuer(claims.Issuer, claims.TenantID); err != nil {
+		return nil, err
 	}
 
 	return claims, nil
 }
 
+// ValidateIDToken verifies an ID token's signature, expiry, audience and issuer.
 func (s *JWTService) ValidateIDToken(tokenString string) (*IDTokenClaims, error) {
-	parsed, err := jwt.ParseWithClaims(tokenString, &IDTokenClaims{}, func(token *jwt.Token) (interface{}, error) {
-		if token.Method.Alg() != jwt.SigningMethodHS256.Alg() {
-			return nil, jwt.ErrTokenUnverifiable
-		}
-		return s.signingKey, nil
-	})
-
+	parsed, err := jwt.ParseWithClaims(tokenString, &IDTokenClaims{}, verificationKeyFunc(s.keys),
+		jwt.WithAudience(s.audience),
+	)
 	if err != nil {
-		if errors.Is(err, jwt.ErrTokenExpired) {
-			return nil, dErrors.New(dErrors.CodeInvalidGrant, "token expired")
-		}
-		return nil, dErrors.New(dErrors.CodeInvalidInput, "invalid token")
+		return nil, parseError(err)
 	}
 
 	if !parsed.Valid {
@@ -334,6 +470,10 @@ func (s *JWTService) ValidateIDToken(tokenString string) (*IDTokenClaims, error)
 		return nil, dErrors.New(dErrors.CodeInvalidInput, "invalid token claims")
 	}
 
+	if err := s.validateIssuer(claims.Issuer, ""); err != nil {
+		return nil, err
+	}
+
 	return claims, nil
 }
 
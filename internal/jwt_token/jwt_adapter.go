@@ -5,13 +5,17 @@ import (
 )
 
 func ToMiddlewareClaims(claims *AccessTokenClaims) *authmw.JWTClaims {
-	return &authmw.JWTClaims{
+	out := &authmw.JWTClaims{
 		UserID:     claims.UserID,
 		SessionID:  claims.SessionID,
 		ClientID:   claims.ClientID,
 		JTI:        claims.ID,                    // JWT ID for revocation tracking
 		APIVersion: claims.APIVersion().String(), // API version from token audience
 	}
+	if claims.Confirmation != nil {
+		out.DPoPJKT = claims.Confirmation.JKT
+	}
+	return out
 }
 
 type JWTServiceAdapter struct {
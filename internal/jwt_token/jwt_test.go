@@ -2,6 +2,8 @@ package jwttoken
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/rsa"
 	"testing"
 	"time"
 
@@ -260,6 +262,120 @@ func Test_ValidateToken_RejectsInvalidIssuer(t *testing.T) {
 	assert.Contains(t, err.Error(), "invalid token issuer")
 }
 
+func Test_ValidateToken_RejectsIssuerPrefixSpoof(t *testing.T) {
+	ctx := context.Background()
+	// Shares the configured base URL as a string prefix but is a different host
+	spoofService := NewJWTService("test-signing-key", "test-issuer.evil.example", "test-audience", time.Hour)
+	token, err := spoofService.GenerateAccessToken(ctx, userID, sessionID, clientID, tenantID, []string{"read"}, id.APIVersionV1)
+	require.NoError(t, err)
+
+	_, err = jwtService.ValidateToken(token)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid token issuer")
+}
+
+func Test_TokenIssuerAndAudience(t *testing.T) {
+	ctx := context.Background()
+	service := NewJWTService("signing-key", "https://auth.example.com", "credo-client", time.Hour)
+	service.SetResourceAudiences([]string{"https://api.example.com"})
+
+	t.Run("access token carries configured issuer and audience", func(t *testing.T) {
+		token, err := service.GenerateAccessToken(ctx, userID, sessionID, clientID, tenantID, []string{"openid"}, id.APIVersionV1)
+		require.NoError(t, err)
+
+		claims, err := service.ValidateToken(token)
+		require.NoError(t, err)
+		assert.Equal(t, "https://auth.example.com/tenants/"+tenantID.String(), claims.Issuer)
+		assert.ElementsMatch(t, jwt.ClaimStrings{
+			"credo-client",
+			"credo-client:v1",
+			clientID.String(),
+			"https://api.example.com",
+		}, claims.Audience)
+	})
+
+	t.Run("requested resources replace configured resource audiences", func(t *testing.T) {
+		token, err := service.GenerateAccessToken(ctx, userID, sessionID, clientID, tenantID, []string{"openid"}, id.APIVersionV1,
+			WithResources([]string{"https://billing.example.com", "https://ledger.example.com"}))
+		require.NoError(t, err)
+
+		claims, err := service.ValidateToken(token)
+		require.NoError(t, err)
+		assert.Contains(t, claims.Audience, "https://billing.example.com")
+		assert.Contains(t, claims.Audience, "https://ledger.example.com")
+		assert.Contains(t, claims.Audience, "credo-client", "base audience is kept so validation still passes")
+		assert.NotContains(t, claims.Audience, "https://api.example.com")
+	})
+
+	t.Run("id token audience names the client but not resource servers", func(t *testing.T) {
+		token, err := service.GenerateIDToken(ctx, userID, sessionID, clientID, tenantID, id.APIVersionV1)
+		require.NoError(t, err)
+
+		claims, err := service.ValidateIDToken(token)
+		require.NoError(t, err)
+		assert.Contains(t, claims.Audience, clientID.String())
+		assert.NotContains(t, claims.Audience, "https://api.example.com")
+	})
+
+	t.Run("token for a different audience is rejected", func(t *testing.T) {
+		other := NewJWTService("signing-key", "https://auth.example.com", "other-audience", time.Hour)
+		token, err := other.GenerateAccessToken(ctx, userID, sessionID, clientID, tenantID, []string{"openid"}, id.APIVersionV1)
+		require.NoError(t, err)
+
+		_, err = service.ValidateToken(token)
+		require.Error(t, err)
+		assert.True(t, dErrors.HasCode(err, dErrors.CodeInvalidInput))
+		assert.Contains(t, err.Error(), "invalid token audience")
+
+		idToken, err := other.GenerateIDToken(ctx, userID, sessionID, clientID, tenantID, id.APIVersionV1)
+		require.NoError(t, err)
+		_, err = service.ValidateIDToken(idToken)
+		assert.ErrorContains(t, err, "invalid token audience")
+	})
+
+	t.Run("issuer must name the token's tenant", func(t *testing.T) {
+		claims := AccessTokenClaims{
+			UserID:   userID.String(),
+			TenantID: tenantID.String(),
+			Scope:    []string{"openid"},
+			RegisteredClaims: jwt.RegisteredClaims{
+				ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Minute)),
+				Issuer:    service.BuildIssuer(id.TenantID(uuid.New())),
+				Audience:  jwt.ClaimStrings{"credo-client"},
+			},
+		}
+		token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte("signing-key"))
+		require.NoError(t, err)
+
+		_, err = service.ValidateToken(token)
+		assert.ErrorContains(t, err, "invalid token issuer")
+	})
+}
+
+func Test_GenerateAccessToken_ActorClaim(t *testing.T) {
+	ctx := context.Background()
+	service := NewJWTService("signing-key", "https://auth.example.com", "credo-client", time.Hour)
+
+	t.Run("no delegation chain omits act", func(t *testing.T) {
+		token, err := service.GenerateAccessToken(ctx, userID, sessionID, clientID, tenantID, []string{"openid"}, id.APIVersionV1)
+		require.NoError(t, err)
+
+		claims, err := service.ValidateToken(token)
+		require.NoError(t, err)
+		assert.Nil(t, claims.Actor)
+	})
+
+	t.Run("delegation chain nests current actor outermost", func(t *testing.T) {
+		token, err := service.GenerateAccessToken(ctx, userID, sessionID, clientID, tenantID, []string{"openid"}, id.APIVersionV1, WithActors([]string{"backend", "frontend"}))
+		require.NoError(t, err)
+
+		claims, err := service.ValidateToken(token)
+		require.NoError(t, err)
+		assert.Equal(t, &Actor{Subject: "backend", Actor: &Actor{Subject: "frontend"}}, claims.Actor)
+		assert.Equal(t, []string{"backend", "frontend"}, claims.Actor.Chain())
+	})
+}
+
 func Test_GenerateAccessToken_RejectsEmptyScopes(t *testing.T) {
 	ctx := context.Background()
 	_, err := jwtService.GenerateAccessToken(ctx, userID, sessionID, clientID, tenantID, []string{}, id.APIVersionV1)
@@ -394,3 +510,145 @@ func Test_GenerateIDToken_IncludesVersionedAudience(t *testing.T) {
 	assert.Contains(t, claims.Audience, "credo-client")
 	assert.Contains(t, claims.Audience, "credo-client:v1")
 }
+
+// fakeKeyProvider lets tests rotate keys and retire verifiers independently.
+type fakeKeyProvider struct {
+	current   Key
+	verifiers []Key
+}
+
+func (p *fakeKeyProvider) Current() Key     { return p.current }
+func (p *fakeKeyProvider) Verifiers() []Key { return p.verifiers }
+
+func Test_SigningKeyRotation(t *testing.T) {
+	ctx := context.Background()
+	oldKey := NewHMACKey("old-signing-key")
+	newKey := NewHMACKey("new-signing-key")
+	keys := &fakeKeyProvider{current: oldKey, verifiers: []Key{oldKey}}
+	service := NewJWTServiceWithKeys(keys, "test-issuer", "test-audience", time.Minute)
+
+	oldToken, err := service.GenerateAccessToken(ctx, userID, sessionID, clientID, tenantID, []string{"read"}, id.APIVersionV1)
+	require.NoError(t, err)
+
+	// Rotate: new key signs, old key remains a verifier
+	keys.current = newKey
+	keys.verifiers = []Key{newKey, oldKey}
+
+	newToken, err := service.GenerateAccessToken(ctx, userID, sessionID, clientID, tenantID, []string{"read"}, id.APIVersionV1)
+	require.NoError(t, err)
+
+	t.Run("new tokens carry the current kid", func(t *testing.T) {
+		parsed, _, err := jwt.NewParser().ParseUnverified(newToken, &AccessTokenClaims{})
+		require.NoError(t, err)
+		assert.Equal(t, newKey.ID, parsed.Header["kid"])
+	})
+
+	t.Run("tokens signed by the previous key still verify", func(t *testing.T) {
+		_, err := service.ValidateToken(oldToken)
+		require.NoError(t, err)
+		_, err = service.ValidateToken(newToken)
+		require.NoError(t, err)
+	})
+
+	t.Run("retired key no longer verifies", func(t *testing.T) {
+		keys.verifiers = []Key{newKey}
+		_, err := service.ValidateToken(oldToken)
+		require.Error(t, err)
+		_, err = service.ParseTokenSkipClaimsValidation(oldToken)
+		require.ErrorContains(t, err, "invalid jwt signature")
+	})
+}
+
+func Test_ValidateToken_AcceptsLegacyTokenWithoutKid(t *testing.T) {
+	claims := AccessTokenClaims{
+		UserID: userID.String(),
+		Scope:  []string{"read"},
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Minute)),
+			Issuer:    jwtService.BuildIssuer(tenantID),
+			Audience:  jwt.ClaimStrings{"test-audience"},
+		},
+	}
+	tokenString, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte("test-signing-key"))
+	require.NoError(t, err)
+
+	_, err = jwtService.ValidateToken(tokenString)
+	require.NoError(t, err)
+}
+
+func Test_JWKS_ExcludesSymmetricKeys(t *testing.T) {
+	set := jwtService.JWKS()
+	assert.Empty(t, set.Keys, "HMAC secrets must never be published")
+}
+
+func newMixedAlgService(t *testing.T) (*JWTService, Key) {
+	t.Helper()
+	priv, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	rsaKey, err := NewRSAKey(priv)
+	require.NoError(t, err)
+
+	keys := NewKeyRing(NewStaticKeyProvider(rsaKey), NewHMACKeyProvider("internal-shared-secret"))
+	return NewJWTServiceWithKeys(keys, "test-issuer", "test-audience", time.Minute), rsaKey
+}
+
+func Test_PerClientSigningAlgorithm(t *testing.T) {
+	externalClient := id.ClientID(uuid.New())
+	internalClient := id.ClientID(uuid.New())
+
+	t.Run("RS256 client token verifies against the key published in JWKS", func(t *testing.T) {
+		service, rsaKey := newMixedAlgService(t)
+		require.NoError(t, service.SetClientSigningAlgs(map[string]string{externalClient.String(): AlgRS256}))
+
+		token, err := service.GenerateAccessToken(context.Background(), userID, sessionID, externalClient, tenantID, []string{"read"}, id.APIVersionV1)
+		require.NoError(t, err)
+
+		parsed, _, err := jwt.NewParser().ParseUnverified(token, &AccessTokenClaims{})
+		require.NoError(t, err)
+		assert.Equal(t, AlgRS256, parsed.Method.Alg())
+
+		set := service.JWKS()
+		require.Len(t, set.Keys, 1, "only the asymmetric key is advertised")
+		assert.Equal(t, rsaKey.ID, set.Keys[0].Kid)
+		assert.Equal(t, parsed.Header["kid"], set.Keys[0].Kid)
+
+		_, err = jwt.Parse(token, func(*jwt.Token) (any, error) { return rsaKey.VerifyKey, nil },
+			jwt.WithValidMethods([]string{AlgRS256}))
+		require.NoError(t, err)
+	})
+
+	t.Run("HS256 client token verifies with the shared secret and is not in JWKS", func(t *testing.T) {
+		service, _ := newMixedAlgService(t)
+		require.NoError(t, service.SetClientSigningAlgs(map[string]string{internalClient.String(): AlgHS256}))
+
+		token, err := service.GenerateAccessToken(context.Background(), userID, sessionID, internalClient, tenantID, []string{"read"}, id.APIVersionV1)
+		require.NoError(t, err)
+
+		parsed, err := jwt.Parse(token, func(*jwt.Token) (any, error) { return []byte("internal-shared-secret"), nil },
+			jwt.WithValidMethods([]string{AlgHS256}))
+		require.NoError(t, err)
+		for _, k := range service.JWKS().Keys {
+			assert.NotEqual(t, parsed.Header["kid"], k.Kid, "HMAC kid must not be published")
+		}
+
+		idToken, err := service.GenerateIDToken(context.Background(), userID, sessionID, internalClient, tenantID, id.APIVersionV1)
+		require.NoError(t, err)
+		_, err = jwt.Parse(idToken, func(*jwt.Token) (any, error) { return []byte("internal-shared-secret"), nil },
+			jwt.WithValidMethods([]string{AlgHS256}))
+		require.NoError(t, err)
+	})
+
+	t.Run("unpinned clients use the default key", func(t *testing.T) {
+		service, _ := newMixedAlgService(t)
+		token, err := service.GenerateAccessToken(context.Background(), userID, sessionID, clientID, tenantID, []string{"read"}, id.APIVersionV1)
+		require.NoError(t, err)
+		claims, err := service.ValidateToken(token)
+		require.NoError(t, err)
+		assert.Equal(t, clientID.String(), claims.ClientID)
+	})
+
+	t.Run("rejects algorithm without a signing key", func(t *testing.T) {
+		err := jwtService.SetClientSigningAlgs(map[string]string{externalClient.String(): AlgRS256})
+		require.ErrorContains(t, err, "no signing key configured for RS256")
+	})
+}
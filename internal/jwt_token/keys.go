@@ -0,0 +1,143 @@
+package jwttoken
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"sync"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// Key is a single JWT signing or verification key.
+//
+// Symmetric keys (HMAC) use the same secret for SignKey and VerifyKey and are
+// never published. Asymmetric keys carry a private SignKey and a public VerifyKey
+// that is advertised through JWKS.
+type Key struct {
+	ID        string            // kid header value
+	Method    jwt.SigningMethod // Algorithm the key is bound to (prevents alg confusion)
+	SignKey   any               // []byte for HMAC, crypto.Signer for RSA/ECDSA
+	VerifyKey any               // []byte for HMAC, crypto.PublicKey for RSA/ECDSA
+}
+
+// IsSymmetric reports whether the key is a shared secret that must not be published.
+func (k Key) IsSymmetric() bool {
+	_, ok := k.Method.(*jwt.SigningMethodHMAC)
+	return ok
+}
+
+// SigningKeyProvider supplies key material for token signing and verification.
+//
+// Implementations may be backed by config/env (StaticKeyProvider), files, or a
+// KMS/secret manager. Rotation is expressed by changing Current() while keeping
+// the previous key in Verifiers() until all tokens it signed have expired.
+type SigningKeyProvider interface {
+	// Current returns the key used to sign newly issued tokens.
+	Current() Key
+	// Verifiers returns every key whose signatures are still accepted,
+	// including Current().
+	Verifiers() []Key
+}
+
+// StaticKeyProvider is the default config-backed SigningKeyProvider.
+// Keys are supplied at startup; Rotate allows swapping the current key at runtime.
+type StaticKeyProvider struct {
+	mu       sync.RWMutex
+	current  Key
+	previous []Key
+}
+
+// NewStaticKeyProvider creates a provider that signs with current and still
+// accepts signatures from the previous keys.
+func NewStaticKeyProvider(current Key, previous ...Key) *StaticKeyProvider {
+	return &StaticKeyProvider{current: current, previous: previous}
+}
+
+// NewHMACKeyProvider creates an HS256 provider from shared secrets.
+// Empty previous secrets are ignored.
+func NewHMACKeyProvider(current string, previous ...string) *StaticKeyProvider {
+	prev := make([]Key, 0, len(previous))
+	for _, secret := range previous {
+		if secret != "" {
+			prev = append(prev, NewHMACKey(secret))
+		}
+	}
+	return NewStaticKeyProvider(NewHMACKey(current), prev...)
+}
+
+// NewHMACKey builds an HS256 key. The kid is derived from a hash of the secret
+// so that the same secret always maps to the same kid across instances.
+func NewHMACKey(secret string) Key {
+	sum := sha256.Sum256([]byte(secret))
+	return Key{
+		ID:        "hs-" + hex.EncodeToString(sum[:8]),
+		Method:    jwt.SigningMethodHS256,
+		SignKey:   []byte(secret),
+		VerifyKey: []byte(secret),
+	}
+}
+
+// Current returns the active signing key.
+func (p *StaticKeyProvider) Current() Key {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return p.current
+}
+
+// Verifiers returns the current key followed by previous keys.
+func (p *StaticKeyProvider) Verifiers() []Key {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	keys := make([]Key, 0, len(p.previous)+1)
+	keys = append(keys, p.current)
+	return append(keys, p.previous...)
+}
+
+// Rotate makes next the signing key and demotes the old current key to a verifier.
+func (p *StaticKeyProvider) Rotate(next Key) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.previous = append([]Key{p.current}, p.previous...)
+	p.current = next
+}
+
+var errNoVerificationKey = errors.New("no verification key for token")
+
+// verificationKeyFunc selects verification keys for a parsed token header.
+//
+// Tokens carrying a kid are verified only against the matching key. Legacy tokens
+// without a kid are tried against every verifier. In both cases the key's bound
+// algorithm must equal the token's alg header, so an attacker cannot downgrade
+// to "none" or switch an asymmetric key into HMAC mode.
+func verificationKeyFunc(keys SigningKeyProvider) jwt.Keyfunc {
+	return func(t *jwt.Token) (any, error) {
+		alg := t.Method.Alg()
+		kid, _ := t.Header["kid"].(string) //nolint:errcheck // absent kid handled below
+
+		var candidates []jwt.VerificationKey
+		for _, k := range keys.Verifiers() {
+			if k.Method.Alg() != alg {
+				continue
+			}
+			if kid != "" && k.ID != kid {
+				continue
+			}
+			candidates = append(candidates, k.VerifyKey)
+		}
+		if len(candidates) == 0 {
+			return nil, errNoVerificationKey
+		}
+		if len(candidates) == 1 {
+			return candidates[0], nil
+		}
+		return jwt.VerificationKeySet{Keys: candidates}, nil
+	}
+}
+
+// sign signs the token with key, stamping the kid header.
+func sign(key Key, claims jwt.Claims) (string, error) {
+	token := jwt.NewWithClaims(key.Method, claims)
+	token.Header["kid"] = key.ID
+	return token.SignedString(key.SignKey)
+}
@@ -1,11 +1,27 @@
 package config
 
 import (
+	"errors"
 	"fmt"
+	"maps"
+	"math"
+	"net"
+	"net/http"
+	"net/netip"
 	"os"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
+
+	authmodels "credo/internal/auth/models"
+	refreshtoken "credo/internal/auth/store/refresh-token"
+	"credo/internal/evidence/registry/domain/citizen"
+	"credo/internal/evidence/registry/providers"
+	ratelimitmodels "credo/internal/ratelimit/models"
+	"credo/internal/ratelimit/store/globalthrottle"
+	"credo/internal/tenant/workers/purge"
+	"credo/pkg/platform/audit"
 )
 
 // Server holds core HTTP server configuration (shared across all modules)
@@ -17,13 +33,30 @@ type Server struct {
 	// Module configs
 	Auth     AuthConfig
 	Consent  ConsentConfig
+	Decision DecisionConfig
 	Registry RegistryConfig
+	Tenant   TenantConfig
 
 	// Security
 	Security SecurityConfig
 
+	// Audit
+	Audit AuditConfig
+
 	// RateLimiting
 	DisableRateLimiting bool
+	// RateLimitStandardHeaders adds IETF draft RateLimit-* headers next to X-RateLimit-*
+	RateLimitStandardHeaders bool
+	// RateLimitDebugHeaders adds X-RateLimit-Decision explaining each allow/deny decision
+	RateLimitDebugHeaders bool
+	// RateLimitGlobalShards spreads the shared global throttle counter across sub-counter rows
+	RateLimitGlobalShards int
+	// RateLimitDegradedPolicy overrides, per endpoint class, whether requests fail closed while the limiter store is down
+	RateLimitDegradedPolicy map[ratelimitmodels.EndpointClass]bool
+	// RateLimitProbe exempts monitoring probes presenting a shared secret from rate limits
+	RateLimitProbe RateLimitProbeConfig
+	// RateLimitBackoff escalates Retry-After for identifiers throttled repeatedly
+	RateLimitBackoff RateLimitBackoffConfig
 
 	// Infrastructure (Phase 2)
 	Database DatabaseConfig
@@ -70,51 +103,203 @@ type RedisConfig struct {
 // AuthConfig holds authentication and session configuration
 type AuthConfig struct {
 	JWTSigningKey                  string
-	JWTIssuerBaseURL               string // Base URL for per-tenant issuers (RFC 8414)
-	JWTAudience                    string
+	JWTPreviousSigningKeys         []string          // Retired keys still accepted for verification during rotation
+	JWTSigningAlg                  string            // Default signing alg: HS256, RS256 or ES256
+	JWTAsymmetricKeyPEM            string            // RSA or P-256 private key for RS256/ES256 signing
+	JWTClientSigningAlgs           map[string]string // Per-client alg overrides, keyed by client ID
+	JWTIssuerBaseURL               string            // Base URL for per-tenant issuers (RFC 8414)
+	JWTAudience                    string            // Audience every token carries and validation requires
+	JWTResourceAudiences           []string          // Resource server identifiers added to access token audiences
 	TokenTTL                       time.Duration
 	SessionTTL                     time.Duration
 	TokenRevocationCleanupInterval time.Duration
 	AuthCleanupInterval            time.Duration
+	RefreshTokenUsedGrace          time.Duration // How long used refresh tokens are kept for replay detection
 	AllowedRedirectSchemes         []string
+	DPoPRequiredClients            []string // Client IDs that must present DPoP proofs (RFC 9449)
 	DeviceBindingEnabled           bool
 	DeviceCookieName               string
 	DeviceCookieMaxAge             int
+	Cookie                         CookieConfig // Security attributes applied to every issued cookie
+	SessionRisk                    SessionRiskConfig
+	LocationNetworks               map[string]string // CIDR -> approximate location for sessions, login events and new_location
+	MaxSessionsPerDevice           int               // Live sessions a user may hold on one device; 0 disables the cap
+	DeviceSessionLimitMode         string            // evict (oldest sessions) or reject (new session)
+}
+
+// SessionRiskConfig controls activity risk scoring on session refresh.
+type SessionRiskConfig struct {
+	Enabled            bool
+	Weights            map[string]int // Points per signal; empty keeps the auth defaults
+	Threshold          int            // Score that emits session_risk_elevated and triggers step-up
+	RapidRefreshWindow time.Duration
+	UsualHoursStart    int  // UTC hour usual activity starts; equal to end disables the signal
+	UsualHoursEnd      int  // UTC hour usual activity ends (exclusive)
+	StepUp             bool // Reject high risk refreshes so the user re-authenticates
+}
+
+// CookieConfig holds the security attributes applied to every cookie the server sets.
+type CookieConfig struct {
+	Secure   bool   // Always mark cookies Secure; when false, Secure follows the request scheme
+	SameSite string // strict, lax, or none; none requires Secure
+	Domain   string // Empty scopes cookies to the issuing host
+	Path     string
+	HTTPOnly bool
+}
+
+// SameSiteMode maps the configured SameSite value to its http constant.
+// Unknown values fall back to strict; Validate rejects them at startup.
+func (c CookieConfig) SameSiteMode() http.SameSite {
+	switch strings.ToLower(c.SameSite) {
+	case "lax":
+		return http.SameSiteLaxMode
+	case "none":
+		return http.SameSiteNoneMode
+	default:
+		return http.SameSiteStrictMode
+	}
+}
+
+// NewCookie builds a cookie carrying these security attributes. Every cookie
+// the server sets goes through here so deployments control them in one place.
+// Secure is also set whenever r arrived over HTTPS.
+func (c CookieConfig) NewCookie(r *http.Request, name, value string, maxAge int) *http.Cookie {
+	return &http.Cookie{
+		Name:     name,
+		Value:    value,
+		Path:     c.Path,
+		Domain:   c.Domain,
+		MaxAge:   maxAge,
+		HttpOnly: c.HTTPOnly,
+		Secure:   c.Secure || isHTTPS(r),
+		SameSite: c.SameSiteMode(),
+	}
+}
+
+func isHTTPS(r *http.Request) bool {
+	if r.TLS != nil {
+		return true
+	}
+	return strings.EqualFold(r.Header.Get("X-Forwarded-Proto"), "https")
+}
+
+// validateCookie rejects attribute combinations browsers refuse to store.
+func validateCookie(c CookieConfig) []error {
+	var errs []error
+	switch strings.ToLower(c.SameSite) {
+	case "strict", "lax":
+	case "none":
+		if !c.Secure {
+			errs = append(errs, errors.New("COOKIE_SAMESITE: none requires COOKIE_SECURE=true"))
+		}
+	default:
+		errs = append(errs, fmt.Errorf("COOKIE_SAMESITE: %q must be strict, lax, or none", c.SameSite))
+	}
+	if !strings.HasPrefix(c.Path, "/") {
+		errs = append(errs, fmt.Errorf("COOKIE_PATH: %q must start with /", c.Path))
+	}
+	return errs
 }
 
 // ConsentConfig holds consent management configuration
 type ConsentConfig struct {
 	ConsentTTL         time.Duration
 	ConsentGrantWindow time.Duration
+	RenewalWindow      time.Duration // Re-grants extend active consents expiring within this window
 	ReGrantCooldown    time.Duration
 }
 
+// DecisionConfig holds decision engine policy configuration
+type DecisionConfig struct {
+	MinCompleteness float64 // Fraction of citizen details age verification requires; 0 accepts any
+}
+
+// TenantConfig holds tenant lifecycle configuration
+type TenantConfig struct {
+	DeletionRetention    time.Duration // How long a soft-deleted tenant is kept before it is purged
+	PurgeInterval        time.Duration // How often the purge worker looks for tenants past retention
+	CountRefreshInterval time.Duration // How often tenant/client count gauges are refreshed
+	StrictScopes         bool          // Reject client scopes missing from the scope registry
+	AllowedScopes        []string      // Extra scopes accepted in strict mode
+	SecretRotationGrace  time.Duration // How long a rotated-out client secret keeps verifying; 0 disables
+}
+
 // RegistryConfig holds registry integration configuration
 type RegistryConfig struct {
 	CacheTTL             time.Duration
+	CacheTTLs            map[providers.ProviderType]time.Duration // Per-type overrides of CacheTTL for citizen and sanctions entries
+	NegativeCacheTTL     time.Duration                            // How long a citizen not-found result is cached; shorter than the citizen TTL
 	CitizenRegistryURL   string
 	CitizenAPIKey        string
 	SanctionsRegistryURL string
 	SanctionsAPIKey      string
 	RegistryTimeout      time.Duration
+	LogProviderLookups   bool               // Debug-log redacted provider lookups (never filter values or PII)
+	LatencyAware         bool               // Try fallback chains fastest provider first, by recent response time
+	MinConfidence        map[string]float64 // Per-evidence-type confidence floor, keyed by provider type
+	ProviderWeights      map[string]float64 // Voting trust weight per provider ID; unlisted providers weigh 1
+
+	// Minimization sets what regulated mode keeps of citizen details, such as
+	// year of birth or city; the zero value clears every detail.
+	Minimization citizen.MinimizationPolicy
 }
 
 // SecurityConfig holds security and compliance settings
 type SecurityConfig struct {
 	RegulatedMode bool
 	AdminAPIToken string
+	// MaxURLBytes and MaxHeaderBytes bound the request target and total header
+	// size; larger requests are rejected with 414 and 431 before any handler runs.
+	MaxURLBytes    int
+	MaxHeaderBytes int
+}
+
+// AuditConfig holds audit event routing configuration
+type AuditConfig struct {
+	CategoryOverrides map[string]string // Per-event category overrides, keyed by event name
+	StrictCategories  bool              // Warn when an event without a category mapping is emitted
+	// Backends routes the security and operations categories to another backend
+	// (AuditBackendSIEMLog); unlisted categories, and compliance always, stay
+	// in each module's hash-chained store.
+	Backends      map[string]string
+	OpsSampleRate float64 // Fraction of operational events recorded
+}
+
+// Audit backends selectable per category in AUDIT_BACKENDS.
+const (
+	AuditBackendPrimary = "primary"  // The module's own audit store
+	AuditBackendSIEMLog = "siem_log" // Structured log records for a SIEM collector
+)
+
+// RateLimitProbeConfig holds the monitoring probe rate limit exemption
+type RateLimitProbeConfig struct {
+	Secret     string   // Shared secret probes send in Header; empty disables the exemption
+	Header     string   // Request header carrying the secret
+	UserAgents []string // Optional User-Agent prefixes the probe must also match
+	Paths      []string // Monitoring routes the exemption applies to; empty uses the middleware defaults
+}
+
+// RateLimitBackoffConfig holds the repeat offender Retry-After escalation
+type RateLimitBackoffConfig struct {
+	Multiplier    float64       // Retry-After growth per repeat throttle; 1 disables escalation
+	MaxRetryAfter time.Duration // Cap on the escalated Retry-After
+	QuietPeriod   time.Duration // Time without a throttle after which the count resets
 }
 
 // Defaults
 var (
 	DefaultTokenTTL                       = 15 * time.Minute
+	DefaultJWTSigningAlg                  = "HS256"
 	DefaultSessionTTL                     = 24 * time.Hour
 	DefaultTokenRevocationCleanupInterval = 5 * time.Minute
 	DefaultAuthCleanupInterval            = 5 * time.Minute
 	DefaultConsentTTL                     = 365 * 24 * time.Hour
 	DefaultConsentGrantWindow             = 5 * time.Minute
+	DefaultConsentRenewalWindow           = 30 * 24 * time.Hour
 	DefaultConsentReGrantCooldown         = 5 * time.Minute
+	DefaultTenantCountRefreshInterval     = 30 * time.Second
 	DefaultRegistryCacheTTL               = 5 * time.Minute
+	DefaultRegistryNegativeCacheTTL       = 1 * time.Minute
 	DefaultCitizenRegistryURL             = "http://localhost:8081"
 	DefaultCitizenAPIKey                  = "citizen-registry-secret-key"
 	DefaultSanctionsRegistryURL           = "http://localhost:8082"
@@ -122,6 +307,19 @@ var (
 	DefaultRegistryTimeout                = 5 * time.Second
 	DefaultDeviceCookieName               = "__Secure-Device-ID"
 	DefaultDeviceCookieMaxAge             = 31536000 // 1 year
+	DefaultCookieSameSite                 = "strict"
+	DefaultCookiePath                     = "/"
+	DefaultMaxURLBytes                    = 8 * 1024
+	DefaultMaxHeaderBytes                 = 16 * 1024
+	DefaultRateLimitGlobalShards          = 1
+	DefaultRateLimitProbeHeader           = "X-Monitoring-Probe"
+	DefaultRateLimitBackoffMultiplier     = 1.0 // opt-in: escalation is off until raised
+	DefaultRateLimitBackoffMaxRetryAfter  = 15 * time.Minute
+	DefaultRateLimitBackoffQuietPeriod    = 10 * time.Minute
+	DefaultAuditOpsSampleRate             = 0.1
+	minRateLimitProbeSecretLen            = 32
+	DefaultSessionRiskThreshold           = 51
+	DefaultSessionRapidRefreshWindow      = time.Minute
 
 	// Database defaults
 	DefaultDBMaxOpenConns    = 25
@@ -148,25 +346,43 @@ var (
 	DefaultRedisWriteTimeout = 3 * time.Second
 )
 
-// FromEnv builds config from environment variables
+// FromEnv builds config from environment variables.
+// Malformed values fail fast instead of silently falling back to defaults, so a
+// typo such as REGULATED_MODE=ture cannot quietly disable a security setting.
 func FromEnv() (Server, error) {
-	env := getEnv("CREDO_ENV", "local")
+	r := &envReader{}
+	env := r.String("CREDO_ENV", "local")
 	demoMode := env == "demo"
-	disableRateLimiting := os.Getenv("DISABLE_RATE_LIMITING") == "true"
 
 	cfg := Server{
-		Addr:                getEnv("ID_GATEWAY_ADDR", ":8080"),
-		Environment:         env,
-		DemoMode:            demoMode,
-		Auth:                loadAuthConfig(env, demoMode),
-		Consent:             loadConsentConfig(),
-		Registry:            loadRegistryConfig(),
-		Security:            loadSecurityConfig(env, demoMode),
-		DisableRateLimiting: disableRateLimiting,
-		Database:            loadDatabaseConfig(),
-		Redis:               loadRedisConfig(),
-		Kafka:               loadKafkaConfig(),
-		Outbox:              loadOutboxConfig(),
+		Addr:                     r.String("ID_GATEWAY_ADDR", ":8080"),
+		Environment:              env,
+		DemoMode:                 demoMode,
+		Auth:                     loadAuthConfig(r, env, demoMode),
+		Consent:                  loadConsentConfig(r),
+		Decision:                 loadDecisionConfig(r),
+		Registry:                 loadRegistryConfig(r),
+		Tenant:                   loadTenantConfig(r),
+		Security:                 loadSecurityConfig(r, env),
+		Audit:                    loadAuditConfig(r, env),
+		DisableRateLimiting:      r.Bool("DISABLE_RATE_LIMITING", false),
+		RateLimitStandardHeaders: r.Bool("RATE_LIMIT_STANDARD_HEADERS", false),
+		RateLimitDebugHeaders:    r.Bool("RATE_LIMIT_DEBUG_HEADERS", false),
+		RateLimitGlobalShards:    r.Int("RATE_LIMIT_GLOBAL_SHARDS", DefaultRateLimitGlobalShards),
+		RateLimitDegradedPolicy:  loadDegradedPolicy(r, "RATE_LIMIT_DEGRADED_POLICY"),
+		RateLimitProbe:           loadRateLimitProbeConfig(r),
+		RateLimitBackoff:         loadRateLimitBackoffConfig(r),
+		Database:                 loadDatabaseConfig(r),
+		Redis:                    loadRedisConfig(r),
+		Kafka:                    loadKafkaConfig(r),
+		Outbox:                   loadOutboxConfig(r),
+	}
+
+	if err := r.Err(); err != nil {
+		return Server{}, fmt.Errorf("invalid configuration: %w", err)
+	}
+	if err := cfg.Validate(); err != nil {
+		return Server{}, fmt.Errorf("invalid configuration: %w", err)
 	}
 
 	if demoMode {
@@ -178,10 +394,81 @@ func FromEnv() (Server, error) {
 	return cfg, nil
 }
 
-func loadAuthConfig(env string, demoMode bool) AuthConfig {
+// Validate checks cross-field and semantic constraints that cannot be expressed
+// by parsing a single environment variable.
+func (s *Server) Validate() error {
+	var errs []error
+	if err := validateListenAddr(s.Addr); err != nil {
+		errs = append(errs, fmt.Errorf("ID_GATEWAY_ADDR: %w", err))
+	}
+	if s.Auth.JWTSigningKey == "" {
+		errs = append(errs, errors.New("JWT_SIGNING_KEY: must not be empty"))
+	}
+	errs = append(errs, validateSigningAlgs(s.Auth)...)
+	errs = append(errs, validateCookie(s.Auth.Cookie)...)
+	errs = append(errs, validateSessionRisk(s.Auth.SessionRisk)...)
+	if mode := s.Auth.DeviceSessionLimitMode; mode != "evict" && mode != "reject" {
+		errs = append(errs, fmt.Errorf("DEVICE_SESSION_LIMIT_MODE: %q must be evict or reject", mode))
+	}
+	if s.Security.MaxURLBytes <= 0 {
+		errs = append(errs, fmt.Errorf("MAX_URL_BYTES: %d must be positive", s.Security.MaxURLBytes))
+	}
+	if s.Security.MaxHeaderBytes <= 0 {
+		errs = append(errs, fmt.Errorf("MAX_HEADER_BYTES: %d must be positive", s.Security.MaxHeaderBytes))
+	}
+	if s.RateLimitGlobalShards < 1 || s.RateLimitGlobalShards > globalthrottle.MaxShards {
+		errs = append(errs, fmt.Errorf("RATE_LIMIT_GLOBAL_SHARDS: %d must be between 1 and %d", s.RateLimitGlobalShards, globalthrottle.MaxShards))
+	}
+	if n := len(s.RateLimitProbe.Secret); n > 0 && n < minRateLimitProbeSecretLen {
+		errs = append(errs, fmt.Errorf("RATE_LIMIT_PROBE_SECRET: must be at least %d characters", minRateLimitProbeSecretLen))
+	}
+	if len(s.Auth.AllowedRedirectSchemes) == 0 {
+		errs = append(errs, errors.New("ALLOWED_REDIRECT_SCHEMES: at least one scheme is required"))
+	}
+	if _, err := audit.NewCategoryRouter(s.Audit.CategoryOverrides); err != nil {
+		errs = append(errs, fmt.Errorf("AUDIT_CATEGORY_OVERRIDES: %w", err))
+	}
+	for _, category := range slices.Sorted(maps.Keys(s.Audit.Backends)) {
+		if category != string(audit.CategorySecurity) && category != string(audit.CategoryOperations) {
+			errs = append(errs, fmt.Errorf("AUDIT_BACKENDS: %q cannot be rerouted (security or operations)", category))
+		}
+		if backend := s.Audit.Backends[category]; backend != AuditBackendPrimary && backend != AuditBackendSIEMLog {
+			errs = append(errs, fmt.Errorf("AUDIT_BACKENDS: %q is not a backend (%s or %s)", backend, AuditBackendPrimary, AuditBackendSIEMLog))
+		}
+	}
+	if s.Registry.NegativeCacheTTL >= s.Registry.CacheTTL {
+		errs = append(errs, fmt.Errorf("REGISTRY_NEGATIVE_CACHE_TTL: %s must be shorter than REGISTRY_CACHE_TTL %s", s.Registry.NegativeCacheTTL, s.Registry.CacheTTL))
+	}
+	if citizenTTL, ok := s.Registry.CacheTTLs[providers.ProviderTypeCitizen]; ok && s.Registry.NegativeCacheTTL >= citizenTTL {
+		errs = append(errs, fmt.Errorf("REGISTRY_NEGATIVE_CACHE_TTL: %s must be shorter than the citizen TTL %s in REGISTRY_CACHE_TTLS", s.Registry.NegativeCacheTTL, citizenTTL))
+	}
+	for _, typ := range slices.Sorted(maps.Keys(s.Registry.CacheTTLs)) {
+		if typ != providers.ProviderTypeCitizen && typ != providers.ProviderTypeSanctions {
+			errs = append(errs, fmt.Errorf("REGISTRY_CACHE_TTLS: %q is not a cached record type (citizen or sanctions)", typ))
+		}
+	}
+	for _, typ := range slices.Sorted(maps.Keys(s.Registry.MinConfidence)) {
+		if !isEvidenceType(typ) {
+			errs = append(errs, fmt.Errorf("REGISTRY_MIN_CONFIDENCE: unknown evidence type %q", typ))
+		}
+	}
+	if s.Database.MaxIdleConns > s.Database.MaxOpenConns {
+		errs = append(errs, fmt.Errorf("DB_MAX_IDLE_CONNS: %d exceeds DB_MAX_OPEN_CONNS %d", s.Database.MaxIdleConns, s.Database.MaxOpenConns))
+	}
+	if s.Redis.MinIdleConns > s.Redis.PoolSize {
+		errs = append(errs, fmt.Errorf("REDIS_MIN_IDLE_CONNS: %d exceeds REDIS_POOL_SIZE %d", s.Redis.MinIdleConns, s.Redis.PoolSize))
+	}
+	return errors.Join(errs...)
+}
+
+func loadAuthConfig(r *envReader, env string, demoMode bool) AuthConfig {
 	jwtSigningKey := os.Getenv("JWT_SIGNING_KEY")
-	jwtIssuerBaseURL := getEnv("JWT_ISSUER_BASE_URL", "http://localhost:8080")
-	jwtAudience := getEnv("JWT_AUDIENCE", "credo-client")
+	if keyFile := os.Getenv("JWT_SIGNING_KEY_FILE"); keyFile != "" {
+		// File-mounted secrets (e.g., Kubernetes/Docker secrets) take precedence over env
+		jwtSigningKey = r.File("JWT_SIGNING_KEY_FILE")
+	}
+	jwtIssuerBaseURL := r.String("JWT_ISSUER_BASE_URL", "http://localhost:8080")
+	jwtAudience := r.String("JWT_AUDIENCE", "credo-client")
 
 	if demoMode {
 		jwtSigningKey = "demo-signing-key-change-me-locally"
@@ -192,47 +479,198 @@ func loadAuthConfig(env string, demoMode bool) AuthConfig {
 
 	return AuthConfig{
 		JWTSigningKey:                  jwtSigningKey,
+		JWTPreviousSigningKeys:         parseList(os.Getenv("JWT_PREVIOUS_SIGNING_KEYS")),
+		JWTSigningAlg:                  r.String("JWT_SIGNING_ALG", DefaultJWTSigningAlg),
+		JWTAsymmetricKeyPEM:            r.File("JWT_ASYMMETRIC_KEY_FILE"),
+		JWTClientSigningAlgs:           r.Map("JWT_CLIENT_SIGNING_ALGS"),
 		JWTIssuerBaseURL:               jwtIssuerBaseURL,
 		JWTAudience:                    jwtAudience,
-		TokenTTL:                       parseDuration("TOKEN_TTL", DefaultTokenTTL),
-		SessionTTL:                     parseDuration("SESSION_TTL", DefaultSessionTTL),
-		TokenRevocationCleanupInterval: parseDuration("TOKEN_REVOCATION_CLEANUP_INTERVAL", DefaultTokenRevocationCleanupInterval),
-		AuthCleanupInterval:            parseDuration("AUTH_CLEANUP_INTERVAL", DefaultAuthCleanupInterval),
+		JWTResourceAudiences:           parseList(os.Getenv("JWT_RESOURCE_AUDIENCES")),
+		TokenTTL:                       r.Duration("TOKEN_TTL", DefaultTokenTTL),
+		SessionTTL:                     r.Duration("SESSION_TTL", DefaultSessionTTL),
+		TokenRevocationCleanupInterval: r.Duration("TOKEN_REVOCATION_CLEANUP_INTERVAL", DefaultTokenRevocationCleanupInterval),
+		AuthCleanupInterval:            r.Duration("AUTH_CLEANUP_INTERVAL", DefaultAuthCleanupInterval),
+		RefreshTokenUsedGrace:          r.Duration("REFRESH_TOKEN_USED_GRACE", refreshtoken.DefaultUsedTokenGrace),
 		AllowedRedirectSchemes:         parseAllowedRedirectSchemes(os.Getenv("ALLOWED_REDIRECT_SCHEMES"), env),
-		DeviceBindingEnabled:           os.Getenv("DEVICE_BINDING_ENABLED") == "true",
-		DeviceCookieName:               getEnv("DEVICE_COOKIE_NAME", DefaultDeviceCookieName),
-		DeviceCookieMaxAge:             parseInt("DEVICE_COOKIE_MAX_AGE", DefaultDeviceCookieMaxAge),
+		DPoPRequiredClients:            parseList(os.Getenv("DPOP_REQUIRED_CLIENTS")),
+		DeviceBindingEnabled:           r.Bool("DEVICE_BINDING_ENABLED", false),
+		DeviceCookieName:               r.String("DEVICE_COOKIE_NAME", DefaultDeviceCookieName),
+		DeviceCookieMaxAge:             r.Int("DEVICE_COOKIE_MAX_AGE", DefaultDeviceCookieMaxAge),
+		Cookie: CookieConfig{
+			Secure:   r.Bool("COOKIE_SECURE", false),
+			SameSite: r.String("COOKIE_SAMESITE", DefaultCookieSameSite),
+			Domain:   r.String("COOKIE_DOMAIN", ""),
+			Path:     r.String("COOKIE_PATH", DefaultCookiePath),
+			HTTPOnly: r.Bool("COOKIE_HTTP_ONLY", true),
+		},
+		SessionRisk: SessionRiskConfig{
+			Enabled:            r.Bool("SESSION_RISK_ENABLED", false),
+			Weights:            loadRiskWeights(r, "SESSION_RISK_WEIGHTS"),
+			Threshold:          r.Int("SESSION_RISK_THRESHOLD", DefaultSessionRiskThreshold),
+			RapidRefreshWindow: r.Duration("SESSION_RISK_RAPID_REFRESH_WINDOW", DefaultSessionRapidRefreshWindow),
+			UsualHoursStart:    r.NonNegativeInt("SESSION_RISK_USUAL_HOURS_START", 0),
+			UsualHoursEnd:      r.NonNegativeInt("SESSION_RISK_USUAL_HOURS_END", 0),
+			StepUp:             r.Bool("SESSION_RISK_STEP_UP", false),
+		},
+		LocationNetworks:       loadLocationNetworks(r, "AUTH_LOCATION_NETWORKS"),
+		MaxSessionsPerDevice:   r.NonNegativeInt("MAX_SESSIONS_PER_DEVICE", 0),
+		DeviceSessionLimitMode: r.String("DEVICE_SESSION_LIMIT_MODE", "evict"),
 	}
 }
 
-func loadConsentConfig() ConsentConfig {
+// loadLocationNetworks parses semicolon-separated cidr=location pairs such as
+// 203.0.113.0/24=Berlin, DE;198.51.100.0/24=Paris, FR. Semicolons separate
+// pairs so locations may contain commas.
+func loadLocationNetworks(r *envReader, key string) map[string]string {
+	raw := os.Getenv(key)
+	if raw == "" {
+		return nil
+	}
+	networks := make(map[string]string)
+	for _, pair := range strings.Split(raw, ";") {
+		if strings.TrimSpace(pair) == "" {
+			continue
+		}
+		cidr, location, ok := strings.Cut(pair, "=")
+		cidr, location = strings.TrimSpace(cidr), strings.TrimSpace(location)
+		if _, err := netip.ParsePrefix(cidr); !ok || err != nil || location == "" {
+			r.fail(key, raw, "must be a semicolon-separated list of cidr=location pairs")
+			return nil
+		}
+		networks[cidr] = location
+	}
+	return networks
+}
+
+// loadRiskWeights parses signal=points pairs such as new_device=35,rapid_refresh=20.
+func loadRiskWeights(r *envReader, key string) map[string]int {
+	pairs := r.Map(key)
+	if pairs == nil {
+		return nil
+	}
+	weights := make(map[string]int, len(pairs))
+	for signal, raw := range pairs {
+		points, err := strconv.Atoi(raw)
+		if err != nil || points < 0 {
+			r.fail(key, raw, "weights must be non-negative integers")
+			return nil
+		}
+		weights[signal] = points
+	}
+	return weights
+}
+
+func loadConsentConfig(r *envReader) ConsentConfig {
 	return ConsentConfig{
-		ConsentTTL:         parseDuration("CONSENT_TTL", DefaultConsentTTL),
-		ConsentGrantWindow: parseDuration("CONSENT_GRANT_WINDOW", DefaultConsentGrantWindow),
-		ReGrantCooldown:    parseDuration("CONSENT_REGRANT_COOLDOWN", DefaultConsentReGrantCooldown),
+		ConsentTTL:         r.Duration("CONSENT_TTL", DefaultConsentTTL),
+		ConsentGrantWindow: r.Duration("CONSENT_GRANT_WINDOW", DefaultConsentGrantWindow),
+		RenewalWindow:      r.Duration("CONSENT_RENEWAL_WINDOW", DefaultConsentRenewalWindow),
+		ReGrantCooldown:    r.Duration("CONSENT_REGRANT_COOLDOWN", DefaultConsentReGrantCooldown),
+	}
+}
+
+func loadDecisionConfig(r *envReader) DecisionConfig {
+	return DecisionConfig{
+		MinCompleteness: r.UnitFloat("DECISION_MIN_COMPLETENESS", 0),
 	}
 }
 
-func loadRegistryConfig() RegistryConfig {
+func loadTenantConfig(r *envReader) TenantConfig {
+	return TenantConfig{
+		DeletionRetention:    r.Duration("TENANT_DELETION_RETENTION", purge.DefaultRetention),
+		PurgeInterval:        r.Duration("TENANT_PURGE_INTERVAL", purge.DefaultInterval),
+		CountRefreshInterval: r.Duration("TENANT_COUNT_REFRESH_INTERVAL", DefaultTenantCountRefreshInterval),
+		StrictScopes:         r.Bool("TENANT_STRICT_SCOPES", true),
+		AllowedScopes:        parseList(os.Getenv("TENANT_ALLOWED_SCOPES")),
+		SecretRotationGrace:  r.NonNegativeDuration("TENANT_SECRET_ROTATION_GRACE", 0),
+	}
+}
+
+func loadRegistryConfig(r *envReader) RegistryConfig {
 	return RegistryConfig{
-		CacheTTL:             parseDuration("REGISTRY_CACHE_TTL", DefaultRegistryCacheTTL),
-		CitizenRegistryURL:   getEnv("CITIZEN_REGISTRY_URL", DefaultCitizenRegistryURL),
-		CitizenAPIKey:        getEnv("CITIZEN_REGISTRY_API_KEY", DefaultCitizenAPIKey),
-		SanctionsRegistryURL: getEnv("SANCTIONS_REGISTRY_URL", DefaultSanctionsRegistryURL),
-		SanctionsAPIKey:      getEnv("SANCTIONS_REGISTRY_API_KEY", DefaultSanctionsAPIKey),
-		RegistryTimeout:      parseDuration("REGISTRY_TIMEOUT", DefaultRegistryTimeout),
+		CacheTTL:             r.Duration("REGISTRY_CACHE_TTL", DefaultRegistryCacheTTL),
+		CacheTTLs:            loadTypeTTLs(r, "REGISTRY_CACHE_TTLS"),
+		NegativeCacheTTL:     r.Duration("REGISTRY_NEGATIVE_CACHE_TTL", DefaultRegistryNegativeCacheTTL),
+		CitizenRegistryURL:   r.String("CITIZEN_REGISTRY_URL", DefaultCitizenRegistryURL),
+		CitizenAPIKey:        r.String("CITIZEN_REGISTRY_API_KEY", DefaultCitizenAPIKey),
+		SanctionsRegistryURL: r.String("SANCTIONS_REGISTRY_URL", DefaultSanctionsRegistryURL),
+		SanctionsAPIKey:      r.String("SANCTIONS_REGISTRY_API_KEY", DefaultSanctionsAPIKey),
+		RegistryTimeout:      r.Duration("REGISTRY_TIMEOUT", DefaultRegistryTimeout),
+		LogProviderLookups:   r.Bool("REGISTRY_LOG_PROVIDER_LOOKUPS", false),
+		LatencyAware:         r.Bool("REGISTRY_LATENCY_AWARE", false),
+		MinConfidence:        loadConfidenceFloors(r, "REGISTRY_MIN_CONFIDENCE"),
+		ProviderWeights:      loadProviderWeights(r, "REGISTRY_PROVIDER_WEIGHTS"),
+		Minimization:         loadMinimizationPolicy(r, "REGISTRY_MINIMIZATION_RULES"),
+	}
+}
+
+// loadTypeTTLs parses type=duration pairs, each duration positive.
+func loadTypeTTLs(r *envReader, key string) map[providers.ProviderType]time.Duration {
+	pairs := r.Map(key)
+	if pairs == nil {
+		return nil
+	}
+	ttls := make(map[providers.ProviderType]time.Duration, len(pairs))
+	for typ, raw := range pairs {
+		ttl, err := time.ParseDuration(raw)
+		if err != nil || ttl <= 0 {
+			r.fail(key, raw, "TTLs must be positive durations such as 1h or 24h")
+			return nil
+		}
+		ttls[providers.ProviderType(typ)] = ttl
 	}
+	return ttls
 }
 
-func loadSecurityConfig(env string, demoMode bool) SecurityConfig {
-	// REGULATED_MODE env var takes precedence; if unset, demo mode defaults to unregulated
-	regulatedEnv := os.Getenv("REGULATED_MODE")
-	var regulated bool
-	if regulatedEnv != "" {
-		regulated = regulatedEnv == "true"
-	} else if demoMode {
-		regulated = false
+// loadConfidenceFloors parses type=floor pairs, each floor between 0 and 1.
+func loadConfidenceFloors(r *envReader, key string) map[string]float64 {
+	pairs := r.Map(key)
+	if pairs == nil {
+		return nil
 	}
+	floors := make(map[string]float64, len(pairs))
+	for typ, raw := range pairs {
+		floor, err := strconv.ParseFloat(raw, 64)
+		if err != nil || !(floor >= 0 && floor <= 1) {
+			r.fail(key, raw, "confidence floors must be numbers between 0 and 1")
+			return nil
+		}
+		floors[typ] = floor
+	}
+	return floors
+}
+
+// loadProviderWeights parses provider=weight pairs, each weight non-negative.
+func loadProviderWeights(r *envReader, key string) map[string]float64 {
+	pairs := r.Map(key)
+	if pairs == nil {
+		return nil
+	}
+	weights := make(map[string]float64, len(pairs))
+	for providerID, raw := range pairs {
+		weight, err := strconv.ParseFloat(raw, 64)
+		if err != nil || !(weight >= 0) || math.IsInf(weight, 1) {
+			r.fail(key, raw, "provider weights must be non-negative numbers")
+			return nil
+		}
+		weights[providerID] = weight
+	}
+	return weights
+}
+
+// loadMinimizationPolicy parses field=rule pairs such as date_of_birth=year.
+func loadMinimizationPolicy(r *envReader, key string) citizen.MinimizationPolicy {
+	policy, err := citizen.ParseMinimizationPolicy(r.Map(key))
+	if err != nil {
+		r.fail(key, os.Getenv(key), "rules must be date_of_birth=year|clear, address=city|clear or full_name=clear")
+		return citizen.MinimizationPolicy{}
+	}
+	return policy
+}
+
+func loadSecurityConfig(r *envReader, env string) SecurityConfig {
+	// REGULATED_MODE must be an explicit boolean when set; unset means unregulated
+	regulated := r.Bool("REGULATED_MODE", false)
 
 	adminToken := os.Getenv("ADMIN_API_TOKEN")
 	if adminToken == "" {
@@ -243,75 +681,344 @@ func loadSecurityConfig(env string, demoMode bool) SecurityConfig {
 	}
 
 	return SecurityConfig{
-		RegulatedMode: regulated,
-		AdminAPIToken: adminToken,
+		RegulatedMode:  regulated,
+		AdminAPIToken:  adminToken,
+		MaxURLBytes:    r.Int("MAX_URL_BYTES", DefaultMaxURLBytes),
+		MaxHeaderBytes: r.Int("MAX_HEADER_BYTES", DefaultMaxHeaderBytes),
+	}
+}
+
+func loadAuditConfig(r *envReader, env string) AuditConfig {
+	return AuditConfig{
+		CategoryOverrides: r.Map("AUDIT_CATEGORY_OVERRIDES"),
+		// On by default for local development so unmapped events surface early
+		StrictCategories: r.Bool("AUDIT_STRICT_CATEGORIES", env == "local"),
+		Backends:         r.Map("AUDIT_BACKENDS"),
+		OpsSampleRate:    r.UnitFloat("AUDIT_OPS_SAMPLE_RATE", DefaultAuditOpsSampleRate),
+	}
+}
+
+func loadRateLimitProbeConfig(r *envReader) RateLimitProbeConfig {
+	return RateLimitProbeConfig{
+		Secret:     os.Getenv("RATE_LIMIT_PROBE_SECRET"),
+		Header:     r.String("RATE_LIMIT_PROBE_HEADER", DefaultRateLimitProbeHeader),
+		UserAgents: parseList(os.Getenv("RATE_LIMIT_PROBE_USER_AGENTS")),
+		Paths:      parseList(os.Getenv("RATE_LIMIT_PROBE_PATHS")),
 	}
 }
 
-func loadDatabaseConfig() DatabaseConfig {
+// loadDegradedPolicy parses class=mode pairs such as auth=closed,read=open.
+func loadDegradedPolicy(r *envReader, key string) map[ratelimitmodels.EndpointClass]bool {
+	pairs := r.Map(key)
+	if pairs == nil {
+		return nil
+	}
+	policy := make(map[ratelimitmodels.EndpointClass]bool, len(pairs))
+	for raw, mode := range pairs {
+		class := ratelimitmodels.EndpointClass(raw)
+		if !class.IsValid() {
+			r.fail(key, raw, "classes must be auth, sensitive, read, write or admin")
+			return nil
+		}
+		switch mode {
+		case "closed":
+			policy[class] = true
+		case "open":
+			policy[class] = false
+		default:
+			r.fail(key, mode, "modes must be open or closed")
+			return nil
+		}
+	}
+	return policy
+}
+
+func loadRateLimitBackoffConfig(r *envReader) RateLimitBackoffConfig {
+	return RateLimitBackoffConfig{
+		Multiplier:    r.Float("RATE_LIMIT_BACKOFF_MULTIPLIER", DefaultRateLimitBackoffMultiplier),
+		MaxRetryAfter: r.Duration("RATE_LIMIT_BACKOFF_MAX_RETRY_AFTER", DefaultRateLimitBackoffMaxRetryAfter),
+		QuietPeriod:   r.Duration("RATE_LIMIT_BACKOFF_QUIET_PERIOD", DefaultRateLimitBackoffQuietPeriod),
+	}
+}
+
+func loadDatabaseConfig(r *envReader) DatabaseConfig {
 	return DatabaseConfig{
 		URL:             os.Getenv("DATABASE_URL"),
-		MaxOpenConns:    parseInt("DB_MAX_OPEN_CONNS", DefaultDBMaxOpenConns),
-		MaxIdleConns:    parseInt("DB_MAX_IDLE_CONNS", DefaultDBMaxIdleConns),
-		ConnMaxLifetime: parseDuration("DB_CONN_MAX_LIFETIME", DefaultDBConnMaxLifetime),
+		MaxOpenConns:    r.Int("DB_MAX_OPEN_CONNS", DefaultDBMaxOpenConns),
+		MaxIdleConns:    r.NonNegativeInt("DB_MAX_IDLE_CONNS", DefaultDBMaxIdleConns),
+		ConnMaxLifetime: r.Duration("DB_CONN_MAX_LIFETIME", DefaultDBConnMaxLifetime),
 	}
 }
 
-func loadKafkaConfig() KafkaConfig {
+func loadKafkaConfig(r *envReader) KafkaConfig {
 	return KafkaConfig{
 		Brokers:         os.Getenv("KAFKA_BROKERS"),
-		AuditTopic:      getEnv("KAFKA_AUDIT_TOPIC", DefaultKafkaAuditTopic),
-		Acks:            getEnv("KAFKA_ACKS", DefaultKafkaAcks),
-		Retries:         parseInt("KAFKA_RETRIES", DefaultKafkaRetries),
-		DeliveryTimeout: parseDuration("KAFKA_DELIVERY_TIMEOUT", DefaultKafkaDeliveryTimeout),
-		ConsumerGroup:   getEnv("KAFKA_CONSUMER_GROUP", DefaultKafkaConsumerGroup),
+		AuditTopic:      r.String("KAFKA_AUDIT_TOPIC", DefaultKafkaAuditTopic),
+		Acks:            r.String("KAFKA_ACKS", DefaultKafkaAcks),
+		Retries:         r.NonNegativeInt("KAFKA_RETRIES", DefaultKafkaRetries),
+		DeliveryTimeout: r.Duration("KAFKA_DELIVERY_TIMEOUT", DefaultKafkaDeliveryTimeout),
+		ConsumerGroup:   r.String("KAFKA_CONSUMER_GROUP", DefaultKafkaConsumerGroup),
 	}
 }
 
-func loadOutboxConfig() OutboxConfig {
+func loadOutboxConfig(r *envReader) OutboxConfig {
 	return OutboxConfig{
-		PollInterval:  parseDuration("OUTBOX_POLL_INTERVAL", DefaultOutboxPollInterval),
-		BatchSize:     parseInt("OUTBOX_BATCH_SIZE", DefaultOutboxBatchSize),
-		RetentionDays: parseInt("OUTBOX_RETENTION_DAYS", DefaultOutboxRetentionDays),
+		PollInterval:  r.Duration("OUTBOX_POLL_INTERVAL", DefaultOutboxPollInterval),
+		BatchSize:     r.Int("OUTBOX_BATCH_SIZE", DefaultOutboxBatchSize),
+		RetentionDays: r.Int("OUTBOX_RETENTION_DAYS", DefaultOutboxRetentionDays),
 	}
 }
 
-func loadRedisConfig() RedisConfig {
+func loadRedisConfig(r *envReader) RedisConfig {
 	return RedisConfig{
 		URL:          os.Getenv("REDIS_URL"),
-		PoolSize:     parseInt("REDIS_POOL_SIZE", DefaultRedisPoolSize),
-		MinIdleConns: parseInt("REDIS_MIN_IDLE_CONNS", DefaultRedisMinIdleConns),
-		DialTimeout:  parseDuration("REDIS_DIAL_TIMEOUT", DefaultRedisDialTimeout),
-		ReadTimeout:  parseDuration("REDIS_READ_TIMEOUT", DefaultRedisReadTimeout),
-		WriteTimeout: parseDuration("REDIS_WRITE_TIMEOUT", DefaultRedisWriteTimeout),
+		PoolSize:     r.Int("REDIS_POOL_SIZE", DefaultRedisPoolSize),
+		MinIdleConns: r.NonNegativeInt("REDIS_MIN_IDLE_CONNS", DefaultRedisMinIdleConns),
+		DialTimeout:  r.Duration("REDIS_DIAL_TIMEOUT", DefaultRedisDialTimeout),
+		ReadTimeout:  r.Duration("REDIS_READ_TIMEOUT", DefaultRedisReadTimeout),
+		WriteTimeout: r.Duration("REDIS_WRITE_TIMEOUT", DefaultRedisWriteTimeout),
 	}
 }
 
 // Helper functions
 
-func getEnv(key, defaultValue string) string {
+// envReader reads environment variables with strict parsing.
+// Parse failures are collected rather than defaulted so that every
+// misconfiguration is reported in a single startup error.
+type envReader struct {
+	errs []error
+}
+
+// Err returns all parse errors collected so far, or nil.
+func (r *envReader) Err() error {
+	return errors.Join(r.errs...)
+}
+
+func (r *envReader) fail(key, val, reason string) {
+	r.errs = append(r.errs, fmt.Errorf("%s=%q: %s", key, val, reason))
+}
+
+func (r *envReader) String(key, defaultValue string) string {
 	if val := os.Getenv(key); val != "" {
 		return val
 	}
 	return defaultValue
 }
 
-func parseDuration(key string, defaultValue time.Duration) time.Duration {
-	if val := os.Getenv(key); val != "" {
-		if duration, err := time.ParseDuration(val); err == nil {
-			return duration
+func (r *envReader) Bool(key string, defaultValue bool) bool {
+	val := os.Getenv(key)
+	if val == "" {
+		return defaultValue
+	}
+	parsed, err := strconv.ParseBool(strings.TrimSpace(val))
+	if err != nil {
+		r.fail(key, val, "must be a boolean (true or false)")
+		return defaultValue
+	}
+	return parsed
+}
+
+func (r *envReader) Duration(key string, defaultValue time.Duration) time.Duration {
+	return r.durationAtLeast(key, defaultValue, time.Nanosecond, "must be a positive duration")
+}
+
+// NonNegativeDuration reads keys where 0 is meaningful, such as a window that 0 disables.
+func (r *envReader) NonNegativeDuration(key string, defaultValue time.Duration) time.Duration {
+	return r.durationAtLeast(key, defaultValue, 0, "must not be a negative duration")
+}
+
+func (r *envReader) durationAtLeast(key string, defaultValue, minimum time.Duration, reason string) time.Duration {
+	val := os.Getenv(key)
+	if val == "" {
+		return defaultValue
+	}
+	duration, err := time.ParseDuration(strings.TrimSpace(val))
+	if err != nil {
+		r.fail(key, val, "must be a duration such as 30s or 5m")
+		return defaultValue
+	}
+	if duration < minimum {
+		r.fail(key, val, reason)
+		return defaultValue
+	}
+	return duration
+}
+
+func (r *envReader) Int(key string, defaultValue int) int {
+	return r.intAtLeast(key, defaultValue, 1, "must be a positive integer")
+}
+
+// NonNegativeInt reads keys where 0 is meaningful, such as a cap that 0 disables.
+func (r *envReader) NonNegativeInt(key string, defaultValue int) int {
+	return r.intAtLeast(key, defaultValue, 0, "must be a non-negative integer")
+}
+
+func (r *envReader) intAtLeast(key string, defaultValue, minimum int, reason string) int {
+	val := os.Getenv(key)
+	if val == "" {
+		return defaultValue
+	}
+	parsed, err := strconv.Atoi(strings.TrimSpace(val))
+	if err != nil {
+		r.fail(key, val, "must be an integer")
+		return defaultValue
+	}
+	if parsed < minimum {
+		r.fail(key, val, reason)
+		return defaultValue
+	}
+	return parsed
+}
+
+func (r *envReader) Float(key string, defaultValue float64) float64 {
+	return r.floatWhere(key, defaultValue, func(f float64) bool { return f > 0 }, "must be a positive number")
+}
+
+// UnitFloat reads fractions such as sample rates, where both 0 and 1 are meaningful.
+func (r *envReader) UnitFloat(key string, defaultValue float64) float64 {
+	return r.floatWhere(key, defaultValue, func(f float64) bool { return f >= 0 && f <= 1 }, "must be a number between 0 and 1")
+}
+
+func (r *envReader) floatWhere(key string, defaultValue float64, valid func(float64) bool, reason string) float64 {
+	val := os.Getenv(key)
+	if val == "" {
+		return defaultValue
+	}
+	parsed, err := strconv.ParseFloat(strings.TrimSpace(val), 64)
+	if err != nil {
+		r.fail(key, val, "must be a number")
+		return defaultValue
+	}
+	if !valid(parsed) {
+		r.fail(key, val, reason)
+		return defaultValue
+	}
+	return parsed
+}
+
+// File reads the file named by the environment variable key and returns its
+// trimmed contents. Read failures are collected as configuration errors.
+func (r *envReader) File(key string) string {
+	path := os.Getenv(key)
+	if path == "" {
+		return ""
+	}
+	data, err := os.ReadFile(path) //nolint:gosec // path comes from operator-controlled config
+	if err != nil {
+		r.fail(key, path, "file could not be read")
+		return ""
+	}
+	return strings.TrimSpace(string(data))
+}
+
+// Map parses a comma-separated list of key=value pairs. Malformed pairs are
+// collected as configuration errors.
+func (r *envReader) Map(key string) map[string]string {
+	raw := os.Getenv(key)
+	if raw == "" {
+		return nil
+	}
+	out := make(map[string]string)
+	for _, pair := range parseList(raw) {
+		k, v, ok := strings.Cut(pair, "=")
+		k, v = strings.TrimSpace(k), strings.TrimSpace(v)
+		if !ok || k == "" || v == "" {
+			r.fail(key, raw, "must be a comma-separated list of key=value pairs")
+			return nil
 		}
+		out[k] = v
 	}
-	return defaultValue
+	return out
 }
 
-func parseInt(key string, defaultValue int) int {
-	if val := os.Getenv(key); val != "" {
-		if parsed, err := strconv.Atoi(val); err == nil && parsed > 0 {
-			return parsed
+// parseList splits a comma-separated value, dropping empty entries.
+func parseList(raw string) []string {
+	var out []string
+	for _, p := range strings.Split(raw, ",") {
+		if v := strings.TrimSpace(p); v != "" {
+			out = append(out, v)
 		}
 	}
-	return defaultValue
+	return out
+}
+
+// validateSigningAlgs checks that every configured signing algorithm is
+// supported and that asymmetric algorithms have a private key to sign with.
+func validateSigningAlgs(auth AuthConfig) []error {
+	var errs []error
+	check := func(key, alg string) {
+		switch alg {
+		case "HS256":
+		case "RS256", "ES256":
+			if auth.JWTAsymmetricKeyPEM == "" {
+				errs = append(errs, fmt.Errorf("%s: %s requires JWT_ASYMMETRIC_KEY_FILE", key, alg))
+			}
+		default:
+			errs = append(errs, fmt.Errorf("%s: unsupported signing algorithm %q", key, alg))
+		}
+	}
+	check("JWT_SIGNING_ALG", auth.JWTSigningAlg)
+	for clientID, alg := range auth.JWTClientSigningAlgs {
+		check("JWT_CLIENT_SIGNING_ALGS["+clientID+"]", alg)
+	}
+	return errs
+}
+
+// validateSessionRisk checks thresholds stay on the 0-100 score scale and
+// every weighted signal is one the auth service detects.
+func validateSessionRisk(c SessionRiskConfig) []error {
+	var errs []error
+	if c.Threshold < 1 || c.Threshold > authmodels.MaxRiskScore {
+		errs = append(errs, fmt.Errorf("SESSION_RISK_THRESHOLD: %d must be between 1 and %d", c.Threshold, authmodels.MaxRiskScore))
+	}
+	if c.RapidRefreshWindow <= 0 {
+		errs = append(errs, fmt.Errorf("SESSION_RISK_RAPID_REFRESH_WINDOW: %s must be positive", c.RapidRefreshWindow))
+	}
+	if c.UsualHoursStart < 0 || c.UsualHoursStart > 23 {
+		errs = append(errs, fmt.Errorf("SESSION_RISK_USUAL_HOURS_START: %d must be an hour between 0 and 23", c.UsualHoursStart))
+	}
+	if c.UsualHoursEnd < 0 || c.UsualHoursEnd > 23 {
+		errs = append(errs, fmt.Errorf("SESSION_RISK_USUAL_HOURS_END: %d must be an hour between 0 and 23", c.UsualHoursEnd))
+	}
+	for _, signal := range slices.Sorted(maps.Keys(c.Weights)) {
+		if !isRiskSignal(signal) {
+			errs = append(errs, fmt.Errorf("SESSION_RISK_WEIGHTS: unknown signal %q", signal))
+		}
+	}
+	return errs
+}
+
+func isRiskSignal(signal string) bool {
+	switch authmodels.RiskSignal(signal) {
+	case authmodels.RiskSignalNewDevice, authmodels.RiskSignalNewLocation,
+		authmodels.RiskSignalUnusualTime, authmodels.RiskSignalRapidRefresh:
+		return true
+	}
+	return false
+}
+
+// isEvidenceType reports whether typ names a registry provider type.
+func isEvidenceType(typ string) bool {
+	switch providers.ProviderType(typ) {
+	case providers.ProviderTypeCitizen, providers.ProviderTypeSanctions,
+		providers.ProviderTypeBiometric, providers.ProviderTypeDocument, providers.ProviderTypeWallet:
+		return true
+	}
+	return false
+}
+
+// validateListenAddr checks that addr is a host:port pair with a valid port.
+// An empty host (":8080") binds all interfaces and is accepted.
+func validateListenAddr(addr string) error {
+	_, port, err := net.SplitHostPort(addr)
+	if err != nil {
+		return fmt.Errorf("must be host:port: %w", err)
+	}
+	n, err := strconv.Atoi(port)
+	if err != nil || n < 0 || n > 65535 {
+		return fmt.Errorf("invalid port %q", port)
+	}
+	return nil
 }
 
 func parseAllowedRedirectSchemes(raw, env string) []string {
@@ -339,7 +1046,7 @@ func parseAllowedRedirectSchemes(raw, env string) []string {
 }
 
 func validateDemoMode() error {
-	prodVars := []string{"JWT_SIGNING_KEY"}
+	prodVars := []string{"JWT_SIGNING_KEY", "JWT_SIGNING_KEY_FILE"}
 	for _, key := range prodVars {
 		if val := os.Getenv(key); val != "" {
 			return fmt.Errorf("refusing to start demo env with production variables: %s", key)
@@ -0,0 +1,604 @@
+package config
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"credo/internal/evidence/registry/domain/citizen"
+	"credo/internal/evidence/registry/providers"
+	ratelimitmodels "credo/internal/ratelimit/models"
+)
+
+func TestFromEnv_ValidConfig(t *testing.T) {
+	t.Setenv("CREDO_ENV", "local")
+	t.Setenv("ID_GATEWAY_ADDR", "127.0.0.1:9090")
+	t.Setenv("REGULATED_MODE", "True")
+	t.Setenv("TOKEN_TTL", "10m")
+	t.Setenv("DB_MAX_OPEN_CONNS", "40")
+	t.Setenv("REFRESH_TOKEN_USED_GRACE", "2h")
+
+	cfg, err := FromEnv()
+	require.NoError(t, err)
+
+	assert.Equal(t, "127.0.0.1:9090", cfg.Addr)
+	assert.True(t, cfg.Security.RegulatedMode)
+	assert.Equal(t, "10m0s", cfg.Auth.TokenTTL.String())
+	assert.Equal(t, 40, cfg.Database.MaxOpenConns)
+	assert.Equal(t, 2*time.Hour, cfg.Auth.RefreshTokenUsedGrace)
+	assert.Equal(t, DefaultSessionTTL, cfg.Auth.SessionTTL, "unset values keep their defaults")
+}
+
+func TestFromEnv_MalformedBoolean(t *testing.T) {
+	t.Setenv("CREDO_ENV", "local")
+	t.Setenv("REGULATED_MODE", "ture")
+
+	_, err := FromEnv()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "REGULATED_MODE")
+}
+
+func TestFromEnv_MalformedDuration(t *testing.T) {
+	t.Setenv("CREDO_ENV", "local")
+	t.Setenv("TOKEN_TTL", "15 minutes")
+
+	_, err := FromEnv()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "TOKEN_TTL")
+}
+
+func TestFromEnv_MalformedAddress(t *testing.T) {
+	t.Setenv("CREDO_ENV", "local")
+	t.Setenv("ID_GATEWAY_ADDR", "localhost8080")
+
+	_, err := FromEnv()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "ID_GATEWAY_ADDR")
+}
+
+func TestFromEnv_ReportsAllErrors(t *testing.T) {
+	t.Setenv("CREDO_ENV", "local")
+	t.Setenv("DEVICE_BINDING_ENABLED", "yes please")
+	t.Setenv("REDIS_POOL_SIZE", "-1")
+
+	_, err := FromEnv()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "DEVICE_BINDING_ENABLED")
+	assert.Contains(t, err.Error(), "REDIS_POOL_SIZE")
+}
+
+func TestFromEnv_ExplicitZero(t *testing.T) {
+	tests := []struct {
+		key string
+		get func(Server) any
+	}{
+		{"REDIS_MIN_IDLE_CONNS", func(c Server) any { return c.Redis.MinIdleConns }},
+		{"DB_MAX_IDLE_CONNS", func(c Server) any { return c.Database.MaxIdleConns }},
+		{"KAFKA_RETRIES", func(c Server) any { return c.Kafka.Retries }},
+		{"SESSION_RISK_USUAL_HOURS_START", func(c Server) any { return c.Auth.SessionRisk.UsualHoursStart }},
+		{"SESSION_RISK_USUAL_HOURS_END", func(c Server) any { return c.Auth.SessionRisk.UsualHoursEnd }},
+		{"MAX_SESSIONS_PER_DEVICE", func(c Server) any { return c.Auth.MaxSessionsPerDevice }},
+		{"AUDIT_OPS_SAMPLE_RATE", func(c Server) any { return c.Audit.OpsSampleRate }},
+		{"DECISION_MIN_COMPLETENESS", func(c Server) any { return c.Decision.MinCompleteness }},
+	}
+	for _, tc := range tests {
+		t.Run(tc.key+" accepts 0", func(t *testing.T) {
+			t.Setenv("CREDO_ENV", "local")
+			t.Setenv(tc.key, "0")
+
+			cfg, err := FromEnv()
+			require.NoError(t, err)
+			assert.Zero(t, tc.get(cfg))
+		})
+
+		t.Run(tc.key+" rejects negatives", func(t *testing.T) {
+			t.Setenv("CREDO_ENV", "local")
+			t.Setenv(tc.key, "-1")
+
+			_, err := FromEnv()
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tc.key)
+		})
+	}
+}
+
+func TestFromEnv_AsymmetricAlgRequiresKey(t *testing.T) {
+	t.Setenv("CREDO_ENV", "local")
+	t.Setenv("JWT_CLIENT_SIGNING_ALGS", "external-client=RS256")
+
+	_, err := FromEnv()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "requires JWT_ASYMMETRIC_KEY_FILE")
+}
+
+func TestFromEnv_MalformedClientSigningAlgs(t *testing.T) {
+	t.Setenv("CREDO_ENV", "local")
+	t.Setenv("JWT_CLIENT_SIGNING_ALGS", "external-client")
+
+	_, err := FromEnv()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "JWT_CLIENT_SIGNING_ALGS")
+}
+
+func TestFromEnv_AuditCategoryOverrides(t *testing.T) {
+	t.Setenv("CREDO_ENV", "local")
+	t.Setenv("AUDIT_CATEGORY_OVERRIDES", "token_issued=security")
+
+	cfg, err := FromEnv()
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"token_issued": "security"}, cfg.Audit.CategoryOverrides)
+}
+
+func TestFromEnv_UnknownAuditCategoryOverride(t *testing.T) {
+	t.Setenv("CREDO_ENV", "local")
+	t.Setenv("AUDIT_CATEGORY_OVERRIDES", "token_issued=archive")
+
+	_, err := FromEnv()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "AUDIT_CATEGORY_OVERRIDES")
+}
+
+func TestFromEnv_AuditBackends(t *testing.T) {
+	t.Run("parses backends and ops sampling", func(t *testing.T) {
+		t.Setenv("CREDO_ENV", "local")
+		t.Setenv("AUDIT_BACKENDS", "security=siem_log,operations=primary")
+		t.Setenv("AUDIT_OPS_SAMPLE_RATE", "0.5")
+
+		cfg, err := FromEnv()
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{"security": AuditBackendSIEMLog, "operations": AuditBackendPrimary}, cfg.Audit.Backends)
+		assert.Equal(t, 0.5, cfg.Audit.OpsSampleRate)
+	})
+
+	t.Run("compliance cannot be rerouted", func(t *testing.T) {
+		t.Setenv("CREDO_ENV", "local")
+		t.Setenv("AUDIT_BACKENDS", "compliance=siem_log")
+
+		_, err := FromEnv()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "AUDIT_BACKENDS")
+	})
+
+	t.Run("unknown backend rejected", func(t *testing.T) {
+		t.Setenv("CREDO_ENV", "local")
+		t.Setenv("AUDIT_BACKENDS", "security=archive")
+
+		_, err := FromEnv()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "AUDIT_BACKENDS")
+	})
+
+	t.Run("sample rate above 1 rejected", func(t *testing.T) {
+		t.Setenv("CREDO_ENV", "local")
+		t.Setenv("AUDIT_OPS_SAMPLE_RATE", "1.5")
+
+		_, err := FromEnv()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "AUDIT_OPS_SAMPLE_RATE")
+	})
+}
+
+func TestFromEnv_DecisionMinCompleteness(t *testing.T) {
+	t.Run("defaults to accepting any completeness", func(t *testing.T) {
+		t.Setenv("CREDO_ENV", "local")
+
+		cfg, err := FromEnv()
+		require.NoError(t, err)
+		assert.Zero(t, cfg.Decision.MinCompleteness)
+	})
+
+	t.Run("parses threshold", func(t *testing.T) {
+		t.Setenv("CREDO_ENV", "local")
+		t.Setenv("DECISION_MIN_COMPLETENESS", "0.66")
+
+		cfg, err := FromEnv()
+		require.NoError(t, err)
+		assert.Equal(t, 0.66, cfg.Decision.MinCompleteness)
+	})
+
+	t.Run("threshold above 1 rejected", func(t *testing.T) {
+		t.Setenv("CREDO_ENV", "local")
+		t.Setenv("DECISION_MIN_COMPLETENESS", "1.5")
+
+		_, err := FromEnv()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "DECISION_MIN_COMPLETENESS")
+	})
+}
+
+func TestFromEnv_TenantSecretRotationGrace(t *testing.T) {
+	t.Run("accepts 0s", func(t *testing.T) {
+		t.Setenv("CREDO_ENV", "local")
+		t.Setenv("TENANT_SECRET_ROTATION_GRACE", "0s")
+
+		cfg, err := FromEnv()
+		require.NoError(t, err)
+		assert.Zero(t, cfg.Tenant.SecretRotationGrace)
+	})
+
+	t.Run("parses window", func(t *testing.T) {
+		t.Setenv("CREDO_ENV", "local")
+		t.Setenv("TENANT_SECRET_ROTATION_GRACE", "24h")
+
+		cfg, err := FromEnv()
+		require.NoError(t, err)
+		assert.Equal(t, 24*time.Hour, cfg.Tenant.SecretRotationGrace)
+	})
+
+	t.Run("negative window rejected", func(t *testing.T) {
+		t.Setenv("CREDO_ENV", "local")
+		t.Setenv("TENANT_SECRET_ROTATION_GRACE", "-5s")
+
+		_, err := FromEnv()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "TENANT_SECRET_ROTATION_GRACE")
+	})
+}
+
+func TestFromEnv_RegistryMinConfidence(t *testing.T) {
+	t.Run("parses per-type floors", func(t *testing.T) {
+		t.Setenv("CREDO_ENV", "local")
+		t.Setenv("REGISTRY_MIN_CONFIDENCE", "citizen=0.8,sanctions=0.5")
+
+		cfg, err := FromEnv()
+		require.NoError(t, err)
+		assert.Equal(t, map[string]float64{"citizen": 0.8, "sanctions": 0.5}, cfg.Registry.MinConfidence)
+	})
+
+	t.Run("rejects floors outside 0 to 1", func(t *testing.T) {
+		t.Setenv("CREDO_ENV", "local")
+		t.Setenv("REGISTRY_MIN_CONFIDENCE", "citizen=1.5")
+
+		_, err := FromEnv()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "REGISTRY_MIN_CONFIDENCE")
+	})
+
+	t.Run("rejects unknown evidence types", func(t *testing.T) {
+		t.Setenv("CREDO_ENV", "local")
+		t.Setenv("REGISTRY_MIN_CONFIDENCE", "citzen=0.8")
+
+		_, err := FromEnv()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `unknown evidence type "citzen"`)
+	})
+}
+
+func TestFromEnv_RegistryProviderWeights(t *testing.T) {
+	t.Run("parses per-provider weights", func(t *testing.T) {
+		t.Setenv("CREDO_ENV", "local")
+		t.Setenv("REGISTRY_PROVIDER_WEIGHTS", "ofac-list=2,internal-watchlist=0.5")
+
+		cfg, err := FromEnv()
+		require.NoError(t, err)
+		assert.Equal(t, map[string]float64{"ofac-list": 2, "internal-watchlist": 0.5}, cfg.Registry.ProviderWeights)
+	})
+
+	t.Run("rejects negative weights", func(t *testing.T) {
+		t.Setenv("CREDO_ENV", "local")
+		t.Setenv("REGISTRY_PROVIDER_WEIGHTS", "ofac-list=-1")
+
+		_, err := FromEnv()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "REGISTRY_PROVIDER_WEIGHTS")
+	})
+}
+
+func TestFromEnv_RegistryMinimizationRules(t *testing.T) {
+	t.Run("defaults to full minimization", func(t *testing.T) {
+		t.Setenv("CREDO_ENV", "local")
+
+		cfg, err := FromEnv()
+		require.NoError(t, err)
+		assert.Equal(t, citizen.MinimizationPolicy{}, cfg.Registry.Minimization)
+	})
+
+	t.Run("parses coarsening rules", func(t *testing.T) {
+		t.Setenv("CREDO_ENV", "local")
+		t.Setenv("REGISTRY_MINIMIZATION_RULES", "date_of_birth=year,address=city,full_name=clear")
+
+		cfg, err := FromEnv()
+		require.NoError(t, err)
+		assert.Equal(t, citizen.MinimizationPolicy{DateOfBirth: citizen.RuleYear, Address: citizen.RuleCity}, cfg.Registry.Minimization)
+	})
+
+	t.Run("rejects rules a field cannot take", func(t *testing.T) {
+		t.Setenv("CREDO_ENV", "local")
+		t.Setenv("REGISTRY_MINIMIZATION_RULES", "full_name=year")
+
+		_, err := FromEnv()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "REGISTRY_MINIMIZATION_RULES")
+	})
+}
+
+func TestFromEnv_CookieConfig(t *testing.T) {
+	t.Run("parses configured attributes", func(t *testing.T) {
+		t.Setenv("CREDO_ENV", "local")
+		t.Setenv("COOKIE_SECURE", "true")
+		t.Setenv("COOKIE_SAMESITE", "none")
+		t.Setenv("COOKIE_DOMAIN", "example.com")
+
+		cfg, err := FromEnv()
+		require.NoError(t, err)
+		assert.True(t, cfg.Auth.Cookie.Secure)
+		assert.Equal(t, http.SameSiteNoneMode, cfg.Auth.Cookie.SameSiteMode())
+		assert.Equal(t, "example.com", cfg.Auth.Cookie.Domain)
+		assert.Equal(t, DefaultCookiePath, cfg.Auth.Cookie.Path)
+		assert.True(t, cfg.Auth.Cookie.HTTPOnly)
+	})
+
+	t.Run("rejects SameSite=None without Secure", func(t *testing.T) {
+		t.Setenv("CREDO_ENV", "local")
+		t.Setenv("COOKIE_SAMESITE", "none")
+
+		_, err := FromEnv()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "none requires COOKIE_SECURE=true")
+	})
+
+	t.Run("rejects unknown SameSite values", func(t *testing.T) {
+		t.Setenv("CREDO_ENV", "local")
+		t.Setenv("COOKIE_SAMESITE", "relaxed")
+
+		_, err := FromEnv()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "COOKIE_SAMESITE")
+	})
+
+	t.Run("rejects a relative path", func(t *testing.T) {
+		t.Setenv("CREDO_ENV", "local")
+		t.Setenv("COOKIE_PATH", "auth")
+
+		_, err := FromEnv()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "COOKIE_PATH")
+	})
+}
+
+func TestFromEnv_RequestSizeLimits(t *testing.T) {
+	t.Run("defaults apply when unset", func(t *testing.T) {
+		t.Setenv("CREDO_ENV", "local")
+
+		cfg, err := FromEnv()
+		require.NoError(t, err)
+		assert.Equal(t, DefaultMaxURLBytes, cfg.Security.MaxURLBytes)
+		assert.Equal(t, DefaultMaxHeaderBytes, cfg.Security.MaxHeaderBytes)
+	})
+
+	t.Run("rejects non-positive limits", func(t *testing.T) {
+		t.Setenv("CREDO_ENV", "local")
+		t.Setenv("MAX_URL_BYTES", "0")
+		t.Setenv("MAX_HEADER_BYTES", "-1")
+
+		_, err := FromEnv()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "MAX_URL_BYTES")
+		assert.Contains(t, err.Error(), "MAX_HEADER_BYTES")
+	})
+}
+
+func TestFromEnv_RateLimitGlobalShards(t *testing.T) {
+	t.Run("parses shard count", func(t *testing.T) {
+		t.Setenv("CREDO_ENV", "local")
+		t.Setenv("RATE_LIMIT_GLOBAL_SHARDS", "8")
+
+		cfg, err := FromEnv()
+		require.NoError(t, err)
+		assert.Equal(t, 8, cfg.RateLimitGlobalShards)
+	})
+
+	t.Run("rejects out of range shard counts", func(t *testing.T) {
+		for _, v := range []string{"0", "65"} {
+			t.Setenv("CREDO_ENV", "local")
+			t.Setenv("RATE_LIMIT_GLOBAL_SHARDS", v)
+
+			_, err := FromEnv()
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), "RATE_LIMIT_GLOBAL_SHARDS")
+		}
+	})
+}
+
+func TestFromEnv_RateLimitDegradedPolicy(t *testing.T) {
+	t.Run("parses per-class modes", func(t *testing.T) {
+		t.Setenv("CREDO_ENV", "local")
+		t.Setenv("RATE_LIMIT_DEGRADED_POLICY", "auth=open,read=closed")
+
+		cfg, err := FromEnv()
+		require.NoError(t, err)
+		assert.Equal(t, map[ratelimitmodels.EndpointClass]bool{
+			ratelimitmodels.ClassAuth: false,
+			ratelimitmodels.ClassRead: true,
+		}, cfg.RateLimitDegradedPolicy)
+	})
+
+	t.Run("rejects unknown classes and modes", func(t *testing.T) {
+		for _, v := range []string{"login=closed", "auth=deny"} {
+			t.Setenv("CREDO_ENV", "local")
+			t.Setenv("RATE_LIMIT_DEGRADED_POLICY", v)
+
+			_, err := FromEnv()
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), "RATE_LIMIT_DEGRADED_POLICY")
+		}
+	})
+}
+
+func TestFromEnv_RateLimitBackoff(t *testing.T) {
+	t.Run("defaults leave escalation off", func(t *testing.T) {
+		t.Setenv("CREDO_ENV", "local")
+
+		cfg, err := FromEnv()
+		require.NoError(t, err)
+		assert.Equal(t, DefaultRateLimitBackoffMultiplier, cfg.RateLimitBackoff.Multiplier)
+		assert.Equal(t, DefaultRateLimitBackoffMaxRetryAfter, cfg.RateLimitBackoff.MaxRetryAfter)
+		assert.Equal(t, DefaultRateLimitBackoffQuietPeriod, cfg.RateLimitBackoff.QuietPeriod)
+	})
+
+	t.Run("parses backoff settings", func(t *testing.T) {
+		t.Setenv("CREDO_ENV", "local")
+		t.Setenv("RATE_LIMIT_BACKOFF_MULTIPLIER", "2.5")
+		t.Setenv("RATE_LIMIT_BACKOFF_MAX_RETRY_AFTER", "5m")
+		t.Setenv("RATE_LIMIT_BACKOFF_QUIET_PERIOD", "2m")
+
+		cfg, err := FromEnv()
+		require.NoError(t, err)
+		assert.Equal(t, 2.5, cfg.RateLimitBackoff.Multiplier)
+		assert.Equal(t, 5*time.Minute, cfg.RateLimitBackoff.MaxRetryAfter)
+		assert.Equal(t, 2*time.Minute, cfg.RateLimitBackoff.QuietPeriod)
+	})
+
+	t.Run("rejects a non-numeric multiplier", func(t *testing.T) {
+		t.Setenv("CREDO_ENV", "local")
+		t.Setenv("RATE_LIMIT_BACKOFF_MULTIPLIER", "double")
+
+		_, err := FromEnv()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "RATE_LIMIT_BACKOFF_MULTIPLIER")
+	})
+}
+
+func TestFromEnv_RateLimitProbe(t *testing.T) {
+	t.Run("parses probe exemption", func(t *testing.T) {
+		t.Setenv("CREDO_ENV", "local")
+		t.Setenv("RATE_LIMIT_PROBE_SECRET", "probe-secret-0123456789abcdef0123")
+		t.Setenv("RATE_LIMIT_PROBE_USER_AGENTS", "uptime-checker/, kube-probe/")
+		t.Setenv("RATE_LIMIT_PROBE_PATHS", "/health, /status")
+
+		cfg, err := FromEnv()
+		require.NoError(t, err)
+		assert.Equal(t, DefaultRateLimitProbeHeader, cfg.RateLimitProbe.Header)
+		assert.Equal(t, []string{"uptime-checker/", "kube-probe/"}, cfg.RateLimitProbe.UserAgents)
+		assert.Equal(t, []string{"/health", "/status"}, cfg.RateLimitProbe.Paths)
+	})
+
+	t.Run("rejects short secrets", func(t *testing.T) {
+		t.Setenv("CREDO_ENV", "local")
+		t.Setenv("RATE_LIMIT_PROBE_SECRET", "short")
+
+		_, err := FromEnv()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "RATE_LIMIT_PROBE_SECRET")
+	})
+}
+
+func TestFromEnv_RegistryCacheTTLs(t *testing.T) {
+	t.Run("parses per-type TTLs", func(t *testing.T) {
+		t.Setenv("CREDO_ENV", "local")
+		t.Setenv("REGISTRY_CACHE_TTLS", "citizen=24h,sanctions=1h")
+
+		cfg, err := FromEnv()
+		require.NoError(t, err)
+		assert.Equal(t, map[providers.ProviderType]time.Duration{providers.ProviderTypeCitizen: 24 * time.Hour, providers.ProviderTypeSanctions: time.Hour}, cfg.Registry.CacheTTLs)
+	})
+
+	t.Run("rejects non-positive TTLs", func(t *testing.T) {
+		t.Setenv("CREDO_ENV", "local")
+		t.Setenv("REGISTRY_CACHE_TTLS", "sanctions=0s")
+
+		_, err := FromEnv()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "REGISTRY_CACHE_TTLS")
+	})
+
+	t.Run("rejects types the cache does not hold", func(t *testing.T) {
+		t.Setenv("CREDO_ENV", "local")
+		t.Setenv("REGISTRY_CACHE_TTLS", "biometric=1h")
+
+		_, err := FromEnv()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `"biometric" is not a cached record type`)
+	})
+
+	t.Run("negative cache must stay shorter than the citizen TTL", func(t *testing.T) {
+		t.Setenv("CREDO_ENV", "local")
+		t.Setenv("REGISTRY_CACHE_TTLS", "citizen=30s")
+
+		_, err := FromEnv()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "REGISTRY_NEGATIVE_CACHE_TTL")
+	})
+}
+
+func TestFromEnv_SessionRisk(t *testing.T) {
+	t.Run("parses scoring settings", func(t *testing.T) {
+		t.Setenv("CREDO_ENV", "local")
+		t.Setenv("SESSION_RISK_ENABLED", "true")
+		t.Setenv("SESSION_RISK_WEIGHTS", "new_device=50,rapid_refresh=10")
+		t.Setenv("SESSION_RISK_USUAL_HOURS_START", "22")
+		t.Setenv("SESSION_RISK_USUAL_HOURS_END", "6")
+
+		cfg, err := FromEnv()
+		require.NoError(t, err)
+		assert.True(t, cfg.Auth.SessionRisk.Enabled)
+		assert.Equal(t, map[string]int{"new_device": 50, "rapid_refresh": 10}, cfg.Auth.SessionRisk.Weights)
+		assert.Equal(t, DefaultSessionRiskThreshold, cfg.Auth.SessionRisk.Threshold)
+		assert.Equal(t, 22, cfg.Auth.SessionRisk.UsualHoursStart)
+		assert.False(t, cfg.Auth.SessionRisk.StepUp)
+	})
+
+	t.Run("rejects unknown signals", func(t *testing.T) {
+		t.Setenv("CREDO_ENV", "local")
+		t.Setenv("SESSION_RISK_WEIGHTS", "new_ip=20")
+
+		_, err := FromEnv()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `unknown signal "new_ip"`)
+	})
+
+	t.Run("rejects thresholds and hours off the scale", func(t *testing.T) {
+		t.Setenv("CREDO_ENV", "local")
+		t.Setenv("SESSION_RISK_THRESHOLD", "101")
+		t.Setenv("SESSION_RISK_USUAL_HOURS_END", "24")
+
+		_, err := FromEnv()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "SESSION_RISK_THRESHOLD")
+		assert.Contains(t, err.Error(), "SESSION_RISK_USUAL_HOURS_END")
+	})
+}
+
+func TestFromEnv_DeviceSessionLimit(t *testing.T) {
+	t.Run("disabled by default", func(t *testing.T) {
+		t.Setenv("CREDO_ENV", "local")
+
+		cfg, err := FromEnv()
+		require.NoError(t, err)
+		assert.Zero(t, cfg.Auth.MaxSessionsPerDevice)
+		assert.Equal(t, "evict", cfg.Auth.DeviceSessionLimitMode)
+	})
+
+	t.Run("rejects unknown modes", func(t *testing.T) {
+		t.Setenv("CREDO_ENV", "local")
+		t.Setenv("MAX_SESSIONS_PER_DEVICE", "3")
+		t.Setenv("DEVICE_SESSION_LIMIT_MODE", "drop")
+
+		_, err := FromEnv()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "DEVICE_SESSION_LIMIT_MODE")
+	})
+}
+
+func TestFromEnv_AuthLocationNetworks(t *testing.T) {
+	t.Run("parses cidr=location pairs", func(t *testing.T) {
+		t.Setenv("CREDO_ENV", "local")
+		t.Setenv("AUTH_LOCATION_NETWORKS", "203.0.113.0/24=Berlin, DE; 2001:db8::/32=Paris, FR")
+
+		cfg, err := FromEnv()
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{"203.0.113.0/24": "Berlin, DE", "2001:db8::/32": "Paris, FR"}, cfg.Auth.LocationNetworks)
+	})
+
+	t.Run("rejects entries that are not networks", func(t *testing.T) {
+		t.Setenv("CREDO_ENV", "local")
+		t.Setenv("AUTH_LOCATION_NETWORKS", "203.0.113.7=Berlin, DE")
+
+		_, err := FromEnv()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "AUTH_LOCATION_NETWORKS")
+	})
+}
@@ -5,11 +5,30 @@ import (
 	"time"
 )
 
+// Option configures the server built by New.
+type Option func(*http.Server)
+
+// WithMaxHeaderBytes bounds how much of the request line and headers the server
+// reads before rejecting the request. Set it at or above the request.HeaderLimit
+// budget so the middleware, not the server, answers ordinary oversized requests.
+// Non-positive values keep net/http's default of 1 MB.
+func WithMaxHeaderBytes(n int) Option {
+	return func(s *http.Server) {
+		if n > 0 {
+			s.MaxHeaderBytes = n
+		}
+	}
+}
+
 // New builds an HTTP server with sane defaults for this project.
-func New(addr string, handler http.Handler) *http.Server {
-	return &http.Server{
+func New(addr string, handler http.Handler, opts ...Option) *http.Server {
+	srv := &http.Server{
 		Addr:              addr,
 		Handler:           handler,
 		ReadHeaderTimeout: 5 * time.Second,
 	}
+	for _, opt := range opts {
+		opt(srv)
+	}
+	return srv
 }
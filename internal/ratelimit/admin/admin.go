@@ -2,20 +2,26 @@ package admin
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 
+	"credo/internal/ratelimit/config"
 	"credo/internal/ratelimit/models"
 	"credo/internal/ratelimit/observability"
 	id "credo/pkg/domain"
+	dErrors "credo/pkg/domain-errors"
+	"credo/pkg/platform/sentinel"
 	"credo/pkg/requestcontext"
 
 	"github.com/google/uuid"
 )
 
-// AllowlistStore is the subset of ports.AllowlistStore needed by admin (excludes IsAllowlisted).
+// AllowlistStore is the subset of ports.AllowlistStore needed by admin.
 type AllowlistStore interface {
+	IsAllowlisted(ctx context.Context, identifier string) (bool, error)
 	Add(ctx context.Context, entry *models.AllowlistEntry) error
+	// Remove returns sentinel.ErrNotFound when no entry matches.
 	Remove(ctx context.Context, entryType models.AllowlistEntryType, identifier string) error
 	List(ctx context.Context) ([]*models.AllowlistEntry, error)
 }
@@ -26,9 +32,30 @@ type BucketStore interface {
 	GetCurrentCount(ctx context.Context, key string) (int, error)
 }
 
+// AuthLockoutStore is the read side of ports.AuthLockoutStore.
+type AuthLockoutStore interface {
+	Get(ctx context.Context, identifier string) (*models.AuthLockout, error)
+}
+
+// QuotaStore is the read side of ports.QuotaStore.
+type QuotaStore interface {
+	GetQuota(ctx context.Context, apiKeyID id.APIKeyID) (*models.APIKeyQuota, error)
+}
+
+// bucketClasses are the endpoint classes an identifier has buckets in.
+var bucketClasses = []models.EndpointClass{
+	models.ClassAuth,
+	models.ClassSensitive,
+	models.ClassRead,
+	models.ClassWrite,
+}
+
 type Service struct {
 	allowlist      AllowlistStore
 	buckets        BucketStore
+	lockouts       AuthLockoutStore
+	quotas         QuotaStore
+	config         *config.Config
 	auditPublisher observability.AuditPublisher
 	logger         *slog.Logger
 }
@@ -47,6 +74,28 @@ func WithAuditPublisher(publisher observability.AuditPublisher) Option {
 	}
 }
 
+// WithAuthLockoutStore lets RateLimitState report lockout records.
+func WithAuthLockoutStore(store AuthLockoutStore) Option {
+	return func(s *Service) {
+		s.lockouts = store
+	}
+}
+
+// WithQuotaStore lets RateLimitState report API key quotas.
+func WithQuotaStore(store QuotaStore) Option {
+	return func(s *Service) {
+		s.quotas = store
+	}
+}
+
+// WithConfig sets the limits RateLimitState reports buckets against.
+// Defaults to config.DefaultConfig().
+func WithConfig(cfg *config.Config) Option {
+	return func(s *Service) {
+		s.config = cfg
+	}
+}
+
 func New(
 	allowlist AllowlistStore,
 	buckets BucketStore,
@@ -67,6 +116,9 @@ func New(
 	for _, opt := range opts {
 		opt(svc)
 	}
+	if svc.config == nil {
+		svc.config = config.DefaultConfig()
+	}
 
 	return svc, nil
 }
@@ -101,6 +153,9 @@ func (s *Service) RemoveFromAllowlist(ctx context.Context, req *models.RemoveAll
 	}
 
 	if err := s.allowlist.Remove(ctx, req.Type, req.Identifier); err != nil {
+		if errors.Is(err, sentinel.ErrNotFound) {
+			return dErrors.New(dErrors.CodeNotFound, "allowlist entry not found")
+		}
 		return fmt.Errorf("failed to remove from allowlist: %w", err)
 	}
 
@@ -119,19 +174,16 @@ func (s *Service) ListAllowlist(ctx context.Context) ([]*models.AllowlistEntry,
 	return entries, nil
 }
 
-func (s *Service) ResetRateLimit(ctx context.Context, req *models.ResetRateLimitRequest) error {
+// ResetRateLimit clears the bucket for the identifier in the requested class,
+// or in every class when none is given. adminUserID is recorded on the audit event.
+func (s *Service) ResetRateLimit(ctx context.Context, req *models.ResetRateLimitRequest, adminUserID id.UserID) error {
 	req.Normalize()
 	if err := req.Validate(); err != nil {
 		return fmt.Errorf("invalid reset rate limit request: %w", err)
 	}
 	classes := []models.EndpointClass{req.Class}
 	if req.Class == "" {
-		classes = []models.EndpointClass{
-			models.ClassAuth,
-			models.ClassSensitive,
-			models.ClassRead,
-			models.ClassWrite,
-		}
+		classes = bucketClasses
 	}
 	keys := make([]string, 0, len(classes))
 	var prefix models.KeyPrefix
@@ -159,6 +211,70 @@ func (s *Service) ResetRateLimit(ctx context.Context, req *models.ResetRateLimit
 		"identifier", req.Identifier,
 		"type", req.Type,
 		"class", req.Class,
+		"admin_user_id", adminUserID,
 	)
 	return nil
 }
+
+// RateLimitState snapshots an identifier's buckets, allowlist status, and,
+// when requested, the lockout for a login from that IP and an API key quota.
+// Every read is non-consuming, so inspecting an identifier never throttles it.
+func (s *Service) RateLimitState(ctx context.Context, req *models.RateLimitStateRequest) (*models.RateLimitState, error) {
+	req.Normalize()
+	if err := req.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid rate limit state request: %w", err)
+	}
+
+	prefix, limits := models.KeyPrefixIP, s.config.IPLimits
+	if req.Type == models.AllowlistTypeUserID {
+		prefix, limits = models.KeyPrefixUser, s.config.UserLimits
+	}
+
+	state := &models.RateLimitState{
+		Type:       req.Type,
+		Identifier: req.Identifier,
+		Buckets:    make([]models.BucketState, 0, len(bucketClasses)),
+	}
+
+	allowlisted, err := s.allowlist.IsAllowlisted(ctx, req.Identifier)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check allowlist: %w", err)
+	}
+	state.Allowlisted = allowlisted
+
+	for _, class := range bucketClasses {
+		key := models.NewRateLimitKey(prefix, req.Identifier, class).String()
+		count, err := s.buckets.GetCurrentCount(ctx, key)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read bucket %s: %w", key, err)
+		}
+		limit := limits[class]
+		state.Buckets = append(state.Buckets, models.BucketState{
+			Class:         class,
+			Key:           key,
+			Count:         count,
+			Limit:         limit.RequestsPerWindow,
+			Remaining:     max(limit.RequestsPerWindow-count, 0),
+			WindowSeconds: int(limit.Window.Seconds()),
+		})
+	}
+
+	if req.Login != "" && s.lockouts != nil {
+		key := models.NewAuthLockoutKey(req.Login, req.Identifier).String()
+		if state.Lockout, err = s.lockouts.Get(ctx, key); err != nil {
+			return nil, fmt.Errorf("failed to read lockout: %w", err)
+		}
+	}
+
+	if req.APIKey != "" && s.quotas != nil {
+		apiKeyID, err := id.ParseAPIKeyID(req.APIKey)
+		if err != nil {
+			return nil, dErrors.New(dErrors.CodeValidation, "invalid api_key format")
+		}
+		if state.Quota, err = s.quotas.GetQuota(ctx, apiKeyID); err != nil {
+			return nil, fmt.Errorf("failed to read quota: %w", err)
+		}
+	}
+
+	return state, nil
+}
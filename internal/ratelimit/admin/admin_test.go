@@ -4,18 +4,30 @@ package admin
 
 import (
 	"context"
+	"errors"
 	"io"
 	"log/slog"
 	"testing"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/suite"
 	"go.uber.org/mock/gomock"
 
 	"credo/internal/ratelimit/admin/mocks"
+	"credo/internal/ratelimit/config"
 	"credo/internal/ratelimit/models"
 	"credo/internal/ratelimit/observability"
+	allowlistStore "credo/internal/ratelimit/store/allowlist"
+	authlockoutStore "credo/internal/ratelimit/store/authlockout"
+	bucketStore "credo/internal/ratelimit/store/bucket"
+	quotaStore "credo/internal/ratelimit/store/quota"
+	id "credo/pkg/domain"
+	dErrors "credo/pkg/domain-errors"
 	"credo/pkg/platform/audit/publishers/security"
 	auditmemory "credo/pkg/platform/audit/store/memory"
+	"credo/pkg/platform/sentinel"
+	"credo/pkg/requestcontext"
 )
 
 // =============================================================================
@@ -31,6 +43,7 @@ type AdminServiceSuite struct {
 	mockAllowlist  *mocks.MockAllowlistStore
 	mockBuckets    *mocks.MockBucketStore
 	auditPublisher observability.AuditPublisher
+	auditStore     *auditmemory.InMemoryStore
 	service        *Service
 }
 
@@ -42,7 +55,8 @@ func (s *AdminServiceSuite) SetupTest() {
 	s.ctrl = gomock.NewController(s.T())
 	s.mockAllowlist = mocks.NewMockAllowlistStore(s.ctrl)
 	s.mockBuckets = mocks.NewMockBucketStore(s.ctrl)
-	s.auditPublisher = security.New(auditmemory.NewInMemoryStore())
+	s.auditStore = auditmemory.NewInMemoryStore()
+	s.auditPublisher = security.New(s.auditStore)
 	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
 	s.service, _ = New(
 		s.mockAllowlist,
@@ -117,7 +131,7 @@ func (s *AdminServiceSuite) TestResetRateLimitNormalization() {
 			Reset(ctx, "ip:192.168.1.100:auth").
 			Return(nil)
 
-		err := s.service.ResetRateLimit(ctx, req)
+		err := s.service.ResetRateLimit(ctx, req, id.UserID{})
 		s.NoError(err)
 	})
 
@@ -140,7 +154,7 @@ func (s *AdminServiceSuite) TestResetRateLimitNormalization() {
 			Reset(ctx, "user:user-123:write").
 			Return(nil)
 
-		err := s.service.ResetRateLimit(ctx, req)
+		err := s.service.ResetRateLimit(ctx, req, id.UserID{})
 		s.NoError(err)
 	})
 
@@ -150,8 +164,178 @@ func (s *AdminServiceSuite) TestResetRateLimitNormalization() {
 			Identifier: "192.168.1.100",
 		}
 
-		err := s.service.ResetRateLimit(ctx, req)
+		err := s.service.ResetRateLimit(ctx, req, id.UserID{})
 		s.Error(err)
 		s.Contains(err.Error(), "type must be")
 	})
 }
+
+func (s *AdminServiceSuite) TestResetRateLimitAudit() {
+	ctx := context.Background()
+	adminUserID := id.UserID(uuid.New())
+
+	s.Run("reset emits audit event with admin actor", func() {
+		s.mockBuckets.EXPECT().Reset(ctx, "ip:192.168.1.100:read").Return(nil)
+
+		err := s.service.ResetRateLimit(ctx, &models.ResetRateLimitRequest{
+			Type:       models.AllowlistTypeIP,
+			Identifier: "192.168.1.100",
+			Class:      models.ClassRead,
+		}, adminUserID)
+		s.Require().NoError(err)
+		s.Require().NoError(s.auditPublisher.Flush(ctx))
+
+		events, err := s.auditStore.ListAll(ctx)
+		s.Require().NoError(err)
+		s.Require().Len(events, 1)
+		s.Equal("rate_limit_reset", events[0].Action)
+		s.Equal("192.168.1.0", events[0].Subject, "IP subjects are anonymized")
+		s.Equal(adminUserID.String(), events[0].ActorID)
+	})
+
+	s.Run("bucket failure stops reset without audit", func() {
+		s.mockBuckets.EXPECT().Reset(ctx, "ip:10.0.0.1:auth").Return(errors.New("store unavailable"))
+
+		err := s.service.ResetRateLimit(ctx, &models.ResetRateLimitRequest{
+			Type:       models.AllowlistTypeIP,
+			Identifier: "10.0.0.1",
+		}, adminUserID)
+		s.Error(err)
+		s.Contains(err.Error(), "ip:10.0.0.1:auth")
+	})
+}
+
+// =============================================================================
+// Allowlist Removal Tests
+// =============================================================================
+// Justification: Stores report absence as an infrastructure sentinel; the
+// service must translate it so the admin API answers 404 instead of 500.
+
+func (s *AdminServiceSuite) TestRemoveFromAllowlist() {
+	ctx := context.Background()
+	req := &models.RemoveAllowlistRequest{Type: models.AllowlistTypeIP, Identifier: "192.168.1.100"}
+
+	s.Run("missing entry returns not found", func() {
+		s.mockAllowlist.EXPECT().
+			Remove(ctx, models.AllowlistTypeIP, "192.168.1.100").
+			Return(sentinel.ErrNotFound)
+
+		err := s.service.RemoveFromAllowlist(ctx, req)
+		s.True(dErrors.HasCode(err, dErrors.CodeNotFound), "got %v", err)
+	})
+
+	s.Run("store failure is not reported as not found", func() {
+		s.mockAllowlist.EXPECT().
+			Remove(ctx, models.AllowlistTypeIP, "192.168.1.100").
+			Return(errors.New("connection refused"))
+
+		err := s.service.RemoveFromAllowlist(ctx, req)
+		s.Error(err)
+		s.False(dErrors.HasCode(err, dErrors.CodeNotFound))
+	})
+}
+
+// =============================================================================
+// Rate Limit State Tests
+// =============================================================================
+// Justification: The snapshot joins key construction across four stores; a
+// wrong prefix or lockout key silently reports an empty state during an
+// incident. Reads must also leave the inspected buckets untouched.
+
+func (s *AdminServiceSuite) TestRateLimitState() {
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+	ctx := requestcontext.WithTime(context.Background(), now)
+	cfg := config.DefaultConfig()
+	ip := "203.0.113.7"
+
+	buckets := bucketStore.New()
+	lockouts := authlockoutStore.New()
+	allowlist := allowlistStore.New()
+	quotas := quotaStore.New(cfg)
+	svc, err := New(allowlist, buckets,
+		WithAuthLockoutStore(lockouts),
+		WithQuotaStore(quotas),
+		WithConfig(cfg),
+	)
+	s.Require().NoError(err)
+
+	authLimit := cfg.IPLimits[models.ClassAuth]
+	for range 3 {
+		_, err := buckets.Allow(ctx, models.NewRateLimitKey(models.KeyPrefixIP, ip, models.ClassAuth).String(), authLimit.RequestsPerWindow, authLimit.Window)
+		s.Require().NoError(err)
+	}
+	readLimit := cfg.IPLimits[models.ClassRead]
+	_, err = buckets.Allow(ctx, models.NewRateLimitKey(models.KeyPrefixIP, ip, models.ClassRead).String(), readLimit.RequestsPerWindow, readLimit.Window)
+	s.Require().NoError(err)
+
+	lockedUntil := now.Add(15 * time.Minute)
+	s.Require().NoError(lockouts.Update(ctx, &models.AuthLockout{
+		Identifier:    models.NewAuthLockoutKey("alice@example.com", ip).String(),
+		FailureCount:  5,
+		DailyFailures: 10,
+		LockedUntil:   &lockedUntil,
+		LastFailureAt: now,
+	}))
+	_, err = quotas.IncrementUsage(ctx, "partner-key", 42)
+	s.Require().NoError(err)
+
+	s.Run("snapshot reports buckets, lockout, and quota", func() {
+		state, err := svc.RateLimitState(ctx, &models.RateLimitStateRequest{
+			Identifier: ip,
+			Login:      "alice@example.com",
+			APIKey:     "partner-key",
+		})
+		s.Require().NoError(err)
+		s.Equal(models.AllowlistTypeIP, state.Type, "IP identifiers are inferred")
+		s.False(state.Allowlisted)
+
+		s.Require().Len(state.Buckets, 4)
+		s.Equal(models.BucketState{
+			Class:         models.ClassAuth,
+			Key:           "ip:203.0.113.7:auth",
+			Count:         3,
+			Limit:         authLimit.RequestsPerWindow,
+			Remaining:     authLimit.RequestsPerWindow - 3,
+			WindowSeconds: 60,
+		}, state.Buckets[0])
+		s.Equal(0, state.Buckets[1].Count, "sensitive bucket untouched")
+		s.Equal(1, state.Buckets[2].Count)
+
+		s.Require().NotNil(state.Lockout)
+		s.Equal(10, state.Lockout.DailyFailures)
+		s.Equal(&lockedUntil, state.Lockout.LockedUntil)
+		s.Require().NotNil(state.Quota)
+		s.Equal(42, state.Quota.CurrentUsage)
+	})
+
+	s.Run("reading the state consumes no tokens", func() {
+		req := &models.RateLimitStateRequest{Identifier: ip}
+		for range 2 {
+			state, err := svc.RateLimitState(ctx, req)
+			s.Require().NoError(err)
+			s.Equal(3, state.Buckets[0].Count)
+		}
+	})
+
+	s.Run("user identifiers read user buckets and allowlist status", func() {
+		userID := uuid.NewString()
+		entry, err := models.NewAllowlistEntry(uuid.NewString(), models.AllowlistTypeUserID, userID, "load test", id.UserID(uuid.New()), nil, now)
+		s.Require().NoError(err)
+		s.Require().NoError(allowlist.Add(ctx, entry))
+
+		state, err := svc.RateLimitState(ctx, &models.RateLimitStateRequest{Identifier: userID})
+		s.Require().NoError(err)
+		s.Equal(models.AllowlistTypeUserID, state.Type)
+		s.True(state.Allowlisted)
+		s.Equal("user:"+userID+":auth", state.Buckets[0].Key)
+		s.Equal(cfg.UserLimits[models.ClassAuth].RequestsPerWindow, state.Buckets[0].Limit)
+		s.Nil(state.Lockout)
+		s.Nil(state.Quota)
+	})
+
+	s.Run("login requires an IP identifier", func() {
+		_, err := svc.RateLimitState(ctx, &models.RateLimitStateRequest{Identifier: uuid.NewString(), Login: "alice@example.com"})
+		s.Require().Error(err)
+		s.Contains(err.Error(), "login requires an ip identifier")
+	})
+}
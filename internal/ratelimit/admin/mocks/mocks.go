@@ -12,6 +12,7 @@ package mocks
 import (
 	context "context"
 	models "credo/internal/ratelimit/models"
+	domain "credo/pkg/domain"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
@@ -55,6 +56,21 @@ func (mr *MockAllowlistStoreMockRecorder) Add(ctx, entry any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockAllowlistStore)(nil).Add), ctx, entry)
 }
 
+// IsAllowlisted mocks base method.
+func (m *MockAllowlistStore) IsAllowlisted(ctx context.Context, identifier string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsAllowlisted", ctx, identifier)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsAllowlisted indicates an expected call of IsAllowlisted.
+func (mr *MockAllowlistStoreMockRecorder) IsAllowlisted(ctx, identifier any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsAllowlisted", reflect.TypeOf((*MockAllowlistStore)(nil).IsAllowlisted), ctx, identifier)
+}
+
 // List mocks base method.
 func (m *MockAllowlistStore) List(ctx context.Context) ([]*models.AllowlistEntry, error) {
 	m.ctrl.T.Helper()
@@ -136,3 +152,81 @@ func (mr *MockBucketStoreMockRecorder) Reset(ctx, key any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reset", reflect.TypeOf((*MockBucketStore)(nil).Reset), ctx, key)
 }
+
+// MockAuthLockoutStore is a mock of AuthLockoutStore interface.
+type MockAuthLockoutStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuthLockoutStoreMockRecorder
+	isgomock struct{}
+}
+
+// MockAuthLockoutStoreMockRecorder is the mock recorder for MockAuthLockoutStore.
+type MockAuthLockoutStoreMockRecorder struct {
+	mock *MockAuthLockoutStore
+}
+
+// NewMockAuthLockoutStore creates a new mock instance.
+func NewMockAuthLockoutStore(ctrl *gomock.Controller) *MockAuthLockoutStore {
+	mock := &MockAuthLockoutStore{ctrl: ctrl}
+	mock.recorder = &MockAuthLockoutStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuthLockoutStore) EXPECT() *MockAuthLockoutStoreMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockAuthLockoutStore) Get(ctx context.Context, identifier string) (*models.AuthLockout, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, identifier)
+	ret0, _ := ret[0].(*models.AuthLockout)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockAuthLockoutStoreMockRecorder) Get(ctx, identifier any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockAuthLockoutStore)(nil).Get), ctx, identifier)
+}
+
+// MockQuotaStore is a mock of QuotaStore interface.
+type MockQuotaStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockQuotaStoreMockRecorder
+	isgomock struct{}
+}
+
+// MockQuotaStoreMockRecorder is the mock recorder for MockQuotaStore.
+type MockQuotaStoreMockRecorder struct {
+	mock *MockQuotaStore
+}
+
+// NewMockQuotaStore creates a new mock instance.
+func NewMockQuotaStore(ctrl *gomock.Controller) *MockQuotaStore {
+	mock := &MockQuotaStore{ctrl: ctrl}
+	mock.recorder = &MockQuotaStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockQuotaStore) EXPECT() *MockQuotaStoreMockRecorder {
+	return m.recorder
+}
+
+// GetQuota mocks base method.
+func (m *MockQuotaStore) GetQuota(ctx context.Context, apiKeyID domain.APIKeyID) (*models.APIKeyQuota, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetQuota", ctx, apiKeyID)
+	ret0, _ := ret[0].(*models.APIKeyQuota)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetQuota indicates an expected call of GetQuota.
+func (mr *MockQuotaStoreMockRecorder) GetQuota(ctx, apiKeyID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQuota", reflect.TypeOf((*MockQuotaStore)(nil).GetQuota), ctx, apiKeyID)
+}
@@ -14,7 +14,18 @@ type Config struct {
 	ClientLimits ClientLimitConfig // Per-client rate limits (PRD-017 FR-2c)
 	Global       GlobalLimit
 	AuthLockout  AuthLockoutConfig
+	AuthAnomaly  AuthAnomalyConfig
 	QuotaTiers   map[models.QuotaTier]QuotaLimit
+
+	// EndpointOverrides replaces the class limit for requests to an exact path,
+	// keyed without the API version segment (e.g. "/me/data-export"). See
+	// GetEndpointLimit for precedence.
+	EndpointOverrides map[string]Limit
+
+	// DegradedFailClosed controls per-class behavior when the rate-limit store is
+	// unavailable and no fallback result exists (PRD-017 FR-7). Classes mapped to
+	// true reject requests with 503; all others fail open.
+	DegradedFailClosed map[models.EndpointClass]bool
 }
 
 // ClientLimitConfig defines per-client rate limits based on client type (PRD-017 FR-2c).
@@ -29,9 +40,11 @@ type Limit struct {
 }
 
 type GlobalLimit struct {
-	PerInstancePerSecond int // 1000 req/sec per instance
-	GlobalPerSecond      int // 10000 req/sec across all instances
-	PerInstancePerHour   int // 100000 req/hour per instance (PRD-017 FR-6)
+	PerInstancePerSecond int           // 1000 req/window per instance
+	GlobalPerSecond      int           // 10000 req/window across all instances
+	PerInstancePerHour   int           // 100000 req/hour per instance (PRD-017 FR-6)
+	Window               time.Duration // 1s tumbling window for the per-second limits
+	Shards               int           // 1 counter row per window; raise to spread writes across sub-counters
 }
 
 type AuthLockoutConfig struct {
@@ -61,6 +74,18 @@ func (c *AuthLockoutConfig) CalculateBackoff(failureCount int) time.Duration {
 	return c.BackoffPolicy().CalculateBackoff(failureCount)
 }
 
+// AuthAnomalyConfig tunes population-level brute-force detection. Per-account
+// lockouts miss distributed attacks that spread a few attempts across many
+// accounts, so the detector watches the total auth-failure rate instead.
+type AuthAnomalyConfig struct {
+	BucketDuration            time.Duration // 1 minute; failure rate is measured per bucket
+	BaselineAlpha             float64       // 0.1; EWMA weight given to each completed bucket
+	SpikeMultiplier           float64       // 5x baseline trips elevated defenses
+	MinFailures               int           // 50 failures/bucket floor so quiet periods don't trip on noise
+	ElevatedDuration          time.Duration // 15 minutes of elevated defenses after the last spike
+	ElevatedAttemptsPerWindow int           // 2 attempts per window while elevated
+}
+
 type QuotaLimit struct {
 	MonthlyRequests int
 	OverageAllowed  bool
@@ -92,6 +117,8 @@ func DefaultConfig() *Config {
 			PerInstancePerSecond: 1000,
 			GlobalPerSecond:      10000,
 			PerInstancePerHour:   100000, // PRD-017 FR-6
+			Window:               time.Second,
+			Shards:               1,
 		},
 		AuthLockout: AuthLockoutConfig{
 			AttemptsPerWindow:      5,
@@ -102,12 +129,40 @@ func DefaultConfig() *Config {
 			ProgressiveBackoffBase: 250 * time.Millisecond,
 			SupportURL:             "/support", // Override with actual support URL in production
 		},
+		AuthAnomaly: AuthAnomalyConfig{
+			BucketDuration:            time.Minute,
+			BaselineAlpha:             0.1,
+			SpikeMultiplier:           5,
+			MinFailures:               50,
+			ElevatedDuration:          15 * time.Minute,
+			ElevatedAttemptsPerWindow: 2,
+		},
 		QuotaTiers: map[models.QuotaTier]QuotaLimit{
 			models.QuotaTierFree:       {MonthlyRequests: 1000, OverageAllowed: false},
 			models.QuotaTierStarter:    {MonthlyRequests: 10000, OverageAllowed: true, OverageRate: 0.01},
 			models.QuotaTierBusiness:   {MonthlyRequests: 100000, OverageAllowed: true, OverageRate: 0.005},
 			models.QuotaTierEnterprise: {MonthlyRequests: -1, OverageAllowed: true}, // unlimited
 		},
+		// Exports assemble every record held on a user, so they get a tighter
+		// limit than the rest of the read class.
+		EndpointOverrides: map[string]Limit{
+			"/me/data-export": {RequestsPerWindow: 10, Window: time.Hour},
+		},
+		DegradedFailClosed: DefaultDegradedFailClosed(),
+	}
+}
+
+// DefaultDegradedFailClosed returns the default degraded-mode policy.
+// Auth, sensitive, and admin classes fail closed because letting them through
+// unchecked during a store outage would open a brute-force window; read and
+// write classes fail open to preserve availability.
+func DefaultDegradedFailClosed() map[models.EndpointClass]bool {
+	return map[models.EndpointClass]bool{
+		models.ClassAuth:      true,
+		models.ClassSensitive: true,
+		models.ClassAdmin:     true,
+		models.ClassRead:      false,
+		models.ClassWrite:     false,
 	}
 }
 
@@ -130,3 +185,34 @@ func (c *Config) GetUserLimit(class models.EndpointClass) (requestsPerWindow int
 	// Default-deny: return false if class not found (PRD-017 FR-1)
 	return 0, 0, false
 }
+
+// GetEndpointLimit returns the limit for a request to path in class, for the
+// IP or user check selected by prefix. Precedence:
+//  1. EndpointOverrides for the exact path (version segment stripped) applies
+//     to both the IP and the user check, counted in a bucket of its own
+//  2. otherwise the class default from IPLimits or UserLimits
+//
+// Returns ok=false if neither is configured (caller should deny the request per PRD-017 FR-1).
+func (c *Config) GetEndpointLimit(path string, class models.EndpointClass, prefix models.KeyPrefix) (requestsPerWindow int, window time.Duration, ok bool) {
+	if limit, found := c.endpointOverride(path); found {
+		return limit.RequestsPerWindow, limit.Window, true
+	}
+	if prefix == models.KeyPrefixUser {
+		return c.GetUserLimit(class)
+	}
+	return c.GetIPLimit(class)
+}
+
+// HasEndpointOverride reports whether path has its own limit.
+func (c *Config) HasEndpointOverride(path string) bool {
+	_, found := c.endpointOverride(path)
+	return found
+}
+
+func (c *Config) endpointOverride(path string) (Limit, bool) {
+	if path == "" {
+		return Limit{}, false
+	}
+	limit, found := c.EndpointOverrides[models.EndpointPath(path)]
+	return limit, found
+}
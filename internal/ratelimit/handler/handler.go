@@ -17,7 +17,8 @@ type Service interface {
 	AddToAllowlist(ctx context.Context, req *models.AddAllowlistRequest, adminUserID id.UserID) (*models.AllowlistEntry, error)
 	RemoveFromAllowlist(ctx context.Context, req *models.RemoveAllowlistRequest) error
 	ListAllowlist(ctx context.Context) ([]*models.AllowlistEntry, error)
-	ResetRateLimit(ctx context.Context, req *models.ResetRateLimitRequest) error
+	ResetRateLimit(ctx context.Context, req *models.ResetRateLimitRequest, adminUserID id.UserID) error
+	RateLimitState(ctx context.Context, req *models.RateLimitStateRequest) (*models.RateLimitState, error)
 }
 
 type Handler struct {
@@ -37,6 +38,7 @@ func (h *Handler) RegisterAdmin(r chi.Router) {
 	r.Delete("/admin/rate-limit/allowlist", h.HandleRemoveAllowlist)
 	r.Get("/admin/rate-limit/allowlist", h.HandleListAllowlist)
 	r.Post("/admin/rate-limit/reset", h.HandleResetRateLimit)
+	r.Get("/admin/rate-limit/state", h.HandleGetRateLimitState)
 }
 
 // HandleAddAllowlist implements POST /admin/rate-limit/allowlist.
@@ -76,9 +78,7 @@ func (h *Handler) HandleAddAllowlist(w http.ResponseWriter, r *http.Request) {
 // HandleRemoveAllowlist implements DELETE /admin/rate-limit/allowlist.
 //
 // Input: { "type": "ip", "identifier": "192.168.1.100" }
-// Output: 204 No Content
-//
-// TODO: Implement this handler
+// Output: 204 No Content, or 404 when no entry matches
 func (h *Handler) HandleRemoveAllowlist(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	requestID := requestcontext.RequestID(ctx)
@@ -143,7 +143,8 @@ func (h *Handler) HandleResetRateLimit(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err := h.service.ResetRateLimit(ctx, req)
+	adminUserID := requestcontext.UserID(ctx)
+	err := h.service.ResetRateLimit(ctx, req, adminUserID)
 	if err != nil {
 		h.logger.ErrorContext(ctx, "failed to reset rate limit",
 			"error", err,
@@ -155,3 +156,35 @@ func (h *Handler) HandleResetRateLimit(w http.ResponseWriter, r *http.Request) {
 	}
 	w.WriteHeader(http.StatusNoContent)
 }
+
+// HandleGetRateLimitState implements GET /admin/rate-limit/state.
+// Returns a debugging snapshot of an identifier's rate limit state, unredacted
+// since the route is admin-only.
+//
+// Query: identifier (required), type (ip or user_id, inferred when omitted),
+// login (lockout for that login from the IP), api_key (quota for that key)
+// Output: { "type": "ip", "identifier": "...", "allowlisted": false, "buckets": [...], "lockout": {...}, "quota": {...} }
+func (h *Handler) HandleGetRateLimitState(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := requestcontext.RequestID(ctx)
+
+	query := r.URL.Query()
+	req := &models.RateLimitStateRequest{
+		Type:       models.AllowlistEntryType(query.Get("type")),
+		Identifier: query.Get("identifier"),
+		Login:      query.Get("login"),
+		APIKey:     query.Get("api_key"),
+	}
+
+	state, err := h.service.RateLimitState(ctx, req)
+	if err != nil {
+		h.logger.ErrorContext(ctx, "failed to read rate limit state",
+			"error", err,
+			"identifier", req.Identifier,
+			"request_id", requestID,
+		)
+		httputil.WriteError(w, err)
+		return
+	}
+	httputil.WriteJSON(w, http.StatusOK, state)
+}
@@ -85,6 +85,21 @@ func (s *HandlerSuite) TestRemoveAllowlist_InvalidJSON() {
 		"expected 400 for invalid JSON")
 }
 
+func (s *HandlerSuite) TestRemoveAllowlist_NotFound() {
+	s.mockService.EXPECT().RemoveFromAllowlist(gomock.Any(), gomock.Any()).
+		Return(dErrors.New(dErrors.CodeNotFound, "allowlist entry not found"))
+
+	req := httptest.NewRequest(http.MethodDelete, "/admin/rate-limit/allowlist",
+		bytes.NewReader([]byte(`{"type":"ip","identifier":"192.168.1.100"}`)))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+
+	s.router.ServeHTTP(rec, req)
+
+	s.Equal(http.StatusNotFound, rec.Code,
+		"DELETE /admin/rate-limit/allowlist should return 404 for an unknown entry")
+}
+
 func (s *HandlerSuite) TestResetRateLimit_InvalidJSON() {
 	req := httptest.NewRequest(http.MethodPost, "/admin/rate-limit/reset",
 		bytes.NewReader([]byte("not valid json")))
@@ -97,6 +112,44 @@ func (s *HandlerSuite) TestResetRateLimit_InvalidJSON() {
 		"expected 400 for invalid JSON")
 }
 
+func (s *HandlerSuite) TestResetRateLimit_Success() {
+	s.mockService.EXPECT().ResetRateLimit(gomock.Any(), &models.ResetRateLimitRequest{
+		Type:       models.AllowlistTypeIP,
+		Identifier: "192.168.1.100",
+		Class:      models.ClassAuth,
+	}, gomock.Any()).Return(nil)
+
+	req := httptest.NewRequest(http.MethodPost, "/admin/rate-limit/reset",
+		bytes.NewReader([]byte(`{"type":"ip","identifier":"192.168.1.100","class":"auth"}`)))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+
+	s.router.ServeHTTP(rec, req)
+
+	s.Equal(http.StatusNoContent, rec.Code)
+}
+
+func (s *HandlerSuite) TestGetRateLimitState_PassesQueryParams() {
+	s.mockService.EXPECT().RateLimitState(gomock.Any(), &models.RateLimitStateRequest{
+		Identifier: "192.168.1.100",
+		Login:      "alice@example.com",
+		APIKey:     "partner-free-123",
+	}).Return(&models.RateLimitState{
+		Type:       models.AllowlistTypeIP,
+		Identifier: "192.168.1.100",
+		Buckets:    []models.BucketState{{Class: models.ClassAuth, Key: "ip:192.168.1.100:auth", Count: 3}},
+	}, nil)
+
+	req := httptest.NewRequest(http.MethodGet,
+		"/admin/rate-limit/state?identifier=192.168.1.100&login=alice@example.com&api_key=partner-free-123", nil)
+	rec := httptest.NewRecorder()
+
+	s.router.ServeHTTP(rec, req)
+
+	s.Equal(http.StatusOK, rec.Code)
+	s.Contains(rec.Body.String(), `"key":"ip:192.168.1.100:auth"`)
+}
+
 // =============================================================================
 // Quota API Endpoint Tests (PRD-017 FR-5)
 // =============================================================================
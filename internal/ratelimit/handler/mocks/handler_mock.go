@@ -12,7 +12,7 @@ package mocks
 import (
 	context "context"
 	models "credo/internal/ratelimit/models"
-	id "credo/pkg/domain"
+	domain "credo/pkg/domain"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
@@ -43,7 +43,7 @@ func (m *MockService) EXPECT() *MockServiceMockRecorder {
 }
 
 // AddToAllowlist mocks base method.
-func (m *MockService) AddToAllowlist(ctx context.Context, req *models.AddAllowlistRequest, adminUserID id.UserID) (*models.AllowlistEntry, error) {
+func (m *MockService) AddToAllowlist(ctx context.Context, req *models.AddAllowlistRequest, adminUserID domain.UserID) (*models.AllowlistEntry, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "AddToAllowlist", ctx, req, adminUserID)
 	ret0, _ := ret[0].(*models.AllowlistEntry)
@@ -86,16 +86,31 @@ func (mr *MockServiceMockRecorder) RemoveFromAllowlist(ctx, req any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveFromAllowlist", reflect.TypeOf((*MockService)(nil).RemoveFromAllowlist), ctx, req)
 }
 
+// RateLimitState mocks base method.
+func (m *MockService) RateLimitState(ctx context.Context, req *models.RateLimitStateRequest) (*models.RateLimitState, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RateLimitState", ctx, req)
+	ret0, _ := ret[0].(*models.RateLimitState)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RateLimitState indicates an expected call of RateLimitState.
+func (mr *MockServiceMockRecorder) RateLimitState(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RateLimitState", reflect.TypeOf((*MockService)(nil).RateLimitState), ctx, req)
+}
+
 // ResetRateLimit mocks base method.
-func (m *MockService) ResetRateLimit(ctx context.Context, req *models.ResetRateLimitRequest) error {
+func (m *MockService) ResetRateLimit(ctx context.Context, req *models.ResetRateLimitRequest, adminUserID domain.UserID) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ResetRateLimit", ctx, req)
+	ret := m.ctrl.Call(m, "ResetRateLimit", ctx, req, adminUserID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // ResetRateLimit indicates an expected call of ResetRateLimit.
-func (mr *MockServiceMockRecorder) ResetRateLimit(ctx, req any) *gomock.Call {
+func (mr *MockServiceMockRecorder) ResetRateLimit(ctx, req, adminUserID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetRateLimit", reflect.TypeOf((*MockService)(nil).ResetRateLimit), ctx, req)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetRateLimit", reflect.TypeOf((*MockService)(nil).ResetRateLimit), ctx, req, adminUserID)
 }
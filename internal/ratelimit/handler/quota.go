@@ -70,6 +70,7 @@ func toQuotaUsageResponse(quota *models.APIKeyQuota) models.QuotaUsageResponse {
 		APIKeyID:  quota.APIKeyID.String(),
 		Tier:      string(quota.Tier),
 		Usage:     quota.CurrentUsage,
+		Overage:   quota.OverageUsage,
 		Limit:     quota.MonthlyLimit,
 		Remaining: remaining,
 		ResetAt:   quota.PeriodEnd,
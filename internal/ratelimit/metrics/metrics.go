@@ -40,8 +40,21 @@ type Metrics struct {
 	BucketLockWaitSeconds         *prometheus.HistogramVec // Time waiting for bucket advisory locks (by key_prefix)
 	BucketEventsCleanedTotal      prometheus.Counter       // Events cleaned during rate limit checks
 	AuthLockoutConcurrentUpdates  prometheus.Counter       // Detected concurrent update attempts (TOCTOU near-misses)
+
+	// Circuit breaker metrics (PRD-017 FR-7)
+	CircuitState        *prometheus.GaugeVec   // 0 closed, 1 half-open, 2 open (breaker)
+	CircuitOpenTotal    *prometheus.CounterVec // Closed-to-open transitions (breaker)
+	FallbackUsedTotal   *prometheus.CounterVec // Checks answered by the fallback limiter (breaker)
+	FallbackFailedTotal *prometheus.CounterVec // Fallback checks that errored (breaker)
 }
 
+// Values reported by the CircuitState gauge.
+const (
+	CircuitStateClosed   = 0
+	CircuitStateHalfOpen = 1
+	CircuitStateOpen     = 2
+)
+
 // New creates a new Metrics instance with all metrics registered.
 func New() *Metrics {
 	return &Metrics{
@@ -159,6 +172,27 @@ func New() *Metrics {
 			Name: "credo_ratelimit_auth_lockout_concurrent_updates_total",
 			Help: "Detected concurrent update attempts on auth lockout records (TOCTOU near-misses)",
 		}),
+
+		// Circuit breaker metrics
+		CircuitState: promauto.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "credo_ratelimit_circuit_state",
+			Help: "Rate limiter circuit breaker state (0=closed, 1=half-open, 2=open)",
+		}, []string{"breaker"}),
+
+		CircuitOpenTotal: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name: "credo_ratelimit_circuit_open_total",
+			Help: "Total number of times a rate limiter circuit breaker opened",
+		}, []string{"breaker"}),
+
+		FallbackUsedTotal: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name: "credo_ratelimit_fallback_used_total",
+			Help: "Total number of rate limit checks routed to the fallback limiter",
+		}, []string{"breaker"}),
+
+		FallbackFailedTotal: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name: "credo_ratelimit_fallback_failed_total",
+			Help: "Total number of fallback rate limit checks that failed",
+		}, []string{"breaker"}),
 	}
 }
 
@@ -270,3 +304,25 @@ func (m *Metrics) IncrementBucketEventsCleaned(count int) {
 func (m *Metrics) IncrementAuthLockoutConcurrentUpdates() {
 	m.AuthLockoutConcurrentUpdates.Inc()
 }
+
+// Circuit breaker metrics helpers
+
+// SetCircuitState sets the state gauge for the named breaker to one of the CircuitState* values.
+func (m *Metrics) SetCircuitState(breaker string, state int) {
+	m.CircuitState.WithLabelValues(breaker).Set(float64(state))
+}
+
+// IncrementCircuitOpen records the named breaker opening.
+func (m *Metrics) IncrementCircuitOpen(breaker string) {
+	m.CircuitOpenTotal.WithLabelValues(breaker).Inc()
+}
+
+// IncrementFallbackUsed records a check routed to the fallback limiter.
+func (m *Metrics) IncrementFallbackUsed(breaker string) {
+	m.FallbackUsedTotal.WithLabelValues(breaker).Inc()
+}
+
+// IncrementFallbackFailed records a fallback check that failed.
+func (m *Metrics) IncrementFallbackFailed(breaker string) {
+	m.FallbackFailedTotal.WithLabelValues(breaker).Inc()
+}
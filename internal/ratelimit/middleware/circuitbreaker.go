@@ -1,6 +1,11 @@
 package middleware
 
-import "sync"
+import (
+	"sync"
+	"time"
+
+	"credo/pkg/platform/circuit"
+)
 
 // CircuitBreaker tracks consecutive limiter errors for fail-safe rate limiting (PRD-017 FR-7):
 // - Track consecutive limiter errors.
@@ -15,6 +20,8 @@ type CircuitBreaker struct {
 	successCount     int
 	failureThreshold int
 	successThreshold int
+	lastTransition   time.Time
+	manual           bool // state was set by an operator and not yet changed by auto-logic
 }
 
 type circuitState int
@@ -58,6 +65,8 @@ func (c *CircuitBreaker) RecordFailure() (useFallback bool, change StateChange)
 	}
 	if c.failureCount >= c.failureThreshold {
 		c.state = circuitOpen
+		c.manual = false
+		c.lastTransition = time.Now()
 		return true, StateChange{Opened: true}
 	}
 	return false, StateChange{}
@@ -75,6 +84,8 @@ func (c *CircuitBreaker) RecordSuccess() (usePrimary bool, change StateChange) {
 			c.state = circuitClosed
 			c.failureCount = 0
 			c.successCount = 0
+			c.manual = false
+			c.lastTransition = time.Now()
 			return true, StateChange{Closed: true}
 		}
 		return false, StateChange{}
@@ -95,3 +106,61 @@ func (c *CircuitBreaker) ShouldUsePrimary() bool {
 func (c *CircuitBreaker) Name() string {
 	return c.name
 }
+
+// ForceOpen trips the circuit on operator request so checks use the fallback
+// limiter. Normal recovery applies: the circuit closes after successThreshold
+// successful primary checks.
+func (c *CircuitBreaker) ForceOpen() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.state != circuitOpen {
+		c.lastTransition = time.Now()
+	}
+	c.state = circuitOpen
+	c.successCount = 0
+	c.manual = true
+}
+
+// ForceClosed closes the circuit on operator request with zero counts. It opens
+// again after failureThreshold consecutive primary errors.
+func (c *CircuitBreaker) ForceClosed() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.state != circuitClosed {
+		c.lastTransition = time.Now()
+	}
+	c.state = circuitClosed
+	c.failureCount = 0
+	c.successCount = 0
+	c.manual = true
+}
+
+// ClearOverride discards a manual state and returns the circuit to automatic
+// control from the closed state. It is a no-op when no override is active.
+func (c *CircuitBreaker) ClearOverride() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if !c.manual {
+		return
+	}
+	if c.state != circuitClosed {
+		c.lastTransition = time.Now()
+	}
+	c.state = circuitClosed
+	c.failureCount = 0
+	c.successCount = 0
+	c.manual = false
+}
+
+// Snapshot returns a read-only view of the breaker for operator inspection.
+func (c *CircuitBreaker) Snapshot() circuit.Snapshot {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return circuit.Snapshot{
+		Name:           "ratelimit_" + c.name,
+		State:          circuit.StatusFor(c.state == circuitOpen, c.successCount),
+		FailureCount:   c.failureCount,
+		LastTransition: c.lastTransition,
+		Manual:         c.manual,
+	}
+}
@@ -40,12 +40,12 @@ func NewFallbackLimiter(cfg *config.Config, allowlistStore requestlimit.Allowlis
 	return &fallbackLimiter{requests: requests}
 }
 
-func (f *fallbackLimiter) CheckIPRateLimit(ctx context.Context, ip string, class models.EndpointClass) (*models.RateLimitResult, error) {
-	return f.requests.CheckIP(ctx, ip, class)
+func (f *fallbackLimiter) CheckIPRateLimit(ctx context.Context, ip string, class models.EndpointClass, path string) (*models.RateLimitResult, error) {
+	return f.requests.CheckIP(ctx, ip, class, path)
 }
 
-func (f *fallbackLimiter) CheckBothLimits(ctx context.Context, ip, userID string, class models.EndpointClass) (*models.RateLimitResult, error) {
-	return f.requests.CheckBoth(ctx, ip, userID, class)
+func (f *fallbackLimiter) CheckBothLimits(ctx context.Context, ip, userID string, class models.EndpointClass, path string) (*models.RateLimitResult, error) {
+	return f.requests.CheckBoth(ctx, ip, userID, class, path)
 }
 
 func (f *fallbackLimiter) CheckGlobalThrottle(ctx context.Context) (bool, error) {
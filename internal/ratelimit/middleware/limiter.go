@@ -24,14 +24,18 @@ func NewLimiter(requests *requestlimit.Service, globalThrottle *globalthrottle.S
 	}
 }
 
-func (l *Limiter) CheckIPRateLimit(ctx context.Context, ip string, class models.EndpointClass) (*models.RateLimitResult, error) {
-	return l.requests.CheckIP(ctx, ip, class)
+func (l *Limiter) CheckIPRateLimit(ctx context.Context, ip string, class models.EndpointClass, path string) (*models.RateLimitResult, error) {
+	return l.requests.CheckIP(ctx, ip, class, path)
 }
 
-func (l *Limiter) CheckBothLimits(ctx context.Context, ip, userID string, class models.EndpointClass) (*models.RateLimitResult, error) {
-	return l.requests.CheckBoth(ctx, ip, userID, class)
+func (l *Limiter) CheckBothLimits(ctx context.Context, ip, userID string, class models.EndpointClass, path string) (*models.RateLimitResult, error) {
+	return l.requests.CheckBoth(ctx, ip, userID, class, path)
 }
 
+// CheckGlobalThrottle allows all traffic when no global throttle service is wired.
 func (l *Limiter) CheckGlobalThrottle(ctx context.Context) (bool, error) {
+	if l.globalThrottle == nil {
+		return true, nil
+	}
 	return l.globalThrottle.Check(ctx)
 }
@@ -0,0 +1,116 @@
+package middleware
+
+import (
+	"context"
+	"crypto/subtle"
+	"net/http"
+	"strings"
+
+	"credo/internal/ratelimit/observability"
+	"credo/pkg/platform/privacy"
+	"credo/pkg/requestcontext"
+)
+
+// DefaultProbeHeader is the request header monitoring probes send their secret in.
+const DefaultProbeHeader = "X-Monitoring-Probe"
+
+// DefaultProbePaths are the health and monitoring routes probes may be exempted on.
+var DefaultProbePaths = []string{"/health", "/metrics"}
+
+// ProbeExemption lets health and monitoring probes bypass rate limits without
+// allowlisting the IP ranges they run from.
+type ProbeExemption struct {
+	// Secret is the shared secret probes present in Header. Empty disables the exemption.
+	Secret string
+
+	// Header carries the secret. Defaults to DefaultProbeHeader.
+	Header string
+
+	// UserAgents optionally narrows the exemption to probes whose User-Agent
+	// starts with one of these prefixes. A matching User-Agent alone never
+	// exempts a request: clients choose it freely.
+	UserAgents []string
+
+	// Paths are the routes the exemption applies to; each also covers its
+	// subpaths. Defaults to DefaultProbePaths. Other routes stay limited even
+	// for requests carrying the secret.
+	Paths []string
+}
+
+// WithProbeExemption exempts requests carrying the probe secret from rate limits
+// and the global throttle. Each exempted request is audited.
+func WithProbeExemption(p ProbeExemption) Option {
+	return func(m *Middleware) {
+		if p.Secret == "" {
+			return
+		}
+		if p.Header == "" {
+			p.Header = DefaultProbeHeader
+		}
+		if len(p.Paths) == 0 {
+			p.Paths = DefaultProbePaths
+		}
+		m.probe = &p
+	}
+}
+
+// WithAuditPublisher sets the publisher for security audit events raised by the middleware.
+func WithAuditPublisher(publisher observability.AuditPublisher) Option {
+	return func(m *Middleware) {
+		m.auditPublisher = publisher
+	}
+}
+
+type probeExemptKey struct{}
+
+// exemptProbe reports whether r is an authenticated monitoring probe on a
+// monitoring route. The first middleware to exempt a request audits the bypass
+// and marks the returned request, so stacked limiters record one event per probe.
+func (m *Middleware) exemptProbe(r *http.Request) (*http.Request, bool) {
+	ctx := r.Context()
+	if ctx.Value(probeExemptKey{}) != nil {
+		return r, true
+	}
+	if m.probe == nil || !m.probe.matchesPath(r.URL.Path) {
+		return r, false
+	}
+	presented := r.Header.Get(m.probe.Header)
+	if presented == "" || subtle.ConstantTimeCompare([]byte(presented), []byte(m.probe.Secret)) != 1 {
+		return r, false
+	}
+	userAgent := r.UserAgent()
+	if !m.probe.matchesUserAgent(userAgent) {
+		return r, false
+	}
+
+	ip := requestcontext.ClientIP(ctx)
+	observability.LogAudit(ctx, m.logger, m.auditPublisher, "allowlist_bypass",
+		"identifier", privacy.AnonymizeIP(ip),
+		"bypass_type", "monitoring_probe",
+		"user_agent", userAgent,
+		"path", r.URL.Path,
+	)
+	return r.WithContext(context.WithValue(ctx, probeExemptKey{}, true)), true
+}
+
+func (p *ProbeExemption) matchesPath(path string) bool {
+	for _, route := range p.Paths {
+		route = strings.TrimSuffix(route, "/")
+		if path == route || strings.HasPrefix(path, route+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *ProbeExemption) matchesUserAgent(userAgent string) bool {
+	if len(p.UserAgents) == 0 {
+		return true
+	}
+	for _, prefix := range p.UserAgents {
+		if strings.HasPrefix(userAgent, prefix) {
+			return true
+		}
+	}
+	return false
+}
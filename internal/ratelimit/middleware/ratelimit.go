@@ -6,20 +6,30 @@
 // Middleware types:
 //   - RateLimit: Per-IP limiting for unauthenticated endpoints
 //   - RateLimitAuthenticated: Combined IP+user limiting for protected endpoints
+//   - RateLimitAuthenticatedResolved: As above, with the class resolved per request
 //   - GlobalThrottle: DDoS protection across all endpoints
 //   - RateLimitClient: Per-OAuth-client limiting
 //
 // Resilience features:
 //   - Circuit breaker with optional fallback limiter
-//   - Fail-open by default (requests proceed on store errors)
-//   - Configurable fail-closed mode for high-security deployments
+//   - Per-class degraded-mode policy: auth/sensitive/admin fail closed (503),
+//     read/write fail open when the store is unavailable
+//   - Configurable global fail-closed mode for high-security deployments
 //   - X-RateLimit-Status: degraded header when using fallback
 //
+// Both rate limiters pass the request path to the limiter, so a path listed in
+// config.EndpointOverrides is limited by its own limit rather than its class's.
+//
+// Monitoring probes presenting the shared secret from WithProbeExemption bypass
+// every limit; each bypass is audited.
+//
 // Standard response headers:
 //   - X-RateLimit-Limit: Maximum requests allowed
 //   - X-RateLimit-Remaining: Requests left in window
 //   - X-RateLimit-Reset: Unix timestamp when window resets
 //   - Retry-After: Seconds to wait (on 429 responses)
+//   - RateLimit-Limit, RateLimit-Remaining, RateLimit-Reset (delta-seconds):
+//     IETF draft equivalents, emitted only with WithStandardHeaders
 package middleware
 
 import (
@@ -28,8 +38,13 @@ import (
 	"log/slog"
 	"net/http"
 	"strconv"
+	"time"
 
+	"credo/internal/ratelimit/config"
+	"credo/internal/ratelimit/metrics"
 	"credo/internal/ratelimit/models"
+	"credo/internal/ratelimit/observability"
+	"credo/pkg/platform/circuit"
 	"credo/pkg/platform/httputil"
 	"credo/pkg/platform/privacy"
 	"credo/pkg/requestcontext"
@@ -38,8 +53,8 @@ import (
 // RateLimiter is the interface consumed by the middleware.
 // Implemented by the aggregated rate limit service that combines requestlimit and globalthrottle.
 type RateLimiter interface {
-	CheckIPRateLimit(ctx context.Context, ip string, class models.EndpointClass) (*models.RateLimitResult, error)
-	CheckBothLimits(ctx context.Context, ip, userID string, class models.EndpointClass) (*models.RateLimitResult, error)
+	CheckIPRateLimit(ctx context.Context, ip string, class models.EndpointClass, path string) (*models.RateLimitResult, error)
+	CheckBothLimits(ctx context.Context, ip, userID string, class models.EndpointClass, path string) (*models.RateLimitResult, error)
 	CheckGlobalThrottle(ctx context.Context) (bool, error)
 }
 
@@ -54,11 +69,17 @@ type Middleware struct {
 	limiter         RateLimiter
 	logger          *slog.Logger
 	disabled        bool
-	failClosed      bool   // If true, reject requests when rate limiter is unavailable
+	failClosed      bool   // If true, reject requests for every class when rate limiter is unavailable
 	supportURL      string // URL for user support (included in auth lockout response)
+	degradedPolicy  map[models.EndpointClass]bool
 	ipBreaker       *CircuitBreaker
 	combinedBreaker *CircuitBreaker
 	fallback        RateLimiter
+	standardHeaders bool // Also emit IETF draft RateLimit-* headers
+	debugHeaders    bool // Also emit X-RateLimit-Decision
+	metrics         *metrics.Metrics
+	probe           *ProbeExemption // Requests carrying the probe secret skip every limit
+	auditPublisher  observability.AuditPublisher
 }
 
 // Option configures a Middleware instance.
@@ -89,14 +110,53 @@ func WithFallbackLimiter(limiter RateLimiter) Option {
 }
 
 // WithFailClosed enables fail-closed behavior for high-security deployments.
-// When enabled, requests are rejected (503) if the rate limiter is unavailable
-// and no fallback succeeds. Default is fail-open (requests proceed on error).
+// When enabled, requests for every endpoint class are rejected (503) if the rate
+// limiter is unavailable and no fallback succeeds, overriding the per-class
+// degraded policy.
 func WithFailClosed(enabled bool) Option {
 	return func(m *Middleware) {
 		m.failClosed = enabled
 	}
 }
 
+// WithStandardHeaders emits the IETF draft RateLimit-Limit, RateLimit-Remaining,
+// and RateLimit-Reset headers alongside the legacy X-RateLimit-* headers.
+// RateLimit-Reset is delta-seconds until the window resets, not an epoch.
+func WithStandardHeaders(enabled bool) Option {
+	return func(m *Middleware) {
+		m.standardHeaders = enabled
+	}
+}
+
+// WithDebugHeaders emits X-RateLimit-Decision, naming why the limiter allowed
+// or denied the request (see models.Decision). The header reveals allowlist
+// membership, so enable it only where clients are trusted, e.g. development.
+func WithDebugHeaders(enabled bool) Option {
+	return func(m *Middleware) {
+		m.debugHeaders = enabled
+	}
+}
+
+// WithMetrics records circuit breaker state and fallback usage. Metrics are
+// skipped when unset.
+func WithMetrics(m *metrics.Metrics) Option {
+	return func(mw *Middleware) {
+		mw.metrics = m
+	}
+}
+
+// WithDegradedPolicy overrides the per-class degraded-mode behavior.
+// Classes mapped to true fail closed (503) when the rate limiter is unavailable
+// and no fallback succeeds; classes mapped to false fail open. Classes not present
+// keep their default from config.DefaultDegradedFailClosed.
+func WithDegradedPolicy(failClosed map[models.EndpointClass]bool) Option {
+	return func(m *Middleware) {
+		for class, closed := range failClosed {
+			m.degradedPolicy[class] = closed
+		}
+	}
+}
+
 // New creates a rate limiting middleware with circuit breaker resilience.
 func New(limiter RateLimiter, logger *slog.Logger, opts ...Option) *Middleware {
 	m := &Middleware{
@@ -104,6 +164,7 @@ func New(limiter RateLimiter, logger *slog.Logger, opts ...Option) *Middleware {
 		logger:          logger,
 		ipBreaker:       newCircuitBreaker("ip"),
 		combinedBreaker: newCircuitBreaker("combined"),
+		degradedPolicy:  config.DefaultDegradedFailClosed(),
 	}
 	for _, opt := range opts {
 		opt(m)
@@ -123,21 +184,27 @@ func (m *Middleware) RateLimit(class models.EndpointClass) func(http.Handler) ht
 				next.ServeHTTP(w, r)
 				return
 			}
+			if probe, ok := m.exemptProbe(r); ok {
+				next.ServeHTTP(w, probe)
+				return
+			}
 
 			ctx := r.Context()
 			ip := requestcontext.ClientIP(ctx)
 
-			result, degraded, err := m.checkIPRateLimit(ctx, ip, class)
+			// The path lets per-endpoint overrides replace the class limit.
+			result, degraded, err := m.checkIPRateLimit(ctx, ip, class, r.URL.Path)
 			if err != nil && !degraded {
-				// DESIGN DECISION: Fail-open on rate limit check errors.
-				// This prioritizes availability over security - requests proceed when the
-				// rate limit store is unavailable (e.g., Redis outage). The error is logged
-				// for monitoring/alerting. This is a deliberate tradeoff: during store outages,
-				// rate limiting is temporarily bypassed to avoid cascading failures.
-				//
-				// For high-security deployments requiring fail-closed behavior, see future
-				// PRD for configurable FailClosed option.
-				m.logger.Error("failed to check IP rate limit", "error", err, "ip_prefix", privacy.AnonymizeIP(ip))
+				// DESIGN DECISION: Degraded-mode behavior depends on the endpoint class.
+				// Read/write classes fail open - requests proceed when the rate limit store
+				// is unavailable (e.g., Redis outage) to avoid cascading failures. Auth and
+				// sensitive classes fail closed, since bypassing limits there opens a
+				// brute-force window. The error is logged for monitoring/alerting either way.
+				m.logger.Error("failed to check IP rate limit", "error", err, "ip_prefix", privacy.AnonymizeIP(ip), "class", class)
+				if m.failsClosed(class) {
+					writeRateLimitUnavailable(w)
+					return
+				}
 				next.ServeHTTP(w, r)
 				return
 			}
@@ -149,7 +216,8 @@ func (m *Middleware) RateLimit(class models.EndpointClass) func(http.Handler) ht
 			if degraded {
 				w.Header().Set("X-RateLimit-Status", "degraded")
 			}
-			addRateLimitHeaders(w, result)
+			addRateLimitHeaders(w, result, m.standardHeaders)
+			m.addDebugHeaders(w, result)
 
 			if !result.Allowed {
 				writeRateLimitExceeded(w, result)
@@ -165,21 +233,43 @@ func (m *Middleware) RateLimit(class models.EndpointClass) func(http.Handler) ht
 // Use for authenticated endpoints. Applies the more restrictive of IP or user limits.
 // Returns 429 with user-specific quota information when exceeded.
 func (m *Middleware) RateLimitAuthenticated(class models.EndpointClass) func(http.Handler) http.Handler {
+	return m.rateLimitAuthenticated(func(*http.Request) models.EndpointClass { return class })
+}
+
+// RateLimitAuthenticatedResolved is RateLimitAuthenticated with the endpoint class
+// chosen per request by resolver from the HTTP method and path, so a route group can
+// mix read, write and sensitive endpoints without wiring a class per route.
+func (m *Middleware) RateLimitAuthenticatedResolved(resolver *models.ClassResolver) func(http.Handler) http.Handler {
+	return m.rateLimitAuthenticated(func(r *http.Request) models.EndpointClass {
+		return resolver.Resolve(r.Method, r.URL.Path)
+	})
+}
+
+func (m *Middleware) rateLimitAuthenticated(classify func(*http.Request) models.EndpointClass) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			if m.disabled {
 				next.ServeHTTP(w, r)
 				return
 			}
+			if probe, ok := m.exemptProbe(r); ok {
+				next.ServeHTTP(w, probe)
+				return
+			}
 
 			ctx := r.Context()
+			class := classify(r)
 			ip := requestcontext.ClientIP(ctx)
 			userID := requestcontext.UserID(ctx).String()
 
-			result, degraded, err := m.checkBothLimits(ctx, ip, userID, class)
+			result, degraded, err := m.checkBothLimits(ctx, ip, userID, class, r.URL.Path)
 			if err != nil && !degraded {
-				// Fail-open: see RateLimit() for design rationale.
-				m.logger.Error("failed to check combined rate limit", "error", err, "ip_prefix", privacy.AnonymizeIP(ip), "user_id", userID)
+				// Degraded policy: see RateLimit() for design rationale.
+				m.logger.Error("failed to check combined rate limit", "error", err, "ip_prefix", privacy.AnonymizeIP(ip), "user_id", userID, "class", class)
+				if m.failsClosed(class) {
+					writeRateLimitUnavailable(w)
+					return
+				}
 				next.ServeHTTP(w, r)
 				return
 			}
@@ -190,7 +280,8 @@ func (m *Middleware) RateLimitAuthenticated(class models.EndpointClass) func(htt
 			if degraded {
 				w.Header().Set("X-RateLimit-Status", "degraded")
 			}
-			addRateLimitHeaders(w, result)
+			addRateLimitHeaders(w, result, m.standardHeaders)
+			m.addDebugHeaders(w, result)
 
 			if !result.Allowed {
 				writeUserRateLimitExceeded(w, result)
@@ -212,6 +303,10 @@ func (m *Middleware) GlobalThrottle() func(http.Handler) http.Handler {
 				next.ServeHTTP(w, r)
 				return
 			}
+			if probe, ok := m.exemptProbe(r); ok {
+				next.ServeHTTP(w, probe)
+				return
+			}
 
 			ctx := r.Context()
 
@@ -234,51 +329,105 @@ func (m *Middleware) GlobalThrottle() func(http.Handler) http.Handler {
 	}
 }
 
-func addRateLimitHeaders(w http.ResponseWriter, result *models.RateLimitResult) {
+// failsClosed reports whether requests of the given class must be rejected when
+// the rate limiter is unavailable. Unknown classes fail closed (PRD-017 FR-1 default-deny).
+func (m *Middleware) failsClosed(class models.EndpointClass) bool {
+	if m.failClosed {
+		return true
+	}
+	closed, ok := m.degradedPolicy[class]
+	return !ok || closed
+}
+
+func addRateLimitHeaders(w http.ResponseWriter, result *models.RateLimitResult, standard bool) {
 	if result == nil {
 		return
 	}
 	w.Header().Set("X-RateLimit-Limit", strconv.Itoa(result.Limit))
 	w.Header().Set("X-RateLimit-Remaining", strconv.Itoa(result.Remaining))
 	w.Header().Set("X-RateLimit-Reset", strconv.FormatInt(result.ResetAt.Unix(), 10))
+	if !standard {
+		return
+	}
+	reset := max(int(time.Until(result.ResetAt).Seconds()), 0)
+	w.Header().Set("RateLimit-Limit", strconv.Itoa(result.Limit))
+	w.Header().Set("RateLimit-Remaining", strconv.Itoa(result.Remaining))
+	w.Header().Set("RateLimit-Reset", strconv.Itoa(reset))
+}
+
+// addDebugHeaders sets X-RateLimit-Decision when debug headers are enabled and
+// the limiter explained its decision.
+func (m *Middleware) addDebugHeaders(w http.ResponseWriter, result *models.RateLimitResult) {
+	if !m.debugHeaders || result == nil || result.Decision == "" {
+		return
+	}
+	w.Header().Set("X-RateLimit-Decision", string(result.Decision))
+}
+
+// writeLimitRejection writes a rejection body built on the base shape, with
+// the Retry-After header matching its retry_after.
+func writeLimitRejection(w http.ResponseWriter, status int, base models.RateLimitExceededResponse, body any) {
+	w.Header().Set("Retry-After", strconv.Itoa(base.RetryAfter))
+	httputil.WriteJSON(w, status, body)
 }
 
 func writeRateLimitExceeded(w http.ResponseWriter, result *models.RateLimitResult) {
-	w.Header().Set("Retry-After", strconv.Itoa(result.RetryAfter))
-	httputil.WriteJSON(w, http.StatusTooManyRequests, &models.RateLimitExceededResponse{
+	base := models.RateLimitExceededResponse{
 		Error:      "rate_limit_exceeded",
+		Reason:     models.LimitReasonIP,
 		Message:    "Too many requests from this IP address. Please try again later.",
 		RetryAfter: result.RetryAfter,
-	})
+	}
+	writeLimitRejection(w, http.StatusTooManyRequests, base, &base)
 }
 
 func writeUserRateLimitExceeded(w http.ResponseWriter, result *models.RateLimitResult) {
-	w.Header().Set("Retry-After", strconv.Itoa(result.RetryAfter))
-	httputil.WriteJSON(w, http.StatusTooManyRequests, &models.UserRateLimitExceededResponse{
-		Error:          "user_rate_limit_exceeded",
-		Message:        "You have exceeded your request quota for this operation.",
-		QuotaLimit:     result.Limit,
-		QuotaRemaining: result.Remaining,
-		QuotaReset:     result.ResetAt,
+	reason := result.Reason
+	if reason == "" {
+		reason = models.LimitReasonUser
+	}
+	base := models.RateLimitExceededResponse{
+		Error:      "user_rate_limit_exceeded",
+		Reason:     reason,
+		Message:    "You have exceeded your request quota for this operation.",
+		RetryAfter: result.RetryAfter,
+	}
+	writeLimitRejection(w, http.StatusTooManyRequests, base, &models.UserRateLimitExceededResponse{
+		RateLimitExceededResponse: base,
+		QuotaLimit:                result.Limit,
+		QuotaRemaining:            result.Remaining,
+		QuotaReset:                result.ResetAt,
 	})
 }
 
 func writeServiceOverloaded(w http.ResponseWriter) {
-	w.Header().Set("Retry-After", "60")
-	httputil.WriteJSON(w, http.StatusServiceUnavailable, &models.ServiceOverloadedResponse{
+	base := models.RateLimitExceededResponse{
 		Error:      "service_unavailable",
+		Reason:     models.LimitReasonGlobal,
 		Message:    "Service is temporarily overloaded. Please try again later.",
 		RetryAfter: 60,
-	})
+	}
+	writeLimitRejection(w, http.StatusServiceUnavailable, base, &models.ServiceOverloadedResponse{RateLimitExceededResponse: base})
+}
+
+func writeRateLimitUnavailable(w http.ResponseWriter) {
+	w.Header().Set("X-RateLimit-Status", "degraded")
+	base := models.RateLimitExceededResponse{
+		Error:      "service_unavailable",
+		Message:    "Rate limiting is temporarily unavailable. Please try again later.",
+		RetryAfter: 30,
+	}
+	writeLimitRejection(w, http.StatusServiceUnavailable, base, &models.ServiceOverloadedResponse{RateLimitExceededResponse: base})
 }
 
 func writeClientRateLimitExceeded(w http.ResponseWriter, result *models.RateLimitResult) {
-	w.Header().Set("Retry-After", strconv.Itoa(result.RetryAfter))
-	httputil.WriteJSON(w, http.StatusTooManyRequests, &models.ClientRateLimitExceededResponse{
+	base := models.RateLimitExceededResponse{
 		Error:      "client_rate_limit_exceeded",
+		Reason:     models.LimitReasonClient,
 		Message:    "OAuth client has exceeded its request quota. Please retry later.",
 		RetryAfter: result.RetryAfter,
-	})
+	}
+	writeLimitRejection(w, http.StatusTooManyRequests, base, &models.ClientRateLimitExceededResponse{RateLimitExceededResponse: base})
 }
 
 // errConflictingClientID indicates conflicting client_id values in different request locations.
@@ -317,14 +466,21 @@ func extractClientID(r *http.Request) (string, error) {
 	}
 }
 
+// CircuitBreakers returns the middleware's IP and combined breakers for status reporting.
+func (m *Middleware) CircuitBreakers() []*CircuitBreaker {
+	return []*CircuitBreaker{m.ipBreaker, m.combinedBreaker}
+}
+
 // ClientMiddleware provides per-OAuth-client rate limiting (PRD-017 FR-2c).
 // Applies different limits for confidential (server-side) vs public (SPA/mobile) clients.
 type ClientMiddleware struct {
-	limiter        ClientRateLimiter
-	logger         *slog.Logger
-	disabled       bool
-	circuitBreaker *CircuitBreaker
-	fallback       ClientRateLimiter
+	limiter         ClientRateLimiter
+	logger          *slog.Logger
+	disabled        bool
+	circuitBreaker  *CircuitBreaker
+	fallback        ClientRateLimiter
+	standardHeaders bool
+	metrics         *metrics.Metrics
 }
 
 // ClientOption configures a ClientMiddleware instance.
@@ -339,6 +495,20 @@ func WithClientFallbackLimiter(limiter ClientRateLimiter) ClientOption {
 	}
 }
 
+// WithClientStandardHeaders is WithStandardHeaders for per-client rate limiting.
+func WithClientStandardHeaders(enabled bool) ClientOption {
+	return func(m *ClientMiddleware) {
+		m.standardHeaders = enabled
+	}
+}
+
+// WithClientMetrics is WithMetrics for per-client rate limiting.
+func WithClientMetrics(m *metrics.Metrics) ClientOption {
+	return func(mw *ClientMiddleware) {
+		mw.metrics = m
+	}
+}
+
 // NewClientMiddleware creates middleware for per-OAuth-client rate limiting.
 func NewClientMiddleware(limiter ClientRateLimiter, logger *slog.Logger, disabled bool, opts ...ClientOption) *ClientMiddleware {
 	m := &ClientMiddleware{
@@ -353,6 +523,11 @@ func NewClientMiddleware(limiter ClientRateLimiter, logger *slog.Logger, disable
 	return m
 }
 
+// CircuitBreaker returns the client limiter's breaker for status reporting.
+func (m *ClientMiddleware) CircuitBreaker() *CircuitBreaker {
+	return m.circuitBreaker
+}
+
 // RateLimitClient returns middleware that enforces per-client rate limits on OAuth endpoints.
 // It extracts client_id from query parameters (for authorize) or request body (for token).
 //
@@ -405,7 +580,7 @@ func (m *ClientMiddleware) RateLimitClient() func(http.Handler) http.Handler {
 			if degraded {
 				w.Header().Set("X-RateLimit-Status", "degraded")
 			}
-			addRateLimitHeaders(w, result)
+			addRateLimitHeaders(w, result, m.standardHeaders)
 
 			if !result.Allowed {
 				writeClientRateLimitExceeded(w, result)
@@ -419,25 +594,56 @@ func (m *ClientMiddleware) RateLimitClient() func(http.Handler) http.Handler {
 
 // withCircuitBreaker wraps a rate limit check with circuit breaker logic.
 // It handles primary check, fallback on failure, and circuit state transitions.
-// Logs state transitions and fallback usage for observability.
+// Logs state transitions and fallback usage, and records them in m when non-nil.
 func withCircuitBreaker[T any](
 	breaker *CircuitBreaker,
 	logger *slog.Logger,
+	m *metrics.Metrics,
 	primary func() (T, error),
 	fallback func() (T, error),
 	fallbackName string,
 ) (result T, degraded bool, err error) {
+	if m != nil {
+		defer func() { m.SetCircuitState(breaker.Name(), circuitStateValue(breaker.Snapshot().State)) }()
+		if fallback != nil {
+			fallback = countFallback(m, breaker.Name(), fallback)
+		}
+	}
 	result, err = primary()
 	if err != nil {
-		return handlePrimaryFailure(breaker, logger, result, err, fallback, fallbackName)
+		return handlePrimaryFailure(breaker, logger, m, result, err, fallback, fallbackName)
 	}
 	return handlePrimarySuccess(breaker, logger, result, fallback, fallbackName)
 }
 
+// countFallback wraps fallback so each call, and each failed call, is counted for breaker.
+func countFallback[T any](m *metrics.Metrics, breaker string, fallback func() (T, error)) func() (T, error) {
+	return func() (T, error) {
+		m.IncrementFallbackUsed(breaker)
+		result, err := fallback()
+		if err != nil {
+			m.IncrementFallbackFailed(breaker)
+		}
+		return result, err
+	}
+}
+
+func circuitStateValue(status circuit.Status) int {
+	switch status {
+	case circuit.StatusOpen:
+		return metrics.CircuitStateOpen
+	case circuit.StatusHalfOpen:
+		return metrics.CircuitStateHalfOpen
+	default:
+		return metrics.CircuitStateClosed
+	}
+}
+
 // handlePrimaryFailure processes circuit breaker state after primary check fails.
 func handlePrimaryFailure[T any](
 	breaker *CircuitBreaker,
 	logger *slog.Logger,
+	m *metrics.Metrics,
 	result T,
 	primaryErr error,
 	fallback func() (T, error),
@@ -445,6 +651,9 @@ func handlePrimaryFailure[T any](
 ) (T, bool, error) {
 	useFallback, change := breaker.RecordFailure()
 	logCircuitOpened(logger, breaker, change)
+	if change.Opened && m != nil {
+		m.IncrementCircuitOpen(breaker.Name())
+	}
 
 	if !useFallback || fallback == nil {
 		return result, false, primaryErr
@@ -518,30 +727,30 @@ func logFallbackUsage(logger *slog.Logger, breaker *CircuitBreaker, reason strin
 	}
 }
 
-func (m *Middleware) checkIPRateLimit(ctx context.Context, ip string, class models.EndpointClass) (*models.RateLimitResult, bool, error) {
+func (m *Middleware) checkIPRateLimit(ctx context.Context, ip string, class models.EndpointClass, path string) (*models.RateLimitResult, bool, error) {
 	primary := func() (*models.RateLimitResult, error) {
-		return m.limiter.CheckIPRateLimit(ctx, ip, class)
+		return m.limiter.CheckIPRateLimit(ctx, ip, class, path)
 	}
 	var fallback func() (*models.RateLimitResult, error)
 	if m.fallback != nil {
 		fallback = func() (*models.RateLimitResult, error) {
-			return m.fallback.CheckIPRateLimit(ctx, ip, class)
+			return m.fallback.CheckIPRateLimit(ctx, ip, class, path)
 		}
 	}
-	return withCircuitBreaker(m.ipBreaker, m.logger, primary, fallback, "IP rate limit")
+	return withCircuitBreaker(m.ipBreaker, m.logger, m.metrics, primary, fallback, "IP rate limit")
 }
 
-func (m *Middleware) checkBothLimits(ctx context.Context, ip, userID string, class models.EndpointClass) (*models.RateLimitResult, bool, error) {
+func (m *Middleware) checkBothLimits(ctx context.Context, ip, userID string, class models.EndpointClass, path string) (*models.RateLimitResult, bool, error) {
 	primary := func() (*models.RateLimitResult, error) {
-		return m.limiter.CheckBothLimits(ctx, ip, userID, class)
+		return m.limiter.CheckBothLimits(ctx, ip, userID, class, path)
 	}
 	var fallback func() (*models.RateLimitResult, error)
 	if m.fallback != nil {
 		fallback = func() (*models.RateLimitResult, error) {
-			return m.fallback.CheckBothLimits(ctx, ip, userID, class)
+			return m.fallback.CheckBothLimits(ctx, ip, userID, class, path)
 		}
 	}
-	return withCircuitBreaker(m.combinedBreaker, m.logger, primary, fallback, "combined rate limit")
+	return withCircuitBreaker(m.combinedBreaker, m.logger, m.metrics, primary, fallback, "combined rate limit")
 }
 
 func (m *ClientMiddleware) checkClientLimit(ctx context.Context, clientID, endpoint string) (*models.RateLimitResult, bool, error) {
@@ -554,5 +763,5 @@ func (m *ClientMiddleware) checkClientLimit(ctx context.Context, clientID, endpo
 			return m.fallback.Check(ctx, clientID, endpoint)
 		}
 	}
-	return withCircuitBreaker(m.circuitBreaker, m.logger, primary, fallback, "client rate limit")
+	return withCircuitBreaker(m.circuitBreaker, m.logger, m.metrics, primary, fallback, "client rate limit")
 }
@@ -8,16 +8,21 @@ import (
 	"log/slog"
 	"net/http"
 	"net/http/httptest"
+	"strconv"
 	"strings"
 	"testing"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/suite"
 
 	"credo/internal/ratelimit/config"
+	"credo/internal/ratelimit/metrics"
 	"credo/internal/ratelimit/models"
 	"credo/internal/ratelimit/store/allowlist"
 	id "credo/pkg/domain"
+	"credo/pkg/platform/audit/publishers/security"
+	auditmemory "credo/pkg/platform/audit/store/memory"
 	"credo/pkg/requestcontext"
 )
 
@@ -76,9 +81,11 @@ type mockRateLimiter struct {
 	checkAuthResult   *models.AuthRateLimitResult
 	checkGlobalErr    error
 	checkGlobalResult bool
+	lastPath          string
 }
 
-func (m *mockRateLimiter) CheckIPRateLimit(_ context.Context, _ string, _ models.EndpointClass) (*models.RateLimitResult, error) {
+func (m *mockRateLimiter) CheckIPRateLimit(_ context.Context, _ string, _ models.EndpointClass, path string) (*models.RateLimitResult, error) {
+	m.lastPath = path
 	return m.checkIPResult, m.checkIPErr
 }
 
@@ -86,7 +93,8 @@ func (m *mockRateLimiter) CheckUserRateLimit(_ context.Context, _ string, _ mode
 	return m.checkUserResult, m.checkUserErr
 }
 
-func (m *mockRateLimiter) CheckBothLimits(_ context.Context, _, _ string, _ models.EndpointClass) (*models.RateLimitResult, error) {
+func (m *mockRateLimiter) CheckBothLimits(_ context.Context, _, _ string, _ models.EndpointClass, path string) (*models.RateLimitResult, error) {
+	m.lastPath = path
 	return m.checkBothResult, m.checkBothErr
 }
 
@@ -197,6 +205,79 @@ func (s *MiddlewareSecuritySuite) TestFailOpenBehavior() {
 	})
 }
 
+// =============================================================================
+// Degraded Mode Policy Tests (Security)
+// =============================================================================
+// Security test: fail-open on auth endpoints during a store outage would let
+// brute-force traffic through unchecked, so auth/sensitive classes fail closed.
+
+func (s *MiddlewareSecuritySuite) TestDegradedPolicy() {
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	s.Run("auth request is rejected with 503 when limiter is unavailable", func() {
+		limiter := &mockRateLimiter{checkIPErr: errors.New("store unavailable")}
+		middleware := New(limiter, s.logger)
+
+		req := withClientMetadata(httptest.NewRequest(http.MethodPost, "/auth/authorize", nil))
+		rr := httptest.NewRecorder()
+		middleware.RateLimit(models.ClassAuth)(next).ServeHTTP(rr, req)
+
+		s.Equal(http.StatusServiceUnavailable, rr.Code)
+		s.Equal("degraded", rr.Header().Get("X-RateLimit-Status"))
+		var body models.ServiceOverloadedResponse
+		s.Require().NoError(json.NewDecoder(rr.Body).Decode(&body))
+		s.Equal("service_unavailable", body.Error)
+	})
+
+	s.Run("sensitive authenticated request is rejected when limiter is unavailable", func() {
+		limiter := &mockRateLimiter{checkBothErr: errors.New("store unavailable")}
+		middleware := New(limiter, s.logger)
+
+		req := withClientMetadata(httptest.NewRequest(http.MethodPost, "/auth/consent", nil))
+		rr := httptest.NewRecorder()
+		middleware.RateLimitAuthenticated(models.ClassSensitive)(next).ServeHTTP(rr, req)
+
+		s.Equal(http.StatusServiceUnavailable, rr.Code)
+	})
+
+	s.Run("read request proceeds when limiter is unavailable", func() {
+		limiter := &mockRateLimiter{checkIPErr: errors.New("store unavailable")}
+		middleware := New(limiter, s.logger)
+
+		req := withClientMetadata(httptest.NewRequest(http.MethodGet, "/auth/userinfo", nil))
+		rr := httptest.NewRecorder()
+		middleware.RateLimit(models.ClassRead)(next).ServeHTTP(rr, req)
+
+		s.Equal(http.StatusOK, rr.Code)
+	})
+
+	s.Run("policy override lets auth fail open", func() {
+		limiter := &mockRateLimiter{checkIPErr: errors.New("store unavailable")}
+		middleware := New(limiter, s.logger, WithDegradedPolicy(map[models.EndpointClass]bool{
+			models.ClassAuth: false,
+		}))
+
+		req := withClientMetadata(httptest.NewRequest(http.MethodPost, "/auth/authorize", nil))
+		rr := httptest.NewRecorder()
+		middleware.RateLimit(models.ClassAuth)(next).ServeHTTP(rr, req)
+
+		s.Equal(http.StatusOK, rr.Code)
+	})
+
+	s.Run("global fail-closed overrides per-class fail-open", func() {
+		limiter := &mockRateLimiter{checkIPErr: errors.New("store unavailable")}
+		middleware := New(limiter, s.logger, WithFailClosed(true))
+
+		req := withClientMetadata(httptest.NewRequest(http.MethodGet, "/auth/userinfo", nil))
+		rr := httptest.NewRecorder()
+		middleware.RateLimit(models.ClassRead)(next).ServeHTTP(rr, req)
+
+		s.Equal(http.StatusServiceUnavailable, rr.Code)
+	})
+}
+
 // =============================================================================
 // Normal Operation Tests
 // =============================================================================
@@ -229,6 +310,82 @@ func (s *MiddlewareSecuritySuite) TestNormalOperation() {
 		s.Equal(http.StatusOK, rr.Code)
 		s.Equal("100", rr.Header().Get("X-RateLimit-Limit"))
 		s.Equal("99", rr.Header().Get("X-RateLimit-Remaining"))
+		s.Empty(rr.Header().Get("RateLimit-Limit"), "draft headers are opt-in")
+		s.Equal("/test", limiter.lastPath, "the path selects endpoint overrides")
+	})
+
+	s.Run("authenticated limits receive the request path", func() {
+		limiter := &mockRateLimiter{
+			checkBothResult: &models.RateLimitResult{Allowed: true, Limit: 100, Remaining: 99},
+		}
+		middleware := New(limiter, s.logger, WithFallbackLimiter(s.fallback))
+
+		req := withClientMetadata(httptest.NewRequest(http.MethodGet, "/me/data-export", nil))
+		rr := httptest.NewRecorder()
+		middleware.RateLimitAuthenticated(models.ClassRead)(http.NotFoundHandler()).ServeHTTP(rr, req)
+
+		s.Equal("/me/data-export", limiter.lastPath)
+	})
+
+	s.Run("standard headers mirror legacy headers", func() {
+		resetAt := time.Now().Add(90 * time.Second)
+		limiter := &mockRateLimiter{
+			checkIPResult: &models.RateLimitResult{
+				Allowed:   false,
+				Limit:     10,
+				Remaining: 0,
+				ResetAt:   resetAt,
+			},
+		}
+		middleware := New(limiter, s.logger, WithFallbackLimiter(s.fallback), WithStandardHeaders(true))
+
+		req := withClientMetadata(httptest.NewRequest(http.MethodGet, "/test", nil))
+		rr := httptest.NewRecorder()
+		middleware.RateLimit(models.ClassRead)(http.NotFoundHandler()).ServeHTTP(rr, req)
+
+		s.Equal(http.StatusTooManyRequests, rr.Code)
+		s.Equal(rr.Header().Get("X-RateLimit-Limit"), rr.Header().Get("RateLimit-Limit"))
+		s.Equal(rr.Header().Get("X-RateLimit-Remaining"), rr.Header().Get("RateLimit-Remaining"))
+
+		epoch, err := strconv.ParseInt(rr.Header().Get("X-RateLimit-Reset"), 10, 64)
+		s.Require().NoError(err)
+		delta, err := strconv.Atoi(rr.Header().Get("RateLimit-Reset"))
+		s.Require().NoError(err)
+		s.InDelta(89, delta, 1, "RateLimit-Reset is delta-seconds")
+		s.InDelta(epoch, time.Now().Unix()+int64(delta), 1, "both resets name the same instant")
+	})
+
+	s.Run("debug headers name the limiter decision only when enabled", func() {
+		limiter := &mockRateLimiter{
+			checkIPResult: &models.RateLimitResult{Allowed: true, Limit: 10, Remaining: 10, Decision: models.DecisionAllowlisted},
+			checkBothResult: &models.RateLimitResult{
+				Allowed:    false,
+				Limit:      10,
+				RetryAfter: 30,
+				Decision:   models.DecisionWindowExceeded,
+			},
+		}
+		next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})
+		serve := func(middleware *Middleware) (ip, authenticated *httptest.ResponseRecorder) {
+			ip = httptest.NewRecorder()
+			req := withClientMetadata(httptest.NewRequest(http.MethodGet, "/test", nil))
+			middleware.RateLimit(models.ClassRead)(next).ServeHTTP(ip, req)
+
+			authenticated = httptest.NewRecorder()
+			parsedUserID, _ := id.ParseUserID(testUserID)
+			req = req.WithContext(requestcontext.WithUserID(req.Context(), parsedUserID))
+			middleware.RateLimitAuthenticated(models.ClassRead)(next).ServeHTTP(authenticated, req)
+			return ip, authenticated
+		}
+
+		ip, authenticated := serve(New(limiter, s.logger, WithFallbackLimiter(s.fallback), WithDebugHeaders(true)))
+		s.Equal("allowlisted", ip.Header().Get("X-RateLimit-Decision"))
+		s.Equal(http.StatusTooManyRequests, authenticated.Code)
+		s.Equal("window_exceeded", authenticated.Header().Get("X-RateLimit-Decision"))
+
+		ip, authenticated = serve(New(limiter, s.logger, WithFallbackLimiter(s.fallback)))
+		s.Empty(ip.Header().Get("X-RateLimit-Decision"))
+		s.Empty(authenticated.Header().Get("X-RateLimit-Decision"))
 	})
 
 	s.Run("authenticated blocked request returns user rate limit payload", func() {
@@ -267,6 +424,7 @@ func (s *MiddlewareSecuritySuite) TestNormalOperation() {
 		err := json.Unmarshal(rr.Body.Bytes(), &payload)
 		s.Require().NoError(err)
 		s.Equal("user_rate_limit_exceeded", payload.Error)
+		s.Equal(models.LimitReasonUser, payload.Reason, "user quota is the default reason for combined checks")
 		s.Equal(5, payload.QuotaLimit)
 		s.Equal(0, payload.QuotaRemaining)
 		s.True(payload.QuotaReset.Equal(resetAt))
@@ -298,6 +456,34 @@ func (s *MiddlewareSecuritySuite) TestNormalOperation() {
 		s.False(nextCalled, "next handler should not be called when rate limited")
 		s.Equal(http.StatusTooManyRequests, rr.Code)
 		s.Equal("60", rr.Header().Get("Retry-After"))
+
+		var payload models.RateLimitExceededResponse
+		s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &payload))
+		s.Equal(models.LimitReasonIP, payload.Reason)
+	})
+
+	s.Run("authenticated request blocked by IP limit reports ip reason", func() {
+		limiter := &mockRateLimiter{
+			checkBothResult: &models.RateLimitResult{
+				Allowed:    false,
+				Limit:      100,
+				RetryAfter: 30,
+				Reason:     models.LimitReasonIP,
+			},
+		}
+		middleware := New(limiter, s.logger, WithFallbackLimiter(s.fallback))
+		next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})
+
+		req := withClientMetadata(httptest.NewRequest(http.MethodGet, "/test", nil))
+		parsedUserID, _ := id.ParseUserID(testUserID)
+		req = req.WithContext(requestcontext.WithUserID(req.Context(), parsedUserID))
+		rr := httptest.NewRecorder()
+		middleware.RateLimitAuthenticated(models.ClassRead)(next).ServeHTTP(rr, req)
+
+		s.Equal(http.StatusTooManyRequests, rr.Code)
+		var payload models.UserRateLimitExceededResponse
+		s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &payload))
+		s.Equal(models.LimitReasonIP, payload.Reason)
 	})
 
 	s.Run("disabled middleware allows all requests", func() {
@@ -325,6 +511,76 @@ func (s *MiddlewareSecuritySuite) TestNormalOperation() {
 	})
 }
 
+// TestCircuitBreakerMetrics verifies breaker transitions and fallback usage
+// reach the metrics registry. metrics.New registers globally, so it is built once.
+func (s *MiddlewareSecuritySuite) TestCircuitBreakerMetrics() {
+	m := metrics.New()
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	serve := func(mw *Middleware) {
+		req := withClientMetadata(httptest.NewRequest(http.MethodGet, "/test", nil))
+		mw.RateLimit(models.ClassRead)(next).ServeHTTP(httptest.NewRecorder(), req)
+	}
+
+	s.Run("opening the circuit updates state, open and fallback counters", func() {
+		limiter := &mockRateLimiter{checkIPErr: errors.New("store unavailable")}
+		mw := New(limiter, s.logger, WithFallbackLimiter(s.fallback), WithMetrics(m))
+
+		for range 5 {
+			serve(mw)
+		}
+		s.InDelta(float64(metrics.CircuitStateOpen), testutil.ToFloat64(m.CircuitState.WithLabelValues("ip")), 0)
+		s.InDelta(1, testutil.ToFloat64(m.CircuitOpenTotal.WithLabelValues("ip")), 0)
+		s.InDelta(1, testutil.ToFloat64(m.FallbackUsedTotal.WithLabelValues("ip")), 0, "fallback serves the check that opened the circuit")
+
+		serve(mw)
+		s.InDelta(2, testutil.ToFloat64(m.FallbackUsedTotal.WithLabelValues("ip")), 0)
+		s.InDelta(1, testutil.ToFloat64(m.CircuitOpenTotal.WithLabelValues("ip")), 0, "already open breakers are not recounted")
+		s.InDelta(0, testutil.ToFloat64(m.FallbackFailedTotal.WithLabelValues("ip")), 0)
+	})
+
+	s.Run("recovery reports half-open then closed", func() {
+		limiter := &mockRateLimiter{checkIPErr: errors.New("store unavailable")}
+		mw := New(limiter, s.logger, WithFallbackLimiter(s.fallback), WithMetrics(m))
+		mw.ipBreaker.ForceOpen()
+
+		limiter.checkIPErr = nil
+		limiter.checkIPResult = &models.RateLimitResult{Allowed: true, Limit: 100, Remaining: 99}
+		serve(mw)
+		s.InDelta(float64(metrics.CircuitStateHalfOpen), testutil.ToFloat64(m.CircuitState.WithLabelValues("ip")), 0)
+
+		serve(mw)
+		serve(mw)
+		s.InDelta(float64(metrics.CircuitStateClosed), testutil.ToFloat64(m.CircuitState.WithLabelValues("ip")), 0)
+	})
+
+	s.Run("failed fallback is counted", func() {
+		limiter := &mockRateLimiter{checkBothErr: errors.New("store unavailable")}
+		fallback := &mockRateLimiter{checkBothErr: errors.New("fallback unavailable")}
+		mw := New(limiter, s.logger, WithFallbackLimiter(fallback), WithMetrics(m))
+		mw.combinedBreaker.ForceOpen()
+
+		req := withClientMetadata(httptest.NewRequest(http.MethodGet, "/test", nil))
+		parsedUserID, _ := id.ParseUserID(testUserID)
+		req = req.WithContext(requestcontext.WithUserID(req.Context(), parsedUserID))
+		mw.RateLimitAuthenticated(models.ClassRead)(next).ServeHTTP(httptest.NewRecorder(), req)
+
+		s.InDelta(1, testutil.ToFloat64(m.FallbackUsedTotal.WithLabelValues("combined")), 0)
+		s.InDelta(1, testutil.ToFloat64(m.FallbackFailedTotal.WithLabelValues("combined")), 0)
+	})
+
+	s.Run("middleware without metrics still serves", func() {
+		limiter := &mockRateLimiter{checkIPErr: errors.New("store unavailable")}
+		mw := New(limiter, s.logger, WithFallbackLimiter(s.fallback))
+		s.NotPanics(func() {
+			for range 6 {
+				serve(mw)
+			}
+		})
+	})
+}
+
 // =============================================================================
 // Circuit Breaker Tests (PRD-017 FR-7)
 // =============================================================================
@@ -457,6 +713,169 @@ func (s *MiddlewareSecuritySuite) TestCircuitBreaker() {
 		s.Equal("100", rr.Header().Get("X-RateLimit-Limit"),
 			"should use primary store limits when circuit is closed")
 	})
+	s.Run("manually opened circuit routes checks to fallback", func() {
+		limiter := &mockRateLimiter{
+			checkIPResult: &models.RateLimitResult{Allowed: true, Limit: 1000, Remaining: 999},
+		}
+		middleware := New(limiter, s.logger, WithFallbackLimiter(s.fallback))
+		next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		})
+		handler := middleware.RateLimit(models.ClassRead)(next)
+
+		middleware.ipBreaker.ForceOpen()
+
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, withClientMetadata(httptest.NewRequest(http.MethodGet, "/test", nil)))
+		s.Equal(http.StatusOK, rr.Code)
+		s.Equal("degraded", rr.Header().Get("X-RateLimit-Status"),
+			"healthy primary must not bypass a manually opened circuit")
+		s.Equal("100", rr.Header().Get("X-RateLimit-Limit"), "limits come from the fallback")
+	})
+
+	s.Run("manually closed circuit returns to primary", func() {
+		limiter := &mockRateLimiter{
+			checkIPErr: errors.New("store unavailable"),
+		}
+		middleware := New(limiter, s.logger, WithFallbackLimiter(s.fallback))
+		next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		})
+		handler := middleware.RateLimit(models.ClassRead)(next)
+
+		for range 5 {
+			handler.ServeHTTP(httptest.NewRecorder(), withClientMetadata(httptest.NewRequest(http.MethodGet, "/test", nil)))
+		}
+		s.Require().True(middleware.ipBreaker.IsOpen())
+
+		// Operator confirms the store has recovered
+		limiter.checkIPErr = nil
+		limiter.checkIPResult = &models.RateLimitResult{Allowed: true, Limit: 100, Remaining: 99}
+		middleware.ipBreaker.ForceClosed()
+
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, withClientMetadata(httptest.NewRequest(http.MethodGet, "/test", nil)))
+		s.Empty(rr.Header().Get("X-RateLimit-Status"),
+			"manually closed circuit should skip recovery probes")
+		s.Equal("100", rr.Header().Get("X-RateLimit-Limit"))
+		s.True(middleware.ipBreaker.Snapshot().Manual)
+	})
+}
+
+// =============================================================================
+// Monitoring Probe Exemption Tests (Security)
+// =============================================================================
+// Security test: Only the shared secret exempts a request; a spoofable
+// User-Agent must not. The exemption covers monitoring routes only and is
+// audited once even when limiters stack.
+
+func (s *MiddlewareSecuritySuite) TestProbeExemption() {
+	const secret = "probe-secret-0123456789abcdef0123"
+	blocked := &mockRateLimiter{
+		checkIPResult:   &models.RateLimitResult{Allowed: false, Limit: 10, RetryAfter: 30},
+		checkBothResult: &models.RateLimitResult{Allowed: false, Limit: 10, RetryAfter: 30},
+	}
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	newProbeRequestTo := func(path, header, userAgent string) *http.Request {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		if header != "" {
+			req.Header.Set(DefaultProbeHeader, header)
+		}
+		req.Header.Set("User-Agent", userAgent)
+		return withClientMetadata(req)
+	}
+	newProbeRequest := func(header, userAgent string) *http.Request {
+		return newProbeRequestTo("/health/ready", header, userAgent)
+	}
+
+	s.Run("probe with secret header bypasses limits and is audited once", func() {
+		auditStore := auditmemory.NewInMemoryStore()
+		publisher := security.New(auditStore)
+		middleware := New(blocked, s.logger,
+			WithProbeExemption(ProbeExemption{Secret: secret}),
+			WithAuditPublisher(publisher),
+		)
+		handler := middleware.GlobalThrottle()(middleware.RateLimit(models.ClassRead)(next))
+
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, newProbeRequest(secret, "uptime-checker/1.0"))
+		s.Equal(http.StatusOK, rr.Code)
+		s.Empty(rr.Header().Get("X-RateLimit-Limit"), "exempt probes skip the limiter entirely")
+
+		s.Require().NoError(publisher.Flush(context.Background()))
+		events, err := auditStore.ListAll(context.Background())
+		s.Require().NoError(err)
+		s.Require().Len(events, 1)
+		s.Equal("allowlist_bypass", events[0].Action)
+		s.Equal("monitoring_probe", events[0].Reason)
+		s.Equal("192.168.1.0", events[0].Subject)
+	})
+
+	s.Run("normal and wrong-secret requests are limited", func() {
+		middleware := New(blocked, s.logger, WithProbeExemption(ProbeExemption{Secret: secret}))
+		handler := middleware.RateLimitAuthenticated(models.ClassRead)(next)
+
+		for _, header := range []string{"", "wrong-secret"} {
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, newProbeRequest(header, "uptime-checker/1.0"))
+			s.Equal(http.StatusTooManyRequests, rr.Code, "header %q", header)
+		}
+	})
+
+	s.Run("secret does not exempt requests outside monitoring routes", func() {
+		middleware := New(blocked, s.logger, WithProbeExemption(ProbeExemption{Secret: secret}))
+		handler := middleware.RateLimit(models.ClassRead)(next)
+
+		for _, path := range []string{"/auth/token", "/healthz", "/admin/metrics"} {
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, newProbeRequestTo(path, secret, "uptime-checker/1.0"))
+			s.Equal(http.StatusTooManyRequests, rr.Code, "path %q", path)
+		}
+	})
+
+	s.Run("configured paths replace the defaults", func() {
+		middleware := New(blocked, s.logger, WithProbeExemption(ProbeExemption{
+			Secret: secret,
+			Paths:  []string{"/status/"},
+		}))
+		handler := middleware.RateLimit(models.ClassRead)(next)
+
+		for _, tc := range []struct {
+			path string
+			want int
+		}{
+			{path: "/status", want: http.StatusOK},
+			{path: "/status/deep", want: http.StatusOK},
+			{path: "/health", want: http.StatusTooManyRequests},
+		} {
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, newProbeRequestTo(tc.path, secret, "uptime-checker/1.0"))
+			s.Equal(tc.want, rr.Code, "path %q", tc.path)
+		}
+	})
+
+	s.Run("user agent allowlist narrows but never grants the exemption", func() {
+		middleware := New(blocked, s.logger, WithProbeExemption(ProbeExemption{
+			Secret:     secret,
+			UserAgents: []string{"uptime-checker/"},
+		}))
+		handler := middleware.RateLimit(models.ClassRead)(next)
+
+		for _, tc := range []struct {
+			header, userAgent string
+			want              int
+		}{
+			{header: "", userAgent: "uptime-checker/1.0", want: http.StatusTooManyRequests},
+			{header: secret, userAgent: "curl/8.0", want: http.StatusTooManyRequests},
+			{header: secret, userAgent: "uptime-checker/1.0", want: http.StatusOK},
+		} {
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, newProbeRequest(tc.header, tc.userAgent))
+			s.Equal(tc.want, rr.Code, "header %q user agent %q", tc.header, tc.userAgent)
+		}
+	})
 }
 
 // =============================================================================
@@ -511,9 +930,96 @@ func (s *MiddlewareSecuritySuite) TestGlobalThrottle() {
 		err := json.Unmarshal(rr.Body.Bytes(), &payload)
 		s.Require().NoError(err)
 		s.Equal("service_unavailable", payload.Error)
+		s.Equal(models.LimitReasonGlobal, payload.Reason)
 	})
 }
 
+// TestRejectionBodies verifies every rejection writer emits the shared base
+// fields plus its own extensions, so clients can parse all of them one way.
+func (s *MiddlewareSecuritySuite) TestRejectionBodies() {
+	resetAt := time.Now().Add(2 * time.Minute).UTC().Truncate(time.Second)
+	result := &models.RateLimitResult{Limit: 5, Remaining: 0, ResetAt: resetAt, RetryAfter: 120}
+
+	tests := []struct {
+		name       string
+		write      func(http.ResponseWriter)
+		status     int
+		error      string
+		reason     models.LimitReason
+		retryAfter int
+		extensions map[string]any
+	}{
+		{
+			name:       "ip",
+			write:      func(w http.ResponseWriter) { writeRateLimitExceeded(w, result) },
+			status:     http.StatusTooManyRequests,
+			error:      "rate_limit_exceeded",
+			reason:     models.LimitReasonIP,
+			retryAfter: 120,
+		},
+		{
+			name:       "user",
+			write:      func(w http.ResponseWriter) { writeUserRateLimitExceeded(w, result) },
+			status:     http.StatusTooManyRequests,
+			error:      "user_rate_limit_exceeded",
+			reason:     models.LimitReasonUser,
+			retryAfter: 120,
+			extensions: map[string]any{
+				"quota_limit":     float64(5),
+				"quota_remaining": float64(0),
+				"quota_reset":     resetAt.Format(time.RFC3339),
+			},
+		},
+		{
+			name:       "client",
+			write:      func(w http.ResponseWriter) { writeClientRateLimitExceeded(w, result) },
+			status:     http.StatusTooManyRequests,
+			error:      "client_rate_limit_exceeded",
+			reason:     models.LimitReasonClient,
+			retryAfter: 120,
+		},
+		{
+			name:       "global throttle",
+			write:      writeServiceOverloaded,
+			status:     http.StatusServiceUnavailable,
+			error:      "service_unavailable",
+			reason:     models.LimitReasonGlobal,
+			retryAfter: 60,
+		},
+		{
+			name:       "limiter unavailable",
+			write:      writeRateLimitUnavailable,
+			status:     http.StatusServiceUnavailable,
+			error:      "service_unavailable",
+			retryAfter: 30,
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			rr := httptest.NewRecorder()
+			tt.write(rr)
+
+			s.Equal(tt.status, rr.Code)
+			s.Equal(strconv.Itoa(tt.retryAfter), rr.Header().Get("Retry-After"))
+
+			var body map[string]any
+			s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &body))
+			s.Equal(tt.error, body["error"])
+			s.NotEmpty(body["message"])
+			s.Equal(float64(tt.retryAfter), body["retry_after"], "retry_after matches the Retry-After header")
+			if tt.reason == "" {
+				s.NotContains(body, "reason")
+			} else {
+				s.Equal(string(tt.reason), body["reason"])
+			}
+			for key, want := range tt.extensions {
+				s.Equal(want, body[key], key)
+			}
+		})
+	}
+}
+
 // =============================================================================
 // Client Rate Limit Tests (PRD-017 FR-2c)
 // =============================================================================
@@ -679,6 +1185,7 @@ func (s *MiddlewareSecuritySuite) TestClientRateLimitMiddleware() {
 		err := json.Unmarshal(rr.Body.Bytes(), &payload)
 		s.Require().NoError(err)
 		s.Equal("client_rate_limit_exceeded", payload.Error)
+		s.Equal(models.LimitReasonClient, payload.Reason)
 	})
 
 	s.Run("degraded header set when client circuit breaker opens", func() {
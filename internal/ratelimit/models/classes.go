@@ -0,0 +1,120 @@
+package models
+
+import (
+	"net/http"
+	"strings"
+)
+
+// ClassRule overrides method-based classification for a route.
+//
+// Path is matched by whole segments against the request path with any leading
+// API version segment ("/v1") removed, so "/auth/consent" matches
+// "/auth/consent" and "/auth/consent/revoke" but not "/auth/consents".
+type ClassRule struct {
+	Path    string        // route path or segment prefix, without API version
+	Methods []string      // HTTP methods the rule applies to; empty matches every method
+	Class   EndpointClass // class assigned when the rule matches
+}
+
+// ClassResolver assigns an EndpointClass to a request from its method and path.
+// Safe methods (GET, HEAD, OPTIONS) are ClassRead and all others ClassWrite,
+// unless a rule promotes the route to a special class such as auth or sensitive.
+// Rules are evaluated in order and the first match wins.
+type ClassResolver struct {
+	rules []ClassRule
+}
+
+// NewClassResolver creates a resolver applying rules before method defaults.
+func NewClassResolver(rules ...ClassRule) *ClassResolver {
+	return &ClassResolver{rules: rules}
+}
+
+// writeMethods are the state-changing methods, for rules that only promote mutations.
+var writeMethods = []string{http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete}
+
+// DefaultClassResolver returns the resolver for the public API routes, following
+// the EndpointClass definitions: OAuth endpoints are auth; consent changes,
+// session revocation, account erasure, VC issuance, registry lookups and
+// decisions are sensitive.
+func DefaultClassResolver() *ClassResolver {
+	return NewClassResolver(
+		ClassRule{Path: "/auth/authorize", Class: ClassAuth},
+		ClassRule{Path: "/auth/token", Class: ClassAuth},
+		ClassRule{Path: "/auth/revoke", Class: ClassAuth},
+		ClassRule{Path: "/auth/consent", Methods: writeMethods, Class: ClassSensitive},
+		ClassRule{Path: "/auth/sessions", Methods: writeMethods, Class: ClassSensitive},
+		ClassRule{Path: "/auth/logout-all", Class: ClassSensitive},
+		ClassRule{Path: "/me", Methods: []string{http.MethodDelete}, Class: ClassSensitive},
+		ClassRule{Path: "/registry", Class: ClassSensitive},
+		ClassRule{Path: "/vc", Class: ClassSensitive},
+		ClassRule{Path: "/decision", Class: ClassSensitive},
+	)
+}
+
+// Resolve returns the endpoint class for a request method and URL path.
+func (r *ClassResolver) Resolve(method, path string) EndpointClass {
+	path = trimVersionSegment(path)
+	for _, rule := range r.rules {
+		if rule.matches(method, path) {
+			return rule.Class
+		}
+	}
+	return classForMethod(method)
+}
+
+func (rule ClassRule) matches(method, path string) bool {
+	prefix := strings.TrimSuffix(rule.Path, "/")
+	if path != prefix && !strings.HasPrefix(path, prefix+"/") {
+		return false
+	}
+	if len(rule.Methods) == 0 {
+		return true
+	}
+	for _, m := range rule.Methods {
+		if strings.EqualFold(m, method) {
+			return true
+		}
+	}
+	return false
+}
+
+func classForMethod(method string) EndpointClass {
+	switch strings.ToUpper(method) {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return ClassRead
+	default:
+		return ClassWrite
+	}
+}
+
+// EndpointPath normalizes a request path for per-endpoint limit lookups by
+// stripping the API version segment, so "/v1/me/data-export" and
+// "/me/data-export" share one override and one bucket.
+func EndpointPath(path string) string {
+	return trimVersionSegment(path)
+}
+
+// trimVersionSegment strips a leading "/v<digits>" segment so rules are
+// version-independent.
+func trimVersionSegment(path string) string {
+	rest, ok := strings.CutPrefix(path, "/v")
+	if !ok {
+		return path
+	}
+	end := strings.IndexByte(rest, '/')
+	if end == -1 {
+		end = len(rest)
+	}
+	if end == 0 {
+		return path
+	}
+	for _, c := range rest[:end] {
+		if c < '0' || c > '9' {
+			return path
+		}
+	}
+	if end == len(rest) {
+		return "/"
+	}
+	return rest[end:]
+}
@@ -0,0 +1,62 @@
+package models
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDefaultClassResolver(t *testing.T) {
+	resolver := DefaultClassResolver()
+
+	tests := []struct {
+		method string
+		path   string
+		want   EndpointClass
+	}{
+		// Consent reads and mutations share a path but not a class
+		{http.MethodGet, "/v1/auth/consent", ClassRead},
+		{http.MethodPost, "/v1/auth/consent", ClassSensitive},
+		{http.MethodPost, "/v1/auth/consent/revoke", ClassSensitive},
+		{http.MethodDelete, "/v1/auth/consent", ClassSensitive},
+
+		{http.MethodPost, "/v1/auth/authorize", ClassAuth},
+		{http.MethodPost, "/v1/auth/token", ClassAuth},
+		{http.MethodGet, "/v1/auth/userinfo", ClassRead},
+		{http.MethodGet, "/v1/auth/sessions", ClassRead},
+		{http.MethodDelete, "/v1/auth/sessions/3f7c", ClassSensitive},
+		{http.MethodPost, "/v1/vc/issue", ClassSensitive},
+		{http.MethodPost, "/v1/decision/evaluate", ClassSensitive},
+		{http.MethodDelete, "/v1/me", ClassSensitive},
+
+		// Unlisted routes fall back to the method
+		{http.MethodGet, "/v1/me/data-export", ClassRead},
+		{http.MethodHead, "/v1/me/data-export", ClassRead},
+		{http.MethodPatch, "/v1/me/profile", ClassWrite},
+		{http.MethodPut, "/v1/me/profile", ClassWrite},
+
+		// Segment matching and version stripping
+		{http.MethodPost, "/v1/auth/consents", ClassWrite},
+		{http.MethodPost, "/auth/consent", ClassSensitive},
+		{http.MethodPost, "/v2/auth/consent", ClassSensitive},
+		{http.MethodPost, "/vcard/auth/consent", ClassWrite},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.method+" "+tt.path, func(t *testing.T) {
+			assert.Equal(t, tt.want, resolver.Resolve(tt.method, tt.path))
+		})
+	}
+}
+
+func TestClassResolver_FirstMatchingRuleWins(t *testing.T) {
+	resolver := NewClassResolver(
+		ClassRule{Path: "/admin/stats", Methods: []string{http.MethodGet}, Class: ClassRead},
+		ClassRule{Path: "/admin", Class: ClassAdmin},
+	)
+
+	assert.Equal(t, ClassRead, resolver.Resolve(http.MethodGet, "/admin/stats"))
+	assert.Equal(t, ClassAdmin, resolver.Resolve(http.MethodPost, "/admin/stats"))
+	assert.Equal(t, ClassAdmin, resolver.Resolve(http.MethodGet, "/admin/users"))
+}
@@ -21,6 +21,7 @@ type RateLimitKey struct {
 	prefix     KeyPrefix
 	identifier string
 	class      EndpointClass // optional, empty for auth keys
+	endpoint   string        // optional, set when the path has its own limit
 }
 
 // NewRateLimitKey creates a rate limit key for IP or user-based limits.
@@ -32,6 +33,13 @@ func NewRateLimitKey(prefix KeyPrefix, identifier string, class EndpointClass) R
 	}
 }
 
+// ForEndpoint scopes the key to a single path, so a per-endpoint limit counts
+// in its own bucket instead of draining the class bucket it overrides.
+func (k RateLimitKey) ForEndpoint(path string) RateLimitKey {
+	k.endpoint = sanitizeKeySegment(path)
+	return k
+}
+
 // NewAuthLockoutKey creates a composite key for auth lockout tracking.
 // Combines identifier (username/email) with IP for per-identity-per-IP tracking.
 func NewAuthLockoutKey(identifier, ip string) RateLimitKey {
@@ -47,6 +55,9 @@ func (k RateLimitKey) String() string {
 	if k.class == "" {
 		return fmt.Sprintf("%s:%s", k.prefix, k.identifier)
 	}
+	if k.endpoint != "" {
+		return fmt.Sprintf("%s:%s:%s:%s", k.prefix, k.identifier, k.class, k.endpoint)
+	}
 	return fmt.Sprintf("%s:%s:%s", k.prefix, k.identifier, k.class)
 }
 
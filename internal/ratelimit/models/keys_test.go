@@ -65,6 +65,14 @@ func (s *KeySecuritySuite) TestKeyCollisionAttack() {
 		s.Contains(keyStr, "ip_c192_c168_c1_c1")
 	})
 
+	s.Run("endpoint segment is escaped and separates buckets", func() {
+		classKey := NewRateLimitKey(KeyPrefixUser, "user-1", ClassRead)
+		endpointKey := classKey.ForEndpoint("/me:data-export")
+
+		s.NotEqual(classKey.String(), endpointKey.String())
+		s.Equal("user:user-1:read:/me_cdata-export", endpointKey.String())
+	})
+
 	s.Run("auth lockout key escapes both identifier and IP", func() {
 		maliciousIdentifier := "admin:user"
 		maliciousIP := "192.168.1.1:8080" // IP with port (contains colon)
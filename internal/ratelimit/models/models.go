@@ -43,11 +43,12 @@ func (c EndpointClass) IsValid() bool {
 	return false
 }
 
-// AllowlistEntryType identifies whether an allowlist entry exempts an IP address or user.
+// AllowlistEntryType identifies whether an allowlist entry exempts an IP address, network, or user.
 type AllowlistEntryType string
 
 const (
 	AllowlistTypeIP     AllowlistEntryType = "ip"      // Exempts a specific IP address
+	AllowlistTypeCIDR   AllowlistEntryType = "cidr"    // Exempts every IP address in a network, e.g. 10.0.0.0/8
 	AllowlistTypeUserID AllowlistEntryType = "user_id" // Exempts a specific user
 )
 
@@ -59,13 +60,13 @@ func ParseAllowlistEntryType(s string) (AllowlistEntryType, error) {
 	}
 	t := AllowlistEntryType(s)
 	if !t.IsValid() {
-		return "", dErrors.New(dErrors.CodeInvalidInput, "invalid allowlist entry type: must be 'ip' or 'user_id'")
+		return "", dErrors.New(dErrors.CodeInvalidInput, "invalid allowlist entry type: must be 'ip', 'cidr', or 'user_id'")
 	}
 	return t, nil
 }
 
 func (t AllowlistEntryType) IsValid() bool {
-	return t == AllowlistTypeIP || t == AllowlistTypeUserID
+	return t == AllowlistTypeIP || t == AllowlistTypeCIDR || t == AllowlistTypeUserID
 }
 
 func (t AllowlistEntryType) String() string {
@@ -93,6 +94,10 @@ func ParseAllowlistIdentifier(entryType AllowlistEntryType, identifier string) (
 		if net.ParseIP(identifier) == nil {
 			return "", dErrors.New(dErrors.CodeInvalidInput, "identifier must be a valid IP address")
 		}
+	case AllowlistTypeCIDR:
+		if _, _, err := net.ParseCIDR(identifier); err != nil {
+			return "", dErrors.New(dErrors.CodeInvalidInput, "identifier must be a valid CIDR range")
+		}
 	case AllowlistTypeUserID:
 		if _, err := id.ParseUserID(identifier); err != nil {
 			return "", dErrors.New(dErrors.CodeInvalidInput, "identifier must be a valid user_id")
@@ -111,25 +116,59 @@ func ParseAllowlistIdentifier(entryType AllowlistEntryType, identifier string) (
 //   - Remaining: requests left before hitting the limit
 //   - ResetAt: when the current window expires and counters reset
 //   - RetryAfter: seconds to wait before retrying (only set when Allowed=false)
+//   - Decision: why the request was allowed or denied, for debugging
 type RateLimitResult struct {
-	Allowed    bool      `json:"allowed"`
-	Bypassed   bool      `json:"bypassed,omitempty"`
-	Limit      int       `json:"limit"`
-	Remaining  int       `json:"remaining"`
-	ResetAt    time.Time `json:"reset_at"`
-	RetryAfter int       `json:"retry_after,omitempty"`
+	Allowed    bool        `json:"allowed"`
+	Bypassed   bool        `json:"bypassed,omitempty"`
+	Limit      int         `json:"limit"`
+	Remaining  int         `json:"remaining"`
+	ResetAt    time.Time   `json:"reset_at"`
+	RetryAfter int         `json:"retry_after,omitempty"`
+	Reason     LimitReason `json:"reason,omitempty"` // Set on denials from checks that combine several limits
+	Decision   Decision    `json:"-"`                // Reveals allowlist membership; surfaced only in debug mode
 }
 
+// Decision explains which path through the rate limiter produced a result.
+// Set by the requestlimit service; middleware exposes it only when debug
+// headers are enabled.
+type Decision string
+
+const (
+	DecisionUnderLimit     Decision = "under_limit"                 // bucket had capacity
+	DecisionWindowExceeded Decision = "window_exceeded"             // bucket was exhausted for the current window
+	DecisionConfigMissing  Decision = "config_missing_default_deny" // no limit configured for the endpoint class
+	DecisionAllowlisted    Decision = "allowlisted"                 // allowlisted and within the limit anyway
+	DecisionBypassed       Decision = "bypassed"                    // allowlisted and would otherwise have been denied
+)
+
+// LimitReason is the machine-readable cause of a rate-limit denial, returned in
+// exceeded responses so clients can react appropriately (e.g. a captcha for
+// auth_lockout, a retry timer for ip).
+type LimitReason string
+
+const (
+	LimitReasonIP          LimitReason = "ip"           // per-IP limit for the endpoint class
+	LimitReasonUser        LimitReason = "user"         // per-user quota for the endpoint class
+	LimitReasonClient      LimitReason = "client"       // per-OAuth-client quota
+	LimitReasonAuthLockout LimitReason = "auth_lockout" // too many failed authentication attempts
+	LimitReasonGlobal      LimitReason = "global"       // global throttle protecting the service
+)
+
 // AuthRateLimitResult extends RateLimitResult with authentication-specific fields.
 // Returned by authlockout.Service.Check for login/password-reset endpoints.
 //
 // Additional fields:
-//   - RequiresCaptcha: true after 3 consecutive lockouts in 24 hours (FR-2b)
+//   - RequiresCaptcha: true after 3 consecutive lockouts in 24 hours (FR-2b),
+//     or for every attempt while a population-wide failure spike is active
 //   - FailureCount: number of failed attempts in the current window
 type AuthRateLimitResult struct {
 	RateLimitResult
 	RequiresCaptcha bool `json:"requires_captcha"`
 	FailureCount    int  `json:"failure_count"`
+	// BackoffMs is the progressive delay in milliseconds the caller should apply
+	// to an allowed attempt. The service never sleeps; callers decide how to
+	// enforce it (e.g. a deferred response or Retry-After).
+	BackoffMs int `json:"backoff_ms,omitempty"`
 }
 
 // AllowlistEntry exempts an IP address or user from rate limiting.
@@ -183,11 +222,22 @@ func (t QuotaTier) IsValid() bool {
 type APIKeyQuota struct {
 	APIKeyID       id.APIKeyID `json:"api_key_id"`
 	Tier           QuotaTier   `json:"tier"`
-	MonthlyLimit   int         `json:"monthly_limit"`   // Max requests allowed this month
-	CurrentUsage   int         `json:"current_usage"`   // Requests used so far
-	OverageAllowed bool        `json:"overage_allowed"` // If true, requests proceed over quota (billed)
-	PeriodStart    time.Time   `json:"period_start"`    // First day of current month
-	PeriodEnd      time.Time   `json:"period_end"`      // Last moment of current month
+	MonthlyLimit   int         `json:"monthly_limit"`         // Max requests allowed this month
+	CurrentUsage   int         `json:"current_usage"`         // Requests used so far
+	OverageUsage   int         `json:"overage_usage"`         // Requests past MonthlyLimit, billed as overage
+	OverageAllowed bool        `json:"overage_allowed"`       // If true, requests proceed over quota (billed)
+	PeriodStart    time.Time   `json:"period_start"`          // First day of current month
+	PeriodEnd      time.Time   `json:"period_end"`            // Last moment of current month
+	GraceUntil     *time.Time  `json:"grace_until,omitempty"` // Set by a downgrade: over-limit usage is tolerated until then
+}
+
+// APIKeyQuotaResult is the outcome of enforcing an API key's monthly quota.
+// Returned by quota.Service.Enforce; handlers map Allowed=false to 429 and emit
+// X-Quota-* headers from Quota.
+type APIKeyQuotaResult struct {
+	Allowed bool         `json:"allowed"`
+	Overage bool         `json:"overage,omitempty"` // Request was past the monthly limit and billed as overage
+	Quota   *APIKeyQuota `json:"quota"`
 }
 
 // AuthLockout tracks authentication failures to prevent brute-force attacks (PRD-017 FR-2b).
@@ -285,6 +335,19 @@ func NewAllowlistEntry(id string, entryType AllowlistEntryType, identifier, reas
 	}, nil
 }
 
+// ContainsIP reports whether a CIDR entry's network contains ip.
+// Always false for other entry types, which match by exact identifier.
+func (e *AllowlistEntry) ContainsIP(ip net.IP) bool {
+	if e.Type != AllowlistTypeCIDR || ip == nil {
+		return false
+	}
+	_, network, err := net.ParseCIDR(e.Identifier.String())
+	if err != nil {
+		return false
+	}
+	return network.Contains(ip)
+}
+
 // IsExpired checks if the entry has expired using the current wall-clock time.
 // IMPURE: Calls time.Now() internally. Use IsExpiredAt for pure/testable code.
 func (e *AllowlistEntry) IsExpired() bool {
@@ -396,8 +459,7 @@ func NewAPIKeyQuota(apiKeyID id.APIKeyID, tier QuotaTier, monthlyLimit int, over
 		return nil, dErrors.New(dErrors.CodeInvariantViolation, "monthly_limit cannot be negative")
 	}
 
-	periodStart := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
-	periodEnd := periodStart.AddDate(0, 1, 0).Add(-time.Nanosecond)
+	periodStart, periodEnd := QuotaPeriod(now)
 
 	return &APIKeyQuota{
 		APIKeyID:       apiKeyID,
@@ -411,8 +473,41 @@ func NewAPIKeyQuota(apiKeyID id.APIKeyID, tier QuotaTier, monthlyLimit int, over
 }
 
 // IsOverQuota returns true if current usage has reached or exceeded the monthly limit.
+// A negative limit means unlimited and is never over quota.
 func (q *APIKeyQuota) IsOverQuota() bool {
-	return q.CurrentUsage >= q.MonthlyLimit
+	return q.MonthlyLimit >= 0 && q.CurrentUsage >= q.MonthlyLimit
+}
+
+// QuotaPeriod returns the calendar month containing now: its first instant and
+// its last nanosecond.
+func QuotaPeriod(now time.Time) (start, end time.Time) {
+	start = time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
+	return start, start.AddDate(0, 1, 0).Add(-time.Nanosecond)
+}
+
+// PeriodEnded returns true once now is after the end of the billing period.
+func (q *APIKeyQuota) PeriodEnded(now time.Time) bool {
+	return now.After(q.PeriodEnd)
+}
+
+// RollOver starts the calendar month containing now when the current period
+// has ended, zeroing usage and overage. Returns false, changing nothing, while
+// the period is still current, so concurrent callers reset a period only once.
+func (q *APIKeyQuota) RollOver(now time.Time) bool {
+	if !q.PeriodEnded(now) {
+		return false
+	}
+	q.CurrentUsage = 0
+	q.OverageUsage = 0
+	q.GraceUntil = nil
+	q.PeriodStart, q.PeriodEnd = QuotaPeriod(now)
+	return true
+}
+
+// InGrace returns true while a downgrade grace period tolerates usage above
+// the monthly limit.
+func (q *APIKeyQuota) InGrace(now time.Time) bool {
+	return q.GraceUntil != nil && now.Before(*q.GraceUntil)
 }
 
 // NewRateLimitViolation creates an audit record for a rate-limited request.
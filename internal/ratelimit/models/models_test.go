@@ -0,0 +1,48 @@
+package models
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	id "credo/pkg/domain"
+	dErrors "credo/pkg/domain-errors"
+)
+
+func TestNewAllowlistEntry_CIDR(t *testing.T) {
+	newEntry := func(identifier string) (*AllowlistEntry, error) {
+		return NewAllowlistEntry("entry-1", AllowlistTypeCIDR, identifier, "office network", id.UserID(uuid.New()), nil, time.Now())
+	}
+
+	for _, identifier := range []string{"10.0.0.0/8", "2001:db8::/32"} {
+		t.Run("accepts "+identifier, func(t *testing.T) {
+			entry, err := newEntry(identifier)
+			require.NoError(t, err)
+			assert.Equal(t, AllowlistTypeCIDR, entry.Type)
+		})
+	}
+
+	for _, identifier := range []string{"10.0.0.0", "10.0.0.0/33", "10.0.0/8", "office"} {
+		t.Run("rejects "+identifier, func(t *testing.T) {
+			_, err := newEntry(identifier)
+			require.Error(t, err)
+			assert.True(t, dErrors.HasCode(err, dErrors.CodeInvariantViolation))
+		})
+	}
+}
+
+func TestAllowlistEntry_ContainsIP(t *testing.T) {
+	cidr := &AllowlistEntry{Type: AllowlistTypeCIDR, Identifier: "10.20.0.0/16"}
+	v6 := &AllowlistEntry{Type: AllowlistTypeCIDR, Identifier: "2001:db8:abcd::/48"}
+	exact := &AllowlistEntry{Type: AllowlistTypeIP, Identifier: "10.20.0.1"}
+
+	assert.True(t, cidr.ContainsIP(net.ParseIP("10.20.255.1")))
+	assert.False(t, cidr.ContainsIP(net.ParseIP("10.21.0.1")), "outside the range")
+	assert.True(t, v6.ContainsIP(net.ParseIP("2001:db8:abcd:1::5")))
+	assert.False(t, v6.ContainsIP(net.ParseIP("10.20.0.1")), "IPv4 is not in an IPv6 range")
+	assert.False(t, exact.ContainsIP(net.ParseIP("10.20.0.1")), "IP entries match by exact identifier only")
+}
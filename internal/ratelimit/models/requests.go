@@ -26,14 +26,19 @@ func validateAllowlistEntry(entryType AllowlistEntryType, identifier string) err
 
 	// Syntax
 	if !entryType.IsValid() {
-		return dErrors.New(dErrors.CodeValidation, "type must be 'ip' or 'user_id'")
+		return dErrors.New(dErrors.CodeValidation, "type must be 'ip', 'cidr', or 'user_id'")
 	}
 
-	// Semantic: validate IP format when type is 'ip'
-	if entryType == AllowlistTypeIP {
+	// Semantic: validate IP and CIDR formats
+	switch entryType {
+	case AllowlistTypeIP:
 		if net.ParseIP(identifier) == nil {
 			return dErrors.New(dErrors.CodeValidation, "identifier must be a valid IP address")
 		}
+	case AllowlistTypeCIDR:
+		if _, _, err := net.ParseCIDR(identifier); err != nil {
+			return dErrors.New(dErrors.CodeValidation, "identifier must be a valid CIDR range, e.g. 10.0.0.0/8")
+		}
 	}
 
 	return nil
@@ -134,6 +139,11 @@ func (r *ResetRateLimitRequest) Validate() error {
 		return err
 	}
 
+	// ResetRateLimit-specific: buckets are keyed by a single IP, never a range
+	if r.Type == AllowlistTypeCIDR {
+		return dErrors.New(dErrors.CodeValidation, "type must be 'ip' or 'user_id'")
+	}
+
 	// ResetRateLimit-specific: optional class validation
 	if r.Class != "" && !r.Class.IsValid() {
 		return dErrors.New(dErrors.CodeValidation, "class must be 'auth', 'sensitive', 'read', or 'write'")
@@ -142,6 +152,53 @@ func (r *ResetRateLimitRequest) Validate() error {
 	return nil
 }
 
+// RateLimitStateRequest selects what GET /admin/rate-limit/state reports on.
+type RateLimitStateRequest struct {
+	Type       AllowlistEntryType // ip or user_id; inferred from Identifier when empty
+	Identifier string
+	Login      string // Optional: username or email whose lockout from the IP is reported
+	APIKey     string // Optional: API key whose quota is reported
+}
+
+// Normalize trims the request and infers a missing type: identifiers that
+// parse as an IP are IPs, anything else is a user ID.
+func (r *RateLimitStateRequest) Normalize() {
+	if r == nil {
+		return
+	}
+	r.Type = AllowlistEntryType(strings.TrimSpace(strings.ToLower(string(r.Type))))
+	r.Identifier = strings.TrimSpace(r.Identifier)
+	r.Login = strings.TrimSpace(r.Login)
+	r.APIKey = strings.TrimSpace(r.APIKey)
+	if r.Type == "" && r.Identifier != "" {
+		r.Type = AllowlistTypeUserID
+		if net.ParseIP(r.Identifier) != nil {
+			r.Type = AllowlistTypeIP
+		}
+	}
+}
+
+// Follows validation order: Size -> Required -> Syntax -> Semantic.
+func (r *RateLimitStateRequest) Validate() error {
+	if r == nil {
+		return dErrors.New(dErrors.CodeBadRequest, "request is required")
+	}
+	if len(r.Login) > 255 {
+		return dErrors.New(dErrors.CodeValidation, "login must be 255 characters or less")
+	}
+	if err := validateAllowlistEntry(r.Type, r.Identifier); err != nil {
+		return err
+	}
+	if r.Type == AllowlistTypeCIDR {
+		return dErrors.New(dErrors.CodeValidation, "type must be 'ip' or 'user_id'")
+	}
+	// Lockouts are tracked per login and client IP
+	if r.Login != "" && r.Type != AllowlistTypeIP {
+		return dErrors.New(dErrors.CodeValidation, "login requires an ip identifier")
+	}
+	return nil
+}
+
 // =============================================================================
 // PRD-017 FR-5: Partner API Quota Requests/Responses
 // =============================================================================
@@ -178,6 +235,7 @@ type QuotaUsageResponse struct {
 	APIKeyID  string    `json:"api_key_id"`
 	Tier      string    `json:"tier"`
 	Usage     int       `json:"usage"`
+	Overage   int       `json:"overage"`
 	Limit     int       `json:"limit"`
 	Remaining int       `json:"remaining"`
 	ResetAt   time.Time `json:"reset_at"`
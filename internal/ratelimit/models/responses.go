@@ -2,18 +2,21 @@ package models
 
 import "time"
 
+// RateLimitExceededResponse is the base body of every rate-limit rejection, so
+// clients can read error, message and retry_after the same way whichever limit
+// was hit. The other rejection bodies embed it and add type-specific fields.
 type RateLimitExceededResponse struct {
-	Error      string `json:"error"` // "rate_limit_exceeded" or "user_rate_limit_exceeded"
-	Message    string `json:"message"`
-	RetryAfter int    `json:"retry_after"` // seconds
+	Error      string      `json:"error"`            // e.g. "rate_limit_exceeded"
+	Reason     LimitReason `json:"reason,omitempty"` // empty only when limiting is unavailable
+	Message    string      `json:"message"`
+	RetryAfter int         `json:"retry_after"` // seconds, matching the Retry-After header
 }
 
 type UserRateLimitExceededResponse struct {
-	Error          string    `json:"error"` // "user_rate_limit_exceeded"
-	Message        string    `json:"message"`
-	QuotaLimit     int       `json:"quota_limit"`
-	QuotaRemaining int       `json:"quota_remaining"`
-	QuotaReset     time.Time `json:"quota_reset"`
+	RateLimitExceededResponse           // Error "user_rate_limit_exceeded"; Reason "ip" or "user", whichever limit was exhausted
+	QuotaLimit                int       `json:"quota_limit"`
+	QuotaRemaining            int       `json:"quota_remaining"`
+	QuotaReset                time.Time `json:"quota_reset"`
 }
 
 type AllowlistEntryResponse struct {
@@ -28,25 +31,42 @@ type QuotaResponse struct {
 	QuotaReset     time.Time `json:"quota_reset"`
 }
 
+// ServiceOverloadedResponse is the 503 body: Error "service_unavailable", Reason
+// "global" when the global throttle tripped and empty when limiting is unavailable.
 type ServiceOverloadedResponse struct {
-	Error      string `json:"error"`   // "service_unavailable"
-	Message    string `json:"message"` // "Service is temporarily overloaded..."
-	RetryAfter int    `json:"retry_after"`
+	RateLimitExceededResponse
 }
 
 // AuthLockoutResponse is returned when an account is temporarily locked
 // due to too many failed authentication attempts (PRD-017 FR-2b).
 type AuthLockoutResponse struct {
-	Error      string `json:"error"`       // "account_locked"
-	Message    string `json:"message"`     // User-friendly lockout message
-	RetryAfter int    `json:"retry_after"` // seconds until lockout expires
-	SupportURL string `json:"support_url"` // URL for user support
+	RateLimitExceededResponse        // Error "account_locked"; Reason "auth_lockout"; RetryAfter until lockout expires
+	SupportURL                string `json:"support_url"` // URL for user support
 }
 
 // ClientRateLimitExceededResponse is returned when an OAuth client exceeds
 // its rate limit quota (PRD-017 FR-2c).
 type ClientRateLimitExceededResponse struct {
-	Error      string `json:"error"`       // "client_rate_limit_exceeded"
-	Message    string `json:"message"`     // User-friendly message
-	RetryAfter int    `json:"retry_after"` // seconds until limit resets
+	RateLimitExceededResponse // Error "client_rate_limit_exceeded"; Reason "client"
+}
+
+// RateLimitState is the admin snapshot returned by GET /admin/rate-limit/state.
+// It is assembled from reads that consume no tokens.
+type RateLimitState struct {
+	Type        AllowlistEntryType `json:"type"`
+	Identifier  string             `json:"identifier"`
+	Allowlisted bool               `json:"allowlisted"`
+	Buckets     []BucketState      `json:"buckets"`
+	Lockout     *AuthLockout       `json:"lockout,omitempty"` // Present when a login was given and has a record
+	Quota       *APIKeyQuota       `json:"quota,omitempty"`   // Present when an API key was given and has a quota
+}
+
+// BucketState is one endpoint class bucket in the current window.
+type BucketState struct {
+	Class         EndpointClass `json:"class"`
+	Key           string        `json:"key"`
+	Count         int           `json:"count"`
+	Limit         int           `json:"limit"`
+	Remaining     int           `json:"remaining"`
+	WindowSeconds int           `json:"window_seconds"`
 }
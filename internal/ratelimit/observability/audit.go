@@ -4,10 +4,13 @@ package observability
 import (
 	"context"
 	"log/slog"
+	"net"
 
+	id "credo/pkg/domain"
 	"credo/pkg/platform/attrs"
 	"credo/pkg/platform/audit"
 	"credo/pkg/platform/audit/publishers/security"
+	"credo/pkg/platform/privacy"
 	"credo/pkg/requestcontext"
 )
 
@@ -15,8 +18,20 @@ import (
 type AuditPublisher = *security.Publisher
 
 // LogAudit logs audit events to both structured logger and audit publisher.
-// It enriches events with request ID and extracts subject/reason from attrList.
+// It enriches events with request ID and extracts subject, user, and reason
+// from attrList. A subject that is an IP address is anonymized.
 func LogAudit(ctx context.Context, logger *slog.Logger, publisher AuditPublisher, event string, attrList ...any) {
+	logAudit(ctx, logger, publisher, audit.SeverityWarning, event, attrList...)
+}
+
+// LogCritical is LogAudit for events that need immediate attention, such as
+// platform-wide attacks. The event is emitted with critical severity so SIEM
+// routing can page on it.
+func LogCritical(ctx context.Context, logger *slog.Logger, publisher AuditPublisher, event string, attrList ...any) {
+	logAudit(ctx, logger, publisher, audit.SeverityCritical, event, attrList...)
+}
+
+func logAudit(ctx context.Context, logger *slog.Logger, publisher AuditPublisher, severity audit.Severity, event string, attrList ...any) {
 	requestID := requestcontext.RequestID(ctx)
 
 	if requestID != "" {
@@ -26,7 +41,11 @@ func LogAudit(ctx context.Context, logger *slog.Logger, publisher AuditPublisher
 	args := append(attrList, "event", event, "log_type", "audit")
 
 	if logger != nil {
-		logger.InfoContext(ctx, event, args...)
+		if severity == audit.SeverityCritical {
+			logger.ErrorContext(ctx, event, args...)
+		} else {
+			logger.InfoContext(ctx, event, args...)
+		}
 	}
 
 	if publisher == nil {
@@ -35,22 +54,46 @@ func LogAudit(ctx context.Context, logger *slog.Logger, publisher AuditPublisher
 
 	publisher.Emit(ctx, audit.SecurityEvent{
 		Action:    event,
+		UserID:    extractUserID(attrList),
 		Subject:   extractSubject(attrList),
 		RequestID: requestID,
 		Reason:    extractReason(attrList),
-		Severity:  audit.SeverityWarning,
+		ActorID:   extractActor(attrList),
+		Severity:  severity,
 	})
 }
 
 func extractSubject(attrList []any) string {
 	for _, key := range []string{"identifier", "ip", "user_id", "client_id", "api_key_id"} {
 		if val := attrs.ExtractString(attrList, key); val != "" {
+			if net.ParseIP(val) != nil {
+				return privacy.AnonymizeIP(val)
+			}
 			return val
 		}
 	}
 	return ""
 }
 
+// extractUserID returns the user_id attribute as a UserID, so per-user audit
+// timelines include rate limit events. Values that are not user IDs are ignored.
+func extractUserID(attrList []any) id.UserID {
+	for i := 0; i < len(attrList)-1; i += 2 {
+		if k, ok := attrList[i].(string); !ok || k != "user_id" {
+			continue
+		}
+		switch v := attrList[i+1].(type) {
+		case id.UserID:
+			return v
+		case string:
+			if userID, err := id.ParseUserID(v); err == nil {
+				return userID
+			}
+		}
+	}
+	return id.UserID{}
+}
+
 func extractReason(attrList []any) string {
 	for _, key := range []string{"reason", "bypass_type"} {
 		if val := attrs.ExtractString(attrList, key); val != "" {
@@ -59,3 +102,21 @@ func extractReason(attrList []any) string {
 	}
 	return ""
 }
+
+// extractActor returns the admin_user_id attribute, if set, as the acting admin.
+func extractActor(attrList []any) string {
+	for i := 0; i < len(attrList)-1; i += 2 {
+		if k, ok := attrList[i].(string); !ok || k != "admin_user_id" {
+			continue
+		}
+		switch v := attrList[i+1].(type) {
+		case id.UserID:
+			if !v.IsNil() {
+				return v.String()
+			}
+		case string:
+			return v
+		}
+	}
+	return ""
+}
@@ -0,0 +1,83 @@
+package observability
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"credo/internal/ratelimit/models"
+	id "credo/pkg/domain"
+	"credo/pkg/platform/audit"
+	"credo/pkg/platform/audit/publishers/security"
+	auditmemory "credo/pkg/platform/audit/store/memory"
+)
+
+// Justification: Subject and user extraction decides whether rate limit events
+// show up in per-user and per-IP forensics; E2E tests only see the HTTP outcome.
+func TestLogAudit(t *testing.T) {
+	userID := id.UserID(uuid.New())
+
+	for _, tc := range []struct {
+		name        string
+		attrs       []any
+		wantSubject string
+		wantUserID  id.UserID
+	}{
+		{
+			name:        "raw IP subject is anonymized",
+			attrs:       []any{"identifier", "203.0.113.77", "endpoint_class", "auth"},
+			wantSubject: "203.0.113.0",
+		},
+		{
+			name:        "user event carries the user",
+			attrs:       []any{"identifier", userID.String(), "user_id", userID.String()},
+			wantSubject: userID.String(),
+			wantUserID:  userID,
+		},
+		{
+			name:        "typed user ID is extracted",
+			attrs:       []any{"ip", "198.51.100.0", "user_id", userID, "bypass_type", "user"},
+			wantSubject: "198.51.100.0",
+			wantUserID:  userID,
+		},
+		{
+			name:        "typed identifiers become the subject",
+			attrs:       []any{"identifier", models.AllowlistIdentifier("10.0.0.0/8"), "type", models.AllowlistTypeCIDR},
+			wantSubject: "10.0.0.0/8",
+		},
+		{
+			name:        "api key event falls back to api_key_id",
+			attrs:       []any{"api_key_id", id.APIKeyID("key-123"), "tier", models.QuotaTierFree},
+			wantSubject: "key-123",
+		},
+		{
+			name:        "non-UUID user_id is not a user",
+			attrs:       []any{"user_id", "anonymous"},
+			wantSubject: "anonymous",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			event := emitOne(t, tc.attrs...)
+			assert.Equal(t, tc.wantSubject, event.Subject)
+			assert.Equal(t, tc.wantUserID, event.UserID)
+		})
+	}
+}
+
+func emitOne(t *testing.T, attrList ...any) audit.Event {
+	t.Helper()
+	store := auditmemory.NewInMemoryStore()
+	publisher := security.New(store)
+	t.Cleanup(func() { _ = publisher.Close() })
+
+	LogAudit(context.Background(), nil, publisher, "rate_limit_exceeded", attrList...)
+
+	require.NoError(t, publisher.Flush(context.Background()))
+	events, err := store.ListAll(context.Background())
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	return events[0]
+}
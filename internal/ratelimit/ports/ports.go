@@ -33,11 +33,14 @@ type AllowlistStore interface {
 	// Add creates a new allowlist entry.
 	Add(ctx context.Context, entry *models.AllowlistEntry) error
 
-	// Remove deletes an allowlist entry.
+	// Remove deletes an allowlist entry. Returns sentinel.ErrNotFound when no entry matches.
 	Remove(ctx context.Context, entryType models.AllowlistEntryType, identifier string) error
 
-	// List returns all allowlist entries.
+	// List returns all active allowlist entries, newest first with ties broken by ID.
 	List(ctx context.Context) ([]*models.AllowlistEntry, error)
+
+	// PurgeExpired deletes entries expired as of now and returns how many were removed.
+	PurgeExpired(ctx context.Context, now time.Time) (int, error)
 }
 
 // AuthLockoutStore manages authentication failure tracking and lockouts.
@@ -92,7 +95,17 @@ type QuotaStore interface {
 	// IncrementUsage adds to the usage counter for an API key.
 	IncrementUsage(ctx context.Context, apiKeyID id.APIKeyID, count int) (*models.APIKeyQuota, error)
 
-	// ResetQuota clears the usage counter for an API key.
+	// RecordOverage adds billable overage units for an API key.
+	RecordOverage(ctx context.Context, apiKeyID id.APIKeyID, units int) (*models.APIKeyQuota, error)
+
+	// RolloverPeriod starts the calendar month of now if the current period has
+	// ended, zeroing usage and overage; a still-current period is left unchanged.
+	RolloverPeriod(ctx context.Context, apiKeyID id.APIKeyID, now time.Time) (*models.APIKeyQuota, error)
+
+	// SetGraceUntil tolerates over-limit usage for an API key until the given time.
+	SetGraceUntil(ctx context.Context, apiKeyID id.APIKeyID, until time.Time) error
+
+	// ResetQuota clears the usage and overage counters for an API key.
 	ResetQuota(ctx context.Context, apiKeyID id.APIKeyID) error
 
 	// ListQuotas returns all quota records.
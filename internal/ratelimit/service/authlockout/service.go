@@ -20,6 +20,10 @@
 //
 // The composite key (username:IP) prevents cross-IP attacks while allowing
 // legitimate multi-device access.
+//
+// An optional SpikeDetector adds population-level defense: when the failure
+// rate across all accounts spikes, every check requires CAPTCHA and the
+// per-account attempt limit is tightened until the spike subsides.
 package authlockout
 
 import (
@@ -61,6 +65,7 @@ type Service struct {
 	auditPublisher observability.AuditPublisher
 	logger         *slog.Logger
 	config         *config.AuthLockoutConfig
+	spikes         *SpikeDetector
 }
 
 // Option configures a Service instance.
@@ -87,6 +92,13 @@ func WithConfig(cfg *config.AuthLockoutConfig) Option {
 	}
 }
 
+// WithSpikeDetector enables population-level brute-force detection.
+func WithSpikeDetector(detector *SpikeDetector) Option {
+	return func(s *Service) {
+		s.spikes = detector
+	}
+}
+
 // New creates an auth lockout service with the given store and options.
 func New(store Store, opts ...Option) (*Service, error) {
 	if store == nil {
@@ -110,9 +122,10 @@ func New(store Store, opts ...Option) (*Service, error) {
 // Call this BEFORE validating credentials to enforce rate limits.
 //
 // Returns:
-//   - Allowed=true with progressive backoff delay (RetryAfter in milliseconds)
+//   - Allowed=true with the progressive backoff delay in BackoffMs
 //   - Allowed=false if hard locked or sliding window exceeded
-//   - RequiresCaptcha=true after 3 consecutive lockouts in 24 hours
+//   - RequiresCaptcha=true after 3 consecutive lockouts in 24 hours, or for
+//     everyone while a population-wide failure spike is being defended against
 //
 // Uses constant-time behavior to prevent timing-based user enumeration.
 func (s *Service) Check(ctx context.Context, identifier, ip string) (*models.AuthRateLimitResult, error) {
@@ -130,6 +143,11 @@ func (s *Service) Check(ctx context.Context, identifier, ip string) (*models.Aut
 	}
 
 	now := requestcontext.Now(ctx)
+	elevated := s.spikes != nil && s.spikes.Elevated(now)
+	attemptsPerWindow := s.config.AttemptsPerWindow
+	if elevated {
+		attemptsPerWindow = min(attemptsPerWindow, s.spikes.ElevatedAttemptsPerWindow())
+	}
 
 	// Check if currently hard-locked (FR-2b: "hard lock for 15 minutes")
 	if record.IsLockedAt(now) {
@@ -139,23 +157,26 @@ func (s *Service) Check(ctx context.Context, identifier, ip string) (*models.Aut
 			"ip", privacy.AnonymizeIP(ip),
 			"locked_until", record.LockedUntil,
 		)
-		return s.buildAuthResult(false, 0, 0, retryAfter, *record.LockedUntil, record), nil
+		return s.buildAuthResult(false, 0, 0, retryAfter, *record.LockedUntil, record, elevated), nil
 	}
 
 	// Check failure count against sliding window (FR-2b: "5 attempts/15 min")
-	if record.IsAttemptLimitReached(s.config.AttemptsPerWindow) {
+	if record.IsAttemptLimitReached(attemptsPerWindow) {
 		// Block - too many attempts in window
 		resetAt := s.config.BackoffPolicy().ResetTime(record.LastFailureAt)
 		retryAfter := max(int(resetAt.Sub(now).Seconds()), 0)
-		return s.buildAuthResult(false, 0, 0, retryAfter, resetAt, record), nil
+		return s.buildAuthResult(false, 0, 0, retryAfter, resetAt, record, elevated), nil
 	}
 
 	// Apply progressive backoff (FR-2b: "250ms → 500ms → 1s")
 	// Calculate backoff even for zero failures to maintain constant-time behavior
+	// The delay is returned rather than slept so the request goroutine never blocks.
 	delay := s.GetProgressiveBackoff(record.FailureCount)
-	remaining := min(record.RemainingAttempts(s.config.AttemptsPerWindow), s.config.AttemptsPerWindow)
+	remaining := min(record.RemainingAttempts(attemptsPerWindow), attemptsPerWindow)
 
-	return s.buildAuthResult(true, s.config.AttemptsPerWindow, remaining, int(delay.Milliseconds()), now.Add(s.config.WindowDuration), record), nil
+	result := s.buildAuthResult(true, attemptsPerWindow, remaining, 0, now.Add(s.config.WindowDuration), record, elevated)
+	result.BackoffMs = int(delay.Milliseconds())
+	return result, nil
 }
 
 // RecordFailure increments failure counters after a failed authentication attempt.
@@ -169,9 +190,11 @@ func (s *Service) Check(ctx context.Context, identifier, ip string) (*models.Aut
 //   - Applies hard lock if daily threshold (10 failures) is reached
 //   - Sets CAPTCHA requirement after 3 consecutive lockouts in 24 hours
 //   - Emits audit event when hard lock is triggered
+//   - Feeds the spike detector and emits a critical event when it trips
 func (s *Service) RecordFailure(ctx context.Context, identifier, ip string) (*models.AuthLockout, error) {
 	now := requestcontext.Now(ctx)
 	key := models.NewAuthLockoutKey(identifier, ip).String()
+	s.recordSpikeFailure(ctx, now)
 
 	// Try atomic path if store supports it (prevents TOCTOU races)
 	if atomicStore, ok := s.store.(AtomicStore); ok {
@@ -263,6 +286,25 @@ func (s *Service) recordFailureNonAtomic(ctx context.Context, key, identifier, i
 	return current, nil
 }
 
+// recordSpikeFailure counts the failure toward the population-wide rate.
+// It runs before the per-account write so a store outage doesn't blind the detector.
+func (s *Service) recordSpikeFailure(ctx context.Context, now time.Time) {
+	if s.spikes == nil {
+		return
+	}
+	spike, tripped := s.spikes.RecordFailure(now)
+	if !tripped {
+		return
+	}
+	observability.LogCritical(ctx, s.logger, s.auditPublisher, "auth_failure_spike_detected",
+		"reason", "failure_rate_spike",
+		"failures", spike.Failures,
+		"threshold", spike.Threshold,
+		"baseline", spike.Baseline,
+		"elevated_until", spike.ElevatedUntil,
+	)
+}
+
 // Clear resets the lockout record after successful authentication.
 // Call this after the user successfully logs in to reset their failure window.
 // Does NOT reset daily failure counts or CAPTCHA requirements (those reset via cleanup worker).
@@ -281,6 +323,17 @@ func (s *Service) Clear(ctx context.Context, identifier, ip string) error {
 	return nil
 }
 
+// AttemptsRemaining reports how many failures record may accrue before the
+// window limit blocks further attempts, and when that window resets. The
+// tighter limit applies while a failure spike is active.
+func (s *Service) AttemptsRemaining(ctx context.Context, record *models.AuthLockout) (int, time.Time) {
+	attemptsPerWindow := s.config.AttemptsPerWindow
+	if s.spikes != nil && s.spikes.Elevated(requestcontext.Now(ctx)) {
+		attemptsPerWindow = min(attemptsPerWindow, s.spikes.ElevatedAttemptsPerWindow())
+	}
+	return record.RemainingAttempts(attemptsPerWindow), s.config.BackoffPolicy().ResetTime(record.LastFailureAt)
+}
+
 // GetProgressiveBackoff calculates the delay before the next attempt.
 // Implements exponential backoff: 250ms → 500ms → 1s (PRD-017 FR-2b).
 func (s *Service) GetProgressiveBackoff(failureCount int) time.Duration {
@@ -288,7 +341,8 @@ func (s *Service) GetProgressiveBackoff(failureCount int) time.Duration {
 }
 
 // buildAuthResult constructs an AuthRateLimitResult with common fields from the lockout record.
-func (s *Service) buildAuthResult(allowed bool, limit, remaining, retryAfter int, resetAt time.Time, record *models.AuthLockout) *models.AuthRateLimitResult {
+// elevated forces CAPTCHA regardless of the record while a failure spike is active.
+func (s *Service) buildAuthResult(allowed bool, limit, remaining, retryAfter int, resetAt time.Time, record *models.AuthLockout, elevated bool) *models.AuthRateLimitResult {
 	return &models.AuthRateLimitResult{
 		RateLimitResult: models.RateLimitResult{
 			Allowed:    allowed,
@@ -297,7 +351,7 @@ func (s *Service) buildAuthResult(allowed bool, limit, remaining, retryAfter int
 			ResetAt:    resetAt,
 			RetryAfter: retryAfter,
 		},
-		RequiresCaptcha: record.RequiresCaptcha,
+		RequiresCaptcha: record.RequiresCaptcha || elevated,
 		FailureCount:    record.FailureCount,
 	}
 }
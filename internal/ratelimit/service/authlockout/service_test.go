@@ -2,6 +2,7 @@ package authlockout
 
 import (
 	"context"
+	"fmt"
 	"io"
 	"log/slog"
 	"testing"
@@ -12,6 +13,9 @@ import (
 	"credo/internal/ratelimit/config"
 	"credo/internal/ratelimit/models"
 	rwauthlockoutStore "credo/internal/ratelimit/store/authlockout"
+	"credo/pkg/platform/audit"
+	"credo/pkg/platform/audit/publishers/security"
+	auditmemory "credo/pkg/platform/audit/store/memory"
 	"credo/pkg/requestcontext"
 )
 
@@ -134,6 +138,53 @@ func (s *AuthLockoutServiceSecuritySuite) TestProgressiveBackoff() {
 	})
 }
 
+// TestCheckReturnsBackoff verifies Check reports the delay instead of sleeping,
+// so the request path never blocks on the backoff.
+func (s *AuthLockoutServiceSecuritySuite) TestCheckReturnsBackoff() {
+	ctx := context.Background()
+	// Raise the window and hard-lock limits so 10 failures still yield an allowed check
+	cfg := *s.config
+	cfg.AttemptsPerWindow = 20
+	cfg.HardLockThreshold = 50
+	svc, err := New(rwauthlockoutStore.New(), WithConfig(&cfg))
+	s.Require().NoError(err)
+
+	for _, tc := range []struct {
+		failures  int
+		backoffMs int
+	}{
+		{failures: 1, backoffMs: 250},
+		{failures: 2, backoffMs: 500},
+		{failures: 3, backoffMs: 1000},
+		{failures: 10, backoffMs: 1000},
+	} {
+		identifier := fmt.Sprintf("backoff-%d@example.com", tc.failures)
+		for range tc.failures {
+			_, err := svc.RecordFailure(ctx, identifier, "192.168.1.70")
+			s.Require().NoError(err)
+		}
+
+		result, err := svc.Check(ctx, identifier, "192.168.1.70")
+		s.Require().NoError(err)
+		s.True(result.Allowed, "%d failures", tc.failures)
+		s.Equal(tc.backoffMs, result.BackoffMs, "%d failures", tc.failures)
+		s.Zero(result.RetryAfter, "an allowed attempt has nothing to retry")
+	}
+}
+
+func (s *AuthLockoutServiceSecuritySuite) TestAttemptsRemaining() {
+	ctx := context.Background()
+
+	for failures := 1; failures <= s.config.AttemptsPerWindow; failures++ {
+		record, err := s.service.RecordFailure(ctx, "remaining@example.com", "192.168.1.80")
+		s.Require().NoError(err)
+
+		remaining, resetAt := s.service.AttemptsRemaining(ctx, record)
+		s.Equal(s.config.AttemptsPerWindow-failures, remaining, "%d failures", failures)
+		s.Equal(record.LastFailureAt.Add(s.config.WindowDuration), resetAt)
+	}
+}
+
 // =============================================================================
 // Daily Failure Persistence Tests (Security)
 // =============================================================================
@@ -230,6 +281,41 @@ func (s *AuthLockoutServiceSecuritySuite) TestHardLock() {
 	})
 }
 
+// =============================================================================
+// Composite Key Tests
+// =============================================================================
+
+func (s *AuthLockoutServiceSecuritySuite) TestCompositeKeyIsolation() {
+	ctx := context.Background()
+	identifier := "shared@example.com"
+	attackerIP := "192.168.1.80"
+	ownerIP := "192.168.1.81"
+
+	s.Run("same identifier from different IPs has independent counters", func() {
+		for i := 0; i < s.config.AttemptsPerWindow; i++ {
+			_, err := s.service.RecordFailure(ctx, identifier, attackerIP)
+			s.Require().NoError(err)
+		}
+
+		attacker, err := s.service.Check(ctx, identifier, attackerIP)
+		s.Require().NoError(err)
+		s.False(attacker.Allowed, "attacker IP should be locked out")
+
+		owner, err := s.service.Check(ctx, identifier, ownerIP)
+		s.Require().NoError(err)
+		s.True(owner.Allowed, "other IP must not inherit the attacker's failures")
+		s.Equal(s.config.AttemptsPerWindow, owner.Remaining)
+	})
+
+	s.Run("clear only resets its own IP", func() {
+		s.Require().NoError(s.service.Clear(ctx, identifier, ownerIP))
+
+		attacker, err := s.service.Check(ctx, identifier, attackerIP)
+		s.Require().NoError(err)
+		s.False(attacker.Allowed)
+	})
+}
+
 // =============================================================================
 // Clear Failures Tests
 // =============================================================================
@@ -255,3 +341,117 @@ func (s *AuthLockoutServiceSecuritySuite) TestClearFailures() {
 		s.Equal(s.config.AttemptsPerWindow, result.Remaining)
 	})
 }
+
+// =============================================================================
+// Population-Level Spike Detection Tests (Security)
+// =============================================================================
+// Security test: Distributed attacks stay under every per-account lockout, so
+// only the global failure rate reveals them. These tests drive the clock
+// through a learned baseline and then a spike across many accounts.
+
+func (s *AuthLockoutServiceSecuritySuite) newSpikeService() (*Service, *auditmemory.InMemoryStore, *security.Publisher) {
+	auditStore := auditmemory.NewInMemoryStore()
+	publisher := security.New(auditStore)
+	s.T().Cleanup(func() { _ = publisher.Close() })
+
+	svc, err := New(
+		rwauthlockoutStore.New(),
+		WithConfig(s.config),
+		WithAuditPublisher(publisher),
+		WithSpikeDetector(NewSpikeDetector(config.AuthAnomalyConfig{
+			BucketDuration:            time.Minute,
+			BaselineAlpha:             0.1,
+			SpikeMultiplier:           5,
+			MinFailures:               20,
+			ElevatedDuration:          15 * time.Minute,
+			ElevatedAttemptsPerWindow: 2,
+		})),
+	)
+	s.Require().NoError(err)
+	return svc, auditStore, publisher
+}
+
+// failAcrossAccounts records n failures at now, each against a distinct account
+// so no single account comes near its own lockout.
+func (s *AuthLockoutServiceSecuritySuite) failAcrossAccounts(svc *Service, now time.Time, n int) {
+	ctx := requestcontext.WithTime(context.Background(), now)
+	for i := range n {
+		_, err := svc.RecordFailure(ctx, fmt.Sprintf("user-%d-%d@example.com", now.Unix(), i), "203.0.113.7")
+		s.Require().NoError(err)
+	}
+}
+
+// warmBaseline records perMinute failures for each of minutes buckets before start.
+func (s *AuthLockoutServiceSecuritySuite) warmBaseline(svc *Service, start time.Time, minutes, perMinute int) {
+	for m := minutes; m > 0; m-- {
+		s.failAcrossAccounts(svc, start.Add(-time.Duration(m)*time.Minute), perMinute)
+	}
+}
+
+func (s *AuthLockoutServiceSecuritySuite) spikeEvents(auditStore *auditmemory.InMemoryStore, publisher *security.Publisher) []audit.Event {
+	s.Require().NoError(publisher.Flush(context.Background()))
+	all, err := auditStore.ListAll(context.Background())
+	s.Require().NoError(err)
+	var events []audit.Event
+	for _, e := range all {
+		if e.Action == string(audit.EventAuthFailureSpike) {
+			events = append(events, e)
+		}
+	}
+	return events
+}
+
+func (s *AuthLockoutServiceSecuritySuite) TestFailureSpikeDetection() {
+	start := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	s.Run("normal failure rate does not trip global defenses", func() {
+		svc, auditStore, publisher := s.newSpikeService()
+		s.warmBaseline(svc, start, 30, 10)
+		s.failAcrossAccounts(svc, start, 12)
+
+		result, err := svc.Check(requestcontext.WithTime(context.Background(), start), "bystander@example.com", "198.51.100.1")
+		s.Require().NoError(err)
+		s.True(result.Allowed)
+		s.False(result.RequiresCaptcha)
+		s.Equal(s.config.AttemptsPerWindow, result.Limit)
+		s.Empty(s.spikeEvents(auditStore, publisher))
+	})
+
+	s.Run("failure spike forces captcha and tightens limits for everyone", func() {
+		svc, auditStore, publisher := s.newSpikeService()
+		s.warmBaseline(svc, start, 30, 10)
+		s.failAcrossAccounts(svc, start, 60)
+
+		ctx := requestcontext.WithTime(context.Background(), start.Add(time.Second))
+		result, err := svc.Check(ctx, "bystander@example.com", "198.51.100.1")
+		s.Require().NoError(err)
+		s.True(result.Allowed, "untouched accounts can still sign in")
+		s.True(result.RequiresCaptcha, "captcha is forced for all auth while elevated")
+		s.Equal(2, result.Limit)
+
+		_, err = svc.RecordFailure(ctx, "victim@example.com", "198.51.100.2")
+		s.Require().NoError(err)
+		_, err = svc.RecordFailure(ctx, "victim@example.com", "198.51.100.2")
+		s.Require().NoError(err)
+		result, err = svc.Check(ctx, "victim@example.com", "198.51.100.2")
+		s.Require().NoError(err)
+		s.False(result.Allowed, "two failures exhaust the tightened window")
+
+		events := s.spikeEvents(auditStore, publisher)
+		s.Require().Len(events, 1, "a continuing spike is reported once")
+		s.Equal(audit.CategorySecurity, events[0].Category)
+		s.Equal("failure_rate_spike", events[0].Reason)
+	})
+
+	s.Run("defenses lapse once the spike subsides", func() {
+		svc, _, _ := s.newSpikeService()
+		s.warmBaseline(svc, start, 30, 10)
+		s.failAcrossAccounts(svc, start, 60)
+
+		ctx := requestcontext.WithTime(context.Background(), start.Add(16*time.Minute))
+		result, err := svc.Check(ctx, "bystander@example.com", "198.51.100.1")
+		s.Require().NoError(err)
+		s.False(result.RequiresCaptcha)
+		s.Equal(s.config.AttemptsPerWindow, result.Limit)
+	})
+}
@@ -0,0 +1,131 @@
+package authlockout
+
+import (
+	"math"
+	"sync"
+	"time"
+
+	"credo/internal/ratelimit/config"
+)
+
+// SpikeDetector watches the auth-failure rate across the whole user population
+// and trips elevated defenses when it spikes beyond a learned baseline. It
+// catches distributed attacks that stay under every per-account lockout by
+// spreading a few attempts across many accounts.
+//
+// The baseline is an exponentially weighted moving average of failures per
+// bucket. Buckets completed while defenses are elevated are not learned, so a
+// sustained attack cannot raise the baseline until it looks normal.
+//
+// State is per instance and in memory: behind a load balancer each instance
+// sees a proportional share of both normal and attack traffic, so the ratio
+// against the baseline holds without cross-instance coordination.
+type SpikeDetector struct {
+	mu            sync.Mutex
+	config        config.AuthAnomalyConfig
+	bucketStart   time.Time
+	bucketCount   int
+	baseline      float64
+	elevatedUntil time.Time
+}
+
+// Spike describes the failure bucket that tripped elevated defenses.
+type Spike struct {
+	Failures      int       // Failures counted in the current bucket
+	Threshold     int       // Failures per bucket that trips defenses
+	Baseline      float64   // Learned failures per bucket before the spike
+	ElevatedUntil time.Time // When elevated defenses lapse unless the spike continues
+}
+
+// NewSpikeDetector creates a detector. Zero-valued fields in cfg fall back to defaults.
+func NewSpikeDetector(cfg config.AuthAnomalyConfig) *SpikeDetector {
+	defaults := config.DefaultConfig().AuthAnomaly
+	if cfg.BucketDuration <= 0 {
+		cfg.BucketDuration = defaults.BucketDuration
+	}
+	if cfg.BaselineAlpha <= 0 || cfg.BaselineAlpha > 1 {
+		cfg.BaselineAlpha = defaults.BaselineAlpha
+	}
+	if cfg.SpikeMultiplier <= 0 {
+		cfg.SpikeMultiplier = defaults.SpikeMultiplier
+	}
+	if cfg.MinFailures <= 0 {
+		cfg.MinFailures = defaults.MinFailures
+	}
+	if cfg.ElevatedDuration <= 0 {
+		cfg.ElevatedDuration = defaults.ElevatedDuration
+	}
+	if cfg.ElevatedAttemptsPerWindow <= 0 {
+		cfg.ElevatedAttemptsPerWindow = defaults.ElevatedAttemptsPerWindow
+	}
+	return &SpikeDetector{config: cfg}
+}
+
+// RecordFailure counts one auth failure at now. It returns the spike when this
+// failure newly trips elevated defenses; a spike that continues while already
+// elevated extends the elevation without being reported again.
+func (d *SpikeDetector) RecordFailure(now time.Time) (*Spike, bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.roll(now)
+	d.bucketCount++
+
+	threshold := d.threshold()
+	if d.bucketCount < threshold {
+		return nil, false
+	}
+	wasElevated := now.Before(d.elevatedUntil)
+	d.elevatedUntil = now.Add(d.config.ElevatedDuration)
+	if wasElevated {
+		return nil, false
+	}
+	return &Spike{
+		Failures:      d.bucketCount,
+		Threshold:     threshold,
+		Baseline:      d.baseline,
+		ElevatedUntil: d.elevatedUntil,
+	}, true
+}
+
+// Elevated reports whether elevated defenses are active at now.
+func (d *SpikeDetector) Elevated(now time.Time) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return now.Before(d.elevatedUntil)
+}
+
+// ElevatedAttemptsPerWindow is the tightened per-account attempt limit applied while elevated.
+func (d *SpikeDetector) ElevatedAttemptsPerWindow() int {
+	return d.config.ElevatedAttemptsPerWindow
+}
+
+// roll closes the current bucket when now falls in a later one, folding the
+// completed bucket and any empty buckets in between into the baseline.
+func (d *SpikeDetector) roll(now time.Time) {
+	start := now.Truncate(d.config.BucketDuration)
+	if d.bucketStart.IsZero() {
+		d.bucketStart = start
+		return
+	}
+	if !start.After(d.bucketStart) {
+		return
+	}
+
+	alpha := d.config.BaselineAlpha
+	if !d.bucketStart.Before(d.elevatedUntil) {
+		// Only buckets that began after elevation lapsed are normal traffic.
+		d.baseline = alpha*float64(d.bucketCount) + (1-alpha)*d.baseline
+	}
+	if idle := int(start.Sub(d.bucketStart)/d.config.BucketDuration) - 1; idle > 0 {
+		d.baseline *= math.Pow(1-alpha, float64(idle))
+	}
+	d.bucketStart = start
+	d.bucketCount = 0
+}
+
+// threshold is the failure count per bucket that trips defenses: a multiple of
+// the baseline, floored so quiet periods don't trip on a handful of typos.
+func (d *SpikeDetector) threshold() int {
+	return max(d.config.MinFailures, int(math.Ceil(d.config.SpikeMultiplier*d.baseline)))
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"sync/atomic"
 
 	"credo/internal/ratelimit/config"
 	"credo/internal/ratelimit/observability"
@@ -18,9 +19,11 @@ type Store interface {
 
 type Service struct {
 	store          Store
+	instance       Store // optional per-instance tier checked before the shared store
 	auditPublisher observability.AuditPublisher
 	logger         *slog.Logger
 	config         *config.GlobalLimit
+	throttled      atomic.Bool // true while requests are being rejected; gates the audit event
 }
 
 type Option func(*Service)
@@ -37,6 +40,15 @@ func WithAuditPublisher(publisher observability.AuditPublisher) Option {
 	}
 }
 
+// WithInstanceStore adds a per-instance tier in front of the shared store, so a
+// single instance is capped at PerInstancePerSecond before it contributes to the
+// cluster-wide count. The shared store should be configured with GlobalPerSecond.
+func WithInstanceStore(store Store) Option {
+	return func(s *Service) {
+		s.instance = store
+	}
+}
+
 func WithConfig(cfg *config.GlobalLimit) Option {
 	return func(s *Service) {
 		s.config = cfg
@@ -62,22 +74,49 @@ func New(store Store, opts ...Option) (*Service, error) {
 }
 
 // Check returns whether the request is allowed (true = allow, false = block).
-// It increments the global counter and checks against the configured limit.
+// It increments the per-instance counter (when configured) and then the shared
+// counter, blocking when either limit is exceeded. The audit event is emitted
+// only on the transition into the throttled state, not for every rejected request.
 func (s *Service) Check(ctx context.Context) (bool, error) {
+	if s.instance != nil {
+		count, blocked, err := s.instance.IncrementGlobal(ctx)
+		if err != nil {
+			return false, dErrors.Wrap(err, dErrors.CodeInternal, "failed to increment instance throttle")
+		}
+		if blocked {
+			s.markThrottled(ctx, "instance", count, s.config.PerInstancePerSecond)
+			return false, nil
+		}
+	}
+
 	count, blocked, err := s.store.IncrementGlobal(ctx)
 	if err != nil {
 		return false, dErrors.Wrap(err, dErrors.CodeInternal, "failed to increment global throttle")
 	}
-
 	if blocked {
-		observability.LogAudit(ctx, s.logger, s.auditPublisher, "global_throttle_triggered",
-			"current_count", count,
-			"global_limit", s.config.GlobalPerSecond,
-		)
+		limit := s.config.GlobalPerSecond
+		if s.instance == nil {
+			// Single-tier deployments use the store as the per-instance counter.
+			limit = s.config.PerInstancePerSecond
+		}
+		s.markThrottled(ctx, "global", count, limit)
+		return false, nil
 	}
 
-	// Return allowed semantics: !blocked means allowed
-	return !blocked, nil
+	s.throttled.Store(false)
+	return true, nil
+}
+
+// markThrottled records a rejection and audits it if throttling just started.
+func (s *Service) markThrottled(ctx context.Context, scope string, count, limit int) {
+	if !s.throttled.CompareAndSwap(false, true) {
+		return
+	}
+	observability.LogAudit(ctx, s.logger, s.auditPublisher, "global_throttle_exceeded",
+		"scope", scope,
+		"current_count", count,
+		"limit", limit,
+	)
 }
 
 func (s *Service) GetCount(ctx context.Context) (int, error) {
@@ -0,0 +1,140 @@
+package globalthrottle
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"credo/internal/ratelimit/config"
+	throttleStore "credo/internal/ratelimit/store/globalthrottle"
+	"credo/pkg/platform/audit/publishers/security"
+	auditmemory "credo/pkg/platform/audit/store/memory"
+	"credo/pkg/requestcontext"
+)
+
+// =============================================================================
+// Global Throttle Service Test Suite
+// =============================================================================
+// Justification for unit tests: Tier ordering and transition-only audit emission
+// cannot be observed through E2E tests without generating real traffic floods.
+
+type GlobalThrottleServiceSuite struct {
+	suite.Suite
+	baseTime   time.Time
+	auditStore *auditmemory.InMemoryStore
+	publisher  *security.Publisher
+}
+
+func TestGlobalThrottleServiceSuite(t *testing.T) {
+	suite.Run(t, new(GlobalThrottleServiceSuite))
+}
+
+func (s *GlobalThrottleServiceSuite) SetupTest() {
+	s.baseTime = time.Date(2025, 12, 11, 10, 0, 0, 0, time.UTC)
+	s.auditStore = auditmemory.NewInMemoryStore()
+	s.publisher = security.New(s.auditStore)
+	s.T().Cleanup(func() { _ = s.publisher.Close() })
+}
+
+func (s *GlobalThrottleServiceSuite) newService(shared Store, opts ...Option) *Service {
+	cfg := &config.GlobalLimit{PerInstancePerSecond: 2, GlobalPerSecond: 3, PerInstancePerHour: 1000, Window: time.Second}
+	opts = append([]Option{WithConfig(cfg), WithAuditPublisher(s.publisher)}, opts...)
+	svc, err := New(shared, opts...)
+	s.Require().NoError(err)
+	return svc
+}
+
+func (s *GlobalThrottleServiceSuite) auditActions() []string {
+	s.Require().NoError(s.publisher.Flush(context.Background()))
+	events, err := s.auditStore.ListAll(context.Background())
+	s.Require().NoError(err)
+	actions := make([]string, 0, len(events))
+	for _, e := range events {
+		actions = append(actions, e.Action)
+	}
+	return actions
+}
+
+func (s *GlobalThrottleServiceSuite) check(svc *Service, at time.Time) bool {
+	allowed, err := svc.Check(requestcontext.WithTime(context.Background(), at))
+	s.Require().NoError(err)
+	return allowed
+}
+
+func (s *GlobalThrottleServiceSuite) TestNew() {
+	s.Run("nil store returns error", func() {
+		_, err := New(nil)
+		s.ErrorContains(err, "global throttle store is required")
+	})
+}
+
+func (s *GlobalThrottleServiceSuite) TestCheck() {
+	s.Run("instance tier blocks before the shared store is counted", func() {
+		shared := throttleStore.New(throttleStore.WithPerSecondLimit(3))
+		instance := throttleStore.New(throttleStore.WithPerSecondLimit(2))
+		svc := s.newService(shared, WithInstanceStore(instance))
+
+		s.True(s.check(svc, s.baseTime))
+		s.True(s.check(svc, s.baseTime))
+		s.False(s.check(svc, s.baseTime))
+
+		count, err := shared.GetGlobalCount(requestcontext.WithTime(context.Background(), s.baseTime))
+		s.Require().NoError(err)
+		s.Equal(2, count, "requests rejected by the instance tier must not consume global capacity")
+	})
+
+	s.Run("shared tier blocks when the cluster limit is reached", func() {
+		shared := throttleStore.New(throttleStore.WithPerSecondLimit(1))
+		instance := throttleStore.New(throttleStore.WithPerSecondLimit(10))
+		svc := s.newService(shared, WithInstanceStore(instance))
+
+		s.True(s.check(svc, s.baseTime))
+		s.False(s.check(svc, s.baseTime))
+	})
+
+	s.Run("new window allows traffic again", func() {
+		svc := s.newService(throttleStore.New(throttleStore.WithPerSecondLimit(1)))
+
+		s.True(s.check(svc, s.baseTime))
+		s.False(s.check(svc, s.baseTime))
+		s.True(s.check(svc, s.baseTime.Add(time.Second)))
+	})
+
+	s.Run("shared store failure is returned for the middleware to fail open", func() {
+		instance := throttleStore.New(throttleStore.WithPerSecondLimit(10))
+		svc := s.newService(failingStore{}, WithInstanceStore(instance))
+
+		allowed, err := svc.Check(requestcontext.WithTime(context.Background(), s.baseTime))
+		s.Error(err)
+		s.False(allowed)
+	})
+}
+
+// failingStore stands in for an unreachable shared store such as Redis.
+type failingStore struct{}
+
+func (failingStore) IncrementGlobal(context.Context) (int, bool, error) {
+	return 0, false, errors.New("connection refused")
+}
+
+func (failingStore) GetGlobalCount(context.Context) (int, error) {
+	return 0, errors.New("connection refused")
+}
+
+func (s *GlobalThrottleServiceSuite) TestAuditOnTransition() {
+	svc := s.newService(throttleStore.New(throttleStore.WithPerSecondLimit(1)))
+
+	s.True(s.check(svc, s.baseTime))
+	for range 5 {
+		s.False(s.check(svc, s.baseTime))
+	}
+	s.Equal([]string{"global_throttle_exceeded"}, s.auditActions(), "sustained throttling audits once")
+
+	s.True(s.check(svc, s.baseTime.Add(time.Second)))
+	s.False(s.check(svc, s.baseTime.Add(time.Second)))
+	s.Equal([]string{"global_throttle_exceeded", "global_throttle_exceeded"}, s.auditActions(),
+		"re-entering the throttled state audits again")
+}
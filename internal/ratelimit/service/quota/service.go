@@ -1,33 +1,40 @@
 // Package quota manages monthly API key usage limits for partner integrations.
 //
 // Partner API keys have tiered monthly quotas (Free, Starter, Business, Enterprise).
-// Quotas reset on the first day of each calendar month.
+// Periods are calendar months; once a quota's PeriodEnd passes, the next
+// request rolls it into the current month with usage and overage at zero. Tiers that allow overage keep serving past the
+// limit and accumulate OverageUsage for billing.
 //
 // Usage:
 //
 //	svc, _ := quota.New(store)
-//	quota, _ := svc.Check(ctx, apiKeyID)
-//	if quota.IsOverQuota() && !quota.OverageAllowed {
+//	result, err := svc.Enforce(ctx, apiKeyID)
+//	if err == nil && !result.Allowed {
 //	    // Return 429 Quota Exceeded
 //	}
-//	svc.Increment(ctx, apiKeyID, 1)  // Track usage
+//	// Emit X-Quota-* headers from result.Quota
 package quota
 
 import (
 	"context"
 	"fmt"
 	"log/slog"
+	"time"
 
 	"credo/internal/ratelimit/models"
 	"credo/internal/ratelimit/observability"
 	id "credo/pkg/domain"
 	dErrors "credo/pkg/domain-errors"
+	"credo/pkg/requestcontext"
 )
 
 // Store manages API key usage quotas.
 type Store interface {
 	GetQuota(ctx context.Context, apiKeyID id.APIKeyID) (*models.APIKeyQuota, error)
 	IncrementUsage(ctx context.Context, apiKeyID id.APIKeyID, count int) (*models.APIKeyQuota, error)
+	RecordOverage(ctx context.Context, apiKeyID id.APIKeyID, units int) (*models.APIKeyQuota, error)
+	RolloverPeriod(ctx context.Context, apiKeyID id.APIKeyID, now time.Time) (*models.APIKeyQuota, error)
+	SetGraceUntil(ctx context.Context, apiKeyID id.APIKeyID, until time.Time) error
 	ResetQuota(ctx context.Context, apiKeyID id.APIKeyID) error
 	ListQuotas(ctx context.Context) ([]*models.APIKeyQuota, error)
 	UpdateTier(ctx context.Context, apiKeyID id.APIKeyID, tier models.QuotaTier) error
@@ -38,6 +45,7 @@ type Service struct {
 	store          Store
 	auditPublisher observability.AuditPublisher
 	logger         *slog.Logger
+	gracePeriod    bool
 }
 
 // Option configures a Service instance.
@@ -57,6 +65,14 @@ func WithAuditPublisher(publisher observability.AuditPublisher) Option {
 	}
 }
 
+// WithGracePeriod tolerates usage above the new limit after a tier downgrade
+// until the current period ends, instead of blocking or billing the key at once.
+func WithGracePeriod(enabled bool) Option {
+	return func(s *Service) {
+		s.gracePeriod = enabled
+	}
+}
+
 // New creates a quota service with the given store and options.
 func New(store Store, opts ...Option) (*Service, error) {
 	if store == nil {
@@ -74,7 +90,8 @@ func New(store Store, opts ...Option) (*Service, error) {
 	return svc, nil
 }
 
-// Check retrieves the current quota for an API key.
+// Check retrieves the current quota for an API key, first rolling it into the
+// current calendar month if its period has ended.
 // Returns CodeNotFound if the API key has no quota record.
 func (s *Service) Check(ctx context.Context, apiKeyID id.APIKeyID) (*models.APIKeyQuota, error) {
 	quota, err := s.store.GetQuota(ctx, apiKeyID)
@@ -84,30 +101,124 @@ func (s *Service) Check(ctx context.Context, apiKeyID id.APIKeyID) (*models.APIK
 	if quota == nil {
 		return nil, dErrors.Wrap(fmt.Errorf("quota not found for API key %s", apiKeyID), dErrors.CodeNotFound, "quota not found")
 	}
-	return quota, nil
+	return s.rollPeriod(ctx, quota)
 }
 
-// Increment adds to the usage counter for an API key.
-// Emits an audit event when quota is exceeded (for billing/monitoring).
-func (s *Service) Increment(ctx context.Context, apiKeyID id.APIKeyID, count int) (*models.APIKeyQuota, error) {
-	quota, err := s.store.IncrementUsage(ctx, apiKeyID, count)
+// Enforce checks an API key's monthly quota and counts one request against it.
+//
+// A key over quota without overage is blocked: usage is not incremented, an
+// api_key_quota_exceeded audit event is emitted, and the result has Allowed=false
+// for the handler to map to 429. A key with overage proceeds; the request is
+// counted, added to OverageUsage, and an api_key_quota_overage audit event
+// records it. A quota past its PeriodEnd is reset before enforcement. A key in
+// downgrade grace (see WithGracePeriod) is allowed and counted without overage.
+// Returns CodeNotFound if the API key has no quota record.
+func (s *Service) Enforce(ctx context.Context, apiKeyID id.APIKeyID) (*models.APIKeyQuotaResult, error) {
+	quota, err := s.Check(ctx, apiKeyID)
 	if err != nil {
-		return nil, dErrors.Wrap(err, dErrors.CodeInternal, "failed to increment API key usage")
+		return nil, err
 	}
 
-	// Log if quota exceeded
-	if quota != nil && quota.CurrentUsage > quota.MonthlyLimit && quota.MonthlyLimit > 0 {
+	overage := quota.IsOverQuota() && !quota.InGrace(requestcontext.Now(ctx))
+	if overage && !quota.OverageAllowed {
 		observability.LogAudit(ctx, s.logger, s.auditPublisher, "api_key_quota_exceeded",
 			"api_key_id", apiKeyID,
 			"current_usage", quota.CurrentUsage,
 			"monthly_limit", quota.MonthlyLimit,
+			"tier", quota.Tier,
 		)
+		return &models.APIKeyQuotaResult{Allowed: false, Quota: quota}, nil
+	}
+
+	quota, err = s.store.IncrementUsage(ctx, apiKeyID, 1)
+	if err != nil {
+		return nil, dErrors.Wrap(err, dErrors.CodeInternal, "failed to increment API key usage")
+	}
+	if overage {
+		if quota, err = s.recordOverage(ctx, quota, 1); err != nil {
+			return nil, err
+		}
+	}
+	return &models.APIKeyQuotaResult{Allowed: true, Overage: overage, Quota: quota}, nil
+}
+
+// Increment adds to the usage counter for an API key without blocking.
+// Units past the monthly limit are recorded as overage when the tier allows it;
+// otherwise an audit event flags the key as over quota. A quota past its
+// PeriodEnd is reset before counting.
+func (s *Service) Increment(ctx context.Context, apiKeyID id.APIKeyID, count int) (*models.APIKeyQuota, error) {
+	existing, err := s.store.GetQuota(ctx, apiKeyID)
+	if err != nil {
+		return nil, dErrors.Wrap(err, dErrors.CodeInternal, "failed to get API key quota")
+	}
+	if existing != nil {
+		if _, err := s.rollPeriod(ctx, existing); err != nil {
+			return nil, err
+		}
+	}
+
+	quota, err := s.store.IncrementUsage(ctx, apiKeyID, count)
+	if err != nil {
+		return nil, dErrors.Wrap(err, dErrors.CodeInternal, "failed to increment API key usage")
+	}
+	if quota == nil || quota.MonthlyLimit < 0 || quota.CurrentUsage <= quota.MonthlyLimit {
+		return quota, nil
+	}
+	if quota.InGrace(requestcontext.Now(ctx)) {
+		return quota, nil
 	}
 
+	if quota.OverageAllowed {
+		// Only the units of this call that crossed the limit are billable
+		return s.recordOverage(ctx, quota, min(count, quota.CurrentUsage-quota.MonthlyLimit))
+	}
+	observability.LogAudit(ctx, s.logger, s.auditPublisher, "api_key_quota_exceeded",
+		"api_key_id", apiKeyID,
+		"current_usage", quota.CurrentUsage,
+		"monthly_limit", quota.MonthlyLimit,
+	)
 	return quota, nil
 }
 
-// Reset clears the usage counter for an API key (admin operation).
+// rollPeriod moves a quota whose billing period has ended into the calendar
+// month of the request time and returns the fresh record. The store re-checks
+// the period atomically, so concurrent requests at a boundary reset it once.
+func (s *Service) rollPeriod(ctx context.Context, quota *models.APIKeyQuota) (*models.APIKeyQuota, error) {
+	now := requestcontext.Now(ctx)
+	if !quota.PeriodEnded(now) {
+		return quota, nil
+	}
+	rolled, err := s.store.RolloverPeriod(ctx, quota.APIKeyID, now)
+	if err != nil {
+		return nil, dErrors.Wrap(err, dErrors.CodeInternal, "failed to start new quota period")
+	}
+	if rolled == nil {
+		return nil, dErrors.Wrap(fmt.Errorf("quota not found for API key %s", quota.APIKeyID), dErrors.CodeNotFound, "quota not found")
+	}
+	return rolled, nil
+}
+
+// recordOverage adds billable overage units and audits them for billing.
+func (s *Service) recordOverage(ctx context.Context, quota *models.APIKeyQuota, units int) (*models.APIKeyQuota, error) {
+	updated, err := s.store.RecordOverage(ctx, quota.APIKeyID, units)
+	if err != nil {
+		return nil, dErrors.Wrap(err, dErrors.CodeInternal, "failed to record API key overage")
+	}
+	if updated != nil {
+		quota = updated
+	}
+	observability.LogAudit(ctx, s.logger, s.auditPublisher, "api_key_quota_overage",
+		"api_key_id", quota.APIKeyID,
+		"current_usage", quota.CurrentUsage,
+		"overage_usage", quota.OverageUsage,
+		"monthly_limit", quota.MonthlyLimit,
+		"tier", quota.Tier,
+	)
+	return quota, nil
+}
+
+// Reset clears the usage and overage counters for an API key (admin operation).
+// The billing period is unchanged.
 // Typically used for customer service or billing adjustments.
 func (s *Service) Reset(ctx context.Context, apiKeyID id.APIKeyID) error {
 	if apiKeyID.IsNil() {
@@ -135,7 +246,9 @@ func (s *Service) List(ctx context.Context) ([]*models.APIKeyQuota, error) {
 }
 
 // UpdateTier changes the subscription tier for an API key.
-// Takes effect immediately; does not reset current usage.
+// Takes effect immediately; does not reset current usage. With WithGracePeriod,
+// a downgrade that leaves usage at or above the new limit grants grace until
+// the period ends.
 func (s *Service) UpdateTier(ctx context.Context, apiKeyID id.APIKeyID, tier models.QuotaTier) error {
 	if apiKeyID.IsNil() {
 		return dErrors.New(dErrors.CodeBadRequest, "api_key_id is required")
@@ -144,14 +257,46 @@ func (s *Service) UpdateTier(ctx context.Context, apiKeyID id.APIKeyID, tier mod
 		return dErrors.New(dErrors.CodeBadRequest, "invalid tier")
 	}
 
+	previous, err := s.store.GetQuota(ctx, apiKeyID)
+	if err != nil {
+		return dErrors.Wrap(err, dErrors.CodeInternal, "failed to get API key quota")
+	}
 	if err := s.store.UpdateTier(ctx, apiKeyID, tier); err != nil {
 		return dErrors.Wrap(err, dErrors.CodeInternal, "failed to update tier")
 	}
 
-	observability.LogAudit(ctx, s.logger, s.auditPublisher, "api_key_tier_updated",
-		"api_key_id", apiKeyID,
-		"tier", tier,
-	)
+	attrs := []any{"api_key_id", apiKeyID, "tier", tier}
+	if s.gracePeriod && previous != nil {
+		graceUntil, err := s.grantDowngradeGrace(ctx, previous)
+		if err != nil {
+			return err
+		}
+		if graceUntil != nil {
+			attrs = append(attrs, "grace_until", *graceUntil)
+		}
+	}
+	observability.LogAudit(ctx, s.logger, s.auditPublisher, "api_key_tier_updated", attrs...)
 
 	return nil
 }
+
+// grantDowngradeGrace sets GraceUntil to the end of the period when the tier
+// change lowered the limit below current usage. Returns the grace end, or nil
+// when no grace was needed.
+func (s *Service) grantDowngradeGrace(ctx context.Context, previous *models.APIKeyQuota) (*time.Time, error) {
+	updated, err := s.store.GetQuota(ctx, previous.APIKeyID)
+	if err != nil {
+		return nil, dErrors.Wrap(err, dErrors.CodeInternal, "failed to get API key quota")
+	}
+	if updated == nil || !updated.IsOverQuota() {
+		return nil, nil
+	}
+	lowered := previous.MonthlyLimit < 0 || updated.MonthlyLimit < previous.MonthlyLimit
+	if !lowered {
+		return nil, nil
+	}
+	if err := s.store.SetGraceUntil(ctx, updated.APIKeyID, updated.PeriodEnd); err != nil {
+		return nil, dErrors.Wrap(err, dErrors.CodeInternal, "failed to grant downgrade grace")
+	}
+	return &updated.PeriodEnd, nil
+}
@@ -2,7 +2,9 @@ package quota
 
 import (
 	"context"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 
@@ -10,6 +12,10 @@ import (
 	"credo/internal/ratelimit/models"
 	quotaStore "credo/internal/ratelimit/store/quota"
 	id "credo/pkg/domain"
+	dErrors "credo/pkg/domain-errors"
+	"credo/pkg/platform/audit/publishers/security"
+	auditmemory "credo/pkg/platform/audit/store/memory"
+	"credo/pkg/requestcontext"
 )
 
 // =============================================================================
@@ -111,6 +117,32 @@ func (s *QuotaServiceSuite) TestIncrement() {
 		s.Equal(1000, quota.MonthlyLimit) // Free tier default
 		s.False(quota.OverageAllowed)
 	})
+
+	s.Run("records only units past the limit as overage", func() {
+		apiKeyID := id.APIKeyID("increment-overage-key")
+		s.Require().NoError(s.store.UpdateTier(ctx, apiKeyID, models.QuotaTierStarter))
+
+		quota, err := s.service.Increment(ctx, apiKeyID, 9998)
+		s.Require().NoError(err)
+		s.Equal(0, quota.OverageUsage)
+
+		quota, err = s.service.Increment(ctx, apiKeyID, 5)
+		s.Require().NoError(err)
+		s.Equal(10003, quota.CurrentUsage)
+		s.Equal(3, quota.OverageUsage)
+
+		quota, err = s.service.Increment(ctx, apiKeyID, 2)
+		s.Require().NoError(err)
+		s.Equal(5, quota.OverageUsage)
+	})
+
+	s.Run("tier without overage records none", func() {
+		apiKeyID := id.APIKeyID("increment-no-overage-key")
+
+		quota, err := s.service.Increment(ctx, apiKeyID, 1005)
+		s.Require().NoError(err)
+		s.Equal(0, quota.OverageUsage)
+	})
 }
 
 // =============================================================================
@@ -137,6 +169,19 @@ func (s *QuotaServiceSuite) TestReset() {
 		s.NoError(err)
 		s.Equal(0, quota.CurrentUsage)
 	})
+
+	s.Run("clears overage", func() {
+		apiKeyID := id.APIKeyID("reset-overage-key")
+		s.Require().NoError(s.store.UpdateTier(ctx, apiKeyID, models.QuotaTierStarter))
+		_, err := s.service.Increment(ctx, apiKeyID, 10010)
+		s.Require().NoError(err)
+
+		s.Require().NoError(s.service.Reset(ctx, apiKeyID))
+
+		quota, err := s.service.Check(ctx, apiKeyID)
+		s.NoError(err)
+		s.Equal(0, quota.OverageUsage)
+	})
 }
 
 // =============================================================================
@@ -230,6 +275,280 @@ func (s *QuotaServiceSuite) TestTierBoundaries() {
 	})
 }
 
+// =============================================================================
+// Enforce Tests
+// =============================================================================
+
+func (s *QuotaServiceSuite) newAuditedService() (*Service, *auditmemory.InMemoryStore, *security.Publisher) {
+	auditStore := auditmemory.NewInMemoryStore()
+	publisher := security.New(auditStore)
+	s.T().Cleanup(func() { _ = publisher.Close() })
+
+	svc, err := New(s.store, WithAuditPublisher(publisher))
+	s.Require().NoError(err)
+	return svc, auditStore, publisher
+}
+
+func (s *QuotaServiceSuite) auditActions(auditStore *auditmemory.InMemoryStore, publisher *security.Publisher) []string {
+	s.Require().NoError(publisher.Flush(context.Background()))
+	events, err := auditStore.ListAll(context.Background())
+	s.Require().NoError(err)
+	actions := make([]string, 0, len(events))
+	for _, e := range events {
+		actions = append(actions, e.Action)
+	}
+	return actions
+}
+
+func (s *QuotaServiceSuite) TestEnforce() {
+	ctx := context.Background()
+
+	s.Run("missing quota returns not found", func() {
+		_, err := s.service.Enforce(ctx, id.APIKeyID("enforce-missing-key"))
+		s.True(dErrors.HasCode(err, dErrors.CodeNotFound))
+	})
+
+	s.Run("under quota allows and counts the request", func() {
+		apiKeyID := id.APIKeyID("enforce-under-key")
+		_, err := s.store.IncrementUsage(ctx, apiKeyID, 10)
+		s.Require().NoError(err)
+
+		result, err := s.service.Enforce(ctx, apiKeyID)
+		s.Require().NoError(err)
+		s.True(result.Allowed)
+		s.False(result.Overage)
+		s.Equal(11, result.Quota.CurrentUsage)
+		s.Equal(1000, result.Quota.MonthlyLimit)
+	})
+
+	s.Run("over quota without overage blocks and audits", func() {
+		svc, auditStore, publisher := s.newAuditedService()
+		apiKeyID := id.APIKeyID("enforce-blocked-key")
+		_, err := s.store.IncrementUsage(ctx, apiKeyID, 1000)
+		s.Require().NoError(err)
+
+		result, err := svc.Enforce(ctx, apiKeyID)
+		s.Require().NoError(err)
+		s.False(result.Allowed)
+		s.Equal(1000, result.Quota.CurrentUsage, "blocked requests are not counted")
+		s.Equal([]string{"api_key_quota_exceeded"}, s.auditActions(auditStore, publisher))
+	})
+
+	s.Run("over quota with overage allows, counts, and records overage", func() {
+		svc, auditStore, publisher := s.newAuditedService()
+		apiKeyID := id.APIKeyID("enforce-overage-key")
+		s.Require().NoError(s.store.UpdateTier(ctx, apiKeyID, models.QuotaTierStarter))
+		_, err := s.store.IncrementUsage(ctx, apiKeyID, 10000)
+		s.Require().NoError(err)
+
+		result, err := svc.Enforce(ctx, apiKeyID)
+		s.Require().NoError(err)
+		s.True(result.Allowed)
+		s.True(result.Overage)
+		s.Equal(10001, result.Quota.CurrentUsage)
+		s.Equal(1, result.Quota.OverageUsage)
+		s.Equal([]string{"api_key_quota_overage"}, s.auditActions(auditStore, publisher))
+	})
+
+	s.Run("starter key past its limit accumulates overage instead of blocking", func() {
+		apiKeyID := id.APIKeyID("enforce-accumulate-key")
+		s.Require().NoError(s.store.UpdateTier(ctx, apiKeyID, models.QuotaTierStarter))
+		_, err := s.store.IncrementUsage(ctx, apiKeyID, 9999)
+		s.Require().NoError(err)
+
+		var result *models.APIKeyQuotaResult
+		for range 4 {
+			result, err = s.service.Enforce(ctx, apiKeyID)
+			s.Require().NoError(err)
+			s.True(result.Allowed)
+		}
+		s.Equal(10003, result.Quota.CurrentUsage)
+		s.Equal(3, result.Quota.OverageUsage, "the request reaching the limit is not overage")
+	})
+
+	s.Run("ended period resets usage and overage before enforcing", func() {
+		apiKeyID := id.APIKeyID("enforce-rollover-key")
+		_, err := s.store.IncrementUsage(ctx, apiKeyID, 1000)
+		s.Require().NoError(err)
+		_, err = s.store.RecordOverage(ctx, apiKeyID, 7)
+		s.Require().NoError(err)
+		quota, err := s.service.Check(ctx, apiKeyID)
+		s.Require().NoError(err)
+		periodEnd := quota.PeriodEnd
+
+		nextPeriod := requestcontext.WithTime(ctx, periodEnd.Add(time.Nanosecond))
+		result, err := s.service.Enforce(nextPeriod, apiKeyID)
+		s.Require().NoError(err)
+		s.True(result.Allowed, "a free key blocked last period is served again")
+		s.Equal(1, result.Quota.CurrentUsage)
+		s.Equal(0, result.Quota.OverageUsage)
+		s.True(result.Quota.PeriodEnd.After(periodEnd))
+	})
+
+	s.Run("unlimited tier is never over quota", func() {
+		apiKeyID := id.APIKeyID("enforce-unlimited-key")
+		s.Require().NoError(s.store.UpdateTier(ctx, apiKeyID, models.QuotaTierEnterprise))
+		_, err := s.store.IncrementUsage(ctx, apiKeyID, 1_000_000)
+		s.Require().NoError(err)
+
+		result, err := s.service.Enforce(ctx, apiKeyID)
+		s.Require().NoError(err)
+		s.True(result.Allowed)
+		s.False(result.Overage)
+	})
+}
+
+// =============================================================================
+// Period Rollover Tests
+// =============================================================================
+// Justification: Month boundaries cannot be reached in E2E runs; usage must
+// reset exactly once when the calendar month changes.
+
+func (s *QuotaServiceSuite) TestPeriodRollover() {
+	jan15 := time.Date(2026, time.January, 15, 12, 0, 0, 0, time.UTC)
+	jan31 := time.Date(2026, time.January, 31, 23, 59, 59, 0, time.UTC)
+	feb1 := time.Date(2026, time.February, 1, 0, 0, 0, 0, time.UTC)
+	at := func(t time.Time) context.Context {
+		return requestcontext.WithTime(context.Background(), t)
+	}
+
+	s.Run("month boundary resets usage exactly once", func() {
+		apiKeyID := id.APIKeyID("rollover-key")
+		quota, err := s.service.Increment(at(jan15), apiKeyID, 400)
+		s.Require().NoError(err)
+		s.Equal(time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC), quota.PeriodStart)
+
+		quota, err = s.service.Increment(at(jan31), apiKeyID, 100)
+		s.Require().NoError(err)
+		s.Equal(500, quota.CurrentUsage, "still January")
+
+		quota, err = s.service.Increment(at(feb1), apiKeyID, 1)
+		s.Require().NoError(err)
+		s.Equal(1, quota.CurrentUsage, "February starts from zero")
+		s.Equal(feb1, quota.PeriodStart)
+		s.Equal(time.Date(2026, time.March, 1, 0, 0, 0, 0, time.UTC).Add(-time.Nanosecond), quota.PeriodEnd)
+
+		quota, err = s.service.Increment(at(feb1.Add(time.Hour)), apiKeyID, 1)
+		s.Require().NoError(err)
+		s.Equal(2, quota.CurrentUsage, "later February requests do not reset again")
+
+		quota, err = s.service.Check(at(feb1.AddDate(0, 0, 9)), apiKeyID)
+		s.Require().NoError(err)
+		s.Equal(2, quota.CurrentUsage)
+	})
+
+	s.Run("check rolls an idle key into the current month", func() {
+		apiKeyID := id.APIKeyID("rollover-idle-key")
+		_, err := s.service.Increment(at(jan15), apiKeyID, 1000)
+		s.Require().NoError(err)
+
+		quota, err := s.service.Check(at(time.Date(2026, time.April, 3, 0, 0, 0, 0, time.UTC)), apiKeyID)
+		s.Require().NoError(err)
+		s.Equal(0, quota.CurrentUsage)
+		s.Equal(time.Date(2026, time.April, 1, 0, 0, 0, 0, time.UTC), quota.PeriodStart)
+	})
+
+	s.Run("concurrent requests at the boundary reset once", func() {
+		apiKeyID := id.APIKeyID("rollover-concurrent-key")
+		_, err := s.service.Increment(at(jan15), apiKeyID, 900)
+		s.Require().NoError(err)
+
+		const requests = 50
+		var wg sync.WaitGroup
+		wg.Add(requests)
+		for range requests {
+			go func() {
+				defer wg.Done()
+				_, err := s.service.Increment(at(feb1), apiKeyID, 1)
+				s.NoError(err)
+			}()
+		}
+		wg.Wait()
+
+		quota, err := s.service.Check(at(feb1), apiKeyID)
+		s.Require().NoError(err)
+		s.Equal(requests, quota.CurrentUsage, "no February request is lost to a second reset")
+	})
+}
+
+// =============================================================================
+// Downgrade Grace Tests
+// =============================================================================
+// Justification: Grace spans the rest of a billing period and ends at the month
+// boundary, which E2E runs cannot reach.
+
+func (s *QuotaServiceSuite) TestDowngradeGrace() {
+	jan10 := time.Date(2026, time.January, 10, 9, 0, 0, 0, time.UTC)
+	feb1 := time.Date(2026, time.February, 1, 0, 0, 0, 0, time.UTC)
+	at := func(t time.Time) context.Context {
+		return requestcontext.WithTime(context.Background(), t)
+	}
+
+	s.Run("with grace a downgraded key stays allowed until the period ends", func() {
+		service, err := New(s.store, WithGracePeriod(true))
+		s.Require().NoError(err)
+		apiKeyID := id.APIKeyID("grace-downgrade-key")
+		s.Require().NoError(service.UpdateTier(at(jan10), apiKeyID, models.QuotaTierBusiness))
+		_, err = service.Increment(at(jan10), apiKeyID, 5000)
+		s.Require().NoError(err)
+
+		s.Require().NoError(service.UpdateTier(at(jan10), apiKeyID, models.QuotaTierFree))
+
+		quota, err := service.Check(at(jan10), apiKeyID)
+		s.Require().NoError(err)
+		s.Require().NotNil(quota.GraceUntil)
+		s.Equal(quota.PeriodEnd, *quota.GraceUntil)
+
+		result, err := service.Enforce(at(jan10.Add(time.Hour)), apiKeyID)
+		s.Require().NoError(err)
+		s.True(result.Allowed)
+		s.False(result.Overage)
+		s.Equal(0, result.Quota.OverageUsage)
+
+		// February starts under the Free limit with no grace left.
+		result, err = service.Enforce(at(feb1), apiKeyID)
+		s.Require().NoError(err)
+		s.True(result.Allowed)
+		s.Nil(result.Quota.GraceUntil)
+		s.Equal(1, result.Quota.CurrentUsage)
+	})
+
+	s.Run("upgrades and downgrades within the new limit grant no grace", func() {
+		service, err := New(s.store, WithGracePeriod(true))
+		s.Require().NoError(err)
+		apiKeyID := id.APIKeyID("grace-within-limit-key")
+		s.Require().NoError(service.UpdateTier(at(jan10), apiKeyID, models.QuotaTierBusiness))
+		_, err = service.Increment(at(jan10), apiKeyID, 10)
+		s.Require().NoError(err)
+
+		s.Require().NoError(service.UpdateTier(at(jan10), apiKeyID, models.QuotaTierFree))
+		s.Require().NoError(service.UpdateTier(at(jan10), apiKeyID, models.QuotaTierStarter))
+
+		quota, err := service.Check(at(jan10), apiKeyID)
+		s.Require().NoError(err)
+		s.Nil(quota.GraceUntil)
+	})
+
+	s.Run("without grace a downgraded key is limited immediately", func() {
+		apiKeyID := id.APIKeyID("no-grace-downgrade-key")
+		s.Require().NoError(s.service.UpdateTier(at(jan10), apiKeyID, models.QuotaTierBusiness))
+		_, err := s.service.Increment(at(jan10), apiKeyID, 20000)
+		s.Require().NoError(err)
+
+		s.Require().NoError(s.service.UpdateTier(at(jan10), apiKeyID, models.QuotaTierFree))
+		result, err := s.service.Enforce(at(jan10), apiKeyID)
+		s.Require().NoError(err)
+		s.False(result.Allowed, "free tier has no overage")
+		s.Nil(result.Quota.GraceUntil)
+
+		s.Require().NoError(s.service.UpdateTier(at(jan10), apiKeyID, models.QuotaTierStarter))
+		result, err = s.service.Enforce(at(jan10), apiKeyID)
+		s.Require().NoError(err)
+		s.True(result.Allowed)
+		s.True(result.Overage, "starter bills usage past its limit at once")
+	})
+}
+
 // =============================================================================
 // List Tests
 // =============================================================================
@@ -0,0 +1,59 @@
+package requestlimit
+
+import (
+	"context"
+	"slices"
+)
+
+// LoadSignal reports current system load, normalized so 1.0 is the capacity
+// the configured limits were sized for. Implementations may sample CPU, memory,
+// or request latency; Load is called on every check, so it must be cheap.
+type LoadSignal interface {
+	Load(ctx context.Context) float64
+}
+
+// LoadTier scales limits by Factor while load is at or above Threshold.
+type LoadTier struct {
+	Threshold float64
+	Factor    float64 // in (0, 1]; 0.5 halves every limit
+}
+
+type adaptiveLimits struct {
+	signal LoadSignal
+	tiers  []LoadTier
+}
+
+// WithAdaptiveLimits tightens per-IP and per-user limits while signal reports
+// high load, and restores the configured limits as load falls back below each
+// tier's threshold. When several tiers apply, the smallest factor wins. Tiers
+// with a factor outside (0, 1] are ignored. Without this option, configured
+// limits always apply.
+func WithAdaptiveLimits(signal LoadSignal, tiers ...LoadTier) Option {
+	return func(s *Service) {
+		if signal == nil {
+			return
+		}
+		valid := slices.DeleteFunc(slices.Clone(tiers), func(t LoadTier) bool {
+			return t.Factor <= 0 || t.Factor > 1
+		})
+		if len(valid) == 0 {
+			return
+		}
+		s.adaptive = &adaptiveLimits{signal: signal, tiers: valid}
+	}
+}
+
+// effectiveLimit returns limit scaled for current load, never below one request.
+func (s *Service) effectiveLimit(ctx context.Context, limit int) int {
+	if s.adaptive == nil {
+		return limit
+	}
+	load := s.adaptive.signal.Load(ctx)
+	factor := 1.0
+	for _, tier := range s.adaptive.tiers {
+		if load >= tier.Threshold {
+			factor = min(factor, tier.Factor)
+		}
+	}
+	return max(int(float64(limit)*factor), 1)
+}
@@ -0,0 +1,95 @@
+package requestlimit
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+
+	"credo/internal/ratelimit/models"
+	"credo/pkg/requestcontext"
+)
+
+// maxTrackedOffenders bounds the offender table; past it, entries whose quiet
+// period has elapsed are swept before a new identifier is added.
+const maxTrackedOffenders = 10_000
+
+// BackoffConfig escalates Retry-After for identifiers throttled repeatedly.
+type BackoffConfig struct {
+	Multiplier    float64       // Retry-After growth per repeat throttle; values <= 1 disable escalation
+	MaxRetryAfter time.Duration // Cap on the escalated Retry-After
+	QuietPeriod   time.Duration // Time without a throttle after which the count resets
+}
+
+type offense struct {
+	count int
+	last  time.Time
+}
+
+type repeatOffenders struct {
+	cfg     BackoffConfig
+	mu      sync.Mutex
+	entries map[string]*offense
+}
+
+// WithRepeatOffenderBackoff makes Retry-After grow for an identifier that keeps
+// hitting its limit: the n-th throttle within the quiet period advises the
+// bucket's own Retry-After times Multiplier^(n-1), capped at MaxRetryAfter. The
+// count resets once the identifier goes QuietPeriod without being throttled.
+//
+// Throttles are counted per identifier across endpoint classes, in this
+// instance's memory. The escalation is advice to clients; the bucket still
+// decides when a request is allowed again. Without this option, or with a
+// Multiplier of at most 1 or a non-positive cap or quiet period, Retry-After is
+// the bucket's.
+func WithRepeatOffenderBackoff(cfg BackoffConfig) Option {
+	return func(s *Service) {
+		if cfg.Multiplier <= 1 || cfg.MaxRetryAfter <= 0 || cfg.QuietPeriod <= 0 {
+			return
+		}
+		s.offenders = &repeatOffenders{cfg: cfg, entries: make(map[string]*offense)}
+	}
+}
+
+// escalate records a throttle of identifier and raises result.RetryAfter by
+// how often it has been throttled recently. A nil receiver leaves it unchanged.
+func (o *repeatOffenders) escalate(ctx context.Context, prefix models.KeyPrefix, identifier string, result *models.RateLimitResult) {
+	if o == nil || result == nil || result.Allowed {
+		return
+	}
+	now := requestcontext.Now(ctx)
+	key := string(prefix) + ":" + identifier
+
+	o.mu.Lock()
+	entry, ok := o.entries[key]
+	if !ok {
+		if len(o.entries) >= maxTrackedOffenders {
+			o.sweepLocked(now)
+		}
+		entry = &offense{}
+		o.entries[key] = entry
+	}
+	if now.Sub(entry.last) >= o.cfg.QuietPeriod {
+		entry.count = 0
+	}
+	entry.count++
+	entry.last = now
+	count := entry.count
+	o.mu.Unlock()
+
+	maxSeconds := int(o.cfg.MaxRetryAfter.Seconds())
+	if result.RetryAfter >= maxSeconds {
+		return
+	}
+	escalated := float64(max(result.RetryAfter, 1)) * math.Pow(o.cfg.Multiplier, float64(count-1))
+	result.RetryAfter = int(min(escalated, float64(maxSeconds)))
+}
+
+// sweepLocked drops offenders whose quiet period has elapsed. Callers hold o.mu.
+func (o *repeatOffenders) sweepLocked(now time.Time) {
+	for key, entry := range o.entries {
+		if now.Sub(entry.last) >= o.cfg.QuietPeriod {
+			delete(o.entries, key)
+		}
+	}
+}
@@ -50,6 +50,8 @@ type Service struct {
 	logger         *slog.Logger
 	config         *config.Config
 	metrics        *metrics.Metrics
+	adaptive       *adaptiveLimits
+	offenders      *repeatOffenders
 }
 
 // Option configures a Service instance.
@@ -83,6 +85,24 @@ func WithMetrics(m *metrics.Metrics) Option {
 	}
 }
 
+// bucketEndpoint returns the normalized request path when it has an override,
+// so its requests count in their own bucket. Empty otherwise.
+func (s *Service) bucketEndpoint(path string) string {
+	if !s.config.HasEndpointOverride(path) {
+		return ""
+	}
+	return models.EndpointPath(path)
+}
+
+// rateLimitKey builds the bucket key, scoped to endpoint when it is set.
+func rateLimitKey(prefix models.KeyPrefix, identifier string, class models.EndpointClass, endpoint string) models.RateLimitKey {
+	key := models.NewRateLimitKey(prefix, identifier, class)
+	if endpoint != "" {
+		key = key.ForEndpoint(endpoint)
+	}
+	return key
+}
+
 // New creates a rate limiting service with the given stores and options.
 // Returns an error if required stores are nil.
 func New(
@@ -113,8 +133,10 @@ func New(
 // CheckIP enforces per-IP rate limits for unauthenticated requests.
 // Used by middleware.RateLimit for endpoints that don't require authentication.
 // Returns Allowed=false if the IP has exceeded its quota for the endpoint class.
-func (s *Service) CheckIP(ctx context.Context, ip string, class models.EndpointClass) (*models.RateLimitResult, error) {
-	requestsPerWindow, window, ok := s.config.GetIPLimit(class)
+// A path listed in config.EndpointOverrides is limited by its own limit instead
+// of the class default; pass "" when the request has no endpoint of its own.
+func (s *Service) CheckIP(ctx context.Context, ip string, class models.EndpointClass, path string) (*models.RateLimitResult, error) {
+	requestsPerWindow, window, ok := s.config.GetEndpointLimit(path, class, models.KeyPrefixIP)
 	if !ok {
 		// Default-deny: no limit configured for this class (PRD-017 FR-1)
 		observability.LogAudit(ctx, s.logger, s.auditPublisher, "rate_limit_config_missing",
@@ -128,16 +150,19 @@ func (s *Service) CheckIP(ctx context.Context, ip string, class models.EndpointC
 			Remaining:  0,
 			ResetAt:    requestcontext.Now(ctx),
 			RetryAfter: 60, // Retry in 60 seconds
+			Decision:   models.DecisionConfigMissing,
 		}, nil
 	}
-	return s.checkRateLimit(ctx, ip, class, models.KeyPrefixIP, requestsPerWindow, window, privacy.AnonymizeIP(ip))
+	requestsPerWindow = s.effectiveLimit(ctx, requestsPerWindow)
+	return s.checkRateLimit(ctx, ip, class, s.bucketEndpoint(path), models.KeyPrefixIP, requestsPerWindow, window, privacy.AnonymizeIP(ip))
 }
 
 // CheckUser enforces per-user rate limits.
 // Used when you want to limit by user identity only, ignoring IP.
 // Returns Allowed=false if the user has exceeded their quota for the endpoint class.
-func (s *Service) CheckUser(ctx context.Context, userID string, class models.EndpointClass) (*models.RateLimitResult, error) {
-	requestsPerWindow, window, ok := s.config.GetUserLimit(class)
+// path selects an endpoint override as in CheckIP.
+func (s *Service) CheckUser(ctx context.Context, userID string, class models.EndpointClass, path string) (*models.RateLimitResult, error) {
+	requestsPerWindow, window, ok := s.config.GetEndpointLimit(path, class, models.KeyPrefixUser)
 	if !ok {
 		// Default-deny: no limit configured for this class (PRD-017 FR-1)
 		observability.LogAudit(ctx, s.logger, s.auditPublisher, "rate_limit_config_missing",
@@ -151,9 +176,11 @@ func (s *Service) CheckUser(ctx context.Context, userID string, class models.End
 			Remaining:  0,
 			ResetAt:    requestcontext.Now(ctx),
 			RetryAfter: 60, // Retry in 60 seconds
+			Decision:   models.DecisionConfigMissing,
 		}, nil
 	}
-	return s.checkRateLimit(ctx, userID, class, models.KeyPrefixUser, requestsPerWindow, window, userID)
+	requestsPerWindow = s.effectiveLimit(ctx, requestsPerWindow)
+	return s.checkRateLimit(ctx, userID, class, s.bucketEndpoint(path), models.KeyPrefixUser, requestsPerWindow, window, userID)
 }
 
 // limitParams groups parameters for a single rate limit check.
@@ -161,6 +188,7 @@ type limitParams struct {
 	identifier    string
 	logIdentifier string
 	prefix        models.KeyPrefix
+	endpoint      string // set when the path has its own limit and bucket
 	limit         int
 	window        time.Duration
 }
@@ -169,6 +197,7 @@ func (s *Service) checkRateLimit(
 	ctx context.Context,
 	identifier string,
 	class models.EndpointClass,
+	endpoint string,
 	keyPrefix models.KeyPrefix,
 	requestsPerWindow int,
 	window time.Duration,
@@ -186,7 +215,7 @@ func (s *Service) checkRateLimit(
 	// This ensures constant-time behavior to prevent timing-based enumeration
 	// of allowlisted IPs/users. An attacker cannot distinguish allowlisted
 	// from non-allowlisted identifiers based on response time.
-	key := models.NewRateLimitKey(keyPrefix, identifier, class)
+	key := rateLimitKey(keyPrefix, identifier, class, endpoint)
 	result, err := s.buckets.Allow(ctx, key.String(), requestsPerWindow, window)
 	if err != nil {
 		return nil, dErrors.Wrap(err, dErrors.CodeInternal, "failed to check rate limit")
@@ -210,9 +239,11 @@ func (s *Service) checkRateLimit(
 			Remaining:  requestsPerWindow,
 			ResetAt:    now.Add(window),
 			RetryAfter: 0,
+			Decision:   allowlistDecision(result.Allowed),
 		}, nil
 	}
 
+	result.Decision = bucketDecision(result.Allowed)
 	if !result.Allowed {
 		observability.LogAudit(ctx, s.logger, s.auditPublisher, string(keyPrefix)+"_rate_limit_exceeded",
 			"identifier", logIdentifier,
@@ -220,6 +251,7 @@ func (s *Service) checkRateLimit(
 			"limit", requestsPerWindow,
 			"window_seconds", int(window.Seconds()),
 		)
+		s.offenders.escalate(ctx, keyPrefix, identifier, result)
 	}
 
 	return result, nil
@@ -228,11 +260,12 @@ func (s *Service) checkRateLimit(
 // checkSingleLimit performs a rate limit check without allowlist handling.
 // Used by CheckBoth after allowlist checks are done upfront.
 func (s *Service) checkSingleLimit(ctx context.Context, p limitParams, class models.EndpointClass) (*models.RateLimitResult, error) {
-	key := models.NewRateLimitKey(p.prefix, p.identifier, class)
+	key := rateLimitKey(p.prefix, p.identifier, class, p.endpoint)
 	res, err := s.buckets.Allow(ctx, key.String(), p.limit, p.window)
 	if err != nil {
 		return nil, dErrors.Wrap(err, dErrors.CodeInternal, "failed to check "+string(p.prefix)+" rate limit")
 	}
+	res.Decision = bucketDecision(res.Allowed)
 	if !res.Allowed {
 		observability.LogAudit(ctx, s.logger, s.auditPublisher, string(p.prefix)+"_rate_limit_exceeded",
 			"identifier", p.logIdentifier,
@@ -253,12 +286,14 @@ func (s *Service) checkSingleLimit(ctx context.Context, p limitParams, class mod
 //   - Checks allowlist; if either IP or user is allowlisted, returns bypass result
 //   - Otherwise returns the more restrictive result (lower remaining count wins)
 //
+// path selects an endpoint override as in CheckIP.
+//
 // This is the primary entry point for authenticated request rate limiting.
-func (s *Service) CheckBoth(ctx context.Context, ip, userID string, class models.EndpointClass) (*models.RateLimitResult, error) {
+func (s *Service) CheckBoth(ctx context.Context, ip, userID string, class models.EndpointClass, path string) (*models.RateLimitResult, error) {
 	now := requestcontext.Now(ctx)
 
 	// Get limits upfront to fail fast if config is missing
-	ipLimit, userLimit, denial := s.getBothLimits(ctx, ip, userID, class, now)
+	ipLimit, userLimit, denial := s.getBothLimits(ctx, ip, userID, class, path, now)
 	if denial != nil {
 		return denial, nil
 	}
@@ -281,14 +316,20 @@ func (s *Service) CheckBoth(ctx context.Context, ip, userID string, class models
 
 	// If either is allowlisted, return bypass result
 	if ipAllowlisted || userAllowlisted {
-		return s.buildBypassResult(ctx, ip, userID, class, ipLimit, userLimit, now, ipAllowlisted, userAllowlisted), nil
+		bypass := s.buildBypassResult(ctx, ip, userID, class, ipLimit, userLimit, now, ipAllowlisted, userAllowlisted)
+		bypass.Decision = allowlistDecision(ipRes.Allowed && userRes.Allowed)
+		return bypass, nil
 	}
 
 	// Both denied → return IP denial (checked first)
 	if !ipRes.Allowed {
+		ipRes.Reason = models.LimitReasonIP
+		s.offenders.escalate(ctx, models.KeyPrefixIP, ip, ipRes)
 		return ipRes, nil
 	}
 	if !userRes.Allowed {
+		userRes.Reason = models.LimitReasonUser
+		s.offenders.escalate(ctx, models.KeyPrefixUser, userID, userRes)
 		return userRes, nil
 	}
 
@@ -296,16 +337,17 @@ func (s *Service) CheckBoth(ctx context.Context, ip, userID string, class models
 }
 
 // getBothLimits retrieves IP and user limits, returning a denial result if config is missing.
-func (s *Service) getBothLimits(ctx context.Context, ip, userID string, class models.EndpointClass, now time.Time) (*limitParams, *limitParams, *models.RateLimitResult) {
+func (s *Service) getBothLimits(ctx context.Context, ip, userID string, class models.EndpointClass, path string, now time.Time) (*limitParams, *limitParams, *models.RateLimitResult) {
 	denial := &models.RateLimitResult{
 		Allowed:    false,
 		Limit:      0,
 		Remaining:  0,
 		ResetAt:    now,
 		RetryAfter: 60,
+		Decision:   models.DecisionConfigMissing,
 	}
 
-	ipRequestsPerWindow, ipWindow, ipOk := s.config.GetIPLimit(class)
+	ipRequestsPerWindow, ipWindow, ipOk := s.config.GetEndpointLimit(path, class, models.KeyPrefixIP)
 	if !ipOk {
 		observability.LogAudit(ctx, s.logger, s.auditPublisher, "rate_limit_config_missing",
 			"identifier", privacy.AnonymizeIP(ip),
@@ -315,7 +357,7 @@ func (s *Service) getBothLimits(ctx context.Context, ip, userID string, class mo
 		return nil, nil, denial
 	}
 
-	userRequestsPerWindow, userWindow, userOk := s.config.GetUserLimit(class)
+	userRequestsPerWindow, userWindow, userOk := s.config.GetEndpointLimit(path, class, models.KeyPrefixUser)
 	if !userOk {
 		observability.LogAudit(ctx, s.logger, s.auditPublisher, "rate_limit_config_missing",
 			"identifier", userID,
@@ -325,18 +367,21 @@ func (s *Service) getBothLimits(ctx context.Context, ip, userID string, class mo
 		return nil, nil, denial
 	}
 
+	endpoint := s.bucketEndpoint(path)
 	ipParams := &limitParams{
 		identifier:    ip,
 		logIdentifier: privacy.AnonymizeIP(ip),
 		prefix:        models.KeyPrefixIP,
-		limit:         ipRequestsPerWindow,
+		endpoint:      endpoint,
+		limit:         s.effectiveLimit(ctx, ipRequestsPerWindow),
 		window:        ipWindow,
 	}
 	userParams := &limitParams{
 		identifier:    userID,
 		logIdentifier: userID,
 		prefix:        models.KeyPrefixUser,
-		limit:         userRequestsPerWindow,
+		endpoint:      endpoint,
+		limit:         s.effectiveLimit(ctx, userRequestsPerWindow),
 		window:        userWindow,
 	}
 	return ipParams, userParams, nil
@@ -385,6 +430,23 @@ func (s *Service) buildBypassResult(ctx context.Context, ip, userID string, clas
 	}
 }
 
+// bucketDecision names the outcome of a bucket check.
+func bucketDecision(allowed bool) models.Decision {
+	if allowed {
+		return models.DecisionUnderLimit
+	}
+	return models.DecisionWindowExceeded
+}
+
+// allowlistDecision distinguishes an allowlist entry that changed the outcome
+// from one that merely skipped a check the request would have passed.
+func allowlistDecision(withinLimit bool) models.Decision {
+	if withinLimit {
+		return models.DecisionAllowlisted
+	}
+	return models.DecisionBypassed
+}
+
 // moreRestrictiveResult returns the result with fewer remaining requests,
 // or the earlier reset time if remaining counts are equal.
 func moreRestrictiveResult(a, b *models.RateLimitResult) *models.RateLimitResult {
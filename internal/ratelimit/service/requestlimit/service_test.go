@@ -2,9 +2,11 @@ package requestlimit
 
 import (
 	"context"
+	"fmt"
 	"io"
 	"log/slog"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 
@@ -12,6 +14,7 @@ import (
 	"credo/internal/ratelimit/models"
 	rwallowlistStore "credo/internal/ratelimit/store/allowlist"
 	rwbucketStore "credo/internal/ratelimit/store/bucket"
+	"credo/pkg/requestcontext"
 )
 
 // =============================================================================
@@ -80,7 +83,7 @@ func (s *RequestLimitServiceSuite) TestCheckIP() {
 	ctx := context.Background()
 
 	s.Run("first request is allowed", func() {
-		result, err := s.service.CheckIP(ctx, "192.168.1.1", models.ClassRead)
+		result, err := s.service.CheckIP(ctx, "192.168.1.1", models.ClassRead, "")
 		s.NoError(err)
 		s.True(result.Allowed)
 		s.Equal(100, result.Limit) // ClassRead default
@@ -88,7 +91,7 @@ func (s *RequestLimitServiceSuite) TestCheckIP() {
 
 	s.Run("requests within limit are allowed", func() {
 		for i := 0; i < 5; i++ {
-			result, err := s.service.CheckIP(ctx, "192.168.1.2", models.ClassRead)
+			result, err := s.service.CheckIP(ctx, "192.168.1.2", models.ClassRead, "")
 			s.NoError(err)
 			s.True(result.Allowed)
 		}
@@ -103,7 +106,7 @@ func (s *RequestLimitServiceSuite) TestCheckUser() {
 	ctx := context.Background()
 
 	s.Run("first request is allowed", func() {
-		result, err := s.service.CheckUser(ctx, "user-123", models.ClassRead)
+		result, err := s.service.CheckUser(ctx, "user-123", models.ClassRead, "")
 		s.NoError(err)
 		s.True(result.Allowed)
 	})
@@ -122,7 +125,7 @@ func (s *RequestLimitServiceSuite) TestCheckBoth() {
 	ctx := context.Background()
 
 	s.Run("first request is allowed", func() {
-		result, err := s.service.CheckBoth(ctx, "192.168.1.10", "user-abc", models.ClassRead)
+		result, err := s.service.CheckBoth(ctx, "192.168.1.10", "user-abc", models.ClassRead, "")
 		s.NoError(err)
 		s.True(result.Allowed)
 	})
@@ -130,16 +133,36 @@ func (s *RequestLimitServiceSuite) TestCheckBoth() {
 	s.Run("returns more restrictive remaining", func() {
 		// Exhaust some IP quota
 		for i := 0; i < 50; i++ {
-			_, _ = s.service.CheckIP(ctx, "192.168.1.11", models.ClassRead)
+			_, _ = s.service.CheckIP(ctx, "192.168.1.11", models.ClassRead, "")
 		}
 
 		// Now check both - IP should have lower remaining
-		result, err := s.service.CheckBoth(ctx, "192.168.1.11", "user-fresh", models.ClassRead)
+		result, err := s.service.CheckBoth(ctx, "192.168.1.11", "user-fresh", models.ClassRead, "")
 		s.NoError(err)
 		s.True(result.Allowed)
 		// Result should reflect the more restrictive (IP) remaining
 		s.Less(result.Remaining, 100) // Less than full user quota
 	})
+
+	s.Run("denial names the exhausted limit", func() {
+		// IP limit (100/min) is exhausted first from a single address
+		for range 100 {
+			_, _ = s.service.CheckBoth(ctx, "192.168.1.12", "user-ip-bound", models.ClassRead, "")
+		}
+		result, err := s.service.CheckBoth(ctx, "192.168.1.12", "user-ip-bound", models.ClassRead, "")
+		s.NoError(err)
+		s.False(result.Allowed)
+		s.Equal(models.LimitReasonIP, result.Reason)
+
+		// User quota (200/hour) is exhausted across many addresses
+		for i := range 200 {
+			_, _ = s.service.CheckBoth(ctx, fmt.Sprintf("10.0.%d.%d", i/250, i%250), "user-roaming", models.ClassRead, "")
+		}
+		result, err = s.service.CheckBoth(ctx, "10.1.0.1", "user-roaming", models.ClassRead, "")
+		s.NoError(err)
+		s.False(result.Allowed)
+		s.Equal(models.LimitReasonUser, result.Reason)
+	})
 }
 
 // =============================================================================
@@ -159,7 +182,7 @@ func (s *RequestLimitServiceSuite) TestAllowlistBypass() {
 		})
 		s.Require().NoError(err)
 
-		result, err := s.service.CheckIP(ctx, "10.0.0.1", models.ClassRead)
+		result, err := s.service.CheckIP(ctx, "10.0.0.1", models.ClassRead, "")
 		s.NoError(err)
 		s.True(result.Allowed)
 		s.Equal(result.Limit, result.Remaining) // Full quota
@@ -184,7 +207,7 @@ func (s *RequestLimitServiceSuite) TestAllowlistBypassTypePriority() {
 		})
 		s.Require().NoError(err)
 
-		result, err := s.service.CheckBoth(ctx, "10.0.0.100", "user-not-listed", models.ClassRead)
+		result, err := s.service.CheckBoth(ctx, "10.0.0.100", "user-not-listed", models.ClassRead, "")
 		s.NoError(err)
 		s.True(result.Allowed)
 		s.True(result.Bypassed)
@@ -198,7 +221,7 @@ func (s *RequestLimitServiceSuite) TestAllowlistBypassTypePriority() {
 		})
 		s.Require().NoError(err)
 
-		result, err := s.service.CheckBoth(ctx, "10.0.0.101", "user-allowlisted", models.ClassRead)
+		result, err := s.service.CheckBoth(ctx, "10.0.0.101", "user-allowlisted", models.ClassRead, "")
 		s.NoError(err)
 		s.True(result.Allowed)
 		s.True(result.Bypassed)
@@ -217,10 +240,320 @@ func (s *RequestLimitServiceSuite) TestAllowlistBypassTypePriority() {
 		})
 		s.Require().NoError(err)
 
-		result, err := s.service.CheckBoth(ctx, "10.0.0.102", "user-also-allowlisted", models.ClassRead)
+		result, err := s.service.CheckBoth(ctx, "10.0.0.102", "user-also-allowlisted", models.ClassRead, "")
 		s.NoError(err)
 		s.True(result.Allowed)
 		s.True(result.Bypassed)
 		// IP allowlist takes priority - verified by code review and the fix to use ipAllowlisted
 	})
 }
+
+// =============================================================================
+// Adaptive Limit Tests
+// =============================================================================
+// Justification: Load-driven tightening depends on an injected signal that
+// feature tests cannot drive.
+
+type fakeLoadSignal struct {
+	load float64
+}
+
+func (f *fakeLoadSignal) Load(context.Context) float64 {
+	return f.load
+}
+
+func (s *RequestLimitServiceSuite) TestAdaptiveLimits() {
+	ctx := context.Background()
+	signal := &fakeLoadSignal{load: 0.3}
+	svc, err := New(s.bucketStore, s.allowlistStore,
+		WithConfig(config.DefaultConfig()),
+		WithAdaptiveLimits(signal,
+			LoadTier{Threshold: 0.8, Factor: 0.5},
+			LoadTier{Threshold: 0.95, Factor: 0.1},
+		),
+	)
+	s.Require().NoError(err)
+
+	s.Run("normal load uses configured limits", func() {
+		result, err := svc.CheckIP(ctx, "10.1.0.1", models.ClassRead, "")
+		s.Require().NoError(err)
+		s.Equal(100, result.Limit)
+	})
+
+	s.Run("high load applies the reduced limit", func() {
+		signal.load = 0.85
+		for range 50 {
+			result, err := svc.CheckIP(ctx, "10.1.0.2", models.ClassRead, "")
+			s.Require().NoError(err)
+			s.Require().True(result.Allowed)
+			s.Equal(50, result.Limit)
+		}
+		result, err := svc.CheckIP(ctx, "10.1.0.2", models.ClassRead, "")
+		s.Require().NoError(err)
+		s.False(result.Allowed, "reduced limit is enforced")
+
+		user, err := svc.CheckUser(ctx, "user-adaptive", models.ClassRead, "")
+		s.Require().NoError(err)
+		s.Equal(100, user.Limit, "user limit of 200 is halved too")
+	})
+
+	s.Run("steepest applicable tier wins", func() {
+		signal.load = 1.2
+		result, err := svc.CheckIP(ctx, "10.1.0.4", models.ClassRead, "")
+		s.Require().NoError(err)
+		s.Equal(10, result.Limit)
+	})
+
+	s.Run("recovery restores the configured limit", func() {
+		signal.load = 0.4
+		result, err := svc.CheckIP(ctx, "10.1.0.2", models.ClassRead, "")
+		s.Require().NoError(err)
+		s.True(result.Allowed)
+		s.Equal(100, result.Limit)
+	})
+
+	s.Run("invalid tiers leave limits unscaled", func() {
+		signal.load = 1.0
+		unscaled, err := New(s.bucketStore, s.allowlistStore,
+			WithConfig(config.DefaultConfig()),
+			WithAdaptiveLimits(signal, LoadTier{Threshold: 0.5, Factor: 0}, LoadTier{Threshold: 0.5, Factor: 2}),
+		)
+		s.Require().NoError(err)
+		result, err := unscaled.CheckIP(ctx, "10.1.0.5", models.ClassRead, "")
+		s.Require().NoError(err)
+		s.Equal(100, result.Limit)
+	})
+}
+
+// =============================================================================
+// Endpoint Override Tests
+// =============================================================================
+// Justification: Override precedence and bucket isolation decide which limit a
+// path gets; E2E runs cannot exhaust an hourly per-endpoint limit.
+
+// =============================================================================
+// Repeat Offender Backoff Tests
+// =============================================================================
+// Justification: Escalation depends on throttle history over time, which
+// feature tests cannot drive deterministically.
+
+// denyingBuckets rejects every request with a fixed Retry-After.
+type denyingBuckets struct {
+	retryAfter int
+}
+
+func (b denyingBuckets) Allow(ctx context.Context, _ string, limit int, window time.Duration) (*models.RateLimitResult, error) {
+	return &models.RateLimitResult{
+		Allowed:    false,
+		Limit:      limit,
+		ResetAt:    requestcontext.Now(ctx).Add(window),
+		RetryAfter: b.retryAfter,
+	}, nil
+}
+
+func (s *RequestLimitServiceSuite) TestRepeatOffenderBackoff() {
+	start := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	at := func(d time.Duration) context.Context {
+		return requestcontext.WithTime(context.Background(), start.Add(d))
+	}
+	newService := func(cfg BackoffConfig) *Service {
+		svc, err := New(denyingBuckets{retryAfter: 10}, s.allowlistStore,
+			WithConfig(config.DefaultConfig()),
+			WithRepeatOffenderBackoff(cfg),
+		)
+		s.Require().NoError(err)
+		return svc
+	}
+	backoff := BackoffConfig{Multiplier: 2, MaxRetryAfter: time.Minute, QuietPeriod: 5 * time.Minute}
+
+	s.Run("repeat throttles escalate up to the cap", func() {
+		svc := newService(backoff)
+		var got []int
+		for i := range 5 {
+			result, err := svc.CheckIP(at(time.Duration(i)*time.Second), "10.3.0.1", models.ClassRead, "")
+			s.Require().NoError(err)
+			s.Require().False(result.Allowed)
+			got = append(got, result.RetryAfter)
+		}
+		s.Equal([]int{10, 20, 40, 60, 60}, got)
+	})
+
+	s.Run("a quiet period resets the escalation", func() {
+		svc := newService(backoff)
+		for i := range 3 {
+			_, err := svc.CheckIP(at(time.Duration(i)*time.Second), "10.3.0.2", models.ClassRead, "")
+			s.Require().NoError(err)
+		}
+
+		result, err := svc.CheckIP(at(2*time.Second+4*time.Minute), "10.3.0.2", models.ClassRead, "")
+		s.Require().NoError(err)
+		s.Equal(60, result.RetryAfter, "throttles inside the quiet period keep escalating")
+
+		result, err = svc.CheckIP(at(2*time.Second+9*time.Minute+time.Second), "10.3.0.2", models.ClassRead, "")
+		s.Require().NoError(err)
+		s.Equal(10, result.RetryAfter)
+	})
+
+	s.Run("identifiers escalate independently", func() {
+		svc := newService(backoff)
+		for range 3 {
+			_, err := svc.CheckIP(at(0), "10.3.0.3", models.ClassRead, "")
+			s.Require().NoError(err)
+		}
+
+		result, err := svc.CheckIP(at(0), "10.3.0.4", models.ClassRead, "")
+		s.Require().NoError(err)
+		s.Equal(10, result.RetryAfter)
+	})
+
+	s.Run("authenticated checks escalate the identifier that was denied", func() {
+		svc := newService(backoff)
+		first, err := svc.CheckBoth(at(0), "10.3.0.5", "user-backoff", models.ClassRead, "")
+		s.Require().NoError(err)
+		second, err := svc.CheckBoth(at(time.Second), "10.3.0.5", "user-backoff", models.ClassRead, "")
+		s.Require().NoError(err)
+		s.Equal(models.LimitReasonIP, second.Reason)
+		s.Equal([]int{10, 20}, []int{first.RetryAfter, second.RetryAfter})
+	})
+
+	s.Run("invalid config leaves Retry-After unchanged", func() {
+		svc := newService(BackoffConfig{Multiplier: 1, MaxRetryAfter: time.Minute, QuietPeriod: time.Minute})
+		for range 3 {
+			result, err := svc.CheckIP(at(0), "10.3.0.6", models.ClassRead, "")
+			s.Require().NoError(err)
+			s.Equal(10, result.RetryAfter)
+		}
+	})
+}
+
+func (s *RequestLimitServiceSuite) TestEndpointOverrides() {
+	cfg := config.DefaultConfig()
+	cfg.EndpointOverrides = map[string]config.Limit{
+		"/me/data-export": {RequestsPerWindow: 2, Window: time.Hour},
+	}
+	svc, err := New(s.bucketStore, s.allowlistStore, WithConfig(cfg))
+	s.Require().NoError(err)
+	ctx := context.Background()
+	export, sibling := "/v1/me/data-export", "/v1/me"
+
+	s.Run("overridden path uses its own limit", func() {
+		for range 2 {
+			result, err := svc.CheckBoth(ctx, "10.2.0.1", "user-export", models.ClassRead, export)
+			s.Require().NoError(err)
+			s.Require().True(result.Allowed)
+			s.Equal(2, result.Limit)
+		}
+		result, err := svc.CheckBoth(ctx, "10.2.0.1", "user-export", models.ClassRead, export)
+		s.Require().NoError(err)
+		s.False(result.Allowed)
+	})
+
+	s.Run("siblings in the class keep the class default", func() {
+		result, err := svc.CheckBoth(ctx, "10.2.0.1", "user-export", models.ClassRead, sibling)
+		s.Require().NoError(err)
+		s.True(result.Allowed, "the exhausted override bucket is separate from the class bucket")
+		s.Equal(100, result.Limit)
+
+		ip, err := svc.CheckIP(ctx, "10.2.0.2", models.ClassRead, sibling)
+		s.Require().NoError(err)
+		s.Equal(100, ip.Limit)
+	})
+
+	s.Run("override applies to IP-only checks", func() {
+		result, err := svc.CheckIP(ctx, "10.2.0.3", models.ClassRead, export)
+		s.Require().NoError(err)
+		s.Equal(2, result.Limit)
+	})
+
+	s.Run("requests without a path use the class default", func() {
+		result, err := svc.CheckIP(context.Background(), "10.2.0.4", models.ClassRead, "")
+		s.Require().NoError(err)
+		s.Equal(100, result.Limit)
+	})
+}
+
+// =============================================================================
+// Decision Tests
+// =============================================================================
+// Justification: The decision explains results that look alike from outside
+// (an allowlisted request and one under its limit are both allowed), so each
+// path is pinned where the cause can be controlled.
+
+func (s *RequestLimitServiceSuite) TestDecision() {
+	ctx := context.Background()
+	allowlist := func(entryType models.AllowlistEntryType, identifier string) {
+		err := s.allowlistStore.Add(ctx, &models.AllowlistEntry{
+			Type:       entryType,
+			Identifier: models.AllowlistIdentifier(identifier),
+		})
+		s.Require().NoError(err)
+	}
+	newService := func(buckets BucketStore, cfg *config.Config) *Service {
+		svc, err := New(buckets, s.allowlistStore, WithConfig(cfg))
+		s.Require().NoError(err)
+		return svc
+	}
+	denying := newService(denyingBuckets{retryAfter: 10}, config.DefaultConfig())
+
+	s.Run("request with capacity is under_limit", func() {
+		ipResult, err := s.service.CheckIP(ctx, "10.4.0.1", models.ClassRead, "")
+		s.Require().NoError(err)
+		s.Equal(models.DecisionUnderLimit, ipResult.Decision)
+
+		bothResult, err := s.service.CheckBoth(ctx, "10.4.0.1", "user-decision", models.ClassRead, "")
+		s.Require().NoError(err)
+		s.Equal(models.DecisionUnderLimit, bothResult.Decision)
+	})
+
+	s.Run("exhausted bucket is window_exceeded", func() {
+		userResult, err := denying.CheckUser(ctx, "user-decision", models.ClassRead, "")
+		s.Require().NoError(err)
+		s.False(userResult.Allowed)
+		s.Equal(models.DecisionWindowExceeded, userResult.Decision)
+
+		bothResult, err := denying.CheckBoth(ctx, "10.4.0.2", "user-decision", models.ClassRead, "")
+		s.Require().NoError(err)
+		s.Equal(models.DecisionWindowExceeded, bothResult.Decision)
+	})
+
+	s.Run("class without a limit is config_missing_default_deny", func() {
+		cfg := config.DefaultConfig()
+		delete(cfg.IPLimits, models.ClassRead)
+		svc := newService(s.bucketStore, cfg)
+
+		ipResult, err := svc.CheckIP(ctx, "10.4.0.3", models.ClassRead, "")
+		s.Require().NoError(err)
+		s.False(ipResult.Allowed)
+		s.Equal(models.DecisionConfigMissing, ipResult.Decision)
+
+		bothResult, err := svc.CheckBoth(ctx, "10.4.0.3", "user-decision", models.ClassRead, "")
+		s.Require().NoError(err)
+		s.Equal(models.DecisionConfigMissing, bothResult.Decision)
+	})
+
+	s.Run("allowlisted request within its limit is allowlisted", func() {
+		allowlist(models.AllowlistTypeIP, "10.4.0.4")
+
+		ipResult, err := s.service.CheckIP(ctx, "10.4.0.4", models.ClassRead, "")
+		s.Require().NoError(err)
+		s.Equal(models.DecisionAllowlisted, ipResult.Decision)
+
+		bothResult, err := s.service.CheckBoth(ctx, "10.4.0.4", "user-decision", models.ClassRead, "")
+		s.Require().NoError(err)
+		s.Equal(models.DecisionAllowlisted, bothResult.Decision)
+	})
+
+	s.Run("allowlisted request over its limit is bypassed", func() {
+		allowlist(models.AllowlistTypeUserID, "user-bypassed")
+
+		userResult, err := denying.CheckUser(ctx, "user-bypassed", models.ClassRead, "")
+		s.Require().NoError(err)
+		s.True(userResult.Allowed)
+		s.Equal(models.DecisionBypassed, userResult.Decision)
+
+		bothResult, err := denying.CheckBoth(ctx, "10.4.0.5", "user-bypassed", models.ClassRead, "")
+		s.Require().NoError(err)
+		s.True(bothResult.Allowed)
+		s.Equal(models.DecisionBypassed, bothResult.Decision)
+	})
+}
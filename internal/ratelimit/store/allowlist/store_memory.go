@@ -2,10 +2,14 @@ package allowlist
 
 import (
 	"context"
+	"net"
+	"slices"
+	"strings"
 	"sync"
 	"time"
 
 	"credo/internal/ratelimit/models"
+	"credo/pkg/platform/sentinel"
 	"credo/pkg/requestcontext"
 )
 
@@ -33,6 +37,9 @@ func (s *InMemoryAllowlistStore) Remove(ctx context.Context, entryType models.Al
 	s.mu.Lock()
 	defer s.mu.Unlock()
 	key := buildKey(entryType, identifier)
+	if _, exists := s.entries[key]; !exists {
+		return sentinel.ErrNotFound
+	}
 	delete(s.entries, key)
 	return nil
 }
@@ -53,10 +60,22 @@ func (s *InMemoryAllowlistStore) IsAllowlisted(ctx context.Context, identifier s
 		}
 	}
 
+	// IP identifiers may also fall inside an allowlisted network
+	ip := net.ParseIP(identifier)
+	if ip == nil {
+		return false, nil
+	}
+	for _, entry := range s.entries {
+		if entry.ContainsIP(ip) && !entry.IsExpiredAt(now) {
+			return true, nil
+		}
+	}
+
 	return false, nil
 }
 
-// List returns all active (non-expired) allowlist entries.
+// List returns all active (non-expired) allowlist entries, newest first with
+// ties broken by ID to match the Postgres store.
 func (s *InMemoryAllowlistStore) List(ctx context.Context) ([]*models.AllowlistEntry, error) {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
@@ -67,34 +86,28 @@ func (s *InMemoryAllowlistStore) List(ctx context.Context) ([]*models.AllowlistE
 			activeEntries = append(activeEntries, entry)
 		}
 	}
-	return activeEntries, nil
-}
-
-// StartCleanup runs periodic cleanup of expired entries until ctx is cancelled.
-func (s *InMemoryAllowlistStore) StartCleanup(ctx context.Context, interval time.Duration) error {
-	ticker := time.NewTicker(interval)
-	defer ticker.Stop()
-
-	for {
-		select {
-		case <-ticker.C:
-			s.RemoveExpiredAt(time.Now())
-		case <-ctx.Done():
-			return ctx.Err()
+	slices.SortFunc(activeEntries, func(a, b *models.AllowlistEntry) int {
+		if c := b.CreatedAt.Compare(a.CreatedAt); c != 0 {
+			return c
 		}
-	}
+		return strings.Compare(a.ID, b.ID)
+	})
+	return activeEntries, nil
 }
 
-// RemoveExpiredAt removes all entries that have expired as of the given time.
-// Exported for testability; background cleanup passes wall-clock time.
-func (s *InMemoryAllowlistStore) RemoveExpiredAt(now time.Time) {
+// PurgeExpired deletes every entry that has expired as of now and returns how
+// many were removed. Entries without an expiry are never purged.
+func (s *InMemoryAllowlistStore) PurgeExpired(_ context.Context, now time.Time) (int, error) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
+	purged := 0
 	for key, entry := range s.entries {
 		if entry.IsExpiredAt(now) {
 			delete(s.entries, key)
+			purged++
 		}
 	}
+	return purged, nil
 }
 
 func buildKey(entryType models.AllowlistEntryType, identifier string) string {
@@ -12,6 +12,7 @@ import (
 
 	"credo/internal/ratelimit/models"
 	id "credo/pkg/domain"
+	"credo/pkg/platform/sentinel"
 )
 
 // NOTE: Basic Add/Remove tests for IP entries are covered by E2E FR-4 scenarios.
@@ -32,16 +33,50 @@ func TestInMemoryAllowlistStore_Remove(t *testing.T) {
 	store := New()
 	ctx := context.Background()
 
-	// Idempotency edge case: remove non-existent entry should succeed (not covered by E2E)
-	t.Run("remove non-existent entry is idempotent", func(t *testing.T) {
+	// Edge case not covered by E2E: absence is reported so admins learn of typos
+	t.Run("remove non-existent entry reports not found", func(t *testing.T) {
 		err := store.Remove(ctx, models.AllowlistTypeIP, "non-existent-ip")
-		require.NoError(t, err)
+		require.ErrorIs(t, err, sentinel.ErrNotFound)
 	})
 }
 
 // NOTE: IsAllowlisted tests (non-existent, existing, expired) are covered by
 // E2E FR-4 scenarios: "Allowlisted IP bypasses limits", "Allowlist entry expires"
 
+// CIDR containment is not covered by E2E (clients cannot choose their source IP)
+func TestInMemoryAllowlistStore_IsAllowlistedCIDR(t *testing.T) {
+	store := New()
+	ctx := context.Background()
+	require.NoError(t, store.Add(ctx, newAllowlistEntry(t, models.AllowlistTypeCIDR, "10.20.0.0/16")))
+	require.NoError(t, store.Add(ctx, newAllowlistEntry(t, models.AllowlistTypeCIDR, "2001:db8:abcd::/48")))
+	require.NoError(t, store.Add(ctx, newAllowlistEntry(t, models.AllowlistTypeCIDR, "192.168.0.0/24", withExpiry(time.Now().Add(-time.Hour)))))
+
+	for _, tc := range []struct {
+		identifier string
+		want       bool
+	}{
+		{identifier: "10.20.4.7", want: true},
+		{identifier: "10.21.0.1", want: false},
+		{identifier: "2001:db8:abcd:12::1", want: true},
+		{identifier: "2001:db8:abce::1", want: false},
+		{identifier: "192.168.0.10", want: false}, // range expired
+		{identifier: "10.20.0.0/16", want: false}, // a range is not an IP
+	} {
+		t.Run(tc.identifier, func(t *testing.T) {
+			got, err := store.IsAllowlisted(ctx, tc.identifier)
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+
+	// user_id allowlisting stays exact-match
+	t.Run("user id does not match a range", func(t *testing.T) {
+		got, err := store.IsAllowlisted(ctx, uuid.NewString())
+		require.NoError(t, err)
+		assert.False(t, got)
+	})
+}
+
 func TestInMemoryAllowlistStore_List(t *testing.T) {
 	ctx := context.Background()
 
@@ -74,6 +109,42 @@ func TestInMemoryAllowlistStore_List(t *testing.T) {
 	})
 }
 
+// Map iteration order would otherwise leak into admin listings
+func TestInMemoryAllowlistStore_ListOrder(t *testing.T) {
+	ctx := context.Background()
+	store := New()
+	base := time.Now()
+	withCreatedAt := func(at time.Time) func(*models.AllowlistEntry) {
+		return func(entry *models.AllowlistEntry) { entry.CreatedAt = at }
+	}
+	require.NoError(t, store.Add(ctx, newAllowlistEntry(t, models.AllowlistTypeIP, "10.0.0.2", withCreatedAt(base.Add(-time.Hour)))))
+	require.NoError(t, store.Add(ctx, newAllowlistEntry(t, models.AllowlistTypeIP, "10.0.0.1", withCreatedAt(base.Add(-2*time.Hour)))))
+	require.NoError(t, store.Add(ctx, newAllowlistEntry(t, models.AllowlistTypeIP, "10.0.0.4", withCreatedAt(base.Add(-time.Hour)))))
+	require.NoError(t, store.Add(ctx, newAllowlistEntry(t, models.AllowlistTypeIP, "10.0.0.3", withCreatedAt(base.Add(-time.Minute)))))
+
+	identifiers := func() []string {
+		entries, err := store.List(ctx)
+		require.NoError(t, err)
+		out := make([]string, 0, len(entries))
+		for _, entry := range entries {
+			out = append(out, entry.Identifier.String())
+		}
+		return out
+	}
+
+	t.Run("newest first with ties broken by id on every call", func(t *testing.T) {
+		want := []string{"10.0.0.3", "10.0.0.2", "10.0.0.4", "10.0.0.1"}
+		for range 10 {
+			assert.Equal(t, want, identifiers())
+		}
+	})
+
+	t.Run("a new entry lands first", func(t *testing.T) {
+		require.NoError(t, store.Add(ctx, newAllowlistEntry(t, models.AllowlistTypeIP, "10.0.0.5", withCreatedAt(base))))
+		assert.Equal(t, "10.0.0.5", identifiers()[0])
+	})
+}
+
 func TestInMemoryAllowlistStore_Concurrent(t *testing.T) {
 	store := New()
 	ctx := context.Background()
@@ -4,11 +4,13 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"net"
 	"time"
 
 	"credo/internal/ratelimit/models"
 	ratelimitsqlc "credo/internal/ratelimit/store/sqlc"
 	id "credo/pkg/domain"
+	"credo/pkg/platform/sentinel"
 	"credo/pkg/requestcontext"
 
 	"github.com/google/uuid"
@@ -48,13 +50,16 @@ func (s *PostgresStore) Add(ctx context.Context, entry *models.AllowlistEntry) e
 }
 
 func (s *PostgresStore) Remove(ctx context.Context, entryType models.AllowlistEntryType, identifier string) error {
-	err := s.queries.DeleteAllowlistEntry(ctx, ratelimitsqlc.DeleteAllowlistEntryParams{
+	deleted, err := s.queries.DeleteAllowlistEntry(ctx, ratelimitsqlc.DeleteAllowlistEntryParams{
 		EntryType:  string(entryType),
 		Identifier: identifier,
 	})
 	if err != nil {
 		return fmt.Errorf("remove allowlist entry: %w", err)
 	}
+	if deleted == 0 {
+		return sentinel.ErrNotFound
+	}
 	return nil
 }
 
@@ -70,7 +75,28 @@ func (s *PostgresStore) IsAllowlisted(ctx context.Context, identifier string) (b
 	if err != nil {
 		return false, fmt.Errorf("check allowlist: %w", err)
 	}
-	return exists, nil
+	if exists {
+		return true, nil
+	}
+
+	// IP identifiers may also fall inside an allowlisted network
+	ip := net.ParseIP(identifier)
+	if ip == nil {
+		return false, nil
+	}
+	rows, err := s.queries.ListActiveAllowlistEntriesByType(ctx, ratelimitsqlc.ListActiveAllowlistEntriesByTypeParams{
+		EntryType: string(models.AllowlistTypeCIDR),
+		ExpiresAt: sql.NullTime{Time: now, Valid: true},
+	})
+	if err != nil {
+		return false, fmt.Errorf("check allowlist ranges: %w", err)
+	}
+	for _, row := range rows {
+		if toAllowlistEntry(row).ContainsIP(ip) {
+			return true, nil
+		}
+	}
+	return false, nil
 }
 
 func (s *PostgresStore) List(ctx context.Context) ([]*models.AllowlistEntry, error) {
@@ -86,30 +112,14 @@ func (s *PostgresStore) List(ctx context.Context) ([]*models.AllowlistEntry, err
 	return entries, nil
 }
 
-// StartCleanup runs periodic cleanup of expired entries until ctx is cancelled.
-func (s *PostgresStore) StartCleanup(ctx context.Context, interval time.Duration) error {
-	ticker := time.NewTicker(interval)
-	defer ticker.Stop()
-
-	for {
-		select {
-		case <-ticker.C:
-			if err := s.RemoveExpiredAt(ctx, time.Now()); err != nil {
-				return err
-			}
-		case <-ctx.Done():
-			return ctx.Err()
-		}
-	}
-}
-
-// RemoveExpiredAt removes all entries that have expired as of the given time.
-// Exported for testability; background cleanup passes wall-clock time.
-func (s *PostgresStore) RemoveExpiredAt(ctx context.Context, now time.Time) error {
-	if err := s.queries.DeleteExpiredAllowlistEntries(ctx, sql.NullTime{Time: now, Valid: true}); err != nil {
-		return fmt.Errorf("cleanup allowlist entries: %w", err)
+// PurgeExpired deletes every entry that has expired as of now and returns how
+// many were removed. Entries without an expiry are never purged.
+func (s *PostgresStore) PurgeExpired(ctx context.Context, now time.Time) (int, error) {
+	purged, err := s.queries.DeleteExpiredAllowlistEntries(ctx, sql.NullTime{Time: now, Valid: true})
+	if err != nil {
+		return 0, fmt.Errorf("purge expired allowlist entries: %w", err)
 	}
-	return nil
+	return int(purged), nil
 }
 
 func toAllowlistEntry(row ratelimitsqlc.RateLimitAllowlist) *models.AllowlistEntry {
@@ -0,0 +1,112 @@
+//go:build integration
+
+package allowlist_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"credo/internal/ratelimit/models"
+	"credo/internal/ratelimit/store/allowlist"
+	id "credo/pkg/domain"
+	"credo/pkg/requestcontext"
+	"credo/pkg/testutil/containers"
+)
+
+type PostgresStoreSuite struct {
+	suite.Suite
+	postgres *containers.PostgresContainer
+	store    *allowlist.PostgresStore
+}
+
+func TestPostgresStoreSuite(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+	suite.Run(t, new(PostgresStoreSuite))
+}
+
+func (s *PostgresStoreSuite) SetupSuite() {
+	mgr := containers.GetManager()
+	s.postgres = mgr.GetPostgres(s.T())
+	s.store = allowlist.NewPostgres(s.postgres.DB)
+}
+
+func (s *PostgresStoreSuite) SetupTest() {
+	err := s.postgres.TruncateTables(context.Background(), "rate_limit_allowlist")
+	s.Require().NoError(err)
+}
+
+func (s *PostgresStoreSuite) add(ctx context.Context, identifier string, expiresAt *time.Time, now time.Time) {
+	entry, err := models.NewAllowlistEntry(uuid.NewString(), models.AllowlistTypeIP, identifier, "integration test", id.UserID(uuid.New()), expiresAt, now)
+	s.Require().NoError(err)
+	s.Require().NoError(s.store.Add(ctx, entry))
+}
+
+// TestPurgeExpired verifies the purge deletes rows, not just hides them, and
+// leaves live and non-expiring entries in place.
+func (s *PostgresStoreSuite) TestPurgeExpired() {
+	ctx := context.Background()
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	expired := now.Add(-time.Minute)
+	live := now.Add(time.Hour)
+
+	s.add(ctx, "203.0.113.1", &expired, now.Add(-time.Hour))
+	s.add(ctx, "203.0.113.2", &expired, now.Add(-time.Hour))
+	s.add(ctx, "203.0.113.3", &live, now)
+	s.add(ctx, "203.0.113.4", nil, now)
+
+	purged, err := s.store.PurgeExpired(ctx, now)
+	s.Require().NoError(err)
+	s.Equal(2, purged)
+
+	var rows int
+	s.Require().NoError(s.postgres.DB.QueryRowContext(ctx, `SELECT COUNT(*) FROM rate_limit_allowlist`).Scan(&rows))
+	s.Equal(2, rows, "expired rows are deleted from the table")
+
+	entries, err := s.store.List(requestcontext.WithTime(ctx, now))
+	s.Require().NoError(err)
+	identifiers := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		identifiers = append(identifiers, entry.Identifier.String())
+	}
+	s.ElementsMatch([]string{"203.0.113.3", "203.0.113.4"}, identifiers)
+
+	s.Run("purging again removes nothing", func() {
+		purged, err := s.store.PurgeExpired(ctx, now)
+		s.Require().NoError(err)
+		s.Zero(purged)
+	})
+}
+
+// TestListOrder verifies the query returns entries newest first.
+func (s *PostgresStoreSuite) TestListOrder() {
+	ctx := context.Background()
+	now := time.Now().UTC().Truncate(time.Microsecond)
+
+	s.add(ctx, "203.0.113.1", nil, now.Add(-3*time.Hour))
+	s.add(ctx, "203.0.113.2", nil, now.Add(-time.Hour))
+	s.add(ctx, "203.0.113.3", nil, now.Add(-2*time.Hour))
+
+	identifiers := func() []string {
+		entries, err := s.store.List(requestcontext.WithTime(ctx, now))
+		s.Require().NoError(err)
+		out := make([]string, 0, len(entries))
+		for _, entry := range entries {
+			out = append(out, entry.Identifier.String())
+		}
+		return out
+	}
+
+	want := []string{"203.0.113.2", "203.0.113.3", "203.0.113.1"}
+	for range 5 {
+		s.Equal(want, identifiers())
+	}
+
+	s.add(ctx, "203.0.113.4", nil, now)
+	s.Equal("203.0.113.4", identifiers()[0])
+}
@@ -4,6 +4,7 @@ import (
 	"container/list"
 	"context"
 	"hash/fnv"
+	"slices"
 	"sync"
 	"time"
 
@@ -14,7 +15,7 @@ import (
 const (
 	defaultShardCount  = 32
 	defaultMaxBuckets  = 100000 // Max buckets per shard before LRU eviction
-	circularBufferSize = 256    // Fixed size for circular buffer (covers most limits)
+	circularBufferSize = 256    // Minimum circular buffer size; grown to the limit when larger
 
 	// maxClockSkewTolerance is the maximum amount of time a timestamp can be
 	// in the future before it's rejected. This prevents clock skew attacks
@@ -41,15 +42,16 @@ const (
 //	                    ↑ valid requests = 5, at limit
 //
 // The circular buffer provides O(1) amortized operations and bounded memory.
-// When the buffer fills, old entries are overwritten (they're expired anyway).
+// It holds at least as many entries as the limit, so when it fills the entries
+// being overwritten are already expired; a live entry is never dropped.
 // ---------------------------------------------------------------------------
 
 // slidingWindow tracks request timestamps in a circular buffer for rate limiting.
 type slidingWindow struct {
-	timestamps [circularBufferSize]int64 // Request timestamps as Unix nanoseconds
-	writePos   int                       // Next position to write (head of circular buffer)
-	size       int                       // Number of entries written (up to buffer capacity)
-	windowSize time.Duration             // Duration of the sliding window
+	timestamps []int64       // Request timestamps as Unix nanoseconds
+	writePos   int           // Next position to write (head of circular buffer)
+	size       int           // Number of entries written (up to buffer capacity)
+	windowSize time.Duration // Duration of the sliding window
 }
 
 // tryConsume attempts to record 'cost' requests against the rate limit.
@@ -57,22 +59,23 @@ type slidingWindow struct {
 // Returns:
 //   - allowed: true if the request is within the limit
 //   - remaining: number of requests still available in the window
-//   - resetAt: when the oldest request expires (allowing new requests)
+//   - resetAt: when a denied request of the same cost would fit, or when the
+//     window fully drains for an allowed one
 func (sw *slidingWindow) tryConsume(cost int, limit int, now time.Time) (allowed bool, remaining int, resetAt time.Time) {
 	nowNano := now.UnixNano()
 	windowStart := nowNano - sw.windowSize.Nanoseconds()
+	sw.ensureCapacity(limit)
 
-	// Count requests within the current window and find the oldest one
-	requestsInWindow, oldestTimestamp := sw.countRequestsInWindow(windowStart, nowNano)
+	// Count requests within the current window
+	requestsInWindow := sw.countRequestsInWindow(windowStart, nowNano)
 
 	// Update size to reflect only valid entries (lazy cleanup)
 	sw.size = requestsInWindow
 
 	// Check if adding these requests would exceed the limit
 	if requestsInWindow+cost > limit {
-		// Calculate when the oldest request will expire
-		oldestExpiry := time.Unix(0, oldestTimestamp).Add(sw.windowSize)
-		return false, 0, oldestExpiry
+		// Enough requests must expire to make room for cost, not just the oldest one
+		return false, 0, sw.expiryOf(requestsInWindow+cost-limit, windowStart, nowNano)
 	}
 
 	// Record the new request timestamps
@@ -83,11 +86,10 @@ func (sw *slidingWindow) tryConsume(cost int, limit int, now time.Time) (allowed
 	return true, remaining, resetAt
 }
 
-// countRequestsInWindow counts valid requests and finds the oldest timestamp.
+// countRequestsInWindow counts valid requests.
 // Timestamps more than maxClockSkewTolerance in the future are ignored to prevent
 // clock skew attacks from corrupting the sliding window state.
-func (sw *slidingWindow) countRequestsInWindow(windowStart, nowNano int64) (count int, oldestTimestamp int64) {
-	oldestTimestamp = nowNano // Default to now if no valid requests
+func (sw *slidingWindow) countRequestsInWindow(windowStart, nowNano int64) (count int) {
 	maxValidTimestamp := nowNano + maxClockSkewTolerance.Nanoseconds()
 
 	for i := 0; i < sw.size; i++ {
@@ -97,18 +99,52 @@ func (sw *slidingWindow) countRequestsInWindow(windowStart, nowNano int64) (coun
 		// Skip timestamps outside the window or too far in the future (clock skew protection)
 		if ts > windowStart && ts <= maxValidTimestamp {
 			count++
-			if ts < oldestTimestamp {
-				oldestTimestamp = ts
-			}
 		}
 	}
-	return count, oldestTimestamp
+	return count
+}
+
+// expiryOf returns when the n-th oldest valid request leaves the window.
+// Once it has, n slots are free. Only called on denial, so the sort stays off the
+// allowed path.
+func (sw *slidingWindow) expiryOf(n int, windowStart, nowNano int64) time.Time {
+	maxValidTimestamp := nowNano + maxClockSkewTolerance.Nanoseconds()
+	valid := make([]int64, 0, sw.size)
+	for i := 0; i < sw.size; i++ {
+		ts := sw.timestamps[sw.bufferIndex(i)]
+		if ts > windowStart && ts <= maxValidTimestamp {
+			valid = append(valid, ts)
+		}
+	}
+	if len(valid) == 0 {
+		return time.Unix(0, nowNano).Add(sw.windowSize)
+	}
+	slices.Sort(valid)
+	n = min(max(n, 1), len(valid))
+	return time.Unix(0, valid[n-1]).Add(sw.windowSize)
+}
+
+// ensureCapacity grows the buffer so it can hold limit entries, preserving
+// order. Without this, limits above the buffer size would overwrite live
+// entries and never be enforced.
+func (sw *slidingWindow) ensureCapacity(limit int) {
+	capacity := max(limit, circularBufferSize)
+	if len(sw.timestamps) >= capacity {
+		return
+	}
+	grown := make([]int64, capacity)
+	for i := 0; i < sw.size; i++ {
+		grown[i] = sw.timestamps[sw.bufferIndex(i)]
+	}
+	sw.timestamps = grown
+	sw.writePos = sw.size
 }
 
 // bufferIndex calculates the actual array index for a logical position.
 // Position 0 is the oldest entry, position (size-1) is the newest.
 func (sw *slidingWindow) bufferIndex(position int) int {
-	return (sw.writePos - sw.size + position + circularBufferSize) % circularBufferSize
+	capacity := len(sw.timestamps)
+	return (sw.writePos - sw.size + position + capacity) % capacity
 }
 
 // recordRequests writes 'count' request timestamps to the buffer.
@@ -123,21 +159,20 @@ func (sw *slidingWindow) recordRequests(count int, timestamp int64, nowNano int6
 
 	for range count {
 		sw.timestamps[sw.writePos] = timestamp
-		sw.writePos = (sw.writePos + 1) % circularBufferSize
+		sw.writePos = (sw.writePos + 1) % len(sw.timestamps)
 
-		if sw.size < circularBufferSize {
+		if sw.size < len(sw.timestamps) {
 			sw.size++
 		}
-		// When size == circularBufferSize, we're overwriting old entries
-		// This is fine because they're expired anyway
+		// When the buffer is full, we're overwriting old entries
+		// This is fine because capacity >= limit, so they're expired anyway
 	}
 }
 
 // currentCount returns the number of requests currently in the window.
 func (sw *slidingWindow) currentCount(now time.Time) int {
 	windowStart := now.UnixNano() - sw.windowSize.Nanoseconds()
-	count, _ := sw.countRequestsInWindow(windowStart, now.UnixNano())
-	return count
+	return sw.countRequestsInWindow(windowStart, now.UnixNano())
 }
 
 // lruEntry wraps a sliding window with LRU tracking.
@@ -7,6 +7,8 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/suite"
+
+	"credo/pkg/requestcontext"
 )
 
 const (
@@ -62,6 +64,70 @@ func (s *InMemoryBucketStoreSuite) TestAllowN() {
 	})
 }
 
+// Security test: a window boundary must not let a burst through. A fixed window
+// would admit the limit again at the boundary; the sliding log only frees slots
+// as individual requests expire, and ResetAt reports exactly when.
+func (s *InMemoryBucketStoreSuite) TestSlidingWindowAccuracy() {
+	start := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	at := func(offset time.Duration) context.Context {
+		return requestcontext.WithTime(s.ctx, start.Add(offset))
+	}
+
+	s.Run("no burst across window boundary", func() {
+		key := "sliding:boundary"
+		for i := range testLimit {
+			result, err := s.store.Allow(at(time.Duration(i)*time.Second), key, testLimit, testWindow)
+			s.Require().NoError(err)
+			s.Require().True(result.Allowed)
+		}
+
+		// Just past one window from the first request, only that first slot is free
+		result, err := s.store.Allow(at(testWindow), key, testLimit, testWindow)
+		s.Require().NoError(err)
+		s.True(result.Allowed)
+		s.Equal(0, result.Remaining)
+
+		result, err = s.store.Allow(at(testWindow), key, testLimit, testWindow)
+		s.Require().NoError(err)
+		s.False(result.Allowed)
+		s.WithinDuration(start.Add(time.Second+testWindow), result.ResetAt, 0)
+	})
+
+	s.Run("denied cost reports when enough requests expire", func() {
+		key := "sliding:cost"
+		for i := range testLimit {
+			_, err := s.store.Allow(at(time.Duration(i)*time.Second), key, testLimit, testWindow)
+			s.Require().NoError(err)
+		}
+
+		result, err := s.store.AllowN(at(10*time.Second), key, 3, testLimit, testWindow)
+		s.Require().NoError(err)
+		s.False(result.Allowed)
+		s.WithinDuration(start.Add(2*time.Second+testWindow), result.ResetAt, 0, "third-oldest request must expire")
+		s.Equal(52, result.RetryAfter)
+	})
+
+	s.Run("limits above the initial buffer size are enforced", func() {
+		key := "sliding:large"
+		limit := circularBufferSize + 44
+		result, err := s.store.AllowN(at(0), key, circularBufferSize, limit, testWindow)
+		s.Require().NoError(err)
+		s.Require().True(result.Allowed)
+
+		result, err = s.store.AllowN(at(time.Second), key, 44, limit, testWindow)
+		s.Require().NoError(err)
+		s.Require().True(result.Allowed)
+
+		result, err = s.store.Allow(at(2*time.Second), key, limit, testWindow)
+		s.Require().NoError(err)
+		s.False(result.Allowed, "live entries must not be overwritten")
+
+		count, err := s.store.GetCurrentCount(at(2*time.Second), key)
+		s.Require().NoError(err)
+		s.Equal(limit, count)
+	})
+}
+
 func (s *InMemoryBucketStoreSuite) TestReset() {
 	key := "reset"
 	_, err := s.store.AllowN(s.ctx, key, 5, testLimit, testWindow)
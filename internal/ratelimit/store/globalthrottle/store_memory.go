@@ -8,14 +8,15 @@ import (
 )
 
 // InMemoryGlobalThrottleStore implements global rate limiting with atomic counters.
-// Uses tumbling windows (per-second and per-hour) for lock-free operation.
+// Uses tumbling windows (per-second, or a configured window, and per-hour) for lock-free operation.
 // This provides approximate rate limiting with minimal contention.
 // For production, use the PostgreSQL-backed store.
 type InMemoryGlobalThrottleStore struct {
 	// Per-second tracking (tumbling window)
 	secondCount    atomic.Int64
-	secondBucket   atomic.Int64 // Unix timestamp of current second bucket
+	secondBucket   atomic.Int64 // Unix timestamp of current window bucket
 	perSecondLimit int
+	window         time.Duration
 
 	// Per-hour tracking (tumbling window)
 	hourCount    atomic.Int64
@@ -33,6 +34,16 @@ func WithPerSecondLimit(limit int) Option {
 	}
 }
 
+// WithWindow sets the tumbling window the per-second limit applies to, in whole seconds.
+// Non-positive values keep the default of one second.
+func WithWindow(window time.Duration) Option {
+	return func(s *InMemoryGlobalThrottleStore) {
+		if window > 0 {
+			s.window = window
+		}
+	}
+}
+
 // WithPerHourLimit sets the per-hour limit.
 func WithPerHourLimit(limit int) Option {
 	return func(s *InMemoryGlobalThrottleStore) {
@@ -45,6 +56,7 @@ func New(opts ...Option) *InMemoryGlobalThrottleStore {
 	s := &InMemoryGlobalThrottleStore{
 		perSecondLimit: 1000,   // Default: 1000 req/sec per instance
 		perHourLimit:   100000, // Default: 100k req/hour per instance
+		window:         time.Second,
 	}
 
 	for _, opt := range opts {
@@ -59,7 +71,7 @@ func New(opts ...Option) *InMemoryGlobalThrottleStore {
 // preventing TOCTOU races where multiple goroutines could temporarily exceed the limit.
 func (s *InMemoryGlobalThrottleStore) IncrementGlobal(ctx context.Context) (count int, blocked bool, err error) {
 	now := requestcontext.Now(ctx)
-	currentSecond := now.Unix()
+	currentSecond := now.Truncate(s.window).Unix()
 	currentHour := now.Truncate(time.Hour).Unix()
 
 	// Check and potentially reset per-second counter
@@ -118,9 +130,9 @@ func (s *InMemoryGlobalThrottleStore) tryIncrementWithLimit(counter *atomic.Int6
 // GetGlobalCount returns the current count in the per-second window.
 func (s *InMemoryGlobalThrottleStore) GetGlobalCount(ctx context.Context) (count int, err error) {
 	now := requestcontext.Now(ctx)
-	currentSecond := now.Unix()
+	currentSecond := now.Truncate(s.window).Unix()
 
-	// If we're in a new second, the effective count is 0
+	// If we're in a new window, the effective count is 0
 	if currentSecond != s.secondBucket.Load() {
 		return 0, nil
 	}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"strconv"
+	"sync/atomic"
 	"time"
 
 	"credo/internal/ratelimit/config"
@@ -16,11 +18,24 @@ const (
 	bucketHour   = "hour"
 )
 
+// MaxShards bounds GlobalLimit.Shards so shard keys fit the bucket_type column.
+const MaxShards = 64
+
 // PostgresStore persists global throttle counters in PostgreSQL.
+//
+// With a single shard every request locks the same "second" and "hour" rows,
+// which become hot under heavy load. With N shards each request locks one of
+// N sub-counter rows (chosen round-robin) and checks the limit against the sum
+// of all shards in the current window. Writers on different shards do not see
+// each other's uncommitted increments, so under contention the aggregate can
+// overshoot the limit by at most N-1 requests per window.
 type PostgresStore struct {
 	db             *sql.DB
 	perSecondLimit int
 	perHourLimit   int
+	window         time.Duration
+	shards         int
+	next           atomic.Uint64
 	queries        *ratelimitsqlc.Queries
 }
 
@@ -30,18 +45,51 @@ func NewPostgres(db *sql.DB, cfg *config.GlobalLimit) *PostgresStore {
 		defaultCfg := config.DefaultConfig().Global
 		cfg = &defaultCfg
 	}
+	window := cfg.Window
+	if window <= 0 {
+		window = time.Second
+	}
+	shards := min(max(cfg.Shards, 1), MaxShards)
 	return &PostgresStore{
 		db:             db,
 		perSecondLimit: cfg.GlobalPerSecond,
 		perHourLimit:   cfg.PerInstancePerHour,
+		window:         window,
+		shards:         shards,
 		queries:        ratelimitsqlc.New(db),
 	}
 }
 
+// shardKey returns the bucket_type row for a shard. A single shard keeps the
+// unsuffixed key so existing rows stay valid.
+func (s *PostgresStore) shardKey(bucketType string, shard int) string {
+	if s.shards == 1 {
+		return bucketType
+	}
+	return bucketType + ":" + strconv.Itoa(shard)
+}
+
+// nextShard picks the shard for the next increment, round-robin.
+func (s *PostgresStore) nextShard() int {
+	return int(s.next.Add(1) % uint64(s.shards)) //nolint:gosec // shards is bounded by MaxShards
+}
+
+// sumShards returns the count across every shard of bucketType in the window starting at bucketStart.
+func (s *PostgresStore) sumShards(ctx context.Context, queries *ratelimitsqlc.Queries, bucketType string, bucketStart time.Time) (int, error) {
+	total, err := queries.SumGlobalThrottleBuckets(ctx, ratelimitsqlc.SumGlobalThrottleBucketsParams{
+		BucketType:  bucketType + "%",
+		BucketStart: bucketStart,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("sum global throttle shards: %w", err)
+	}
+	return int(total), nil
+}
+
 // IncrementGlobal increments the global counter and checks if the request is blocked.
 func (s *PostgresStore) IncrementGlobal(ctx context.Context) (count int, blocked bool, err error) {
 	now := requestcontext.Now(ctx)
-	currentSecond := now.Truncate(time.Second)
+	currentSecond := now.Truncate(s.window)
 	currentHour := now.Truncate(time.Hour)
 
 	tx, err := s.db.BeginTx(ctx, nil)
@@ -53,46 +101,61 @@ func (s *PostgresStore) IncrementGlobal(ctx context.Context) (count int, blocked
 	}()
 
 	qtx := s.queries.WithTx(tx)
-	secStart, secCount, err := s.loadBucket(ctx, qtx, bucketSecond, currentSecond)
+	shard := s.nextShard()
+	secKey := s.shardKey(bucketSecond, shard)
+	hourKey := s.shardKey(bucketHour, shard)
+	secStart, secCount, err := s.loadBucket(ctx, qtx, secKey, currentSecond)
 	if err != nil {
 		return 0, false, err
 	}
-	hourStart, hourCount, err := s.loadBucket(ctx, qtx, bucketHour, currentHour)
+	hourStart, hourCount, err := s.loadBucket(ctx, qtx, hourKey, currentHour)
 	if err != nil {
 		return 0, false, err
 	}
 
-	if secCount+1 > s.perSecondLimit {
+	// The limit applies to the aggregate; other shards are read without locking.
+	secTotal, hourTotal := secCount, hourCount
+	if s.shards > 1 {
+		if secTotal, err = s.sumShards(ctx, qtx, bucketSecond, secStart); err != nil {
+			return 0, false, err
+		}
+		if hourTotal, err = s.sumShards(ctx, qtx, bucketHour, hourStart); err != nil {
+			return 0, false, err
+		}
+	}
+
+	if secTotal+1 > s.perSecondLimit {
 		if err := tx.Commit(); err != nil {
 			return 0, false, fmt.Errorf("commit global throttle tx: %w", err)
 		}
-		return secCount, true, nil
+		return secTotal, true, nil
 	}
-	if hourCount+1 > s.perHourLimit {
+	if hourTotal+1 > s.perHourLimit {
 		if err := tx.Commit(); err != nil {
 			return 0, false, fmt.Errorf("commit global throttle tx: %w", err)
 		}
-		return hourCount, true, nil
+		return hourTotal, true, nil
 	}
 
-	secCount++
-	hourCount++
-	if err := s.updateBucket(ctx, qtx, bucketSecond, secStart, secCount); err != nil {
+	if err := s.updateBucket(ctx, qtx, secKey, secStart, secCount+1); err != nil {
 		return 0, false, err
 	}
-	if err := s.updateBucket(ctx, qtx, bucketHour, hourStart, hourCount); err != nil {
+	if err := s.updateBucket(ctx, qtx, hourKey, hourStart, hourCount+1); err != nil {
 		return 0, false, err
 	}
 
 	if err := tx.Commit(); err != nil {
 		return 0, false, fmt.Errorf("commit global throttle tx: %w", err)
 	}
-	return secCount, false, nil
+	return secTotal + 1, false, nil
 }
 
-// GetGlobalCount returns the current count in the per-second window.
+// GetGlobalCount returns the current count in the per-second window, summed across shards.
 func (s *PostgresStore) GetGlobalCount(ctx context.Context) (count int, err error) {
-	now := requestcontext.Now(ctx).Truncate(time.Second)
+	now := requestcontext.Now(ctx).Truncate(s.window)
+	if s.shards > 1 {
+		return s.sumShards(ctx, s.queries, bucketSecond, now)
+	}
 	var bucketStart time.Time
 	var current int32
 	row, err := s.queries.GetGlobalThrottleBucket(ctx, bucketSecond)
@@ -151,7 +214,6 @@ func (s *PostgresStore) ensureBucketCurrent(ctx context.Context, queries *rateli
 }
 
 func (s *PostgresStore) updateBucket(ctx context.Context, queries *ratelimitsqlc.Queries, bucketType string, bucketStart time.Time, count int) error {
-	count = min(1000, count)
 	if err := queries.UpdateGlobalThrottleBucket(ctx, ratelimitsqlc.UpdateGlobalThrottleBucketParams{
 		BucketType:  bucketType,
 		BucketStart: bucketStart,
@@ -217,3 +217,78 @@ func (s *PostgresStoreSuite) TestConcurrentWithDifferentLimits() {
 	// Should allow exactly limit requests
 	s.Equal(int32(cfg.GlobalPerSecond), allowed.Load())
 }
+
+// TestShardedAggregate verifies that a sharded store spreads increments across
+// sub-counter rows while enforcing the limit on their sum.
+func (s *PostgresStoreSuite) TestShardedAggregate() {
+	fixedTime := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	ctx := requestcontext.WithTime(context.Background(), fixedTime)
+	cfg := &config.GlobalLimit{
+		GlobalPerSecond:    12,
+		PerInstancePerHour: 1000,
+		Shards:             4,
+	}
+	store := globalthrottle.NewPostgres(s.postgres.DB, cfg)
+
+	for i := 0; i < cfg.GlobalPerSecond; i++ {
+		count, blocked, err := store.IncrementGlobal(ctx)
+		s.Require().NoError(err)
+		s.False(blocked, "request %d should not be blocked", i+1)
+		s.Equal(i+1, count, "count is the aggregate across shards")
+	}
+
+	_, blocked, err := store.IncrementGlobal(ctx)
+	s.Require().NoError(err)
+	s.True(blocked, "limit applies to the sum of shards, not each shard")
+
+	count, err := store.GetGlobalCount(ctx)
+	s.Require().NoError(err)
+	s.Equal(cfg.GlobalPerSecond, count)
+
+	rows, err := s.postgres.DB.QueryContext(ctx, `SELECT bucket_type, count FROM global_throttle WHERE bucket_type LIKE 'second:%'`)
+	s.Require().NoError(err)
+	defer rows.Close()
+	perShard := map[string]int{}
+	for rows.Next() {
+		var key string
+		var n int
+		s.Require().NoError(rows.Scan(&key, &n))
+		perShard[key] = n
+	}
+	s.Require().NoError(rows.Err())
+	s.Len(perShard, cfg.Shards, "writes spread across every shard")
+	for key, n := range perShard {
+		s.Equal(cfg.GlobalPerSecond/cfg.Shards, n, "shard %s", key)
+	}
+}
+
+// TestShardedConcurrentOvershootBounded verifies concurrent increments on a
+// sharded store overshoot the aggregate limit by at most Shards-1.
+func (s *PostgresStoreSuite) TestShardedConcurrentOvershootBounded() {
+	fixedTime := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	ctx := requestcontext.WithTime(context.Background(), fixedTime)
+	cfg := &config.GlobalLimit{
+		GlobalPerSecond:    20,
+		PerInstancePerHour: 1000,
+		Shards:             4,
+	}
+	store := globalthrottle.NewPostgres(s.postgres.DB, cfg)
+
+	const goroutines = 60
+	var wg sync.WaitGroup
+	var allowed atomic.Int32
+	for i := 0; i < goroutines; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, blocked, err := store.IncrementGlobal(ctx)
+			if err == nil && !blocked {
+				allowed.Add(1)
+			}
+		}()
+	}
+	wg.Wait()
+
+	s.GreaterOrEqual(allowed.Load(), int32(cfg.GlobalPerSecond))
+	s.LessOrEqual(allowed.Load(), int32(cfg.GlobalPerSecond+cfg.Shards-1))
+}
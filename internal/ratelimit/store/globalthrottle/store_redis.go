@@ -0,0 +1,142 @@
+package globalthrottle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"credo/internal/ratelimit/config"
+	"credo/pkg/requestcontext"
+)
+
+// redisKeyPrefix namespaces the per-window counters: global:throttle:<unix_second>
+// with one shard, global:throttle:<unix_second>:<shard> with several.
+const redisKeyPrefix = "global:throttle:"
+
+// RedisStore keeps the cluster-wide global throttle count in Redis.
+//
+// Each window has its own key, incremented with INCR and given a TTL of two
+// windows so stale keys expire on their own. Every attempt is counted,
+// including ones that end up blocked, so the count is an upper bound on the
+// requests admitted in the window. Only GlobalPerSecond is enforced here; the
+// per-instance hourly cap belongs to the in-process tier in front of it.
+//
+// With N shards, as in PostgresStore, each request increments one of N
+// sub-counter keys (chosen round-robin) and the limit applies to their sum.
+// The increment and the sum run in one MULTI, so sharding never overshoots.
+type RedisStore struct {
+	client         *redis.Client
+	perSecondLimit int
+	window         time.Duration
+	shards         int
+	next           atomic.Uint64
+}
+
+// NewRedis constructs a Redis-backed global throttle store.
+func NewRedis(client *redis.Client, cfg *config.GlobalLimit) *RedisStore {
+	if cfg == nil {
+		defaultCfg := config.DefaultConfig().Global
+		cfg = &defaultCfg
+	}
+	window := cfg.Window
+	if window <= 0 {
+		window = time.Second
+	}
+	return &RedisStore{
+		client:         client,
+		perSecondLimit: cfg.GlobalPerSecond,
+		window:         window,
+		shards:         min(max(cfg.Shards, 1), MaxShards),
+	}
+}
+
+// keys returns every shard key of the current window. A single shard keeps the
+// unsuffixed key so counters written before sharding stay valid.
+func (s *RedisStore) keys(ctx context.Context) []string {
+	window := redisKeyPrefix + strconv.FormatInt(requestcontext.Now(ctx).Truncate(s.window).Unix(), 10)
+	if s.shards == 1 {
+		return []string{window}
+	}
+	keys := make([]string, s.shards)
+	for shard := range keys {
+		keys[shard] = window + ":" + strconv.Itoa(shard)
+	}
+	return keys
+}
+
+// nextShard picks the shard for the next increment, round-robin.
+func (s *RedisStore) nextShard() int {
+	return int(s.next.Add(1) % uint64(s.shards)) //nolint:gosec // shards is bounded by MaxShards
+}
+
+// IncrementGlobal increments the current window's counter and reports whether
+// the global limit is exceeded.
+func (s *RedisStore) IncrementGlobal(ctx context.Context) (count int, blocked bool, err error) {
+	keys := s.keys(ctx)
+	key := keys[s.nextShard()]
+	var incr *redis.IntCmd
+	var shards *redis.SliceCmd
+	_, err = s.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		incr = pipe.Incr(ctx, key)
+		pipe.Expire(ctx, key, 2*s.window)
+		if len(keys) > 1 {
+			shards = pipe.MGet(ctx, keys...)
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, false, fmt.Errorf("increment global throttle: %w", err)
+	}
+	count = int(incr.Val())
+	if shards != nil {
+		if count, err = sumShardValues(shards.Val()); err != nil {
+			return 0, false, fmt.Errorf("increment global throttle: %w", err)
+		}
+	}
+	return count, count > s.perSecondLimit, nil
+}
+
+// GetGlobalCount returns the count in the current window, summed across shards.
+func (s *RedisStore) GetGlobalCount(ctx context.Context) (count int, err error) {
+	keys := s.keys(ctx)
+	if len(keys) > 1 {
+		values, err := s.client.MGet(ctx, keys...).Result()
+		if err != nil {
+			return 0, fmt.Errorf("get global count: %w", err)
+		}
+		if count, err = sumShardValues(values); err != nil {
+			return 0, fmt.Errorf("get global count: %w", err)
+		}
+		return count, nil
+	}
+	count, err = s.client.Get(ctx, keys[0]).Int()
+	if errors.Is(err, redis.Nil) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("get global count: %w", err)
+	}
+	return count, nil
+}
+
+// sumShardValues adds up MGET results; shards not written this window are nil.
+func sumShardValues(values []any) (int, error) {
+	total := 0
+	for _, v := range values {
+		str, ok := v.(string)
+		if !ok {
+			continue
+		}
+		n, err := strconv.Atoi(str)
+		if err != nil {
+			return 0, fmt.Errorf("parse shard count: %w", err)
+		}
+		total += n
+	}
+	return total, nil
+}
@@ -0,0 +1,148 @@
+//go:build integration
+
+package globalthrottle_test
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/suite"
+
+	"credo/internal/ratelimit/config"
+	"credo/internal/ratelimit/store/globalthrottle"
+	"credo/pkg/requestcontext"
+	"credo/pkg/testutil/containers"
+)
+
+type RedisStoreSuite struct {
+	suite.Suite
+	redis *containers.RedisContainer
+}
+
+func TestRedisStoreSuite(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+	suite.Run(t, new(RedisStoreSuite))
+}
+
+func (s *RedisStoreSuite) SetupSuite() {
+	mgr := containers.GetManager()
+	s.redis = mgr.GetRedis(s.T())
+}
+
+func (s *RedisStoreSuite) SetupTest() {
+	s.Require().NoError(s.redis.FlushAll(context.Background()))
+}
+
+// TestLimitEnforcement verifies blocking starts at limit+1 within one window.
+func (s *RedisStoreSuite) TestLimitEnforcement() {
+	ctx := requestcontext.WithTime(context.Background(), time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC))
+	store := globalthrottle.NewRedis(s.redis.Client, &config.GlobalLimit{GlobalPerSecond: 10})
+
+	for i := 0; i < 10; i++ {
+		count, blocked, err := store.IncrementGlobal(ctx)
+		s.Require().NoError(err)
+		s.False(blocked, "request %d should not be blocked", i+1)
+		s.Equal(i+1, count)
+	}
+
+	_, blocked, err := store.IncrementGlobal(ctx)
+	s.Require().NoError(err)
+	s.True(blocked)
+}
+
+// TestSharedAcrossInstances verifies two stores on one Redis share the count,
+// as separate gateway instances would.
+func (s *RedisStoreSuite) TestSharedAcrossInstances() {
+	ctx := requestcontext.WithTime(context.Background(), time.Date(2024, 1, 1, 12, 0, 1, 0, time.UTC))
+	cfg := &config.GlobalLimit{GlobalPerSecond: 50}
+	instances := []*globalthrottle.RedisStore{
+		globalthrottle.NewRedis(s.redis.Client, cfg),
+		globalthrottle.NewRedis(s.redis.Client, cfg),
+	}
+
+	const goroutines = 100
+	var wg sync.WaitGroup
+	var allowed atomic.Int32
+	for i := 0; i < goroutines; i++ {
+		wg.Add(1)
+		go func(store *globalthrottle.RedisStore) {
+			defer wg.Done()
+			_, blocked, err := store.IncrementGlobal(ctx)
+			if err == nil && !blocked {
+				allowed.Add(1)
+			}
+		}(instances[i%len(instances)])
+	}
+	wg.Wait()
+
+	s.Equal(int32(cfg.GlobalPerSecond), allowed.Load())
+	count, err := instances[0].GetGlobalCount(ctx)
+	s.Require().NoError(err)
+	s.Equal(goroutines, count, "every attempt is counted")
+}
+
+// TestShardedAggregate verifies a sharded store spreads increments across
+// shard keys while enforcing the limit on their sum.
+func (s *RedisStoreSuite) TestShardedAggregate() {
+	ctx := requestcontext.WithTime(context.Background(), time.Date(2024, 1, 1, 12, 0, 3, 0, time.UTC))
+	cfg := &config.GlobalLimit{GlobalPerSecond: 8, Shards: 4}
+	store := globalthrottle.NewRedis(s.redis.Client, cfg)
+
+	for i := 0; i < cfg.GlobalPerSecond; i++ {
+		count, blocked, err := store.IncrementGlobal(ctx)
+		s.Require().NoError(err)
+		s.False(blocked, "request %d should not be blocked", i+1)
+		s.Equal(i+1, count, "count is the aggregate across shards")
+	}
+	_, blocked, err := store.IncrementGlobal(ctx)
+	s.Require().NoError(err)
+	s.True(blocked, "limit applies to the sum of shards, not each shard")
+
+	keys, err := s.redis.Client.Keys(context.Background(), "global:throttle:1704110403:*").Result()
+	s.Require().NoError(err)
+	s.Len(keys, cfg.Shards, "writes spread across every shard")
+	count, err := store.GetGlobalCount(ctx)
+	s.Require().NoError(err)
+	s.Equal(cfg.GlobalPerSecond+1, count)
+}
+
+// TestWindowKeysExpire verifies a new window starts from zero and old keys get a TTL.
+func (s *RedisStoreSuite) TestWindowKeysExpire() {
+	first := time.Date(2024, 1, 1, 12, 0, 2, 0, time.UTC)
+	store := globalthrottle.NewRedis(s.redis.Client, &config.GlobalLimit{GlobalPerSecond: 1})
+
+	_, _, err := store.IncrementGlobal(requestcontext.WithTime(context.Background(), first))
+	s.Require().NoError(err)
+
+	next := requestcontext.WithTime(context.Background(), first.Add(time.Second))
+	count, err := store.GetGlobalCount(next)
+	s.Require().NoError(err)
+	s.Equal(0, count)
+	_, blocked, err := store.IncrementGlobal(next)
+	s.Require().NoError(err)
+	s.False(blocked)
+
+	ttl, err := s.redis.Client.TTL(context.Background(), "global:throttle:1704110402").Result()
+	s.Require().NoError(err)
+	s.Greater(ttl, time.Duration(0))
+	s.LessOrEqual(ttl, 2*time.Second)
+}
+
+// TestUnavailableRedisErrors verifies Redis failures surface as errors so the
+// middleware can apply its fail-open policy.
+func (s *RedisStoreSuite) TestUnavailableRedisErrors() {
+	client := redis.NewClient(&redis.Options{Addr: "127.0.0.1:1", MaxRetries: -1})
+	defer client.Close()
+	store := globalthrottle.NewRedis(client, nil)
+
+	_, _, err := store.IncrementGlobal(context.Background())
+	s.Error(err)
+	_, err = store.GetGlobalCount(context.Background())
+	s.Error(err)
+}
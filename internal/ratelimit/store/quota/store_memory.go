@@ -3,6 +3,7 @@ package quota
 import (
 	"context"
 	"sync"
+	"time"
 
 	c "credo/internal/ratelimit/config"
 	"credo/internal/ratelimit/models"
@@ -10,6 +11,8 @@ import (
 	"credo/pkg/requestcontext"
 )
 
+// InMemoryQuotaStore keeps API key quotas in memory. Methods return copies so
+// callers never read a record while another request updates it.
 type InMemoryQuotaStore struct {
 	mu     sync.RWMutex
 	quotas map[id.APIKeyID]*models.APIKeyQuota
@@ -28,7 +31,7 @@ func (s *InMemoryQuotaStore) GetQuota(_ context.Context, apiKeyID id.APIKeyID) (
 	defer s.mu.RUnlock()
 
 	if quota, exists := s.quotas[apiKeyID]; exists {
-		return quota, nil
+		return copyQuota(quota), nil
 	}
 	return nil, nil
 }
@@ -39,33 +42,62 @@ func (s *InMemoryQuotaStore) IncrementUsage(ctx context.Context, apiKeyID id.API
 
 	quota, exists := s.quotas[apiKeyID]
 	if !exists {
-		now := requestcontext.Now(ctx)
-		limits := s.config.QuotaTiers[models.QuotaTierFree]
-		quota = &models.APIKeyQuota{
-			APIKeyID:       apiKeyID,
-			Tier:           models.QuotaTierFree,
-			MonthlyLimit:   limits.MonthlyRequests,
-			CurrentUsage:   0,
-			OverageAllowed: limits.OverageAllowed,
-			PeriodStart:    now,
-			PeriodEnd:      now.AddDate(0, 1, 0),
-		}
+		quota = s.newQuota(apiKeyID, models.QuotaTierFree, requestcontext.Now(ctx))
 		s.quotas[apiKeyID] = quota
 	}
 	quota.CurrentUsage += count
-	return quota, nil
+	return copyQuota(quota), nil
 }
 
-// ResetQuota resets the usage counter for an API key (PRD-017 FR-5)
-func (s *InMemoryQuotaStore) ResetQuota(ctx context.Context, apiKeyID id.APIKeyID) error {
+// RecordOverage adds billable overage units to an existing quota (PRD-017 FR-5).
+// Keys without a quota record have nothing to bill and are ignored.
+func (s *InMemoryQuotaStore) RecordOverage(_ context.Context, apiKeyID id.APIKeyID, units int) (*models.APIKeyQuota, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	quota, exists := s.quotas[apiKeyID]
+	if !exists {
+		return nil, nil
+	}
+	quota.OverageUsage += units
+	return copyQuota(quota), nil
+}
+
+// RolloverPeriod starts a new billing period if the quota's period ended before
+// now (PRD-017 FR-5). The check and reset happen under one lock, so concurrent
+// callers at a month boundary reset usage once.
+func (s *InMemoryQuotaStore) RolloverPeriod(_ context.Context, apiKeyID id.APIKeyID, now time.Time) (*models.APIKeyQuota, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	quota, exists := s.quotas[apiKeyID]
+	if !exists {
+		return nil, nil
+	}
+	quota.RollOver(now)
+	return copyQuota(quota), nil
+}
+
+// SetGraceUntil tolerates over-limit usage until the given time (PRD-017 FR-5).
+func (s *InMemoryQuotaStore) SetGraceUntil(_ context.Context, apiKeyID id.APIKeyID, until time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if quota, exists := s.quotas[apiKeyID]; exists {
+		quota.GraceUntil = &until
+	}
+	return nil
+}
+
+// ResetQuota clears the usage and overage counters for an API key within its
+// current billing period (PRD-017 FR-5)
+func (s *InMemoryQuotaStore) ResetQuota(_ context.Context, apiKeyID id.APIKeyID) error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
 	if quota, exists := s.quotas[apiKeyID]; exists {
-		now := requestcontext.Now(ctx)
 		quota.CurrentUsage = 0
-		quota.PeriodStart = now
-		quota.PeriodEnd = now.AddDate(0, 1, 0)
+		quota.OverageUsage = 0
 	}
 	return nil
 }
@@ -77,7 +109,7 @@ func (s *InMemoryQuotaStore) ListQuotas(_ context.Context) ([]*models.APIKeyQuot
 
 	result := make([]*models.APIKeyQuota, 0, len(s.quotas))
 	for _, quota := range s.quotas {
-		result = append(result, quota)
+		result = append(result, copyQuota(quota))
 	}
 	return result, nil
 }
@@ -90,18 +122,7 @@ func (s *InMemoryQuotaStore) UpdateTier(ctx context.Context, apiKeyID id.APIKeyI
 	quota, exists := s.quotas[apiKeyID]
 	if !exists {
 		// Create new quota with the specified tier
-		now := requestcontext.Now(ctx)
-		limits := s.config.QuotaTiers[tier]
-		quota = &models.APIKeyQuota{
-			APIKeyID:       apiKeyID,
-			Tier:           tier,
-			MonthlyLimit:   limits.MonthlyRequests,
-			CurrentUsage:   0,
-			OverageAllowed: limits.OverageAllowed,
-			PeriodStart:    now,
-			PeriodEnd:      now.AddDate(0, 1, 0),
-		}
-		s.quotas[apiKeyID] = quota
+		s.quotas[apiKeyID] = s.newQuota(apiKeyID, tier, requestcontext.Now(ctx))
 		return nil
 	}
 
@@ -112,3 +133,23 @@ func (s *InMemoryQuotaStore) UpdateTier(ctx context.Context, apiKeyID id.APIKeyI
 	quota.OverageAllowed = limits.OverageAllowed
 	return nil
 }
+
+// newQuota builds a zero-usage quota for tier covering the calendar month of now.
+func (s *InMemoryQuotaStore) newQuota(apiKeyID id.APIKeyID, tier models.QuotaTier, now time.Time) *models.APIKeyQuota {
+	limits := s.config.QuotaTiers[tier]
+	periodStart, periodEnd := models.QuotaPeriod(now)
+	return &models.APIKeyQuota{
+		APIKeyID:       apiKeyID,
+		Tier:           tier,
+		MonthlyLimit:   limits.MonthlyRequests,
+		CurrentUsage:   0,
+		OverageAllowed: limits.OverageAllowed,
+		PeriodStart:    periodStart,
+		PeriodEnd:      periodEnd,
+	}
+}
+
+func copyQuota(quota *models.APIKeyQuota) *models.APIKeyQuota {
+	cp := *quota
+	return &cp
+}
@@ -13,7 +13,7 @@ import (
 	"github.com/google/uuid"
 )
 
-const deleteAllowlistEntry = `-- name: DeleteAllowlistEntry :exec
+const deleteAllowlistEntry = `-- name: DeleteAllowlistEntry :execrows
 DELETE FROM rate_limit_allowlist WHERE entry_type = $1 AND identifier = $2
 `
 
@@ -22,18 +22,24 @@ type DeleteAllowlistEntryParams struct {
 	Identifier string
 }
 
-func (q *Queries) DeleteAllowlistEntry(ctx context.Context, arg DeleteAllowlistEntryParams) error {
-	_, err := q.db.ExecContext(ctx, deleteAllowlistEntry, arg.EntryType, arg.Identifier)
-	return err
+func (q *Queries) DeleteAllowlistEntry(ctx context.Context, arg DeleteAllowlistEntryParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteAllowlistEntry, arg.EntryType, arg.Identifier)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
 }
 
-const deleteExpiredAllowlistEntries = `-- name: DeleteExpiredAllowlistEntries :exec
+const deleteExpiredAllowlistEntries = `-- name: DeleteExpiredAllowlistEntries :execrows
 DELETE FROM rate_limit_allowlist WHERE expires_at IS NOT NULL AND expires_at <= $1
 `
 
-func (q *Queries) DeleteExpiredAllowlistEntries(ctx context.Context, expiresAt sql.NullTime) error {
-	_, err := q.db.ExecContext(ctx, deleteExpiredAllowlistEntries, expiresAt)
-	return err
+func (q *Queries) DeleteExpiredAllowlistEntries(ctx context.Context, expiresAt sql.NullTime) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteExpiredAllowlistEntries, expiresAt)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
 }
 
 const isAllowlisted = `-- name: IsAllowlisted :one
@@ -57,10 +63,54 @@ func (q *Queries) IsAllowlisted(ctx context.Context, arg IsAllowlistedParams) (b
 	return exists, err
 }
 
+const listActiveAllowlistEntriesByType = `-- name: ListActiveAllowlistEntriesByType :many
+SELECT id, entry_type, identifier, reason, expires_at, created_at, created_by
+FROM rate_limit_allowlist
+WHERE entry_type = $1
+  AND (expires_at IS NULL OR expires_at > $2)
+`
+
+type ListActiveAllowlistEntriesByTypeParams struct {
+	EntryType string
+	ExpiresAt sql.NullTime
+}
+
+func (q *Queries) ListActiveAllowlistEntriesByType(ctx context.Context, arg ListActiveAllowlistEntriesByTypeParams) ([]RateLimitAllowlist, error) {
+	rows, err := q.db.QueryContext(ctx, listActiveAllowlistEntriesByType, arg.EntryType, arg.ExpiresAt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []RateLimitAllowlist
+	for rows.Next() {
+		var i RateLimitAllowlist
+		if err := rows.Scan(
+			&i.ID,
+			&i.EntryType,
+			&i.Identifier,
+			&i.Reason,
+			&i.ExpiresAt,
+			&i.CreatedAt,
+			&i.CreatedBy,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listAllowlistEntries = `-- name: ListAllowlistEntries :many
 SELECT id, entry_type, identifier, reason, expires_at, created_at, created_by
 FROM rate_limit_allowlist
 WHERE expires_at IS NULL OR expires_at > $1
+ORDER BY created_at DESC, id
 `
 
 func (q *Queries) ListAllowlistEntries(ctx context.Context, expiresAt sql.NullTime) ([]RateLimitAllowlist, error) {
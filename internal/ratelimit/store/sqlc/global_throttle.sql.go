@@ -63,6 +63,24 @@ func (q *Queries) InsertGlobalThrottleBucket(ctx context.Context, arg InsertGlob
 	return err
 }
 
+const sumGlobalThrottleBuckets = `-- name: SumGlobalThrottleBuckets :one
+SELECT COALESCE(SUM(count), 0)::int AS total
+FROM global_throttle
+WHERE bucket_type LIKE $1 AND bucket_start = $2
+`
+
+type SumGlobalThrottleBucketsParams struct {
+	BucketType  string
+	BucketStart time.Time
+}
+
+func (q *Queries) SumGlobalThrottleBuckets(ctx context.Context, arg SumGlobalThrottleBucketsParams) (int32, error) {
+	row := q.db.QueryRowContext(ctx, sumGlobalThrottleBuckets, arg.BucketType, arg.BucketStart)
+	var total int32
+	err := row.Scan(&total)
+	return total, err
+}
+
 const updateGlobalThrottleBucket = `-- name: UpdateGlobalThrottleBucket :exec
 UPDATE global_throttle
 SET bucket_start = $2, count = $3
@@ -5,7 +5,7 @@ ON CONFLICT (entry_type, identifier) DO UPDATE SET
     reason = EXCLUDED.reason,
     expires_at = EXCLUDED.expires_at;
 
--- name: DeleteAllowlistEntry :exec
+-- name: DeleteAllowlistEntry :execrows
 DELETE FROM rate_limit_allowlist WHERE entry_type = $1 AND identifier = $2;
 
 -- name: IsAllowlisted :one
@@ -16,10 +16,17 @@ SELECT EXISTS(
       AND (expires_at IS NULL OR expires_at > $2)
 );
 
+-- name: ListActiveAllowlistEntriesByType :many
+SELECT id, entry_type, identifier, reason, expires_at, created_at, created_by
+FROM rate_limit_allowlist
+WHERE entry_type = $1
+  AND (expires_at IS NULL OR expires_at > $2);
+
 -- name: ListAllowlistEntries :many
 SELECT id, entry_type, identifier, reason, expires_at, created_at, created_by
 FROM rate_limit_allowlist
-WHERE expires_at IS NULL OR expires_at > $1;
+WHERE expires_at IS NULL OR expires_at > $1
+ORDER BY created_at DESC, id;
 
--- name: DeleteExpiredAllowlistEntries :exec
+-- name: DeleteExpiredAllowlistEntries :execrows
 DELETE FROM rate_limit_allowlist WHERE expires_at IS NOT NULL AND expires_at <= $1;
@@ -18,3 +18,8 @@ ON CONFLICT (bucket_type) DO NOTHING;
 UPDATE global_throttle
 SET bucket_start = $2, count = $3
 WHERE bucket_type = $1;
+
+-- name: SumGlobalThrottleBuckets :one
+SELECT COALESCE(SUM(count), 0)::int AS total
+FROM global_throttle
+WHERE bucket_type LIKE $1 AND bucket_start = $2;
@@ -0,0 +1,121 @@
+// Package allowlistpurge removes expired rate limit allowlist entries in the
+// background. Reads already skip expired entries (PRD-017 FR-4); purging keeps
+// the table from growing with entries that can never match again.
+package allowlistpurge
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"time"
+
+	"credo/pkg/platform/audit"
+	"credo/pkg/platform/audit/publishers/ops"
+	"credo/pkg/requestcontext"
+)
+
+// DefaultInterval is how often expired entries are purged.
+const DefaultInterval = 5 * time.Minute
+
+// AllowlistStore exposes the purge operation. The worker supplies now so
+// expiry is judged against the same clock reads use.
+type AllowlistStore interface {
+	PurgeExpired(ctx context.Context, now time.Time) (int, error)
+}
+
+// Option configures the purge Service.
+type Option func(*Service)
+
+// WithLogger overrides the logger used for purge progress and failures.
+func WithLogger(logger *slog.Logger) Option {
+	return func(s *Service) {
+		if logger != nil {
+			s.logger = logger
+		}
+	}
+}
+
+// WithInterval overrides how often the worker runs when greater than zero.
+func WithInterval(interval time.Duration) Option {
+	return func(s *Service) {
+		if interval > 0 {
+			s.interval = interval
+		}
+	}
+}
+
+// WithAuditPublisher sets the ops publisher that records allowlist_entries_purged.
+func WithAuditPublisher(publisher *ops.Publisher) Option {
+	return func(s *Service) {
+		s.auditPublisher = publisher
+	}
+}
+
+// Service periodically deletes allowlist entries whose expiry has passed.
+type Service struct {
+	store          AllowlistStore
+	logger         *slog.Logger
+	interval       time.Duration
+	auditPublisher *ops.Publisher
+}
+
+// New constructs a purge Service with options applied.
+func New(store AllowlistStore, opts ...Option) (*Service, error) {
+	if store == nil {
+		return nil, fmt.Errorf("allowlist store is required")
+	}
+	svc := &Service{
+		store:    store,
+		logger:   slog.Default(),
+		interval: DefaultInterval,
+	}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(svc)
+		}
+	}
+	return svc, nil
+}
+
+// Start runs purges periodically until ctx is cancelled. A failed run is
+// logged and retried on the next tick.
+func (s *Service) Start(ctx context.Context) error {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			purged, err := s.RunOnce(ctx)
+			if err != nil {
+				s.logger.ErrorContext(ctx, "allowlist purge failed", "error", err)
+				continue
+			}
+			if purged > 0 {
+				s.logger.InfoContext(ctx, "allowlist purge completed", "purged", purged)
+			}
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// RunOnce deletes every entry expired as of requestcontext.Now(ctx) and returns
+// how many were removed. Runs that remove entries emit allowlist_entries_purged.
+func (s *Service) RunOnce(ctx context.Context) (int, error) {
+	now := requestcontext.Now(ctx)
+	purged, err := s.store.PurgeExpired(ctx, now)
+	if err != nil {
+		return 0, err
+	}
+	if purged > 0 && s.auditPublisher != nil {
+		s.auditPublisher.Track(audit.OpsEvent{
+			Timestamp: now,
+			Subject:   "rate_limit_allowlist",
+			Action:    string(audit.EventAllowlistPurged),
+			Metadata:  map[string]string{audit.MetadataCount: strconv.Itoa(purged)},
+		})
+	}
+	return purged, nil
+}
@@ -0,0 +1,86 @@
+package allowlistpurge
+
+// Justification: Allowlist expiries are hours or days out, so E2E scenarios
+// cannot wait for a purge. These tests pin the clock to verify only expired
+// entries are removed and that each purging run is audited with its count.
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"credo/internal/ratelimit/models"
+	allowliststore "credo/internal/ratelimit/store/allowlist"
+	id "credo/pkg/domain"
+	"credo/pkg/platform/audit"
+	"credo/pkg/platform/audit/publishers/ops"
+	auditmemory "credo/pkg/platform/audit/store/memory"
+	"credo/pkg/requestcontext"
+)
+
+type PurgeSuite struct {
+	suite.Suite
+	store      *allowliststore.InMemoryAllowlistStore
+	auditStore *auditmemory.InMemoryStore
+	svc        *Service
+	now        time.Time
+}
+
+func TestPurgeSuite(t *testing.T) {
+	suite.Run(t, new(PurgeSuite))
+}
+
+func (s *PurgeSuite) SetupTest() {
+	s.now = time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	s.store = allowliststore.New()
+	s.auditStore = auditmemory.NewInMemoryStore()
+	var err error
+	s.svc, err = New(s.store, WithAuditPublisher(ops.New(s.auditStore, ops.WithSampleRate(1))))
+	s.Require().NoError(err)
+}
+
+func (s *PurgeSuite) add(identifier string, expiresAt *time.Time) {
+	entry, err := models.NewAllowlistEntry(uuid.NewString(), models.AllowlistTypeIP, identifier, "test", id.UserID(uuid.New()), expiresAt, s.now.Add(-24*time.Hour))
+	s.Require().NoError(err)
+	s.Require().NoError(s.store.Add(context.Background(), entry))
+}
+
+func (s *PurgeSuite) TestRunOnce() {
+	past := s.now.Add(-time.Minute)
+	future := s.now.Add(time.Hour)
+	s.add("10.0.0.1", &past)
+	s.add("10.0.0.2", &past)
+	s.add("10.0.0.3", &future)
+	s.add("10.0.0.4", nil)
+
+	ctx := requestcontext.WithTime(context.Background(), s.now)
+	purged, err := s.svc.RunOnce(ctx)
+	s.Require().NoError(err)
+	s.Equal(2, purged)
+
+	remaining, err := s.store.List(ctx)
+	s.Require().NoError(err)
+	s.Len(remaining, 2)
+
+	s.Eventually(func() bool {
+		events, err := s.auditStore.ListAll(context.Background())
+		return err == nil && len(events) == 1
+	}, time.Second, 10*time.Millisecond)
+	events, err := s.auditStore.ListAll(context.Background())
+	s.Require().NoError(err)
+	s.Equal(string(audit.EventAllowlistPurged), events[0].Action)
+	s.Equal("2", events[0].Metadata[audit.MetadataCount])
+
+	s.Run("a run with nothing expired is not audited", func() {
+		purged, err := s.svc.RunOnce(ctx)
+		s.Require().NoError(err)
+		s.Zero(purged)
+		time.Sleep(20 * time.Millisecond)
+		events, err := s.auditStore.ListAll(context.Background())
+		s.Require().NoError(err)
+		s.Len(events, 1)
+	})
+}
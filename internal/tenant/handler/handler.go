@@ -25,11 +25,12 @@ import (
 // - Currently only platform admin auth is implemented (shared X-Admin-Token)
 // - When tenant admin auth is added, handlers must extract tenant context and use scoped methods
 type Service interface {
-	CreateTenant(ctx context.Context, name string) (*models.Tenant, error)
+	CreateTenantIdempotent(ctx context.Context, name, idempotencyKey string) (*models.Tenant, bool, error)
 	GetTenantDetails(ctx context.Context, id id.TenantID) (*readmodels.TenantDetails, error)
 	GetTenantByName(ctx context.Context, name string) (*models.Tenant, error)
 	DeactivateTenant(ctx context.Context, id id.TenantID) (*models.Tenant, error)
 	ReactivateTenant(ctx context.Context, id id.TenantID) (*models.Tenant, error)
+	DeleteTenant(ctx context.Context, id id.TenantID) (*models.Tenant, error)
 	CreateClient(ctx context.Context, cmd *service.CreateClientCommand) (*models.Client, string, error)
 	GetClient(ctx context.Context, id id.ClientID) (*models.Client, error)
 	GetClientForTenant(ctx context.Context, tenantID id.TenantID, id id.ClientID) (*models.Client, error)
@@ -41,6 +42,9 @@ type Service interface {
 	RotateClientSecretForTenant(ctx context.Context, tenantID id.TenantID, id id.ClientID) (*models.Client, string, error)
 }
 
+// idempotencyKeyHeader carries the client-chosen key that makes a create retry-safe.
+const idempotencyKeyHeader = "Idempotency-Key"
+
 // Handler provides HTTP endpoints for tenant and client management.
 // All endpoints require admin authorization via X-Admin-Token middleware.
 type Handler struct {
@@ -61,6 +65,7 @@ func (h *Handler) Register(r chi.Router) {
 	r.Get("/admin/tenants/by-name/{name}", h.HandleGetTenantByName)
 	r.Post("/admin/tenants/{id}/deactivate", h.HandleDeactivateTenant)
 	r.Post("/admin/tenants/{id}/reactivate", h.HandleReactivateTenant)
+	r.Delete("/admin/tenants/{id}", h.HandleDeleteTenant)
 	r.Post("/admin/clients", h.HandleCreateClient)
 	r.Get("/admin/clients/{id}", h.HandleGetClient)
 	r.Put("/admin/clients/{id}", h.HandleUpdateClient)
@@ -70,7 +75,9 @@ func (h *Handler) Register(r chi.Router) {
 }
 
 // HandleCreateTenant creates a new tenant with the given name.
-// Returns the created tenant with its generated UUID.
+// Returns the created tenant with its generated UUID (201). A retry carrying the
+// same Idempotency-Key header and name returns the original tenant with 200
+// instead of a 409 conflict.
 func (h *Handler) HandleCreateTenant(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	requestID := requestcontext.RequestID(ctx)
@@ -80,14 +87,18 @@ func (h *Handler) HandleCreateTenant(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	tenant, err := h.service.CreateTenant(ctx, req.Name)
+	tenant, created, err := h.service.CreateTenantIdempotent(ctx, req.Name, r.Header.Get(idempotencyKeyHeader))
 	if err != nil {
 		h.logger.ErrorContext(ctx, "create tenant failed", "error", err, "request_id", requestID)
 		httputil.WriteError(w, err)
 		return
 	}
 
-	httputil.WriteJSON(w, http.StatusCreated, &TenantCreateResponse{
+	status := http.StatusCreated
+	if !created {
+		status = http.StatusOK
+	}
+	httputil.WriteJSON(w, status, &TenantCreateResponse{
 		TenantID: tenant.ID.String(),
 		Tenant:   toTenantResponse(tenant),
 	})
@@ -177,6 +188,29 @@ func (h *Handler) HandleReactivateTenant(w http.ResponseWriter, r *http.Request)
 	httputil.WriteJSON(w, http.StatusOK, toTenantResponse(tenant))
 }
 
+// HandleDeleteTenant soft-deletes a tenant.
+// Clients under a deleted tenant stop resolving immediately; the tenant and its
+// users and clients are purged once the retention window elapses.
+func (h *Handler) HandleDeleteTenant(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestID := requestcontext.RequestID(ctx)
+	idStr := chi.URLParam(r, "id")
+	tenantID, err := id.ParseTenantID(idStr)
+	if err != nil {
+		httputil.WriteError(w, dErrors.New(dErrors.CodeBadRequest, "invalid tenant id"))
+		return
+	}
+
+	tenant, err := h.service.DeleteTenant(ctx, tenantID)
+	if err != nil {
+		h.logger.ErrorContext(ctx, "delete tenant failed", "error", err, "request_id", requestID, "tenant_id", tenantID)
+		httputil.WriteError(w, err)
+		return
+	}
+
+	httputil.WriteJSON(w, http.StatusOK, toTenantResponse(tenant))
+}
+
 // HandleCreateClient registers a new client under a tenant.
 func (h *Handler) HandleCreateClient(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -69,6 +69,57 @@ func (s *HandlerSuite) TestAdminTokenRequired() {
 	s.Equal(http.StatusUnauthorized, rec.Code, "expected 401 when admin token missing")
 }
 
+// TestCreateTenantIdempotency verifies that a retried create carrying the same
+// Idempotency-Key returns the original tenant, while different callers still
+// collide on the name.
+func (s *HandlerSuite) TestCreateTenantIdempotency() {
+	create := func(name, key string) *httptest.ResponseRecorder {
+		body, err := json.Marshal(map[string]string{"name": name})
+		s.Require().NoError(err)
+		req := httptest.NewRequest(http.MethodPost, "/admin/tenants", bytes.NewReader(body))
+		req.Header.Set("X-Admin-Token", adminToken)
+		req.Header.Set("Content-Type", "application/json")
+		if key != "" {
+			req.Header.Set("Idempotency-Key", key)
+		}
+		rec := httptest.NewRecorder()
+		s.router.ServeHTTP(rec, req)
+		return rec
+	}
+	tenantID := func(rec *httptest.ResponseRecorder) string {
+		var resp TenantCreateResponse
+		s.Require().NoError(json.NewDecoder(rec.Body).Decode(&resp))
+		return resp.TenantID
+	}
+
+	s.Run("retry with the same key returns the original tenant", func() {
+		first := create("Acme Retry", "key-1")
+		s.Require().Equal(http.StatusCreated, first.Code)
+
+		retry := create("Acme Retry", "key-1")
+		s.Require().Equal(http.StatusOK, retry.Code)
+		s.Equal(tenantID(first), tenantID(retry))
+	})
+
+	s.Run("same name with a different key is a conflict", func() {
+		s.Require().Equal(http.StatusCreated, create("Acme Conflict", "key-2").Code)
+
+		s.Equal(http.StatusConflict, create("Acme Conflict", "key-3").Code)
+	})
+
+	s.Run("same name without a key is a conflict", func() {
+		s.Require().Equal(http.StatusCreated, create("Acme Keyless", "key-4").Code)
+
+		s.Equal(http.StatusConflict, create("Acme Keyless", "").Code)
+	})
+
+	s.Run("reusing a key for a different name is a conflict", func() {
+		s.Require().Equal(http.StatusCreated, create("Acme Original", "key-5").Code)
+
+		s.Equal(http.StatusConflict, create("Acme Different", "key-5").Code)
+	})
+}
+
 // ErrorMappingSuite tests domain error to HTTP status code translation.
 // Feature files can only assert final HTTP status codes; these tests verify
 // that specific domain error codes are correctly mapped through the handler layer.
@@ -185,8 +236,8 @@ var (
 // It returns specific errors based on IDs to test handler error translation.
 type stubService struct{}
 
-func (s *stubService) CreateTenant(ctx context.Context, name string) (*models.Tenant, error) {
-	return nil, dErrors.New(dErrors.CodeInternal, "not implemented")
+func (s *stubService) CreateTenantIdempotent(ctx context.Context, name, idempotencyKey string) (*models.Tenant, bool, error) {
+	return nil, false, dErrors.New(dErrors.CodeInternal, "not implemented")
 }
 
 func (s *stubService) GetTenantDetails(ctx context.Context, tenantID id.TenantID) (*readmodels.TenantDetails, error) {
@@ -211,6 +262,10 @@ func (s *stubService) ReactivateTenant(ctx context.Context, tenantID id.TenantID
 	return nil, dErrors.New(dErrors.CodeNotFound, "tenant not found")
 }
 
+func (s *stubService) DeleteTenant(ctx context.Context, tenantID id.TenantID) (*models.Tenant, error) {
+	return nil, dErrors.New(dErrors.CodeNotFound, "tenant not found")
+}
+
 func (s *stubService) CreateClient(ctx context.Context, cmd *service.CreateClientCommand) (*models.Client, string, error) {
 	return nil, "", dErrors.New(dErrors.CodeInternal, "not implemented")
 }
@@ -17,6 +17,14 @@ import (
 
 const maxNameLength = 128
 
+// Client metadata size limits, checked before the service validates formats.
+const (
+	maxDisplayNameLength    = 128
+	maxLogoURILength        = 2048
+	maxDescriptionLength    = 1000
+	maxSupportContactLength = 320
+)
+
 type CreateTenantRequest struct {
 	Name string `json:"name"`
 }
@@ -49,6 +57,15 @@ type CreateClientRequest struct {
 	AllowedScopes []string `json:"allowed_scopes"`
 	Public        bool     `json:"public_client"`
 
+	// Optional RFC 8707 resource indicators the client may request tokens for
+	AllowedResources []string `json:"allowed_resources"`
+
+	// Optional display metadata
+	DisplayName    string `json:"display_name"`
+	LogoURI        string `json:"logo_uri"`
+	Description    string `json:"description"`
+	SupportContact string `json:"support_contact"`
+
 	tenantID id.TenantID
 }
 
@@ -62,6 +79,11 @@ func (r *CreateClientRequest) Normalize() {
 	r.RedirectURIs = strutil.DedupeAndTrim(r.RedirectURIs)
 	r.AllowedGrants = strutil.DedupeAndTrimLower(r.AllowedGrants)
 	r.AllowedScopes = strutil.DedupeAndTrim(r.AllowedScopes)
+	r.AllowedResources = strutil.DedupeAndTrim(r.AllowedResources)
+	r.DisplayName = strings.TrimSpace(r.DisplayName)
+	r.LogoURI = strings.TrimSpace(r.LogoURI)
+	r.Description = strings.TrimSpace(r.Description)
+	r.SupportContact = strings.TrimSpace(r.SupportContact)
 }
 
 // Validate validates the create client request following strict validation order.
@@ -88,6 +110,12 @@ func (r *CreateClientRequest) validateSizeLimits() error {
 		validation.CheckSliceCount("scopes", len(r.AllowedScopes), validation.MaxScopes),
 		validation.CheckEachStringLength("redirect URI", r.RedirectURIs, validation.MaxRedirectURILength),
 		validation.CheckEachStringLength("scope", r.AllowedScopes, validation.MaxScopeLength),
+		validation.CheckSliceCount("resources", len(r.AllowedResources), validation.MaxResources),
+		validation.CheckEachStringLength("resource", r.AllowedResources, validation.MaxResourceLength),
+		validation.CheckStringLength("display_name", r.DisplayName, maxDisplayNameLength),
+		validation.CheckStringLength("logo_uri", r.LogoURI, maxLogoURILength),
+		validation.CheckStringLength("description", r.Description, maxDescriptionLength),
+		validation.CheckStringLength("support_contact", r.SupportContact, maxSupportContactLength),
 	}
 	for _, err := range checks {
 		if err != nil {
@@ -122,7 +150,7 @@ func (r *CreateClientRequest) validateSyntax() error {
 			return dErrors.New(dErrors.CodeValidation, "invalid redirect_uri format")
 		}
 	}
-	return nil
+	return validateLogoURISyntax(r.LogoURI)
 }
 
 // ToCommand converts the HTTP request to a service command.
@@ -143,12 +171,19 @@ func (r *CreateClientRequest) ToCommand() (*service.CreateClientCommand, error)
 	}
 
 	return &service.CreateClientCommand{
-		TenantID:      tenantID,
-		Name:          r.Name,
-		RedirectURIs:  r.RedirectURIs,
-		AllowedGrants: grants,
-		AllowedScopes: r.AllowedScopes,
-		Public:        r.Public,
+		TenantID:         tenantID,
+		Name:             r.Name,
+		RedirectURIs:     r.RedirectURIs,
+		AllowedGrants:    grants,
+		AllowedScopes:    r.AllowedScopes,
+		Public:           r.Public,
+		AllowedResources: r.AllowedResources,
+		Metadata: models.ClientMetadata{
+			DisplayName:    r.DisplayName,
+			LogoURI:        r.LogoURI,
+			Description:    r.Description,
+			SupportContact: r.SupportContact,
+		},
 	}, nil
 }
 
@@ -158,6 +193,15 @@ type UpdateClientRequest struct {
 	AllowedGrants *[]string `json:"allowed_grants,omitempty"`
 	AllowedScopes *[]string `json:"allowed_scopes,omitempty"`
 	RotateSecret  bool      `json:"rotate_secret"`
+
+	// Optional resource allowlist; an empty list clears it
+	AllowedResources *[]string `json:"allowed_resources,omitempty"`
+
+	// Optional display metadata; an empty string clears the field
+	DisplayName    *string `json:"display_name,omitempty"`
+	LogoURI        *string `json:"logo_uri,omitempty"`
+	Description    *string `json:"description,omitempty"`
+	SupportContact *string `json:"support_contact,omitempty"`
 }
 
 func (r *UpdateClientRequest) Normalize() {
@@ -168,6 +212,11 @@ func (r *UpdateClientRequest) Normalize() {
 	r.RedirectURIs = strutil.DedupeAndTrimPtr(r.RedirectURIs)
 	r.AllowedGrants = strutil.DedupeAndTrimLowerPtr(r.AllowedGrants)
 	r.AllowedScopes = strutil.DedupeAndTrimPtr(r.AllowedScopes)
+	r.AllowedResources = strutil.DedupeAndTrimPtr(r.AllowedResources)
+	r.DisplayName = strutil.TrimSpacePtr(r.DisplayName)
+	r.LogoURI = strutil.TrimSpacePtr(r.LogoURI)
+	r.Description = strutil.TrimSpacePtr(r.Description)
+	r.SupportContact = strutil.TrimSpacePtr(r.SupportContact)
 }
 
 // Validate validates the update client request following strict validation order.
@@ -210,10 +259,41 @@ func (r *UpdateClientRequest) validateSizeLimits() error {
 			return err
 		}
 	}
+	if r.AllowedResources != nil {
+		if err := validation.CheckSliceCount("resources", len(*r.AllowedResources), validation.MaxResources); err != nil {
+			return err
+		}
+		if err := validation.CheckEachStringLength("resource", *r.AllowedResources, validation.MaxResourceLength); err != nil {
+			return err
+		}
+	}
+	metadata := []struct {
+		field string
+		value *string
+		max   int
+	}{
+		{"display_name", r.DisplayName, maxDisplayNameLength},
+		{"logo_uri", r.LogoURI, maxLogoURILength},
+		{"description", r.Description, maxDescriptionLength},
+		{"support_contact", r.SupportContact, maxSupportContactLength},
+	}
+	for _, m := range metadata {
+		if m.value == nil {
+			continue
+		}
+		if err := validation.CheckStringLength(m.field, *m.value, m.max); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
 func (r *UpdateClientRequest) validateSyntax() error {
+	if r.LogoURI != nil {
+		if err := validateLogoURISyntax(*r.LogoURI); err != nil {
+			return err
+		}
+	}
 	if r.RedirectURIs == nil {
 		return nil
 	}
@@ -225,11 +305,27 @@ func (r *UpdateClientRequest) validateSyntax() error {
 	return nil
 }
 
+// validateLogoURISyntax rejects a logo URI that does not parse as an absolute URL.
+// The allowed schemes are enforced by the service.
+func validateLogoURISyntax(uri string) error {
+	if uri == "" {
+		return nil
+	}
+	if parsed, err := url.Parse(uri); err != nil || !parsed.IsAbs() {
+		return dErrors.New(dErrors.CodeValidation, "invalid logo_uri format")
+	}
+	return nil
+}
+
 // ToCommand converts the HTTP request to a service command.
 func (r *UpdateClientRequest) ToCommand() *service.UpdateClientCommand {
 	cmd := &service.UpdateClientCommand{
-		Name:         r.Name,
-		RotateSecret: r.RotateSecret,
+		Name:           r.Name,
+		RotateSecret:   r.RotateSecret,
+		DisplayName:    r.DisplayName,
+		LogoURI:        r.LogoURI,
+		Description:    r.Description,
+		SupportContact: r.SupportContact,
 	}
 
 	if r.RedirectURIs != nil {
@@ -245,6 +341,9 @@ func (r *UpdateClientRequest) ToCommand() *service.UpdateClientCommand {
 	if r.AllowedScopes != nil {
 		cmd.SetAllowedScopes(*r.AllowedScopes)
 	}
+	if r.AllowedResources != nil {
+		cmd.SetAllowedResources(*r.AllowedResources)
+	}
 
 	return cmd
 }
@@ -143,6 +143,30 @@ func (s *CreateClientRequestSuite) TestValidation() {
 }
 
 // TestRequiredFields verifies required field enforcement.
+func (s *CreateClientRequestSuite) TestMetadataValidation() {
+	s.Run("metadata is optional", func() {
+		s.NoError(s.validRequest().Validate())
+	})
+
+	s.Run("description exceeding max length rejected", func() {
+		req := s.validRequest()
+		req.Description = strings.Repeat("a", maxDescriptionLength+1)
+
+		err := req.Validate()
+		s.Require().Error(err)
+		s.Contains(err.Error(), "description")
+	})
+
+	s.Run("relative logo URI rejected", func() {
+		req := s.validRequest()
+		req.LogoURI = "/static/logo.png"
+
+		err := req.Validate()
+		s.Require().Error(err)
+		s.Contains(err.Error(), "logo_uri")
+	})
+}
+
 func (s *CreateClientRequestSuite) TestRequiredFields() {
 	s.Run("missing name rejected", func() {
 		req := &CreateClientRequest{
@@ -199,6 +223,21 @@ func (s *CreateClientRequestSuite) TestNormalize() {
 		s.Equal("openid", req.AllowedScopes[0])
 	})
 
+	s.Run("trims display metadata", func() {
+		req := s.validRequest()
+		req.DisplayName = "  Acme Portal "
+		req.LogoURI = " https://cdn.example.com/logo.png\n"
+		req.Description = "\t  Customer self-service portal  \n"
+		req.SupportContact = "  support@example.com "
+
+		req.Normalize()
+
+		s.Equal("Acme Portal", req.DisplayName)
+		s.Equal("https://cdn.example.com/logo.png", req.LogoURI)
+		s.Equal("Customer self-service portal", req.Description)
+		s.Equal("support@example.com", req.SupportContact)
+	})
+
 	s.Run("nil request does not panic", func() {
 		var req *CreateClientRequest
 		s.NotPanics(func() { req.Normalize() })
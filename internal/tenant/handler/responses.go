@@ -13,6 +13,7 @@ type TenantResponse struct {
 	Status    models.TenantStatus `json:"status"`
 	CreatedAt time.Time           `json:"created_at"`
 	UpdatedAt time.Time           `json:"updated_at"`
+	DeletedAt *time.Time          `json:"deleted_at,omitempty"`
 }
 
 type TenantCreateResponse struct {
@@ -31,16 +32,23 @@ type TenantDetailsResponse struct {
 }
 
 type ClientResponse struct {
-	ID            string   `json:"id"`
-	TenantID      string   `json:"tenant_id"`
-	Name          string   `json:"name"`
-	OAuthClientID string   `json:"client_id"`
-	ClientSecret  string   `json:"client_secret,omitempty"` // Only included on create/rotate
-	RedirectURIs  []string `json:"redirect_uris"`
-	AllowedGrants []string `json:"allowed_grants"`
-	AllowedScopes []string `json:"allowed_scopes"`
-	Status        string   `json:"status"`
-	PublicClient  bool     `json:"public_client"`
+	ID               string   `json:"id"`
+	TenantID         string   `json:"tenant_id"`
+	Name             string   `json:"name"`
+	OAuthClientID    string   `json:"client_id"`
+	ClientSecret     string   `json:"client_secret,omitempty"` // Only included on create/rotate
+	RedirectURIs     []string `json:"redirect_uris"`
+	AllowedGrants    []string `json:"allowed_grants"`
+	AllowedScopes    []string `json:"allowed_scopes"`
+	AllowedResources []string `json:"allowed_resources,omitempty"`
+	Status           string   `json:"status"`
+	PublicClient     bool     `json:"public_client"`
+
+	// Display metadata; not security-sensitive, so always returned when set
+	DisplayName    string `json:"display_name,omitempty"`
+	LogoURI        string `json:"logo_uri,omitempty"`
+	Description    string `json:"description,omitempty"`
+	SupportContact string `json:"support_contact,omitempty"`
 }
 
 // Response mapping functions - convert domain objects to HTTP DTOs
@@ -52,6 +60,7 @@ func toTenantResponse(t *models.Tenant) *TenantResponse {
 		Status:    t.Status,
 		CreatedAt: t.CreatedAt,
 		UpdatedAt: t.UpdatedAt,
+		DeletedAt: t.DeletedAt,
 	}
 }
 
@@ -69,16 +78,22 @@ func toTenantDetailsResponse(td *readmodels.TenantDetails) *TenantDetailsRespons
 
 func toClientResponse(client *models.Client, secret string) *ClientResponse {
 	return &ClientResponse{
-		ID:            client.ID.String(),
-		TenantID:      client.TenantID.String(),
-		Name:          client.Name,
-		OAuthClientID: client.OAuthClientID,
-		ClientSecret:  secret, // Empty string omitted due to omitempty tag
-		RedirectURIs:  client.RedirectURIs,
-		AllowedGrants: grantTypesToStrings(client.AllowedGrants),
-		AllowedScopes: client.AllowedScopes,
-		Status:        client.Status.String(),
-		PublicClient:  !client.IsConfidential(),
+		ID:               client.ID.String(),
+		TenantID:         client.TenantID.String(),
+		Name:             client.Name,
+		OAuthClientID:    client.OAuthClientID,
+		ClientSecret:     secret, // Empty string omitted due to omitempty tag
+		RedirectURIs:     client.RedirectURIs,
+		AllowedGrants:    grantTypesToStrings(client.AllowedGrants),
+		AllowedScopes:    client.AllowedScopes,
+		AllowedResources: client.AllowedResources,
+		Status:           client.Status.String(),
+		PublicClient:     !client.IsConfidential(),
+
+		DisplayName:    client.DisplayName,
+		LogoURI:        client.LogoURI,
+		Description:    client.Description,
+		SupportContact: client.SupportContact,
 	}
 }
 
@@ -8,12 +8,17 @@ import (
 )
 
 // Metrics provides observability for the tenant module.
-// Tracks tenant/client creation counts and critical path durations.
+// Tracks tenant/client creation counts, current totals and critical path durations.
 type Metrics struct {
 	TenantCreated         prometheus.Counter
 	ResolveClientDuration prometheus.Histogram
 	CreateClientDuration  prometheus.Histogram
 	GetTenantDuration     prometheus.Histogram
+
+	// Current totals, refreshed periodically from the stores rather than per scrape
+	Tenants          prometheus.Gauge
+	Clients          prometheus.Gauge
+	ClientsPerTenant *prometheus.GaugeVec // Top-N tenants only, to bound label cardinality
 }
 
 // New creates a new Metrics instance with all tenant module metrics registered.
@@ -38,6 +43,18 @@ func New() *Metrics {
 			Help:    "Duration of GetTenant operations (tenant details with counts)",
 			Buckets: []float64{0.001, 0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1},
 		}),
+		Tenants: promauto.NewGauge(prometheus.GaugeOpts{
+			Name: "credo_tenants",
+			Help: "Current number of tenants, excluding soft-deleted tenants",
+		}),
+		Clients: promauto.NewGauge(prometheus.GaugeOpts{
+			Name: "credo_clients",
+			Help: "Current number of registered clients",
+		}),
+		ClientsPerTenant: promauto.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "credo_clients_per_tenant",
+			Help: "Current number of clients for the tenants with the most clients",
+		}, []string{"tenant_id"}),
 	}
 }
 
@@ -63,3 +80,18 @@ func (m *Metrics) ObserveCreateClient(start time.Time) {
 func (m *Metrics) ObserveGetTenant(start time.Time) {
 	m.GetTenantDuration.Observe(time.Since(start).Seconds())
 }
+
+// SetCounts records current tenant and client totals.
+func (m *Metrics) SetCounts(tenants, clients int) {
+	m.Tenants.Set(float64(tenants))
+	m.Clients.Set(float64(clients))
+}
+
+// SetClientsPerTenant replaces the per-tenant client gauges, so tenants that
+// drop out of the top-N stop being reported.
+func (m *Metrics) SetClientsPerTenant(counts map[string]int) {
+	m.ClientsPerTenant.Reset()
+	for tenantID, n := range counts {
+		m.ClientsPerTenant.WithLabelValues(tenantID).Set(float64(n))
+	}
+}
@@ -17,15 +17,25 @@ import (
 //   - Status transitions: active ↔ inactive only
 //   - TenantID is immutable after construction
 //   - client_credentials grant requires IsConfidential() == true
+//   - ClientMetadata is display-only and never affects authorization
+//   - AllowedResources is the only set of audiences a client may request (RFC 8707)
+//   - At most two secrets verify: the current one and, within its grace window, the previous one
 type Client struct {
-	ID               id.ClientID  `json:"id"`
-	TenantID         id.TenantID  `json:"tenant_id"`
-	Name             string       `json:"name"`
-	OAuthClientID    string       `json:"client_id"`
-	ClientSecretHash string       `json:"-"` // Never serialize - contains bcrypt hash
+	ID               id.ClientID `json:"id"`
+	TenantID         id.TenantID `json:"tenant_id"`
+	Name             string      `json:"name"`
+	OAuthClientID    string      `json:"client_id"`
+	ClientSecretHash string      `json:"-"` // Never serialize - contains bcrypt hash
+
+	// Secret replaced by the last rotation, still accepted until it expires
+	PreviousSecretHash      string     `json:"-"`
+	PreviousSecretExpiresAt *time.Time `json:"-"`
+
 	RedirectURIs     []string     `json:"redirect_uris"`
 	AllowedGrants    []GrantType  `json:"allowed_grants"`
 	AllowedScopes    []string     `json:"allowed_scopes"`
+	AllowedResources []string     `json:"allowed_resources,omitempty"` // Resource servers requestable via the token resource parameter
+	ClientMetadata                // Display-only; see ClientMetadata
 	Status           ClientStatus `json:"status"`
 	CreatedAt        time.Time    `json:"created_at"`
 	UpdatedAt        time.Time    `json:"updated_at"`
@@ -142,6 +152,28 @@ func (c *Client) IsConfidential() bool {
 	return c.ClientSecretHash != ""
 }
 
+// ApplySecretRotation replaces the secret hash. With a positive grace the
+// replaced hash keeps verifying until now+grace; otherwise it stops at once.
+// Rotating again within the window supersedes the previous hash rather than
+// chaining, so at most two secrets are ever accepted.
+func (c *Client) ApplySecretRotation(hash string, now time.Time, grace time.Duration) {
+	c.PreviousSecretHash, c.PreviousSecretExpiresAt = "", nil
+	if grace > 0 && c.ClientSecretHash != "" {
+		expiresAt := now.Add(grace)
+		c.PreviousSecretHash = c.ClientSecretHash
+		c.PreviousSecretExpiresAt = &expiresAt
+	}
+	c.ClientSecretHash = hash
+	c.UpdatedAt = now
+}
+
+// AcceptsPreviousSecret reports whether the previous secret is still inside its grace window.
+func (c *Client) AcceptsPreviousSecret(now time.Time) bool {
+	return c.PreviousSecretHash != "" &&
+		c.PreviousSecretExpiresAt != nil &&
+		now.Before(*c.PreviousSecretExpiresAt)
+}
+
 // CanUseGrant checks if the client is allowed to use the specified grant type.
 // Public clients cannot use client_credentials (requires secure secret storage).
 func (c *Client) CanUseGrant(grant GrantType) bool {
@@ -1,6 +1,10 @@
 package models
 
-import id "credo/pkg/domain"
+import (
+	"time"
+
+	id "credo/pkg/domain"
+)
 
 // Domain events capture what happened in the tenant domain.
 // These are pure data structures with no behavior - the application layer
@@ -21,6 +25,11 @@ type TenantReactivated struct {
 	TenantID id.TenantID
 }
 
+// TenantDeleted is emitted when a tenant is soft-deleted, starting its retention window.
+type TenantDeleted struct {
+	TenantID id.TenantID
+}
+
 // ClientCreated is emitted when a new OAuth client is registered.
 type ClientCreated struct {
 	TenantID   id.TenantID
@@ -28,6 +37,13 @@ type ClientCreated struct {
 	ClientName string
 }
 
+// ClientUpdated is emitted when a client's registration fields change.
+// Secret rotation is reported separately as ClientSecretRotated.
+type ClientUpdated struct {
+	TenantID id.TenantID
+	ClientID id.ClientID
+}
+
 // ClientDeactivated is emitted when a client is blocked from OAuth flows.
 type ClientDeactivated struct {
 	TenantID id.TenantID
@@ -41,7 +57,9 @@ type ClientReactivated struct {
 }
 
 // ClientSecretRotated is emitted when a client's secret is regenerated.
+// PreviousSecretExpiresAt is set when the replaced secret keeps verifying for a grace window.
 type ClientSecretRotated struct {
-	TenantID id.TenantID
-	ClientID id.ClientID
+	TenantID                id.TenantID
+	ClientID                id.ClientID
+	PreviousSecretExpiresAt *time.Time
 }
@@ -69,6 +69,19 @@ func (s *TenantModelSuite) TestLifecycle() {
 		s.True(dErrors.HasCode(err, dErrors.CodeInvariantViolation),
 			"expected invariant violation for double-reactivation")
 	})
+
+	s.Run("deleted tenant cannot be reactivated or deleted again", func() {
+		now := time.Now()
+		tenant := s.newTenant(TenantStatusInactive)
+		s.Require().NoError(tenant.CanSoftDelete())
+		tenant.ApplySoftDelete(now)
+		s.Equal(TenantStatusDeleted, tenant.Status)
+		s.Require().NotNil(tenant.DeletedAt)
+		s.Equal(now, *tenant.DeletedAt)
+
+		s.True(dErrors.HasCode(tenant.Reactivate(now), dErrors.CodeInvariantViolation))
+		s.True(dErrors.HasCode(tenant.CanSoftDelete(), dErrors.CodeInvariantViolation))
+	})
 }
 
 // TestIsActive verifies the IsActive helper method.
@@ -11,8 +11,9 @@ import (
 //
 // Invariants:
 //   - Name is non-empty and at most 128 characters
-//   - Status is either active or inactive
-//   - Status transitions: active ↔ inactive only (no other states)
+//   - Status is active, inactive or deleted
+//   - Status transitions: active ↔ inactive, and either → deleted (terminal)
+//   - DeletedAt is set exactly when Status is deleted
 //   - CreatedAt is immutable after construction
 //
 // # Cascade Invariant
@@ -27,6 +28,7 @@ import (
 //   - ResolveClient MUST check tenant.IsActive() before returning client
 //   - This prevents suspended organizations from issuing new tokens
 //   - Existing tokens remain valid until expiry (revoke separately if needed)
+//   - A soft-deleted tenant is not found at all, which fails ResolveClient the same way
 //
 // This design choice:
 //   - Avoids expensive cascade updates to all clients on tenant status change
@@ -39,12 +41,18 @@ type Tenant struct {
 	Status    TenantStatus `json:"status"`
 	CreatedAt time.Time    `json:"created_at"`
 	UpdatedAt time.Time    `json:"updated_at"`
+	DeletedAt *time.Time   `json:"deleted_at,omitempty"`
 }
 
 func (t *Tenant) IsActive() bool {
 	return t.Status == TenantStatusActive
 }
 
+// IsDeleted reports whether the tenant is soft-deleted.
+func (t *Tenant) IsDeleted() bool {
+	return t.Status == TenantStatusDeleted
+}
+
 // CanDeactivate checks if the tenant can transition to inactive status.
 // Returns an error if the transition is not allowed.
 // Use with ApplyDeactivation in Execute callbacks for proper separation of concerns.
@@ -101,6 +109,24 @@ func (t *Tenant) Reactivate(now time.Time) error {
 	return nil
 }
 
+// CanSoftDelete checks if the tenant can transition to deleted status.
+// Returns an error if the tenant is already deleted.
+// Use with ApplySoftDelete in Execute callbacks for proper separation of concerns.
+func (t *Tenant) CanSoftDelete() error {
+	if !t.Status.CanTransitionTo(TenantStatusDeleted) {
+		return dErrors.New(dErrors.CodeInvariantViolation, "tenant is already deleted")
+	}
+	return nil
+}
+
+// ApplySoftDelete transitions the tenant to deleted status, starting its
+// retention window at now. Call CanSoftDelete first to validate the transition.
+func (t *Tenant) ApplySoftDelete(now time.Time) {
+	t.Status = TenantStatusDeleted
+	t.DeletedAt = &now
+	t.UpdatedAt = now
+}
+
 func NewTenant(tenantID id.TenantID, name string, now time.Time) (*Tenant, error) {
 	if name == "" {
 		return nil, dErrors.New(dErrors.CodeInvariantViolation, "tenant name cannot be empty")
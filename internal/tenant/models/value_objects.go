@@ -3,13 +3,15 @@ package models
 import domain "credo/pkg/domain"
 
 // TenantStatus represents the lifecycle state of a tenant.
-// Tenants can be active (operational) or inactive (suspended).
+// Tenants can be active (operational), inactive (suspended) or deleted
+// (soft-deleted, awaiting hard deletion after the retention window).
 //
 // State machine:
 //
 //	active ↔ inactive
+//	active, inactive → deleted
 //
-// Invariant: Only these two states are valid; no terminal states exist.
+// Invariant: deleted is terminal; a soft-deleted tenant is never restored.
 type TenantStatus string
 
 const (
@@ -17,11 +19,14 @@ const (
 	TenantStatusActive TenantStatus = "active"
 	// TenantStatusInactive indicates the tenant is suspended and all OAuth flows are blocked.
 	TenantStatusInactive TenantStatus = "inactive"
+	// TenantStatusDeleted indicates the tenant is soft-deleted: hidden from lookups and
+	// retained only until the retention window elapses.
+	TenantStatusDeleted TenantStatus = "deleted"
 )
 
 // IsValid returns true if the status is a known valid value.
 func (s TenantStatus) IsValid() bool {
-	return s == TenantStatusActive || s == TenantStatusInactive
+	return s == TenantStatusActive || s == TenantStatusInactive || s == TenantStatusDeleted
 }
 
 // String returns the string representation of the status.
@@ -30,13 +35,13 @@ func (s TenantStatus) String() string {
 }
 
 // CanTransitionTo returns true if the status can transition to the target status.
-// Tenants can toggle between active and inactive states.
+// Tenants can toggle between active and inactive states, and either can be deleted.
 func (s TenantStatus) CanTransitionTo(target TenantStatus) bool {
 	switch s {
 	case TenantStatusActive:
-		return target == TenantStatusInactive
+		return target == TenantStatusInactive || target == TenantStatusDeleted
 	case TenantStatusInactive:
-		return target == TenantStatusActive
+		return target == TenantStatusActive || target == TenantStatusDeleted
 	}
 	return false
 }
@@ -87,4 +92,17 @@ const (
 	GrantTypeRefreshToken = domain.GrantTypeRefreshToken
 	// GrantTypeClientCredentials is for machine-to-machine authentication (confidential clients only).
 	GrantTypeClientCredentials = domain.GrantTypeClientCredentials
+	// GrantTypeTokenExchange lets the client exchange a user's access token for a delegated one (RFC 8693).
+	GrantTypeTokenExchange = domain.GrantTypeTokenExchange
 )
+
+// ClientMetadata is optional display information shown in consent and admin UIs.
+// It is not security-sensitive: it never takes part in OAuth validation or
+// client authentication, and changing it emits no security audit event.
+// An empty field means unset.
+type ClientMetadata struct {
+	DisplayName    string `json:"display_name,omitempty"`    // Friendly name shown instead of Name
+	LogoURI        string `json:"logo_uri,omitempty"`        // https URL of the client logo
+	Description    string `json:"description,omitempty"`     // Short description of the application
+	SupportContact string `json:"support_contact,omitempty"` // Support email address or https URL
+}
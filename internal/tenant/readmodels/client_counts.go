@@ -0,0 +1,10 @@
+package readmodels
+
+import id "credo/pkg/domain"
+
+// TenantClientCount is the number of clients registered under one tenant,
+// used for capacity reporting.
+type TenantClientCount struct {
+	TenantID id.TenantID
+	Clients  int
+}
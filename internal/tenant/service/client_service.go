@@ -24,6 +24,8 @@ type ClientService struct {
 	auditEmitter *auditEmitter
 	metrics      *tenantmetrics.Metrics
 	tx           StoreTx
+	scopes       scopePolicy
+	secretGrace  time.Duration
 }
 
 func NewClientService(clients ClientStore, tenants TenantStore, opts ...Option) *ClientService {
@@ -41,6 +43,8 @@ func NewClientService(clients ClientStore, tenants TenantStore, opts ...Option)
 		auditEmitter: newAuditEmitter(cfg.logger, cfg.auditPublisher),
 		metrics:      cfg.metrics,
 		tx:           tx,
+		scopes:       cfg.scopes,
+		secretGrace:  cfg.secretGrace,
 	}
 }
 
@@ -53,6 +57,9 @@ func (s *ClientService) CreateClient(ctx context.Context, cmd *CreateClientComma
 	if err := cmd.Validate(); err != nil {
 		return nil, "", dErrors.Wrap(err, dErrors.CodeValidation, "invalid client request")
 	}
+	if err := s.scopes.validate(cmd.AllowedScopes); err != nil {
+		return nil, "", dErrors.Wrap(err, dErrors.CodeValidation, "invalid client request")
+	}
 
 	var client *models.Client
 	var secret string
@@ -84,6 +91,8 @@ func (s *ClientService) CreateClient(ctx context.Context, cmd *CreateClientComma
 		if err != nil {
 			return err
 		}
+		newClient.ClientMetadata = normalizeMetadata(cmd.Metadata)
+		newClient.AllowedResources = cmd.AllowedResources
 
 		if err := s.clients.Create(txCtx, newClient); err != nil {
 			return dErrors.Wrap(err, dErrors.CodeInternal, "failed to create client")
@@ -149,6 +158,9 @@ func (s *ClientService) UpdateClient(ctx context.Context, clientID id.ClientID,
 	if err := cmd.Validate(); err != nil {
 		return nil, "", dErrors.Wrap(err, dErrors.CodeValidation, "invalid update request")
 	}
+	if err := s.scopes.validate(cmd.AllowedScopes); err != nil {
+		return nil, "", dErrors.Wrap(err, dErrors.CodeValidation, "invalid update request")
+	}
 
 	// Pre-generate secret if rotation requested (minimize lock duration)
 	var secret, hash string
@@ -173,7 +185,7 @@ func (s *ClientService) UpdateClient(ctx context.Context, clientID id.ClientID,
 		},
 		func(c *models.Client) {
 			if cmd.RotateSecret {
-				c.ClientSecretHash = hash
+				c.ApplySecretRotation(hash, now, s.secretGrace)
 			}
 			applyFieldUpdates(c, cmd)
 			c.UpdatedAt = now
@@ -183,13 +195,8 @@ func (s *ClientService) UpdateClient(ctx context.Context, clientID id.ClientID,
 		return nil, "", wrapClientErr(err, "failed to update client")
 	}
 
-	if cmd.RotateSecret {
-		if err := s.auditEmitter.emitClientSecretRotated(ctx, models.ClientSecretRotated{
-			TenantID: client.TenantID,
-			ClientID: client.ID,
-		}); err != nil {
-			return nil, "", err
-		}
+	if err := s.emitClientUpdateEvents(ctx, client, cmd); err != nil {
+		return nil, "", err
 	}
 
 	return client, secret, nil
@@ -212,6 +219,9 @@ func (s *ClientService) UpdateClientForTenant(ctx context.Context, tenantID id.T
 	if err := cmd.Validate(); err != nil {
 		return nil, "", dErrors.Wrap(err, dErrors.CodeValidation, "invalid update request")
 	}
+	if err := s.scopes.validate(cmd.AllowedScopes); err != nil {
+		return nil, "", dErrors.Wrap(err, dErrors.CodeValidation, "invalid update request")
+	}
 
 	// Pre-generate secret if rotation requested (minimize lock duration)
 	var secret, hash string
@@ -239,7 +249,7 @@ func (s *ClientService) UpdateClientForTenant(ctx context.Context, tenantID id.T
 		},
 		func(c *models.Client) {
 			if cmd.RotateSecret {
-				c.ClientSecretHash = hash
+				c.ApplySecretRotation(hash, now, s.secretGrace)
 			}
 			applyFieldUpdates(c, cmd)
 			c.UpdatedAt = now
@@ -249,16 +259,32 @@ func (s *ClientService) UpdateClientForTenant(ctx context.Context, tenantID id.T
 		return nil, "", wrapClientErr(err, "failed to update client")
 	}
 
-	if cmd.RotateSecret {
-		if err := s.auditEmitter.emitClientSecretRotated(ctx, models.ClientSecretRotated{
+	if err := s.emitClientUpdateEvents(ctx, client, cmd); err != nil {
+		return nil, "", err
+	}
+
+	return client, secret, nil
+}
+
+// emitClientUpdateEvents audits an applied update: field changes as client_updated
+// and a rotation as client_secret_rotated, so an update doing both emits both.
+func (s *ClientService) emitClientUpdateEvents(ctx context.Context, client *models.Client, cmd *UpdateClientCommand) error {
+	if cmd.HasFieldUpdates() {
+		if err := s.auditEmitter.emitClientUpdated(ctx, models.ClientUpdated{
 			TenantID: client.TenantID,
 			ClientID: client.ID,
 		}); err != nil {
-			return nil, "", err
+			return err
 		}
 	}
-
-	return client, secret, nil
+	if cmd.RotateSecret {
+		return s.auditEmitter.emitClientSecretRotated(ctx, models.ClientSecretRotated{
+			TenantID:                client.TenantID,
+			ClientID:                client.ID,
+			PreviousSecretExpiresAt: client.PreviousSecretExpiresAt,
+		})
+	}
+	return nil
 }
 
 // DeactivateClient transitions a client to inactive status.
@@ -365,8 +391,7 @@ func (s *ClientService) RotateClientSecret(ctx context.Context, clientID id.Clie
 			return nil
 		},
 		func(c *models.Client) {
-			c.ClientSecretHash = hash
-			c.UpdatedAt = now
+			c.ApplySecretRotation(hash, now, s.secretGrace)
 		},
 	)
 	if err != nil {
@@ -374,8 +399,9 @@ func (s *ClientService) RotateClientSecret(ctx context.Context, clientID id.Clie
 	}
 
 	if err := s.auditEmitter.emitClientSecretRotated(ctx, models.ClientSecretRotated{
-		TenantID: client.TenantID,
-		ClientID: client.ID,
+		TenantID:                client.TenantID,
+		ClientID:                client.ID,
+		PreviousSecretExpiresAt: client.PreviousSecretExpiresAt,
 	}); err != nil {
 		return nil, "", err
 	}
@@ -414,8 +440,7 @@ func (s *ClientService) RotateClientSecretForTenant(ctx context.Context, tenantI
 			return nil
 		},
 		func(c *models.Client) {
-			c.ClientSecretHash = hash
-			c.UpdatedAt = now
+			c.ApplySecretRotation(hash, now, s.secretGrace)
 		},
 	)
 	if err != nil {
@@ -423,8 +448,9 @@ func (s *ClientService) RotateClientSecretForTenant(ctx context.Context, tenantI
 	}
 
 	if err := s.auditEmitter.emitClientSecretRotated(ctx, models.ClientSecretRotated{
-		TenantID: client.TenantID,
-		ClientID: client.ID,
+		TenantID:                client.TenantID,
+		ClientID:                client.ID,
+		PreviousSecretExpiresAt: client.PreviousSecretExpiresAt,
 	}); err != nil {
 		return nil, "", err
 	}
@@ -437,6 +463,7 @@ func (s *ClientService) RotateClientSecretForTenant(ctx context.Context, tenantI
 // This is the explicit entry point for auth module to verify client secrets.
 //
 // Security: Uses bcrypt constant-time comparison via secrets.Verify.
+// Within a rotation grace window the previous secret is also accepted.
 // Returns a generic "invalid credentials" error to prevent enumeration attacks.
 func (s *ClientService) VerifyClientSecret(ctx context.Context, clientID id.ClientID, providedSecret string) error {
 	if err := requireClientID(clientID); err != nil {
@@ -449,23 +476,14 @@ func (s *ClientService) VerifyClientSecret(ctx context.Context, clientID id.Clie
 		return invalidClientCredentials()
 	}
 
-	// Public clients cannot authenticate with a secret
-	if !client.IsConfidential() {
-		return invalidClientCredentials()
-	}
-
-	// Verify the secret using bcrypt constant-time comparison
-	if err := secrets.Verify(providedSecret, client.ClientSecretHash); err != nil {
-		return invalidClientCredentials()
-	}
-
-	return nil
+	return s.verifySecret(ctx, client, providedSecret)
 }
 
 // VerifyClientSecretByOAuthID verifies a client's credentials using the OAuth client_id string.
 // This is the common entry point used during token endpoint authentication.
 //
 // Security: Uses bcrypt constant-time comparison via secrets.Verify.
+// Within a rotation grace window the previous secret is also accepted.
 // Returns a generic "invalid credentials" error to prevent enumeration attacks.
 func (s *ClientService) VerifyClientSecretByOAuthID(ctx context.Context, oauthClientID, providedSecret string) error {
 	oauthClientID = strings.TrimSpace(oauthClientID)
@@ -479,17 +497,24 @@ func (s *ClientService) VerifyClientSecretByOAuthID(ctx context.Context, oauthCl
 		return invalidClientCredentials()
 	}
 
-	// Public clients cannot authenticate with a secret
+	return s.verifySecret(ctx, client, providedSecret)
+}
+
+// verifySecret accepts the current secret or, inside its rotation grace window,
+// the previous one. Public clients cannot authenticate with a secret.
+func (s *ClientService) verifySecret(ctx context.Context, client *models.Client, providedSecret string) error {
 	if !client.IsConfidential() {
 		return invalidClientCredentials()
 	}
-
-	// Verify the secret using bcrypt constant-time comparison
-	if err := secrets.Verify(providedSecret, client.ClientSecretHash); err != nil {
-		return invalidClientCredentials()
+	if err := secrets.Verify(providedSecret, client.ClientSecretHash); err == nil {
+		return nil
 	}
-
-	return nil
+	if client.AcceptsPreviousSecret(requestcontext.Now(ctx)) {
+		if err := secrets.Verify(providedSecret, client.PreviousSecretHash); err == nil {
+			return nil
+		}
+	}
+	return invalidClientCredentials()
 }
 
 // ResolveClient maps client_id -> client and tenant as a single choke point.
@@ -554,6 +579,21 @@ func applyFieldUpdates(client *models.Client, cmd *UpdateClientCommand) {
 	if cmd.HasAllowedScopes() {
 		client.AllowedScopes = cmd.AllowedScopes
 	}
+	if cmd.HasAllowedResources() {
+		client.AllowedResources = cmd.AllowedResources
+	}
+	if cmd.DisplayName != nil {
+		client.DisplayName = strings.TrimSpace(*cmd.DisplayName)
+	}
+	if cmd.LogoURI != nil {
+		client.LogoURI = strings.TrimSpace(*cmd.LogoURI)
+	}
+	if cmd.Description != nil {
+		client.Description = strings.TrimSpace(*cmd.Description)
+	}
+	if cmd.SupportContact != nil {
+		client.SupportContact = strings.TrimSpace(*cmd.SupportContact)
+	}
 }
 
 func (s *ClientService) observeResolveClient(start time.Time) {
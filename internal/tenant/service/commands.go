@@ -1,6 +1,7 @@
 package service
 
 import (
+	"net/mail"
 	"net/url"
 	"slices"
 	"strings"
@@ -12,6 +13,15 @@ import (
 
 const maxNameLength = 128
 
+// Client metadata limits. Metadata is display-only, so these bound storage and
+// rendering rather than enforce any security property.
+const (
+	maxDisplayNameLength    = 128
+	maxLogoURILength        = 2048
+	maxDescriptionLength    = 1000
+	maxSupportContactLength = 320
+)
+
 // CreateClientCommand contains validated input for client creation.
 // Domain validation (OAuth rules) happens here, not in HTTP layer.
 type CreateClientCommand struct {
@@ -21,6 +31,9 @@ type CreateClientCommand struct {
 	AllowedGrants []models.GrantType
 	AllowedScopes []string
 	Public        bool
+	Metadata      models.ClientMetadata // Optional display metadata
+
+	AllowedResources []string // Optional RFC 8707 resource allowlist
 }
 
 func (c *CreateClientCommand) Validate() error {
@@ -48,7 +61,13 @@ func (c *CreateClientCommand) Validate() error {
 	if len(c.AllowedScopes) == 0 {
 		return dErrors.New(dErrors.CodeValidation, "allowed_scopes are required")
 	}
-	return validateEachScope(c.AllowedScopes)
+	if err := validateEachScope(c.AllowedScopes); err != nil {
+		return err
+	}
+	if err := validateEachResource(c.AllowedResources); err != nil {
+		return err
+	}
+	return validateMetadata(normalizeMetadata(c.Metadata))
 }
 
 // UpdateClientCommand contains validated input for client updates.
@@ -60,10 +79,20 @@ type UpdateClientCommand struct {
 	AllowedScopes []string
 	RotateSecret  bool
 
+	// Resource allowlist: unset = don't change, empty slice = clear.
+	AllowedResources []string
+
+	// Display metadata: nil = don't change, empty string = clear.
+	DisplayName    *string
+	LogoURI        *string
+	Description    *string
+	SupportContact *string
+
 	// Internal flags to distinguish "not provided" from "provided empty"
-	hasRedirectURIs  bool
-	hasAllowedGrants bool
-	hasAllowedScopes bool
+	hasRedirectURIs     bool
+	hasAllowedGrants    bool
+	hasAllowedScopes    bool
+	hasAllowedResources bool
 }
 
 func (c *UpdateClientCommand) SetRedirectURIs(uris []string) {
@@ -81,9 +110,15 @@ func (c *UpdateClientCommand) SetAllowedScopes(scopes []string) {
 	c.hasAllowedScopes = true
 }
 
-func (c *UpdateClientCommand) HasRedirectURIs() bool  { return c.hasRedirectURIs }
-func (c *UpdateClientCommand) HasAllowedGrants() bool { return c.hasAllowedGrants }
-func (c *UpdateClientCommand) HasAllowedScopes() bool { return c.hasAllowedScopes }
+func (c *UpdateClientCommand) SetAllowedResources(resources []string) {
+	c.AllowedResources = resources
+	c.hasAllowedResources = true
+}
+
+func (c *UpdateClientCommand) HasRedirectURIs() bool     { return c.hasRedirectURIs }
+func (c *UpdateClientCommand) HasAllowedGrants() bool    { return c.hasAllowedGrants }
+func (c *UpdateClientCommand) HasAllowedScopes() bool    { return c.hasAllowedScopes }
+func (c *UpdateClientCommand) HasAllowedResources() bool { return c.hasAllowedResources }
 
 func (c *UpdateClientCommand) Validate() error {
 	if err := validateOptionalName(c.Name); err != nil {
@@ -113,16 +148,50 @@ func (c *UpdateClientCommand) Validate() error {
 			return err
 		}
 	}
-	return nil
+	if c.hasAllowedResources {
+		if err := validateEachResource(c.AllowedResources); err != nil {
+			return err
+		}
+	}
+	return validateMetadata(normalizeMetadata(c.metadataChanges()))
+}
+
+// metadataChanges returns the provided metadata fields, leaving the rest empty.
+// Empty fields always pass validation, so this is safe to validate as a whole.
+func (c *UpdateClientCommand) metadataChanges() models.ClientMetadata {
+	var m models.ClientMetadata
+	if c.DisplayName != nil {
+		m.DisplayName = *c.DisplayName
+	}
+	if c.LogoURI != nil {
+		m.LogoURI = *c.LogoURI
+	}
+	if c.Description != nil {
+		m.Description = *c.Description
+	}
+	if c.SupportContact != nil {
+		m.SupportContact = *c.SupportContact
+	}
+	return m
+}
+
+// HasFieldUpdates reports whether the command changes any registration field
+// besides the secret.
+func (c *UpdateClientCommand) HasFieldUpdates() bool {
+	return c.Name != nil ||
+		c.hasRedirectURIs ||
+		c.hasAllowedGrants ||
+		c.hasAllowedScopes ||
+		c.hasAllowedResources ||
+		c.DisplayName != nil ||
+		c.LogoURI != nil ||
+		c.Description != nil ||
+		c.SupportContact != nil
 }
 
 // IsEmpty returns true if the command contains no updates.
 func (c *UpdateClientCommand) IsEmpty() bool {
-	return c.Name == nil &&
-		!c.hasRedirectURIs &&
-		!c.hasAllowedGrants &&
-		!c.hasAllowedScopes &&
-		!c.RotateSecret
+	return !c.HasFieldUpdates() && !c.RotateSecret
 }
 
 // Domain validation functions for OAuth rules.
@@ -157,21 +226,62 @@ func isLocalhost(host string) bool {
 	return host == "localhost" || strings.HasPrefix(host, "localhost:")
 }
 
-// allowedScopes defines the valid OAuth scopes clients can request.
-var allowedScopes = map[string]struct{}{
-	"openid":  {},
-	"profile": {},
-	"email":   {},
-	"offline": {}, // For refresh tokens
+// scopePolicy decides which scopes a client may be registered with.
+// The zero value is strict: only scopes in the domain scope registry pass.
+type scopePolicy struct {
+	lenient bool                // Accept any well-formed scope
+	extra   map[string]struct{} // Accepted in strict mode besides registered scopes
 }
 
-func validateScope(scope string) error {
-	if _, ok := allowedScopes[scope]; !ok {
+// validate rejects scopes unknown to the registry and allowlist unless lenient.
+// Scope syntax has already been checked by the command's Validate.
+func (p scopePolicy) validate(scopes []string) error {
+	if p.lenient {
+		return nil
+	}
+	for _, scope := range scopes {
+		if id.IsKnownScope(scope) {
+			continue
+		}
+		if _, ok := p.extra[scope]; ok {
+			continue
+		}
 		return dErrors.New(dErrors.CodeValidation, "unsupported scope")
 	}
 	return nil
 }
 
+// validateScope checks scope syntax per RFC 6749 section 3.3: a non-empty
+// string of printable ASCII excluding space, double quote and backslash.
+func validateScope(scope string) error {
+	if scope == "" {
+		return dErrors.New(dErrors.CodeValidation, "scope cannot be empty")
+	}
+	for _, r := range scope {
+		if r <= 0x20 || r > 0x7e || r == '"' || r == '\\' {
+			return dErrors.New(dErrors.CodeValidation, "scope contains invalid characters")
+		}
+	}
+	return nil
+}
+
+// validateResource checks a resource indicator per RFC 8707 section 2: an
+// absolute URI without a fragment. Resources become token audiences, so they
+// follow the same https-or-localhost rule as redirect URIs.
+func validateResource(resource string) error {
+	parsed, err := url.Parse(resource)
+	if err != nil || !parsed.IsAbs() || parsed.Host == "" {
+		return dErrors.New(dErrors.CodeValidation, "resource must be an absolute URI")
+	}
+	if parsed.Fragment != "" || strings.Contains(resource, "#") {
+		return dErrors.New(dErrors.CodeValidation, "resource must not include a fragment")
+	}
+	if !isAllowedScheme(parsed.Scheme, parsed.Host) {
+		return dErrors.New(dErrors.CodeValidation, "resource must be https or localhost for development")
+	}
+	return nil
+}
+
 // Batch validation helpers to reduce nesting in Validate methods.
 
 func validateOptionalName(name *string) error {
@@ -214,3 +324,62 @@ func validateEachScope(scopes []string) error {
 	}
 	return nil
 }
+
+func validateEachResource(resources []string) error {
+	for _, resource := range resources {
+		if err := validateResource(resource); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// normalizeMetadata trims surrounding whitespace from every metadata field.
+func normalizeMetadata(m models.ClientMetadata) models.ClientMetadata {
+	return models.ClientMetadata{
+		DisplayName:    strings.TrimSpace(m.DisplayName),
+		LogoURI:        strings.TrimSpace(m.LogoURI),
+		Description:    strings.TrimSpace(m.Description),
+		SupportContact: strings.TrimSpace(m.SupportContact),
+	}
+}
+
+// validateMetadata checks length limits and the format of URI and contact fields.
+// Empty fields are unset and always valid.
+func validateMetadata(m models.ClientMetadata) error {
+	if len(m.DisplayName) > maxDisplayNameLength {
+		return dErrors.New(dErrors.CodeValidation, "display_name must be 128 characters or less")
+	}
+	if len(m.LogoURI) > maxLogoURILength {
+		return dErrors.New(dErrors.CodeValidation, "logo_uri must be 2048 characters or less")
+	}
+	if len(m.Description) > maxDescriptionLength {
+		return dErrors.New(dErrors.CodeValidation, "description must be 1000 characters or less")
+	}
+	if len(m.SupportContact) > maxSupportContactLength {
+		return dErrors.New(dErrors.CodeValidation, "support_contact must be 320 characters or less")
+	}
+	if m.LogoURI != "" && !isDisplayURI(m.LogoURI) {
+		return dErrors.New(dErrors.CodeValidation, "logo_uri must be an https URL")
+	}
+	if m.SupportContact != "" && !isEmailAddress(m.SupportContact) && !isDisplayURI(m.SupportContact) {
+		return dErrors.New(dErrors.CodeValidation, "support_contact must be an email address or https URL")
+	}
+	return nil
+}
+
+// isDisplayURI reports whether uri is an absolute URL that a UI can safely link
+// or load: https, or http on localhost for development.
+func isDisplayURI(uri string) bool {
+	parsed, err := url.Parse(uri)
+	if err != nil || parsed.Host == "" {
+		return false
+	}
+	return isAllowedScheme(parsed.Scheme, parsed.Host)
+}
+
+// isEmailAddress reports whether s is a bare email address without a display name.
+func isEmailAddress(s string) bool {
+	addr, err := mail.ParseAddress(s)
+	return err == nil && addr.Address == s
+}
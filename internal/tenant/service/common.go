@@ -11,6 +11,7 @@ import (
 	"credo/pkg/platform/attrs"
 	"credo/pkg/platform/audit"
 	"credo/pkg/platform/audit/publishers/security"
+	adminmw "credo/pkg/platform/middleware/admin"
 	"credo/pkg/platform/sentinel"
 	"credo/pkg/requestcontext"
 )
@@ -23,6 +24,8 @@ type TenantStore interface {
 	Execute(ctx context.Context, tenantID id.TenantID, validate func(*models.Tenant) error, mutate func(*models.Tenant)) (*models.Tenant, error)
 	FindByID(ctx context.Context, tenantID id.TenantID) (*models.Tenant, error)
 	FindByName(ctx context.Context, name string) (*models.Tenant, error)
+	SaveIdempotencyKey(ctx context.Context, key string, tenantID id.TenantID) error
+	FindByIdempotencyKey(ctx context.Context, key string) (*models.Tenant, error)
 	Count(ctx context.Context) (int, error)
 }
 
@@ -130,50 +133,68 @@ func (e *auditEmitter) emit(ctx context.Context, event string, attributes ...any
 // Typed event emitters provide stronger typing for domain events.
 
 func (e *auditEmitter) emitTenantCreated(ctx context.Context, evt models.TenantCreated) error {
-	return e.emit(ctx, string(audit.EventTenantCreated), "tenant_id", evt.TenantID)
+	return e.emit(ctx, string(audit.EventTenantCreated), "tenant_id", evt.TenantID.String())
 }
 
 func (e *auditEmitter) emitTenantDeactivated(ctx context.Context, evt models.TenantDeactivated) error {
-	return e.emit(ctx, string(audit.EventTenantDeactivated), "tenant_id", evt.TenantID)
+	return e.emit(ctx, string(audit.EventTenantDeactivated), "tenant_id", evt.TenantID.String())
 }
 
 func (e *auditEmitter) emitTenantReactivated(ctx context.Context, evt models.TenantReactivated) error {
-	return e.emit(ctx, string(audit.EventTenantReactivated), "tenant_id", evt.TenantID)
+	return e.emit(ctx, string(audit.EventTenantReactivated), "tenant_id", evt.TenantID.String())
+}
+
+func (e *auditEmitter) emitTenantDeleted(ctx context.Context, evt models.TenantDeleted) error {
+	return e.emit(ctx, string(audit.EventTenantDeleted), "tenant_id", evt.TenantID.String())
 }
 
 func (e *auditEmitter) emitClientCreated(ctx context.Context, evt models.ClientCreated) error {
 	return e.emit(ctx, string(audit.EventClientCreated),
-		"tenant_id", evt.TenantID,
-		"client_id", evt.ClientID,
+		"tenant_id", evt.TenantID.String(),
+		"client_id", evt.ClientID.String(),
 		"client_name", evt.ClientName,
 	)
 }
 
+func (e *auditEmitter) emitClientUpdated(ctx context.Context, evt models.ClientUpdated) error {
+	return e.emit(ctx, string(audit.EventClientUpdated),
+		"tenant_id", evt.TenantID.String(),
+		"client_id", evt.ClientID.String(),
+	)
+}
+
 func (e *auditEmitter) emitClientDeactivated(ctx context.Context, evt models.ClientDeactivated) error {
 	return e.emit(ctx, string(audit.EventClientDeactivated),
-		"client_id", evt.ClientID,
-		"tenant_id", evt.TenantID,
+		"client_id", evt.ClientID.String(),
+		"tenant_id", evt.TenantID.String(),
 	)
 }
 
 func (e *auditEmitter) emitClientReactivated(ctx context.Context, evt models.ClientReactivated) error {
 	return e.emit(ctx, string(audit.EventClientReactivated),
-		"client_id", evt.ClientID,
-		"tenant_id", evt.TenantID,
+		"client_id", evt.ClientID.String(),
+		"tenant_id", evt.TenantID.String(),
 	)
 }
 
 func (e *auditEmitter) emitClientSecretRotated(ctx context.Context, evt models.ClientSecretRotated) error {
-	return e.emit(ctx, string(audit.EventClientSecretRotated),
-		"tenant_id", evt.TenantID,
-		"client_id", evt.ClientID,
-	)
+	attributes := []any{
+		"tenant_id", evt.TenantID.String(),
+		"client_id", evt.ClientID.String(),
+	}
+	if evt.PreviousSecretExpiresAt != nil {
+		attributes = append(attributes, "previous_secret_expires_at", *evt.PreviousSecretExpiresAt)
+	}
+	return e.emit(ctx, string(audit.EventClientSecretRotated), attributes...)
 }
 
 func (e *auditEmitter) enrichAttributes(ctx context.Context, attributes []any) []any {
 	if requestID := requestcontext.RequestID(ctx); requestID != "" {
 		attributes = append(attributes, "request_id", requestID)
 	}
+	if actorID := adminmw.GetAdminActorID(ctx); actorID != "" {
+		attributes = append(attributes, "actor_id", actorID)
+	}
 	return attributes
 }
 
@@ -197,6 +218,7 @@ func (e *auditEmitter) emitToAudit(ctx context.Context, event string, attributes
 		Subject:   subject,
 		Action:    event,
 		RequestID: requestcontext.RequestID(ctx),
+		ActorID:   attrs.ExtractString(attributes, "actor_id"),
 		Severity:  audit.SeverityInfo,
 	})
 
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	tenantcontracts "credo/contracts/tenant"
+	"credo/internal/tenant/models"
 )
 
 // ResolveClientContract resolves a client and its tenant returning contract types
@@ -17,14 +18,24 @@ func (s *ClientService) ResolveClientContract(ctx context.Context, clientID stri
 	}
 
 	return &tenantcontracts.ResolvedClient{
-			ID:            client.ID.String(),
-			TenantID:      client.TenantID.String(),
-			OAuthClientID: client.OAuthClientID,
-			RedirectURIs:  client.RedirectURIs,
-			AllowedScopes: client.AllowedScopes,
-			Active:        client.IsActive(),
+			ID:               client.ID.String(),
+			TenantID:         client.TenantID.String(),
+			OAuthClientID:    client.OAuthClientID,
+			RedirectURIs:     client.RedirectURIs,
+			AllowedScopes:    client.AllowedScopes,
+			AllowedResources: client.AllowedResources,
+			AllowedGrants:    grantStrings(client.AllowedGrants),
+			Active:           client.IsActive(),
 		}, &tenantcontracts.ResolvedTenant{
 			ID:     tenant.ID.String(),
 			Active: tenant.IsActive(),
 		}, nil
 }
+
+func grantStrings(grants []models.GrantType) []string {
+	out := make([]string, len(grants))
+	for i, grant := range grants {
+		out[i] = grant.String()
+	}
+	return out
+}
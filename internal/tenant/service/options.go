@@ -2,6 +2,7 @@ package service
 
 import (
 	"log/slog"
+	"time"
 
 	tenantmetrics "credo/internal/tenant/metrics"
 )
@@ -12,6 +13,8 @@ type serviceConfig struct {
 	auditPublisher AuditPublisher
 	metrics        *tenantmetrics.Metrics
 	tx             StoreTx
+	scopes         scopePolicy
+	secretGrace    time.Duration
 }
 
 // Option configures a service.
@@ -40,3 +43,34 @@ func WithTx(tx StoreTx) Option {
 		c.tx = tx
 	}
 }
+
+// WithStrictScopes controls whether client registration rejects scopes missing
+// from the scope registry. Strict mode is on by default; turning it off lets
+// deployments register clients with custom scopes the platform does not describe.
+func WithStrictScopes(strict bool) Option {
+	return func(c *serviceConfig) {
+		c.scopes.lenient = !strict
+	}
+}
+
+// WithAllowedScopes accepts additional scope names in strict mode without
+// registering display metadata for them.
+func WithAllowedScopes(scopes ...string) Option {
+	return func(c *serviceConfig) {
+		if c.scopes.extra == nil {
+			c.scopes.extra = make(map[string]struct{}, len(scopes))
+		}
+		for _, scope := range scopes {
+			c.scopes.extra[scope] = struct{}{}
+		}
+	}
+}
+
+// WithSecretRotationGrace keeps a rotated-out client secret verifying for grace,
+// so deployments rolling out the new secret are not cut off mid-rollout.
+// Zero, the default, invalidates the old secret immediately.
+func WithSecretRotationGrace(grace time.Duration) Option {
+	return func(c *serviceConfig) {
+		c.secretGrace = grace
+	}
+}
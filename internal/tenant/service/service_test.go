@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/suite"
@@ -13,8 +14,11 @@ import (
 	tenantstore "credo/internal/tenant/store/tenant"
 	id "credo/pkg/domain"
 	dErrors "credo/pkg/domain-errors"
+	"credo/pkg/platform/audit"
 	"credo/pkg/platform/audit/publishers/security"
 	auditmemory "credo/pkg/platform/audit/store/memory"
+	adminmw "credo/pkg/platform/middleware/admin"
+	"credo/pkg/requestcontext"
 )
 
 // ServiceSuite provides shared test setup for tenant service tests.
@@ -22,18 +26,21 @@ type ServiceSuite struct {
 	suite.Suite
 	tenantStore *tenantstore.InMemory
 	clientStore *clientstore.InMemory
+	auditStore  *auditmemory.InMemoryStore
+	publisher   *security.Publisher
 	service     *Service
 }
 
 func (s *ServiceSuite) SetupTest() {
 	s.tenantStore = tenantstore.NewInMemory()
 	s.clientStore = clientstore.NewInMemory()
-	auditStore := auditmemory.NewInMemoryStore()
+	s.auditStore = auditmemory.NewInMemoryStore()
+	s.publisher = security.New(s.auditStore)
 	svc, err := New(
 		s.tenantStore,
 		s.clientStore,
 		nil,
-		WithAuditPublisher(security.New(auditStore)),
+		WithAuditPublisher(s.publisher),
 	)
 	s.Require().NoError(err)
 	s.service = svc
@@ -63,6 +70,20 @@ func (s *ServiceSuite) createTestClient(tenantID id.TenantID) *tenant.Client {
 	return client
 }
 
+// auditEvents flushes the publisher and returns the events recorded for subject.
+func (s *ServiceSuite) auditEvents(subject string) []audit.Event {
+	s.Require().NoError(s.publisher.Flush(context.Background()))
+	events, err := s.auditStore.ListAll(context.Background())
+	s.Require().NoError(err)
+	var matched []audit.Event
+	for _, evt := range events {
+		if evt.Subject == subject {
+			matched = append(matched, evt)
+		}
+	}
+	return matched
+}
+
 // TestTenantCreation verifies tenant creation domain invariants.
 // Feature files test HTTP-level behavior; these tests verify specific error CODEs
 // and exact boundaries that cannot be easily asserted in Gherkin.
@@ -206,6 +227,270 @@ func (s *ServiceSuite) TestClientRedirectURIValidation() {
 	})
 }
 
+// TestClientMetadata verifies display metadata validation and normalization.
+func (s *ServiceSuite) TestClientMetadata() {
+	newCommand := func(tenantID id.TenantID, metadata tenant.ClientMetadata) *CreateClientCommand {
+		return &CreateClientCommand{
+			TenantID:      tenantID,
+			Name:          "Web",
+			RedirectURIs:  []string{"https://app.example.com/callback"},
+			AllowedGrants: []tenant.GrantType{tenant.GrantTypeAuthorizationCode},
+			AllowedScopes: []string{"openid"},
+			Metadata:      metadata,
+		}
+	}
+
+	s.Run("stores trimmed metadata", func() {
+		tenantRecord := s.createTestTenant("Metadata1")
+
+		client, _, err := s.service.CreateClient(context.Background(), newCommand(tenantRecord.ID, tenant.ClientMetadata{
+			DisplayName:    " Acme Portal ",
+			LogoURI:        "https://cdn.example.com/logo.png",
+			Description:    "  Customer self-service portal\n",
+			SupportContact: "support@example.com",
+		}))
+		s.Require().NoError(err)
+
+		stored, err := s.clientStore.FindByID(context.Background(), client.ID)
+		s.Require().NoError(err)
+		s.Equal("Acme Portal", stored.DisplayName)
+		s.Equal("Customer self-service portal", stored.Description)
+		s.Equal("support@example.com", stored.SupportContact)
+	})
+
+	s.Run("rejects bad logo URI", func() {
+		tenantRecord := s.createTestTenant("Metadata2")
+
+		for _, logo := range []string{"javascript:alert(1)", "http://cdn.example.com/logo.png", "https:///logo.png", "logo.png"} {
+			_, _, err := s.service.CreateClient(context.Background(), newCommand(tenantRecord.ID, tenant.ClientMetadata{LogoURI: logo}))
+			s.True(dErrors.HasCode(err, dErrors.CodeValidation), "expected validation error for logo %q, got: %v", logo, err)
+		}
+	})
+
+	s.Run("support contact accepts email or https URL only", func() {
+		tenantRecord := s.createTestTenant("Metadata3")
+
+		for _, contact := range []string{"help@example.com", "https://example.com/support"} {
+			_, _, err := s.service.CreateClient(context.Background(), newCommand(tenantRecord.ID, tenant.ClientMetadata{SupportContact: contact}))
+			s.NoError(err, "contact %q", contact)
+		}
+		for _, contact := range []string{"Help <help@example.com>", "not a contact", "ftp://example.com"} {
+			_, _, err := s.service.CreateClient(context.Background(), newCommand(tenantRecord.ID, tenant.ClientMetadata{SupportContact: contact}))
+			s.True(dErrors.HasCode(err, dErrors.CodeValidation), "expected validation error for contact %q, got: %v", contact, err)
+		}
+	})
+
+	s.Run("update changes and clears fields without touching others", func() {
+		tenantRecord := s.createTestTenant("Metadata4")
+		client, _, err := s.service.CreateClient(context.Background(), newCommand(tenantRecord.ID, tenant.ClientMetadata{
+			DisplayName: "Old",
+			Description: "Keep me",
+		}))
+		s.Require().NoError(err)
+
+		newName, cleared := "  New  ", ""
+		updated, secret, err := s.service.UpdateClient(context.Background(), client.ID, &UpdateClientCommand{
+			DisplayName: &newName,
+			LogoURI:     &cleared,
+		})
+		s.Require().NoError(err)
+		s.Empty(secret, "metadata changes must not rotate the secret")
+		s.Equal("New", updated.DisplayName)
+		s.Empty(updated.LogoURI)
+		s.Equal("Keep me", updated.Description)
+	})
+}
+
+// TestClientScopeRegistry verifies scopes are checked against the registry in
+// strict mode and only checked for syntax when strict mode is off.
+func (s *ServiceSuite) TestClientScopeRegistry() {
+	newService := func(opts ...Option) *Service {
+		opts = append(opts, WithAuditPublisher(security.New(auditmemory.NewInMemoryStore())))
+		svc, err := New(tenantstore.NewInMemory(), clientstore.NewInMemory(), nil, opts...)
+		s.Require().NoError(err)
+		return svc
+	}
+	register := func(svc *Service, scopes ...string) error {
+		tenantRecord, err := svc.CreateTenant(context.Background(), "Scopes")
+		s.Require().NoError(err)
+		_, _, err = svc.CreateClient(context.Background(), &CreateClientCommand{
+			TenantID:      tenantRecord.ID,
+			Name:          "Web",
+			RedirectURIs:  []string{"https://app.example.com/callback"},
+			AllowedGrants: []tenant.GrantType{tenant.GrantTypeAuthorizationCode},
+			AllowedScopes: scopes,
+		})
+		return err
+	}
+
+	s.Run("strict mode rejects unknown scope", func() {
+		err := register(newService(WithStrictScopes(true)), "openid", "payments:write")
+		s.Require().Error(err)
+		s.True(dErrors.HasCode(err, dErrors.CodeValidation))
+	})
+
+	s.Run("strict mode is the default", func() {
+		err := register(newService(), "payments:write")
+		s.True(dErrors.HasCode(err, dErrors.CodeValidation), "got: %v", err)
+	})
+
+	s.Run("strict mode accepts allowlisted scope", func() {
+		err := register(newService(WithAllowedScopes("payments:write")), "openid", "payments:write")
+		s.NoError(err)
+	})
+
+	s.Run("lenient mode accepts unknown scope", func() {
+		err := register(newService(WithStrictScopes(false)), "openid", "payments:write")
+		s.NoError(err)
+	})
+
+	s.Run("lenient mode still rejects malformed scope", func() {
+		err := register(newService(WithStrictScopes(false)), "openid", "bad scope")
+		s.True(dErrors.HasCode(err, dErrors.CodeValidation), "got: %v", err)
+	})
+
+	s.Run("strict mode rejects unknown scope on update", func() {
+		svc := newService()
+		tenantRecord, err := svc.CreateTenant(context.Background(), "ScopeUpdate")
+		s.Require().NoError(err)
+		client, _, err := svc.CreateClient(context.Background(), &CreateClientCommand{
+			TenantID:      tenantRecord.ID,
+			Name:          "Web",
+			RedirectURIs:  []string{"https://app.example.com/callback"},
+			AllowedGrants: []tenant.GrantType{tenant.GrantTypeAuthorizationCode},
+			AllowedScopes: []string{"openid"},
+		})
+		s.Require().NoError(err)
+
+		cmd := &UpdateClientCommand{}
+		cmd.SetAllowedScopes([]string{"openid", "payments:write"})
+		_, _, err = svc.UpdateClient(context.Background(), client.ID, cmd)
+		s.True(dErrors.HasCode(err, dErrors.CodeValidation), "got: %v", err)
+	})
+}
+
+// TestClientAllowedResources verifies the RFC 8707 resource allowlist is
+// validated at registration, replaceable on update and exposed to auth.
+func (s *ServiceSuite) TestClientAllowedResources() {
+	register := func(resources ...string) (*tenant.Client, error) {
+		tenantRecord, err := s.service.CreateTenant(context.Background(), "Resources-"+uuid.NewString())
+		s.Require().NoError(err)
+		client, _, err := s.service.CreateClient(context.Background(), &CreateClientCommand{
+			TenantID:         tenantRecord.ID,
+			Name:             "Web",
+			RedirectURIs:     []string{"https://app.example.com/callback"},
+			AllowedGrants:    []tenant.GrantType{tenant.GrantTypeAuthorizationCode},
+			AllowedScopes:    []string{"openid"},
+			AllowedResources: resources,
+		})
+		return client, err
+	}
+
+	s.Run("allowlist is exposed through the resolve contract", func() {
+		client, err := register("https://api.example.com")
+		s.Require().NoError(err)
+
+		resolved, _, err := s.service.ResolveClientContract(context.Background(), client.OAuthClientID)
+		s.Require().NoError(err)
+		s.Equal([]string{"https://api.example.com"}, resolved.AllowedResources)
+	})
+
+	s.Run("rejects malformed resources", func() {
+		for _, resource := range []string{"api", "https://api.example.com#frag", "http://api.example.com"} {
+			_, err := register(resource)
+			s.True(dErrors.HasCode(err, dErrors.CodeValidation), "resource %q: got %v", resource, err)
+		}
+	})
+
+	s.Run("update replaces and clears the allowlist", func() {
+		client, err := register("https://api.example.com")
+		s.Require().NoError(err)
+
+		cmd := &UpdateClientCommand{}
+		cmd.SetAllowedResources([]string{"https://billing.example.com"})
+		updated, _, err := s.service.UpdateClient(context.Background(), client.ID, cmd)
+		s.Require().NoError(err)
+		s.Equal([]string{"https://billing.example.com"}, updated.AllowedResources)
+
+		cmd = &UpdateClientCommand{}
+		cmd.SetAllowedResources([]string{})
+		updated, _, err = s.service.UpdateClient(context.Background(), client.ID, cmd)
+		s.Require().NoError(err)
+		s.Empty(updated.AllowedResources)
+	})
+}
+
+// TestClientAuditEvents verifies which audit events client changes emit and
+// that the acting admin is attributed.
+func (s *ServiceSuite) TestClientAuditEvents() {
+	actions := func(events []audit.Event) []string {
+		out := make([]string, 0, len(events))
+		for _, evt := range events {
+			out = append(out, evt.Action)
+		}
+		return out
+	}
+	adminCtx := context.WithValue(context.Background(), adminmw.ContextKeyAdminActorID, "ops-alice")
+
+	s.Run("create emits client_created with actor", func() {
+		s.SetupTest()
+		tenantRecord := s.createTestTenant("AuditCreate")
+		_, _, err := s.service.CreateClient(adminCtx, &CreateClientCommand{
+			TenantID:      tenantRecord.ID,
+			Name:          "Web",
+			RedirectURIs:  []string{"https://app.example.com/callback"},
+			AllowedGrants: []tenant.GrantType{tenant.GrantTypeAuthorizationCode},
+			AllowedScopes: []string{"openid"},
+		})
+		s.Require().NoError(err)
+
+		events := s.auditEvents(tenantRecord.ID.String())
+		s.Equal([]string{string(audit.EventTenantCreated), string(audit.EventClientCreated)}, actions(events))
+		s.Equal("ops-alice", events[1].ActorID)
+	})
+
+	s.Run("update with rotation emits client_updated and client_secret_rotated", func() {
+		s.SetupTest()
+		tenantRecord := s.createTestTenant("AuditRotate")
+		client := s.createTestClient(tenantRecord.ID)
+		name := "Renamed"
+
+		_, secret, err := s.service.UpdateClient(adminCtx, client.ID, &UpdateClientCommand{Name: &name, RotateSecret: true})
+		s.Require().NoError(err)
+		s.NotEmpty(secret)
+
+		events := s.auditEvents(tenantRecord.ID.String())
+		s.Equal([]string{
+			string(audit.EventTenantCreated),
+			string(audit.EventClientCreated),
+			string(audit.EventClientUpdated),
+			string(audit.EventClientSecretRotated),
+		}, actions(events))
+		s.Equal(audit.CategorySecurity, audit.AuditEvent(events[3].Action).Category())
+		s.Equal("ops-alice", events[3].ActorID)
+	})
+
+	s.Run("update without rotation emits only client_updated", func() {
+		s.SetupTest()
+		tenantRecord := s.createTestTenant("AuditUpdate")
+		client := s.createTestClient(tenantRecord.ID)
+		cmd := &UpdateClientCommand{}
+		cmd.SetRedirectURIs([]string{"https://app.example.com/new-callback"})
+
+		_, secret, err := s.service.UpdateClientForTenant(adminCtx, tenantRecord.ID, client.ID, cmd)
+		s.Require().NoError(err)
+		s.Empty(secret)
+
+		events := s.auditEvents(tenantRecord.ID.String())
+		s.Equal([]string{
+			string(audit.EventTenantCreated),
+			string(audit.EventClientCreated),
+			string(audit.EventClientUpdated),
+		}, actions(events))
+		s.Equal("ops-alice", events[2].ActorID)
+	})
+}
+
 // TestClientUpdates verifies client update behaviors and security invariants.
 func (s *ServiceSuite) TestClientUpdates() {
 	s.Run("rejects client_credentials grant for public client", func() {
@@ -265,6 +550,116 @@ func (s *ServiceSuite) TestClientResolution() {
 	})
 }
 
+// TestClientSecretRotationGrace verifies which secrets authenticate after a
+// rotation with a grace window, pinning the clock around its expiry.
+func (s *ServiceSuite) TestClientSecretRotationGrace() {
+	grace := time.Hour
+	rotatedAt := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	at := func(t time.Time) context.Context {
+		return requestcontext.WithTime(context.Background(), t)
+	}
+
+	svc, err := New(s.tenantStore, s.clientStore, nil,
+		WithAuditPublisher(s.publisher),
+		WithSecretRotationGrace(grace),
+	)
+	s.Require().NoError(err)
+
+	tenantRecord := s.createTestTenant("RotationGrace")
+	client, oldSecret, err := svc.CreateClient(context.Background(), &CreateClientCommand{
+		TenantID:      tenantRecord.ID,
+		Name:          "Backend",
+		RedirectURIs:  []string{"https://app.example.com/callback"},
+		AllowedGrants: []tenant.GrantType{tenant.GrantTypeClientCredentials},
+		AllowedScopes: []string{"openid"},
+	})
+	s.Require().NoError(err)
+
+	rotated, newSecret, err := svc.RotateClientSecret(at(rotatedAt), client.ID)
+	s.Require().NoError(err)
+	s.Require().NotNil(rotated.PreviousSecretExpiresAt)
+	s.Equal(rotatedAt.Add(grace), *rotated.PreviousSecretExpiresAt)
+
+	s.Run("new secret verifies", func() {
+		s.NoError(svc.VerifyClientSecret(at(rotatedAt.Add(2*grace)), client.ID, newSecret))
+	})
+
+	s.Run("old secret verifies within grace", func() {
+		s.NoError(svc.VerifyClientSecret(at(rotatedAt.Add(grace-time.Second)), client.ID, oldSecret))
+		s.NoError(svc.VerifyClientSecretByOAuthID(at(rotatedAt.Add(grace-time.Second)), client.OAuthClientID, oldSecret))
+	})
+
+	s.Run("old secret is rejected after grace", func() {
+		err := svc.VerifyClientSecret(at(rotatedAt.Add(grace)), client.ID, oldSecret)
+		s.True(dErrors.HasCode(err, dErrors.CodeInvalidClient), "got: %v", err)
+	})
+
+	s.Run("rotating again supersedes the previous secret", func() {
+		_, _, err := svc.RotateClientSecret(at(rotatedAt.Add(time.Minute)), client.ID)
+		s.Require().NoError(err)
+
+		s.NoError(svc.VerifyClientSecret(at(rotatedAt.Add(2*time.Minute)), client.ID, newSecret))
+		err = svc.VerifyClientSecret(at(rotatedAt.Add(2*time.Minute)), client.ID, oldSecret)
+		s.True(dErrors.HasCode(err, dErrors.CodeInvalidClient), "got: %v", err)
+	})
+
+	s.Run("without grace the old secret is rejected immediately", func() {
+		_, secret, err := s.service.RotateClientSecret(context.Background(), client.ID)
+		s.Require().NoError(err)
+		err = s.service.VerifyClientSecret(context.Background(), client.ID, newSecret)
+		s.True(dErrors.HasCode(err, dErrors.CodeInvalidClient), "got: %v", err)
+		s.NoError(s.service.VerifyClientSecret(context.Background(), client.ID, secret))
+	})
+}
+
+// TestTenantSoftDelete verifies that a soft-deleted tenant disappears from
+// lookups at once and takes its clients out of OAuth resolution with it.
+func (s *ServiceSuite) TestTenantSoftDelete() {
+	s.Run("excluded from FindByName and GetTenant", func() {
+		tenantRecord := s.createTestTenant("SoftDelete1")
+
+		deleted, err := s.service.DeleteTenant(context.Background(), tenantRecord.ID)
+		s.Require().NoError(err)
+		s.Equal(tenant.TenantStatusDeleted, deleted.Status)
+		s.Require().NotNil(deleted.DeletedAt)
+
+		_, err = s.service.GetTenantByName(context.Background(), "SoftDelete1")
+		s.True(dErrors.HasCode(err, dErrors.CodeNotFound), "expected CodeNotFound by name, got: %v", err)
+		_, err = s.service.GetTenant(context.Background(), tenantRecord.ID)
+		s.True(dErrors.HasCode(err, dErrors.CodeNotFound), "expected CodeNotFound by ID, got: %v", err)
+	})
+
+	s.Run("clients stop resolving immediately", func() {
+		tenantRecord := s.createTestTenant("SoftDelete2")
+		client := s.createTestClient(tenantRecord.ID)
+
+		_, err := s.service.DeleteTenant(context.Background(), tenantRecord.ID)
+		s.Require().NoError(err)
+
+		_, _, err = s.service.ResolveClient(context.Background(), client.OAuthClientID)
+		s.Require().Error(err)
+		s.True(dErrors.HasCode(err, dErrors.CodeInvalidClient), "expected invalid client, got: %v", err)
+	})
+
+	s.Run("name stays reserved until purge", func() {
+		tenantRecord := s.createTestTenant("SoftDelete3")
+		_, err := s.service.DeleteTenant(context.Background(), tenantRecord.ID)
+		s.Require().NoError(err)
+
+		_, err = s.service.CreateTenant(context.Background(), "SoftDelete3")
+		s.True(dErrors.HasCode(err, dErrors.CodeConflict), "expected CodeConflict, got: %v", err)
+	})
+
+	s.Run("deleting twice returns CodeNotFound", func() {
+		tenantRecord := s.createTestTenant("SoftDelete4")
+		_, err := s.service.DeleteTenant(context.Background(), tenantRecord.ID)
+		s.Require().NoError(err)
+
+		_, err = s.service.DeleteTenant(context.Background(), tenantRecord.ID)
+		s.True(dErrors.HasCode(err, dErrors.CodeNotFound), "expected CodeNotFound, got: %v", err)
+	})
+}
+
 // TestClientLifecycle verifies client activation/deactivation error codes.
 // Feature files test HTTP 409, but these verify the exact CodeConflict error
 // is returned by the service layer (mirrors model tests for service path).
@@ -40,11 +40,36 @@ func NewTenantService(tenants TenantStore, opts ...Option) *TenantService {
 	}
 }
 
+// maxIdempotencyKeyLength matches the tenant_idempotency_keys column width.
+const maxIdempotencyKeyLength = 255
+
+// CreateTenant creates a tenant with a unique (case-insensitive) name.
 func (s *TenantService) CreateTenant(ctx context.Context, name string) (*models.Tenant, error) {
+	tenant, _, err := s.CreateTenantIdempotent(ctx, name, "")
+	return tenant, err
+}
+
+// CreateTenantIdempotent creates a tenant, treating a retry of the same create as success.
+//
+// When an earlier request with the same idempotency key created a tenant with the
+// same name, that tenant is returned and created is false. Reusing a key for a
+// different name is a conflict, and so is a name already taken by a request with a
+// different key or none, so genuinely different callers still collide. An empty
+// key disables idempotency.
+func (s *TenantService) CreateTenantIdempotent(ctx context.Context, name, idempotencyKey string) (tenant *models.Tenant, created bool, err error) {
 	name = strings.TrimSpace(name)
+	idempotencyKey = strings.TrimSpace(idempotencyKey)
+	if len(idempotencyKey) > maxIdempotencyKeyLength {
+		return nil, false, dErrors.New(dErrors.CodeBadRequest, "idempotency key must be 255 characters or less")
+	}
 
-	var tenant *models.Tenant
-	err := s.tx.RunInTx(ctx, func(txCtx context.Context) error {
+	if idempotencyKey != "" {
+		if existing, err := s.replayCreate(ctx, name, idempotencyKey); existing != nil || err != nil {
+			return existing, false, err
+		}
+	}
+
+	err = s.tx.RunInTx(ctx, func(txCtx context.Context) error {
 		t, err := models.NewTenant(id.TenantID(uuid.New()), name, requestcontext.Now(txCtx))
 		if err != nil {
 			return err
@@ -56,6 +81,14 @@ func (s *TenantService) CreateTenant(ctx context.Context, name string) (*models.
 			}
 			return dErrors.Wrap(err, dErrors.CodeInternal, "failed to create tenant")
 		}
+		if idempotencyKey != "" {
+			if err := s.tenants.SaveIdempotencyKey(txCtx, idempotencyKey, t.ID); err != nil {
+				if errors.Is(err, sentinel.ErrAlreadyUsed) {
+					return dErrors.New(dErrors.CodeConflict, "idempotency key was used for a different tenant")
+				}
+				return dErrors.Wrap(err, dErrors.CodeInternal, "failed to save idempotency key")
+			}
+		}
 		if err := s.auditEmitter.emitTenantCreated(txCtx, models.TenantCreated{TenantID: t.ID}); err != nil {
 			return err
 		}
@@ -63,11 +96,34 @@ func (s *TenantService) CreateTenant(ctx context.Context, name string) (*models.
 		return nil
 	})
 	if err != nil {
-		return nil, err
+		// A concurrent retry with the same key may have won the race. Its
+		// transaction has committed by the time the conflict surfaces here.
+		if idempotencyKey != "" && dErrors.HasCode(err, dErrors.CodeConflict) {
+			if existing, replayErr := s.replayCreate(ctx, name, idempotencyKey); existing != nil && replayErr == nil {
+				return existing, false, nil
+			}
+		}
+		return nil, false, err
 	}
 
 	s.incrementTenantCreated()
-	return tenant, nil
+	return tenant, true, nil
+}
+
+// replayCreate returns the tenant an earlier request with idempotencyKey created,
+// or nil if the key is unused. A key used for a different name is a conflict.
+func (s *TenantService) replayCreate(ctx context.Context, name, idempotencyKey string) (*models.Tenant, error) {
+	existing, err := s.tenants.FindByIdempotencyKey(ctx, idempotencyKey)
+	if err != nil {
+		if errors.Is(err, sentinel.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, dErrors.Wrap(err, dErrors.CodeInternal, "failed to look up idempotency key")
+	}
+	if !strings.EqualFold(existing.Name, name) {
+		return nil, dErrors.New(dErrors.CodeConflict, "idempotency key was used for a different tenant")
+	}
+	return existing, nil
 }
 
 func (s *TenantService) GetTenant(ctx context.Context, tenantID id.TenantID) (*models.Tenant, error) {
@@ -166,6 +222,44 @@ func (s *TenantService) ReactivateTenant(ctx context.Context, tenantID id.Tenant
 	return tenant, nil
 }
 
+// DeleteTenant soft-deletes a tenant. The tenant immediately disappears from
+// lookups, so its clients can no longer be resolved for OAuth flows, and it is
+// hard-deleted with its users and clients once the retention window elapses.
+// Its name stays reserved until then.
+//
+// Uses the Execute callback pattern for atomic validate-then-mutate.
+// A tenant that is already deleted is not visible to Execute and reports not found.
+func (s *TenantService) DeleteTenant(ctx context.Context, tenantID id.TenantID) (*models.Tenant, error) {
+	if err := requireTenantID(tenantID); err != nil {
+		return nil, err
+	}
+
+	now := requestcontext.Now(ctx)
+	tenant, err := s.tenants.Execute(ctx, tenantID,
+		func(t *models.Tenant) error {
+			if err := t.CanSoftDelete(); err != nil {
+				if dErrors.HasCode(err, dErrors.CodeInvariantViolation) {
+					return dErrors.New(dErrors.CodeConflict, "tenant is already deleted")
+				}
+				return err
+			}
+			return nil
+		},
+		func(t *models.Tenant) {
+			t.ApplySoftDelete(now)
+		},
+	)
+	if err != nil {
+		return nil, wrapTenantErr(err)
+	}
+
+	if err := s.auditEmitter.emitTenantDeleted(ctx, models.TenantDeleted{TenantID: tenant.ID}); err != nil {
+		return nil, err
+	}
+
+	return tenant, nil
+}
+
 func (s *TenantService) incrementTenantCreated() {
 	if s.metrics != nil {
 		s.metrics.IncrementTenantCreated()
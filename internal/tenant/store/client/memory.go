@@ -2,9 +2,11 @@ package client
 
 import (
 	"context"
+	"sort"
 	"sync"
 
 	"credo/internal/tenant/models"
+	"credo/internal/tenant/readmodels"
 	id "credo/pkg/domain"
 	"credo/pkg/platform/sentinel"
 )
@@ -91,6 +93,35 @@ func (s *InMemory) CountByTenant(_ context.Context, tenantID id.TenantID) (int,
 	return s.tenantCount[tenantID], nil
 }
 
+// Count returns the total number of stored clients.
+func (s *InMemory) Count(_ context.Context) (int, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return len(s.clients), nil
+}
+
+// TopTenantsByClientCount returns up to limit tenants with the most clients,
+// largest first; ties are ordered by tenant ID so results are stable.
+func (s *InMemory) TopTenantsByClientCount(_ context.Context, limit int) ([]readmodels.TenantClientCount, error) {
+	s.mu.RLock()
+	counts := make([]readmodels.TenantClientCount, 0, len(s.tenantCount))
+	for tenantID, n := range s.tenantCount {
+		counts = append(counts, readmodels.TenantClientCount{TenantID: tenantID, Clients: n})
+	}
+	s.mu.RUnlock()
+
+	sort.Slice(counts, func(i, j int) bool {
+		if counts[i].Clients != counts[j].Clients {
+			return counts[i].Clients > counts[j].Clients
+		}
+		return counts[i].TenantID.String() < counts[j].TenantID.String()
+	})
+	if len(counts) > limit {
+		counts = counts[:limit]
+	}
+	return counts, nil
+}
+
 // Execute atomically validates and mutates a client under lock.
 func (s *InMemory) Execute(_ context.Context, clientID id.ClientID, validate func(*models.Client) error, mutate func(*models.Client)) (*models.Client, error) {
 	s.mu.Lock()
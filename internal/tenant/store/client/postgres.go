@@ -6,8 +6,10 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"time"
 
 	"credo/internal/tenant/models"
+	"credo/internal/tenant/readmodels"
 	tenantsqlc "credo/internal/tenant/store/sqlc"
 	id "credo/pkg/domain"
 	"credo/pkg/platform/sentinel"
@@ -55,6 +57,10 @@ func (s *PostgresStore) Create(ctx context.Context, client *models.Client) error
 	if err != nil {
 		return fmt.Errorf("marshal allowed scopes: %w", err)
 	}
+	allowedResources, err := marshalResources(client.AllowedResources)
+	if err != nil {
+		return err
+	}
 
 	err = s.queriesFor(ctx).CreateClient(ctx, tenantsqlc.CreateClientParams{
 		ID:               uuid.UUID(client.ID),
@@ -68,6 +74,11 @@ func (s *PostgresStore) Create(ctx context.Context, client *models.Client) error
 		Status:           string(client.Status),
 		CreatedAt:        client.CreatedAt,
 		UpdatedAt:        client.UpdatedAt,
+		DisplayName:      client.DisplayName,
+		LogoUri:          client.LogoURI,
+		Description:      client.Description,
+		SupportContact:   client.SupportContact,
+		AllowedResources: allowedResources,
 	})
 	if err != nil {
 		if isUniqueViolation(err) {
@@ -137,17 +148,28 @@ func (s *PostgresStore) updateClient(ctx context.Context, queries *tenantsqlc.Qu
 	if err != nil {
 		return fmt.Errorf("marshal allowed scopes: %w", err)
 	}
+	allowedResources, err := marshalResources(client.AllowedResources)
+	if err != nil {
+		return err
+	}
 
 	res, err := queries.UpdateClient(ctx, tenantsqlc.UpdateClientParams{
-		ID:               uuid.UUID(client.ID),
-		Name:             client.Name,
-		OauthClientID:    client.OAuthClientID,
-		ClientSecretHash: nullString(client.ClientSecretHash),
-		RedirectUris:     redirectURIs,
-		AllowedGrants:    allowedGrants,
-		AllowedScopes:    allowedScopes,
-		Status:           string(client.Status),
-		UpdatedAt:        client.UpdatedAt,
+		ID:                      uuid.UUID(client.ID),
+		Name:                    client.Name,
+		OauthClientID:           client.OAuthClientID,
+		ClientSecretHash:        nullString(client.ClientSecretHash),
+		RedirectUris:            redirectURIs,
+		AllowedGrants:           allowedGrants,
+		AllowedScopes:           allowedScopes,
+		Status:                  string(client.Status),
+		UpdatedAt:               client.UpdatedAt,
+		DisplayName:             client.DisplayName,
+		LogoUri:                 client.LogoURI,
+		Description:             client.Description,
+		SupportContact:          client.SupportContact,
+		PreviousSecretHash:      nullString(client.PreviousSecretHash),
+		PreviousSecretExpiresAt: nullTime(client.PreviousSecretExpiresAt),
+		AllowedResources:        allowedResources,
 	})
 	if err != nil {
 		return fmt.Errorf("update client: %w", err)
@@ -210,6 +232,32 @@ func (s *PostgresStore) CountByTenant(ctx context.Context, tenantID id.TenantID)
 	return int(count), nil
 }
 
+// Count returns the total number of registered clients.
+func (s *PostgresStore) Count(ctx context.Context) (int, error) {
+	count, err := s.queriesFor(ctx).CountClients(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("count clients: %w", err)
+	}
+	return int(count), nil
+}
+
+// TopTenantsByClientCount returns up to limit tenants with the most clients,
+// largest first; ties are ordered by tenant ID so results are stable.
+func (s *PostgresStore) TopTenantsByClientCount(ctx context.Context, limit int) ([]readmodels.TenantClientCount, error) {
+	rows, err := s.queriesFor(ctx).ListTopTenantsByClientCount(ctx, int32(limit)) //nolint:gosec // limit is a small configured value
+	if err != nil {
+		return nil, fmt.Errorf("list top tenants by client count: %w", err)
+	}
+	counts := make([]readmodels.TenantClientCount, 0, len(rows))
+	for _, row := range rows {
+		counts = append(counts, readmodels.TenantClientCount{
+			TenantID: id.TenantID(row.TenantID),
+			Clients:  int(row.ClientCount),
+		})
+	}
+	return counts, nil
+}
+
 func toClient(row tenantsqlc.Client) (*models.Client, error) {
 	client := &models.Client{
 		ID:            id.ClientID(row.ID),
@@ -219,10 +267,23 @@ func toClient(row tenantsqlc.Client) (*models.Client, error) {
 		CreatedAt:     row.CreatedAt,
 		UpdatedAt:     row.UpdatedAt,
 		Status:        models.ClientStatus(row.Status),
+		ClientMetadata: models.ClientMetadata{
+			DisplayName:    row.DisplayName,
+			LogoURI:        row.LogoUri,
+			Description:    row.Description,
+			SupportContact: row.SupportContact,
+		},
 	}
 	if row.ClientSecretHash.Valid {
 		client.ClientSecretHash = row.ClientSecretHash.String
 	}
+	if row.PreviousSecretHash.Valid {
+		client.PreviousSecretHash = row.PreviousSecretHash.String
+	}
+	if row.PreviousSecretExpiresAt.Valid {
+		expiresAt := row.PreviousSecretExpiresAt.Time
+		client.PreviousSecretExpiresAt = &expiresAt
+	}
 	if err := unmarshalJSONIfPresent([]byte(row.RedirectUris), &client.RedirectURIs, "redirect_uris"); err != nil {
 		return nil, err
 	}
@@ -232,9 +293,25 @@ func toClient(row tenantsqlc.Client) (*models.Client, error) {
 	if err := unmarshalJSONIfPresent([]byte(row.AllowedScopes), &client.AllowedScopes, "allowed_scopes"); err != nil {
 		return nil, err
 	}
+	if err := unmarshalJSONIfPresent([]byte(row.AllowedResources), &client.AllowedResources, "allowed_resources"); err != nil {
+		return nil, err
+	}
 	return client, nil
 }
 
+// marshalResources encodes the resource allowlist, storing an unset list as an
+// empty array so the column always holds a JSON array.
+func marshalResources(resources []string) ([]byte, error) {
+	if resources == nil {
+		resources = []string{}
+	}
+	data, err := json.Marshal(resources)
+	if err != nil {
+		return nil, fmt.Errorf("marshal allowed resources: %w", err)
+	}
+	return data, nil
+}
+
 // unmarshalJSONIfPresent unmarshals JSON data into target if data is non-empty.
 func unmarshalJSONIfPresent[T any](data []byte, target *T, field string) error {
 	if len(data) == 0 {
@@ -253,6 +330,13 @@ func nullString(value string) sql.NullString {
 	return sql.NullString{String: value, Valid: true}
 }
 
+func nullTime(value *time.Time) sql.NullTime {
+	if value == nil {
+		return sql.NullTime{}
+	}
+	return sql.NullTime{Time: *value, Valid: true}
+}
+
 func isUniqueViolation(err error) bool {
 	var pgErr *pgconn.PgError
 	if errors.As(err, &pgErr) {
@@ -306,3 +306,55 @@ func (s *PostgresStoreSuite) TestNotFoundError() {
 	err = s.store.Update(ctx, c)
 	s.ErrorIs(err, sentinel.ErrNotFound)
 }
+
+// TestPreviousSecretRoundTrip verifies the rotation grace columns persist and clear.
+func (s *PostgresStoreSuite) TestPreviousSecretRoundTrip() {
+	ctx := context.Background()
+
+	c := s.newTestClient("grace-" + uuid.NewString())
+	c.ClientSecretHash = "old-hash"
+	s.Require().NoError(s.store.Create(ctx, c))
+
+	rotatedAt := time.Now().UTC().Truncate(time.Microsecond)
+	c.ApplySecretRotation("new-hash", rotatedAt, time.Hour)
+	s.Require().NoError(s.store.Update(ctx, c))
+
+	found, err := s.store.FindByID(ctx, c.ID)
+	s.Require().NoError(err)
+	s.Equal("new-hash", found.ClientSecretHash)
+	s.Equal("old-hash", found.PreviousSecretHash)
+	s.Require().NotNil(found.PreviousSecretExpiresAt)
+	s.True(rotatedAt.Add(time.Hour).Equal(*found.PreviousSecretExpiresAt))
+
+	found.ApplySecretRotation("newer-hash", rotatedAt, 0)
+	s.Require().NoError(s.store.Update(ctx, found))
+
+	cleared, err := s.store.FindByID(ctx, c.ID)
+	s.Require().NoError(err)
+	s.Empty(cleared.PreviousSecretHash)
+	s.Nil(cleared.PreviousSecretExpiresAt)
+}
+
+// TestAllowedResourcesRoundTrip verifies the resource allowlist persists, and
+// that an unset list is stored as an empty array rather than JSON null.
+func (s *PostgresStoreSuite) TestAllowedResourcesRoundTrip() {
+	ctx := context.Background()
+
+	c := s.newTestClient("resources-" + uuid.NewString())
+	s.Require().NoError(s.store.Create(ctx, c))
+
+	found, err := s.store.FindByID(ctx, c.ID)
+	s.Require().NoError(err)
+	s.Empty(found.AllowedResources)
+
+	var stored string
+	s.Require().NoError(s.postgres.DB.QueryRowContext(ctx, "SELECT allowed_resources::text FROM clients WHERE id = $1", uuid.UUID(c.ID)).Scan(&stored))
+	s.Equal("[]", stored)
+
+	found.AllowedResources = []string{"https://api.example.com", "https://billing.example.com"}
+	s.Require().NoError(s.store.Update(ctx, found))
+
+	updated, err := s.store.FindByOAuthClientID(ctx, c.OAuthClientID)
+	s.Require().NoError(err)
+	s.Equal([]string{"https://api.example.com", "https://billing.example.com"}, updated.AllowedResources)
+}
@@ -14,6 +14,17 @@ import (
 	"github.com/google/uuid"
 )
 
+const countClients = `-- name: CountClients :one
+SELECT COUNT(*) FROM clients
+`
+
+func (q *Queries) CountClients(ctx context.Context) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countClients)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const countClientsByTenant = `-- name: CountClientsByTenant :one
 SELECT COUNT(*) FROM clients WHERE tenant_id = $1
 `
@@ -28,9 +39,10 @@ func (q *Queries) CountClientsByTenant(ctx context.Context, tenantID uuid.UUID)
 const createClient = `-- name: CreateClient :exec
 INSERT INTO clients (
     id, tenant_id, name, oauth_client_id, client_secret_hash, redirect_uris,
-    allowed_grants, allowed_scopes, status, created_at, updated_at
+    allowed_grants, allowed_scopes, status, created_at, updated_at,
+    display_name, logo_uri, description, support_contact, allowed_resources
 )
-VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16)
 `
 
 type CreateClientParams struct {
@@ -45,6 +57,11 @@ type CreateClientParams struct {
 	Status           string
 	CreatedAt        time.Time
 	UpdatedAt        time.Time
+	DisplayName      string
+	LogoUri          string
+	Description      string
+	SupportContact   string
+	AllowedResources json.RawMessage
 }
 
 func (q *Queries) CreateClient(ctx context.Context, arg CreateClientParams) error {
@@ -60,13 +77,20 @@ func (q *Queries) CreateClient(ctx context.Context, arg CreateClientParams) erro
 		arg.Status,
 		arg.CreatedAt,
 		arg.UpdatedAt,
+		arg.DisplayName,
+		arg.LogoUri,
+		arg.Description,
+		arg.SupportContact,
+		arg.AllowedResources,
 	)
 	return err
 }
 
 const getClientByID = `-- name: GetClientByID :one
 SELECT id, tenant_id, name, oauth_client_id, client_secret_hash, redirect_uris,
-    allowed_grants, allowed_scopes, status, created_at, updated_at
+    allowed_grants, allowed_scopes, status, created_at, updated_at,
+    display_name, logo_uri, description, support_contact,
+    previous_secret_hash, previous_secret_expires_at, allowed_resources
 FROM clients
 WHERE id = $1
 `
@@ -86,13 +110,22 @@ func (q *Queries) GetClientByID(ctx context.Context, id uuid.UUID) (Client, erro
 		&i.Status,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.DisplayName,
+		&i.LogoUri,
+		&i.Description,
+		&i.SupportContact,
+		&i.PreviousSecretHash,
+		&i.PreviousSecretExpiresAt,
+		&i.AllowedResources,
 	)
 	return i, err
 }
 
 const getClientByOAuthClientID = `-- name: GetClientByOAuthClientID :one
 SELECT id, tenant_id, name, oauth_client_id, client_secret_hash, redirect_uris,
-    allowed_grants, allowed_scopes, status, created_at, updated_at
+    allowed_grants, allowed_scopes, status, created_at, updated_at,
+    display_name, logo_uri, description, support_contact,
+    previous_secret_hash, previous_secret_expires_at, allowed_resources
 FROM clients
 WHERE oauth_client_id = $1
 `
@@ -112,13 +145,22 @@ func (q *Queries) GetClientByOAuthClientID(ctx context.Context, oauthClientID st
 		&i.Status,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.DisplayName,
+		&i.LogoUri,
+		&i.Description,
+		&i.SupportContact,
+		&i.PreviousSecretHash,
+		&i.PreviousSecretExpiresAt,
+		&i.AllowedResources,
 	)
 	return i, err
 }
 
 const getClientByTenantAndID = `-- name: GetClientByTenantAndID :one
 SELECT id, tenant_id, name, oauth_client_id, client_secret_hash, redirect_uris,
-    allowed_grants, allowed_scopes, status, created_at, updated_at
+    allowed_grants, allowed_scopes, status, created_at, updated_at,
+    display_name, logo_uri, description, support_contact,
+    previous_secret_hash, previous_secret_expires_at, allowed_resources
 FROM clients
 WHERE id = $1 AND tenant_id = $2
 `
@@ -143,13 +185,22 @@ func (q *Queries) GetClientByTenantAndID(ctx context.Context, arg GetClientByTen
 		&i.Status,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.DisplayName,
+		&i.LogoUri,
+		&i.Description,
+		&i.SupportContact,
+		&i.PreviousSecretHash,
+		&i.PreviousSecretExpiresAt,
+		&i.AllowedResources,
 	)
 	return i, err
 }
 
 const getClientForUpdate = `-- name: GetClientForUpdate :one
 SELECT id, tenant_id, name, oauth_client_id, client_secret_hash, redirect_uris,
-    allowed_grants, allowed_scopes, status, created_at, updated_at
+    allowed_grants, allowed_scopes, status, created_at, updated_at,
+    display_name, logo_uri, description, support_contact,
+    previous_secret_hash, previous_secret_expires_at, allowed_resources
 FROM clients
 WHERE id = $1
 FOR UPDATE
@@ -170,10 +221,53 @@ func (q *Queries) GetClientForUpdate(ctx context.Context, id uuid.UUID) (Client,
 		&i.Status,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.DisplayName,
+		&i.LogoUri,
+		&i.Description,
+		&i.SupportContact,
+		&i.PreviousSecretHash,
+		&i.PreviousSecretExpiresAt,
+		&i.AllowedResources,
 	)
 	return i, err
 }
 
+const listTopTenantsByClientCount = `-- name: ListTopTenantsByClientCount :many
+SELECT tenant_id, COUNT(*) AS client_count
+FROM clients
+GROUP BY tenant_id
+ORDER BY client_count DESC, tenant_id
+LIMIT $1
+`
+
+type ListTopTenantsByClientCountRow struct {
+	TenantID    uuid.UUID
+	ClientCount int64
+}
+
+func (q *Queries) ListTopTenantsByClientCount(ctx context.Context, limit int32) ([]ListTopTenantsByClientCountRow, error) {
+	rows, err := q.db.QueryContext(ctx, listTopTenantsByClientCount, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListTopTenantsByClientCountRow
+	for rows.Next() {
+		var i ListTopTenantsByClientCountRow
+		if err := rows.Scan(&i.TenantID, &i.ClientCount); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const updateClient = `-- name: UpdateClient :execresult
 UPDATE clients
 SET name = $2,
@@ -183,20 +277,34 @@ SET name = $2,
     allowed_grants = $6,
     allowed_scopes = $7,
     status = $8,
-    updated_at = $9
+    updated_at = $9,
+    display_name = $10,
+    logo_uri = $11,
+    description = $12,
+    support_contact = $13,
+    previous_secret_hash = $14,
+    previous_secret_expires_at = $15,
+    allowed_resources = $16
 WHERE id = $1
 `
 
 type UpdateClientParams struct {
-	ID               uuid.UUID
-	Name             string
-	OauthClientID    string
-	ClientSecretHash sql.NullString
-	RedirectUris     json.RawMessage
-	AllowedGrants    json.RawMessage
-	AllowedScopes    json.RawMessage
-	Status           string
-	UpdatedAt        time.Time
+	ID                      uuid.UUID
+	Name                    string
+	OauthClientID           string
+	ClientSecretHash        sql.NullString
+	RedirectUris            json.RawMessage
+	AllowedGrants           json.RawMessage
+	AllowedScopes           json.RawMessage
+	Status                  string
+	UpdatedAt               time.Time
+	DisplayName             string
+	LogoUri                 string
+	Description             string
+	SupportContact          string
+	PreviousSecretHash      sql.NullString
+	PreviousSecretExpiresAt sql.NullTime
+	AllowedResources        json.RawMessage
 }
 
 func (q *Queries) UpdateClient(ctx context.Context, arg UpdateClientParams) (sql.Result, error) {
@@ -210,5 +318,12 @@ func (q *Queries) UpdateClient(ctx context.Context, arg UpdateClientParams) (sql
 		arg.AllowedScopes,
 		arg.Status,
 		arg.UpdatedAt,
+		arg.DisplayName,
+		arg.LogoUri,
+		arg.Description,
+		arg.SupportContact,
+		arg.PreviousSecretHash,
+		arg.PreviousSecretExpiresAt,
+		arg.AllowedResources,
 	)
 }
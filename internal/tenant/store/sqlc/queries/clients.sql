@@ -1,9 +1,10 @@
 -- name: CreateClient :exec
 INSERT INTO clients (
     id, tenant_id, name, oauth_client_id, client_secret_hash, redirect_uris,
-    allowed_grants, allowed_scopes, status, created_at, updated_at
+    allowed_grants, allowed_scopes, status, created_at, updated_at,
+    display_name, logo_uri, description, support_contact, allowed_resources
 )
-VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11);
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16);
 
 -- name: UpdateClient :execresult
 UPDATE clients
@@ -14,33 +15,58 @@ SET name = $2,
     allowed_grants = $6,
     allowed_scopes = $7,
     status = $8,
-    updated_at = $9
+    updated_at = $9,
+    display_name = $10,
+    logo_uri = $11,
+    description = $12,
+    support_contact = $13,
+    previous_secret_hash = $14,
+    previous_secret_expires_at = $15,
+    allowed_resources = $16
 WHERE id = $1;
 
 -- name: GetClientByID :one
 SELECT id, tenant_id, name, oauth_client_id, client_secret_hash, redirect_uris,
-    allowed_grants, allowed_scopes, status, created_at, updated_at
+    allowed_grants, allowed_scopes, status, created_at, updated_at,
+    display_name, logo_uri, description, support_contact,
+    previous_secret_hash, previous_secret_expires_at, allowed_resources
 FROM clients
 WHERE id = $1;
 
 -- name: GetClientByTenantAndID :one
 SELECT id, tenant_id, name, oauth_client_id, client_secret_hash, redirect_uris,
-    allowed_grants, allowed_scopes, status, created_at, updated_at
+    allowed_grants, allowed_scopes, status, created_at, updated_at,
+    display_name, logo_uri, description, support_contact,
+    previous_secret_hash, previous_secret_expires_at, allowed_resources
 FROM clients
 WHERE id = $1 AND tenant_id = $2;
 
 -- name: GetClientByOAuthClientID :one
 SELECT id, tenant_id, name, oauth_client_id, client_secret_hash, redirect_uris,
-    allowed_grants, allowed_scopes, status, created_at, updated_at
+    allowed_grants, allowed_scopes, status, created_at, updated_at,
+    display_name, logo_uri, description, support_contact,
+    previous_secret_hash, previous_secret_expires_at, allowed_resources
 FROM clients
 WHERE oauth_client_id = $1;
 
 -- name: CountClientsByTenant :one
 SELECT COUNT(*) FROM clients WHERE tenant_id = $1;
 
+-- name: CountClients :one
+SELECT COUNT(*) FROM clients;
+
+-- name: ListTopTenantsByClientCount :many
+SELECT tenant_id, COUNT(*) AS client_count
+FROM clients
+GROUP BY tenant_id
+ORDER BY client_count DESC, tenant_id
+LIMIT $1;
+
 -- name: GetClientForUpdate :one
 SELECT id, tenant_id, name, oauth_client_id, client_secret_hash, redirect_uris,
-    allowed_grants, allowed_scopes, status, created_at, updated_at
+    allowed_grants, allowed_scopes, status, created_at, updated_at,
+    display_name, logo_uri, description, support_contact,
+    previous_secret_hash, previous_secret_expires_at, allowed_resources
 FROM clients
 WHERE id = $1
 FOR UPDATE;
@@ -3,25 +3,57 @@ INSERT INTO tenants (id, name, status, created_at, updated_at)
 VALUES ($1, $2, $3, $4, $5);
 
 -- name: GetTenantByID :one
-SELECT id, name, status, created_at, updated_at
+SELECT id, name, status, created_at, updated_at, deleted_at
 FROM tenants
-WHERE id = $1;
+WHERE id = $1 AND deleted_at IS NULL;
 
 -- name: GetTenantByName :one
-SELECT id, name, status, created_at, updated_at
+SELECT id, name, status, created_at, updated_at, deleted_at
 FROM tenants
-WHERE lower(name) = lower($1);
+WHERE lower(name) = lower($1) AND deleted_at IS NULL;
 
 -- name: CountTenants :one
-SELECT COUNT(*) FROM tenants;
+SELECT COUNT(*) FROM tenants
+WHERE deleted_at IS NULL;
 
 -- name: UpdateTenant :execresult
 UPDATE tenants
-SET name = $2, status = $3, updated_at = $4
+SET name = $2, status = $3, updated_at = $4, deleted_at = $5
 WHERE id = $1;
 
 -- name: GetTenantForUpdate :one
-SELECT id, name, status, created_at, updated_at
+SELECT id, name, status, created_at, updated_at, deleted_at
 FROM tenants
-WHERE id = $1
+WHERE id = $1 AND deleted_at IS NULL
 FOR UPDATE;
+
+-- name: CreateTenantIdempotencyKey :exec
+INSERT INTO tenant_idempotency_keys (idempotency_key, tenant_id)
+VALUES ($1, $2);
+
+-- name: GetTenantIDByIdempotencyKey :one
+SELECT tenant_id
+FROM tenant_idempotency_keys
+WHERE idempotency_key = $1;
+
+-- name: ListTenantIDsDeletedBefore :many
+SELECT id
+FROM tenants
+WHERE status = 'deleted' AND deleted_at < sqlc.arg(cutoff)::timestamptz
+ORDER BY deleted_at;
+
+-- name: DeleteSessionsByTenant :exec
+DELETE FROM sessions
+WHERE tenant_id = $1;
+
+-- name: DeleteUsersByTenant :exec
+DELETE FROM users
+WHERE tenant_id = $1;
+
+-- name: DeleteClientsByTenant :exec
+DELETE FROM clients
+WHERE tenant_id = $1;
+
+-- name: DeleteDeletedTenant :execrows
+DELETE FROM tenants
+WHERE id = $1 AND status = 'deleted';
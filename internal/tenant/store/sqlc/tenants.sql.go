@@ -15,6 +15,7 @@ import (
 
 const countTenants = `-- name: CountTenants :one
 SELECT COUNT(*) FROM tenants
+WHERE deleted_at IS NULL
 `
 
 func (q *Queries) CountTenants(ctx context.Context) (int64, error) {
@@ -48,10 +49,68 @@ func (q *Queries) CreateTenant(ctx context.Context, arg CreateTenantParams) erro
 	return err
 }
 
+const createTenantIdempotencyKey = `-- name: CreateTenantIdempotencyKey :exec
+INSERT INTO tenant_idempotency_keys (idempotency_key, tenant_id)
+VALUES ($1, $2)
+`
+
+type CreateTenantIdempotencyKeyParams struct {
+	IdempotencyKey string
+	TenantID       uuid.UUID
+}
+
+func (q *Queries) CreateTenantIdempotencyKey(ctx context.Context, arg CreateTenantIdempotencyKeyParams) error {
+	_, err := q.db.ExecContext(ctx, createTenantIdempotencyKey, arg.IdempotencyKey, arg.TenantID)
+	return err
+}
+
+const deleteClientsByTenant = `-- name: DeleteClientsByTenant :exec
+DELETE FROM clients
+WHERE tenant_id = $1
+`
+
+func (q *Queries) DeleteClientsByTenant(ctx context.Context, tenantID uuid.UUID) error {
+	_, err := q.db.ExecContext(ctx, deleteClientsByTenant, tenantID)
+	return err
+}
+
+const deleteDeletedTenant = `-- name: DeleteDeletedTenant :execrows
+DELETE FROM tenants
+WHERE id = $1 AND status = 'deleted'
+`
+
+func (q *Queries) DeleteDeletedTenant(ctx context.Context, id uuid.UUID) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteDeletedTenant, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const deleteSessionsByTenant = `-- name: DeleteSessionsByTenant :exec
+DELETE FROM sessions
+WHERE tenant_id = $1
+`
+
+func (q *Queries) DeleteSessionsByTenant(ctx context.Context, tenantID uuid.UUID) error {
+	_, err := q.db.ExecContext(ctx, deleteSessionsByTenant, tenantID)
+	return err
+}
+
+const deleteUsersByTenant = `-- name: DeleteUsersByTenant :exec
+DELETE FROM users
+WHERE tenant_id = $1
+`
+
+func (q *Queries) DeleteUsersByTenant(ctx context.Context, tenantID uuid.UUID) error {
+	_, err := q.db.ExecContext(ctx, deleteUsersByTenant, tenantID)
+	return err
+}
+
 const getTenantByID = `-- name: GetTenantByID :one
-SELECT id, name, status, created_at, updated_at
+SELECT id, name, status, created_at, updated_at, deleted_at
 FROM tenants
-WHERE id = $1
+WHERE id = $1 AND deleted_at IS NULL
 `
 
 func (q *Queries) GetTenantByID(ctx context.Context, id uuid.UUID) (Tenant, error) {
@@ -63,14 +122,15 @@ func (q *Queries) GetTenantByID(ctx context.Context, id uuid.UUID) (Tenant, erro
 		&i.Status,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.DeletedAt,
 	)
 	return i, err
 }
 
 const getTenantByName = `-- name: GetTenantByName :one
-SELECT id, name, status, created_at, updated_at
+SELECT id, name, status, created_at, updated_at, deleted_at
 FROM tenants
-WHERE lower(name) = lower($1)
+WHERE lower(name) = lower($1) AND deleted_at IS NULL
 `
 
 func (q *Queries) GetTenantByName(ctx context.Context, lower string) (Tenant, error) {
@@ -82,14 +142,15 @@ func (q *Queries) GetTenantByName(ctx context.Context, lower string) (Tenant, er
 		&i.Status,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.DeletedAt,
 	)
 	return i, err
 }
 
 const getTenantForUpdate = `-- name: GetTenantForUpdate :one
-SELECT id, name, status, created_at, updated_at
+SELECT id, name, status, created_at, updated_at, deleted_at
 FROM tenants
-WHERE id = $1
+WHERE id = $1 AND deleted_at IS NULL
 FOR UPDATE
 `
 
@@ -102,13 +163,57 @@ func (q *Queries) GetTenantForUpdate(ctx context.Context, id uuid.UUID) (Tenant,
 		&i.Status,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.DeletedAt,
 	)
 	return i, err
 }
 
+const getTenantIDByIdempotencyKey = `-- name: GetTenantIDByIdempotencyKey :one
+SELECT tenant_id
+FROM tenant_idempotency_keys
+WHERE idempotency_key = $1
+`
+
+func (q *Queries) GetTenantIDByIdempotencyKey(ctx context.Context, idempotencyKey string) (uuid.UUID, error) {
+	row := q.db.QueryRowContext(ctx, getTenantIDByIdempotencyKey, idempotencyKey)
+	var tenant_id uuid.UUID
+	err := row.Scan(&tenant_id)
+	return tenant_id, err
+}
+
+const listTenantIDsDeletedBefore = `-- name: ListTenantIDsDeletedBefore :many
+SELECT id
+FROM tenants
+WHERE status = 'deleted' AND deleted_at < $1::timestamptz
+ORDER BY deleted_at
+`
+
+func (q *Queries) ListTenantIDsDeletedBefore(ctx context.Context, cutoff time.Time) ([]uuid.UUID, error) {
+	rows, err := q.db.QueryContext(ctx, listTenantIDsDeletedBefore, cutoff)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const updateTenant = `-- name: UpdateTenant :execresult
 UPDATE tenants
-SET name = $2, status = $3, updated_at = $4
+SET name = $2, status = $3, updated_at = $4, deleted_at = $5
 WHERE id = $1
 `
 
@@ -117,6 +222,7 @@ type UpdateTenantParams struct {
 	Name      string
 	Status    string
 	UpdatedAt time.Time
+	DeletedAt sql.NullTime
 }
 
 func (q *Queries) UpdateTenant(ctx context.Context, arg UpdateTenantParams) (sql.Result, error) {
@@ -125,5 +231,6 @@ func (q *Queries) UpdateTenant(ctx context.Context, arg UpdateTenantParams) (sql
 		arg.Name,
 		arg.Status,
 		arg.UpdatedAt,
+		arg.DeletedAt,
 	)
 }
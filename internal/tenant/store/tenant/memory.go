@@ -3,8 +3,10 @@ package tenant
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strings"
 	"sync"
+	"time"
 
 	"credo/internal/tenant/models"
 	id "credo/pkg/domain"
@@ -16,6 +18,7 @@ type InMemory struct {
 	mu      sync.RWMutex
 	tenants map[id.TenantID]*models.Tenant
 	nameIdx map[string]id.TenantID
+	keyIdx  map[string]id.TenantID // idempotency key -> tenant
 }
 
 // NewInMemory creates an in-memory tenant store.
@@ -23,6 +26,7 @@ func NewInMemory() *InMemory {
 	return &InMemory{
 		tenants: make(map[id.TenantID]*models.Tenant),
 		nameIdx: make(map[string]id.TenantID),
+		keyIdx:  make(map[string]id.TenantID),
 	}
 }
 
@@ -43,7 +47,7 @@ func (s *InMemory) CreateIfNameAvailable(_ context.Context, t *models.Tenant) er
 func (s *InMemory) FindByID(_ context.Context, tenantID id.TenantID) (*models.Tenant, error) {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
-	if t, ok := s.tenants[tenantID]; ok {
+	if t, ok := s.tenants[tenantID]; ok && !t.IsDeleted() {
 		return t, nil
 	}
 	return nil, sentinel.ErrNotFound
@@ -54,16 +58,47 @@ func (s *InMemory) FindByName(_ context.Context, name string) (*models.Tenant, e
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 	if tenantID, ok := s.nameIdx[strings.ToLower(name)]; ok {
-		return s.tenants[tenantID], nil
+		if t := s.tenants[tenantID]; !t.IsDeleted() {
+			return t, nil
+		}
 	}
 	return nil, sentinel.ErrNotFound
 }
 
-// Count returns the total number of tenants.
+// SaveIdempotencyKey records the idempotency key of the request that created the tenant.
+func (s *InMemory) SaveIdempotencyKey(_ context.Context, key string, tenantID id.TenantID) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, exists := s.keyIdx[key]; exists {
+		return fmt.Errorf("idempotency key already used: %w", sentinel.ErrAlreadyUsed)
+	}
+	s.keyIdx[key] = tenantID
+	return nil
+}
+
+// FindByIdempotencyKey retrieves the tenant created by the request with the given idempotency key.
+func (s *InMemory) FindByIdempotencyKey(_ context.Context, key string) (*models.Tenant, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if tenantID, ok := s.keyIdx[key]; ok {
+		if t, ok := s.tenants[tenantID]; ok && !t.IsDeleted() {
+			return t, nil
+		}
+	}
+	return nil, sentinel.ErrNotFound
+}
+
+// Count returns the total number of tenants, excluding soft-deleted ones.
 func (s *InMemory) Count(_ context.Context) (int, error) {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
-	return len(s.tenants), nil
+	count := 0
+	for _, t := range s.tenants {
+		if !t.IsDeleted() {
+			count++
+		}
+	}
+	return count, nil
 }
 
 // Update updates an existing tenant. Returns ErrNotFound if tenant doesn't exist.
@@ -83,7 +118,7 @@ func (s *InMemory) Execute(_ context.Context, tenantID id.TenantID, validate fun
 	defer s.mu.Unlock()
 
 	tenant, exists := s.tenants[tenantID]
-	if !exists {
+	if !exists || tenant.IsDeleted() {
 		return nil, sentinel.ErrNotFound
 	}
 
@@ -95,3 +130,43 @@ func (s *InMemory) Execute(_ context.Context, tenantID id.TenantID, validate fun
 	s.tenants[tenantID] = tenant
 	return tenant, nil
 }
+
+// ListDeletedBefore returns the IDs of tenants soft-deleted before cutoff, oldest first.
+func (s *InMemory) ListDeletedBefore(_ context.Context, cutoff time.Time) ([]id.TenantID, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var deleted []*models.Tenant
+	for _, t := range s.tenants {
+		if t.IsDeleted() && t.DeletedAt.Before(cutoff) {
+			deleted = append(deleted, t)
+		}
+	}
+	slices.SortFunc(deleted, func(a, b *models.Tenant) int {
+		return a.DeletedAt.Compare(*b.DeletedAt)
+	})
+	ids := make([]id.TenantID, 0, len(deleted))
+	for _, t := range deleted {
+		ids = append(ids, t.ID)
+	}
+	return ids, nil
+}
+
+// HardDelete permanently removes a soft-deleted tenant, releasing its name and
+// idempotency keys. Returns ErrNotFound if the tenant does not exist or is not
+// soft-deleted. The in-memory store holds no dependent rows to remove.
+func (s *InMemory) HardDelete(_ context.Context, tenantID id.TenantID) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t, ok := s.tenants[tenantID]
+	if !ok || !t.IsDeleted() {
+		return sentinel.ErrNotFound
+	}
+	delete(s.tenants, tenantID)
+	delete(s.nameIdx, strings.ToLower(t.Name))
+	for key, keyTenant := range s.keyIdx {
+		if keyTenant == tenantID {
+			delete(s.keyIdx, key)
+		}
+	}
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"time"
 
 	"credo/internal/tenant/models"
 	tenantsqlc "credo/internal/tenant/store/sqlc"
@@ -82,7 +83,34 @@ func (s *PostgresStore) FindByName(ctx context.Context, name string) (*models.Te
 	return toTenant(row), nil
 }
 
-// Count returns the total number of tenants.
+// SaveIdempotencyKey records the idempotency key of the request that created the tenant.
+func (s *PostgresStore) SaveIdempotencyKey(ctx context.Context, key string, tenantID id.TenantID) error {
+	err := s.queriesFor(ctx).CreateTenantIdempotencyKey(ctx, tenantsqlc.CreateTenantIdempotencyKeyParams{
+		IdempotencyKey: key,
+		TenantID:       uuid.UUID(tenantID),
+	})
+	if err != nil {
+		if isUniqueViolation(err) {
+			return fmt.Errorf("idempotency key already used: %w", sentinel.ErrAlreadyUsed)
+		}
+		return fmt.Errorf("save tenant idempotency key: %w", err)
+	}
+	return nil
+}
+
+// FindByIdempotencyKey retrieves the tenant created by the request with the given idempotency key.
+func (s *PostgresStore) FindByIdempotencyKey(ctx context.Context, key string) (*models.Tenant, error) {
+	tenantID, err := s.queriesFor(ctx).GetTenantIDByIdempotencyKey(ctx, key)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sentinel.ErrNotFound
+		}
+		return nil, fmt.Errorf("find tenant by idempotency key: %w", err)
+	}
+	return s.FindByID(ctx, id.TenantID(tenantID))
+}
+
+// Count returns the total number of tenants, excluding soft-deleted ones.
 func (s *PostgresStore) Count(ctx context.Context) (int, error) {
 	count, err := s.queriesFor(ctx).CountTenants(ctx)
 	if err != nil {
@@ -101,6 +129,7 @@ func (s *PostgresStore) Update(ctx context.Context, tenant *models.Tenant) error
 		Name:      tenant.Name,
 		Status:    string(tenant.Status),
 		UpdatedAt: tenant.UpdatedAt,
+		DeletedAt: nullTime(tenant.DeletedAt),
 	})
 	if err != nil {
 		return fmt.Errorf("update tenant: %w", err)
@@ -145,6 +174,7 @@ func (s *PostgresStore) Execute(ctx context.Context, tenantID id.TenantID, valid
 		Name:      tenant.Name,
 		Status:    string(tenant.Status),
 		UpdatedAt: tenant.UpdatedAt,
+		DeletedAt: nullTime(tenant.DeletedAt),
 	})
 	if err != nil {
 		return nil, fmt.Errorf("update tenant: %w", err)
@@ -163,14 +193,77 @@ func (s *PostgresStore) Execute(ctx context.Context, tenantID id.TenantID, valid
 	return tenant, nil
 }
 
+// ListDeletedBefore returns the IDs of tenants soft-deleted before cutoff, oldest first.
+func (s *PostgresStore) ListDeletedBefore(ctx context.Context, cutoff time.Time) ([]id.TenantID, error) {
+	rows, err := s.queriesFor(ctx).ListTenantIDsDeletedBefore(ctx, cutoff)
+	if err != nil {
+		return nil, fmt.Errorf("list deleted tenants: %w", err)
+	}
+	ids := make([]id.TenantID, 0, len(rows))
+	for _, row := range rows {
+		ids = append(ids, id.TenantID(row))
+	}
+	return ids, nil
+}
+
+// HardDelete permanently removes a soft-deleted tenant together with its
+// sessions, users and clients in a single transaction. Rows that cascade from
+// users and sessions (consents, tokens, authorization codes) go with them.
+// Returns ErrNotFound if the tenant does not exist or is not soft-deleted.
+func (s *PostgresStore) HardDelete(ctx context.Context, tenantID id.TenantID) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin tenant hard delete tx: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback() //nolint:errcheck // rollback after commit is no-op; error already captured
+	}()
+
+	qtx := s.queries.WithTx(tx)
+	tid := uuid.UUID(tenantID)
+	if err := qtx.DeleteSessionsByTenant(ctx, tid); err != nil {
+		return fmt.Errorf("delete tenant sessions: %w", err)
+	}
+	if err := qtx.DeleteUsersByTenant(ctx, tid); err != nil {
+		return fmt.Errorf("delete tenant users: %w", err)
+	}
+	if err := qtx.DeleteClientsByTenant(ctx, tid); err != nil {
+		return fmt.Errorf("delete tenant clients: %w", err)
+	}
+	rows, err := qtx.DeleteDeletedTenant(ctx, tid)
+	if err != nil {
+		return fmt.Errorf("delete tenant: %w", err)
+	}
+	if rows == 0 {
+		return sentinel.ErrNotFound
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit tenant hard delete: %w", err)
+	}
+	return nil
+}
+
 func toTenant(row tenantsqlc.Tenant) *models.Tenant {
-	return &models.Tenant{
+	tenant := &models.Tenant{
 		ID:        id.TenantID(row.ID),
 		Name:      row.Name,
 		Status:    models.TenantStatus(row.Status),
 		CreatedAt: row.CreatedAt,
 		UpdatedAt: row.UpdatedAt,
 	}
+	if row.DeletedAt.Valid {
+		deletedAt := row.DeletedAt.Time
+		tenant.DeletedAt = &deletedAt
+	}
+	return tenant
+}
+
+func nullTime(t *time.Time) sql.NullTime {
+	if t == nil {
+		return sql.NullTime{}
+	}
+	return sql.NullTime{Time: *t, Valid: true}
 }
 
 func isUniqueViolation(err error) bool {
@@ -285,3 +285,78 @@ func (s *PostgresStoreSuite) TestCountUnderConcurrentCreation() {
 	s.Require().NoError(err)
 	s.Equal(goroutines, count)
 }
+
+// TestIdempotencyKey verifies a create's idempotency key resolves to its tenant
+// and cannot be claimed twice.
+func (s *PostgresStoreSuite) TestIdempotencyKey() {
+	ctx := context.Background()
+	key := "create-" + uuid.NewString()
+
+	t := newTestTenant("Idempotent Tenant " + uuid.NewString())
+	s.Require().NoError(s.store.CreateIfNameAvailable(ctx, t))
+	s.Require().NoError(s.store.SaveIdempotencyKey(ctx, key, t.ID))
+
+	found, err := s.store.FindByIdempotencyKey(ctx, key)
+	s.Require().NoError(err)
+	s.Equal(t.ID, found.ID)
+
+	other := newTestTenant("Other Tenant " + uuid.NewString())
+	s.Require().NoError(s.store.CreateIfNameAvailable(ctx, other))
+	s.ErrorIs(s.store.SaveIdempotencyKey(ctx, key, other.ID), sentinel.ErrAlreadyUsed)
+
+	_, err = s.store.FindByIdempotencyKey(ctx, "unused-"+uuid.NewString())
+	s.ErrorIs(err, sentinel.ErrNotFound)
+}
+
+// TestSoftDeleteAndHardDelete verifies a soft-deleted tenant is hidden from
+// lookups and that hard deletion removes it together with its users and clients.
+func (s *PostgresStoreSuite) TestSoftDeleteAndHardDelete() {
+	ctx := context.Background()
+	tenantID := s.postgres.CreateTestTenant(ctx, s.T())
+	s.postgres.CreateTestUser(ctx, s.T(), tenantID)
+	s.postgres.CreateTestClient(ctx, s.T(), tenantID)
+
+	found, err := s.store.FindByID(ctx, tenantID)
+	s.Require().NoError(err)
+
+	deletedAt := time.Now().Add(-time.Hour).UTC().Truncate(time.Microsecond)
+	_, err = s.store.Execute(ctx, tenantID,
+		func(t *models.Tenant) error { return t.CanSoftDelete() },
+		func(t *models.Tenant) { t.ApplySoftDelete(deletedAt) },
+	)
+	s.Require().NoError(err)
+
+	s.Run("hidden from lookups", func() {
+		_, err := s.store.FindByID(ctx, tenantID)
+		s.ErrorIs(err, sentinel.ErrNotFound)
+		_, err = s.store.FindByName(ctx, found.Name)
+		s.ErrorIs(err, sentinel.ErrNotFound)
+	})
+
+	s.Run("listed only once past the cutoff", func() {
+		ids, err := s.store.ListDeletedBefore(ctx, deletedAt)
+		s.Require().NoError(err)
+		s.NotContains(ids, tenantID)
+
+		ids, err = s.store.ListDeletedBefore(ctx, deletedAt.Add(time.Second))
+		s.Require().NoError(err)
+		s.Contains(ids, tenantID)
+	})
+
+	s.Run("hard delete removes dependents", func() {
+		s.Require().NoError(s.store.HardDelete(ctx, tenantID))
+
+		for _, table := range []string{"users", "clients", "tenants"} {
+			column := "tenant_id"
+			if table == "tenants" {
+				column = "id"
+			}
+			var count int
+			s.Require().NoError(s.postgres.QueryRow(ctx,
+				"SELECT COUNT(*) FROM "+table+" WHERE "+column+" = $1", uuid.UUID(tenantID),
+			).Scan(&count))
+			s.Zero(count, "%s rows must be purged", table)
+		}
+		s.ErrorIs(s.store.HardDelete(ctx, tenantID), sentinel.ErrNotFound)
+	})
+}
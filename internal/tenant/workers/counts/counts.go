@@ -0,0 +1,144 @@
+package counts
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	tenantmetrics "credo/internal/tenant/metrics"
+	"credo/internal/tenant/readmodels"
+)
+
+// Refresh defaults keep the count queries off the scrape path: gauges are at
+// most one interval stale, which is plenty for capacity dashboards.
+const (
+	DefaultInterval = 30 * time.Second
+	DefaultTopN     = 10
+)
+
+// TenantCounter counts live tenants.
+type TenantCounter interface {
+	Count(ctx context.Context) (int, error)
+}
+
+// ClientCounter counts clients in total and for the largest tenants.
+type ClientCounter interface {
+	Count(ctx context.Context) (int, error)
+	TopTenantsByClientCount(ctx context.Context, limit int) ([]readmodels.TenantClientCount, error)
+}
+
+// Option configures the count Service.
+type Option func(*Service)
+
+// WithLogger overrides the logger used for refresh failures.
+func WithLogger(logger *slog.Logger) Option {
+	return func(s *Service) {
+		if logger != nil {
+			s.logger = logger
+		}
+	}
+}
+
+// WithInterval overrides how often the gauges are refreshed when greater than zero.
+func WithInterval(interval time.Duration) Option {
+	return func(s *Service) {
+		if interval > 0 {
+			s.interval = interval
+		}
+	}
+}
+
+// WithTopN overrides how many tenants get a clients-per-tenant gauge when greater than zero.
+func WithTopN(n int) Option {
+	return func(s *Service) {
+		if n > 0 {
+			s.topN = n
+		}
+	}
+}
+
+// Service periodically copies tenant and client counts from the stores into gauges.
+type Service struct {
+	tenants  TenantCounter
+	clients  ClientCounter
+	metrics  *tenantmetrics.Metrics
+	logger   *slog.Logger
+	interval time.Duration
+	topN     int
+}
+
+// New constructs a count Service with options applied.
+func New(tenants TenantCounter, clients ClientCounter, metrics *tenantmetrics.Metrics, opts ...Option) (*Service, error) {
+	if tenants == nil {
+		return nil, fmt.Errorf("tenant store is required")
+	}
+	if clients == nil {
+		return nil, fmt.Errorf("client store is required")
+	}
+	if metrics == nil {
+		return nil, fmt.Errorf("metrics are required")
+	}
+	svc := &Service{
+		tenants:  tenants,
+		clients:  clients,
+		metrics:  metrics,
+		logger:   slog.Default(),
+		interval: DefaultInterval,
+		topN:     DefaultTopN,
+	}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(svc)
+		}
+	}
+	return svc, nil
+}
+
+// Start refreshes the gauges immediately and then every interval until ctx is cancelled.
+func (s *Service) Start(ctx context.Context) error {
+	s.refresh(ctx)
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			s.refresh(ctx)
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (s *Service) refresh(ctx context.Context) {
+	if err := s.RunOnce(ctx); err != nil {
+		s.logger.ErrorContext(ctx, "tenant count refresh failed", "error", err)
+	}
+}
+
+// RunOnce reads the current counts and updates the gauges.
+// On error the gauges keep their previous values rather than dropping to zero.
+func (s *Service) RunOnce(ctx context.Context) error {
+	tenants, err := s.tenants.Count(ctx)
+	if err != nil {
+		return fmt.Errorf("count tenants: %w", err)
+	}
+	clients, err := s.clients.Count(ctx)
+	if err != nil {
+		return fmt.Errorf("count clients: %w", err)
+	}
+	top, err := s.clients.TopTenantsByClientCount(ctx, s.topN)
+	if err != nil {
+		return fmt.Errorf("count clients per tenant: %w", err)
+	}
+
+	perTenant := make(map[string]int, len(top))
+	for _, c := range top {
+		perTenant[c.TenantID.String()] = c.Clients
+	}
+	s.metrics.SetCounts(tenants, clients)
+	s.metrics.SetClientsPerTenant(perTenant)
+	return nil
+}
@@ -0,0 +1,113 @@
+package counts
+
+// Justification: Gauges are only observable through the metrics registry, so
+// these tests verify the refresh copies store counts into them, including
+// dropping tenants that fall out of the top-N.
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/suite"
+
+	tenantmetrics "credo/internal/tenant/metrics"
+	"credo/internal/tenant/models"
+	clientstore "credo/internal/tenant/store/client"
+	tenantstore "credo/internal/tenant/store/tenant"
+	id "credo/pkg/domain"
+)
+
+type CountsSuite struct {
+	suite.Suite
+	metrics *tenantmetrics.Metrics // promauto registers globally, so build once
+	tenants *tenantstore.InMemory
+	clients *clientstore.InMemory
+}
+
+func TestCountsSuite(t *testing.T) {
+	suite.Run(t, &CountsSuite{metrics: tenantmetrics.New()})
+}
+
+func (s *CountsSuite) SetupTest() {
+	s.tenants = tenantstore.NewInMemory()
+	s.clients = clientstore.NewInMemory()
+}
+
+func (s *CountsSuite) createTenant(name string) *models.Tenant {
+	tenant, err := models.NewTenant(id.TenantID(uuid.New()), name, time.Now())
+	s.Require().NoError(err)
+	s.Require().NoError(s.tenants.CreateIfNameAvailable(context.Background(), tenant))
+	return tenant
+}
+
+func (s *CountsSuite) createClients(tenantID id.TenantID, n int) {
+	for i := range n {
+		client, err := models.NewClient(
+			id.ClientID(uuid.New()),
+			tenantID,
+			fmt.Sprintf("client-%d", i),
+			uuid.NewString(),
+			"",
+			[]string{"https://app.example.com/callback"},
+			[]models.GrantType{models.GrantTypeAuthorizationCode},
+			[]string{"openid"},
+			time.Now(),
+		)
+		s.Require().NoError(err)
+		s.Require().NoError(s.clients.Create(context.Background(), client))
+	}
+}
+
+func (s *CountsSuite) TestRefresh() {
+	s.Run("gauges reflect store counts", func() {
+		s.SetupTest()
+		acme := s.createTenant("Acme")
+		globex := s.createTenant("Globex")
+		s.createTenant("Initech")
+		s.createClients(acme.ID, 3)
+		s.createClients(globex.ID, 1)
+
+		svc, err := New(s.tenants, s.clients, s.metrics)
+		s.Require().NoError(err)
+		s.Require().NoError(svc.RunOnce(context.Background()))
+
+		s.InDelta(3.0, testutil.ToFloat64(s.metrics.Tenants), 0)
+		s.InDelta(4.0, testutil.ToFloat64(s.metrics.Clients), 0)
+		s.InDelta(3.0, testutil.ToFloat64(s.metrics.ClientsPerTenant.WithLabelValues(acme.ID.String())), 0)
+		s.InDelta(1.0, testutil.ToFloat64(s.metrics.ClientsPerTenant.WithLabelValues(globex.ID.String())), 0)
+	})
+
+	s.Run("only the top-N tenants are labeled", func() {
+		s.SetupTest()
+		acme := s.createTenant("Acme")
+		globex := s.createTenant("Globex")
+		s.createClients(acme.ID, 2)
+		s.createClients(globex.ID, 1)
+
+		svc, err := New(s.tenants, s.clients, s.metrics, WithTopN(1))
+		s.Require().NoError(err)
+		s.Require().NoError(svc.RunOnce(context.Background()))
+
+		s.Equal(1, testutil.CollectAndCount(s.metrics.ClientsPerTenant))
+		s.InDelta(2.0, testutil.ToFloat64(s.metrics.ClientsPerTenant.WithLabelValues(acme.ID.String())), 0)
+	})
+
+	s.Run("refresh picks up newly created tenants", func() {
+		s.SetupTest()
+		svc, err := New(s.tenants, s.clients, s.metrics)
+		s.Require().NoError(err)
+		s.Require().NoError(svc.RunOnce(context.Background()))
+		s.InDelta(0.0, testutil.ToFloat64(s.metrics.Tenants), 0)
+
+		s.createTenant("Acme")
+		s.createTenant("Globex")
+		s.InDelta(0.0, testutil.ToFloat64(s.metrics.Tenants), 0, "gauges only change on refresh")
+
+		s.Require().NoError(svc.RunOnce(context.Background()))
+		s.InDelta(2.0, testutil.ToFloat64(s.metrics.Tenants), 0)
+	})
+}
@@ -0,0 +1,135 @@
+package purge
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	id "credo/pkg/domain"
+	"credo/pkg/requestcontext"
+)
+
+const (
+	// DefaultRetention is how long a soft-deleted tenant is kept before it is purged.
+	DefaultRetention = 30 * 24 * time.Hour
+	// DefaultInterval is how often the worker looks for tenants past retention.
+	DefaultInterval = 1 * time.Hour
+)
+
+// TenantStore exposes the operations needed to purge soft-deleted tenants.
+// The cutoff is computed by the worker so the retention rule stays out of the store.
+type TenantStore interface {
+	ListDeletedBefore(ctx context.Context, cutoff time.Time) ([]id.TenantID, error)
+	HardDelete(ctx context.Context, tenantID id.TenantID) error
+}
+
+// PurgeResult summarizes a purge run.
+type PurgeResult struct {
+	Purged int // Tenants hard-deleted
+	Failed int // Tenants left for the next run after a delete error
+}
+
+// Option configures the purge Service.
+type Option func(*Service)
+
+// WithLogger overrides the logger used for purge progress and failures.
+func WithLogger(logger *slog.Logger) Option {
+	return func(s *Service) {
+		if logger != nil {
+			s.logger = logger
+		}
+	}
+}
+
+// WithInterval overrides how often the worker runs when greater than zero.
+func WithInterval(interval time.Duration) Option {
+	return func(s *Service) {
+		if interval > 0 {
+			s.interval = interval
+		}
+	}
+}
+
+// WithRetention overrides how long soft-deleted tenants are kept when greater than zero.
+func WithRetention(retention time.Duration) Option {
+	return func(s *Service) {
+		if retention > 0 {
+			s.retention = retention
+		}
+	}
+}
+
+// Service periodically hard-deletes tenants whose soft-delete retention window has elapsed.
+type Service struct {
+	store     TenantStore
+	logger    *slog.Logger
+	interval  time.Duration
+	retention time.Duration
+}
+
+// New constructs a purge Service with options applied.
+func New(store TenantStore, opts ...Option) (*Service, error) {
+	if store == nil {
+		return nil, fmt.Errorf("tenant store is required")
+	}
+	svc := &Service{
+		store:     store,
+		logger:    slog.Default(),
+		interval:  DefaultInterval,
+		retention: DefaultRetention,
+	}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(svc)
+		}
+	}
+	return svc, nil
+}
+
+// Start runs purges periodically until ctx is cancelled.
+func (s *Service) Start(ctx context.Context) error {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			res, err := s.RunOnce(ctx)
+			if err != nil {
+				s.logger.ErrorContext(ctx, "tenant purge failed", "error", err, "purged", res.Purged, "failed", res.Failed)
+				continue
+			}
+			if res.Purged > 0 {
+				s.logger.InfoContext(ctx, "tenant purge completed", "purged", res.Purged)
+			}
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// RunOnce hard-deletes every tenant soft-deleted more than the retention window ago.
+// Each tenant is deleted independently, so one failure does not block the rest;
+// failures are aggregated into the returned error and retried on the next run.
+func (s *Service) RunOnce(ctx context.Context) (PurgeResult, error) {
+	var res PurgeResult
+	cutoff := requestcontext.Now(ctx).Add(-s.retention)
+
+	tenantIDs, err := s.store.ListDeletedBefore(ctx, cutoff)
+	if err != nil {
+		return res, fmt.Errorf("list deleted tenants: %w", err)
+	}
+
+	var errs []error
+	for _, tenantID := range tenantIDs {
+		if err := s.store.HardDelete(ctx, tenantID); err != nil {
+			res.Failed++
+			errs = append(errs, fmt.Errorf("purge tenant %s: %w", tenantID, err))
+			continue
+		}
+		res.Purged++
+	}
+	return res, errors.Join(errs...)
+}
@@ -0,0 +1,130 @@
+package purge
+
+// Justification: The retention window is days long, so the purge boundary
+// cannot be exercised end to end. These tests pin the clock to verify that a
+// soft-deleted tenant survives until the window elapses and is hard-deleted after.
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"credo/internal/tenant/models"
+	tenantstore "credo/internal/tenant/store/tenant"
+	id "credo/pkg/domain"
+	"credo/pkg/platform/sentinel"
+	"credo/pkg/requestcontext"
+)
+
+type failingStore struct {
+	*tenantstore.InMemory
+	failFor id.TenantID
+}
+
+func (f *failingStore) HardDelete(ctx context.Context, tenantID id.TenantID) error {
+	if tenantID == f.failFor {
+		return errors.New("delete blocked")
+	}
+	return f.InMemory.HardDelete(ctx, tenantID)
+}
+
+type PurgeSuite struct {
+	suite.Suite
+	store     *tenantstore.InMemory
+	deletedAt time.Time
+}
+
+func TestPurgeSuite(t *testing.T) {
+	suite.Run(t, new(PurgeSuite))
+}
+
+func (s *PurgeSuite) SetupTest() {
+	s.store = tenantstore.NewInMemory()
+	s.deletedAt = time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+}
+
+func (s *PurgeSuite) softDeletedTenant(name string) *models.Tenant {
+	tenant, err := models.NewTenant(id.TenantID(uuid.New()), name, s.deletedAt.Add(-time.Hour))
+	s.Require().NoError(err)
+	s.Require().NoError(s.store.CreateIfNameAvailable(context.Background(), tenant))
+	tenant.ApplySoftDelete(s.deletedAt)
+	s.Require().NoError(s.store.Update(context.Background(), tenant))
+	return tenant
+}
+
+func (s *PurgeSuite) runAt(svc *Service, now time.Time) (PurgeResult, error) {
+	return svc.RunOnce(requestcontext.WithTime(context.Background(), now))
+}
+
+func (s *PurgeSuite) TestRetentionWindow() {
+	retention := 7 * 24 * time.Hour
+
+	s.Run("keeps tenant until the window elapses", func() {
+		s.SetupTest()
+		tenant := s.softDeletedTenant("Acme")
+		svc, err := New(s.store, WithRetention(retention))
+		s.Require().NoError(err)
+
+		res, err := s.runAt(svc, s.deletedAt.Add(retention))
+		s.Require().NoError(err)
+		s.Zero(res.Purged)
+
+		ids, err := s.store.ListDeletedBefore(context.Background(), s.deletedAt.Add(time.Second))
+		s.Require().NoError(err)
+		s.Equal([]id.TenantID{tenant.ID}, ids, "tenant must still be held in retention")
+	})
+
+	s.Run("hard-deletes tenant once the window has elapsed", func() {
+		s.SetupTest()
+		s.softDeletedTenant("Acme")
+		svc, err := New(s.store, WithRetention(retention))
+		s.Require().NoError(err)
+
+		res, err := s.runAt(svc, s.deletedAt.Add(retention+time.Second))
+		s.Require().NoError(err)
+		s.Equal(1, res.Purged)
+
+		ids, err := s.store.ListDeletedBefore(context.Background(), s.deletedAt.Add(retention+time.Second))
+		s.Require().NoError(err)
+		s.Empty(ids)
+
+		reused, err := models.NewTenant(id.TenantID(uuid.New()), "Acme", s.deletedAt.Add(retention+time.Second))
+		s.Require().NoError(err)
+		s.NoError(s.store.CreateIfNameAvailable(context.Background(), reused), "purge releases the name")
+	})
+
+	s.Run("never purges live tenants", func() {
+		s.SetupTest()
+		live, err := models.NewTenant(id.TenantID(uuid.New()), "Live", s.deletedAt.Add(-365*24*time.Hour))
+		s.Require().NoError(err)
+		s.Require().NoError(s.store.CreateIfNameAvailable(context.Background(), live))
+		svc, err := New(s.store, WithRetention(retention))
+		s.Require().NoError(err)
+
+		res, err := s.runAt(svc, s.deletedAt.Add(10*retention))
+		s.Require().NoError(err)
+		s.Zero(res.Purged)
+
+		_, err = s.store.FindByID(context.Background(), live.ID)
+		s.NoError(err)
+	})
+}
+
+func (s *PurgeSuite) TestFailureIsolation() {
+	blocked := s.softDeletedTenant("Blocked")
+	s.softDeletedTenant("Other")
+	svc, err := New(&failingStore{InMemory: s.store, failFor: blocked.ID})
+	s.Require().NoError(err)
+
+	res, err := s.runAt(svc, s.deletedAt.Add(DefaultRetention+time.Second))
+	s.Require().Error(err)
+	s.Equal(1, res.Purged, "other tenants are purged despite one failure")
+	s.Equal(1, res.Failed)
+
+	s.Require().NoError(s.store.HardDelete(context.Background(), blocked.ID))
+	s.ErrorIs(s.store.HardDelete(context.Background(), blocked.ID), sentinel.ErrNotFound)
+}
@@ -1,11 +0,0 @@
-package http
-
-// import "net/http"
-
-// func (h *Handler) handleDataExport(w http.ResponseWriter, r *http.Request) {
-// 	h.notImplemented(w, "/me/data-export")
-// }
-
-// func (h *Handler) handleDataDeletion(w http.ResponseWriter, r *http.Request) {
-// 	h.notImplemented(w, "/me")
-// }
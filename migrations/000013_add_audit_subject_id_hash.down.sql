@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_audit_events_subject_id_hash;
+ALTER TABLE audit_events DROP COLUMN IF EXISTS subject_id_hash;
@@ -0,0 +1,11 @@
+-- Migration: Persist subject_id_hash on audit events
+--
+-- Decision events already carry a SHA-256 subject hash, but it was dropped on
+-- write. Erasure also needs it: a deleted user's events are anonymized by
+-- replacing email/subject with the hash, and stay queryable by that hash.
+
+ALTER TABLE audit_events ADD COLUMN IF NOT EXISTS subject_id_hash VARCHAR(64) NOT NULL DEFAULT '';
+
+CREATE INDEX idx_audit_events_subject_id_hash ON audit_events(subject_id_hash) WHERE subject_id_hash != '';
+
+COMMENT ON COLUMN audit_events.subject_id_hash IS 'SHA-256 of the subject identifier; replaces PII on events of erased users.';
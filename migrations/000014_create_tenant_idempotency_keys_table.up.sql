@@ -0,0 +1,13 @@
+-- Migration: Create tenant_idempotency_keys table
+--
+-- An admin client retrying a tenant create after a timeout would otherwise get a
+-- 409 for the tenant its first attempt created. The key supplied with the
+-- original request is recorded here so the retry can return that tenant instead.
+
+CREATE TABLE IF NOT EXISTS tenant_idempotency_keys (
+    idempotency_key VARCHAR(255) PRIMARY KEY,
+    tenant_id       UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+COMMENT ON TABLE tenant_idempotency_keys IS 'Idempotency keys of tenant create requests. A retry with the same key and name returns the original tenant.';
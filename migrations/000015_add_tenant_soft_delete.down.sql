@@ -0,0 +1,13 @@
+-- Rollback: Remove tenant soft delete
+-- Soft-deleted tenants cannot be represented without deleted_at, so they are
+-- restored as inactive rather than silently reactivated.
+
+DROP INDEX IF EXISTS idx_tenants_deleted_at;
+
+ALTER TABLE tenants DROP CONSTRAINT IF EXISTS tenants_deleted_at_status;
+UPDATE tenants SET status = 'inactive' WHERE status = 'deleted';
+ALTER TABLE tenants DROP CONSTRAINT IF EXISTS tenants_status_valid;
+ALTER TABLE tenants ADD CONSTRAINT tenants_status_valid CHECK (status IN ('active', 'inactive'));
+ALTER TABLE tenants DROP COLUMN IF EXISTS deleted_at;
+
+COMMENT ON COLUMN tenants.status IS 'Lifecycle: active <-> inactive. Enforced in service layer.';
@@ -0,0 +1,16 @@
+-- Migration: Soft-delete tenants with a retention window
+--
+-- Deleting a tenant first marks it 'deleted' and stamps deleted_at. The row and
+-- its dependents are retained for the legal window and hard-deleted by the
+-- tenant purge worker once deleted_at is older than the retention period.
+
+ALTER TABLE tenants ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMPTZ;
+
+ALTER TABLE tenants DROP CONSTRAINT IF EXISTS tenants_status_valid;
+ALTER TABLE tenants ADD CONSTRAINT tenants_status_valid CHECK (status IN ('active', 'inactive', 'deleted'));
+ALTER TABLE tenants ADD CONSTRAINT tenants_deleted_at_status CHECK ((status = 'deleted') = (deleted_at IS NOT NULL));
+
+CREATE INDEX idx_tenants_deleted_at ON tenants(deleted_at) WHERE deleted_at IS NOT NULL;
+
+COMMENT ON COLUMN tenants.status IS 'Lifecycle: active <-> inactive, either -> deleted (terminal). Enforced in service layer.';
+COMMENT ON COLUMN tenants.deleted_at IS 'Soft-delete time; the tenant is hard-deleted once this is older than the retention window.';
@@ -0,0 +1,7 @@
+-- Rollback: Remove client display metadata
+
+ALTER TABLE clients
+    DROP COLUMN IF EXISTS support_contact,
+    DROP COLUMN IF EXISTS description,
+    DROP COLUMN IF EXISTS logo_uri,
+    DROP COLUMN IF EXISTS display_name;
@@ -0,0 +1,12 @@
+-- Migration: Add client display metadata
+--
+-- Optional, display-only fields for consent and admin UIs. Empty string means
+-- unset. None of them take part in OAuth validation or client authentication.
+
+ALTER TABLE clients
+    ADD COLUMN IF NOT EXISTS display_name    VARCHAR(128)  NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS logo_uri        VARCHAR(2048) NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS description     VARCHAR(1000) NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS support_contact VARCHAR(320)  NOT NULL DEFAULT '';
+
+COMMENT ON COLUMN clients.display_name IS 'Display-only metadata for consent and admin UIs; never used in authorization decisions.';
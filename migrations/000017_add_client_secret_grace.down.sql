@@ -0,0 +1,5 @@
+-- Rollback: Stop keeping the previous client secret
+
+ALTER TABLE clients
+    DROP COLUMN IF EXISTS previous_secret_expires_at,
+    DROP COLUMN IF EXISTS previous_secret_hash;
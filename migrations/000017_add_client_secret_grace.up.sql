@@ -0,0 +1,11 @@
+-- Migration: Keep the previous client secret verifiable during rotation
+--
+-- When a grace window is configured, rotating a secret moves the old hash here
+-- so in-flight deployments keep authenticating until the window expires.
+-- Both columns are NULL when no previous secret is being honoured.
+
+ALTER TABLE clients
+    ADD COLUMN IF NOT EXISTS previous_secret_hash       TEXT,
+    ADD COLUMN IF NOT EXISTS previous_secret_expires_at TIMESTAMPTZ;
+
+COMMENT ON COLUMN clients.previous_secret_hash IS 'bcrypt hash of the secret replaced by the last rotation, accepted until previous_secret_expires_at.';
@@ -0,0 +1,4 @@
+-- Rollback: Remove per-client resource indicator allowlist
+
+ALTER TABLE clients
+    DROP COLUMN IF EXISTS allowed_resources;
@@ -0,0 +1,9 @@
+-- Migration: Add per-client resource indicator allowlist (RFC 8707)
+--
+-- Resource server identifiers a client may request via the token endpoint's
+-- resource parameter. Empty means the client cannot target specific resources.
+
+ALTER TABLE clients
+    ADD COLUMN IF NOT EXISTS allowed_resources JSONB NOT NULL DEFAULT '[]';
+
+COMMENT ON COLUMN clients.allowed_resources IS 'Absolute URIs the client may request as token audiences via the resource parameter (RFC 8707).';
@@ -0,0 +1,6 @@
+-- Rollback: Remove outbox deduplication key
+
+DROP INDEX IF EXISTS idx_outbox_dedup_key;
+
+ALTER TABLE outbox
+    DROP COLUMN IF EXISTS dedup_key;
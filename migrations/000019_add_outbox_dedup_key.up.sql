@@ -0,0 +1,13 @@
+-- Migration: Add optional outbox deduplication key
+--
+-- Callers that may retry an append (e.g. after a transient DB error) supply a
+-- stable key per logical event; the partial unique index turns the retry into
+-- a no-op instead of a duplicate row. Rows without a key are unaffected.
+
+ALTER TABLE outbox
+    ADD COLUMN IF NOT EXISTS dedup_key VARCHAR(255);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_outbox_dedup_key ON outbox(dedup_key)
+    WHERE dedup_key IS NOT NULL;
+
+COMMENT ON COLUMN outbox.dedup_key IS 'Optional caller-supplied key; duplicate appends with the same key are ignored.';
@@ -0,0 +1,4 @@
+-- Rollback: Remove session risk score
+
+ALTER TABLE sessions
+    DROP COLUMN IF EXISTS risk_score;
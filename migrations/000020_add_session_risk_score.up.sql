@@ -0,0 +1,9 @@
+-- Migration: Add session risk score
+--
+-- Weighted activity-signal score (0-100) computed on each refresh. Drives
+-- step-up re-authentication and session_risk_elevated security events.
+
+ALTER TABLE sessions
+    ADD COLUMN IF NOT EXISTS risk_score INTEGER NOT NULL DEFAULT 0;
+
+COMMENT ON COLUMN sessions.risk_score IS 'Activity risk score (0-100) from the most recent refresh.';
@@ -0,0 +1,5 @@
+-- Rollback: Remove coarse citizen details from the citizen cache
+
+ALTER TABLE citizen_cache
+    DROP COLUMN IF EXISTS city,
+    DROP COLUMN IF EXISTS birth_year;
@@ -0,0 +1,11 @@
+-- Migration: Add coarse citizen details to the citizen cache
+--
+-- Regulated-mode minimization policies may keep year of birth and city while
+-- clearing the full date of birth and address.
+
+ALTER TABLE citizen_cache
+    ADD COLUMN IF NOT EXISTS birth_year TEXT NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS city TEXT NOT NULL DEFAULT '';
+
+COMMENT ON COLUMN citizen_cache.birth_year IS 'Year of birth kept by a coarsening minimization policy.';
+COMMENT ON COLUMN citizen_cache.city IS 'City kept by a coarsening minimization policy.';
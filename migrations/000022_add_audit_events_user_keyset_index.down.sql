@@ -0,0 +1,3 @@
+-- Rollback: Remove the keyset pagination index on audit events
+
+DROP INDEX IF EXISTS idx_audit_events_user_timestamp_id;
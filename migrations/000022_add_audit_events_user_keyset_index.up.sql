@@ -0,0 +1,9 @@
+-- Migration: Index audit events for keyset pagination by user
+--
+-- ListByUserPaged walks a user's events newest first by (timestamp, id). This
+-- index serves both the ordering and the row-value cursor comparison, so each
+-- page reads only its own rows however deep the user's history goes.
+
+CREATE INDEX IF NOT EXISTS idx_audit_events_user_timestamp_id
+    ON audit_events(user_id, timestamp DESC, id DESC)
+    WHERE user_id IS NOT NULL;
@@ -0,0 +1,4 @@
+-- Rollback: Remove registry completeness from the citizen cache
+
+ALTER TABLE citizen_cache
+    DROP COLUMN IF EXISTS completeness;
@@ -0,0 +1,10 @@
+-- Migration: Add registry completeness to the citizen cache
+--
+-- Completeness is the fraction of personal details the registry populated.
+-- It is measured before minimization, so regulated-mode records keep it even
+-- though their details are cleared.
+
+ALTER TABLE citizen_cache
+    ADD COLUMN IF NOT EXISTS completeness DOUBLE PRECISION NOT NULL DEFAULT 0;
+
+COMMENT ON COLUMN citizen_cache.completeness IS 'Fraction of personal details the registry populated (0.0 to 1.0), measured before minimization.';
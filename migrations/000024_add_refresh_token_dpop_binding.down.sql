@@ -0,0 +1,4 @@
+-- Rollback: Remove the DPoP key binding from refresh tokens
+
+ALTER TABLE refresh_tokens
+    DROP COLUMN IF EXISTS dpop_jkt;
@@ -0,0 +1,9 @@
+-- Migration: Bind refresh tokens to the DPoP key they were issued to
+--
+-- A refresh token issued with a DPoP proof can only be redeemed with a proof
+-- from the same key (RFC 9449 §5). Bearer tokens keep an empty thumbprint.
+
+ALTER TABLE refresh_tokens
+    ADD COLUMN IF NOT EXISTS dpop_jkt TEXT NOT NULL DEFAULT '';
+
+COMMENT ON COLUMN refresh_tokens.dpop_jkt IS 'RFC 7638 thumbprint of the DPoP key the token is bound to. Empty for bearer tokens.';
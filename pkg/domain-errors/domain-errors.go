@@ -27,6 +27,10 @@ const (
 	CodeUnsupportedGrantType Code = "unsupported_grant_type" // Grant type not supported
 	CodeInvalidRequest       Code = "invalid_request"        // Missing required parameter or malformed request
 	CodeAccessDenied         Code = "access_denied"          // Resource owner or server denied request
+	CodeInvalidDPoPProof     Code = "invalid_dpop_proof"     // Missing or invalid DPoP proof (RFC 9449 §5)
+	CodeInvalidTarget        Code = "invalid_target"         // Requested resource is invalid or not allowed (RFC 8707 §2)
+	CodeInvalidScope         Code = "invalid_scope"          // Requested scope is invalid or exceeds what was granted
+	CodeUnauthorizedClient   Code = "unauthorized_client"    // Client is not allowed to use the grant type
 )
 
 // Error wraps domain or infrastructure failures with a stable code.
@@ -35,6 +39,10 @@ type Error struct {
 	Code    Code
 	Message string
 	Err     error
+
+	// Reason optionally refines Code with a stable, machine-readable cause
+	// clients can act on (e.g. "user_inactive" for a CodeForbidden).
+	Reason string
 }
 
 // Error implements the error interface.
@@ -64,17 +72,31 @@ func New(code Code, msg string) error {
 	return &Error{Code: code, Message: msg}
 }
 
+// NewWithReason creates a new domain error with a machine-readable reason.
+func NewWithReason(code Code, reason, msg string) error {
+	return &Error{Code: code, Message: msg, Reason: reason}
+}
+
 // Wrap creates a new domain error wrapping an existing error.
-// If the wrapped error is already a domain error, the original code is preserved.
+// If the wrapped error is already a domain error, the original code and reason are preserved.
 func Wrap(err error, code Code, msg string) error {
 	var existing *Error
 	if errors.As(err, &existing) {
-		// Preserve the original domain code, update message
-		return &Error{Code: existing.Code, Message: msg, Err: err}
+		// Preserve the original domain code and reason, update message
+		return &Error{Code: existing.Code, Message: msg, Err: err, Reason: existing.Reason}
 	}
 	return &Error{Code: code, Message: msg, Err: err}
 }
 
+// WrapWithReason is Wrap with a reason for errors that carry none of their own.
+func WrapWithReason(err error, code Code, reason, msg string) error {
+	wrapped := Wrap(err, code, msg).(*Error)
+	if wrapped.Reason == "" {
+		wrapped.Reason = reason
+	}
+	return wrapped
+}
+
 // HasCode checks if an error is a domain error with the given code.
 func HasCode(err error, code Code) bool {
 	var e *Error
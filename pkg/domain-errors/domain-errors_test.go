@@ -120,6 +120,26 @@ func (s *DomainErrorsSuite) TestWrap() {
 
 		s.True(errors.Is(wrapped, original))
 	})
+
+	s.Run("preserves the reason of a wrapped domain error", func() {
+		original := NewWithReason(CodeForbidden, "user_inactive", "user inactive")
+		wrapped := Wrap(original, CodeInternal, "token error")
+
+		var domainErr *Error
+		s.Require().True(errors.As(wrapped, &domainErr))
+		s.Equal(CodeForbidden, domainErr.Code)
+		s.Equal("user_inactive", domainErr.Reason)
+	})
+
+	s.Run("WrapWithReason only fills a missing reason", func() {
+		var domainErr *Error
+		s.Require().True(errors.As(WrapWithReason(errors.New("revoked"), CodeInvalidGrant, "session_revoked", "revoked"), &domainErr))
+		s.Equal("session_revoked", domainErr.Reason)
+
+		original := NewWithReason(CodeForbidden, "user_inactive", "user inactive")
+		s.Require().True(errors.As(WrapWithReason(original, CodeInvalidGrant, "session_revoked", "revoked"), &domainErr))
+		s.Equal("user_inactive", domainErr.Reason)
+	})
 }
 
 func (s *DomainErrorsSuite) TestHasCode() {
@@ -0,0 +1,138 @@
+package domain
+
+import (
+	"regexp"
+	"sync"
+
+	dErrors "credo/pkg/domain-errors"
+)
+
+// CountryCode is an ISO 3166-1 alpha-2 country code (e.g., "SE") naming the
+// scheme a national ID was issued under.
+type CountryCode string
+
+// countryCodePattern validates the country code format: two uppercase letters.
+var countryCodePattern = regexp.MustCompile(`^[A-Z]{2}$`)
+
+// ChecksumValidator checks a national ID against one country's scheme. Validators
+// receive IDs that already match the generic format and must be pure.
+type ChecksumValidator interface {
+	ValidChecksum(value string) bool
+}
+
+// ChecksumFunc adapts a function to ChecksumValidator.
+type ChecksumFunc func(value string) bool
+
+// ValidChecksum calls f(value).
+func (f ChecksumFunc) ValidChecksum(value string) bool { return f(value) }
+
+// ChecksumRegistry holds one checksum validator per country. It is safe for
+// concurrent use.
+type ChecksumRegistry struct {
+	mu         sync.RWMutex
+	validators map[CountryCode]ChecksumValidator
+}
+
+// NewChecksumRegistry creates an empty registry.
+func NewChecksumRegistry() *ChecksumRegistry {
+	return &ChecksumRegistry{validators: make(map[CountryCode]ChecksumValidator)}
+}
+
+// Register sets the validator for country, replacing any existing one.
+func (r *ChecksumRegistry) Register(country CountryCode, v ChecksumValidator) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.validators[country] = v
+}
+
+// Validator returns the validator registered for country.
+func (r *ChecksumRegistry) Validator(country CountryCode) (ChecksumValidator, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	v, ok := r.validators[country]
+	return v, ok
+}
+
+// ForCountry returns nid as issued by country once it passes the checksum
+// registered for country. An empty country returns nid unchanged, and a
+// country without a validator is accepted on the generic format alone.
+func (r *ChecksumRegistry) ForCountry(country CountryCode, nid NationalID) (NationalID, error) {
+	if country == "" {
+		return nid, nil
+	}
+	if !countryCodePattern.MatchString(string(country)) {
+		return NationalID{}, dErrors.New(dErrors.CodeInvalidInput, "country has invalid format: must be an ISO 3166-1 alpha-2 code")
+	}
+	if v, ok := r.Validator(country); ok && !v.ValidChecksum(nid.value) {
+		return NationalID{}, dErrors.New(dErrors.CodeInvalidInput, "national_id failed the "+string(country)+" checksum")
+	}
+	return NationalID{value: nid.value, country: country}, nil
+}
+
+// DefaultChecksums creates a registry with the built-in validators:
+//   - SE: Swedish personnummer, 10 digits (YYMMDDNNNC) or 12 digits
+//     (YYYYMMDDNNNC), with a Luhn check digit over the last ten
+//   - HR: Croatian OIB, 11 digits with an ISO 7064 MOD 11,10 check digit
+func DefaultChecksums() *ChecksumRegistry {
+	r := NewChecksumRegistry()
+	r.Register("SE", ChecksumFunc(validPersonnummer))
+	r.Register("HR", ChecksumFunc(func(v string) bool { return len(v) == 11 && ISO7064Mod11_10(v) }))
+	return r
+}
+
+// validPersonnummer checks a Swedish personnummer in its 10- or 12-digit form.
+// The century digits of the long form are not part of the Luhn check.
+func validPersonnummer(v string) bool {
+	switch len(v) {
+	case 10:
+		return Luhn(v)
+	case 12:
+		return allDigits(v[:2]) && Luhn(v[2:])
+	}
+	return false
+}
+
+// Luhn reports whether an all-digit value ends in a valid Luhn (mod 10) check digit.
+func Luhn(value string) bool {
+	if !allDigits(value) || len(value) < 2 {
+		return false
+	}
+	sum := 0
+	for i := range len(value) {
+		d := int(value[len(value)-1-i] - '0')
+		if i%2 == 1 {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+	}
+	return sum%10 == 0
+}
+
+// ISO7064Mod11_10 reports whether an all-digit value ends in a valid ISO 7064
+// MOD 11,10 check digit.
+func ISO7064Mod11_10(value string) bool {
+	if !allDigits(value) || len(value) < 2 {
+		return false
+	}
+	product := 10
+	for i := range len(value) - 1 {
+		sum := (product + int(value[i]-'0')) % 10
+		if sum == 0 {
+			sum = 10
+		}
+		product = (sum * 2) % 11
+	}
+	return (11-product)%10 == int(value[len(value)-1]-'0')
+}
+
+func allDigits(value string) bool {
+	for i := range len(value) {
+		if value[i] < '0' || value[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,75 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	dErrors "credo/pkg/domain-errors"
+)
+
+// TestChecksumRegistry_ForCountry validates the invariant:
+// "A national ID accepted for a country passes that country's checksum"
+//
+// Justification: Check digits are pure arithmetic enforced at the trust
+// boundary; E2E fixtures use generic IDs without a country.
+func TestChecksumRegistry_ForCountry(t *testing.T) {
+	tests := []struct {
+		name    string
+		country CountryCode
+		input   string
+		wantErr bool
+	}{
+		{"SE valid personnummer", "SE", "8112189876", false},
+		{"SE valid personnummer 2", "SE", "6408233234", false},
+		{"SE wrong check digit", "SE", "8112189875", true},
+		{"SE 12-digit personnummer", "SE", "198112189876", false},
+		{"SE 12-digit wrong check digit", "SE", "198112189875", true},
+		{"SE 12-digit letters in century", "SE", "AB8112189876", true},
+		{"SE wrong length", "SE", "81121898760", true},
+		{"SE letters", "SE", "81121898AB", true},
+		{"HR valid OIB", "HR", "69435151530", false},
+		{"HR valid OIB 2", "HR", "94577403194", false},
+		{"HR wrong check digit", "HR", "69435151531", true},
+		{"HR wrong length", "HR", "6943515153", true},
+		{"country without validator uses format only", "DE", "ABC123", false},
+		{"no country uses format only", "", "8112189875", false},
+		{"malformed country", "Se", "8112189876", true},
+		{"format is still enforced", "SE", "81-12-18", true},
+	}
+
+	checksums := DefaultChecksums()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			nid, err := ParseNationalID(tt.input)
+			if err == nil {
+				nid, err = checksums.ForCountry(tt.country, nid)
+			}
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.True(t, dErrors.HasCode(err, dErrors.CodeInvalidInput))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.input, nid.String())
+			assert.Equal(t, tt.country, nid.Country())
+		})
+	}
+}
+
+func TestChecksumRegistry(t *testing.T) {
+	t.Run("registered validator is returned", func(t *testing.T) {
+		r := NewChecksumRegistry()
+		r.Register("XX", ChecksumFunc(func(string) bool { return false }))
+
+		v, ok := r.Validator("XX")
+		require.True(t, ok)
+		assert.False(t, v.ValidChecksum("123456"))
+	})
+
+	t.Run("unknown country has no validator", func(t *testing.T) {
+		_, ok := NewChecksumRegistry().Validator("SE")
+		assert.False(t, ok)
+	})
+}
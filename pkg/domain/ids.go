@@ -22,9 +22,11 @@ type (
 type APIKeyID string
 
 // NationalID is a validated national identifier (6-20 uppercase alphanumeric characters).
-// This is a domain primitive that enforces validity at parse time.
+// This is a domain primitive that enforces validity at parse time. IDs returned by
+// ChecksumRegistry.ForCountry also carry a CountryCode and passed its checksum.
 type NationalID struct {
-	value string
+	value   string
+	country CountryCode
 }
 
 // nationalIDPattern validates the national ID format: 6-20 uppercase alphanumeric characters.
@@ -65,7 +67,8 @@ func ParseAPIKeyID(s string) (APIKeyID, error) {
 }
 
 // ParseNationalID validates and creates a NationalID from a string.
-// The ID must be 6-20 uppercase alphanumeric characters.
+// The ID must be 6-20 uppercase alphanumeric characters. No country is known, so
+// no checksum is run; use ChecksumRegistry.ForCountry once the issuer is.
 func ParseNationalID(s string) (NationalID, error) {
 	if s == "" {
 		return NationalID{}, dErrors.New(dErrors.CodeInvalidInput, "national_id is required")
@@ -84,6 +87,9 @@ func (id ConsentID) String() string  { return uuid.UUID(id).String() }
 func (id APIKeyID) String() string   { return string(id) }
 func (id NationalID) String() string { return id.value }
 
+// Country returns the issuing country, or "" if the ID was parsed without one.
+func (id NationalID) Country() CountryCode { return id.country }
+
 func (id UserID) IsNil() bool     { return uuid.UUID(id) == uuid.Nil }
 func (id SessionID) IsNil() bool  { return uuid.UUID(id) == uuid.Nil }
 func (id ClientID) IsNil() bool   { return uuid.UUID(id) == uuid.Nil }
@@ -7,12 +7,14 @@ const (
 	GrantTypeAuthorizationCode GrantType = "authorization_code"
 	GrantTypeRefreshToken      GrantType = "refresh_token"
 	GrantTypeClientCredentials GrantType = "client_credentials"
+	// GrantTypeTokenExchange exchanges one token for another (RFC 8693).
+	GrantTypeTokenExchange GrantType = "urn:ietf:params:oauth:grant-type:token-exchange"
 )
 
 // IsValid returns true if the grant type is a known valid value.
 func (g GrantType) IsValid() bool {
 	switch g {
-	case GrantTypeAuthorizationCode, GrantTypeRefreshToken, GrantTypeClientCredentials:
+	case GrantTypeAuthorizationCode, GrantTypeRefreshToken, GrantTypeClientCredentials, GrantTypeTokenExchange:
 		return true
 	}
 	return false
@@ -26,5 +28,5 @@ func (g GrantType) String() string {
 // RequiresConfidentialClient returns true if this grant type can only be used
 // by confidential clients (those with a client secret).
 func (g GrantType) RequiresConfidentialClient() bool {
-	return g == GrantTypeClientCredentials
+	return g == GrantTypeClientCredentials || g == GrantTypeTokenExchange
 }
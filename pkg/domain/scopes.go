@@ -0,0 +1,69 @@
+package domain
+
+import "sort"
+
+// ScopeInfo is the display metadata for an OAuth scope, rendered by the
+// authorize/consent UI so users see what they are granting.
+type ScopeInfo struct {
+	Name        string
+	Title       string
+	Description string
+	Sensitive   bool // Grants access to personal data; consent screens should highlight it
+}
+
+// scopeRegistry is the single source of truth for the scopes the platform understands.
+var scopeRegistry = map[string]ScopeInfo{
+	"openid": {
+		Name:        "openid",
+		Title:       "Sign you in",
+		Description: "Confirm your identity using your account.",
+	},
+	"profile": {
+		Name:        "profile",
+		Title:       "View your profile",
+		Description: "Read your name and basic profile details.",
+		Sensitive:   true,
+	},
+	"email": {
+		Name:        "email",
+		Title:       "View your email address",
+		Description: "Read the email address on your account and whether it is verified.",
+		Sensitive:   true,
+	},
+	"offline": {
+		Name:        "offline",
+		Title:       "Stay signed in",
+		Description: "Keep access while you are away by issuing refresh tokens.",
+	},
+}
+
+// IsKnownScope reports whether name is a registered scope.
+func IsKnownScope(name string) bool {
+	_, ok := scopeRegistry[name]
+	return ok
+}
+
+// KnownScopes returns every registered scope, sorted by name.
+func KnownScopes() []ScopeInfo {
+	scopes := make([]ScopeInfo, 0, len(scopeRegistry))
+	for _, info := range scopeRegistry {
+		scopes = append(scopes, info)
+	}
+	sort.Slice(scopes, func(i, j int) bool { return scopes[i].Name < scopes[j].Name })
+	return scopes
+}
+
+// DescribeScopes returns display metadata for names in the given order.
+// Unregistered scopes fall back to their name as the title so a consent screen
+// still lists them rather than silently hiding what is being granted.
+func DescribeScopes(names []string) []ScopeInfo {
+	scopes := make([]ScopeInfo, 0, len(names))
+	for _, name := range names {
+		info, ok := scopeRegistry[name]
+		if !ok {
+			info = ScopeInfo{Name: name, Title: name}
+		}
+		scopes = append(scopes, info)
+	}
+	return scopes
+}
@@ -0,0 +1,37 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Unit tests for the scope registry.
+// Justification: Consent screens rely on every registered scope being described.
+
+func TestScopeRegistry(t *testing.T) {
+	t.Run("every registered scope is described", func(t *testing.T) {
+		scopes := KnownScopes()
+		require.NotEmpty(t, scopes)
+		for i, info := range scopes {
+			assert.NotEmpty(t, info.Title, "scope %q", info.Name)
+			assert.NotEmpty(t, info.Description, "scope %q", info.Name)
+			if i > 0 {
+				assert.Less(t, scopes[i-1].Name, info.Name, "scopes must be sorted")
+			}
+		}
+	})
+
+	t.Run("known scopes are recognised", func(t *testing.T) {
+		assert.True(t, IsKnownScope("email"))
+		assert.False(t, IsKnownScope("payments:write"))
+	})
+
+	t.Run("describe falls back to the name for unknown scopes", func(t *testing.T) {
+		scopes := DescribeScopes([]string{"openid", "payments:write"})
+		require.Len(t, scopes, 2)
+		assert.Equal(t, "openid", scopes[0].Name)
+		assert.Equal(t, ScopeInfo{Name: "payments:write", Title: "payments:write"}, scopes[1])
+	})
+}
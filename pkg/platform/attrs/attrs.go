@@ -1,18 +1,27 @@
 package attrs
 
+import "fmt"
+
 // ExtractString extracts a string value from a key-value attribute slice.
 // The slice should be formatted as [key1, value1, key2, value2, ...].
+// Typed identifiers such as id.UserID are accepted through fmt.Stringer; a
+// value reporting IsNil() as true counts as unset.
 // Returns empty string if the key is not found or the value is not a string.
 func ExtractString(attrs []any, key string) string {
 	for i := 0; i < len(attrs)-1; i += 2 {
 		k, ok := attrs[i].(string)
-		if !ok {
+		if !ok || k != key {
 			continue
 		}
-		if k == key {
-			if v, ok := attrs[i+1].(string); ok {
-				return v
+		switch v := attrs[i+1].(type) {
+		case string:
+			return v
+		case interface{ IsNil() bool }:
+			if s, ok := v.(fmt.Stringer); ok && !v.IsNil() {
+				return s.String()
 			}
+		case fmt.Stringer:
+			return v.String()
 		}
 	}
 	return ""
@@ -36,19 +36,21 @@ func NewHandler(store *auditpostgres.Store, logger *slog.Logger) *Handler {
 
 // kafkaPayload matches the JSON structure produced by the outbox store.
 type kafkaPayload struct {
-	ID              string `json:"ID"`
-	Category        string `json:"Category"`
-	Timestamp       string `json:"Timestamp"`
-	UserID          string `json:"UserID"`
-	Subject         string `json:"Subject"`
-	Action          string `json:"Action"`
-	Purpose         string `json:"Purpose"`
-	RequestingParty string `json:"RequestingParty"`
-	Decision        string `json:"Decision"`
-	Reason          string `json:"Reason"`
-	Email           string `json:"Email"`
-	RequestID       string `json:"RequestID"`
-	ActorID         string `json:"ActorID"`
+	ID              string            `json:"ID"`
+	Category        string            `json:"Category"`
+	Timestamp       string            `json:"Timestamp"`
+	UserID          string            `json:"UserID"`
+	Subject         string            `json:"Subject"`
+	Action          string            `json:"Action"`
+	Purpose         string            `json:"Purpose"`
+	RequestingParty string            `json:"RequestingParty"`
+	Decision        string            `json:"Decision"`
+	Reason          string            `json:"Reason"`
+	Email           string            `json:"Email"`
+	RequestID       string            `json:"RequestID"`
+	ActorID         string            `json:"ActorID"`
+	SubjectIDHash   string            `json:"SubjectIDHash"`
+	Metadata        map[string]string `json:"Metadata"`
 }
 
 // Handle processes a single Kafka message containing an audit event.
@@ -87,6 +89,8 @@ func (h *Handler) Handle(ctx context.Context, msg *consumer.Message) error {
 		Email:           payload.Email,
 		RequestID:       payload.RequestID,
 		ActorID:         payload.ActorID,
+		SubjectIDHash:   payload.SubjectIDHash,
+		Metadata:        payload.Metadata,
 	}
 
 	// Parse timestamp
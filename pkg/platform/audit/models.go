@@ -1,6 +1,7 @@
 package audit
 
 import (
+	"sort"
 	"time"
 
 	id "credo/pkg/domain"
@@ -50,29 +51,52 @@ type Event struct {
 	// being evaluated. Used for compliance traceability without storing raw PII.
 	// Only populated for decision events where a third-party identity is evaluated.
 	SubjectIDHash string
+	// Metadata carries event-specific context that has no dedicated column,
+	// such as the anonymized IP and device of a login attempt.
+	Metadata map[string]string
+	// DedupKey optionally identifies one logical event across append retries.
+	// Stores ignore a second append with the same key. It is not part of the
+	// published payload; downstream consumers deduplicate by event ID.
+	DedupKey string
 }
 
+// Metadata keys shared by emitters and readers of Event.Metadata.
+const (
+	MetadataIP       = "ip"
+	MetadataDevice   = "device"
+	MetadataLocation = "location"
+	MetadataCount    = "count"
+	MetadataPurpose  = "purpose"
+	MetadataDecision = "decision"
+)
+
 type AuditEvent string
 
 const (
 	// Auth events
-	EventUserCreated      AuditEvent = "user_created"
-	EventSessionCreated   AuditEvent = "session_created"
-	EventSessionRevoked   AuditEvent = "session_revoked"
-	EventSessionsRevoked  AuditEvent = "sessions_revoked"
-	EventTokenIssued      AuditEvent = "token_issued"
-	EventTokenRefreshed   AuditEvent = "token_refreshed"
-	EventUserInfoAccessed AuditEvent = "userinfo_accessed"
-	EventAuthFailed       AuditEvent = "auth_failed"
-	EventUserDeleted      AuditEvent = "user_deleted"
+	EventUserCreated         AuditEvent = "user_created"
+	EventSessionCreated      AuditEvent = "session_created"
+	EventSessionRevoked      AuditEvent = "session_revoked"
+	EventSessionsRevoked     AuditEvent = "sessions_revoked"
+	EventTokenIssued         AuditEvent = "token_issued"
+	EventTokenRefreshed      AuditEvent = "token_refreshed"
+	EventUserInfoAccessed    AuditEvent = "userinfo_accessed"
+	EventAuthFailed          AuditEvent = "auth_failed"
+	EventLoginSucceeded      AuditEvent = "login_succeeded"
+	EventLoginFailed         AuditEvent = "login_failed"
+	EventUserDeleted         AuditEvent = "user_deleted"
+	EventErasureStep         AuditEvent = "erasure_step_completed"
+	EventSessionRiskElevated AuditEvent = "session_risk_elevated"
 
 	// Tenant events
 	EventTenantCreated     AuditEvent = "tenant_created"
 	EventTenantDeactivated AuditEvent = "tenant_deactivated"
 	EventTenantReactivated AuditEvent = "tenant_reactivated"
+	EventTenantDeleted     AuditEvent = "tenant_deleted"
 
 	// Client events
 	EventClientCreated       AuditEvent = "client_created"
+	EventClientUpdated       AuditEvent = "client_updated"
 	EventClientDeactivated   AuditEvent = "client_deactivated"
 	EventClientReactivated   AuditEvent = "client_reactivated"
 	EventClientSecretRotated AuditEvent = "client_secret_rotated"
@@ -88,6 +112,15 @@ const (
 	EventAuthLockoutTriggered AuditEvent = "auth_lockout_triggered"
 	EventAuthLockoutCleared   AuditEvent = "auth_lockout_cleared"
 	EventAllowlistBypassed    AuditEvent = "allowlist_bypassed"
+	EventAuthFailureSpike     AuditEvent = "auth_failure_spike_detected"
+	EventAllowlistPurged      AuditEvent = "allowlist_entries_purged"
+
+	// Circuit breaker events
+	EventCircuitBreakerForced          AuditEvent = "circuit_breaker_forced"
+	EventCircuitBreakerOverrideCleared AuditEvent = "circuit_breaker_override_cleared"
+
+	// Audit pipeline events
+	EventOutboxReplayed AuditEvent = "audit_outbox_replayed"
 
 	// Decision events
 	EventDecisionMade AuditEvent = "decision_made"
@@ -101,21 +134,30 @@ var eventCategories = map[AuditEvent]EventCategory{
 	// Compliance events - require tamper-proof storage
 	EventUserCreated:    CategoryCompliance,
 	EventUserDeleted:    CategoryCompliance,
+	EventErasureStep:    CategoryCompliance,
 	EventConsentGranted: CategoryCompliance,
 	EventConsentRevoked: CategoryCompliance,
 	EventConsentDeleted: CategoryCompliance,
 
 	// Security events - feed into SIEM and alerting
-	EventAuthFailed:           CategorySecurity,
-	EventSessionRevoked:       CategorySecurity,
-	EventSessionsRevoked:      CategorySecurity,
-	EventClientSecretRotated:  CategorySecurity,
-	EventRateLimitExceeded:    CategorySecurity,
-	EventAuthLockoutTriggered: CategorySecurity,
-	EventAuthLockoutCleared:   CategorySecurity,
-	EventAllowlistBypassed:    CategorySecurity,
-	EventTenantDeactivated:    CategorySecurity,
-	EventClientDeactivated:    CategorySecurity,
+	EventAuthFailed:                    CategorySecurity,
+	EventLoginSucceeded:                CategorySecurity,
+	EventLoginFailed:                   CategorySecurity,
+	EventSessionRevoked:                CategorySecurity,
+	EventSessionsRevoked:               CategorySecurity,
+	EventSessionRiskElevated:           CategorySecurity,
+	EventClientSecretRotated:           CategorySecurity,
+	EventRateLimitExceeded:             CategorySecurity,
+	EventAuthLockoutTriggered:          CategorySecurity,
+	EventAuthLockoutCleared:            CategorySecurity,
+	EventAllowlistBypassed:             CategorySecurity,
+	EventAuthFailureSpike:              CategorySecurity,
+	EventCircuitBreakerForced:          CategorySecurity,
+	EventCircuitBreakerOverrideCleared: CategorySecurity,
+	EventOutboxReplayed:                CategorySecurity,
+	EventTenantDeactivated:             CategorySecurity,
+	EventTenantDeleted:                 CategorySecurity,
+	EventClientDeactivated:             CategorySecurity,
 
 	// Operations events - routine activity, can be sampled
 	EventSessionCreated:    CategoryOperations,
@@ -126,7 +168,9 @@ var eventCategories = map[AuditEvent]EventCategory{
 	EventTenantCreated:     CategoryOperations,
 	EventTenantReactivated: CategoryOperations,
 	EventClientCreated:     CategoryOperations,
+	EventClientUpdated:     CategoryOperations,
 	EventClientReactivated: CategoryOperations,
+	EventAllowlistPurged:   CategoryOperations,
 
 	// Decision events - compliance category for regulatory requirements
 	EventDecisionMade: CategoryCompliance,
@@ -141,6 +185,23 @@ func (e AuditEvent) Category() EventCategory {
 	return CategoryOperations
 }
 
+// IsMapped reports whether the event has an explicit category mapping.
+func (e AuditEvent) IsMapped() bool {
+	_, ok := eventCategories[e]
+	return ok
+}
+
+// AllMappedEvents returns every event with an explicit category mapping,
+// sorted by name.
+func AllMappedEvents() []AuditEvent {
+	events := make([]AuditEvent, 0, len(eventCategories))
+	for e := range eventCategories {
+		events = append(events, e)
+	}
+	sort.Slice(events, func(i, j int) bool { return events[i] < events[j] })
+	return events
+}
+
 // -----------------------------------------------------------------------------
 // Right-sized event types for tri-publisher architecture
 // -----------------------------------------------------------------------------
@@ -155,6 +216,7 @@ type ComplianceEvent struct {
 	Action        string    // The action taken (e.g., "consent_granted")
 	Purpose       string    // Purpose of data processing (for consent events)
 	Decision      string    // Outcome of the action (e.g., "granted", "denied")
+	Reason        string    // Why the action was taken (e.g., "user_initiated")
 	SubjectIDHash string    // SHA-256 hash of external ID (for traceability without PII)
 	RequestID     string    // Correlation ID for request tracing
 	ActorID       string    // Admin who performed action (if different from UserID)
@@ -173,6 +235,7 @@ func (e ComplianceEvent) ToLegacyEvent() Event {
 		Action:        e.Action,
 		Purpose:       e.Purpose,
 		Decision:      e.Decision,
+		Reason:        e.Reason,
 		SubjectIDHash: e.SubjectIDHash,
 		RequestID:     e.RequestID,
 		ActorID:       e.ActorID,
@@ -184,10 +247,13 @@ func (e ComplianceEvent) ToLegacyEvent() Event {
 // Use with SecurityAuditor for non-blocking emission.
 type SecurityEvent struct {
 	Timestamp time.Time // When the event occurred (set automatically if zero)
+	UserID    id.UserID // User the event concerns, when known (enables per-user timelines)
 	Subject   string    // Entity involved (user_id, IP, client_id)
 	Action    string    // Security action (e.g., "auth_failed", "lockout_triggered")
 	Reason    string    // Why this happened (e.g., "invalid_password", "rate_exceeded")
 	IP        string    // Client IP address (critical for security forensics)
+	Device    string    // Device display name parsed from the user agent
+	Location  string    // Approximate location (city/country), never precise coordinates
 	RequestID string    // Correlation ID
 	ActorID   string    // Actor if different from subject
 	Severity  Severity  // "info", "warning", "critical" for SIEM routing
@@ -206,16 +272,36 @@ const (
 func (e SecurityEvent) Category() EventCategory { return CategorySecurity }
 
 // ToLegacyEvent converts to the legacy Event type for backwards compatibility.
+// IP, device, and location carry over as metadata when set.
 func (e SecurityEvent) ToLegacyEvent() Event {
 	return Event{
 		Category:  CategorySecurity,
 		Timestamp: e.Timestamp,
+		UserID:    e.UserID,
 		Subject:   e.Subject,
 		Action:    e.Action,
 		Reason:    e.Reason,
 		RequestID: e.RequestID,
 		ActorID:   e.ActorID,
+		Metadata:  e.metadata(),
+	}
+}
+
+func (e SecurityEvent) metadata() map[string]string {
+	if e.IP == "" && e.Device == "" && e.Location == "" {
+		return nil
+	}
+	metadata := make(map[string]string, 3)
+	if e.IP != "" {
+		metadata[MetadataIP] = e.IP
+	}
+	if e.Device != "" {
+		metadata[MetadataDevice] = e.Device
+	}
+	if e.Location != "" {
+		metadata[MetadataLocation] = e.Location
 	}
+	return metadata
 }
 
 // OpsEvent captures operational events with minimal overhead.
@@ -226,6 +312,7 @@ type OpsEvent struct {
 	Subject   string    // Entity involved
 	Action    string    // Operational action (e.g., "token_issued")
 	RequestID string    // Correlation ID
+	Metadata  map[string]string
 }
 
 // Category returns CategoryOperations (always).
@@ -239,5 +326,6 @@ func (e OpsEvent) ToLegacyEvent() Event {
 		Subject:   e.Subject,
 		Action:    e.Action,
 		RequestID: e.RequestID,
+		Metadata:  e.Metadata,
 	}
 }
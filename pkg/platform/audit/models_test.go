@@ -1,6 +1,12 @@
 package audit
 
 import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
@@ -23,6 +29,7 @@ func (s *AuditEventSuite) TestCategory_ComplianceEvents() {
 	complianceEvents := []AuditEvent{
 		EventUserCreated,
 		EventUserDeleted,
+		EventErasureStep,
 		EventConsentGranted,
 		EventConsentRevoked,
 		EventConsentDeleted,
@@ -39,14 +46,19 @@ func (s *AuditEventSuite) TestCategory_ComplianceEvents() {
 func (s *AuditEventSuite) TestCategory_SecurityEvents() {
 	securityEvents := []AuditEvent{
 		EventAuthFailed,
+		EventLoginSucceeded,
+		EventLoginFailed,
 		EventSessionRevoked,
 		EventSessionsRevoked,
+		EventSessionRiskElevated,
 		EventClientSecretRotated,
 		EventRateLimitExceeded,
 		EventAuthLockoutTriggered,
 		EventAuthLockoutCleared,
 		EventAllowlistBypassed,
+		EventAuthFailureSpike,
 		EventTenantDeactivated,
+		EventTenantDeleted,
 		EventClientDeactivated,
 	}
 
@@ -67,7 +79,9 @@ func (s *AuditEventSuite) TestCategory_OperationsEvents() {
 		EventTenantCreated,
 		EventTenantReactivated,
 		EventClientCreated,
+		EventClientUpdated,
 		EventClientReactivated,
+		EventAllowlistPurged,
 	}
 
 	for _, event := range operationsEvents {
@@ -89,3 +103,43 @@ func (s *AuditEventSuite) TestCategory_EmptyEventDefaultsToOperations() {
 	emptyEvent := AuditEvent("")
 	s.Equal(CategoryOperations, emptyEvent.Category())
 }
+
+// TestCategory_EveryEventConstantIsMapped parses the package source so that a
+// newly declared AuditEvent constant fails here until it is classified.
+func (s *AuditEventSuite) TestCategory_EveryEventConstantIsMapped() {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, ".", func(fi fs.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}, 0)
+	s.Require().NoError(err)
+
+	var declared []AuditEvent
+	for _, file := range pkgs["audit"].Files {
+		for _, decl := range file.Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok || gen.Tok != token.CONST {
+				continue
+			}
+			for _, spec := range gen.Specs {
+				vs := spec.(*ast.ValueSpec)
+				typ, ok := vs.Type.(*ast.Ident)
+				if !ok || typ.Name != "AuditEvent" {
+					continue
+				}
+				for _, v := range vs.Values {
+					lit := v.(*ast.BasicLit)
+					value, err := strconv.Unquote(lit.Value)
+					s.Require().NoError(err)
+					declared = append(declared, AuditEvent(value))
+				}
+			}
+		}
+	}
+	s.Require().NotEmpty(declared)
+
+	mapped := AllMappedEvents()
+	for _, event := range declared {
+		s.Contains(mapped, event, "%s needs an explicit entry in eventCategories", event)
+	}
+	s.Len(mapped, len(declared), "eventCategories must not map undeclared events")
+}
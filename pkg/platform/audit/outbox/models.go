@@ -16,6 +16,7 @@ type Entry struct {
 	Payload       []byte     // JSON-encoded audit.Event
 	CreatedAt     time.Time  // When the entry was created
 	ProcessedAt   *time.Time // NULL = pending, non-NULL = published to Kafka
+	DedupKey      string     // Optional; appends repeating a key are ignored
 }
 
 // IsPending returns true if this entry has not been processed yet.
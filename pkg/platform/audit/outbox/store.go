@@ -12,6 +12,7 @@ import (
 type Store interface {
 	// Append adds a new entry to the outbox.
 	// This should be called within the same transaction as the business operation.
+	// An entry whose DedupKey matches an existing entry is ignored without error.
 	Append(ctx context.Context, entry *Entry) error
 
 	// FetchUnprocessed returns up to limit entries that haven't been processed.
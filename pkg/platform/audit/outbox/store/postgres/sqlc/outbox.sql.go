@@ -34,8 +34,9 @@ func (q *Queries) DeleteProcessedOutboxEntriesBefore(ctx context.Context, proces
 }
 
 const insertOutboxEntry = `-- name: InsertOutboxEntry :exec
-INSERT INTO outbox (id, aggregate_type, aggregate_id, event_type, payload, created_at)
-VALUES ($1, $2, $3, $4, $5, $6)
+INSERT INTO outbox (id, aggregate_type, aggregate_id, event_type, payload, created_at, dedup_key)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+ON CONFLICT (dedup_key) WHERE dedup_key IS NOT NULL DO NOTHING
 `
 
 type InsertOutboxEntryParams struct {
@@ -45,6 +46,7 @@ type InsertOutboxEntryParams struct {
 	EventType     string
 	Payload       json.RawMessage
 	CreatedAt     time.Time
+	DedupKey      sql.NullString
 }
 
 func (q *Queries) InsertOutboxEntry(ctx context.Context, arg InsertOutboxEntryParams) error {
@@ -55,12 +57,13 @@ func (q *Queries) InsertOutboxEntry(ctx context.Context, arg InsertOutboxEntryPa
 		arg.EventType,
 		arg.Payload,
 		arg.CreatedAt,
+		arg.DedupKey,
 	)
 	return err
 }
 
 const listUnprocessedOutboxEntries = `-- name: ListUnprocessedOutboxEntries :many
-SELECT id, aggregate_type, aggregate_id, event_type, payload, created_at, processed_at
+SELECT id, aggregate_type, aggregate_id, event_type, payload, created_at, processed_at, dedup_key
 FROM outbox
 WHERE processed_at IS NULL
 ORDER BY created_at ASC
@@ -85,6 +88,7 @@ func (q *Queries) ListUnprocessedOutboxEntries(ctx context.Context, limit int32)
 			&i.Payload,
 			&i.CreatedAt,
 			&i.ProcessedAt,
+			&i.DedupKey,
 		); err != nil {
 			return nil, err
 		}
@@ -113,3 +117,20 @@ type MarkOutboxEntryProcessedParams struct {
 func (q *Queries) MarkOutboxEntryProcessed(ctx context.Context, arg MarkOutboxEntryProcessedParams) (sql.Result, error) {
 	return q.db.ExecContext(ctx, markOutboxEntryProcessed, arg.ID, arg.ProcessedAt)
 }
+
+const requeueProcessedOutboxEntries = `-- name: RequeueProcessedOutboxEntries :execresult
+UPDATE outbox
+SET processed_at = NULL
+WHERE processed_at IS NOT NULL
+  AND created_at >= $1
+  AND created_at < $2
+`
+
+type RequeueProcessedOutboxEntriesParams struct {
+	CreatedFrom time.Time
+	CreatedTo   time.Time
+}
+
+func (q *Queries) RequeueProcessedOutboxEntries(ctx context.Context, arg RequeueProcessedOutboxEntriesParams) (sql.Result, error) {
+	return q.db.ExecContext(ctx, requeueProcessedOutboxEntries, arg.CreatedFrom, arg.CreatedTo)
+}
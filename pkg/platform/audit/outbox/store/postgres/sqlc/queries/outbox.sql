@@ -1,9 +1,10 @@
 -- name: InsertOutboxEntry :exec
-INSERT INTO outbox (id, aggregate_type, aggregate_id, event_type, payload, created_at)
-VALUES ($1, $2, $3, $4, $5, $6);
+INSERT INTO outbox (id, aggregate_type, aggregate_id, event_type, payload, created_at, dedup_key)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+ON CONFLICT (dedup_key) WHERE dedup_key IS NOT NULL DO NOTHING;
 
 -- name: ListUnprocessedOutboxEntries :many
-SELECT id, aggregate_type, aggregate_id, event_type, payload, created_at, processed_at
+SELECT id, aggregate_type, aggregate_id, event_type, payload, created_at, processed_at, dedup_key
 FROM outbox
 WHERE processed_at IS NULL
 ORDER BY created_at ASC
@@ -15,6 +16,13 @@ UPDATE outbox
 SET processed_at = $2
 WHERE id = $1 AND processed_at IS NULL;
 
+-- name: RequeueProcessedOutboxEntries :execresult
+UPDATE outbox
+SET processed_at = NULL
+WHERE processed_at IS NOT NULL
+  AND created_at >= sqlc.arg(created_from)
+  AND created_at < sqlc.arg(created_to);
+
 -- name: CountPendingOutboxEntries :one
 SELECT COUNT(*) FROM outbox WHERE processed_at IS NULL;
 
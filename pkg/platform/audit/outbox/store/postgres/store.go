@@ -36,6 +36,7 @@ func (s *Store) Append(ctx context.Context, entry *outbox.Entry) error {
 		EventType:     entry.EventType,
 		Payload:       json.RawMessage(entry.Payload),
 		CreatedAt:     entry.CreatedAt,
+		DedupKey:      dedupKey(entry),
 	})
 	if err != nil {
 		return fmt.Errorf("insert outbox entry: %w", err)
@@ -111,6 +112,26 @@ func (s *Store) DeleteProcessedBefore(ctx context.Context, before time.Time) (in
 	return rowsAffected, nil
 }
 
+// RequeueProcessedBetween clears processed_at on entries created in [from, to)
+// so the worker publishes them again. Returns the number of entries requeued.
+// Replays rely on consumers deduplicating by event ID.
+func (s *Store) RequeueProcessedBetween(ctx context.Context, from, to time.Time) (int64, error) {
+	result, err := s.queries.RequeueProcessedOutboxEntries(ctx, outboxsqlc.RequeueProcessedOutboxEntriesParams{
+		CreatedFrom: from,
+		CreatedTo:   to,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("requeue processed entries: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("get rows affected: %w", err)
+	}
+
+	return rowsAffected, nil
+}
+
 // AppendTx adds a new entry to the outbox table within a transaction.
 // Use this when you want to include the outbox write in an existing transaction.
 func (s *Store) AppendTx(ctx context.Context, tx *sql.Tx, entry *outbox.Entry) error {
@@ -122,6 +143,7 @@ func (s *Store) AppendTx(ctx context.Context, tx *sql.Tx, entry *outbox.Entry) e
 		EventType:     entry.EventType,
 		Payload:       json.RawMessage(entry.Payload),
 		CreatedAt:     entry.CreatedAt,
+		DedupKey:      dedupKey(entry),
 	}); err != nil {
 		return fmt.Errorf("insert outbox entry in tx: %w", err)
 	}
@@ -133,6 +155,10 @@ func (s *Store) BeginTx(ctx context.Context) (*sql.Tx, error) {
 	return s.db.BeginTx(ctx, nil)
 }
 
+func dedupKey(entry *outbox.Entry) sql.NullString {
+	return sql.NullString{String: entry.DedupKey, Valid: entry.DedupKey != ""}
+}
+
 func toOutboxEntry(row outboxsqlc.Outbox) *outbox.Entry {
 	entry := &outbox.Entry{
 		ID:            row.ID,
@@ -141,6 +167,7 @@ func toOutboxEntry(row outboxsqlc.Outbox) *outbox.Entry {
 		EventType:     row.EventType,
 		Payload:       []byte(row.Payload),
 		CreatedAt:     row.CreatedAt,
+		DedupKey:      row.DedupKey.String,
 	}
 	if row.ProcessedAt.Valid {
 		entry.ProcessedAt = &row.ProcessedAt.Time
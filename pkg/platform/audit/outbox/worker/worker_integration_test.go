@@ -310,3 +310,62 @@ func (s *WorkerIntegrationSuite) TestConcurrentWorkersWithSkipLocked() {
 	s.Require().NoError(err)
 	s.Equal(int64(0), pending)
 }
+
+// TestReplayRepublishesProcessedEntries verifies the admin replay path.
+// Invariant: Requeued entries in the replay range are published again; entries outside it stay processed.
+func (s *WorkerIntegrationSuite) TestReplayRepublishesProcessedEntries() {
+	ctx := context.Background()
+	topic := "test-outbox-replay"
+
+	err := s.kafka.CreateTopic(ctx, topic, 1, 1)
+	s.Require().NoError(err)
+
+	now := time.Now()
+	inRange := outbox.NewEntry("user", uuid.New().String(), "user_created", []byte(`{"n":1}`))
+	inRange.CreatedAt = now.Add(-30 * time.Minute)
+	outOfRange := outbox.NewEntry("user", uuid.New().String(), "user_created", []byte(`{"n":2}`))
+	outOfRange.CreatedAt = now.Add(-3 * time.Hour)
+
+	for _, entry := range []*outbox.Entry{inRange, outOfRange} {
+		s.Require().NoError(s.store.Append(ctx, entry))
+		s.Require().NoError(s.store.MarkProcessed(ctx, entry.ID, now))
+	}
+
+	pending, err := s.store.CountPending(ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(int64(0), pending, "entries start out published")
+
+	requeued, err := s.store.RequeueProcessedBetween(ctx, now.Add(-time.Hour), now)
+	s.Require().NoError(err)
+	s.Equal(int64(1), requeued)
+
+	pending, err = s.store.CountPending(ctx)
+	s.Require().NoError(err)
+	s.Equal(int64(1), pending)
+
+	w := worker.New(s.store, s.producer,
+		worker.WithTopic(topic),
+		worker.WithPollInterval(50*time.Millisecond),
+		worker.WithBatchSize(10),
+	)
+	w.Start()
+
+	s.Eventually(func() bool {
+		count, _ := s.store.CountPending(ctx)
+		return count == 0
+	}, 5*time.Second, 50*time.Millisecond)
+
+	stopCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	s.Require().NoError(w.Stop(stopCtx))
+
+	consumer, err := s.kafka.NewConsumer(ctx, "test-outbox-replay-consumer", topic)
+	s.Require().NoError(err)
+	defer consumer.Close()
+
+	record := s.kafka.WaitForMessage(ctx, consumer, 5*time.Second, func(r *kgo.Record) bool {
+		s.NotEqual(outOfRange.ID.String(), string(r.Key), "entry outside the range must not be replayed")
+		return string(r.Key) == inRange.ID.String()
+	})
+	s.Require().NotNil(record, "replayed entry should be re-published to Kafka")
+}
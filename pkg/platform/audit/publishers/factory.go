@@ -9,6 +9,7 @@
 package publishers
 
 import (
+	"fmt"
 	"log/slog"
 	"time"
 
@@ -16,6 +17,7 @@ import (
 	"credo/pkg/platform/audit/publishers/compliance"
 	"credo/pkg/platform/audit/publishers/ops"
 	"credo/pkg/platform/audit/publishers/security"
+	"credo/pkg/platform/audit/store/routed"
 )
 
 // System holds all three publishers.
@@ -89,6 +91,17 @@ func New(store audit.Store, cfg Config, logger *slog.Logger) *System {
 	return s
 }
 
+// NewRouted creates the tri-publisher audit system over per-category backends.
+// Each event is stored in the backend for its category (after router overrides),
+// regardless of which publisher emitted it. It fails if any category lacks a backend.
+func NewRouted(backends map[audit.EventCategory]audit.Store, router *audit.CategoryRouter, cfg Config, logger *slog.Logger) (*System, error) {
+	store, err := routed.New(backends, routed.WithCategoryRouter(router))
+	if err != nil {
+		return nil, fmt.Errorf("configure audit backends: %w", err)
+	}
+	return New(store, cfg, logger), nil
+}
+
 // Close shuts down all publishers gracefully.
 func (s *System) Close() error {
 	// Security publisher needs graceful drain
@@ -0,0 +1,88 @@
+package audit
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"sort"
+)
+
+// CategoryRouter resolves the category of an audit event, applying operator
+// overrides on top of the default eventCategories map.
+// A nil router resolves every event to its default category.
+type CategoryRouter struct {
+	overrides map[AuditEvent]EventCategory
+	strict    *slog.Logger
+}
+
+// RouterOption configures a CategoryRouter.
+type RouterOption func(*CategoryRouter)
+
+// WithStrictCategories logs a warning whenever an event without an explicit
+// category mapping is routed, so a forgotten mapping is noticed in
+// development instead of silently downgrading the event to operations.
+func WithStrictCategories(logger *slog.Logger) RouterOption {
+	return func(r *CategoryRouter) {
+		r.strict = logger
+	}
+}
+
+// NewCategoryRouter builds a router from event=category overrides.
+// Every override must name a known event and category so that a typo cannot
+// silently reroute compliance events to a sampled category.
+func NewCategoryRouter(overrides map[string]string, opts ...RouterOption) (*CategoryRouter, error) {
+	// Sorted so the joined error is stable across runs
+	events := make([]string, 0, len(overrides))
+	for event := range overrides {
+		events = append(events, event)
+	}
+	sort.Strings(events)
+
+	var errs []error
+	resolved := make(map[AuditEvent]EventCategory, len(overrides))
+	for _, event := range events {
+		category := EventCategory(overrides[event])
+		if !AuditEvent(event).IsMapped() {
+			errs = append(errs, fmt.Errorf("unknown audit event %q", event))
+			continue
+		}
+		if !category.IsValid() {
+			errs = append(errs, fmt.Errorf("unknown audit category %q for event %q", category, event))
+			continue
+		}
+		resolved[AuditEvent(event)] = category
+	}
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+	r := &CategoryRouter{overrides: resolved}
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r, nil
+}
+
+// Category returns the overridden category for event, falling back to
+// the event's default category.
+func (r *CategoryRouter) Category(event AuditEvent) EventCategory {
+	if r != nil {
+		if cat, ok := r.overrides[event]; ok {
+			return cat
+		}
+		if r.strict != nil && !event.IsMapped() {
+			r.strict.Warn("audit event has no category mapping, defaulting to operations",
+				"action", string(event),
+			)
+		}
+	}
+	return event.Category()
+}
+
+// IsValid reports whether c is one of the defined categories.
+func (c EventCategory) IsValid() bool {
+	switch c {
+	case CategoryCompliance, CategorySecurity, CategoryOperations:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,74 @@
+package audit
+
+import (
+	"bytes"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+// CategoryRouterSuite tests operator category overrides.
+//
+// Justification: overrides change where compliance and security events are
+// routed, so unknown names must be rejected rather than silently ignored.
+type CategoryRouterSuite struct {
+	suite.Suite
+}
+
+func TestCategoryRouterSuite(t *testing.T) {
+	suite.Run(t, new(CategoryRouterSuite))
+}
+
+func (s *CategoryRouterSuite) TestOverriddenEventRoutesToConfiguredCategory() {
+	router, err := NewCategoryRouter(map[string]string{
+		string(EventTokenIssued): string(CategorySecurity),
+	})
+	s.Require().NoError(err)
+
+	s.Equal(CategorySecurity, router.Category(EventTokenIssued))
+}
+
+func (s *CategoryRouterSuite) TestNonOverriddenEventsKeepDefaults() {
+	router, err := NewCategoryRouter(map[string]string{
+		string(EventTokenIssued): string(CategorySecurity),
+	})
+	s.Require().NoError(err)
+
+	for event := range eventCategories {
+		if event == EventTokenIssued {
+			continue
+		}
+		s.Equal(event.Category(), router.Category(event), string(event))
+	}
+	s.Equal(CategoryOperations, router.Category("unknown_event"))
+}
+
+func (s *CategoryRouterSuite) TestNilRouterUsesDefaults() {
+	var router *CategoryRouter
+	s.Equal(CategoryOperations, router.Category(EventTokenIssued))
+	s.Equal(CategoryCompliance, router.Category(EventConsentGranted))
+}
+
+func (s *CategoryRouterSuite) TestRejectsUnknownNames() {
+	_, err := NewCategoryRouter(map[string]string{
+		"token_isued":               string(CategorySecurity),
+		string(EventConsentGranted): "archive",
+	})
+	s.Require().Error(err)
+	s.Contains(err.Error(), `unknown audit event "token_isued"`)
+	s.Contains(err.Error(), `unknown audit category "archive"`)
+}
+
+func (s *CategoryRouterSuite) TestStrictModeWarnsOnUnmappedEvent() {
+	var buf bytes.Buffer
+	router, err := NewCategoryRouter(nil, WithStrictCategories(slog.New(slog.NewTextHandler(&buf, nil))))
+	s.Require().NoError(err)
+
+	s.Equal(CategoryCompliance, router.Category(EventConsentGranted))
+	s.Empty(buf.String(), "mapped events must not warn")
+
+	s.Equal(CategoryOperations, router.Category("brand_new_event"))
+	s.Contains(buf.String(), "no category mapping")
+	s.Contains(buf.String(), "brand_new_event")
+}
@@ -2,9 +2,12 @@ package audit
 
 import (
 	"context"
+	"time"
 
 	id "credo/pkg/domain"
 	pkgerrors "credo/pkg/domain-errors"
+
+	"github.com/google/uuid"
 )
 
 var (
@@ -17,4 +20,41 @@ type Store interface {
 	ListByUser(ctx context.Context, userID id.UserID) ([]Event, error)
 	ListAll(ctx context.Context) ([]Event, error)
 	ListRecent(ctx context.Context, limit int) ([]Event, error)
+	// ListByRequestID returns every event sharing requestID, oldest first.
+	ListByRequestID(ctx context.Context, requestID string) ([]Event, error)
+}
+
+// Anonymizer scrubs PII from an erased user's events while keeping the events
+// for retention. Email is cleared and Subject replaced by subjectIDHash, which
+// also fills SubjectIDHash where the event had none. Returns the number of
+// events rewritten.
+type Anonymizer interface {
+	AnonymizeUser(ctx context.Context, userID id.UserID, subjectIDHash string) (int, error)
+}
+
+// UserPager reads a user's events in keyset pages, newest first, so large
+// histories can be streamed without loading them whole. next is nil on the
+// last page.
+type UserPager interface {
+	ListByUserPaged(ctx context.Context, userID id.UserID, cursor *AuditCursor, limit int) (events []Event, next *AuditCursor, err error)
+}
+
+// AuditFilter narrows an audit query. Zero-valued fields do not filter; From
+// and To bound the event timestamp inclusively. Limit caps the number of
+// events returned, newest first.
+type AuditFilter struct {
+	Category EventCategory
+	From     time.Time
+	To       time.Time
+	UserID   id.UserID
+	Action   string
+	Limit    int
+}
+
+// AuditCursor marks the last event of a page so the next page resumes after
+// it. Events are ordered by timestamp then ID, newest first; the ID breaks
+// ties between events recorded at the same instant.
+type AuditCursor struct {
+	Timestamp time.Time
+	ID        uuid.UUID
 }
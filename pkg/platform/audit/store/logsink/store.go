@@ -0,0 +1,66 @@
+// Package logsink provides a write-only audit.Store that emits each event as a
+// structured log record, for a SIEM that collects the service's logs.
+package logsink
+
+import (
+	"context"
+	"log/slog"
+
+	id "credo/pkg/domain"
+	audit "credo/pkg/platform/audit"
+)
+
+// Store writes events to a logger instead of keeping them. Reads return no
+// events: once routed here, events live in the SIEM, not in the service.
+type Store struct {
+	logger *slog.Logger
+}
+
+// New creates a log sink writing to logger.
+func New(logger *slog.Logger) *Store {
+	return &Store{logger: logger}
+}
+
+// Append emits event as one "audit_event" record. Email is left out so the
+// sink never ships raw PII; SubjectIDHash carries the subject instead.
+func (s *Store) Append(ctx context.Context, event audit.Event) error {
+	attrs := []slog.Attr{
+		slog.String("category", string(event.Category)),
+		slog.String("action", event.Action),
+		slog.Time("timestamp", event.Timestamp),
+		slog.String("user_id", event.UserID.String()),
+		slog.String("subject", event.Subject),
+		slog.String("purpose", event.Purpose),
+		slog.String("requesting_party", event.RequestingParty),
+		slog.String("decision", event.Decision),
+		slog.String("reason", event.Reason),
+		slog.String("request_id", event.RequestID),
+		slog.String("actor_id", event.ActorID),
+		slog.String("subject_id_hash", event.SubjectIDHash),
+	}
+	if len(event.Metadata) > 0 {
+		attrs = append(attrs, slog.Any("metadata", event.Metadata))
+	}
+	s.logger.LogAttrs(ctx, slog.LevelInfo, "audit_event", attrs...)
+	return nil
+}
+
+// ListByUser returns no events.
+func (s *Store) ListByUser(context.Context, id.UserID) ([]audit.Event, error) {
+	return nil, nil
+}
+
+// ListAll returns no events.
+func (s *Store) ListAll(context.Context) ([]audit.Event, error) {
+	return nil, nil
+}
+
+// ListRecent returns no events.
+func (s *Store) ListRecent(context.Context, int) ([]audit.Event, error) {
+	return nil, nil
+}
+
+// ListByRequestID returns no events.
+func (s *Store) ListByRequestID(context.Context, string) ([]audit.Event, error) {
+	return nil, nil
+}
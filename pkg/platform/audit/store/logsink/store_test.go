@@ -0,0 +1,44 @@
+package logsink_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	audit "credo/pkg/platform/audit"
+	"credo/pkg/platform/audit/store/logsink"
+)
+
+// Justification: what the SIEM receives is a log line, which no E2E flow reads.
+func TestAppendWritesOneRecordWithoutEmail(t *testing.T) {
+	var buf bytes.Buffer
+	store := logsink.New(slog.New(slog.NewJSONHandler(&buf, nil)))
+
+	err := store.Append(context.Background(), audit.Event{
+		Category:  audit.CategorySecurity,
+		Action:    string(audit.EventAuthFailed),
+		Timestamp: time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC),
+		Email:     "user@example.com",
+		RequestID: "req-1",
+		Metadata:  map[string]string{audit.MetadataIP: "192.168.1.0"},
+	})
+	require.NoError(t, err)
+
+	var record map[string]any
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &record))
+	assert.Equal(t, "audit_event", record["msg"])
+	assert.Equal(t, "security", record["category"])
+	assert.Equal(t, "req-1", record["request_id"])
+	assert.Equal(t, map[string]any{"ip": "192.168.1.0"}, record["metadata"])
+	assert.NotContains(t, buf.String(), "user@example.com")
+
+	events, err := store.ListAll(context.Background())
+	require.NoError(t, err)
+	assert.Empty(t, events, "the sink keeps nothing to read back")
+}
@@ -1,32 +1,55 @@
 package memory
 
 import (
+	"bytes"
 	"context"
+	"sort"
 	"sync"
+	"time"
 
 	id "credo/pkg/domain"
 	audit "credo/pkg/platform/audit"
+
+	"github.com/google/uuid"
 )
 
 type InMemoryStore struct {
 	mu     sync.RWMutex
 	events map[id.UserID][]audit.Event
+	// eventIDs parallels events; IDs break timestamp ties when paging.
+	eventIDs  map[id.UserID][]uuid.UUID
+	dedupKeys map[string]struct{}
 }
 
 func (s *InMemoryStore) Clear() {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 	s.events = make(map[id.UserID][]audit.Event)
+	s.eventIDs = make(map[id.UserID][]uuid.UUID)
+	s.dedupKeys = make(map[string]struct{})
 }
 
 func NewInMemoryStore() *InMemoryStore {
-	return &InMemoryStore{events: make(map[id.UserID][]audit.Event)}
+	return &InMemoryStore{
+		events:    make(map[id.UserID][]audit.Event),
+		eventIDs:  make(map[id.UserID][]uuid.UUID),
+		dedupKeys: make(map[string]struct{}),
+	}
 }
 
+// Append records event. Like the Postgres outbox, an event repeating an earlier
+// DedupKey is ignored.
 func (s *InMemoryStore) Append(_ context.Context, event audit.Event) error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
+	if event.DedupKey != "" {
+		if _, seen := s.dedupKeys[event.DedupKey]; seen {
+			return nil
+		}
+		s.dedupKeys[event.DedupKey] = struct{}{}
+	}
 	s.events[event.UserID] = append(s.events[event.UserID], event)
+	s.eventIDs[event.UserID] = append(s.eventIDs[event.UserID], uuid.New())
 	return nil
 }
 
@@ -36,6 +59,100 @@ func (s *InMemoryStore) ListByUser(_ context.Context, userID id.UserID) ([]audit
 	return append([]audit.Event{}, s.events[userID]...), nil
 }
 
+// ListByUserPaged returns up to limit of userID's events, newest first,
+// starting after cursor (nil for the first page). Like the Postgres store,
+// events are ordered by timestamp then ID. A limit of zero or less returns
+// every remaining event.
+func (s *InMemoryStore) ListByUserPaged(_ context.Context, userID id.UserID, cursor *audit.AuditCursor, limit int) ([]audit.Event, *audit.AuditCursor, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	events := s.events[userID]
+	ids := s.eventIDs[userID]
+	order := make([]int, len(events))
+	for i := range order {
+		order[i] = i
+	}
+	sort.Slice(order, func(a, b int) bool {
+		return newerThan(events[order[a]].Timestamp, ids[order[a]], events[order[b]].Timestamp, ids[order[b]])
+	})
+
+	var page []audit.Event
+	var next *audit.AuditCursor
+	last := -1
+	for _, i := range order {
+		if cursor != nil && !newerThan(cursor.Timestamp, cursor.ID, events[i].Timestamp, ids[i]) {
+			continue
+		}
+		if limit > 0 && len(page) == limit {
+			next = &audit.AuditCursor{Timestamp: events[last].Timestamp, ID: ids[last]}
+			break
+		}
+		page = append(page, events[i])
+		last = i
+	}
+	return page, next, nil
+}
+
+// newerThan reports whether the event at (ts, eventID) sorts before the one
+// at (otherTS, otherID) in newest-first order.
+func newerThan(ts time.Time, eventID uuid.UUID, otherTS time.Time, otherID uuid.UUID) bool {
+	if !ts.Equal(otherTS) {
+		return ts.After(otherTS)
+	}
+	return bytes.Compare(eventID[:], otherID[:]) > 0
+}
+
+// ListBySubjectHash returns events carrying subjectIDHash, including the
+// anonymized events of erased users.
+func (s *InMemoryStore) ListBySubjectHash(_ context.Context, subjectIDHash string) ([]audit.Event, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var matched []audit.Event
+	for _, userEvents := range s.events {
+		for _, e := range userEvents {
+			if e.SubjectIDHash == subjectIDHash || e.Subject == subjectIDHash {
+				matched = append(matched, e)
+			}
+		}
+	}
+	return matched, nil
+}
+
+// ListByRequestID returns the events recorded for a single request, oldest first.
+func (s *InMemoryStore) ListByRequestID(_ context.Context, requestID string) ([]audit.Event, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var matched []audit.Event
+	for _, userEvents := range s.events {
+		for _, e := range userEvents {
+			if e.RequestID == requestID {
+				matched = append(matched, e)
+			}
+		}
+	}
+	sort.SliceStable(matched, func(i, j int) bool { return matched[i].Timestamp.Before(matched[j].Timestamp) })
+	return matched, nil
+}
+
+// AnonymizeUser scrubs email and subject from every event of userID.
+func (s *InMemoryStore) AnonymizeUser(_ context.Context, userID id.UserID, subjectIDHash string) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	events := s.events[userID]
+	for i := range events {
+		events[i].Email = ""
+		events[i].Subject = subjectIDHash
+		if events[i].SubjectIDHash == "" {
+			events[i].SubjectIDHash = subjectIDHash
+		}
+	}
+	return len(events), nil
+}
+
 // ListAll returns all audit events across all users (admin-only operation)
 func (s *InMemoryStore) ListAll(_ context.Context) ([]audit.Event, error) {
 	s.mu.RLock()
@@ -0,0 +1,101 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	audit "credo/pkg/platform/audit"
+
+	"github.com/google/uuid"
+)
+
+// maxQueryLimit bounds Query results; a zero or larger filter limit uses it.
+const maxQueryLimit = 1000
+
+const queryAuditEventsSelect = `SELECT category, timestamp, user_id, subject, action,
+       purpose, requesting_party, decision, reason,
+       email, request_id, actor_id, subject_id_hash, metadata
+FROM audit_events`
+
+// Query returns the materialized events matching filter, newest first. Events
+// sharing a timestamp are ordered by ID so pages stay stable.
+func (s *Store) Query(ctx context.Context, filter audit.AuditFilter) ([]audit.Event, error) {
+	query, args := buildAuditQuery(filter)
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query audit events: %w", err)
+	}
+	defer rows.Close()
+
+	var events []auditEventRow
+	for rows.Next() {
+		var row auditEventRow
+		if err := rows.Scan(
+			&row.Category,
+			&row.Timestamp,
+			&row.UserID,
+			&row.Subject,
+			&row.Action,
+			&row.Purpose,
+			&row.RequestingParty,
+			&row.Decision,
+			&row.Reason,
+			&row.Email,
+			&row.RequestID,
+			&row.ActorID,
+			&row.SubjectIDHash,
+			&row.Metadata,
+		); err != nil {
+			return nil, fmt.Errorf("scan audit event: %w", err)
+		}
+		events = append(events, row)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("query audit events: %w", err)
+	}
+	return mapAuditEvents(events), nil
+}
+
+// buildAuditQuery renders the SQL for filter. Filter values are only ever
+// bound as parameters; the SQL text varies only in which fixed predicates it
+// includes. Each predicate compares a bare column so the category/timestamp,
+// user_id and action indexes stay usable.
+func buildAuditQuery(filter audit.AuditFilter) (string, []any) {
+	var predicates []string
+	var args []any
+	where := func(predicate string, arg any) {
+		args = append(args, arg)
+		predicates = append(predicates, predicate+" $"+strconv.Itoa(len(args)))
+	}
+	if filter.Category != "" {
+		where("category =", string(filter.Category))
+	}
+	if !filter.From.IsZero() {
+		where("timestamp >=", filter.From)
+	}
+	if !filter.To.IsZero() {
+		where("timestamp <=", filter.To)
+	}
+	if !filter.UserID.IsNil() {
+		where("user_id =", uuid.UUID(filter.UserID))
+	}
+	if filter.Action != "" {
+		where("action =", filter.Action)
+	}
+
+	var query strings.Builder
+	query.WriteString(queryAuditEventsSelect)
+	if len(predicates) > 0 {
+		query.WriteString("\nWHERE ")
+		query.WriteString(strings.Join(predicates, "\n  AND "))
+	}
+	limit := filter.Limit
+	if limit <= 0 || limit > maxQueryLimit {
+		limit = maxQueryLimit
+	}
+	args = append(args, limit)
+	query.WriteString("\nORDER BY timestamp DESC, id DESC\nLIMIT $" + strconv.Itoa(len(args)))
+	return query.String(), args
+}
@@ -7,19 +7,64 @@ package sqlc
 
 import (
 	"context"
+	"database/sql"
 	"encoding/json"
 	"time"
 
 	"github.com/google/uuid"
 )
 
+const anonymizeAuditEventsByUser = `-- name: AnonymizeAuditEventsByUser :execrows
+UPDATE audit_events
+SET email = '',
+    subject = $1,
+    subject_id_hash = COALESCE(NULLIF(subject_id_hash, ''), $1)
+WHERE user_id = $2
+`
+
+type AnonymizeAuditEventsByUserParams struct {
+	SubjectIDHash string
+	UserID        uuid.NullUUID
+}
+
+func (q *Queries) AnonymizeAuditEventsByUser(ctx context.Context, arg AnonymizeAuditEventsByUserParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, anonymizeAuditEventsByUser, arg.SubjectIDHash, arg.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const anonymizeOutboxEntriesByUser = `-- name: AnonymizeOutboxEntriesByUser :execrows
+UPDATE outbox
+SET payload = (payload - 'Email') || jsonb_build_object(
+        'Subject', $1::text,
+        'SubjectIDHash', COALESCE(NULLIF(payload->>'SubjectIDHash', ''), $1::text)
+    )
+WHERE aggregate_type = 'user'
+  AND aggregate_id = $2
+`
+
+type AnonymizeOutboxEntriesByUserParams struct {
+	SubjectIDHash string
+	AggregateID   string
+}
+
+func (q *Queries) AnonymizeOutboxEntriesByUser(ctx context.Context, arg AnonymizeOutboxEntriesByUserParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, anonymizeOutboxEntriesByUser, arg.SubjectIDHash, arg.AggregateID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const insertAuditEvent = `-- name: InsertAuditEvent :exec
 INSERT INTO audit_events (
     id, category, timestamp, user_id, subject, action,
     purpose, requesting_party, decision, reason,
-    email, request_id, actor_id
+    email, request_id, actor_id, subject_id_hash, metadata
 )
-VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)
 ON CONFLICT (id) DO NOTHING
 `
 
@@ -37,6 +82,8 @@ type InsertAuditEventParams struct {
 	Email           string
 	RequestID       string
 	ActorID         string
+	SubjectIDHash   string
+	Metadata        json.RawMessage
 }
 
 func (q *Queries) InsertAuditEvent(ctx context.Context, arg InsertAuditEventParams) error {
@@ -54,13 +101,16 @@ func (q *Queries) InsertAuditEvent(ctx context.Context, arg InsertAuditEventPara
 		arg.Email,
 		arg.RequestID,
 		arg.ActorID,
+		arg.SubjectIDHash,
+		arg.Metadata,
 	)
 	return err
 }
 
 const insertOutboxEntry = `-- name: InsertOutboxEntry :exec
-INSERT INTO outbox (id, aggregate_type, aggregate_id, event_type, payload, created_at)
-VALUES ($1, $2, $3, $4, $5, $6)
+INSERT INTO outbox (id, aggregate_type, aggregate_id, event_type, payload, created_at, dedup_key)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+ON CONFLICT (dedup_key) WHERE dedup_key IS NOT NULL DO NOTHING
 `
 
 type InsertOutboxEntryParams struct {
@@ -70,6 +120,7 @@ type InsertOutboxEntryParams struct {
 	EventType     string
 	Payload       json.RawMessage
 	CreatedAt     time.Time
+	DedupKey      sql.NullString
 }
 
 func (q *Queries) InsertOutboxEntry(ctx context.Context, arg InsertOutboxEntryParams) error {
@@ -80,6 +131,7 @@ func (q *Queries) InsertOutboxEntry(ctx context.Context, arg InsertOutboxEntryPa
 		arg.EventType,
 		arg.Payload,
 		arg.CreatedAt,
+		arg.DedupKey,
 	)
 	return err
 }
@@ -87,7 +139,7 @@ func (q *Queries) InsertOutboxEntry(ctx context.Context, arg InsertOutboxEntryPa
 const listAuditEvents = `-- name: ListAuditEvents :many
 SELECT category, timestamp, user_id, subject, action,
        purpose, requesting_party, decision, reason,
-       email, request_id, actor_id
+       email, request_id, actor_id, subject_id_hash, metadata
 FROM audit_events
 ORDER BY timestamp DESC
 `
@@ -105,6 +157,8 @@ type ListAuditEventsRow struct {
 	Email           string
 	RequestID       string
 	ActorID         string
+	SubjectIDHash   string
+	Metadata        json.RawMessage
 }
 
 func (q *Queries) ListAuditEvents(ctx context.Context) ([]ListAuditEventsRow, error) {
@@ -129,6 +183,136 @@ func (q *Queries) ListAuditEvents(ctx context.Context) ([]ListAuditEventsRow, er
 			&i.Email,
 			&i.RequestID,
 			&i.ActorID,
+			&i.SubjectIDHash,
+			&i.Metadata,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAuditEventsByRequestID = `-- name: ListAuditEventsByRequestID :many
+SELECT category, timestamp, user_id, subject, action,
+       purpose, requesting_party, decision, reason,
+       email, request_id, actor_id, subject_id_hash, metadata
+FROM audit_events
+WHERE request_id = $1
+ORDER BY timestamp ASC, id ASC
+`
+
+type ListAuditEventsByRequestIDRow struct {
+	Category        string
+	Timestamp       time.Time
+	UserID          uuid.NullUUID
+	Subject         string
+	Action          string
+	Purpose         string
+	RequestingParty string
+	Decision        string
+	Reason          string
+	Email           string
+	RequestID       string
+	ActorID         string
+	SubjectIDHash   string
+	Metadata        json.RawMessage
+}
+
+func (q *Queries) ListAuditEventsByRequestID(ctx context.Context, requestID string) ([]ListAuditEventsByRequestIDRow, error) {
+	rows, err := q.db.QueryContext(ctx, listAuditEventsByRequestID, requestID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListAuditEventsByRequestIDRow
+	for rows.Next() {
+		var i ListAuditEventsByRequestIDRow
+		if err := rows.Scan(
+			&i.Category,
+			&i.Timestamp,
+			&i.UserID,
+			&i.Subject,
+			&i.Action,
+			&i.Purpose,
+			&i.RequestingParty,
+			&i.Decision,
+			&i.Reason,
+			&i.Email,
+			&i.RequestID,
+			&i.ActorID,
+			&i.SubjectIDHash,
+			&i.Metadata,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAuditEventsBySubjectHash = `-- name: ListAuditEventsBySubjectHash :many
+SELECT category, timestamp, user_id, subject, action,
+       purpose, requesting_party, decision, reason,
+       email, request_id, actor_id, subject_id_hash, metadata
+FROM audit_events
+WHERE subject_id_hash = $1 OR subject = $1
+ORDER BY timestamp DESC
+`
+
+type ListAuditEventsBySubjectHashRow struct {
+	Category        string
+	Timestamp       time.Time
+	UserID          uuid.NullUUID
+	Subject         string
+	Action          string
+	Purpose         string
+	RequestingParty string
+	Decision        string
+	Reason          string
+	Email           string
+	RequestID       string
+	ActorID         string
+	SubjectIDHash   string
+	Metadata        json.RawMessage
+}
+
+func (q *Queries) ListAuditEventsBySubjectHash(ctx context.Context, subjectIDHash string) ([]ListAuditEventsBySubjectHashRow, error) {
+	rows, err := q.db.QueryContext(ctx, listAuditEventsBySubjectHash, subjectIDHash)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListAuditEventsBySubjectHashRow
+	for rows.Next() {
+		var i ListAuditEventsBySubjectHashRow
+		if err := rows.Scan(
+			&i.Category,
+			&i.Timestamp,
+			&i.UserID,
+			&i.Subject,
+			&i.Action,
+			&i.Purpose,
+			&i.RequestingParty,
+			&i.Decision,
+			&i.Reason,
+			&i.Email,
+			&i.RequestID,
+			&i.ActorID,
+			&i.SubjectIDHash,
+			&i.Metadata,
 		); err != nil {
 			return nil, err
 		}
@@ -146,7 +330,7 @@ func (q *Queries) ListAuditEvents(ctx context.Context) ([]ListAuditEventsRow, er
 const listAuditEventsByUser = `-- name: ListAuditEventsByUser :many
 SELECT category, timestamp, user_id, subject, action,
        purpose, requesting_party, decision, reason,
-       email, request_id, actor_id
+       email, request_id, actor_id, subject_id_hash, metadata
 FROM audit_events
 WHERE user_id = $1
 ORDER BY timestamp DESC
@@ -165,6 +349,8 @@ type ListAuditEventsByUserRow struct {
 	Email           string
 	RequestID       string
 	ActorID         string
+	SubjectIDHash   string
+	Metadata        json.RawMessage
 }
 
 func (q *Queries) ListAuditEventsByUser(ctx context.Context, userID uuid.NullUUID) ([]ListAuditEventsByUserRow, error) {
@@ -189,6 +375,160 @@ func (q *Queries) ListAuditEventsByUser(ctx context.Context, userID uuid.NullUUI
 			&i.Email,
 			&i.RequestID,
 			&i.ActorID,
+			&i.SubjectIDHash,
+			&i.Metadata,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAuditEventsByUserAfter = `-- name: ListAuditEventsByUserAfter :many
+SELECT id, category, timestamp, user_id, subject, action,
+       purpose, requesting_party, decision, reason,
+       email, request_id, actor_id, subject_id_hash, metadata
+FROM audit_events
+WHERE user_id = $1
+  AND (timestamp, id) < ($2::timestamptz, $3::uuid)
+ORDER BY timestamp DESC, id DESC
+LIMIT $4
+`
+
+type ListAuditEventsByUserAfterParams struct {
+	UserID          uuid.NullUUID
+	CursorTimestamp time.Time
+	CursorID        uuid.UUID
+	PageLimit       int32
+}
+
+type ListAuditEventsByUserAfterRow struct {
+	ID              uuid.UUID
+	Category        string
+	Timestamp       time.Time
+	UserID          uuid.NullUUID
+	Subject         string
+	Action          string
+	Purpose         string
+	RequestingParty string
+	Decision        string
+	Reason          string
+	Email           string
+	RequestID       string
+	ActorID         string
+	SubjectIDHash   string
+	Metadata        json.RawMessage
+}
+
+func (q *Queries) ListAuditEventsByUserAfter(ctx context.Context, arg ListAuditEventsByUserAfterParams) ([]ListAuditEventsByUserAfterRow, error) {
+	rows, err := q.db.QueryContext(ctx, listAuditEventsByUserAfter,
+		arg.UserID,
+		arg.CursorTimestamp,
+		arg.CursorID,
+		arg.PageLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListAuditEventsByUserAfterRow
+	for rows.Next() {
+		var i ListAuditEventsByUserAfterRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Category,
+			&i.Timestamp,
+			&i.UserID,
+			&i.Subject,
+			&i.Action,
+			&i.Purpose,
+			&i.RequestingParty,
+			&i.Decision,
+			&i.Reason,
+			&i.Email,
+			&i.RequestID,
+			&i.ActorID,
+			&i.SubjectIDHash,
+			&i.Metadata,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAuditEventsByUserFirstPage = `-- name: ListAuditEventsByUserFirstPage :many
+SELECT id, category, timestamp, user_id, subject, action,
+       purpose, requesting_party, decision, reason,
+       email, request_id, actor_id, subject_id_hash, metadata
+FROM audit_events
+WHERE user_id = $1
+ORDER BY timestamp DESC, id DESC
+LIMIT $2
+`
+
+type ListAuditEventsByUserFirstPageParams struct {
+	UserID    uuid.NullUUID
+	PageLimit int32
+}
+
+type ListAuditEventsByUserFirstPageRow struct {
+	ID              uuid.UUID
+	Category        string
+	Timestamp       time.Time
+	UserID          uuid.NullUUID
+	Subject         string
+	Action          string
+	Purpose         string
+	RequestingParty string
+	Decision        string
+	Reason          string
+	Email           string
+	RequestID       string
+	ActorID         string
+	SubjectIDHash   string
+	Metadata        json.RawMessage
+}
+
+func (q *Queries) ListAuditEventsByUserFirstPage(ctx context.Context, arg ListAuditEventsByUserFirstPageParams) ([]ListAuditEventsByUserFirstPageRow, error) {
+	rows, err := q.db.QueryContext(ctx, listAuditEventsByUserFirstPage, arg.UserID, arg.PageLimit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListAuditEventsByUserFirstPageRow
+	for rows.Next() {
+		var i ListAuditEventsByUserFirstPageRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Category,
+			&i.Timestamp,
+			&i.UserID,
+			&i.Subject,
+			&i.Action,
+			&i.Purpose,
+			&i.RequestingParty,
+			&i.Decision,
+			&i.Reason,
+			&i.Email,
+			&i.RequestID,
+			&i.ActorID,
+			&i.SubjectIDHash,
+			&i.Metadata,
 		); err != nil {
 			return nil, err
 		}
@@ -206,7 +546,7 @@ func (q *Queries) ListAuditEventsByUser(ctx context.Context, userID uuid.NullUUI
 const listRecentAuditEvents = `-- name: ListRecentAuditEvents :many
 SELECT category, timestamp, user_id, subject, action,
        purpose, requesting_party, decision, reason,
-       email, request_id, actor_id
+       email, request_id, actor_id, subject_id_hash, metadata
 FROM audit_events
 ORDER BY timestamp DESC
 LIMIT $1
@@ -225,6 +565,8 @@ type ListRecentAuditEventsRow struct {
 	Email           string
 	RequestID       string
 	ActorID         string
+	SubjectIDHash   string
+	Metadata        json.RawMessage
 }
 
 func (q *Queries) ListRecentAuditEvents(ctx context.Context, limit int32) ([]ListRecentAuditEventsRow, error) {
@@ -249,6 +591,8 @@ func (q *Queries) ListRecentAuditEvents(ctx context.Context, limit int32) ([]Lis
 			&i.Email,
 			&i.RequestID,
 			&i.ActorID,
+			&i.SubjectIDHash,
+			&i.Metadata,
 		); err != nil {
 			return nil, err
 		}
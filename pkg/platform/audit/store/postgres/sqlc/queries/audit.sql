@@ -1,35 +1,87 @@
 -- name: InsertOutboxEntry :exec
-INSERT INTO outbox (id, aggregate_type, aggregate_id, event_type, payload, created_at)
-VALUES ($1, $2, $3, $4, $5, $6);
+INSERT INTO outbox (id, aggregate_type, aggregate_id, event_type, payload, created_at, dedup_key)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+ON CONFLICT (dedup_key) WHERE dedup_key IS NOT NULL DO NOTHING;
 
 -- name: InsertAuditEvent :exec
 INSERT INTO audit_events (
     id, category, timestamp, user_id, subject, action,
     purpose, requesting_party, decision, reason,
-    email, request_id, actor_id
+    email, request_id, actor_id, subject_id_hash, metadata
 )
-VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)
 ON CONFLICT (id) DO NOTHING;
 
 -- name: ListAuditEventsByUser :many
 SELECT category, timestamp, user_id, subject, action,
        purpose, requesting_party, decision, reason,
-       email, request_id, actor_id
+       email, request_id, actor_id, subject_id_hash, metadata
 FROM audit_events
 WHERE user_id = $1
 ORDER BY timestamp DESC;
 
+-- name: ListAuditEventsByUserFirstPage :many
+SELECT id, category, timestamp, user_id, subject, action,
+       purpose, requesting_party, decision, reason,
+       email, request_id, actor_id, subject_id_hash, metadata
+FROM audit_events
+WHERE user_id = sqlc.arg(user_id)
+ORDER BY timestamp DESC, id DESC
+LIMIT sqlc.arg(page_limit);
+
+-- name: ListAuditEventsByUserAfter :many
+SELECT id, category, timestamp, user_id, subject, action,
+       purpose, requesting_party, decision, reason,
+       email, request_id, actor_id, subject_id_hash, metadata
+FROM audit_events
+WHERE user_id = sqlc.arg(user_id)
+  AND (timestamp, id) < (sqlc.arg(cursor_timestamp)::timestamptz, sqlc.arg(cursor_id)::uuid)
+ORDER BY timestamp DESC, id DESC
+LIMIT sqlc.arg(page_limit);
+
+-- name: ListAuditEventsBySubjectHash :many
+SELECT category, timestamp, user_id, subject, action,
+       purpose, requesting_party, decision, reason,
+       email, request_id, actor_id, subject_id_hash, metadata
+FROM audit_events
+WHERE subject_id_hash = sqlc.arg(subject_id_hash) OR subject = sqlc.arg(subject_id_hash)
+ORDER BY timestamp DESC;
+
+-- name: ListAuditEventsByRequestID :many
+SELECT category, timestamp, user_id, subject, action,
+       purpose, requesting_party, decision, reason,
+       email, request_id, actor_id, subject_id_hash, metadata
+FROM audit_events
+WHERE request_id = $1
+ORDER BY timestamp ASC, id ASC;
+
 -- name: ListAuditEvents :many
 SELECT category, timestamp, user_id, subject, action,
        purpose, requesting_party, decision, reason,
-       email, request_id, actor_id
+       email, request_id, actor_id, subject_id_hash, metadata
 FROM audit_events
 ORDER BY timestamp DESC;
 
 -- name: ListRecentAuditEvents :many
 SELECT category, timestamp, user_id, subject, action,
        purpose, requesting_party, decision, reason,
-       email, request_id, actor_id
+       email, request_id, actor_id, subject_id_hash, metadata
 FROM audit_events
 ORDER BY timestamp DESC
 LIMIT $1;
+
+-- name: AnonymizeAuditEventsByUser :execrows
+UPDATE audit_events
+SET email = '',
+    subject = sqlc.arg(subject_id_hash),
+    subject_id_hash = COALESCE(NULLIF(subject_id_hash, ''), sqlc.arg(subject_id_hash))
+WHERE user_id = sqlc.arg(user_id);
+
+-- name: AnonymizeOutboxEntriesByUser :execrows
+UPDATE outbox
+SET payload = (payload - 'Email') || jsonb_build_object(
+        'Subject', sqlc.arg(subject_id_hash)::text,
+        'SubjectIDHash', COALESCE(NULLIF(payload->>'SubjectIDHash', ''), sqlc.arg(subject_id_hash)::text)
+    )
+WHERE aggregate_type = 'user'
+  AND aggregate_id = sqlc.arg(aggregate_id);
@@ -21,14 +21,30 @@ import (
 type Store struct {
 	db      *sql.DB
 	queries *auditsqlc.Queries
+	router  *audit.CategoryRouter
+}
+
+// Option configures the Store.
+type Option func(*Store)
+
+// WithCategoryRouter applies operator category overrides when deriving an
+// event's category from its action.
+func WithCategoryRouter(router *audit.CategoryRouter) Option {
+	return func(s *Store) {
+		s.router = router
+	}
 }
 
 // New creates a new PostgreSQL audit store that writes to the outbox.
-func New(db *sql.DB) *Store {
-	return &Store{
+func New(db *sql.DB, opts ...Option) *Store {
+	s := &Store{
 		db:      db,
 		queries: auditsqlc.New(db),
 	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
 }
 
 func (s *Store) queriesFor(ctx context.Context) *auditsqlc.Queries {
@@ -41,27 +57,31 @@ func (s *Store) queriesFor(ctx context.Context) *auditsqlc.Queries {
 // outboxPayload is the JSON structure published to Kafka.
 // Field names match audit.Event for proper deserialization by the consumer.
 type outboxPayload struct {
-	ID              string `json:"ID"`
-	Category        string `json:"Category"`
-	Timestamp       string `json:"Timestamp"`
-	UserID          string `json:"UserID,omitempty"`
-	Subject         string `json:"Subject"`
-	Action          string `json:"Action"`
-	Purpose         string `json:"Purpose,omitempty"`
-	RequestingParty string `json:"RequestingParty,omitempty"`
-	Decision        string `json:"Decision,omitempty"`
-	Reason          string `json:"Reason,omitempty"`
-	Email           string `json:"Email,omitempty"`
-	RequestID       string `json:"RequestID,omitempty"`
-	ActorID         string `json:"ActorID,omitempty"`
+	ID              string            `json:"ID"`
+	Category        string            `json:"Category"`
+	Timestamp       string            `json:"Timestamp"`
+	UserID          string            `json:"UserID,omitempty"`
+	Subject         string            `json:"Subject"`
+	Action          string            `json:"Action"`
+	Purpose         string            `json:"Purpose,omitempty"`
+	RequestingParty string            `json:"RequestingParty,omitempty"`
+	Decision        string            `json:"Decision,omitempty"`
+	Reason          string            `json:"Reason,omitempty"`
+	Email           string            `json:"Email,omitempty"`
+	RequestID       string            `json:"RequestID,omitempty"`
+	ActorID         string            `json:"ActorID,omitempty"`
+	SubjectIDHash   string            `json:"SubjectIDHash,omitempty"`
+	Metadata        map[string]string `json:"Metadata,omitempty"`
 }
 
 // Append writes an audit event to the outbox table for Kafka publishing.
+// When event.DedupKey is set, a retried append with the same key is a no-op.
 func (s *Store) Append(ctx context.Context, event audit.Event) error {
 	eventID := uuid.New()
 
-	// Always derive category from action - eventCategories map is the source of truth
-	category := audit.AuditEvent(event.Action).Category()
+	// Always derive category from action - eventCategories map, plus any
+	// configured overrides, is the source of truth
+	category := s.router.Category(audit.AuditEvent(event.Action))
 
 	// Build JSON payload for Kafka
 	payload := outboxPayload{
@@ -77,6 +97,8 @@ func (s *Store) Append(ctx context.Context, event audit.Event) error {
 		Email:           event.Email,
 		RequestID:       event.RequestID,
 		ActorID:         event.ActorID,
+		SubjectIDHash:   event.SubjectIDHash,
+		Metadata:        event.Metadata,
 	}
 	if !event.UserID.IsNil() {
 		payload.UserID = uuid.UUID(event.UserID).String()
@@ -102,6 +124,7 @@ func (s *Store) Append(ctx context.Context, event audit.Event) error {
 		EventType:     event.Action,
 		Payload:       json.RawMessage(payloadBytes),
 		CreatedAt:     time.Now(),
+		DedupKey:      sql.NullString{String: event.DedupKey, Valid: event.DedupKey != ""},
 	})
 	if err != nil {
 		return fmt.Errorf("insert outbox entry: %w", err)
@@ -117,6 +140,10 @@ func (s *Store) AppendWithID(ctx context.Context, eventID uuid.UUID, event audit
 	if !event.UserID.IsNil() {
 		userID = uuid.NullUUID{UUID: uuid.UUID(event.UserID), Valid: true}
 	}
+	metadata, err := marshalMetadata(event.Metadata)
+	if err != nil {
+		return err
+	}
 
 	if err := s.queries.InsertAuditEvent(ctx, auditsqlc.InsertAuditEventParams{
 		ID:              eventID,
@@ -132,6 +159,8 @@ func (s *Store) AppendWithID(ctx context.Context, eventID uuid.UUID, event audit
 		Email:           event.Email,
 		RequestID:       event.RequestID,
 		ActorID:         event.ActorID,
+		SubjectIDHash:   event.SubjectIDHash,
+		Metadata:        metadata,
 	}); err != nil {
 		return fmt.Errorf("insert audit event: %w", err)
 	}
@@ -147,6 +176,104 @@ func (s *Store) ListByUser(ctx context.Context, userID id.UserID) ([]audit.Event
 	return mapAuditEvents(toAuditEventRowsFromByUser(rows)), nil
 }
 
+// ListByUserPaged returns up to limit of userID's events, newest first,
+// starting after cursor (nil for the first page). next marks where the
+// following page starts and is nil on the last page. A limit outside
+// 1..maxQueryLimit uses maxQueryLimit.
+func (s *Store) ListByUserPaged(ctx context.Context, userID id.UserID, cursor *audit.AuditCursor, limit int) (events []audit.Event, next *audit.AuditCursor, err error) {
+	if limit <= 0 || limit > maxQueryLimit {
+		limit = maxQueryLimit
+	}
+	user := uuid.NullUUID{UUID: uuid.UUID(userID), Valid: true}
+	// One extra row tells whether another page follows.
+	pageLimit := int32(limit + 1) //nolint:gosec // bounded by maxQueryLimit
+
+	var rows []auditEventRow
+	if cursor == nil {
+		page, err := s.queries.ListAuditEventsByUserFirstPage(ctx, auditsqlc.ListAuditEventsByUserFirstPageParams{
+			UserID:    user,
+			PageLimit: pageLimit,
+		})
+		if err != nil {
+			return nil, nil, fmt.Errorf("query audit events: %w", err)
+		}
+		rows = toAuditEventRowsFromByUserFirstPage(page)
+	} else {
+		page, err := s.queries.ListAuditEventsByUserAfter(ctx, auditsqlc.ListAuditEventsByUserAfterParams{
+			UserID:          user,
+			CursorTimestamp: cursor.Timestamp,
+			CursorID:        cursor.ID,
+			PageLimit:       pageLimit,
+		})
+		if err != nil {
+			return nil, nil, fmt.Errorf("query audit events: %w", err)
+		}
+		rows = toAuditEventRowsFromByUserAfter(page)
+	}
+
+	if len(rows) > limit {
+		rows = rows[:limit]
+		last := rows[len(rows)-1]
+		next = &audit.AuditCursor{Timestamp: last.Timestamp, ID: last.ID}
+	}
+	return mapAuditEvents(rows), next, nil
+}
+
+// ListBySubjectHash returns events carrying subjectIDHash, including the
+// anonymized events of erased users.
+func (s *Store) ListBySubjectHash(ctx context.Context, subjectIDHash string) ([]audit.Event, error) {
+	rows, err := s.queries.ListAuditEventsBySubjectHash(ctx, subjectIDHash)
+	if err != nil {
+		return nil, fmt.Errorf("query audit events: %w", err)
+	}
+	return mapAuditEvents(toAuditEventRowsFromBySubjectHash(rows)), nil
+}
+
+// ListByRequestID returns the events recorded for a single request, oldest first,
+// so a flow can be replayed in the order it happened.
+func (s *Store) ListByRequestID(ctx context.Context, requestID string) ([]audit.Event, error) {
+	rows, err := s.queries.ListAuditEventsByRequestID(ctx, requestID)
+	if err != nil {
+		return nil, fmt.Errorf("query audit events: %w", err)
+	}
+	return mapAuditEvents(toAuditEventRowsFromByRequestID(rows)), nil
+}
+
+// AnonymizeUser scrubs email and subject from every event of userID, replacing
+// the subject with subjectIDHash. Events already materialized in audit_events
+// and every outbox entry for the user, published or not, are rewritten in one
+// transaction, so a later replay never re-publishes the PII. Messages already
+// published to Kafka are outside this store.
+func (s *Store) AnonymizeUser(ctx context.Context, userID id.UserID, subjectIDHash string) (int, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("begin anonymize tx: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback() //nolint:errcheck // rollback after commit is no-op; error already captured
+	}()
+
+	q := s.queries.WithTx(tx)
+	events, err := q.AnonymizeAuditEventsByUser(ctx, auditsqlc.AnonymizeAuditEventsByUserParams{
+		SubjectIDHash: subjectIDHash,
+		UserID:        uuid.NullUUID{UUID: uuid.UUID(userID), Valid: true},
+	})
+	if err != nil {
+		return 0, fmt.Errorf("anonymize audit events: %w", err)
+	}
+	entries, err := q.AnonymizeOutboxEntriesByUser(ctx, auditsqlc.AnonymizeOutboxEntriesByUserParams{
+		SubjectIDHash: subjectIDHash,
+		AggregateID:   uuid.UUID(userID).String(),
+	})
+	if err != nil {
+		return 0, fmt.Errorf("anonymize outbox entries: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("commit anonymize tx: %w", err)
+	}
+	return int(events + entries), nil
+}
+
 // ListAll returns all audit events (admin only).
 func (s *Store) ListAll(ctx context.Context) ([]audit.Event, error) {
 	rows, err := s.queries.ListAuditEvents(ctx)
@@ -167,6 +294,7 @@ func (s *Store) ListRecent(ctx context.Context, limit int) ([]audit.Event, error
 }
 
 type auditEventRow struct {
+	ID              uuid.UUID // Only set by paged queries, which need it for the cursor
 	Timestamp       time.Time
 	UserID          uuid.NullUUID
 	Category        string
@@ -179,6 +307,8 @@ type auditEventRow struct {
 	Email           string
 	RequestID       string
 	ActorID         string
+	SubjectIDHash   string
+	Metadata        json.RawMessage
 }
 
 func mapAuditEvents(rows []auditEventRow) []audit.Event {
@@ -205,6 +335,102 @@ func toAuditEventRowsFromByUser(rows []auditsqlc.ListAuditEventsByUserRow) []aud
 			Email:           row.Email,
 			RequestID:       row.RequestID,
 			ActorID:         row.ActorID,
+			SubjectIDHash:   row.SubjectIDHash,
+			Metadata:        row.Metadata,
+		})
+	}
+	return events
+}
+
+func toAuditEventRowsFromByUserFirstPage(rows []auditsqlc.ListAuditEventsByUserFirstPageRow) []auditEventRow {
+	events := make([]auditEventRow, 0, len(rows))
+	for _, row := range rows {
+		events = append(events, auditEventRow{
+			ID:              row.ID,
+			Category:        row.Category,
+			Timestamp:       row.Timestamp,
+			UserID:          row.UserID,
+			Subject:         row.Subject,
+			Action:          row.Action,
+			Purpose:         row.Purpose,
+			RequestingParty: row.RequestingParty,
+			Decision:        row.Decision,
+			Reason:          row.Reason,
+			Email:           row.Email,
+			RequestID:       row.RequestID,
+			ActorID:         row.ActorID,
+			SubjectIDHash:   row.SubjectIDHash,
+			Metadata:        row.Metadata,
+		})
+	}
+	return events
+}
+
+func toAuditEventRowsFromByUserAfter(rows []auditsqlc.ListAuditEventsByUserAfterRow) []auditEventRow {
+	events := make([]auditEventRow, 0, len(rows))
+	for _, row := range rows {
+		events = append(events, auditEventRow{
+			ID:              row.ID,
+			Category:        row.Category,
+			Timestamp:       row.Timestamp,
+			UserID:          row.UserID,
+			Subject:         row.Subject,
+			Action:          row.Action,
+			Purpose:         row.Purpose,
+			RequestingParty: row.RequestingParty,
+			Decision:        row.Decision,
+			Reason:          row.Reason,
+			Email:           row.Email,
+			RequestID:       row.RequestID,
+			ActorID:         row.ActorID,
+			SubjectIDHash:   row.SubjectIDHash,
+			Metadata:        row.Metadata,
+		})
+	}
+	return events
+}
+
+func toAuditEventRowsFromBySubjectHash(rows []auditsqlc.ListAuditEventsBySubjectHashRow) []auditEventRow {
+	events := make([]auditEventRow, 0, len(rows))
+	for _, row := range rows {
+		events = append(events, auditEventRow{
+			Category:        row.Category,
+			Timestamp:       row.Timestamp,
+			UserID:          row.UserID,
+			Subject:         row.Subject,
+			Action:          row.Action,
+			Purpose:         row.Purpose,
+			RequestingParty: row.RequestingParty,
+			Decision:        row.Decision,
+			Reason:          row.Reason,
+			Email:           row.Email,
+			RequestID:       row.RequestID,
+			ActorID:         row.ActorID,
+			SubjectIDHash:   row.SubjectIDHash,
+			Metadata:        row.Metadata,
+		})
+	}
+	return events
+}
+
+func toAuditEventRowsFromByRequestID(rows []auditsqlc.ListAuditEventsByRequestIDRow) []auditEventRow {
+	events := make([]auditEventRow, 0, len(rows))
+	for _, row := range rows {
+		events = append(events, auditEventRow{
+			Category:        row.Category,
+			Timestamp:       row.Timestamp,
+			UserID:          row.UserID,
+			Subject:         row.Subject,
+			Action:          row.Action,
+			Purpose:         row.Purpose,
+			RequestingParty: row.RequestingParty,
+			Decision:        row.Decision,
+			Reason:          row.Reason,
+			Email:           row.Email,
+			RequestID:       row.RequestID,
+			ActorID:         row.ActorID,
+			SubjectIDHash:   row.SubjectIDHash,
+			Metadata:        row.Metadata,
 		})
 	}
 	return events
@@ -226,6 +452,8 @@ func toAuditEventRowsFromAll(rows []auditsqlc.ListAuditEventsRow) []auditEventRo
 			Email:           row.Email,
 			RequestID:       row.RequestID,
 			ActorID:         row.ActorID,
+			SubjectIDHash:   row.SubjectIDHash,
+			Metadata:        row.Metadata,
 		})
 	}
 	return events
@@ -247,6 +475,8 @@ func toAuditEventRowsFromRecent(rows []auditsqlc.ListRecentAuditEventsRow) []aud
 			Email:           row.Email,
 			RequestID:       row.RequestID,
 			ActorID:         row.ActorID,
+			SubjectIDHash:   row.SubjectIDHash,
+			Metadata:        row.Metadata,
 		})
 	}
 	return events
@@ -265,9 +495,37 @@ func toAuditEvent(row auditEventRow) audit.Event {
 		Email:           row.Email,
 		RequestID:       row.RequestID,
 		ActorID:         row.ActorID,
+		SubjectIDHash:   row.SubjectIDHash,
 	}
 	if row.UserID.Valid {
 		event.UserID = id.UserID(row.UserID.UUID)
 	}
+	event.Metadata = unmarshalMetadata(row.Metadata)
 	return event
 }
+
+// marshalMetadata encodes event metadata for the JSONB column, storing an
+// empty object rather than null so the column default holds.
+func marshalMetadata(metadata map[string]string) (json.RawMessage, error) {
+	if len(metadata) == 0 {
+		return json.RawMessage(`{}`), nil
+	}
+	b, err := json.Marshal(metadata)
+	if err != nil {
+		return nil, fmt.Errorf("marshal audit metadata: %w", err)
+	}
+	return b, nil
+}
+
+// unmarshalMetadata decodes the metadata column. Malformed or empty metadata
+// yields nil so a bad row never hides the rest of the event.
+func unmarshalMetadata(raw json.RawMessage) map[string]string {
+	if len(raw) == 0 {
+		return nil
+	}
+	var metadata map[string]string
+	if err := json.Unmarshal(raw, &metadata); err != nil || len(metadata) == 0 {
+		return nil
+	}
+	return metadata
+}
@@ -0,0 +1,478 @@
+//go:build integration
+
+package postgres_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	id "credo/pkg/domain"
+	audit "credo/pkg/platform/audit"
+	outboxpostgres "credo/pkg/platform/audit/outbox/store/postgres"
+	auditpostgres "credo/pkg/platform/audit/store/postgres"
+	"credo/pkg/testutil/containers"
+)
+
+type AnonymizeIntegrationSuite struct {
+	suite.Suite
+	postgres *containers.PostgresContainer
+	store    *auditpostgres.Store
+}
+
+func TestAnonymizeIntegrationSuite(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+	suite.Run(t, new(AnonymizeIntegrationSuite))
+}
+
+func (s *AnonymizeIntegrationSuite) SetupSuite() {
+	mgr := containers.GetManager()
+	s.postgres = mgr.GetPostgres(s.T())
+	s.store = auditpostgres.New(s.postgres.DB)
+}
+
+func (s *AnonymizeIntegrationSuite) SetupTest() {
+	s.Require().NoError(s.postgres.TruncateAll(context.Background()))
+}
+
+// TestAnonymizeUser verifies that erasure scrubs PII from materialized events
+// and pending outbox entries while the events stay queryable by hash.
+// Invariant: other users' events are untouched.
+func (s *AnonymizeIntegrationSuite) TestAnonymizeUser() {
+	ctx := context.Background()
+	userID := id.UserID(uuid.New())
+	otherID := id.UserID(uuid.New())
+	hash := "5f4dcc3b5aa765d61d8327deb882cf995f4dcc3b5aa765d61d8327deb882cf99"
+
+	seed := func(userID id.UserID, action, email string) {
+		s.Require().NoError(s.store.AppendWithID(ctx, uuid.New(), audit.Event{
+			Category:  audit.CategoryCompliance,
+			Timestamp: time.Now().UTC(),
+			UserID:    userID,
+			Subject:   email,
+			Action:    action,
+			Email:     email,
+		}))
+	}
+	seed(userID, "user_created", "alice@example.com")
+	seed(userID, "consent_granted", "alice@example.com")
+	seed(otherID, "user_created", "bob@example.com")
+
+	// Not yet published to Kafka: the outbox payload must be scrubbed too.
+	s.Require().NoError(s.store.Append(ctx, audit.Event{
+		Timestamp: time.Now().UTC(),
+		UserID:    userID,
+		Subject:   "alice@example.com",
+		Action:    "session_created",
+		Email:     "alice@example.com",
+	}))
+
+	count, err := s.store.AnonymizeUser(ctx, userID, hash)
+	s.Require().NoError(err)
+	s.Equal(3, count)
+
+	s.Run("materialized events are scrubbed but retained", func() {
+		byHash, err := s.store.ListBySubjectHash(ctx, hash)
+		s.Require().NoError(err)
+		s.Len(byHash, 2)
+		for _, e := range byHash {
+			s.Equal(userID, e.UserID)
+			s.Empty(e.Email)
+			s.Equal(hash, e.Subject)
+			s.Equal(hash, e.SubjectIDHash)
+		}
+	})
+
+	s.Run("pending outbox payload is scrubbed", func() {
+		var raw []byte
+		err := s.postgres.QueryRow(ctx,
+			`SELECT payload FROM outbox WHERE aggregate_id = $1`, uuid.UUID(userID).String(),
+		).Scan(&raw)
+		s.Require().NoError(err)
+
+		var payload map[string]any
+		s.Require().NoError(json.Unmarshal(raw, &payload))
+		s.NotContains(payload, "Email")
+		s.Equal(hash, payload["Subject"])
+		s.Equal(hash, payload["SubjectIDHash"])
+	})
+
+	s.Run("other users are untouched", func() {
+		events, err := s.store.ListByUser(ctx, otherID)
+		s.Require().NoError(err)
+		s.Require().Len(events, 1)
+		s.Equal("bob@example.com", events[0].Email)
+		s.Equal("bob@example.com", events[0].Subject)
+	})
+}
+
+// TestAnonymizeUserBeforeReplay verifies that erasure also scrubs outbox entries
+// already published, so replaying their range does not re-publish the PII.
+func (s *AnonymizeIntegrationSuite) TestAnonymizeUserBeforeReplay() {
+	ctx := context.Background()
+	userID := id.UserID(uuid.New())
+	hash := "5f4dcc3b5aa765d61d8327deb882cf995f4dcc3b5aa765d61d8327deb882cf99"
+	outbox := outboxpostgres.New(s.postgres.DB)
+
+	start := time.Now().Add(-time.Minute)
+	s.Require().NoError(s.store.Append(ctx, audit.Event{
+		Timestamp: time.Now().UTC(),
+		UserID:    userID,
+		Subject:   "alice@example.com",
+		Action:    "session_created",
+		Email:     "alice@example.com",
+	}))
+	published, err := outbox.FetchUnprocessed(ctx, 10)
+	s.Require().NoError(err)
+	s.Require().Len(published, 1)
+	s.Require().NoError(outbox.MarkProcessed(ctx, published[0].ID, time.Now()))
+
+	_, err = s.store.AnonymizeUser(ctx, userID, hash)
+	s.Require().NoError(err)
+
+	requeued, err := outbox.RequeueProcessedBetween(ctx, start, time.Now().Add(time.Minute))
+	s.Require().NoError(err)
+	s.Equal(int64(1), requeued)
+
+	replayed, err := outbox.FetchUnprocessed(ctx, 10)
+	s.Require().NoError(err)
+	s.Require().Len(replayed, 1)
+	var payload map[string]any
+	s.Require().NoError(json.Unmarshal(replayed[0].Payload, &payload))
+	s.NotContains(payload, "Email")
+	s.Equal(hash, payload["Subject"])
+	s.NotContains(string(replayed[0].Payload), "alice@example.com")
+}
+
+type RequestTraceIntegrationSuite struct {
+	suite.Suite
+	postgres *containers.PostgresContainer
+	store    *auditpostgres.Store
+}
+
+func TestRequestTraceIntegrationSuite(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+	suite.Run(t, new(RequestTraceIntegrationSuite))
+}
+
+func (s *RequestTraceIntegrationSuite) SetupSuite() {
+	mgr := containers.GetManager()
+	s.postgres = mgr.GetPostgres(s.T())
+	s.store = auditpostgres.New(s.postgres.DB)
+}
+
+func (s *RequestTraceIntegrationSuite) SetupTest() {
+	s.Require().NoError(s.postgres.TruncateAll(context.Background()))
+}
+
+// TestListByRequestID verifies a request's events come back oldest first,
+// regardless of insertion order, and that other requests are excluded.
+func (s *RequestTraceIntegrationSuite) TestListByRequestID() {
+	ctx := context.Background()
+	userID := id.UserID(uuid.New())
+	requestID := "req-" + uuid.NewString()
+	start := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	seed := func(requestID, action string, at time.Time, metadata map[string]string) {
+		s.Require().NoError(s.store.AppendWithID(ctx, uuid.New(), audit.Event{
+			Category:  audit.AuditEvent(action).Category(),
+			Timestamp: at,
+			UserID:    userID,
+			Action:    action,
+			RequestID: requestID,
+			Metadata:  metadata,
+		}))
+	}
+	// Inserted out of order: consumers materialize events as Kafka delivers them.
+	seed(requestID, string(audit.EventTokenIssued), start.Add(3*time.Millisecond), nil)
+	seed(requestID, string(audit.EventRateLimitExceeded), start, nil)
+	seed(requestID, string(audit.EventConsentChecked), start.Add(2*time.Millisecond), nil)
+	seed(requestID, string(audit.EventLoginSucceeded), start.Add(time.Millisecond), map[string]string{audit.MetadataIP: "203.0.113.0"})
+	seed("req-other", string(audit.EventTokenIssued), start.Add(time.Millisecond), nil)
+
+	events, err := s.store.ListByRequestID(ctx, requestID)
+	s.Require().NoError(err)
+
+	actions := make([]string, 0, len(events))
+	for _, e := range events {
+		s.Equal(requestID, e.RequestID)
+		actions = append(actions, e.Action)
+	}
+	s.Equal([]string{
+		string(audit.EventRateLimitExceeded),
+		string(audit.EventLoginSucceeded),
+		string(audit.EventConsentChecked),
+		string(audit.EventTokenIssued),
+	}, actions)
+	s.Equal("203.0.113.0", events[1].Metadata[audit.MetadataIP], "metadata round-trips through the JSONB column")
+
+	s.Run("unknown request returns no events", func() {
+		events, err := s.store.ListByRequestID(ctx, "req-missing")
+		s.Require().NoError(err)
+		s.Empty(events)
+	})
+}
+
+type QueryIntegrationSuite struct {
+	suite.Suite
+	postgres *containers.PostgresContainer
+	store    *auditpostgres.Store
+}
+
+func TestQueryIntegrationSuite(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+	suite.Run(t, new(QueryIntegrationSuite))
+}
+
+func (s *QueryIntegrationSuite) SetupSuite() {
+	mgr := containers.GetManager()
+	s.postgres = mgr.GetPostgres(s.T())
+	s.store = auditpostgres.New(s.postgres.DB)
+}
+
+func (s *QueryIntegrationSuite) SetupTest() {
+	s.Require().NoError(s.postgres.TruncateAll(context.Background()))
+}
+
+// TestQuery verifies each filter dimension alone and combined, with results
+// newest first.
+func (s *QueryIntegrationSuite) TestQuery() {
+	ctx := context.Background()
+	alice := id.UserID(uuid.New())
+	bob := id.UserID(uuid.New())
+	start := time.Date(2026, 4, 1, 12, 0, 0, 0, time.UTC)
+
+	seed := func(userID id.UserID, action string, at time.Time) {
+		s.Require().NoError(s.store.AppendWithID(ctx, uuid.New(), audit.Event{
+			Category:  audit.AuditEvent(action).Category(),
+			Timestamp: at,
+			UserID:    userID,
+			Action:    action,
+		}))
+	}
+	seed(alice, string(audit.EventUserCreated), start)
+	seed(alice, string(audit.EventLoginSucceeded), start.Add(time.Hour))
+	seed(bob, string(audit.EventUserCreated), start.Add(2*time.Hour))
+	seed(bob, string(audit.EventRateLimitExceeded), start.Add(3*time.Hour))
+	seed(alice, string(audit.EventConsentGranted), start.Add(4*time.Hour))
+
+	actions := func(filter audit.AuditFilter) []string {
+		events, err := s.store.Query(ctx, filter)
+		s.Require().NoError(err)
+		got := make([]string, 0, len(events))
+		for _, e := range events {
+			got = append(got, e.Action)
+		}
+		return got
+	}
+
+	s.Run("no filter returns every event newest first", func() {
+		s.Equal([]string{
+			string(audit.EventConsentGranted),
+			string(audit.EventRateLimitExceeded),
+			string(audit.EventUserCreated),
+			string(audit.EventLoginSucceeded),
+			string(audit.EventUserCreated),
+		}, actions(audit.AuditFilter{}))
+	})
+
+	s.Run("category", func() {
+		events, err := s.store.Query(ctx, audit.AuditFilter{Category: audit.CategorySecurity})
+		s.Require().NoError(err)
+		s.Require().NotEmpty(events)
+		for _, e := range events {
+			s.Equal(audit.CategorySecurity, e.Category)
+		}
+	})
+
+	s.Run("time range is inclusive", func() {
+		s.Equal([]string{
+			string(audit.EventRateLimitExceeded),
+			string(audit.EventUserCreated),
+			string(audit.EventLoginSucceeded),
+		}, actions(audit.AuditFilter{From: start.Add(time.Hour), To: start.Add(3 * time.Hour)}))
+		s.Len(actions(audit.AuditFilter{From: start.Add(4 * time.Hour)}), 1)
+		s.Len(actions(audit.AuditFilter{To: start}), 1)
+	})
+
+	s.Run("user", func() {
+		s.Equal([]string{
+			string(audit.EventRateLimitExceeded),
+			string(audit.EventUserCreated),
+		}, actions(audit.AuditFilter{UserID: bob}))
+	})
+
+	s.Run("action", func() {
+		events, err := s.store.Query(ctx, audit.AuditFilter{Action: string(audit.EventUserCreated)})
+		s.Require().NoError(err)
+		s.Require().Len(events, 2)
+		s.Equal(bob, events[0].UserID)
+		s.Equal(alice, events[1].UserID)
+	})
+
+	s.Run("limit keeps the newest events", func() {
+		s.Equal([]string{
+			string(audit.EventConsentGranted),
+			string(audit.EventRateLimitExceeded),
+		}, actions(audit.AuditFilter{Limit: 2}))
+	})
+
+	s.Run("combined filters all apply", func() {
+		s.Equal([]string{string(audit.EventUserCreated)}, actions(audit.AuditFilter{
+			Category: audit.EventUserCreated.Category(),
+			From:     start,
+			To:       start.Add(3 * time.Hour),
+			UserID:   alice,
+			Action:   string(audit.EventUserCreated),
+			Limit:    10,
+		}))
+	})
+
+	s.Run("quoted input matches literally", func() {
+		s.Empty(actions(audit.AuditFilter{Action: "x' OR '1'='1"}))
+	})
+}
+
+// TestListByUserPaged verifies paging through a user's events visits each
+// event exactly once, newest first, including events sharing a timestamp.
+func (s *QueryIntegrationSuite) TestListByUserPaged() {
+	ctx := context.Background()
+	userID := id.UserID(uuid.New())
+	start := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	const total = 7
+	for i := range total {
+		// Pairs share a timestamp so the cursor must break ties by ID.
+		s.Require().NoError(s.store.AppendWithID(ctx, uuid.New(), audit.Event{
+			Category:  audit.CategoryOperations,
+			Timestamp: start.Add(time.Duration(i/2) * time.Minute),
+			UserID:    userID,
+			Action:    fmt.Sprintf("action_%d", i),
+		}))
+	}
+	s.Require().NoError(s.store.AppendWithID(ctx, uuid.New(), audit.Event{
+		Category:  audit.CategoryOperations,
+		Timestamp: start,
+		UserID:    id.UserID(uuid.New()),
+		Action:    "other_user",
+	}))
+
+	s.Run("pages cover every event once without gaps", func() {
+		var seen []string
+		var pages int
+		var cursor *audit.AuditCursor
+		var previous time.Time
+		for {
+			events, next, err := s.store.ListByUserPaged(ctx, userID, cursor, 3)
+			s.Require().NoError(err)
+			pages++
+			for _, e := range events {
+				s.Equal(userID, e.UserID)
+				if !previous.IsZero() {
+					s.False(e.Timestamp.After(previous), "events are newest first")
+				}
+				previous = e.Timestamp
+				seen = append(seen, e.Action)
+			}
+			if next == nil {
+				break
+			}
+			s.Require().Len(events, 3, "only the last page may be short")
+			s.Require().Less(pages, total, "paging must terminate")
+			cursor = next
+		}
+
+		s.Equal(3, pages)
+		s.Len(seen, total)
+		unique := make(map[string]bool, len(seen))
+		for _, action := range seen {
+			unique[action] = true
+		}
+		s.Len(unique, total, "no event is returned twice")
+		s.NotContains(seen, "other_user")
+	})
+
+	s.Run("a page that ends exactly at the last event has no next cursor", func() {
+		events, next, err := s.store.ListByUserPaged(ctx, userID, nil, total)
+		s.Require().NoError(err)
+		s.Len(events, total)
+		s.Nil(next)
+	})
+
+	s.Run("user without events returns an empty last page", func() {
+		events, next, err := s.store.ListByUserPaged(ctx, id.UserID(uuid.New()), nil, 3)
+		s.Require().NoError(err)
+		s.Empty(events)
+		s.Nil(next)
+	})
+}
+
+type OutboxDedupIntegrationSuite struct {
+	suite.Suite
+	postgres *containers.PostgresContainer
+	store    *auditpostgres.Store
+}
+
+func TestOutboxDedupIntegrationSuite(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+	suite.Run(t, new(OutboxDedupIntegrationSuite))
+}
+
+func (s *OutboxDedupIntegrationSuite) SetupSuite() {
+	mgr := containers.GetManager()
+	s.postgres = mgr.GetPostgres(s.T())
+	s.store = auditpostgres.New(s.postgres.DB)
+}
+
+func (s *OutboxDedupIntegrationSuite) SetupTest() {
+	s.Require().NoError(s.postgres.TruncateAll(context.Background()))
+}
+
+func (s *OutboxDedupIntegrationSuite) countOutbox(ctx context.Context) int {
+	var count int
+	s.Require().NoError(s.postgres.QueryRow(ctx, `SELECT COUNT(*) FROM outbox`).Scan(&count))
+	return count
+}
+
+// TestAppendDedupKey verifies a retried append carrying the same dedup key
+// leaves exactly one outbox row.
+// Invariant: events without a dedup key are never deduplicated.
+func (s *OutboxDedupIntegrationSuite) TestAppendDedupKey() {
+	ctx := context.Background()
+	event := audit.Event{
+		Timestamp: time.Now().UTC(),
+		UserID:    id.UserID(uuid.New()),
+		Subject:   "alice@example.com",
+		Action:    string(audit.EventSessionCreated),
+	}
+
+	s.Run("same dedup key appends once", func() {
+		keyed := event
+		keyed.DedupKey = "session_created:" + uuid.NewString()
+
+		s.Require().NoError(s.store.Append(ctx, keyed))
+		s.Require().NoError(s.store.Append(ctx, keyed), "retry must not fail")
+		s.Equal(1, s.countOutbox(ctx))
+	})
+
+	s.Run("events without a dedup key are all kept", func() {
+		s.Require().NoError(s.postgres.TruncateAll(ctx))
+
+		s.Require().NoError(s.store.Append(ctx, event))
+		s.Require().NoError(s.store.Append(ctx, event))
+		s.Equal(2, s.countOutbox(ctx))
+	})
+}
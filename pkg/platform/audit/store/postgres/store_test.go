@@ -2,8 +2,15 @@ package postgres
 
 import (
 	"math"
+	"strconv"
+	"strings"
 	"testing"
+	"time"
 
+	id "credo/pkg/domain"
+	audit "credo/pkg/platform/audit"
+
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -51,3 +58,93 @@ func TestListRecent_ClampsLimitToInt32Max(t *testing.T) {
 		})
 	}
 }
+
+func TestBuildAuditQuery(t *testing.T) {
+	// The query text is assembled per call, so these cases pin that every
+	// filter value travels as a bind parameter and only the intended
+	// predicates are emitted.
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := from.Add(24 * time.Hour)
+	userID := id.UserID(uuid.New())
+
+	tests := []struct {
+		name       string
+		filter     audit.AuditFilter
+		predicates []string
+		args       []any
+	}{
+		{
+			name: "no filter lists newest events up to the cap",
+			args: []any{maxQueryLimit},
+		},
+		{
+			name:       "category",
+			filter:     audit.AuditFilter{Category: audit.CategorySecurity},
+			predicates: []string{"category = $1"},
+			args:       []any{"security", maxQueryLimit},
+		},
+		{
+			name:       "from",
+			filter:     audit.AuditFilter{From: from},
+			predicates: []string{"timestamp >= $1"},
+			args:       []any{from, maxQueryLimit},
+		},
+		{
+			name:       "to",
+			filter:     audit.AuditFilter{To: to},
+			predicates: []string{"timestamp <= $1"},
+			args:       []any{to, maxQueryLimit},
+		},
+		{
+			name:       "user",
+			filter:     audit.AuditFilter{UserID: userID},
+			predicates: []string{"user_id = $1"},
+			args:       []any{uuid.UUID(userID), maxQueryLimit},
+		},
+		{
+			name:       "action",
+			filter:     audit.AuditFilter{Action: "user_created"},
+			predicates: []string{"action = $1"},
+			args:       []any{"user_created", maxQueryLimit},
+		},
+		{
+			name: "combined filters number parameters in order",
+			filter: audit.AuditFilter{
+				Category: audit.CategoryCompliance,
+				From:     from,
+				To:       to,
+				UserID:   userID,
+				Action:   "consent_granted",
+				Limit:    50,
+			},
+			predicates: []string{"category = $1", "timestamp >= $2", "timestamp <= $3", "user_id = $4", "action = $5"},
+			args:       []any{"compliance", from, to, uuid.UUID(userID), "consent_granted", 50},
+		},
+		{
+			name:   "limit above the cap is clamped",
+			filter: audit.AuditFilter{Limit: maxQueryLimit + 1},
+			args:   []any{maxQueryLimit},
+		},
+		{
+			name:       "user input is bound, never inlined",
+			filter:     audit.AuditFilter{Action: "x' OR '1'='1"},
+			predicates: []string{"action = $1"},
+			args:       []any{"x' OR '1'='1", maxQueryLimit},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			query, args := buildAuditQuery(tt.filter)
+
+			assert.Equal(t, tt.args, args)
+			assert.True(t, strings.HasSuffix(query, "ORDER BY timestamp DESC, id DESC\nLIMIT $"+strconv.Itoa(len(args))))
+			assert.NotContains(t, query, "'")
+			for _, predicate := range tt.predicates {
+				assert.Contains(t, query, predicate)
+			}
+			assert.Equal(t, len(tt.predicates) > 0, strings.Contains(query, "WHERE"))
+			assert.Equal(t, max(len(tt.predicates)-1, 0), strings.Count(query, " AND "))
+		})
+	}
+}
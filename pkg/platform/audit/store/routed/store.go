@@ -0,0 +1,159 @@
+// Package routed provides an audit.Store that dispatches each event to a
+// backend chosen by its category, so compliance, security, and operations
+// events can live in stores with different guarantees behind the same publishers.
+package routed
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	id "credo/pkg/domain"
+	audit "credo/pkg/platform/audit"
+)
+
+// categories lists every category that must have a backend.
+var categories = []audit.EventCategory{
+	audit.CategoryCompliance,
+	audit.CategorySecurity,
+	audit.CategoryOperations,
+}
+
+// Store implements audit.Store by routing appends to a per-category backend.
+// Reads fan out to every distinct backend and merge the results.
+type Store struct {
+	backends map[audit.EventCategory]audit.Store
+	distinct []audit.Store
+	router   *audit.CategoryRouter
+}
+
+// Option configures the Store.
+type Option func(*Store)
+
+// WithCategoryRouter applies operator category overrides when choosing an
+// event's backend, matching how the Postgres store derives the category.
+func WithCategoryRouter(router *audit.CategoryRouter) Option {
+	return func(s *Store) {
+		s.router = router
+	}
+}
+
+// New creates a routed store. Every category must have a backend and no
+// backend may be registered for an unknown category, so a wiring mistake fails
+// at startup instead of dropping compliance events at runtime. One backend may
+// serve several categories.
+func New(backends map[audit.EventCategory]audit.Store, opts ...Option) (*Store, error) {
+	var errs []error
+	for _, category := range categories {
+		if backends[category] == nil {
+			errs = append(errs, fmt.Errorf("no audit backend configured for category %q", category))
+		}
+	}
+	for category := range backends {
+		if !category.IsValid() {
+			errs = append(errs, fmt.Errorf("audit backend configured for unknown category %q", category))
+		}
+	}
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+
+	s := &Store{backends: make(map[audit.EventCategory]audit.Store, len(categories))}
+	for _, category := range categories {
+		backend := backends[category]
+		s.backends[category] = backend
+		if !containsStore(s.distinct, backend) {
+			s.distinct = append(s.distinct, backend)
+		}
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s, nil
+}
+
+func containsStore(stores []audit.Store, target audit.Store) bool {
+	for _, st := range stores {
+		if st == target {
+			return true
+		}
+	}
+	return false
+}
+
+// Append writes event to the backend for its category.
+func (s *Store) Append(ctx context.Context, event audit.Event) error {
+	category := s.router.Category(audit.AuditEvent(event.Action))
+	return s.backends[category].Append(ctx, event)
+}
+
+// ListByUser returns the user's events from every backend, oldest first.
+func (s *Store) ListByUser(ctx context.Context, userID id.UserID) ([]audit.Event, error) {
+	return s.collect(func(st audit.Store) ([]audit.Event, error) {
+		return st.ListByUser(ctx, userID)
+	})
+}
+
+// ListAll returns every event from every backend, oldest first.
+func (s *Store) ListAll(ctx context.Context) ([]audit.Event, error) {
+	return s.collect(func(st audit.Store) ([]audit.Event, error) {
+		return st.ListAll(ctx)
+	})
+}
+
+// ListByRequestID returns the request's events from every backend, oldest first.
+func (s *Store) ListByRequestID(ctx context.Context, requestID string) ([]audit.Event, error) {
+	return s.collect(func(st audit.Store) ([]audit.Event, error) {
+		return st.ListByRequestID(ctx, requestID)
+	})
+}
+
+// ListRecent returns the limit most recent events across all backends, newest first.
+func (s *Store) ListRecent(ctx context.Context, limit int) ([]audit.Event, error) {
+	events, err := s.collect(func(st audit.Store) ([]audit.Event, error) {
+		return st.ListRecent(ctx, limit)
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(events, func(i, j int) bool { return events[i].Timestamp.After(events[j].Timestamp) })
+	if limit >= 0 && len(events) > limit {
+		events = events[:limit]
+	}
+	return events, nil
+}
+
+// AnonymizeUser scrubs the user's events in every backend that supports it.
+// Returns the total number of events rewritten.
+func (s *Store) AnonymizeUser(ctx context.Context, userID id.UserID, subjectIDHash string) (int, error) {
+	total := 0
+	for _, st := range s.distinct {
+		anonymizer, ok := st.(audit.Anonymizer)
+		if !ok {
+			continue
+		}
+		n, err := anonymizer.AnonymizeUser(ctx, userID, subjectIDHash)
+		if err != nil {
+			return total, fmt.Errorf("anonymize audit events: %w", err)
+		}
+		total += n
+	}
+	return total, nil
+}
+
+// collect runs list against every distinct backend and merges the results
+// oldest first. A failing backend fails the whole read rather than returning a
+// silently incomplete trail.
+func (s *Store) collect(list func(audit.Store) ([]audit.Event, error)) ([]audit.Event, error) {
+	var merged []audit.Event
+	for _, st := range s.distinct {
+		events, err := list(st)
+		if err != nil {
+			return nil, err
+		}
+		merged = append(merged, events...)
+	}
+	sort.SliceStable(merged, func(i, j int) bool { return merged[i].Timestamp.Before(merged[j].Timestamp) })
+	return merged, nil
+}
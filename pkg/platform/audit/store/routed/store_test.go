@@ -0,0 +1,203 @@
+package routed_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	id "credo/pkg/domain"
+	audit "credo/pkg/platform/audit"
+	"credo/pkg/platform/audit/publishers"
+	auditmemory "credo/pkg/platform/audit/store/memory"
+	"credo/pkg/platform/audit/store/routed"
+)
+
+// RoutedStoreSuite tests per-category backend dispatch.
+//
+// Justification: a misrouted or unconfigured category would send compliance
+// events to a sampled store, which no E2E flow can observe.
+type RoutedStoreSuite struct {
+	suite.Suite
+	compliance *auditmemory.InMemoryStore
+	security   *auditmemory.InMemoryStore
+	ops        *auditmemory.InMemoryStore
+}
+
+func TestRoutedStoreSuite(t *testing.T) {
+	suite.Run(t, new(RoutedStoreSuite))
+}
+
+func (s *RoutedStoreSuite) SetupTest() {
+	s.compliance = auditmemory.NewInMemoryStore()
+	s.security = auditmemory.NewInMemoryStore()
+	s.ops = auditmemory.NewInMemoryStore()
+}
+
+func (s *RoutedStoreSuite) backends() map[audit.EventCategory]audit.Store {
+	return map[audit.EventCategory]audit.Store{
+		audit.CategoryCompliance: s.compliance,
+		audit.CategorySecurity:   s.security,
+		audit.CategoryOperations: s.ops,
+	}
+}
+
+func (s *RoutedStoreSuite) actions(st audit.Store) []string {
+	events, err := st.ListAll(context.Background())
+	s.Require().NoError(err)
+	actions := make([]string, 0, len(events))
+	for _, e := range events {
+		actions = append(actions, e.Action)
+	}
+	return actions
+}
+
+func (s *RoutedStoreSuite) TestNew() {
+	s.Run("missing backend fails fast", func() {
+		backends := s.backends()
+		delete(backends, audit.CategorySecurity)
+
+		_, err := routed.New(backends)
+		s.ErrorContains(err, `no audit backend configured for category "security"`)
+	})
+
+	s.Run("nil backend fails fast", func() {
+		backends := s.backends()
+		backends[audit.CategoryCompliance] = nil
+
+		_, err := routed.New(backends)
+		s.ErrorContains(err, `no audit backend configured for category "compliance"`)
+	})
+
+	s.Run("unknown category is rejected", func() {
+		backends := s.backends()
+		backends["archive"] = s.ops
+
+		_, err := routed.New(backends)
+		s.ErrorContains(err, `unknown category "archive"`)
+	})
+}
+
+func (s *RoutedStoreSuite) TestAppendRoutesByCategory() {
+	st, err := routed.New(s.backends())
+	s.Require().NoError(err)
+	ctx := context.Background()
+
+	s.Require().NoError(st.Append(ctx, audit.Event{Action: string(audit.EventUserCreated)}))
+	s.Require().NoError(st.Append(ctx, audit.Event{Action: string(audit.EventLoginFailed)}))
+	s.Require().NoError(st.Append(ctx, audit.Event{Action: string(audit.EventTokenIssued)}))
+
+	s.Equal([]string{string(audit.EventUserCreated)}, s.actions(s.compliance))
+	s.Equal([]string{string(audit.EventLoginFailed)}, s.actions(s.security))
+	s.Equal([]string{string(audit.EventTokenIssued)}, s.actions(s.ops))
+}
+
+func (s *RoutedStoreSuite) TestAppendHonoursCategoryOverrides() {
+	router, err := audit.NewCategoryRouter(map[string]string{
+		string(audit.EventTokenIssued): string(audit.CategorySecurity),
+	})
+	s.Require().NoError(err)
+	st, err := routed.New(s.backends(), routed.WithCategoryRouter(router))
+	s.Require().NoError(err)
+
+	s.Require().NoError(st.Append(context.Background(), audit.Event{Action: string(audit.EventTokenIssued)}))
+
+	s.Equal([]string{string(audit.EventTokenIssued)}, s.actions(s.security))
+	s.Empty(s.actions(s.ops))
+}
+
+func (s *RoutedStoreSuite) TestReadsMergeBackends() {
+	shared := auditmemory.NewInMemoryStore()
+	st, err := routed.New(map[audit.EventCategory]audit.Store{
+		audit.CategoryCompliance: s.compliance,
+		audit.CategorySecurity:   shared,
+		audit.CategoryOperations: shared,
+	})
+	s.Require().NoError(err)
+	ctx := context.Background()
+	userID := id.UserID(uuid.New())
+	base := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	s.Require().NoError(st.Append(ctx, audit.Event{UserID: userID, Action: string(audit.EventTokenIssued), Timestamp: base.Add(2 * time.Second), RequestID: "req-1"}))
+	s.Require().NoError(st.Append(ctx, audit.Event{UserID: userID, Action: string(audit.EventUserCreated), Timestamp: base, RequestID: "req-1"}))
+	s.Require().NoError(st.Append(ctx, audit.Event{UserID: userID, Action: string(audit.EventLoginFailed), Timestamp: base.Add(time.Second)}))
+
+	s.Run("list by user is merged oldest first without double-reading a shared backend", func() {
+		events, err := st.ListByUser(ctx, userID)
+		s.Require().NoError(err)
+		s.Require().Len(events, 3)
+		s.Equal(string(audit.EventUserCreated), events[0].Action)
+		s.Equal(string(audit.EventLoginFailed), events[1].Action)
+		s.Equal(string(audit.EventTokenIssued), events[2].Action)
+	})
+
+	s.Run("list by request spans backends", func() {
+		events, err := st.ListByRequestID(ctx, "req-1")
+		s.Require().NoError(err)
+		s.Len(events, 2)
+	})
+
+	s.Run("list recent is newest first and limited", func() {
+		events, err := st.ListRecent(ctx, 2)
+		s.Require().NoError(err)
+		s.Require().Len(events, 2)
+		s.Equal(string(audit.EventTokenIssued), events[0].Action)
+		s.Equal(string(audit.EventLoginFailed), events[1].Action)
+	})
+}
+
+func (s *RoutedStoreSuite) TestReadFailsWhenABackendFails() {
+	st, err := routed.New(map[audit.EventCategory]audit.Store{
+		audit.CategoryCompliance: s.compliance,
+		audit.CategorySecurity:   failingStore{Store: s.security},
+		audit.CategoryOperations: s.ops,
+	})
+	s.Require().NoError(err)
+
+	_, err = st.ListAll(context.Background())
+	s.Error(err, "a partial audit trail must not be returned as complete")
+}
+
+func (s *RoutedStoreSuite) TestPublishersReachConfiguredBackends() {
+	cfg := publishers.DefaultConfig()
+	cfg.OpsSampleRate = 1.0
+	system, err := publishers.NewRouted(s.backends(), nil, cfg, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	s.Require().NoError(err)
+	defer func() { _ = system.Close() }()
+	ctx := context.Background()
+
+	s.Require().NoError(system.Compliance.Emit(ctx, audit.ComplianceEvent{
+		UserID: id.UserID(uuid.New()),
+		Action: string(audit.EventConsentGranted),
+	}))
+	system.Security.Emit(ctx, audit.SecurityEvent{Action: string(audit.EventAuthLockoutTriggered)})
+	s.Require().NoError(system.Security.Flush(ctx))
+	system.Ops.Track(audit.OpsEvent{Action: string(audit.EventSessionCreated)})
+
+	s.Equal([]string{string(audit.EventConsentGranted)}, s.actions(s.compliance))
+	s.Equal([]string{string(audit.EventAuthLockoutTriggered)}, s.actions(s.security))
+	s.Eventually(func() bool {
+		return len(s.actions(s.ops)) == 1
+	}, time.Second, 10*time.Millisecond)
+}
+
+func (s *RoutedStoreSuite) TestNewRoutedFailsFastOnMissingBackend() {
+	backends := s.backends()
+	delete(backends, audit.CategoryOperations)
+
+	_, err := publishers.NewRouted(backends, nil, publishers.DefaultConfig(), slog.New(slog.NewTextHandler(io.Discard, nil)))
+	s.ErrorContains(err, `no audit backend configured for category "operations"`)
+}
+
+type failingStore struct {
+	audit.Store
+}
+
+func (failingStore) ListAll(context.Context) ([]audit.Event, error) {
+	return nil, errors.New("backend unavailable")
+}
@@ -1,7 +1,10 @@
 // Package circuit provides a simple circuit breaker implementation for resilience.
 package circuit
 
-import "sync"
+import (
+	"sync"
+	"time"
+)
 
 // State represents the circuit breaker state.
 type State int
@@ -32,6 +35,8 @@ type Breaker struct {
 	successCount     int
 	failureThreshold int
 	successThreshold int
+	lastTransition   time.Time
+	manual           bool // state was set by an operator and not yet changed by auto-logic
 }
 
 // Option configures a Breaker instance.
@@ -109,6 +114,8 @@ func (b *Breaker) RecordFailure() (useFallback bool, change StateChange) {
 
 	if b.failureCount >= b.failureThreshold {
 		b.state = StateOpen
+		b.manual = false
+		b.lastTransition = time.Now()
 		return true, StateChange{Opened: true}
 	}
 
@@ -129,6 +136,8 @@ func (b *Breaker) RecordSuccess() (usePrimary bool, change StateChange) {
 			b.state = StateClosed
 			b.failureCount = 0
 			b.successCount = 0
+			b.manual = false
+			b.lastTransition = time.Now()
 			return true, StateChange{Closed: true}
 		}
 		return false, StateChange{}
@@ -142,7 +151,68 @@ func (b *Breaker) RecordSuccess() (usePrimary bool, change StateChange) {
 func (b *Breaker) Reset() {
 	b.mu.Lock()
 	defer b.mu.Unlock()
+	if b.state != StateClosed {
+		b.lastTransition = time.Now()
+	}
+	b.state = StateClosed
+	b.failureCount = 0
+	b.successCount = 0
+	b.manual = false
+}
+
+// ForceOpen trips the circuit on operator request. The breaker then behaves as
+// if it had opened on its own and closes once SuccessThreshold successes are seen.
+func (b *Breaker) ForceOpen() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.state != StateOpen {
+		b.lastTransition = time.Now()
+	}
+	b.state = StateOpen
+	b.successCount = 0
+	b.manual = true
+}
+
+// ForceClosed closes the circuit on operator request with zero counts. It opens
+// again once FailureThreshold consecutive failures are seen.
+func (b *Breaker) ForceClosed() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.state != StateClosed {
+		b.lastTransition = time.Now()
+	}
 	b.state = StateClosed
 	b.failureCount = 0
 	b.successCount = 0
+	b.manual = true
+}
+
+// ClearOverride discards a manual state and returns the breaker to automatic
+// control from the closed state. It is a no-op when no override is active.
+func (b *Breaker) ClearOverride() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if !b.manual {
+		return
+	}
+	if b.state != StateClosed {
+		b.lastTransition = time.Now()
+	}
+	b.state = StateClosed
+	b.failureCount = 0
+	b.successCount = 0
+	b.manual = false
+}
+
+// Snapshot returns a read-only view of the breaker's current state.
+func (b *Breaker) Snapshot() Snapshot {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return Snapshot{
+		Name:           b.name,
+		State:          StatusFor(b.state == StateOpen, b.successCount),
+		FailureCount:   b.failureCount,
+		LastTransition: b.lastTransition,
+		Manual:         b.manual,
+	}
 }
@@ -120,3 +120,57 @@ func TestBreaker_OpenCircuitReturnsFallback(t *testing.T) {
 	assert.True(t, useFallback)
 	assert.False(t, change.Opened) // Already open, no state change
 }
+
+func TestBreaker_Snapshot(t *testing.T) {
+	b := New("test", WithFailureThreshold(2), WithSuccessThreshold(2))
+	snap := b.Snapshot()
+	assert.Equal(t, StatusClosed, snap.State)
+	assert.True(t, snap.LastTransition.IsZero())
+
+	b.RecordFailure()
+	b.RecordFailure()
+	snap = b.Snapshot()
+	assert.Equal(t, StatusOpen, snap.State)
+	assert.Equal(t, 2, snap.FailureCount)
+	assert.False(t, snap.LastTransition.IsZero())
+
+	b.RecordSuccess()
+	assert.Equal(t, StatusHalfOpen, b.Snapshot().State)
+}
+
+func TestBreaker_ManualOverride(t *testing.T) {
+	t.Run("forced open closes again once recovery is proven", func(t *testing.T) {
+		b := New("test", WithSuccessThreshold(2))
+		b.ForceOpen()
+		assert.True(t, b.IsOpen())
+		assert.True(t, b.Snapshot().Manual)
+
+		b.RecordSuccess()
+		_, change := b.RecordSuccess()
+		assert.True(t, change.Closed)
+		assert.False(t, b.Snapshot().Manual, "auto-logic transition ends the override")
+	})
+
+	t.Run("forced closed opens again at the failure threshold", func(t *testing.T) {
+		b := New("test", WithFailureThreshold(2))
+		b.RecordFailure()
+		b.RecordFailure()
+		b.ForceClosed()
+		assert.False(t, b.IsOpen())
+		assert.Zero(t, b.Snapshot().FailureCount)
+
+		b.RecordFailure()
+		_, change := b.RecordFailure()
+		assert.True(t, change.Opened)
+		assert.False(t, b.Snapshot().Manual)
+	})
+
+	t.Run("clearing an override returns to automatic control", func(t *testing.T) {
+		b := New("test")
+		b.ForceOpen()
+		b.ClearOverride()
+		snap := b.Snapshot()
+		assert.Equal(t, StatusClosed, snap.State)
+		assert.False(t, snap.Manual)
+	})
+}
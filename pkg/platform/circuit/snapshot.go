@@ -0,0 +1,102 @@
+package circuit
+
+import (
+	"sync"
+	"time"
+)
+
+// Status is the externally reported breaker state.
+type Status string
+
+const (
+	StatusClosed   Status = "closed"
+	StatusOpen     Status = "open"
+	StatusHalfOpen Status = "half_open" // open, but recent successes are counting toward recovery
+)
+
+// StatusFor maps a two-state breaker to its reported status. An open breaker that
+// has recorded successes since opening is trialling recovery and reports half-open.
+func StatusFor(open bool, successCount int) Status {
+	switch {
+	case !open:
+		return StatusClosed
+	case successCount > 0:
+		return StatusHalfOpen
+	default:
+		return StatusOpen
+	}
+}
+
+// Snapshot is a point-in-time, read-only view of a circuit breaker.
+type Snapshot struct {
+	Name           string
+	State          Status
+	FailureCount   int
+	LastTransition time.Time // zero if the breaker has never changed state
+	Manual         bool      // state was forced by an operator and auto-logic has not since changed it
+}
+
+// Reporter is implemented by circuit breakers that can report their state.
+type Reporter interface {
+	Snapshot() Snapshot
+}
+
+// Controller is implemented by circuit breakers that operators can override.
+//
+// A forced state is respected until the breaker's own thresholds would change it
+// (a forced-open breaker still closes after enough successes, a forced-closed one
+// still opens after enough failures) or until the override is cleared.
+type Controller interface {
+	Reporter
+	ForceOpen()
+	ForceClosed()
+	ClearOverride()
+}
+
+// Registry collects circuit breakers from across modules so operators can
+// inspect them in one place. Breakers are reported in registration order.
+type Registry struct {
+	mu        sync.RWMutex
+	reporters []Reporter
+}
+
+// NewRegistry creates an empty breaker registry.
+func NewRegistry() *Registry {
+	return &Registry{}
+}
+
+// Register adds breakers to the registry. Nil reporters are ignored.
+func (r *Registry) Register(reporters ...Reporter) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, rep := range reporters {
+		if rep != nil {
+			r.reporters = append(r.reporters, rep)
+		}
+	}
+}
+
+// Snapshots returns the current state of every registered breaker.
+func (r *Registry) Snapshots() []Snapshot {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	out := make([]Snapshot, 0, len(r.reporters))
+	for _, rep := range r.reporters {
+		out = append(out, rep.Snapshot())
+	}
+	return out
+}
+
+// Controller returns the registered breaker with the given name if it accepts
+// manual overrides.
+func (r *Registry) Controller(name string) (Controller, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for _, rep := range r.reporters {
+		ctrl, ok := rep.(Controller)
+		if ok && rep.Snapshot().Name == name {
+			return ctrl, true
+		}
+	}
+	return nil, false
+}
@@ -22,6 +22,7 @@ func WriteJSON(w http.ResponseWriter, status int, response any) {
 
 // WriteError centralizes domain error translation to HTTP responses.
 // It translates transport-agnostic domain errors into HTTP status codes and error responses.
+// A domain error's Reason, when set, is returned as "reason" next to the error code.
 func WriteError(w http.ResponseWriter, err error) {
 	// Try domain error first
 	var domainErr *dErrors.Error
@@ -34,6 +35,9 @@ func WriteError(w http.ResponseWriter, err error) {
 		if domainErr.Message != "" && domainErr.Code != dErrors.CodeInternal {
 			response["error_description"] = domainErr.Message
 		}
+		if domainErr.Reason != "" {
+			response["reason"] = domainErr.Reason
+		}
 		WriteJSON(w, status, response)
 		return
 	}
@@ -66,7 +70,8 @@ func DomainCodeToHTTPStatus(code dErrors.Code) int {
 	case dErrors.CodeInternal:
 		return http.StatusInternalServerError
 	// OAuth 2.0 error codes (RFC 6749 §5.2) - all return 400 Bad Request
-	case dErrors.CodeInvalidGrant, dErrors.CodeInvalidClient, dErrors.CodeUnsupportedGrantType, dErrors.CodeInvalidRequest, dErrors.CodeAccessDenied:
+	case dErrors.CodeInvalidGrant, dErrors.CodeInvalidClient, dErrors.CodeUnsupportedGrantType, dErrors.CodeInvalidRequest, dErrors.CodeAccessDenied, dErrors.CodeInvalidDPoPProof, dErrors.CodeInvalidTarget,
+		dErrors.CodeInvalidScope, dErrors.CodeUnauthorizedClient:
 		return http.StatusBadRequest
 	default:
 		return http.StatusInternalServerError
@@ -124,6 +129,14 @@ func DomainCodeToHTTPCode(code dErrors.Code) string {
 		return "invalid_request"
 	case dErrors.CodeAccessDenied:
 		return "access_denied"
+	case dErrors.CodeInvalidDPoPProof:
+		return "invalid_dpop_proof"
+	case dErrors.CodeInvalidTarget:
+		return "invalid_target"
+	case dErrors.CodeInvalidScope:
+		return "invalid_scope"
+	case dErrors.CodeUnauthorizedClient:
+		return "unauthorized_client"
 	default:
 		return "internal_error"
 	}
@@ -48,5 +48,21 @@ func TestWriteError(t *testing.T) {
 		if body["error_description"] != "invalid input" {
 			t.Fatalf("expected error_description to be returned for bad request")
 		}
+		if _, ok := body["reason"]; ok {
+			t.Fatalf("expected reason to be omitted when unset")
+		}
+	})
+
+	t.Run("reason is returned when set", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		WriteError(w, dErrors.NewWithReason(dErrors.CodeForbidden, "user_inactive", "user inactive"))
+
+		var body map[string]string
+		if err := json.NewDecoder(w.Body).Decode(&body); err != nil {
+			t.Fatalf("decode response: %v", err)
+		}
+		if body["error"] != "forbidden" || body["reason"] != "user_inactive" {
+			t.Fatalf("expected forbidden with reason user_inactive, got %v", body)
+		}
 	})
 }
@@ -6,6 +6,7 @@ import (
 	"log/slog"
 	"net/http"
 	"strings"
+	"time"
 
 	id "credo/pkg/domain"
 	"credo/pkg/requestcontext"
@@ -21,6 +22,12 @@ type TokenRevocationChecker interface {
 	IsTokenRevoked(ctx context.Context, jti string) (bool, error)
 }
 
+// DPoPProofVerifier validates DPoP proofs for sender-constrained tokens (RFC 9449).
+// It returns the thumbprint of the key that signed the proof.
+type DPoPProofVerifier interface {
+	VerifyProof(proof, method, path, accessToken string, now time.Time) (string, error)
+}
+
 // JWTClaims represents the claims we expect from the JWT validator
 type JWTClaims struct {
 	UserID     string
@@ -28,6 +35,22 @@ type JWTClaims struct {
 	ClientID   string
 	JTI        string // JWT ID for revocation tracking
 	APIVersion string // API version from token audience (e.g., "v1")
+	DPoPJKT    string // cnf.jkt thumbprint; non-empty for DPoP-bound tokens
+}
+
+// Option configures RequireAuth.
+type Option func(*options)
+
+type options struct {
+	dpop DPoPProofVerifier
+}
+
+// WithDPoPVerifier enables DPoP-bound token acceptance. Without a verifier,
+// bound tokens are always rejected.
+func WithDPoPVerifier(v DPoPProofVerifier) Option {
+	return func(o *options) {
+		o.dpop = v
+	}
 }
 
 // writeJSONError writes a JSON error response with the given status code and error details.
@@ -120,15 +143,51 @@ func parseClaims(claims *JWTClaims) (*parsedClaims, error) {
 	}, nil
 }
 
+// extractToken returns the access token and whether it was sent with the DPoP scheme.
+func extractToken(authHeader string) (token string, dpopScheme bool, ok bool) {
+	if token, ok := strings.CutPrefix(authHeader, "Bearer "); ok {
+		return token, false, true
+	}
+	if token, ok := strings.CutPrefix(authHeader, "DPoP "); ok {
+		return token, true, true
+	}
+	return "", false, false
+}
+
+// checkSenderConstraint verifies that a DPoP-bound token is presented with the DPoP
+// scheme and a proof signed by the bound key (RFC 9449 §7). Unbound tokens must not
+// use the DPoP scheme. Returns a client-safe description on failure.
+func checkSenderConstraint(r *http.Request, verifier DPoPProofVerifier, claims *JWTClaims, token string, dpopScheme bool) (string, bool) {
+	if claims.DPoPJKT == "" {
+		if dpopScheme {
+			return "Token is not DPoP-bound", false
+		}
+		return "", true
+	}
+	if !dpopScheme || verifier == nil {
+		return "DPoP-bound token requires DPoP authorization", false
+	}
+	jkt, err := verifier.VerifyProof(r.Header.Get("DPoP"), r.Method, r.URL.Path, token, requestcontext.Now(r.Context()))
+	if err != nil || jkt != claims.DPoPJKT {
+		return "Invalid DPoP proof", false
+	}
+	return "", true
+}
+
 // RequireAuth returns middleware that validates JWT tokens and populates context with typed IDs.
-// It validates the token, checks revocation status, parses claim IDs, and stores typed IDs in context.
-func RequireAuth(validator JWTValidator, revocationChecker TokenRevocationChecker, logger *slog.Logger) func(http.Handler) http.Handler {
+// It validates the token, checks revocation status, verifies DPoP sender constraints,
+// parses claim IDs, and stores typed IDs in context.
+func RequireAuth(validator JWTValidator, revocationChecker TokenRevocationChecker, logger *slog.Logger, opts ...Option) func(http.Handler) http.Handler {
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			ctx := r.Context()
 			authHeader := r.Header.Get("Authorization")
 
-			token, ok := strings.CutPrefix(authHeader, "Bearer ")
+			token, dpopScheme, ok := extractToken(authHeader)
 			if !ok {
 				requestID := requestcontext.RequestID(ctx)
 				logger.WarnContext(ctx, "unauthorized access - missing token",
@@ -149,6 +208,16 @@ func RequireAuth(validator JWTValidator, revocationChecker TokenRevocationChecke
 				return
 			}
 
+			if desc, ok := checkSenderConstraint(r, o.dpop, claims, token, dpopScheme); !ok {
+				requestID := requestcontext.RequestID(ctx)
+				logger.WarnContext(ctx, "unauthorized access - DPoP sender constraint failed",
+					"request_id", requestID,
+				)
+				w.Header().Set("WWW-Authenticate", `DPoP error="invalid_dpop_proof"`)
+				writeJSONError(w, http.StatusUnauthorized, "invalid_dpop_proof", desc)
+				return
+			}
+
 			// TR-4: Middleware revocation check (PRD-016).
 			switch checkRevocation(ctx, revocationChecker, claims.JTI, logger) {
 			case revocationMissingJTI, revocationRevoked:
@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
@@ -284,6 +285,75 @@ func (s *AuthMiddlewareTestSuite) TestBearerWithWhitespaceToken() {
 	s.Equal(http.StatusUnauthorized, w.Code)
 }
 
+// stubDPoPVerifier maps proof strings to the thumbprint of the key that signed them.
+type stubDPoPVerifier struct {
+	thumbprints map[string]string
+}
+
+func (v *stubDPoPVerifier) VerifyProof(proof, _, _, _ string, _ time.Time) (string, error) {
+	jkt, ok := v.thumbprints[proof]
+	if !ok {
+		return "", errors.New("invalid proof")
+	}
+	return jkt, nil
+}
+
+func (s *AuthMiddlewareTestSuite) TestDPoPBoundToken() {
+	boundClaims := &JWTClaims{
+		UserID:    testUserID,
+		SessionID: testSessionID,
+		ClientID:  testClientID,
+		JTI:       "jti-dpop",
+		DPoPJKT:   "holder-jkt",
+	}
+	verifier := &stubDPoPVerifier{thumbprints: map[string]string{
+		"holder-proof":   "holder-jkt",
+		"attacker-proof": "attacker-jkt",
+	}}
+
+	serve := func(authHeader, proof string, opts ...Option) (*mockHandler, *httptest.ResponseRecorder) {
+		next := &mockHandler{}
+		handler := RequireAuth(s.validator, nil, s.logger, opts...)(next)
+		req := httptest.NewRequest(http.MethodGet, "/test", nil)
+		req.Header.Set("Authorization", authHeader)
+		if proof != "" {
+			req.Header.Set("DPoP", proof)
+		}
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, req)
+		return next, w
+	}
+
+	s.Run("proof from bound key is accepted", func() {
+		s.validator.On("ValidateToken", "bound-token").Return(boundClaims, nil).Once()
+		next, w := serve("DPoP bound-token", "holder-proof", WithDPoPVerifier(verifier))
+		s.True(next.called)
+		s.Equal(http.StatusOK, w.Code)
+	})
+
+	s.Run("replayed token with mismatched proof is rejected", func() {
+		s.validator.On("ValidateToken", "bound-token").Return(boundClaims, nil).Once()
+		next, w := serve("DPoP bound-token", "attacker-proof", WithDPoPVerifier(verifier))
+		s.False(next.called)
+		s.Equal(http.StatusUnauthorized, w.Code)
+		s.Contains(w.Header().Get("WWW-Authenticate"), "invalid_dpop_proof")
+	})
+
+	s.Run("bound token presented as bearer is rejected", func() {
+		s.validator.On("ValidateToken", "bound-token").Return(boundClaims, nil).Once()
+		next, w := serve("Bearer bound-token", "", WithDPoPVerifier(verifier))
+		s.False(next.called)
+		s.Equal(http.StatusUnauthorized, w.Code)
+	})
+
+	s.Run("bound token is rejected when DPoP is not configured", func() {
+		s.validator.On("ValidateToken", "bound-token").Return(boundClaims, nil).Once()
+		next, w := serve("DPoP bound-token", "holder-proof")
+		s.False(next.called)
+		s.Equal(http.StatusUnauthorized, w.Code)
+	})
+}
+
 func TestAuthMiddlewareTestSuite(t *testing.T) {
 	suite.Run(t, new(AuthMiddlewareTestSuite))
 }
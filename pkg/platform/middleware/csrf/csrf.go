@@ -0,0 +1,135 @@
+package csrf
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"log/slog"
+	"net/http"
+
+	"credo/pkg/requestcontext"
+)
+
+// Default names for the double-submit token.
+const (
+	DefaultCookieName = "csrf_token"
+	DefaultHeaderName = "X-CSRF-Token"
+)
+
+// Config holds configuration for the CSRF middleware.
+type Config struct {
+	// CookieName is the cookie carrying the token. Defaults to DefaultCookieName.
+	CookieName string
+
+	// HeaderName is the request header the client echoes the token in.
+	// Defaults to DefaultHeaderName.
+	HeaderName string
+
+	// NewCookie builds the issued token cookie, so it carries the same security
+	// attributes as the session cookie it protects; pass
+	// config.CookieConfig.NewCookie. The token is always readable by scripts,
+	// whatever HttpOnly the builder sets. Defaults to a session cookie on "/"
+	// with SameSite=Strict, Secure over TLS.
+	NewCookie func(r *http.Request, name, value string, maxAge int) *http.Cookie
+
+	Logger *slog.Logger
+}
+
+// Protect enforces double-submit-cookie CSRF protection on state-changing requests.
+//
+// Safe methods (GET, HEAD, OPTIONS, TRACE) pass through and are issued a token
+// cookie when none is present. Other methods must echo the cookie value in the
+// header; a missing or mismatched token is rejected with 403.
+//
+// Requests carrying an Authorization header are exempt: bearer and DPoP tokens
+// are not sent ambiently by browsers, so they cannot be forged cross-site. Mount
+// this only on routes whose handlers authenticate through cookies, never in front
+// of routes that fall back from a header to a cookie.
+func Protect(cfg Config) func(http.Handler) http.Handler {
+	cookieName := cfg.CookieName
+	if cookieName == "" {
+		cookieName = DefaultCookieName
+	}
+	headerName := cfg.HeaderName
+	if headerName == "" {
+		headerName = DefaultHeaderName
+	}
+	newCookie := cfg.NewCookie
+	if newCookie == nil {
+		newCookie = defaultCookie
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("Authorization") != "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			cookie, err := r.Cookie(cookieName)
+			hasCookie := err == nil && cookie.Value != ""
+
+			if isSafeMethod(r.Method) {
+				if !hasCookie {
+					token, err := newToken()
+					if err != nil {
+						http.Error(w, `{"error":"internal_error"}`, http.StatusInternalServerError)
+						return
+					}
+					// Readable by scripts on purpose: the client must copy it into the header
+					tokenCookie := newCookie(r, cookieName, token, 0)
+					tokenCookie.HttpOnly = false
+					http.SetCookie(w, tokenCookie)
+				}
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			header := r.Header.Get(headerName)
+			if !hasCookie || header == "" || subtle.ConstantTimeCompare([]byte(header), []byte(cookie.Value)) != 1 {
+				if cfg.Logger != nil {
+					ctx := r.Context()
+					cfg.Logger.WarnContext(ctx, "csrf token missing or mismatched",
+						"request_id", requestcontext.RequestID(ctx),
+						"method", r.Method,
+						"path", r.URL.Path,
+					)
+				}
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusForbidden)
+				_, _ = w.Write([]byte(`{"error":"forbidden","error_description":"csrf token missing or invalid"}`)) //nolint:errcheck // headers already sent
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+func defaultCookie(r *http.Request, name, value string, maxAge int) *http.Cookie {
+	return &http.Cookie{ //nolint:gosec // double-submit token must not be HttpOnly
+		Name:     name,
+		Value:    value,
+		Path:     "/",
+		MaxAge:   maxAge,
+		Secure:   r.TLS != nil,
+		SameSite: http.SameSiteStrictMode,
+	}
+}
+
+func isSafeMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodTrace:
+		return true
+	}
+	return false
+}
+
+// newToken returns 32 random bytes, base64url encoded.
+func newToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
@@ -0,0 +1,138 @@
+package csrf
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+// CSRFMiddlewareSuite tests the double-submit CSRF middleware.
+//
+// Justification: Security-critical request filter.
+// The invariant "forged cookie-authenticated mutation never reaches handler" must be preserved.
+type CSRFMiddlewareSuite struct {
+	suite.Suite
+	handlerCalled bool
+	handler       http.Handler
+}
+
+func TestCSRFMiddlewareSuite(t *testing.T) {
+	suite.Run(t, new(CSRFMiddlewareSuite))
+}
+
+func (s *CSRFMiddlewareSuite) SetupTest() {
+	s.handlerCalled = false
+	s.handler = Protect(Config{})(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.handlerCalled = true
+		w.WriteHeader(http.StatusOK)
+	}))
+}
+
+func (s *CSRFMiddlewareSuite) serve(req *http.Request) *httptest.ResponseRecorder {
+	s.handlerCalled = false
+	w := httptest.NewRecorder()
+	s.handler.ServeHTTP(w, req)
+	return w
+}
+
+func (s *CSRFMiddlewareSuite) TestStateChangingRequests() {
+	s.Run("matching cookie and header passes", func() {
+		req := httptest.NewRequest(http.MethodPost, "/settings", nil)
+		req.AddCookie(&http.Cookie{Name: DefaultCookieName, Value: "token-123"})
+		req.Header.Set(DefaultHeaderName, "token-123")
+
+		w := s.serve(req)
+
+		s.True(s.handlerCalled)
+		s.Equal(http.StatusOK, w.Code)
+	})
+
+	s.Run("missing header returns 403 and blocks handler", func() {
+		req := httptest.NewRequest(http.MethodPost, "/settings", nil)
+		req.AddCookie(&http.Cookie{Name: DefaultCookieName, Value: "token-123"})
+
+		w := s.serve(req)
+
+		s.False(s.handlerCalled)
+		s.Equal(http.StatusForbidden, w.Code)
+		s.JSONEq(`{"error":"forbidden","error_description":"csrf token missing or invalid"}`, w.Body.String())
+	})
+
+	s.Run("missing cookie returns 403", func() {
+		req := httptest.NewRequest(http.MethodDelete, "/settings", nil)
+		req.Header.Set(DefaultHeaderName, "token-123")
+
+		w := s.serve(req)
+
+		s.False(s.handlerCalled)
+		s.Equal(http.StatusForbidden, w.Code)
+	})
+
+	s.Run("mismatched token returns 403", func() {
+		req := httptest.NewRequest(http.MethodPut, "/settings", nil)
+		req.AddCookie(&http.Cookie{Name: DefaultCookieName, Value: "token-123"})
+		req.Header.Set(DefaultHeaderName, "token-456")
+
+		w := s.serve(req)
+
+		s.False(s.handlerCalled)
+		s.Equal(http.StatusForbidden, w.Code)
+	})
+
+	s.Run("bearer-authenticated request is exempt", func() {
+		req := httptest.NewRequest(http.MethodPost, "/auth/userinfo", nil)
+		req.Header.Set("Authorization", "Bearer access-token")
+
+		w := s.serve(req)
+
+		s.True(s.handlerCalled)
+		s.Equal(http.StatusOK, w.Code)
+	})
+}
+
+func (s *CSRFMiddlewareSuite) TestSafeMethods() {
+	s.Run("issues token cookie when absent", func() {
+		w := s.serve(httptest.NewRequest(http.MethodGet, "/settings", nil))
+
+		s.True(s.handlerCalled)
+		cookies := w.Result().Cookies()
+		s.Require().Len(cookies, 1)
+		s.Equal(DefaultCookieName, cookies[0].Name)
+		s.NotEmpty(cookies[0].Value)
+		s.False(cookies[0].HttpOnly, "client must be able to read the token to echo it")
+		s.Equal(http.SameSiteStrictMode, cookies[0].SameSite)
+	})
+
+	s.Run("builds the token cookie with the configured attributes", func() {
+		handler := Protect(Config{
+			NewCookie: func(_ *http.Request, name, value string, maxAge int) *http.Cookie {
+				return &http.Cookie{
+					Name: name, Value: value, MaxAge: maxAge, Path: "/app", Domain: "example.com",
+					HttpOnly: true, Secure: true, SameSite: http.SameSiteLaxMode,
+				}
+			},
+		})(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) { w.WriteHeader(http.StatusOK) }))
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/settings", nil))
+
+		cookies := w.Result().Cookies()
+		s.Require().Len(cookies, 1)
+		s.Equal("/app", cookies[0].Path)
+		s.Equal("example.com", cookies[0].Domain)
+		s.True(cookies[0].Secure)
+		s.Equal(http.SameSiteLaxMode, cookies[0].SameSite)
+		s.False(cookies[0].HttpOnly, "the token stays readable whatever the builder sets")
+	})
+
+	s.Run("keeps existing token cookie", func() {
+		req := httptest.NewRequest(http.MethodGet, "/settings", nil)
+		req.AddCookie(&http.Cookie{Name: DefaultCookieName, Value: "token-123"})
+
+		w := s.serve(req)
+
+		s.True(s.handlerCalled)
+		s.Empty(w.Result().Cookies())
+	})
+}
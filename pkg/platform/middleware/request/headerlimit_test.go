@@ -0,0 +1,81 @@
+package request
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHeaderLimit(t *testing.T) {
+	const (
+		maxURLBytes    = 256
+		maxHeaderBytes = 512
+	)
+	serve := func(req *http.Request) (*httptest.ResponseRecorder, bool) {
+		called := false
+		handler := HeaderLimit(maxURLBytes, maxHeaderBytes)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			called = true
+			w.WriteHeader(http.StatusOK)
+		}))
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, req)
+		return w, called
+	}
+
+	t.Run("request within limits passes through", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/auth/authorize?scope=openid&state=abc", nil)
+		req.Header.Set("Authorization", "Bearer token")
+
+		w, called := serve(req)
+
+		assert.True(t, called)
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("oversized URL returns 414 before handler", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/auth/authorize?state="+strings.Repeat("a", maxURLBytes), nil)
+
+		w, called := serve(req)
+
+		assert.False(t, called)
+		assert.Equal(t, http.StatusRequestURITooLong, w.Code)
+		assert.JSONEq(t, `{"error":"uri_too_long","error_description":"request URL exceeds the maximum allowed length"}`, w.Body.String())
+	})
+
+	t.Run("oversized headers return 431 before handler", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/auth/authorize", nil)
+		req.Header.Set("X-Padding", strings.Repeat("a", maxHeaderBytes))
+
+		w, called := serve(req)
+
+		assert.False(t, called)
+		assert.Equal(t, http.StatusRequestHeaderFieldsTooLarge, w.Code)
+	})
+
+	t.Run("many small headers are summed", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/auth/authorize", nil)
+		for range 40 {
+			req.Header.Add("X-Repeated", "0123456789")
+		}
+
+		w, called := serve(req)
+
+		assert.False(t, called)
+		assert.Equal(t, http.StatusRequestHeaderFieldsTooLarge, w.Code)
+	})
+
+	t.Run("non-positive limits disable the checks", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/auth/authorize?state="+strings.Repeat("a", 4096), nil)
+		req.Header.Set("X-Padding", strings.Repeat("a", 4096))
+		w := httptest.NewRecorder()
+
+		HeaderLimit(0, 0)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		})).ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+}
@@ -26,6 +26,9 @@ const (
 
 	// MaxGrants is the maximum number of OAuth grant types per client.
 	MaxGrants = 10
+
+	// MaxResources is the maximum number of resource indicators per client or token request.
+	MaxResources = 10
 )
 
 // String element length limits
@@ -36,6 +39,9 @@ const (
 	// MaxRedirectURILength is the maximum length of a redirect URI.
 	MaxRedirectURILength = 2048
 
+	// MaxResourceLength is the maximum length of a resource indicator URI.
+	MaxResourceLength = 2048
+
 	// MaxPurposeIDLength is the maximum length of a purpose identifier.
 	MaxPurposeIDLength = 100
 
@@ -56,6 +62,12 @@ const (
 
 	// MaxRefreshTokenLength is the maximum length of a refresh token.
 	MaxRefreshTokenLength = 256
+
+	// MaxSubjectTokenLength is the maximum length of a token exchange subject token.
+	MaxSubjectTokenLength = 8192
+
+	// MaxClientSecretLength is the maximum length of a client secret at the token endpoint.
+	MaxClientSecretLength = 256
 )
 
 // CheckSliceCount validates that a slice does not exceed the maximum count.
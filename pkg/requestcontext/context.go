@@ -42,6 +42,7 @@ type (
 	requestTimeKey       struct{}
 	apiVersionKey        struct{}
 	tokenAPIVersionKey   struct{}
+	dpopThumbprintKey    struct{}
 )
 
 // Exported context keys for direct use in tests that need context.WithValue.
@@ -57,6 +58,7 @@ var (
 	ContextKeyRequestTime       = requestTimeKey{}
 	ContextKeyAPIVersion        = apiVersionKey{}
 	ContextKeyTokenAPIVersion   = tokenAPIVersionKey{}
+	ContextKeyDPoPThumbprint    = dpopThumbprintKey{}
 )
 
 // -----------------------------------------------------------------------------
@@ -239,3 +241,22 @@ func TokenAPIVersion(ctx context.Context) id.APIVersion {
 func WithTokenAPIVersion(ctx context.Context, version id.APIVersion) context.Context {
 	return context.WithValue(ctx, ContextKeyTokenAPIVersion, version)
 }
+
+// -----------------------------------------------------------------------------
+// Sender-constrained tokens (DPoP, RFC 9449)
+// -----------------------------------------------------------------------------
+
+// DPoPThumbprint retrieves the JWK thumbprint of a verified DPoP proof from context.
+// Returns empty string if the request carried no DPoP proof.
+func DPoPThumbprint(ctx context.Context) string {
+	if jkt, ok := ctx.Value(ContextKeyDPoPThumbprint).(string); ok {
+		return jkt
+	}
+	return ""
+}
+
+// WithDPoPThumbprint injects the verified DPoP key thumbprint into the context.
+// Set by the token endpoint after verifying the DPoP proof header.
+func WithDPoPThumbprint(ctx context.Context, jkt string) context.Context {
+	return context.WithValue(ctx, ContextKeyDPoPThumbprint, jkt)
+}
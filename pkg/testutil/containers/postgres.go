@@ -155,6 +155,7 @@ func (p *PostgresContainer) TruncateModuleTables(ctx context.Context) error {
 		"consents",
 
 		// Core tables (users depends on tenants via clients)
+		"tenant_idempotency_keys",
 		"users",
 		"clients",
 		"tenants",